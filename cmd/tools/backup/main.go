@@ -0,0 +1,112 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"go.uber.org/zap"
+
+	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
+	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/backup"
+	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/paramtable"
+)
+
+var (
+	mode       = flag.String("mode", "", "backup or restore")
+	backupName = flag.String("name", "", "Name of the backup")
+	rootPath   = flag.String("root", "backup", "Path in the object storage to store the backups")
+	dbName     = flag.String("db", "", "Database of the collection, the default database is used if it is empty")
+	collection = flag.String("collection", "", "Collection to backup, or the new collection to restore into")
+	timestamp  = flag.Uint64("ts", 0, "Hybrid timestamp to backup at, the current time is used if it is zero")
+)
+
+func main() {
+	flag.Parse()
+	if (*mode != "backup" && *mode != "restore") || *backupName == "" || *collection == "" {
+		fmt.Println("usage: backup -mode backup|restore -name <backup> -collection <collection> [-db <db>] [-root <path>] [-ts <timestamp>]")
+		os.Exit(1)
+	}
+
+	var params paramtable.GlobalParamTable
+	params.Init()
+	ctx := context.Background()
+
+	etcdCli, err := etcd.GetEtcdClient(&params.BaseParams)
+	if err != nil {
+		log.Fatal("failed to connect to etcd", zap.Error(err))
+	}
+	defer etcdCli.Close()
+
+	rootCoord, err := rcc.NewClient(ctx, params.BaseParams.MetaRootPath, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create root coord client", zap.Error(err))
+	}
+	dataCoord, err := dcc.NewClient(ctx, params.BaseParams.MetaRootPath, etcdCli)
+	if err != nil {
+		log.Fatal("failed to create data coord client", zap.Error(err))
+	}
+	for _, component := range []interface {
+		Init() error
+		Start() error
+	}{rootCoord, dataCoord} {
+		if err := component.Init(); err != nil {
+			log.Fatal("failed to init client", zap.Error(err))
+		}
+		if err := component.Start(); err != nil {
+			log.Fatal("failed to start client", zap.Error(err))
+		}
+	}
+
+	minioKV, err := miniokv.NewMinIOKV(ctx, &miniokv.Option{
+		Address:           params.MinioCfg.Address,
+		AccessKeyID:       params.MinioCfg.AccessKeyID,
+		SecretAccessKeyID: params.MinioCfg.SecretAccessKey,
+		UseSSL:            params.MinioCfg.UseSSL,
+		BucketName:        params.MinioCfg.BucketName,
+	})
+	if err != nil {
+		log.Fatal("failed to connect to minio", zap.Error(err))
+	}
+
+	manager := backup.NewManager(rootCoord, dataCoord, storage.NewMinioChunkManager(minioKV), *rootPath)
+	switch *mode {
+	case "backup":
+		result, err := manager.Backup(ctx, *backupName, *dbName, *collection, *timestamp)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		fmt.Printf("backup %s of collection %s complete, timestamp: %d, segments: %d\n",
+			*backupName, *collection, result.GetBackupTimestamp(), len(result.GetSegments()))
+	case "restore":
+		collectionID, err := manager.Restore(ctx, *backupName, *dbName, *collection)
+		if err != nil {
+			fmt.Printf("error: %s\n", err.Error())
+			os.Exit(1)
+		}
+		fmt.Printf("restore backup %s into collection %s complete, collection ID: %d\n", *backupName, *collection, collectionID)
+	}
+}
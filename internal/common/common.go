@@ -41,6 +41,10 @@ const (
 	// TimeStampFieldName defines the name of the Timestamp field
 	TimeStampFieldName = "Timestamp"
 
+	// MetaFieldName defines the name of the hidden json field which stores the dynamic fields,
+	// it can't collide with the user fields since a field name can't start with `$`
+	MetaFieldName = "$meta"
+
 	// DefaultShardsNum defines the default number of shards when creating a collection
 	DefaultShardsNum = int32(2)
 
@@ -54,6 +58,13 @@ const (
 	NotRegisteredID = int64(-1)
 )
 
+// collection properties
+const (
+	// CollectionTTLConfigKey is the property key of the collection ttl in seconds,
+	// the entities inserted earlier than the ttl are filtered out in query and dropped by compaction
+	CollectionTTLConfigKey = "collection.ttl.seconds"
+)
+
 // Endian is type alias of binary.LittleEndian.
 // Milvus uses little endian by default.
 var Endian = binary.LittleEndian
@@ -78,6 +78,10 @@ datatype_name(DataType data_type) {
         case DataType::VECTOR_BINARY: {
             return "vector_binary";
         }
+        case DataType::VARCHAR:
+            return "varchar";
+        case DataType::JSON:
+            return "json";
         default: {
             auto err_msg = "Unsupported DataType(" + std::to_string((int)data_type) + ")";
             PanicInfo(err_msg);
@@ -126,6 +130,12 @@ class FieldMeta {
 
     FieldMeta(const FieldName& name, FieldId id, DataType type) : name_(name), id_(id), type_(type) {
         Assert(!is_vector());
+        Assert(!is_string());
+    }
+
+    FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t max_len)
+        : name_(name), id_(id), type_(type), string_info_(StringInfo{max_len}) {
+        Assert(is_string());
     }
 
     FieldMeta(const FieldName& name, FieldId id, DataType type, int64_t dim, std::optional<MetricType> metric_type)
@@ -139,6 +149,20 @@ class FieldMeta {
         return type_ == DataType::VECTOR_BINARY || type_ == DataType::VECTOR_FLOAT;
     }
 
+    bool
+    is_string() const {
+        Assert(type_ != DataType::NONE);
+        // a json field holds the serialized documents in the same layout as a varchar field
+        return type_ == DataType::VARCHAR || type_ == DataType::JSON;
+    }
+
+    int64_t
+    get_max_len() const {
+        Assert(is_string());
+        Assert(string_info_.has_value());
+        return string_info_->max_len_;
+    }
+
     int64_t
     get_dim() const {
         Assert(is_vector());
@@ -168,10 +192,25 @@ class FieldMeta {
         return type_;
     }
 
+    // only the scalar fields can be nullable, the validity of the rows is kept by the segment
+    void
+    set_nullable(bool nullable) {
+        Assert(!nullable || !is_vector());
+        nullable_ = nullable;
+    }
+
+    bool
+    is_nullable() const {
+        return nullable_;
+    }
+
+    // a varchar value or a serialized json document varies in size, which takes its length and the bytes
     int
     get_sizeof() const {
         if (is_vector()) {
             return datatype_sizeof(type_, get_dim());
+        } else if (is_string()) {
+            PanicInfo("size of " + datatype_name(type_) + " value is variable");
         } else {
             return datatype_sizeof(type_);
         }
@@ -182,10 +221,15 @@ class FieldMeta {
         int64_t dim_;
         std::optional<MetricType> metric_type_;
     };
+    struct StringInfo {
+        int64_t max_len_;
+    };
     FieldName name_;
     FieldId id_;
     DataType type_ = DataType::NONE;
     std::optional<VectorInfo> vector_info_;
+    std::optional<StringInfo> string_info_;
+    bool nullable_ = false;
 };
 
 }  // namespace milvus
@@ -69,9 +69,15 @@ Schema::ParseFrom(const milvus::proto::schema::CollectionSchema& schema_proto) {
                 auto metric_type = GetMetricType(index_map.at("metric_type"));
                 schema->AddField(name, field_id, data_type, dim, metric_type);
             }
+        } else if (data_type == DataType::VARCHAR || data_type == DataType::JSON) {
+            auto type_map = RepeatedKeyValToMap(child.type_params());
+            AssertInfo(type_map.count("max_length"), "max_length not found");
+            auto max_len = boost::lexical_cast<int64_t>(type_map.at("max_length"));
+            schema->AddField(name, field_id, data_type, max_len);
         } else {
             schema->AddField(name, field_id, data_type);
         }
+        schema->fields_.back().set_nullable(child.nullable());
 
         if (child.is_primary_key()) {
             AssertInfo(!schema->get_primary_key_offset().has_value(), "repetitive primary key");
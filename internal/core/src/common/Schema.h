@@ -57,6 +57,13 @@ class Schema {
         this->AddField(std::move(field_meta));
     }
 
+    // string type
+    void
+    AddField(const FieldName& name, const FieldId id, DataType data_type, int64_t max_len) {
+        auto field_meta = FieldMeta(name, id, data_type, max_len);
+        this->AddField(std::move(field_meta));
+    }
+
     // vector type
     void
     AddField(const FieldName& name,
@@ -158,7 +165,8 @@ class Schema {
         AssertInfo(!id_offsets_.count(field_meta.get_id()), "duplicated field id");
         id_offsets_.emplace(field_meta.get_id(), offset);
 
-        auto field_sizeof = field_meta.get_sizeof();
+        // the varchar and json values vary in size, which are not counted in the total size
+        auto field_sizeof = field_meta.is_string() ? 0 : field_meta.get_sizeof();
         sizeof_infos_.push_back(std::move(field_sizeof));
         fields_.emplace_back(std::move(field_meta));
         total_sizeof_ += field_sizeof;
@@ -20,6 +20,7 @@
 #include <limits>
 #include <string>
 #include <utility>
+#include <variant>
 #include <vector>
 #include <boost/align/aligned_allocator.hpp>
 #include <NamedType/named_type.hpp>
@@ -43,6 +44,9 @@ using VectorArray = proto::schema::VectorField;
 using IdArray = proto::schema::IDs;
 using MetricType = faiss::MetricType;
 
+// a primary key is either int64 or varchar, monostate stands for the invalid primary key
+using PkType = std::variant<std::monostate, int64_t, std::string>;
+
 MetricType
 GetMetricType(const std::string& type);
 
@@ -84,7 +88,7 @@ struct SearchResult {
     // TODO(gexi): utilize these fields
     void* segment_;
     std::vector<int64_t> result_offsets_;
-    std::vector<int64_t> primary_keys_;
+    std::vector<PkType> primary_keys_;
     std::vector<std::vector<char>> row_data_;
 };
 
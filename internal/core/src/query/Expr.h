@@ -70,6 +70,9 @@ struct LogicalBinaryExpr : BinaryExprBase {
 struct TermExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the path of keys to the value and the type of the value, if the field is json
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
 
  protected:
     // prevent accidential instantiation
@@ -100,6 +103,9 @@ static const std::map<std::string, OpType> mapping_ = {
 struct UnaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the path of keys to the value and the type of the value, if the field is json
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
     OpType op_type_;
 
  protected:
@@ -114,6 +120,9 @@ struct UnaryRangeExpr : Expr {
 struct BinaryRangeExpr : Expr {
     FieldOffset field_offset_;
     DataType data_type_ = DataType::NONE;
+    // the path of keys to the value and the type of the value, if the field is json
+    std::vector<std::string> nested_path_;
+    DataType value_type_ = DataType::NONE;
     bool lower_inclusive_;
     bool upper_inclusive_;
 
@@ -138,4 +147,29 @@ struct CompareExpr : Expr {
     accept(ExprVisitor&) override;
 };
 
+struct NullExpr : Expr {
+    enum class OpType { Invalid = 0, IsNull = 1, IsNotNull = 2 };
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    OpType op_type_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
+struct MatchExpr : Expr {
+    enum class OpType { Invalid = 0, PrefixMatch = 1, PostfixMatch = 2, InfixMatch = 3 };
+    FieldOffset field_offset_;
+    DataType data_type_ = DataType::NONE;
+    // the path of keys to the string value, if the field is json
+    std::vector<std::string> nested_path_;
+    OpType op_type_;
+    std::string pattern_;
+
+ public:
+    void
+    accept(ExprVisitor&) override;
+};
+
 }  // namespace milvus::query
@@ -46,6 +46,8 @@ struct VectorPlanNode : PlanNode {
     std::optional<ExprPtr> predicate_;
     SearchInfo search_info_;
     std::string placeholder_tag_;
+    // entities inserted before expire_timestamp_ are expired by collection ttl, 0 means never expire
+    Timestamp expire_timestamp_ = 0;
 };
 
 struct FloatVectorANNS : VectorPlanNode {
@@ -66,6 +68,8 @@ struct RetrievePlanNode : PlanNode {
     accept(PlanNodeVisitor&) override;
 
     ExprPtr predicate_;
+    // entities inserted before expire_timestamp_ are expired by collection ttl, 0 means never expire
+    Timestamp expire_timestamp_ = 0;
 };
 
 }  // namespace milvus::query
@@ -22,7 +22,7 @@ namespace planpb = milvus::proto::plan;
 template <typename T>
 std::unique_ptr<TermExprImpl<T>>
 ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::TermExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<TermExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -36,8 +36,15 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             result->terms_.emplace_back(static_cast<T>(value_proto.int64_val()));
         } else if constexpr (std::is_floating_point_v<T>) {
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                result->terms_.emplace_back(static_cast<T>(value_proto.int64_val()));
+                continue;
+            }
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             result->terms_.emplace_back(static_cast<T>(value_proto.float_val()));
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            result->terms_.emplace_back(value_proto.string_val());
         } else {
             static_assert(always_false<T>);
         }
@@ -48,7 +55,7 @@ ExtractTermExprImpl(FieldOffset field_offset, DataType data_type, const planpb::
 template <typename T>
 std::unique_ptr<UnaryRangeExprImpl<T>>
 ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::UnaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<UnaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -62,8 +69,15 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             v = static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                v = static_cast<T>(value_proto.int64_val());
+                return;
+            }
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -75,7 +89,7 @@ ExtractUnaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const pl
 template <typename T>
 std::unique_ptr<BinaryRangeExprImpl<T>>
 ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const planpb::BinaryRangeExpr& expr_proto) {
-    static_assert(std::is_fundamental_v<T>);
+    static_assert(std::is_fundamental_v<T> || std::is_same_v<T, std::string>);
     auto result = std::make_unique<BinaryRangeExprImpl<T>>();
     result->field_offset_ = field_offset;
     result->data_type_ = data_type;
@@ -88,8 +102,15 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
             Assert(value_proto.val_case() == planpb::GenericValue::kInt64Val);
             v = static_cast<T>(value_proto.int64_val());
         } else if constexpr (std::is_floating_point_v<T>) {
+            if (value_proto.val_case() == planpb::GenericValue::kInt64Val) {
+                v = static_cast<T>(value_proto.int64_val());
+                return;
+            }
             Assert(value_proto.val_case() == planpb::GenericValue::kFloatVal);
             v = static_cast<T>(value_proto.float_val());
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            Assert(value_proto.val_case() == planpb::GenericValue::kStringVal);
+            v = value_proto.string_val();
         } else {
             static_assert(always_false<T>);
         }
@@ -101,6 +122,30 @@ ExtractBinaryRangeExprImpl(FieldOffset field_offset, DataType data_type, const p
     return result;
 }
 
+// the values in a json field are compared as bool, double or string, according to the literal in the expr
+static DataType
+GetJsonValueType(const planpb::GenericValue& value_proto) {
+    switch (value_proto.val_case()) {
+        case planpb::GenericValue::kBoolVal:
+            return DataType::BOOL;
+        case planpb::GenericValue::kInt64Val:
+        case planpb::GenericValue::kFloatVal:
+            return DataType::DOUBLE;
+        case planpb::GenericValue::kStringVal:
+            return DataType::VARCHAR;
+        default:
+            PanicInfo("unsupported value of json expr");
+    }
+}
+
+template <typename ExprImplPtr>
+ExprPtr
+SetJsonPath(ExprImplPtr expr, const planpb::ColumnInfo& column_info, DataType value_type) {
+    expr->nested_path_.assign(column_info.nested_path().begin(), column_info.nested_path().end());
+    expr->value_type_ = value_type;
+    return expr;
+}
+
 std::unique_ptr<VectorPlanNode>
 ProtoParser::PlanNodeFromProto(const planpb::PlanNode& plan_node_proto) {
     // TODO: add more buffs
@@ -221,6 +266,23 @@ ProtoParser::ParseUnaryRangeExpr(const proto::plan::UnaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::VARCHAR: {
+                return ExtractUnaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                auto value_type = GetJsonValueType(expr_pb.value());
+                switch (value_type) {
+                    case DataType::BOOL:
+                        return SetJsonPath(ExtractUnaryRangeExprImpl<bool>(field_offset, data_type, expr_pb),
+                                           column_info, value_type);
+                    case DataType::DOUBLE:
+                        return SetJsonPath(ExtractUnaryRangeExprImpl<double>(field_offset, data_type, expr_pb),
+                                           column_info, value_type);
+                    default:
+                        return SetJsonPath(ExtractUnaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb),
+                                           column_info, value_type);
+                }
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -260,6 +322,25 @@ ProtoParser::ParseBinaryRangeExpr(const proto::plan::BinaryRangeExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractBinaryRangeExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::VARCHAR: {
+                return ExtractBinaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                auto value_type = GetJsonValueType(expr_pb.lower_value());
+                AssertInfo(value_type == GetJsonValueType(expr_pb.upper_value()),
+                           "bounds of json range expr are of different types");
+                switch (value_type) {
+                    case DataType::BOOL:
+                        return SetJsonPath(ExtractBinaryRangeExprImpl<bool>(field_offset, data_type, expr_pb),
+                                           columnInfo, value_type);
+                    case DataType::DOUBLE:
+                        return SetJsonPath(ExtractBinaryRangeExprImpl<double>(field_offset, data_type, expr_pb),
+                                           columnInfo, value_type);
+                    default:
+                        return SetJsonPath(ExtractBinaryRangeExprImpl<std::string>(field_offset, data_type, expr_pb),
+                                           columnInfo, value_type);
+                }
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -325,6 +406,24 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
             case DataType::DOUBLE: {
                 return ExtractTermExprImpl<double>(field_offset, data_type, expr_pb);
             }
+            case DataType::VARCHAR: {
+                return ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb);
+            }
+            case DataType::JSON: {
+                // an empty term list matches nothing, whatever the type of the values is
+                auto value_type = expr_pb.values_size() > 0 ? GetJsonValueType(expr_pb.values(0)) : DataType::DOUBLE;
+                switch (value_type) {
+                    case DataType::BOOL:
+                        return SetJsonPath(ExtractTermExprImpl<bool>(field_offset, data_type, expr_pb), columnInfo,
+                                           value_type);
+                    case DataType::DOUBLE:
+                        return SetJsonPath(ExtractTermExprImpl<double>(field_offset, data_type, expr_pb), columnInfo,
+                                           value_type);
+                    default:
+                        return SetJsonPath(ExtractTermExprImpl<std::string>(field_offset, data_type, expr_pb),
+                                           columnInfo, value_type);
+                }
+            }
             default: {
                 PanicInfo("unsupported data type");
             }
@@ -333,6 +432,45 @@ ProtoParser::ParseTermExpr(const proto::plan::TermExpr& expr_pb) {
     return result;
 }
 
+ExprPtr
+ProtoParser::ParseNullExpr(const proto::plan::NullExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+
+    auto op = static_cast<NullExpr::OpType>(expr_pb.op());
+    Assert(op == NullExpr::OpType::IsNull || op == NullExpr::OpType::IsNotNull);
+    auto result = std::make_unique<NullExpr>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->op_type_ = op;
+    return result;
+}
+
+ExprPtr
+ProtoParser::ParseMatchExpr(const proto::plan::MatchExpr& expr_pb) {
+    auto& column_info = expr_pb.column_info();
+    auto field_id = FieldId(column_info.field_id());
+    auto field_offset = schema.get_offset(field_id);
+    auto data_type = schema[field_offset].get_data_type();
+    Assert(data_type == static_cast<DataType>(column_info.data_type()));
+    AssertInfo(data_type == DataType::VARCHAR || data_type == DataType::JSON,
+               "match expr is only supported on varchar or json field");
+
+    auto op = static_cast<MatchExpr::OpType>(expr_pb.op());
+    Assert(op == MatchExpr::OpType::PrefixMatch || op == MatchExpr::OpType::PostfixMatch ||
+           op == MatchExpr::OpType::InfixMatch);
+    auto result = std::make_unique<MatchExpr>();
+    result->field_offset_ = field_offset;
+    result->data_type_ = data_type;
+    result->nested_path_.assign(column_info.nested_path().begin(), column_info.nested_path().end());
+    result->op_type_ = op;
+    result->pattern_ = expr_pb.pattern();
+    return result;
+}
+
 ExprPtr
 ProtoParser::ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb) {
     auto op = static_cast<LogicalUnaryExpr::OpType>(expr_pb.op());
@@ -378,6 +516,12 @@ ProtoParser::ParseExpr(const proto::plan::Expr& expr_pb) {
         case ppe::kCompareExpr: {
             return ParseCompareExpr(expr_pb.compare_expr());
         }
+        case ppe::kNullExpr: {
+            return ParseNullExpr(expr_pb.null_expr());
+        }
+        case ppe::kMatchExpr: {
+            return ParseMatchExpr(expr_pb.match_expr());
+        }
         default:
             PanicInfo("unsupported expr proto node");
     }
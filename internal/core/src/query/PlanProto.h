@@ -41,6 +41,12 @@ class ProtoParser {
     ExprPtr
     ParseTermExpr(const proto::plan::TermExpr& expr_pb);
 
+    ExprPtr
+    ParseNullExpr(const proto::plan::NullExpr& expr_pb);
+
+    ExprPtr
+    ParseMatchExpr(const proto::plan::MatchExpr& expr_pb);
+
     ExprPtr
     ParseUnaryExpr(const proto::plan::UnaryExpr& expr_pb);
 
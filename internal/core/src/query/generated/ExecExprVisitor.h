@@ -42,6 +42,12 @@ class ExecExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(MatchExpr& expr) override;
+
  public:
     using RetType = boost::dynamic_bitset<>;
     ExecExprVisitor(const segcore::SegmentInternalInterface& segment, int64_t row_count, Timestamp timestamp)
@@ -60,7 +66,19 @@ class ExecExprVisitor : public ExprVisitor {
  public:
     template <typename T, typename IndexFunc, typename ElementFunc>
     auto
-    ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
+    ExecRangeVisitorImpl(FieldOffset field_offset,
+                         const std::vector<std::string>& nested_path,
+                         IndexFunc func,
+                         ElementFunc element_func) -> RetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecVarCharVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    template <typename T, typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldOffset field_offset, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> RetType;
 
     template <typename T>
     auto
@@ -78,6 +96,9 @@ class ExecExprVisitor : public ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    void
+    MaskNullRows(FieldOffset field_offset, RetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -45,4 +45,14 @@ CompareExpr::accept(ExprVisitor& visitor) {
     visitor.visit(*this);
 }
 
+void
+NullExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
+void
+MatchExpr::accept(ExprVisitor& visitor) {
+    visitor.visit(*this);
+}
+
 }  // namespace milvus::query
@@ -36,5 +36,11 @@ class ExprVisitor {
 
     virtual void
     visit(CompareExpr&) = 0;
+
+    virtual void
+    visit(NullExpr&) = 0;
+
+    virtual void
+    visit(MatchExpr&) = 0;
 };
 }  // namespace milvus::query
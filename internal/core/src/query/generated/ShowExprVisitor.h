@@ -37,6 +37,12 @@ class ShowExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(MatchExpr& expr) override;
+
  public:
     using RetType = Json;
 
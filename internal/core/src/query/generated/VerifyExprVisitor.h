@@ -42,6 +42,12 @@ class VerifyExprVisitor : public ExprVisitor {
     void
     visit(CompareExpr& expr) override;
 
+    void
+    visit(NullExpr& expr) override;
+
+    void
+    visit(MatchExpr& expr) override;
+
  public:
 };
 }  // namespace milvus::query
@@ -18,6 +18,8 @@
 #include "query/ExprImpl.h"
 #include "query/generated/ExecExprVisitor.h"
 #include "segcore/SegmentGrowingImpl.h"
+#include "segcore/Utils.h"
+#include "utils/Json.h"
 
 namespace milvus::query {
 // THIS CONTAINS EXTRA BODY FOR VISITOR
@@ -42,7 +44,19 @@ class ExecExprVisitor : ExprVisitor {
  public:
     template <typename T, typename IndexFunc, typename ElementFunc>
     auto
-    ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc func, ElementFunc element_func) -> RetType;
+    ExecRangeVisitorImpl(FieldOffset field_offset,
+                         const std::vector<std::string>& nested_path,
+                         IndexFunc func,
+                         ElementFunc element_func) -> RetType;
+
+    template <typename ElementFunc>
+    auto
+    ExecVarCharVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType;
+
+    template <typename T, typename ElementFunc>
+    auto
+    ExecJsonVisitorImpl(FieldOffset field_offset, const std::vector<std::string>& nested_path, ElementFunc element_func)
+        -> RetType;
 
     template <typename T>
     auto
@@ -60,6 +74,9 @@ class ExecExprVisitor : ExprVisitor {
     auto
     ExecCompareExprDispatcher(CompareExpr& expr, CmpFunc cmp_func) -> RetType;
 
+    void
+    MaskNullRows(FieldOffset field_offset, RetType& res);
+
  private:
     const segcore::SegmentInternalInterface& segment_;
     int64_t row_count_;
@@ -68,6 +85,15 @@ class ExecExprVisitor : ExprVisitor {
 };
 }  // namespace impl
 
+// the comparisons are false on the null rows of a nullable field
+void
+ExecExprVisitor::MaskNullRows(FieldOffset field_offset, RetType& res) {
+    if (!segment_.get_schema()[field_offset].is_nullable()) {
+        return;
+    }
+    res &= segment_.get_valid_data(field_offset, row_count_);
+}
+
 void
 ExecExprVisitor::visit(LogicalUnaryExpr& expr) {
     using OpType = LogicalUnaryExpr::OpType;
@@ -140,33 +166,67 @@ Assemble(const std::deque<boost::dynamic_bitset<>>& srcs) -> boost::dynamic_bits
 
 template <typename T, typename IndexFunc, typename ElementFunc>
 auto
-ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc index_func, ElementFunc element_func)
-    -> RetType {
-    auto& schema = segment_.get_schema();
-    auto& field_meta = schema[field_offset];
-    auto indexing_barrier = segment_.num_chunk_index(field_offset);
+ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset,
+                                      const std::vector<std::string>& nested_path,
+                                      IndexFunc index_func,
+                                      ElementFunc element_func) -> RetType {
+    // the values of a json field are looked up in the documents row by row
+    if (segment_.get_schema()[field_offset].get_data_type() == DataType::JSON) {
+        return ExecJsonVisitorImpl<T>(field_offset, nested_path, element_func);
+    }
+    // no scalar index is built on varchar fields, the rows are compared one by one
+    if constexpr (std::is_same_v<T, std::string>) {
+        return ExecVarCharVisitorImpl(field_offset, element_func);
+    } else {
+        auto& schema = segment_.get_schema();
+        auto& field_meta = schema[field_offset];
+        auto indexing_barrier = segment_.num_chunk_index(field_offset);
+        auto size_per_chunk = segment_.size_per_chunk();
+        auto num_chunk = upper_div(row_count_, size_per_chunk);
+        std::deque<boost::dynamic_bitset<>> results;
+
+        using Index = knowhere::scalar::StructuredIndex<T>;
+        for (auto chunk_id = 0; chunk_id < indexing_barrier; ++chunk_id) {
+            const Index& indexing = segment_.chunk_scalar_index<T>(field_offset, chunk_id);
+            // NOTE: knowhere is not const-ready
+            // This is a dirty workaround
+            auto data = index_func(const_cast<Index*>(&indexing));
+            AssertInfo(data->size() == size_per_chunk, "[ExecExprVisitor]Data size not equal to size_per_chunk");
+            results.emplace_back(std::move(*data));
+        }
+        for (auto chunk_id = indexing_barrier; chunk_id < num_chunk; ++chunk_id) {
+            auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+            boost::dynamic_bitset<> result(this_size);
+            auto chunk = segment_.chunk_data<T>(field_offset, chunk_id);
+            const T* data = chunk.data();
+            for (int index = 0; index < this_size; ++index) {
+                result[index] = element_func(data[index]);
+            }
+            AssertInfo(result.size() == this_size, "");
+            results.emplace_back(std::move(result));
+        }
+        auto final_result = Assemble(results);
+        AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Final result size not equal to row count");
+        return final_result;
+    }
+}
+
+template <typename ElementFunc>
+auto
+ExecExprVisitor::ExecVarCharVisitorImpl(FieldOffset field_offset, ElementFunc element_func) -> RetType {
+    auto& field_meta = segment_.get_schema()[field_offset];
+    AssertInfo(field_meta.is_string(), "[ExecExprVisitor]Field of varchar expr isn't string type");
     auto size_per_chunk = segment_.size_per_chunk();
     auto num_chunk = upper_div(row_count_, size_per_chunk);
     std::deque<boost::dynamic_bitset<>> results;
 
-    using Index = knowhere::scalar::StructuredIndex<T>;
-    for (auto chunk_id = 0; chunk_id < indexing_barrier; ++chunk_id) {
-        const Index& indexing = segment_.chunk_scalar_index<T>(field_offset, chunk_id);
-        // NOTE: knowhere is not const-ready
-        // This is a dirty workaround
-        auto data = index_func(const_cast<Index*>(&indexing));
-        AssertInfo(data->size() == size_per_chunk, "[ExecExprVisitor]Data size not equal to size_per_chunk");
-        results.emplace_back(std::move(*data));
-    }
-    for (auto chunk_id = indexing_barrier; chunk_id < num_chunk; ++chunk_id) {
+    for (auto chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
         auto this_size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
         boost::dynamic_bitset<> result(this_size);
-        auto chunk = segment_.chunk_data<T>(field_offset, chunk_id);
-        const T* data = chunk.data();
+        auto chunk = segment_.chunk_string_data(field_offset, chunk_id);
         for (int index = 0; index < this_size; ++index) {
-            result[index] = element_func(data[index]);
+            result[index] = element_func(std::string(chunk[index]));
         }
-        AssertInfo(result.size() == this_size, "");
         results.emplace_back(std::move(result));
     }
     auto final_result = Assemble(results);
@@ -174,6 +234,49 @@ ExecExprVisitor::ExecRangeVisitorImpl(FieldOffset field_offset, IndexFunc index_
     return final_result;
 }
 
+template <typename T, typename ElementFunc>
+auto
+ExecExprVisitor::ExecJsonVisitorImpl(FieldOffset field_offset,
+                                     const std::vector<std::string>& nested_path,
+                                     ElementFunc element_func) -> RetType {
+    AssertInfo(segment_.get_schema()[field_offset].get_data_type() == DataType::JSON,
+               "[ExecExprVisitor]Field of json expr isn't json type");
+    // the json documents are stored like varchar values, a row doesn't match
+    // if the document lacks the path or the value is of another type
+    auto json_func = [&nested_path, &element_func](const std::string& doc) -> bool {
+        auto root = nlohmann::json::parse(doc, nullptr, false);
+        if (root.is_discarded()) {
+            return false;
+        }
+        const nlohmann::json* node = &root;
+        for (auto& key : nested_path) {
+            if (!node->is_object()) {
+                return false;
+            }
+            auto iter = node->find(key);
+            if (iter == node->end()) {
+                return false;
+            }
+            node = &*iter;
+        }
+        if constexpr (std::is_same_v<T, bool>) {
+            if (!node->is_boolean()) {
+                return false;
+            }
+        } else if constexpr (std::is_same_v<T, std::string>) {
+            if (!node->is_string()) {
+                return false;
+            }
+        } else {
+            if (!node->is_number()) {
+                return false;
+            }
+        }
+        return element_func(node->get<T>());
+    };
+    return ExecVarCharVisitorImpl(field_offset, json_func);
+}
+
 #pragma clang diagnostic push
 #pragma ide diagnostic ignored "Simplify"
 template <typename T>
@@ -188,32 +291,32 @@ ExecExprVisitor::ExecUnaryRangeVisitorDispatcher(UnaryRangeExpr& expr_raw) -> Re
         case OpType::Equal: {
             auto index_func = [val](Index* index) { return index->In(1, &val); };
             auto elem_func = [val](T x) { return (x == val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         case OpType::NotEqual: {
             auto index_func = [val](Index* index) { return index->NotIn(1, &val); };
             auto elem_func = [val](T x) { return (x != val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         case OpType::GreaterEqual: {
             auto index_func = [val](Index* index) { return index->Range(val, Operator::GE); };
             auto elem_func = [val](T x) { return (x >= val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         case OpType::GreaterThan: {
             auto index_func = [val](Index* index) { return index->Range(val, Operator::GT); };
             auto elem_func = [val](T x) { return (x > val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         case OpType::LessEqual: {
             auto index_func = [val](Index* index) { return index->Range(val, Operator::LE); };
             auto elem_func = [val](T x) { return (x <= val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         case OpType::LessThan: {
             auto index_func = [val](Index* index) { return index->Range(val, Operator::LT); };
             auto elem_func = [val](T x) { return (x < val); };
-            return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+            return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
         }
         default: {
             PanicInfo("unsupported range node");
@@ -242,16 +345,16 @@ ExecExprVisitor::ExecBinaryRangeVisitorDispatcher(BinaryRangeExpr& expr_raw) ->
     auto index_func = [=](Index* index) { return index->Range(val1, lower_inclusive, val2, upper_inclusive); };
     if (lower_inclusive && upper_inclusive) {
         auto elem_func = [val1, val2](T x) { return (val1 <= x && x <= val2); };
-        return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+        return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
     } else if (lower_inclusive && !upper_inclusive) {
         auto elem_func = [val1, val2](T x) { return (val1 <= x && x < val2); };
-        return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+        return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
     } else if (!lower_inclusive && upper_inclusive) {
         auto elem_func = [val1, val2](T x) { return (val1 < x && x <= val2); };
-        return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+        return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
     } else {
         auto elem_func = [val1, val2](T x) { return (val1 < x && x < val2); };
-        return ExecRangeVisitorImpl<T>(expr.field_offset_, index_func, elem_func);
+        return ExecRangeVisitorImpl<T>(expr.field_offset_, expr.nested_path_, index_func, elem_func);
     }
 }
 #pragma clang diagnostic pop
@@ -261,8 +364,10 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
     AssertInfo(expr.data_type_ == field_meta.get_data_type(),
                "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    // the expr on a json field is dispatched by the type of the value
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
     RetType res;
-    switch (expr.data_type_) {
+    switch (data_type) {
         case DataType::BOOL: {
             res = ExecUnaryRangeVisitorDispatcher<bool>(expr);
             break;
@@ -291,10 +396,15 @@ ExecExprVisitor::visit(UnaryRangeExpr& expr) {
             res = ExecUnaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::VARCHAR: {
+            res = ExecUnaryRangeVisitorDispatcher<std::string>(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_offset_, res);
     ret_ = std::move(res);
 }
 
@@ -303,8 +413,10 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
     AssertInfo(expr.data_type_ == field_meta.get_data_type(),
                "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    // the expr on a json field is dispatched by the type of the value
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
     RetType res;
-    switch (expr.data_type_) {
+    switch (data_type) {
         case DataType::BOOL: {
             res = ExecBinaryRangeVisitorDispatcher<bool>(expr);
             break;
@@ -333,18 +445,23 @@ ExecExprVisitor::visit(BinaryRangeExpr& expr) {
             res = ExecBinaryRangeVisitorDispatcher<double>(expr);
             break;
         }
+        case DataType::VARCHAR: {
+            res = ExecBinaryRangeVisitorDispatcher<std::string>(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_offset_, res);
     ret_ = std::move(res);
 }
 
 template <typename Op>
 struct relational {
     template <typename T, typename U>
-    bool
-    operator()(T const& a, U const& b) const {
+    auto
+    operator()(T const& a, U const& b) const -> decltype(Op{}(a, b)) {
         return Op{}(a, b);
     }
     template <typename... T>
@@ -357,7 +474,8 @@ struct relational {
 template <typename Op>
 auto
 ExecExprVisitor::ExecCompareExprDispatcher(CompareExpr& expr, Op op) -> RetType {
-    using number = boost::variant<bool, int8_t, int16_t, int32_t, int64_t, float, double>;
+    using number = boost::variant<bool, int8_t, int16_t, int32_t, int64_t, float, double, std::string>;
+    auto& schema = segment_.get_schema();
     auto size_per_chunk = segment_.size_per_chunk();
     auto num_chunk = upper_div(row_count_, size_per_chunk);
     std::deque<RetType> bitsets;
@@ -393,6 +511,11 @@ ExecExprVisitor::ExecCompareExprDispatcher(CompareExpr& expr, Op op) -> RetType
                     auto chunk_data = segment_.chunk_data<double>(offset, chunk_id).data();
                     return [chunk_data](int i) -> const number { return chunk_data[i]; };
                 }
+                case DataType::VARCHAR: {
+                    auto chunk_data = std::make_shared<std::vector<std::string_view>>(
+                        segment_.chunk_string_data(offset, chunk_id));
+                    return [chunk_data](int i) -> const number { return std::string((*chunk_data)[i]); };
+                }
                 default:
                     PanicInfo("unsupported datatype");
             }
@@ -453,6 +576,8 @@ ExecExprVisitor::visit(CompareExpr& expr) {
         }
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.left_field_offset_, res);
+    MaskNullRows(expr.right_field_offset_, res);
     ret_ = std::move(res);
 }
 
@@ -468,20 +593,31 @@ ExecExprVisitor::ExecTermVisitorImpl(TermExpr& expr_raw) -> RetType {
     auto num_chunk = upper_div(row_count_, size_per_chunk);
     std::deque<RetType> bitsets;
     std::sort(expr.terms_.begin(), expr.terms_.end());
-    for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
-        Span<T> chunk = segment_.chunk_data<T>(field_offset, chunk_id);
-        auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
-        boost::dynamic_bitset<> bitset(size);
-        for (int i = 0; i < size; ++i) {
-            auto value = chunk.data()[i];
-            bool is_in = std::binary_search(expr.terms_.begin(), expr.terms_.end(), value);
-            bitset[i] = is_in;
+    if (field_meta.get_data_type() == DataType::JSON) {
+        auto elem_func = [&expr](const T& x) { return std::binary_search(expr.terms_.begin(), expr.terms_.end(), x); };
+        return ExecJsonVisitorImpl<T>(field_offset, expr.nested_path_, elem_func);
+    }
+    if constexpr (std::is_same_v<T, std::string>) {
+        auto elem_func = [&expr](const std::string& x) {
+            return std::binary_search(expr.terms_.begin(), expr.terms_.end(), x);
+        };
+        return ExecVarCharVisitorImpl(field_offset, elem_func);
+    } else {
+        for (int64_t chunk_id = 0; chunk_id < num_chunk; ++chunk_id) {
+            Span<T> chunk = segment_.chunk_data<T>(field_offset, chunk_id);
+            auto size = chunk_id == num_chunk - 1 ? row_count_ - chunk_id * size_per_chunk : size_per_chunk;
+            boost::dynamic_bitset<> bitset(size);
+            for (int i = 0; i < size; ++i) {
+                auto value = chunk.data()[i];
+                bool is_in = std::binary_search(expr.terms_.begin(), expr.terms_.end(), value);
+                bitset[i] = is_in;
+            }
+            bitsets.emplace_back(std::move(bitset));
         }
-        bitsets.emplace_back(std::move(bitset));
+        auto final_result = Assemble(bitsets);
+        AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+        return final_result;
     }
-    auto final_result = Assemble(bitsets);
-    AssertInfo(final_result.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
-    return final_result;
 }
 
 void
@@ -489,8 +625,10 @@ ExecExprVisitor::visit(TermExpr& expr) {
     auto& field_meta = segment_.get_schema()[expr.field_offset_];
     AssertInfo(expr.data_type_ == field_meta.get_data_type(),
                "[ExecExprVisitor]DataType of expr isn't field_meta data type ");
+    // the expr on a json field is dispatched by the type of the value
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
     RetType res;
-    switch (expr.data_type_) {
+    switch (data_type) {
         case DataType::BOOL: {
             res = ExecTermVisitorImpl<bool>(expr);
             break;
@@ -519,10 +657,77 @@ ExecExprVisitor::visit(TermExpr& expr) {
             res = ExecTermVisitorImpl<double>(expr);
             break;
         }
+        case DataType::VARCHAR: {
+            res = ExecTermVisitorImpl<std::string>(expr);
+            break;
+        }
         default:
             PanicInfo("unsupported");
     }
     AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_offset_, res);
+    ret_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(NullExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    RetType res = segment_.get_valid_data(expr.field_offset_, row_count_);
+    switch (expr.op_type_) {
+        case NullExpr::OpType::IsNull: {
+            res.flip();
+            break;
+        }
+        case NullExpr::OpType::IsNotNull: {
+            break;
+        }
+        default: {
+            PanicInfo("unsupported null optype");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    ret_ = std::move(res);
+}
+
+void
+ExecExprVisitor::visit(MatchExpr& expr) {
+    auto& field_meta = segment_.get_schema()[expr.field_offset_];
+    AssertInfo(expr.data_type_ == field_meta.get_data_type(),
+               "[ExecExprVisitor]DataType of expr isn't field_meta data type");
+    auto& pattern = expr.pattern_;
+    auto exec_match = [&](auto elem_func) -> RetType {
+        if (expr.data_type_ == DataType::JSON) {
+            return ExecJsonVisitorImpl<std::string>(expr.field_offset_, expr.nested_path_, elem_func);
+        }
+        return ExecVarCharVisitorImpl(expr.field_offset_, elem_func);
+    };
+    RetType res;
+    switch (expr.op_type_) {
+        case MatchExpr::OpType::PrefixMatch: {
+            auto elem_func = [&pattern](const std::string& x) { return x.compare(0, pattern.size(), pattern) == 0; };
+            res = exec_match(elem_func);
+            break;
+        }
+        case MatchExpr::OpType::PostfixMatch: {
+            auto elem_func = [&pattern](const std::string& x) {
+                return x.size() >= pattern.size() && x.compare(x.size() - pattern.size(), pattern.size(), pattern) == 0;
+            };
+            res = exec_match(elem_func);
+            break;
+        }
+        case MatchExpr::OpType::InfixMatch: {
+            auto elem_func = [&pattern](const std::string& x) { return x.find(pattern) != std::string::npos; };
+            res = exec_match(elem_func);
+            break;
+        }
+        default: {
+            PanicInfo("unsupported match optype");
+        }
+    }
+    AssertInfo(res.size() == row_count_, "[ExecExprVisitor]Size of results not equal row count");
+    MaskNullRows(expr.field_offset_, res);
     ret_ = std::move(res);
 }
 }  // namespace milvus::query
@@ -103,6 +103,9 @@ ExecPlanNodeVisitor::VectorVisitorImpl(VectorPlanNode& node) {
         bitset_holder.resize(active_count, true);
     }
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (node.expire_timestamp_ > 0) {
+        segment->mask_with_expired(bitset_holder, node.expire_timestamp_);
+    }
 
     if (!bitset_holder.empty()) {
         bitset_holder.flip();
@@ -138,6 +141,12 @@ ExecPlanNodeVisitor::visit(RetrievePlanNode& node) {
     }
 
     segment->mask_with_timestamps(bitset_holder, timestamp_);
+    if (node.expire_timestamp_ > 0) {
+        if (bitset_holder.empty()) {
+            bitset_holder.resize(active_count, true);
+        }
+        segment->mask_with_expired(bitset_holder, node.expire_timestamp_);
+    }
 
     BitsetView view;
     if (!bitset_holder.empty()) {
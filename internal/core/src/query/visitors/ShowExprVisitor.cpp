@@ -110,8 +110,9 @@ void
 ShowExprVisitor::visit(TermExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
     auto terms = [&] {
-        switch (expr.data_type_) {
+        switch (data_type) {
             case DataType::BOOL:
                 return TermExtract<bool>(expr);
             case DataType::INT8:
@@ -126,6 +127,8 @@ ShowExprVisitor::visit(TermExpr& expr) {
                 return TermExtract<double>(expr);
             case DataType::FLOAT:
                 return TermExtract<float>(expr);
+            case DataType::VARCHAR:
+                return TermExtract<std::string>(expr);
             default:
                 PanicInfo("unsupported type");
         }
@@ -135,6 +138,9 @@ ShowExprVisitor::visit(TermExpr& expr) {
              {"field_offset", expr.field_offset_.get()},
              {"data_type", datatype_name(expr.data_type_)},
              {"terms", std::move(terms)}};
+    if (expr.data_type_ == DataType::JSON) {
+        res["nested_path"] = expr.nested_path_;
+    }
 
     ret_ = res;
 }
@@ -151,6 +157,9 @@ UnaryRangeExtract(const UnaryRangeExpr& expr_raw) {
              {"data_type", datatype_name(expr->data_type_)},
              {"op", OpType_Name(static_cast<OpType>(expr->op_type_))},
              {"value", expr->value_}};
+    if (expr->data_type_ == DataType::JSON) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -158,7 +167,8 @@ void
 ShowExprVisitor::visit(UnaryRangeExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
-    switch (expr.data_type_) {
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
+    switch (data_type) {
         case DataType::BOOL:
             ret_ = UnaryRangeExtract<bool>(expr);
             return;
@@ -180,6 +190,9 @@ ShowExprVisitor::visit(UnaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = UnaryRangeExtract<float>(expr);
             return;
+        case DataType::VARCHAR:
+            ret_ = UnaryRangeExtract<std::string>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -199,6 +212,9 @@ BinaryRangeExtract(const BinaryRangeExpr& expr_raw) {
              {"upper_inclusive", expr->upper_inclusive_},
              {"lower_value", expr->lower_value_},
              {"upper_value", expr->upper_value_}};
+    if (expr->data_type_ == DataType::JSON) {
+        res["nested_path"] = expr->nested_path_;
+    }
     return res;
 }
 
@@ -206,7 +222,8 @@ void
 ShowExprVisitor::visit(BinaryRangeExpr& expr) {
     AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
     AssertInfo(datatype_is_vector(expr.data_type_) == false, "[ShowExprVisitor]Data type of expr isn't vector type");
-    switch (expr.data_type_) {
+    auto data_type = expr.data_type_ == DataType::JSON ? expr.value_type_ : expr.data_type_;
+    switch (data_type) {
         case DataType::BOOL:
             ret_ = BinaryRangeExtract<bool>(expr);
             return;
@@ -228,6 +245,9 @@ ShowExprVisitor::visit(BinaryRangeExpr& expr) {
         case DataType::FLOAT:
             ret_ = BinaryRangeExtract<float>(expr);
             return;
+        case DataType::VARCHAR:
+            ret_ = BinaryRangeExtract<std::string>(expr);
+            return;
         default:
             PanicInfo("unsupported type");
     }
@@ -248,4 +268,34 @@ ShowExprVisitor::visit(CompareExpr& expr) {
     ret_ = res;
 }
 
+void
+ShowExprVisitor::visit(NullExpr& expr) {
+    using proto::plan::NullExpr_NullOp;
+    using proto::plan::NullExpr_NullOp_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Null"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", NullExpr_NullOp_Name(static_cast<NullExpr_NullOp>(expr.op_type_))}};
+    ret_ = res;
+}
+
+void
+ShowExprVisitor::visit(MatchExpr& expr) {
+    using proto::plan::MatchExpr_MatchOp;
+    using proto::plan::MatchExpr_MatchOp_Name;
+    AssertInfo(!ret_.has_value(), "[ShowExprVisitor]Ret json already has value before visit");
+
+    Json res{{"expr_type", "Match"},
+             {"field_offset", expr.field_offset_.get()},
+             {"data_type", datatype_name(expr.data_type_)},
+             {"op", MatchExpr_MatchOp_Name(static_cast<MatchExpr_MatchOp>(expr.op_type_))},
+             {"pattern", expr.pattern_}};
+    if (expr.data_type_ == DataType::JSON) {
+        res["nested_path"] = expr.nested_path_;
+    }
+    ret_ = res;
+}
+
 }  // namespace milvus::query
@@ -42,4 +42,14 @@ VerifyExprVisitor::visit(CompareExpr& expr) {
     // TODO
 }
 
+void
+VerifyExprVisitor::visit(NullExpr& expr) {
+    // TODO
+}
+
+void
+VerifyExprVisitor::visit(MatchExpr& expr) {
+    // TODO
+}
+
 }  // namespace milvus::query
@@ -13,6 +13,7 @@
 
 #include "pb/schema.pb.h"
 #include "segcore/Collection.h"
+#include "segcore/Utils.h"
 
 namespace milvus::segcore {
 
@@ -45,4 +46,13 @@ Collection::parse() {
     schema_ = Schema::ParseFrom(collection_schema);
 }
 
+void
+Collection::update_schema(const std::string& collection_proto) {
+    Collection collection(collection_proto);
+    AssertSchemaAppended(*schema_, *collection.schema_);
+    schema_proto_ = collection_proto;
+    collection_name_ = collection.collection_name_;
+    schema_ = collection.schema_;
+}
+
 }  // namespace milvus::segcore
@@ -25,6 +25,10 @@ class Collection {
     void
     parse();
 
+    // replace the schema with the one altered by AddField, the segments created later take the new schema
+    void
+    update_schema(const std::string& collection_proto);
+
  public:
     SchemaPtr&
     get_schema() {
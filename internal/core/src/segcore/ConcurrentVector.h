@@ -16,6 +16,7 @@
 #include <deque>
 #include <mutex>
 #include <shared_mutex>
+#include <string>
 #include <utility>
 #include <vector>
 
@@ -25,6 +26,7 @@
 #include "common/Types.h"
 #include "common/Span.h"
 #include "exceptions/EasyAssert.h"
+#include "segcore/Utils.h"
 #include "utils/Utils.h"
 
 namespace milvus::segcore {
@@ -140,7 +142,11 @@ class ConcurrentVectorImpl : public VectorBase {
 
     SpanBase
     get_span_base(int64_t chunk_id) const override {
-        return get_span(chunk_id);
+        if constexpr (is_scalar && !std::is_fundamental_v<Type>) {
+            PanicInfo("span of non-fundamental type is unsupported");
+        } else {
+            return get_span(chunk_id);
+        }
     }
 
     void
@@ -234,12 +240,42 @@ class ConcurrentVectorImpl : public VectorBase {
 template <typename Type>
 class ConcurrentVector : public ConcurrentVectorImpl<Type, true> {
  public:
-    static_assert(std::is_fundamental_v<Type>);
+    static_assert(std::is_fundamental_v<Type> || std::is_same_v<Type, PkType>);
     explicit ConcurrentVector(int64_t size_per_chunk)
         : ConcurrentVectorImpl<Type, true>::ConcurrentVectorImpl(1, size_per_chunk) {
     }
 };
 
+// the varchar values or the serialized json documents, set_data_raw takes the values encoded as the row based data
+template <>
+class ConcurrentVector<std::string> : public ConcurrentVectorImpl<std::string, true> {
+ public:
+    explicit ConcurrentVector(int64_t size_per_chunk)
+        : ConcurrentVectorImpl<std::string, true>::ConcurrentVectorImpl(1, size_per_chunk) {
+    }
+
+    void
+    set_data_raw(ssize_t element_offset, const void* source, ssize_t element_count) override {
+        std::vector<std::string> values;
+        values.reserve(element_count);
+        auto data = static_cast<const char*>(source);
+        for (ssize_t i = 0; i < element_count; ++i) {
+            values.emplace_back(ReadVarChar(data));
+        }
+        bytes_ += data - static_cast<const char*>(source);
+        set_data(element_offset, values.data(), element_count);
+    }
+
+    // total size of the values set
+    int64_t
+    get_bytes() const {
+        return bytes_;
+    }
+
+ private:
+    std::atomic<int64_t> bytes_ = 0;
+};
+
 template <>
 class ConcurrentVector<FloatVector> : public ConcurrentVectorImpl<float, false> {
  public:
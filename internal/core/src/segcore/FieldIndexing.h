@@ -109,14 +109,15 @@ CreateIndex(const FieldMeta& field_meta, const SegcoreConfig& segcore_config);
 class IndexingRecord {
  public:
     explicit IndexingRecord(const Schema& schema, const SegcoreConfig& segcore_config)
-        : schema_(schema), segcore_config_(segcore_config) {
-        Initialize();
+        : segcore_config_(segcore_config) {
+        Initialize(schema);
     }
 
+    // the fields appended to the schema later are not indexed, the schema is not kept as it may be replaced
     void
-    Initialize() {
+    Initialize(const Schema& schema) {
         int offset_id = 0;
-        for (const FieldMeta& field : schema_) {
+        for (const FieldMeta& field : schema) {
             auto offset = FieldOffset(offset_id);
             ++offset_id;
 
@@ -130,10 +131,14 @@ class IndexingRecord {
                     continue;
                 }
             }
+            // no index is built on varchar fields
+            if (field.is_string()) {
+                continue;
+            }
 
             field_indexings_.try_emplace(offset, CreateIndex(field, segcore_config_));
         }
-        assert(offset_id == schema_.size());
+        assert(offset_id == schema.size());
     }
 
     // concurrent, reentrant
@@ -175,7 +180,6 @@ class IndexingRecord {
     }
 
  private:
-    const Schema& schema_;
     const SegcoreConfig& segcore_config_;
 
  private:
@@ -16,49 +16,59 @@ namespace milvus::segcore {
 InsertRecord::InsertRecord(const Schema& schema, int64_t size_per_chunk)
     : uids_(size_per_chunk), timestamps_(size_per_chunk) {
     for (auto& field : schema) {
-        if (field.is_vector()) {
-            if (field.get_data_type() == DataType::VECTOR_FLOAT) {
-                this->append_field_data<FloatVector>(field.get_dim(), size_per_chunk);
-                continue;
-            } else if (field.get_data_type() == DataType::VECTOR_BINARY) {
-                this->append_field_data<BinaryVector>(field.get_dim(), size_per_chunk);
-                continue;
-            } else {
-                PanicInfo("unsupported");
-            }
+        append_field(field, size_per_chunk);
+    }
+}
+
+void
+InsertRecord::append_field(const FieldMeta& field, int64_t size_per_chunk) {
+    if (field.is_vector()) {
+        if (field.get_data_type() == DataType::VECTOR_FLOAT) {
+            this->append_field_data<FloatVector>(field.get_dim(), size_per_chunk);
+            return;
+        } else if (field.get_data_type() == DataType::VECTOR_BINARY) {
+            this->append_field_data<BinaryVector>(field.get_dim(), size_per_chunk);
+            return;
+        } else {
+            PanicInfo("unsupported");
+        }
+    }
+    switch (field.get_data_type()) {
+        case DataType::BOOL: {
+            this->append_field_data<bool>(size_per_chunk);
+            break;
+        }
+        case DataType::INT8: {
+            this->append_field_data<int8_t>(size_per_chunk);
+            break;
+        }
+        case DataType::INT16: {
+            this->append_field_data<int16_t>(size_per_chunk);
+            break;
+        }
+        case DataType::INT32: {
+            this->append_field_data<int32_t>(size_per_chunk);
+            break;
+        }
+        case DataType::INT64: {
+            this->append_field_data<int64_t>(size_per_chunk);
+            break;
+        }
+        case DataType::FLOAT: {
+            this->append_field_data<float>(size_per_chunk);
+            break;
+        }
+        case DataType::DOUBLE: {
+            this->append_field_data<double>(size_per_chunk);
+            break;
+        }
+        case DataType::VARCHAR:
+        case DataType::JSON: {
+            this->append_field_data<std::string>(size_per_chunk);
+            break;
         }
-        switch (field.get_data_type()) {
-            case DataType::BOOL: {
-                this->append_field_data<bool>(size_per_chunk);
-                break;
-            }
-            case DataType::INT8: {
-                this->append_field_data<int8_t>(size_per_chunk);
-                break;
-            }
-            case DataType::INT16: {
-                this->append_field_data<int16_t>(size_per_chunk);
-                break;
-            }
-            case DataType::INT32: {
-                this->append_field_data<int32_t>(size_per_chunk);
-                break;
-            }
-            case DataType::INT64: {
-                this->append_field_data<int64_t>(size_per_chunk);
-                break;
-            }
-            case DataType::FLOAT: {
-                this->append_field_data<float>(size_per_chunk);
-                break;
-            }
-            case DataType::DOUBLE: {
-                this->append_field_data<double>(size_per_chunk);
-                break;
-            }
-            default: {
-                PanicInfo("unsupported");
-            }
+        default: {
+            PanicInfo("unsupported");
         }
     }
 }
@@ -12,6 +12,7 @@
 #pragma once
 
 #include <memory>
+#include <string>
 #include <vector>
 
 #include "common/Schema.h"
@@ -56,11 +57,15 @@ struct InsertRecord {
         return ptr;
     }
 
+    // append a column of the field
+    void
+    append_field(const FieldMeta& field, int64_t size_per_chunk);
+
     // append a column of scalar type
     template <typename Type>
     void
     append_field_data(int64_t size_per_chunk) {
-        static_assert(std::is_fundamental_v<Type>);
+        static_assert(std::is_fundamental_v<Type> || std::is_same_v<Type, std::string>);
         fields_data_.emplace_back(std::make_unique<ConcurrentVector<Type>>(size_per_chunk));
     }
 
@@ -10,6 +10,7 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include <limits>
+#include <utility>
 
 #include "common/Consts.h"
 #include "common/Types.h"
@@ -18,15 +19,16 @@
 using milvus::SearchResult;
 
 struct SearchResultPair {
-    int64_t primary_key_;
+    milvus::PkType primary_key_;
     float distance_;
     milvus::SearchResult* search_result_;
     int64_t index_;
     int64_t offset_;
     int64_t offset_rb_;  // right bound
 
-    SearchResultPair(int64_t primary_key, float distance, SearchResult* result, int64_t index, int64_t lb, int64_t rb)
-        : primary_key_(primary_key),
+    SearchResultPair(
+        milvus::PkType primary_key, float distance, SearchResult* result, int64_t index, int64_t lb, int64_t rb)
+        : primary_key_(std::move(primary_key)),
           distance_(distance),
           search_result_(result),
           index_(index),
@@ -36,10 +38,10 @@ struct SearchResultPair {
 
     bool
     operator>(const SearchResultPair& other) const {
-        if (this->primary_key_ == INVALID_ID) {
+        if (std::holds_alternative<std::monostate>(this->primary_key_)) {
             return false;
         } else {
-            if (other.primary_key_ == INVALID_ID) {
+            if (std::holds_alternative<std::monostate>(other.primary_key_)) {
                 return true;
             } else {
                 return (distance_ > other.distance_);
@@ -55,11 +57,11 @@ struct SearchResultPair {
                 primary_key_ = search_result_->primary_keys_.at(offset_);
                 distance_ = search_result_->distances_.at(offset_);
             } else {
-                primary_key_ = INVALID_ID;
+                primary_key_ = std::monostate{};
                 distance_ = std::numeric_limits<float>::max();
             }
         } else {
-            primary_key_ = INVALID_ID;
+            primary_key_ = std::monostate{};
             distance_ = std::numeric_limits<float>::max();
         }
     }
@@ -13,52 +13,48 @@
 #include "ScalarIndex.h"
 
 namespace milvus::segcore {
+std::pair<std::vector<std::pair<PkType, SegOffset>>::const_iterator,
+          std::vector<std::pair<PkType, SegOffset>>::const_iterator>
+ScalarIndexVector::find(const PkType& id) const {
+    using Pair = std::pair<PkType, SegOffset>;
+    return std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
+                            [](const Pair& left, const Pair& right) { return left.first < right.first; });
+}
+
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 ScalarIndexVector::do_search_ids(const IdArray& ids) const {
     auto res_ids = std::make_unique<IdArray>();
-    // TODO: support string array
-    static_assert(std::is_same_v<T, int64_t>);
-    AssertInfo(ids.has_int_id(), "ids doesn't have int_id field");
-    auto src_ids = ids.int_id();
-    auto dst_ids = res_ids->mutable_int_id();
-    std::vector<SegOffset> dst_offsets;
-
-    // TODO: a possible optimization:
-    // TODO: sort the input id array to make access cache friendly
-
-    // assume no repeated key now
-    // TODO: support repeated key
-    for (auto id : src_ids.data()) {
-        using Pair = std::pair<T, SegOffset>;
-        auto [iter_beg, iter_end] =
-            std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
-                             [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    AssertInfo(ids.has_int_id() || ids.has_str_id(), "ids doesn't have int_id or str_id field");
+    std::vector<PkType> src_ids;
+    if (ids.has_int_id()) {
+        res_ids->mutable_int_id();
+        src_ids.assign(ids.int_id().data().begin(), ids.int_id().data().end());
+    } else {
+        res_ids->mutable_str_id();
+        src_ids.assign(ids.str_id().data().begin(), ids.str_id().data().end());
+    }
 
-        if (iter_beg == iter_end) {
-            // no data
-            continue;
+    auto [dst_ids, dst_offsets] = do_search_ids(src_ids);
+    for (auto& id : dst_ids) {
+        if (ids.has_int_id()) {
+            res_ids->mutable_int_id()->add_data(std::get<int64_t>(id));
+        } else {
+            res_ids->mutable_str_id()->add_data(std::get<std::string>(id));
         }
-        // TODO: for repeated key, decide the final offset with Timestamp
-        // no repeated key, simplified logic
-        // AssertInfo(iter_beg + 1 == iter_end, "There are no repeated keys in more than one results");
-        auto [entry_id, entry_offset] = *iter_beg;
-
-        dst_ids->add_data(entry_id);
-        dst_offsets.push_back(entry_offset);
     }
     return {std::move(res_ids), std::move(dst_offsets)};
 }
 
-std::pair<std::vector<idx_t>, std::vector<SegOffset>>
-ScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
+std::pair<std::vector<PkType>, std::vector<SegOffset>>
+ScalarIndexVector::do_search_ids(const std::vector<PkType>& ids) const {
     std::vector<SegOffset> dst_offsets;
-    std::vector<idx_t> dst_ids;
+    std::vector<PkType> dst_ids;
 
-    for (auto id : ids) {
-        using Pair = std::pair<T, SegOffset>;
-        auto [iter_beg, iter_end] =
-            std::equal_range(mapping_.begin(), mapping_.end(), std::make_pair(id, SegOffset(0)),
-                             [](const Pair& left, const Pair& right) { return left.first < right.first; });
+    // TODO: a possible optimization:
+    // TODO: sort the input id array to make access cache friendly
+
+    for (auto& id : ids) {
+        auto [iter_beg, iter_end] = find(id);
 
         if (iter_beg == iter_end) {
             // no data
@@ -67,7 +63,7 @@ ScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
         // TODO: for repeated key, decide the final offset with Timestamp
         // no repeated key, simplified logic
         // AssertInfo(iter_beg + 1 == iter_end, "There are no repeated keys in more than one results");
-        auto [entry_id, entry_offset] = *iter_beg;
+        auto& [entry_id, entry_offset] = *iter_beg;
 
         dst_ids.push_back(entry_id);
         dst_offsets.push_back(entry_offset);
@@ -75,13 +71,16 @@ ScalarIndexVector::do_search_ids(const std::vector<idx_t>& ids) const {
     return {std::move(dst_ids), std::move(dst_offsets)};
 }
 
-void
-ScalarIndexVector::append_data(const ScalarIndexVector::T* ids, int64_t count, SegOffset base) {
-    for (int64_t i = 0; i < count; ++i) {
-        auto offset = base + SegOffset(i);
-        mapping_.emplace_back(ids[i], offset);
+std::vector<SegOffset>
+ScalarIndexVector::find_offsets(const PkType& id) const {
+    std::vector<SegOffset> dst_offsets;
+    auto [iter_beg, iter_end] = find(id);
+    for (auto iter = iter_beg; iter != iter_end; ++iter) {
+        dst_offsets.push_back(iter->second);
     }
+    return dst_offsets;
 }
+
 void
 ScalarIndexVector::build() {
     std::sort(mapping_.begin(), mapping_.end());
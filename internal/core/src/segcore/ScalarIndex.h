@@ -13,7 +13,9 @@
 
 #include <memory>
 #include <string>
+#include <type_traits>
 #include <utility>
+#include <variant>
 #include <vector>
 
 #include "common/Types.h"
@@ -25,20 +27,29 @@ class ScalarIndexBase {
  public:
     virtual std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const = 0;
-    virtual std::pair<std::vector<idx_t>, std::vector<SegOffset>>
-    do_search_ids(const std::vector<idx_t>& ids) const = 0;
+    virtual std::pair<std::vector<PkType>, std::vector<SegOffset>>
+    do_search_ids(const std::vector<PkType>& ids) const = 0;
+    // all offsets of the key, a key has several rows once it is upserted
+    virtual std::vector<SegOffset>
+    find_offsets(const PkType& id) const = 0;
     virtual ~ScalarIndexBase() = default;
     virtual std::string
     debug() const = 0;
 };
 
+// the int64 or varchar primary keys are stored as they are, so the keys are compared by their values
 class ScalarIndexVector : public ScalarIndexBase {
-    using T = int64_t;
-
  public:
     // TODO: use proto::schema::ids
+    template <typename T>
     void
-    append_data(const T* ids, int64_t count, SegOffset base);
+    append_data(const T* ids, int64_t count, SegOffset base) {
+        static_assert(std::is_same_v<T, int64_t> || std::is_same_v<T, std::string>);
+        for (int64_t i = 0; i < count; ++i) {
+            auto offset = base + SegOffset(i);
+            mapping_.emplace_back(PkType(ids[i]), offset);
+        }
+    }
 
     void
     build();
@@ -46,20 +57,31 @@ class ScalarIndexVector : public ScalarIndexBase {
     std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
     do_search_ids(const IdArray& ids) const override;
 
-    std::pair<std::vector<idx_t>, std::vector<SegOffset>>
-    do_search_ids(const std::vector<idx_t>& ids) const override;
+    std::pair<std::vector<PkType>, std::vector<SegOffset>>
+    do_search_ids(const std::vector<PkType>& ids) const override;
+
+    std::vector<SegOffset>
+    find_offsets(const PkType& id) const override;
 
     std::string
     debug() const override {
         std::string dbg_str;
-        for (auto pr : mapping_) {
-            dbg_str += "<" + std::to_string(pr.first) + "->" + std::to_string(pr.second.get()) + ">";
+        for (auto& pr : mapping_) {
+            auto key = std::holds_alternative<std::string>(pr.first) ? std::get<std::string>(pr.first)
+                                                                      : std::to_string(std::get<int64_t>(pr.first));
+            dbg_str += "<" + key + "->" + std::to_string(pr.second.get()) + ">";
         }
         return dbg_str;
     }
 
  private:
-    std::vector<std::pair<T, SegOffset>> mapping_;
+    // offsets of the rows matching the key
+    std::pair<std::vector<std::pair<PkType, SegOffset>>::const_iterator,
+              std::vector<std::pair<PkType, SegOffset>>::const_iterator>
+    find(const PkType& id) const;
+
+ private:
+    std::vector<std::pair<PkType, SegOffset>> mapping_;
 };
 
 }  // namespace milvus::segcore
@@ -24,9 +24,10 @@
 namespace milvus::segcore {
 
 using SearchResult = milvus::SearchResult;
+// the rows vary in size with the varchar and json values, size is the total size of the rows
 struct RowBasedRawData {
-    void* raw_data;      // schema
-    int sizeof_per_row;  // alignment
+    void* raw_data;  // schema
+    int64_t size;
     int64_t count;
 };
 
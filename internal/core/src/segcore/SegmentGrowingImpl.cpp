@@ -22,6 +22,7 @@
 #include "query/generated/ExecPlanNodeVisitor.h"
 #include "segcore/Reduce.h"
 #include "segcore/SegmentGrowingImpl.h"
+#include "segcore/Utils.h"
 #include "utils/Utils.h"
 
 namespace milvus::segcore {
@@ -57,15 +58,16 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
     auto bitmap = current->bitmap_ptr;
     if (del_barrier < old->del_barrier) {
         for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
-            // get uid in delete logs
-            auto uid = deleted_record_.uids_[del_index];
-            // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            // get pk in delete logs
+            auto& pk = deleted_record_.pks_[del_index];
+            auto del_ts = deleted_record_.timestamps_[del_index];
+            // map pk to corresponding offsets, select the max one inserted before the delete, which should be
+            // the target; the row inserted with the same timestamp (e.g. by upsert) is not affected by the delete
             int64_t the_offset = -1;
-            auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+            auto [iter_b, iter_e] = pk2offset_.equal_range(pk);
             for (auto iter = iter_b; iter != iter_e; ++iter) {
                 auto offset = iter->second;
-                if (record_.timestamps_[offset] < query_timestamp) {
+                if (record_.timestamps_[offset] < del_ts) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -80,18 +82,19 @@ SegmentGrowingImpl::get_deleted_bitmap(int64_t del_barrier,
         return current;
     } else {
         for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
-            // get uid in delete logs
-            auto uid = deleted_record_.uids_[del_index];
-            // map uid to corresponding offsets, select the max one, which should be the target
-            // the max one should be closest to query_timestamp, so the delete log should refer to it
+            // get pk in delete logs
+            auto& pk = deleted_record_.pks_[del_index];
+            auto del_ts = deleted_record_.timestamps_[del_index];
+            // map pk to corresponding offsets, select the max one inserted before the delete, which should be
+            // the target; the row inserted with the same timestamp (e.g. by upsert) is not affected by the delete
             int64_t the_offset = -1;
-            auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+            auto [iter_b, iter_e] = pk2offset_.equal_range(pk);
             for (auto iter = iter_b; iter != iter_e; ++iter) {
                 auto offset = iter->second;
                 if (offset >= insert_barrier) {
                     continue;
                 }
-                if (record_.timestamps_[offset] < query_timestamp) {
+                if (record_.timestamps_[offset] < del_ts) {
                     AssertInfo(offset < insert_barrier, "Timestamp offset is larger than insert barrier");
                     the_offset = std::max(the_offset, offset);
                 }
@@ -135,6 +138,20 @@ SegmentGrowingImpl::get_filtered_bitmap(const BitsetView& bitset, int64_t ins_ba
     return res;
 }
 
+// size of the value of the field at data, a varchar or json value takes its length and the bytes,
+// the value may exceed the remaining bytes if the data is malformed
+static int64_t
+value_sizeof(const FieldMeta& field_meta, const uint8_t* data, int64_t remaining) {
+    if (!field_meta.is_string()) {
+        return field_meta.get_sizeof();
+    }
+    uint32_t len = 0;
+    if (remaining >= static_cast<int64_t>(sizeof(len))) {
+        memcpy(&len, data, sizeof(len));
+    }
+    return sizeof(len) + len;
+}
+
 Status
 SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            int64_t size,
@@ -142,16 +159,30 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
                            const Timestamp* timestamps_raw,
                            const RowBasedRawData& entities_raw) {
     AssertInfo(entities_raw.count == size, "Entities_raw count not equal to insert size");
-    // step 1: check schema if valid
-    if (entities_raw.sizeof_per_row != schema_->get_total_sizeof()) {
-        std::string msg = "entity length = " + std::to_string(entities_raw.sizeof_per_row) +
-                          ", schema length = " + std::to_string(schema_->get_total_sizeof());
+    // step 1: split the rows by the schema, which vary in size with the varchar and json values
+    auto raw_data = reinterpret_cast<const uint8_t*>(entities_raw.raw_data);
+    std::vector<std::vector<std::pair<const uint8_t*, int64_t>>> row_values(size);
+    int64_t pos = 0;
+    for (int64_t i = 0; i < size; ++i) {
+        row_values[i].reserve(schema_->size());
+        for (int fid = 0; fid < schema_->size(); ++fid) {
+            auto len = value_sizeof((*schema_)[FieldOffset(fid)], raw_data + pos, entities_raw.size - pos);
+            if (len > entities_raw.size - pos) {
+                std::string msg = "entity length = " + std::to_string(entities_raw.size) +
+                                  " is less than the length of " + std::to_string(size) + " rows";
+                throw std::runtime_error(msg);
+            }
+            row_values[i].emplace_back(raw_data + pos, len);
+            pos += len;
+        }
+    }
+    if (pos != entities_raw.size) {
+        std::string msg = "entity length = " + std::to_string(entities_raw.size) +
+                          ", length of rows = " + std::to_string(pos);
         throw std::runtime_error(msg);
     }
 
     // step 2: sort timestamp
-    auto raw_data = reinterpret_cast<const char*>(entities_raw.raw_data);
-    auto len_per_row = entities_raw.sizeof_per_row;
     std::vector<std::tuple<Timestamp, idx_t, int64_t>> ordering;
     ordering.resize(size);
     // #pragma omp parallel for
@@ -161,16 +192,8 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
     std::sort(ordering.begin(), ordering.end());
 
     // step 3: and convert row-based data to column-based data accordingly
-    auto sizeof_infos = schema_->get_sizeof_infos();
-    std::vector<int> offset_infos(schema_->size() + 1, 0);
-    std::partial_sum(sizeof_infos.begin(), sizeof_infos.end(), offset_infos.begin() + 1);
     std::vector<aligned_vector<uint8_t>> entities(schema_->size());
 
-    for (int fid = 0; fid < schema_->size(); ++fid) {
-        auto len = sizeof_infos[fid];
-        entities[fid].resize(len * size);
-    }
-
     std::vector<idx_t> uids(size);
     std::vector<Timestamp> timestamps(size);
     // #pragma omp parallel for
@@ -179,11 +202,8 @@ SegmentGrowingImpl::Insert(int64_t reserved_begin,
         timestamps[index] = t;
         uids[index] = uid;
         for (int fid = 0; fid < schema_->size(); ++fid) {
-            auto len = sizeof_infos[fid];
-            auto offset = offset_infos[fid];
-            auto src = raw_data + order_index * len_per_row + offset;
-            auto dst = entities[fid].data() + index * len;
-            memcpy(dst, src, len);
+            auto [src, len] = row_values[order_index][fid];
+            entities[fid].insert(entities[fid].end(), src, src + len);
         }
     }
 
@@ -209,15 +229,21 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
         for (int i = 0; i < size; ++i) {
             auto row_id = row_ids[i];
             // NOTE: this must be the last step, cannot be put above
-            uid2offset_.insert(std::make_pair(row_id, reserved_begin + i));
+            pk2offset_.insert(std::make_pair(PkType(row_id), reserved_begin + i));
         }
     } else {
         auto offset = schema_->get_primary_key_offset().value_or(FieldOffset(-1));
         AssertInfo(offset.get() != -1, "Primary key offset is -1");
-        auto& row = columns_data[offset.get()];
-        auto row_ptr = reinterpret_cast<const int64_t*>(row.data());
-        for (int i = 0; i < size; ++i) {
-            uid2offset_.insert(std::make_pair(row_ptr[i], reserved_begin + i));
+        if ((*schema_)[offset].get_data_type() == DataType::VARCHAR) {
+            auto& pks = *record_.get_field_data<std::string>(offset);
+            for (int i = 0; i < size; ++i) {
+                pk2offset_.insert(std::make_pair(PkType(pks[reserved_begin + i]), reserved_begin + i));
+            }
+        } else {
+            auto row_ptr = reinterpret_cast<const int64_t*>(columns_data[offset.get()].data());
+            for (int i = 0; i < size; ++i) {
+                pk2offset_.insert(std::make_pair(PkType(row_ptr[i]), reserved_begin + i));
+            }
         }
     }
 
@@ -231,35 +257,67 @@ SegmentGrowingImpl::do_insert(int64_t reserved_begin,
 Status
 SegmentGrowingImpl::Delete(int64_t reserved_begin,
                            int64_t size,
-                           const int64_t* uids_raw,
+                           const void* primary_keys,
                            const Timestamp* timestamps_raw) {
-    std::vector<std::tuple<Timestamp, idx_t>> ordering;
+    auto pks_raw = ParsePrimaryKeys(*schema_, primary_keys, size);
+    std::vector<std::tuple<Timestamp, PkType>> ordering;
     ordering.resize(size);
     // #pragma omp parallel for
     for (int i = 0; i < size; ++i) {
-        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
+        ordering[i] = std::make_tuple(timestamps_raw[i], std::move(pks_raw[i]));
     }
     std::sort(ordering.begin(), ordering.end());
-    std::vector<idx_t> uids(size);
+    std::vector<PkType> pks(size);
     std::vector<Timestamp> timestamps(size);
     // #pragma omp parallel for
     for (int index = 0; index < size; ++index) {
-        auto [t, uid] = ordering[index];
+        auto& [t, pk] = ordering[index];
         timestamps[index] = t;
-        uids[index] = uid;
+        pks[index] = std::move(pk);
     }
     deleted_record_.timestamps_.set_data(reserved_begin, timestamps.data(), size);
-    deleted_record_.uids_.set_data(reserved_begin, uids.data(), size);
+    deleted_record_.pks_.set_data(reserved_begin, pks.data(), size);
     deleted_record_.ack_responder_.AddSegment(reserved_begin, reserved_begin + size);
     return Status::OK();
 }
 
+void
+SegmentGrowingImpl::UpdateSchema(SchemaPtr schema, const void* default_row) {
+    AssertSchemaAppended(*schema_, *schema);
+    auto chunk_rows = segcore_config_.get_chunk_rows();
+    int64_t row_count = record_.reserved;
+    auto src = static_cast<const char*>(default_row);
+    for (auto offset = schema_->size(); offset < schema->size(); ++offset) {
+        auto& field_meta = (*schema)[FieldOffset(offset)];
+        AssertInfo(!field_meta.is_vector(), "vector field can't be appended to the schema");
+        record_.append_field(field_meta, chunk_rows);
+        auto value = src;
+        if (field_meta.is_string()) {
+            ReadVarChar(src);
+        } else {
+            src += field_meta.get_sizeof();
+        }
+        auto element_sizeof = src - value;
+        aligned_vector<char> column(element_sizeof * row_count);
+        for (int64_t row = 0; row < row_count; ++row) {
+            memcpy(column.data() + row * element_sizeof, value, element_sizeof);
+        }
+        record_.get_field_data_base(FieldOffset(offset))->set_data_raw(0, column.data(), row_count);
+    }
+    schema_ = std::move(schema);
+}
+
 int64_t
 SegmentGrowingImpl::GetMemoryUsageInBytes() const {
     int64_t total_bytes = 0;
     auto chunk_rows = segcore_config_.get_chunk_rows();
     int64_t ins_n = upper_align(record_.reserved, chunk_rows);
     total_bytes += ins_n * (schema_->get_total_sizeof() + 16 + 1);
+    for (int fid = 0; fid < schema_->size(); ++fid) {
+        if ((*schema_)[FieldOffset(fid)].is_string()) {
+            total_bytes += record_.get_field_data<std::string>(FieldOffset(fid))->get_bytes();
+        }
+    }
     int64_t del_n = upper_align(deleted_record_.reserved, chunk_rows);
     total_bytes += del_n * (16 * 2);
     return total_bytes;
@@ -349,6 +407,25 @@ SegmentGrowingImpl::bulk_subscript(FieldOffset field_offset,
     }
 }
 
+void
+SegmentGrowingImpl::bulk_subscript_strings(FieldOffset field_offset,
+                                           const int64_t* seg_offsets,
+                                           int64_t count,
+                                           std::string* output) const {
+    AssertInfo(schema_->operator[](field_offset).is_string(), "Field meta type isn't varchar or json type");
+    auto& vec = *record_.get_field_data<std::string>(field_offset);
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        output[i] = (offset == INVALID_SEG_OFFSET ? std::string() : vec[offset]);
+    }
+}
+
+std::vector<std::string_view>
+SegmentGrowingImpl::chunk_string_data(FieldOffset field_offset, int64_t chunk_id) const {
+    auto& chunk = record_.get_field_data<std::string>(field_offset)->get_chunk(chunk_id);
+    return std::vector<std::string_view>(chunk.begin(), chunk.end());
+}
+
 template <typename T>
 void
 SegmentGrowingImpl::bulk_subscript_impl(int64_t element_sizeof,
@@ -438,13 +515,20 @@ SegmentGrowingImpl::Insert(int64_t reserved_offset,
     for (int field_offset = 0; field_offset < schema_->size(); ++field_offset) {
         auto& field_meta = schema_->operator[](FieldOffset(field_offset));
         aligned_vector<uint8_t> column;
-        auto element_sizeof = field_meta.get_sizeof();
         auto& src_vec = values.columns_[field_offset];
-        AssertInfo(src_vec.size() == element_sizeof * size, "Vector size is not aligned");
+        int64_t src_size = src_vec.size();
+        // the varchar and json values vary in size
+        std::vector<int64_t> element_offsets(size + 1, 0);
+        for (int64_t i = 0; i < size; ++i) {
+            auto pos = element_offsets[i];
+            element_offsets[i + 1] = pos + value_sizeof(field_meta, src_vec.data() + pos, src_size - pos);
+            AssertInfo(element_offsets[i + 1] <= src_size, "Vector size is not aligned");
+        }
+        AssertInfo(element_offsets[size] == src_size, "Vector size is not aligned");
         for (int64_t i = 0; i < size; ++i) {
             auto offset = indexes[i];
-            auto beg = src_vec.data() + offset * element_sizeof;
-            column.insert(column.end(), beg, beg + element_sizeof);
+            auto beg = src_vec.data() + element_offsets[offset];
+            column.insert(column.end(), beg, src_vec.data() + element_offsets[offset + 1]);
         }
         columns_data.emplace_back(std::move(column));
     }
@@ -495,13 +579,19 @@ SegmentGrowingImpl::search_ids(const BitsetView& bitset, Timestamp timestamp) co
 
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
-    AssertInfo(id_array.has_int_id(), "Id array doesn't have int_id element");
-    auto& src_int_arr = id_array.int_id();
+    AssertInfo(id_array.has_int_id() || id_array.has_str_id(), "Id array doesn't have int_id or str_id element");
     auto res_id_arr = std::make_unique<IdArray>();
-    auto res_int_id_arr = res_id_arr->mutable_int_id();
+    std::vector<PkType> pks;
+    if (id_array.has_int_id()) {
+        res_id_arr->mutable_int_id();
+        pks.assign(id_array.int_id().data().begin(), id_array.int_id().data().end());
+    } else {
+        res_id_arr->mutable_str_id();
+        pks.assign(id_array.str_id().data().begin(), id_array.str_id().data().end());
+    }
     std::vector<SegOffset> res_offsets;
-    for (auto uid : src_int_arr.data()) {
-        auto [iter_b, iter_e] = uid2offset_.equal_range(uid);
+    for (auto& pk : pks) {
+        auto [iter_b, iter_e] = pk2offset_.equal_range(pk);
         SegOffset the_offset(-1);
         for (auto iter = iter_b; iter != iter_e; ++iter) {
             auto offset = SegOffset(iter->second);
@@ -513,7 +603,11 @@ SegmentGrowingImpl::search_ids(const IdArray& id_array, Timestamp timestamp) con
         if (the_offset == SegOffset(-1)) {
             continue;
         }
-        res_int_id_arr->add_data(uid);
+        if (id_array.has_int_id()) {
+            res_id_arr->mutable_int_id()->add_data(std::get<int64_t>(pk));
+        } else {
+            res_id_arr->mutable_str_id()->add_data(std::get<std::string>(pk));
+        }
         res_offsets.push_back(the_offset);
     }
     return {std::move(res_id_arr), std::move(res_offsets)};
@@ -538,4 +632,14 @@ SegmentGrowingImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk,
     // DO NOTHING
 }
 
+void
+SegmentGrowingImpl::mask_with_expired(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_timestamp) const {
+    auto& ts_vec = this->get_insert_record().timestamps_;
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (ts_vec[i] < expire_timestamp) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
@@ -18,6 +18,8 @@
 #include <tbb/concurrent_priority_queue.h>
 #include <tbb/concurrent_unordered_map.h>
 #include <tbb/concurrent_vector.h>
+#include <string>
+#include <string_view>
 #include <vector>
 #include <utility>
 
@@ -61,11 +63,14 @@ class SegmentGrowingImpl : public SegmentGrowing {
 
     // TODO: add id into delete log, possibly bitmap
     Status
-    Delete(int64_t reserverd_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+    Delete(int64_t reserverd_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) override;
 
     int64_t
     GetMemoryUsageInBytes() const override;
 
+    void
+    UpdateSchema(SchemaPtr schema, const void* default_row) override;
+
     std::string
     debug() const override;
 
@@ -98,6 +103,10 @@ class SegmentGrowingImpl : public SegmentGrowing {
     // return count of index that has index, i.e., [0, num_chunk_index) have built index
     int64_t
     num_chunk_index(FieldOffset field_offset) const final {
+        // the fields appended to the schema after the segment is created have no index
+        if (!indexing_record_.is_in(field_offset)) {
+            return 0;
+        }
         return indexing_record_.get_finished_ack();
     }
 
@@ -107,6 +116,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
         return indexing_record_.get_field_indexing(field_offset).get_chunk_indexing(chunk_id);
     }
 
+    std::vector<std::string_view>
+    chunk_string_data(FieldOffset field_offset, int64_t chunk_id) const override;
+
     int64_t
     size_per_chunk() const final {
         return segcore_config_.get_chunk_rows();
@@ -152,6 +164,12 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const override;
 
+    void
+    bulk_subscript_strings(FieldOffset field_offset,
+                           const int64_t* seg_offsets,
+                           int64_t count,
+                           std::string* output) const override;
+
  public:
     friend std::unique_ptr<SegmentGrowing>
     CreateGrowingSegment(SchemaPtr schema, const SegcoreConfig& segcore_config, int64_t segment_id);
@@ -167,6 +185,9 @@ class SegmentGrowingImpl : public SegmentGrowing {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expired(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -224,7 +245,8 @@ class SegmentGrowingImpl : public SegmentGrowing {
     IndexingRecord indexing_record_;
     SealedIndexingRecord sealed_indexing_record_;
 
-    tbb::concurrent_unordered_multimap<idx_t, int64_t> uid2offset_;
+    // the primary keys are the row ids in auto id mode
+    tbb::concurrent_unordered_multimap<PkType, int64_t, std::hash<PkType>> pk2offset_;
     int64_t id_;
 
  private:
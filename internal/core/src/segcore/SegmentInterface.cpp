@@ -10,6 +10,8 @@
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
 #include "SegmentInterface.h"
+#include "Utils.h"
+#include "common/Consts.h"
 #include "query/generated/ExecPlanNodeVisitor.h"
 
 namespace milvus::segcore {
@@ -23,19 +25,46 @@ SegmentInternalInterface::FillPrimaryKeys(const query::Plan* plan, SearchResult&
     Assert(results.primary_keys_.size() == 0);
     results.primary_keys_.resize(size);
 
-    auto element_sizeof = sizeof(int64_t);
-    aligned_vector<char> blob(size * element_sizeof);
-    if (plan->schema_.get_is_auto_id()) {
-        bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, blob.data());
-    } else {
-        auto key_offset_opt = get_schema().get_primary_key_offset();
+    bulk_subscript_primary_keys(plan, results.ids_.data(), size, results.primary_keys_.data());
+}
+
+void
+SegmentInternalInterface::bulk_subscript_primary_keys(const query::Plan* plan,
+                                                      const int64_t* seg_offsets,
+                                                      int64_t count,
+                                                      PkType* output) const {
+    std::optional<FieldOffset> key_offset_opt;
+    if (!plan->schema_.get_is_auto_id()) {
+        key_offset_opt = get_schema().get_primary_key_offset();
         AssertInfo(key_offset_opt.has_value(), "Cannot get primary key offset from schema");
-        auto key_offset = key_offset_opt.value();
-        AssertInfo(get_schema()[key_offset].get_data_type() == DataType::INT64, "Primary key field is not INT64 type");
-        bulk_subscript(key_offset, results.ids_.data(), size, blob.data());
     }
-
-    memcpy(results.primary_keys_.data(), blob.data(), element_sizeof * size);
+    auto data_type =
+        key_offset_opt.has_value() ? get_schema()[key_offset_opt.value()].get_data_type() : DataType::INT64;
+    switch (data_type) {
+        case DataType::INT64: {
+            std::vector<int64_t> pks(count);
+            if (key_offset_opt.has_value()) {
+                bulk_subscript(key_offset_opt.value(), seg_offsets, count, pks.data());
+            } else {
+                bulk_subscript(SystemFieldType::RowId, seg_offsets, count, pks.data());
+            }
+            for (int64_t i = 0; i < count; ++i) {
+                output[i] = seg_offsets[i] == INVALID_SEG_OFFSET ? PkType() : PkType(pks[i]);
+            }
+            break;
+        }
+        case DataType::VARCHAR: {
+            std::vector<std::string> pks(count);
+            bulk_subscript_strings(key_offset_opt.value(), seg_offsets, count, pks.data());
+            for (int64_t i = 0; i < count; ++i) {
+                output[i] = seg_offsets[i] == INVALID_SEG_OFFSET ? PkType() : PkType(std::move(pks[i]));
+            }
+            break;
+        }
+        default: {
+            PanicInfo("Primary key field is not INT64 or VARCHAR type");
+        }
+    }
 }
 
 void
@@ -46,50 +75,59 @@ SegmentInternalInterface::FillTargetEntry(const query::Plan* plan, SearchResult&
     AssertInfo(results.ids_.size() == size, "Size of result distances is not equal to size of ids");
     Assert(results.row_data_.size() == 0);
 
-    std::vector<int64_t> element_sizeofs;
-    std::vector<aligned_vector<char>> blobs;
+    // each row starts with the int64 primary key, or the row id for a varchar primary key or in auto id mode,
+    // then the target entries follow, a varchar or json value takes its length and the bytes
+    std::vector<std::vector<char>> targets(size);
+    auto append = [&targets](int64_t i, const void* src, int64_t element_sizeof) {
+        auto src_ptr = static_cast<const char*>(src);
+        targets[i].insert(targets[i].end(), src_ptr, src_ptr + element_sizeof);
+    };
 
     // fill row_ids
     {
-        aligned_vector<char> blob(size * sizeof(int64_t));
-        if (plan->schema_.get_is_auto_id()) {
-            bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, blob.data());
+        std::vector<int64_t> ids(size);
+        auto pk_offset = get_schema().get_primary_key_offset();
+        if (!plan->schema_.get_is_auto_id() && pk_offset.has_value() &&
+            get_schema()[pk_offset.value()].get_data_type() == DataType::INT64) {
+            bulk_subscript(pk_offset.value(), results.ids_.data(), size, ids.data());
         } else {
-            auto key_offset_opt = get_schema().get_primary_key_offset();
-            AssertInfo(key_offset_opt.has_value(), "Cannot get primary key offset from schema");
-            auto key_offset = key_offset_opt.value();
-            AssertInfo(get_schema()[key_offset].get_data_type() == DataType::INT64,
-                       "Primary key field is not INT64 type");
-            bulk_subscript(key_offset, results.ids_.data(), size, blob.data());
-        }
-        blobs.emplace_back(std::move(blob));
-        element_sizeofs.push_back(sizeof(int64_t));
+            bulk_subscript(SystemFieldType::RowId, results.ids_.data(), size, ids.data());
+        }
+        for (int64_t i = 0; i < size; ++i) {
+            auto id = results.ids_[i] == INVALID_SEG_OFFSET ? INVALID_ID : ids[i];
+            append(i, &id, sizeof(id));
+        }
     }
 
     // fill other entries except primary key
     for (auto field_offset : plan->target_entries_) {
         auto& field_meta = get_schema()[field_offset];
-        auto element_sizeof = field_meta.get_sizeof();
-        aligned_vector<char> blob(size * element_sizeof);
-        bulk_subscript(field_offset, results.ids_.data(), size, blob.data());
-        blobs.emplace_back(std::move(blob));
-        element_sizeofs.push_back(element_sizeof);
-    }
-
-    auto target_sizeof = std::accumulate(element_sizeofs.begin(), element_sizeofs.end(), 0);
+        if (field_meta.is_string()) {
+            std::vector<std::string> values(size);
+            bulk_subscript_strings(field_offset, results.ids_.data(), size, values.data());
+            for (int64_t i = 0; i < size; ++i) {
+                WriteVarChar(values[i], targets[i]);
+            }
+        } else {
+            auto element_sizeof = field_meta.get_sizeof();
+            aligned_vector<char> blob(size * element_sizeof);
+            bulk_subscript(field_offset, results.ids_.data(), size, blob.data());
+            for (int64_t i = 0; i < size; ++i) {
+                append(i, blob.data() + i * element_sizeof, element_sizeof);
+            }
+        }
 
-    for (int64_t i = 0; i < size; ++i) {
-        int64_t element_offset = 0;
-        std::vector<char> target(target_sizeof);
-        for (int loc = 0; loc < blobs.size(); ++loc) {
-            auto element_sizeof = element_sizeofs[loc];
-            auto blob_ptr = blobs[loc].data();
-            auto src = blob_ptr + element_sizeof * i;
-            auto dst = target.data() + element_offset;
-            memcpy(dst, src, element_sizeof);
-            element_offset += element_sizeof;
+        // a nullable field is followed by a byte of the validity of the row
+        if (field_meta.is_nullable()) {
+            std::unique_ptr<bool[]> valid_data(new bool[size]);
+            bulk_subscript_valid_data(field_offset, results.ids_.data(), size, valid_data.get());
+            for (int64_t i = 0; i < size; ++i) {
+                append(i, &valid_data[i], sizeof(bool));
+            }
         }
-        assert(element_offset == target_sizeof);
+    }
+
+    for (auto& target : targets) {
         results.row_data_.emplace_back(std::move(target));
     }
 }
@@ -110,7 +148,8 @@ SegmentInternalInterface::Search(const query::Plan* plan,
 // Note: this is temporary solution.
 // modify bulk script implement to make process more clear
 static std::unique_ptr<ScalarArray>
-CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
+CreateScalarArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_meta) {
+    auto data_type = field_meta.get_data_type();
     auto scalar_array = std::make_unique<ScalarArray>();
     switch (data_type) {
         case DataType::BOOL: {
@@ -155,6 +194,18 @@ CreateScalarArrayFrom(const void* data_raw, int64_t count, DataType data_type) {
             obj->mutable_data()->Add(data, data + count);
             break;
         }
+        case DataType::VARCHAR: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_string_data();
+            obj->mutable_data()->Add(data, data + count);
+            break;
+        }
+        case DataType::JSON: {
+            auto data = reinterpret_cast<const std::string*>(data_raw);
+            auto obj = scalar_array->mutable_json_data();
+            obj->mutable_data()->Add(data, data + count);
+            break;
+        }
         default: {
             PanicInfo("unsupported datatype");
         }
@@ -170,7 +221,7 @@ CreateDataArrayFrom(const void* data_raw, int64_t count, const FieldMeta& field_
     data_array->set_type(milvus::proto::schema::DataType(field_meta.get_data_type()));
 
     if (!datatype_is_vector(data_type)) {
-        auto scalar_array = CreateScalarArrayFrom(data_raw, count, data_type);
+        auto scalar_array = CreateScalarArrayFrom(data_raw, count, field_meta);
         data_array->set_allocated_scalars(scalar_array.release());
     } else {
         auto vector_array = data_array->mutable_vectors();
@@ -204,9 +255,22 @@ std::unique_ptr<DataArray>
 SegmentInternalInterface::BulkSubScript(FieldOffset field_offset, const SegOffset* seg_offsets, int64_t count) const {
     if (field_offset.get() >= 0) {
         auto& field_meta = get_schema()[field_offset];
-        aligned_vector<char> data(field_meta.get_sizeof() * count);
-        bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
-        return CreateDataArrayFrom(data.data(), count, field_meta);
+        std::unique_ptr<DataArray> data_array;
+        if (field_meta.is_string()) {
+            std::vector<std::string> data(count);
+            bulk_subscript_strings(field_offset, (const int64_t*)seg_offsets, count, data.data());
+            data_array = CreateDataArrayFrom(data.data(), count, field_meta);
+        } else {
+            aligned_vector<char> data(field_meta.get_sizeof() * count);
+            bulk_subscript(field_offset, (const int64_t*)seg_offsets, count, data.data());
+            data_array = CreateDataArrayFrom(data.data(), count, field_meta);
+        }
+        if (field_meta.is_nullable()) {
+            std::unique_ptr<bool[]> valid_data(new bool[count]);
+            bulk_subscript_valid_data(field_offset, (const int64_t*)seg_offsets, count, valid_data.get());
+            data_array->mutable_valid_data()->Add(valid_data.get(), valid_data.get() + count);
+        }
+        return data_array;
     } else {
         Assert(field_offset.get() == -1);
         aligned_vector<char> data(sizeof(int64_t) * count);
@@ -234,11 +298,66 @@ SegmentInternalInterface::Retrieve(const query::RetrievePlan* plan, Timestamp ti
         auto col_data = col.release();
         fields_data->AddAllocated(col_data);
         if (pk_offset.has_value() && pk_offset.value() == field_offset) {
-            auto int_ids = ids->mutable_int_id();
-            auto src_data = col_data->scalars().long_data();
-            int_ids->mutable_data()->Add(src_data.data().begin(), src_data.data().end());
+            if (col_data->type() == proto::schema::DataType::VarChar) {
+                auto str_ids = ids->mutable_str_id();
+                auto src_data = col_data->scalars().string_data();
+                str_ids->mutable_data()->Add(src_data.data().begin(), src_data.data().end());
+            } else {
+                auto int_ids = ids->mutable_int_id();
+                auto src_data = col_data->scalars().long_data();
+                int_ids->mutable_data()->Add(src_data.data().begin(), src_data.data().end());
+            }
         }
     }
     return results;
 }
+
+void
+SegmentInternalInterface::set_valid_data(FieldOffset field_offset,
+                                         int64_t offset,
+                                         int64_t count,
+                                         const bool* valid_data) {
+    std::unique_lock lck(valid_mutex_);
+    auto& field_valid_data = valid_data_[field_offset];
+    if (field_valid_data.size() < offset + count) {
+        field_valid_data.resize(offset + count, true);
+    }
+    for (int64_t i = 0; i < count; ++i) {
+        field_valid_data[offset + i] = valid_data[i];
+    }
+}
+
+boost::dynamic_bitset<>
+SegmentInternalInterface::get_valid_data(FieldOffset field_offset, int64_t row_count) const {
+    std::shared_lock lck(valid_mutex_);
+    boost::dynamic_bitset<> res(row_count);
+    res.set();
+    auto iter = valid_data_.find(field_offset);
+    if (iter == valid_data_.end()) {
+        return res;
+    }
+    auto& field_valid_data = iter->second;
+    for (int64_t i = 0; i < row_count && i < field_valid_data.size(); ++i) {
+        res[i] = field_valid_data[i];
+    }
+    return res;
+}
+
+void
+SegmentInternalInterface::bulk_subscript_valid_data(FieldOffset field_offset,
+                                                    const int64_t* seg_offsets,
+                                                    int64_t count,
+                                                    bool* output) const {
+    std::shared_lock lck(valid_mutex_);
+    auto iter = valid_data_.find(field_offset);
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        if (iter == valid_data_.end() || offset < 0 || offset >= static_cast<int64_t>(iter->second.size())) {
+            output[i] = true;
+        } else {
+            output[i] = iter->second[offset];
+        }
+    }
+}
+
 }  // namespace milvus::segcore
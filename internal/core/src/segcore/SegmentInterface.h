@@ -13,7 +13,10 @@
 
 #include <deque>
 #include <memory>
+#include <shared_mutex>
 #include <string>
+#include <string_view>
+#include <unordered_map>
 #include <utility>
 #include <vector>
 
@@ -60,7 +63,13 @@ class SegmentInterface {
     PreDelete(int64_t size) = 0;
 
     virtual Status
-    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) = 0;
+    Delete(int64_t reserved_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) = 0;
+
+    // replace the schema with the one altered by AddField, which appends scalar fields to it, the rows already
+    // in the segment take default_row, the default values of the appended fields encoded as the row based data.
+    // It must not run concurrently with the other operations on the segment
+    virtual void
+    UpdateSchema(SchemaPtr schema, const void* default_row) = 0;
 };
 
 // internal API for DSL calculation
@@ -101,6 +110,18 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual std::string
     debug() const = 0;
 
+    // set the validity of count rows of a nullable field from offset, the rows never set are valid
+    void
+    set_valid_data(FieldOffset field_offset, int64_t offset, int64_t count, const bool* valid_data);
+
+    // validity of the first row_count rows of the field, false means the row is null
+    boost::dynamic_bitset<>
+    get_valid_data(FieldOffset field_offset, int64_t row_count) const;
+
+    // validity of the rows at seg_offsets of the field, the invalid offsets are valid
+    void
+    bulk_subscript_valid_data(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, bool* output) const;
+
  public:
     virtual void
     vector_search(int64_t vec_count,
@@ -118,9 +139,17 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual int64_t
     num_chunk_index(FieldOffset field_offset) const = 0;
 
+    // the varchar values or the serialized json documents of a chunk, which are valid until the segment changes
+    virtual std::vector<std::string_view>
+    chunk_string_data(FieldOffset field_offset, int64_t chunk_id) const = 0;
+
     virtual void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const = 0;
 
+    // unset the bits of entities inserted before expire_timestamp
+    virtual void
+    mask_with_expired(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_timestamp) const = 0;
+
     // count of chunks
     virtual int64_t
     num_chunk() const = 0;
@@ -156,6 +185,21 @@ class SegmentInternalInterface : public SegmentInterface {
     virtual void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const = 0;
 
+    // calculate output[i] = Vec[seg_offsets[i]}, where Vec is the varchar or json field at field_offset
+    virtual void
+    bulk_subscript_strings(FieldOffset field_offset,
+                           const int64_t* seg_offsets,
+                           int64_t count,
+                           std::string* output) const = 0;
+
+    // calculate output[i] = the primary key of seg_offsets[i], which is the row id in auto id mode,
+    // the invalid offsets take the invalid primary key
+    void
+    bulk_subscript_primary_keys(const query::Plan* plan,
+                                const int64_t* seg_offsets,
+                                int64_t count,
+                                PkType* output) const;
+
     // TODO: special hack: FieldOffset == -1 -> RowId.
     // TODO: remove this hack when transfer is done
     virtual std::unique_ptr<DataArray>
@@ -169,6 +213,10 @@ class SegmentInternalInterface : public SegmentInterface {
 
  protected:
     mutable std::shared_mutex mutex_;
+
+ private:
+    mutable std::shared_mutex valid_mutex_;
+    std::unordered_map<FieldOffset, boost::dynamic_bitset<>> valid_data_;
 };
 
 }  // namespace milvus::segcore
@@ -9,11 +9,14 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#include <unordered_map>
+
 #include "SegmentSealedImpl.h"
 #include "common/Consts.h"
 #include "query/SearchBruteForce.h"
 #include "query/SearchOnSealed.h"
 #include "query/ScalarIndex.h"
+#include "segcore/Utils.h"
 
 namespace milvus::segcore {
 
@@ -65,7 +68,7 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of field data is 0");
     auto field_id = FieldId(info.field_id);
     AssertInfo(info.blob, "Field info blob is null");
-    auto create_index = [](const int64_t* data, int64_t size) {
+    auto create_index = [](const auto* data, int64_t size) {
         AssertInfo(size, "Vector data size is 0 when create index");
         auto pk_index = std::make_unique<ScalarIndexVector>();
         pk_index->append_data(data, size, SegOffset(0));
@@ -126,21 +129,44 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         auto field_offset = schema_->get_offset(field_id);
         auto& field_meta = schema_->operator[](field_offset);
         // Assert(!field_meta.is_vector());
-        auto element_sizeof = field_meta.get_sizeof();
-        auto span = SpanBase(info.blob, info.row_count, element_sizeof);
-        auto length_in_bytes = element_sizeof * info.row_count;
-        aligned_vector<char> vec_data(length_in_bytes);
-        memcpy(vec_data.data(), info.blob, length_in_bytes);
-
-        // generate scalar index
+        aligned_vector<char> vec_data;
+        std::vector<int64_t> string_offsets;
         std::unique_ptr<knowhere::Index> index;
-        if (!field_meta.is_vector()) {
-            index = query::generate_scalar_index(span, field_meta.get_data_type());
+        if (field_meta.is_string()) {
+            // the varchar and json values vary in size, find where each of them starts
+            auto src = static_cast<const char*>(info.blob);
+            auto data = src;
+            string_offsets.resize(info.row_count);
+            for (int64_t i = 0; i < info.row_count; ++i) {
+                string_offsets[i] = data - src;
+                ReadVarChar(data);
+            }
+            vec_data.assign(src, data);
+        } else {
+            auto element_sizeof = field_meta.get_sizeof();
+            auto span = SpanBase(info.blob, info.row_count, element_sizeof);
+            auto length_in_bytes = element_sizeof * info.row_count;
+            vec_data.resize(length_in_bytes);
+            memcpy(vec_data.data(), info.blob, length_in_bytes);
+
+            // generate scalar index
+            if (!field_meta.is_vector()) {
+                index = query::generate_scalar_index(span, field_meta.get_data_type());
+            }
         }
 
         std::unique_ptr<ScalarIndexBase> pk_index_;
         if (schema_->get_primary_key_offset() == field_offset) {
-            pk_index_ = create_index((const int64_t*)vec_data.data(), info.row_count);
+            if (field_meta.is_string()) {
+                std::vector<std::string> pks(info.row_count);
+                for (int64_t i = 0; i < info.row_count; ++i) {
+                    auto data = vec_data.data() + string_offsets[i];
+                    pks[i] = ReadVarChar(data);
+                }
+                pk_index_ = create_index(pks.data(), info.row_count);
+            } else {
+                pk_index_ = create_index((const int64_t*)vec_data.data(), info.row_count);
+            }
         }
 
         // write data under lock
@@ -154,6 +180,7 @@ SegmentSealedImpl::LoadFieldData(const LoadFieldDataInfo& info) {
         } else {
             AssertInfo(!scalar_indexings_[field_offset.get()], "scalar indexing not cleared");
             fields_data_[field_offset.get()] = std::move(vec_data);
+            string_offsets_[field_offset.get()] = std::move(string_offsets);
             scalar_indexings_[field_offset.get()] = std::move(index);
         }
 
@@ -170,11 +197,11 @@ SegmentSealedImpl::LoadDeletedRecord(const LoadDeletedRecordInfo& info) {
     AssertInfo(info.row_count > 0, "The row count of deleted record is 0");
     AssertInfo(info.primary_keys, "Deleted primary keys is null");
     AssertInfo(info.timestamps, "Deleted timestamps is null");
-    auto primary_keys = reinterpret_cast<const idx_t*>(info.primary_keys);
     auto timestamps = reinterpret_cast<const Timestamp*>(info.timestamps);
     int64_t size = info.row_count;
+    auto primary_keys = ParsePrimaryKeys(*schema_, info.primary_keys, size);
 
-    deleted_record_.uids_.set_data(0, primary_keys, size);
+    deleted_record_.pks_.set_data(0, primary_keys.data(), size);
     deleted_record_.timestamps_.set_data(0, timestamps, size);
     deleted_record_.ack_responder_.AddSegment(0, size);
     deleted_record_.reserved.fetch_add(size);
@@ -202,6 +229,7 @@ SegmentSealedImpl::chunk_data_impl(FieldOffset field_offset, int64_t chunk_id) c
     AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
                "Can't get bitset element at " + std::to_string(field_offset.get()));
     auto& field_meta = schema_->operator[](field_offset);
+    AssertInfo(!field_meta.is_string(), "varchar or json field has no span, use chunk_string_data instead");
     auto element_sizeof = field_meta.get_sizeof();
     SpanBase base(fields_data_[field_offset.get()].data(), row_count_opt_.value(), element_sizeof);
     return base;
@@ -221,7 +249,13 @@ SegmentSealedImpl::GetMemoryUsageInBytes() const {
     // TODO: add estimate for index
     std::shared_lock lck(mutex_);
     auto row_count = row_count_opt_.value_or(0);
-    return schema_->get_total_sizeof() * row_count;
+    int64_t total_bytes = schema_->get_total_sizeof() * row_count;
+    for (int64_t i = 0; i < schema_->size(); ++i) {
+        if (schema_->operator[](FieldOffset(i)).is_string()) {
+            total_bytes += fields_data_[i].size() + string_offsets_[i].size() * sizeof(int64_t);
+        }
+    }
+    return total_bytes;
 }
 
 int64_t
@@ -241,44 +275,46 @@ SegmentSealedImpl::get_deleted_bitmap(int64_t del_barrier,
                                       int64_t insert_barrier,
                                       bool force) const {
     auto old = deleted_record_.get_lru_entry();
+    if (old->del_barrier == del_barrier && old->bitmap_ptr->count() == insert_barrier) {
+        return old;
+    }
 
-    auto current = old->clone(insert_barrier);
+    // the cached bitmap is updated with the deletes after its barrier, deletes only add bits since
+    // a row is deleted once any delete is later than it; an older query rebuilds the bitmap
+    std::shared_ptr<DeletedRecord::TmpBitmap> current;
+    int64_t start = 0;
+    if (old->del_barrier < del_barrier && old->bitmap_ptr->count() == insert_barrier) {
+        current = old->clone(insert_barrier);
+        start = old->del_barrier;
+    } else {
+        current = std::make_shared<DeletedRecord::TmpBitmap>();
+        current->bitmap_ptr = std::make_shared<faiss::ConcurrentBitset>(insert_barrier);
+    }
     current->del_barrier = del_barrier;
     auto bitmap = current->bitmap_ptr;
-    // Sealed segment only has one chunk with chunk_id 0
-    auto span = deleted_record_.uids_.get_span_base(0);
-    auto uids_ptr = reinterpret_cast<const idx_t*>(span.data());
-    auto del_size = deleted_record_.reserved.load();
-    std::vector<idx_t> ids(del_size);
-    std::copy_n(uids_ptr, del_size, ids.data());
-
-    auto [uids, seg_offsets] = primary_key_index_->do_search_ids(ids);
-    for (int i = 0; i < uids.size(); ++i) {
-        bitmap->set(seg_offsets[i].get());
-    }
-    if (uids.size() == 0 || seg_offsets.size() == 0) {
-        return current;
-    }
 
-    if (del_barrier < old->del_barrier) {
-        for (auto del_index = del_barrier; del_index < old->del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
-            AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
-                bitmap->clear(the_offset);
-            }
+    // a delete only takes effect on the rows inserted before it, so the row written by
+    // an upsert, which shares the same timestamp with its delete, is kept
+    std::unordered_map<PkType, Timestamp> del_timestamps;
+    for (int64_t del_index = start; del_index < del_barrier; ++del_index) {
+        auto& pk = deleted_record_.pks_[del_index];
+        auto ts = deleted_record_.timestamps_[del_index];
+        auto [iter, inserted] = del_timestamps.emplace(pk, ts);
+        if (!inserted) {
+            iter->second = std::max(iter->second, ts);
         }
-        return current;
-    } else {
-        for (auto del_index = old->del_barrier; del_index < del_barrier; ++del_index) {
-            int64_t the_offset = seg_offsets[del_index].get();
+    }
+    // every row of the pk is checked, a pk written by several upserts has a row for each of them
+    for (auto& [pk, del_ts] : del_timestamps) {
+        for (auto& seg_offset : primary_key_index_->find_offsets(pk)) {
+            int64_t the_offset = seg_offset.get();
             AssertInfo(the_offset >= 0, "Seg offset is invalid");
-            if (deleted_record_.timestamps_[del_index] < query_timestamp) {
+            if (the_offset < insert_barrier && timestamps_[the_offset] < del_ts) {
                 bitmap->set(the_offset);
             }
         }
-        this->deleted_record_.insert_lru_entry(current);
     }
+    this->deleted_record_.insert_lru_entry(current);
     return current;
 }
 
@@ -398,9 +434,11 @@ SegmentSealedImpl::DropFieldData(const FieldId field_id) {
         std::unique_lock lck(mutex_);
         set_bit(field_data_ready_bitset_, field_offset, false);
         auto vec = std::move(fields_data_[field_offset.get()]);
+        auto string_offsets = std::move(string_offsets_[field_offset.get()]);
         lck.unlock();
 
         vec.clear();
+        string_offsets.clear();
     }
 }
 
@@ -447,9 +485,53 @@ SegmentSealedImpl::check_search(const query::Plan* plan) const {
     std::cout << log_prefix << "SegmentSealedImpl::check_search all done" << std::endl;
 }
 
+void
+SegmentSealedImpl::UpdateSchema(SchemaPtr schema, const void* default_row) {
+    std::unique_lock lck(mutex_);
+    AssertSchemaAppended(*schema_, *schema);
+    auto old_size = schema_->size();
+    fields_data_.resize(schema->size());
+    string_offsets_.resize(schema->size());
+    field_data_ready_bitset_.resize(schema->size());
+    vecindex_ready_bitset_.resize(schema->size());
+    scalar_indexings_.resize(schema->size());
+    schema_ = std::move(schema);
+    auto row_count_opt = row_count_opt_;
+    lck.unlock();
+
+    // the fields of the rows loaded later come from the binlogs
+    if (!row_count_opt.has_value()) {
+        return;
+    }
+    auto row_count = row_count_opt.value();
+    auto src = static_cast<const char*>(default_row);
+    for (auto offset = old_size; offset < schema_->size(); ++offset) {
+        auto& field_meta = (*schema_)[FieldOffset(offset)];
+        AssertInfo(!field_meta.is_vector(), "vector field can't be appended to the schema");
+        auto value = src;
+        if (field_meta.is_string()) {
+            ReadVarChar(src);
+        } else {
+            src += field_meta.get_sizeof();
+        }
+        auto element_sizeof = src - value;
+        aligned_vector<char> column(element_sizeof * row_count);
+        for (int64_t row = 0; row < row_count; ++row) {
+            memcpy(column.data() + row * element_sizeof, value, element_sizeof);
+        }
+
+        LoadFieldDataInfo info;
+        info.field_id = field_meta.get_id().get();
+        info.blob = column.data();
+        info.row_count = row_count;
+        LoadFieldData(info);
+    }
+}
+
 SegmentSealedImpl::SegmentSealedImpl(SchemaPtr schema, int64_t segment_id)
     : schema_(schema),
       fields_data_(schema->size()),
+      string_offsets_(schema->size()),
       field_data_ready_bitset_(schema->size()),
       vecindex_ready_bitset_(schema->size()),
       scalar_indexings_(schema->size()),
@@ -544,6 +626,34 @@ SegmentSealedImpl::bulk_subscript(FieldOffset field_offset,
     }
 }
 
+void
+SegmentSealedImpl::bulk_subscript_strings(FieldOffset field_offset,
+                                          const int64_t* seg_offsets,
+                                          int64_t count,
+                                          std::string* output) const {
+    if (!get_bit(field_data_ready_bitset_, field_offset)) {
+        return;
+    }
+    AssertInfo(schema_->operator[](field_offset).is_string(), "Field meta type isn't varchar or json type");
+    for (int64_t i = 0; i < count; ++i) {
+        auto offset = seg_offsets[i];
+        output[i] = (offset == INVALID_SEG_OFFSET ? std::string() : std::string(get_string(field_offset, offset)));
+    }
+}
+
+std::vector<std::string_view>
+SegmentSealedImpl::chunk_string_data(FieldOffset field_offset, int64_t chunk_id) const {
+    std::shared_lock lck(mutex_);
+    AssertInfo(chunk_id == 0, "Chunk_id is not equal to 0");
+    AssertInfo(get_bit(field_data_ready_bitset_, field_offset),
+               "Can't get bitset element at " + std::to_string(field_offset.get()));
+    std::vector<std::string_view> values(row_count_opt_.value());
+    for (int64_t i = 0; i < values.size(); ++i) {
+        values[i] = get_string(field_offset, i);
+    }
+    return values;
+}
+
 bool
 SegmentSealedImpl::HasIndex(FieldId field_id) const {
     std::shared_lock lck(mutex_);
@@ -566,8 +676,6 @@ SegmentSealedImpl::HasFieldData(FieldId field_id) const {
 
 std::pair<std::unique_ptr<IdArray>, std::vector<SegOffset>>
 SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) const {
-    AssertInfo(id_array.has_int_id(), "string ids are not implemented");
-    auto arr = id_array.int_id();
     AssertInfo(primary_key_index_, "Primary key index is null");
     return primary_key_index_->do_search_ids(id_array);
 }
@@ -575,24 +683,25 @@ SegmentSealedImpl::search_ids(const IdArray& id_array, Timestamp timestamp) cons
 Status
 SegmentSealedImpl::Delete(int64_t reserved_offset,
                           int64_t row_count,
-                          const int64_t* uids_raw,
+                          const void* primary_keys,
                           const Timestamp* timestamps_raw) {
-    std::vector<std::tuple<Timestamp, idx_t>> ordering(row_count);
+    auto pks_raw = ParsePrimaryKeys(*schema_, primary_keys, row_count);
+    std::vector<std::tuple<Timestamp, PkType>> ordering(row_count);
     for (int i = 0; i < row_count; i++) {
-        ordering[i] = std::make_tuple(timestamps_raw[i], uids_raw[i]);
+        ordering[i] = std::make_tuple(timestamps_raw[i], std::move(pks_raw[i]));
     }
     std::sort(ordering.begin(), ordering.end());
-    std::vector<idx_t> src_uids(row_count);
+    std::vector<PkType> src_pks(row_count);
     std::vector<Timestamp> src_timestamps(row_count);
 
     for (int i = 0; i < row_count; i++) {
-        auto [t, uid] = ordering[i];
+        auto& [t, pk] = ordering[i];
         src_timestamps[i] = t;
-        src_uids[i] = uid;
+        src_pks[i] = std::move(pk);
     }
     auto current_size = deleted_record_.record_size_;
     deleted_record_.timestamps_.set_data(reserved_offset, src_timestamps.data(), row_count);
-    deleted_record_.uids_.set_data(reserved_offset, src_uids.data(), row_count);
+    deleted_record_.pks_.set_data(reserved_offset, src_pks.data(), row_count);
     deleted_record_.ack_responder_.AddSegment(reserved_offset, row_count);
     return Status::OK();
 }
@@ -666,4 +775,14 @@ SegmentSealedImpl::mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, T
     bitset_chunk &= mask;
 }
 
+void
+SegmentSealedImpl::mask_with_expired(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_timestamp) const {
+    AssertInfo(bitset_chunk.size() <= this->timestamps_.size(), "Bitset size is larger than timestamp size");
+    for (int64_t i = 0; i < bitset_chunk.size(); ++i) {
+        if (this->timestamps_[i] < expire_timestamp) {
+            bitset_chunk[i] = false;
+        }
+    }
+}
+
 }  // namespace milvus::segcore
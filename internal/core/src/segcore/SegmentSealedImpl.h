@@ -15,6 +15,7 @@
 #include <map>
 #include <memory>
 #include <string>
+#include <string_view>
 #include <utility>
 #include <vector>
 #include <tbb/concurrent_priority_queue.h>
@@ -27,6 +28,7 @@
 #include "SealedIndexingRecord.h"
 #include "SegmentSealed.h"
 #include "TimestampIndex.h"
+#include "Utils.h"
 
 namespace milvus::segcore {
 
@@ -49,6 +51,8 @@ class SegmentSealedImpl : public SegmentSealed {
     HasIndex(FieldId field_id) const override;
     bool
     HasFieldData(FieldId field_id) const override;
+    void
+    UpdateSchema(SchemaPtr schema, const void* default_row) override;
 
  public:
     int64_t
@@ -84,7 +88,10 @@ class SegmentSealedImpl : public SegmentSealed {
     PreDelete(int64_t size) override;
 
     Status
-    Delete(int64_t reserved_offset, int64_t size, const int64_t* row_ids, const Timestamp* timestamps) override;
+    Delete(int64_t reserved_offset, int64_t size, const void* primary_keys, const Timestamp* timestamps) override;
+
+    std::vector<std::string_view>
+    chunk_string_data(FieldOffset field_offset, int64_t chunk_id) const override;
 
  protected:
     // blob and row_count
@@ -104,6 +111,12 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     bulk_subscript(FieldOffset field_offset, const int64_t* seg_offsets, int64_t count, void* output) const override;
 
+    void
+    bulk_subscript_strings(FieldOffset field_offset,
+                           const int64_t* seg_offsets,
+                           int64_t count,
+                           std::string* output) const override;
+
     void
     check_search(const query::Plan* plan) const override;
 
@@ -119,6 +132,13 @@ class SegmentSealedImpl : public SegmentSealed {
     bulk_subscript_impl(
         int64_t element_sizeof, const void* src_raw, const int64_t* seg_offsets, int64_t count, void* dst_raw);
 
+    // the varchar or json value of the row at seg_offset, caller should hold the lock
+    std::string_view
+    get_string(FieldOffset field_offset, int64_t seg_offset) const {
+        auto data = fields_data_[field_offset.get()].data() + string_offsets_[field_offset.get()][seg_offset];
+        return ReadVarChar(data);
+    }
+
     void
     update_row_count(int64_t row_count) {
         if (row_count_opt_.has_value()) {
@@ -131,6 +151,9 @@ class SegmentSealedImpl : public SegmentSealed {
     void
     mask_with_timestamps(boost::dynamic_bitset<>& bitset_chunk, Timestamp timestamp) const override;
 
+    void
+    mask_with_expired(boost::dynamic_bitset<>& bitset_chunk, Timestamp expire_timestamp) const override;
+
     void
     vector_search(int64_t vec_count,
                   query::SearchInfo search_info,
@@ -181,6 +204,8 @@ class SegmentSealedImpl : public SegmentSealed {
     std::unique_ptr<ScalarIndexBase> primary_key_index_;
 
     std::vector<aligned_vector<char>> fields_data_;
+    // the varchar and json values are stored as they are encoded in fields_data_, each of which starts at its offset
+    std::vector<std::vector<int64_t>> string_offsets_;
     mutable DeletedRecord deleted_record_;
 
     SealedIndexingRecord vecindexs_;
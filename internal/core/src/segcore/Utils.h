@@ -9,11 +9,18 @@
 // is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
 // or implied. See the License for the specific language governing permissions and limitations under the License
 
+#pragma once
+
 #include <stdlib.h>
+#include <cstring>
 #include <string>
+#include <string_view>
 #include <exception>
 #include <stdexcept>
+#include <vector>
 
+#include "common/Schema.h"
+#include "exceptions/EasyAssert.h"
 #include "index/thirdparty/faiss/MetricType.h"
 
 namespace milvus::segcore {
@@ -52,4 +59,53 @@ MetricTypeToString(faiss::MetricType metric_type) {
     }
 }
 
+// a varchar value or a serialized json document is encoded as its uint32 length followed by the bytes,
+// it must be kept the same as typeutil.EncodeVarChar. ReadVarChar moves data past the value
+static inline std::string_view
+ReadVarChar(const char*& data) {
+    uint32_t len;
+    memcpy(&len, data, sizeof(len));
+    std::string_view value(data + sizeof(len), len);
+    data += sizeof(len) + len;
+    return value;
+}
+
+static inline void
+WriteVarChar(std::string_view value, std::vector<char>& output) {
+    uint32_t len = value.size();
+    auto len_ptr = reinterpret_cast<const char*>(&len);
+    output.insert(output.end(), len_ptr, len_ptr + sizeof(len));
+    output.insert(output.end(), value.begin(), value.end());
+}
+
+// the primary keys to delete are int64 values, or the encoded varchar values for a varchar primary key
+static inline std::vector<PkType>
+ParsePrimaryKeys(const Schema& schema, const void* primary_keys, int64_t count) {
+    std::vector<PkType> pks;
+    pks.reserve(count);
+    auto pk_offset = schema.get_primary_key_offset();
+    if (pk_offset.has_value() && schema[pk_offset.value()].get_data_type() == DataType::VARCHAR) {
+        auto data = static_cast<const char*>(primary_keys);
+        for (int64_t i = 0; i < count; ++i) {
+            pks.emplace_back(std::string(ReadVarChar(data)));
+        }
+    } else {
+        auto data = static_cast<const int64_t*>(primary_keys);
+        for (int64_t i = 0; i < count; ++i) {
+            pks.emplace_back(data[i]);
+        }
+    }
+    return pks;
+}
+
+// AddField only appends fields to the schema, so the fields of the old schema keep their offsets in the new one
+static inline void
+AssertSchemaAppended(const Schema& old_schema, const Schema& schema) {
+    AssertInfo(schema.size() >= old_schema.size(), "fields can't be removed from the schema");
+    for (int64_t i = 0; i < old_schema.size(); ++i) {
+        AssertInfo(schema[FieldOffset(i)].get_id() == old_schema[FieldOffset(i)].get_id(),
+                   "field at offset " + std::to_string(i) + " is changed in the schema");
+    }
+}
+
 }  // namespace milvus::segcore
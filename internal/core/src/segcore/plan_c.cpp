@@ -116,6 +116,12 @@ GetMetricType(CSearchPlan plan) {
     return strdup(metric_str.c_str());
 }
 
+void
+SetSearchPlanExpireTimestamp(CSearchPlan plan, uint64_t expire_timestamp) {
+    auto search_plan = static_cast<milvus::query::Plan*>(plan);
+    search_plan->plan_node_->expire_timestamp_ = expire_timestamp;
+}
+
 void
 DeleteSearchPlan(CSearchPlan cPlan) {
     auto plan = (milvus::query::Plan*)cPlan;
@@ -179,6 +185,12 @@ CreateRetrievePlanByExpr(CCollection c_col, const char* serialized_expr_plan, in
     }
 }
 
+void
+SetRetrievePlanExpireTimestamp(CRetrievePlan c_plan, uint64_t expire_timestamp) {
+    auto retrieve_plan = static_cast<milvus::query::RetrievePlan*>(c_plan);
+    retrieve_plan->plan_node_->expire_timestamp_ = expire_timestamp;
+}
+
 void
 DeleteRetrievePlan(CRetrievePlan c_plan) {
     auto plan = (milvus::query::RetrievePlan*)c_plan;
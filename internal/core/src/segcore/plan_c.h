@@ -45,6 +45,9 @@ GetTopK(CSearchPlan plan);
 const char*
 GetMetricType(CSearchPlan plan);
 
+void
+SetSearchPlanExpireTimestamp(CSearchPlan plan, uint64_t expire_timestamp);
+
 void
 DeleteSearchPlan(CSearchPlan plan);
 
@@ -57,6 +60,9 @@ CreateRetrievePlan(CCollection c_col, CProto retrieve_request, CRetrievePlan* ou
 CStatus
 CreateRetrievePlanByExpr(CCollection c_col, const char* serialized_expr_plan, int64_t size, CRetrievePlan* res_plan);
 
+void
+SetRetrievePlanExpireTimestamp(CRetrievePlan plan, uint64_t expire_timestamp);
+
 void
 DeleteRetrievePlan(CRetrievePlan plan);
 
@@ -79,7 +79,7 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
     }
 
     std::vector<std::vector<int64_t>> search_records(num_segments);
-    std::unordered_set<int64_t> pk_set;
+    std::unordered_set<milvus::PkType> pk_set;
     int64_t skip_dup_cnt = 0;
 
     // reduce search results
@@ -110,15 +110,16 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             std::sort(result_pairs.begin(), result_pairs.end(), std::greater<>());
             auto& pilot = result_pairs[0];
             auto index = pilot.index_;
-            int64_t curr_pk = pilot.primary_key_;
+            auto curr_pk = pilot.primary_key_;
+            auto is_valid_pk = !std::holds_alternative<std::monostate>(curr_pk);
             // remove duplicates
-            if (curr_pk == INVALID_ID || pk_set.count(curr_pk) == 0) {
+            if (!is_valid_pk || pk_set.count(curr_pk) == 0) {
                 pilot.search_result_->result_offsets_.push_back(curr_offset++);
                 // when inserted data are dirty, it's possible that primary keys are duplicated,
                 // in this case, "offset_" may be greater than "offset_rb_" (#10530)
                 search_records[index].push_back(pilot.offset_ < pilot.offset_rb_ ? pilot.offset_ : INVALID_OFFSET);
-                if (curr_pk != INVALID_ID) {
-                    pk_set.insert(curr_pk);
+                if (is_valid_pk) {
+                    pk_set.insert(std::move(curr_pk));
                 }
             } else {
                 // skip entity with same primary key
@@ -137,18 +138,18 @@ ReduceResultData(std::vector<SearchResult*>& search_results, int64_t nq, int64_t
             continue;
         }
 
-        std::vector<int64_t> primary_keys;
+        std::vector<milvus::PkType> primary_keys;
         std::vector<float> distances;
         std::vector<int64_t> ids;
         for (int j = 0; j < search_records[i].size(); j++) {
             auto& offset = search_records[i][j];
-            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset] : INVALID_ID);
+            primary_keys.push_back(offset != INVALID_OFFSET ? search_result->primary_keys_[offset] : milvus::PkType());
             distances.push_back(offset != INVALID_OFFSET ? search_result->distances_[offset]
                                                          : std::numeric_limits<float>::max());
             ids.push_back(offset != INVALID_OFFSET ? search_result->ids_[offset] : INVALID_ID);
         }
 
-        search_result->primary_keys_ = primary_keys;
+        search_result->primary_keys_ = std::move(primary_keys);
         search_result->distances_ = distances;
         search_result->ids_ = ids;
     }
@@ -135,6 +135,20 @@ GetDeletedCount(CSegmentInterface c_segment) {
     return deleted_count;
 }
 
+CStatus
+LoadValidData(CSegmentInterface c_segment, int64_t field_id, int64_t offset, int64_t count, const bool* valid_data) {
+    try {
+        auto segment_interface = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto segment = dynamic_cast<milvus::segcore::SegmentInternalInterface*>(segment_interface);
+        AssertInfo(segment != nullptr, "segment conversion failed");
+        auto field_offset = segment->get_schema().get_offset(milvus::FieldId(field_id));
+        segment->set_valid_data(field_offset, offset, count, valid_data);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
+
 //////////////////////////////    interfaces for growing segment    //////////////////////////////
 CStatus
 Insert(CSegmentInterface c_segment,
@@ -143,14 +157,14 @@ Insert(CSegmentInterface c_segment,
        const int64_t* row_ids,
        const uint64_t* timestamps,
        void* raw_data,
-       int sizeof_per_row,
+       int64_t raw_data_size,
        int64_t count) {
     try {
         auto segment = (milvus::segcore::SegmentGrowing*)c_segment;
         milvus::segcore::RowBasedRawData dataChunk{};
 
         dataChunk.raw_data = raw_data;
-        dataChunk.sizeof_per_row = sizeof_per_row;
+        dataChunk.size = raw_data_size;
         dataChunk.count = count;
         segment->Insert(reserved_offset, size, row_ids, timestamps, dataChunk);
         return milvus::SuccessCStatus();
@@ -174,12 +188,12 @@ CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* primary_keys,
        const uint64_t* timestamps) {
     auto segment = (milvus::segcore::SegmentInterface*)c_segment;
 
     try {
-        auto res = segment->Delete(reserved_offset, size, row_ids, timestamps);
+        auto res = segment->Delete(reserved_offset, size, primary_keys, timestamps);
         return milvus::SuccessCStatus();
     } catch (std::exception& e) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
@@ -263,3 +277,15 @@ DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id) {
         return milvus::FailureCStatus(UnexpectedError, e.what());
     }
 }
+
+CStatus
+UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection, const void* default_row) {
+    try {
+        auto segment = reinterpret_cast<milvus::segcore::SegmentInterface*>(c_segment);
+        auto col = reinterpret_cast<milvus::segcore::Collection*>(c_collection);
+        segment->UpdateSchema(col->get_schema(), default_row);
+        return milvus::SuccessCStatus();
+    } catch (std::exception& e) {
+        return milvus::FailureCStatus(UnexpectedError, e.what());
+    }
+}
@@ -60,6 +60,9 @@ GetRowCount(CSegmentInterface c_segment);
 int64_t
 GetDeletedCount(CSegmentInterface c_segment);
 
+CStatus
+LoadValidData(CSegmentInterface c_segment, int64_t field_id, int64_t offset, int64_t count, const bool* valid_data);
+
 //////////////////////////////    interfaces for growing segment    //////////////////////////////
 CStatus
 Insert(CSegmentInterface c_segment,
@@ -68,7 +71,7 @@ Insert(CSegmentInterface c_segment,
        const int64_t* row_ids,
        const uint64_t* timestamps,
        void* raw_data,
-       int sizeof_per_row,
+       int64_t raw_data_size,
        int64_t count);
 
 CStatus
@@ -78,7 +81,7 @@ CStatus
 Delete(CSegmentInterface c_segment,
        int64_t reserved_offset,
        int64_t size,
-       const int64_t* row_ids,
+       const void* primary_keys,
        const uint64_t* timestamps);
 
 int64_t
@@ -100,6 +103,9 @@ DropFieldData(CSegmentInterface c_segment, int64_t field_id);
 CStatus
 DropSealedSegmentIndex(CSegmentInterface c_segment, int64_t field_id);
 
+CStatus
+UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection, const void* default_row);
+
 #ifdef __cplusplus
 }
 #endif
@@ -43,6 +43,8 @@ enum class DataType {
     DOUBLE = 11,
 
     STRING = 20,
+    VARCHAR = 21,
+    JSON = 23,
 
     VECTOR_BINARY = 100,
     VECTOR_FLOAT = 101,
@@ -204,12 +204,11 @@ TEST(CApiTest, InsertTest) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(res.error_code == Success);
 
     DeleteCollection(collection);
@@ -238,7 +237,6 @@ TEST(CApiTest, SearchTest) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t ts_offset = 1000;
     for (int i = 0; i < N; i++) {
@@ -248,7 +246,8 @@ TEST(CApiTest, SearchTest) {
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     ASSERT_EQ(ins_res.error_code, Success);
 
     const char* dsl_string = R"(
@@ -306,12 +305,12 @@ TEST(CApiTest, SearchTestWithExpr) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     ASSERT_EQ(ins_res.error_code, Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -358,12 +357,12 @@ TEST(CApiTest, RetrieveTestWithExpr) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     ASSERT_EQ(ins_res.error_code, Success);
 
     auto schema = ((milvus::segcore::Collection*)collection)->get_schema();
@@ -400,12 +399,11 @@ TEST(CApiTest, GetMemoryUsageInBytesTest) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
 
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(res.error_code == Success);
 
     auto memory_usage_size = GetMemoryUsageInBytes(segment);
@@ -442,11 +440,10 @@ TEST(CApiTest, GetRowCountTest) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(res.error_code == Success);
 
     auto row_count = GetRowCount(segment);
@@ -514,7 +511,7 @@ CheckSearchResultDuplicate(const std::vector<CSearchResult>& results) {
         }
         for (int j = 0; j < size; j++) {
             auto offset = search_result->result_offsets_[j];
-            result_pks[offset] = search_result->primary_keys_[j];
+            result_pks[offset] = std::get<int64_t>(search_result->primary_keys_[j]);
         }
     }
 
@@ -538,11 +535,11 @@ TEST(CApiTest, ReduceRemoveDuplicates) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -629,11 +626,11 @@ TEST(CApiTest, Reduce) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -712,11 +709,11 @@ TEST(CApiTest, ReduceSearchWithExpr) {
 
     int N = 10000;
     auto [raw_data, timestamps, uids] = generate_data(N);
-    auto line_sizeof = (sizeof(int) + sizeof(float) * DIM);
 
     int64_t offset;
     PreInsert(segment, N, &offset);
-    auto ins_res = Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int)line_sizeof, N);
+    auto ins_res =
+        Insert(segment, offset, N, uids.data(), timestamps.data(), raw_data.data(), (int64_t)raw_data.size(), N);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -891,7 +888,7 @@ TEST(CApiTest, Indexing_Without_Predicate) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"(
@@ -1015,7 +1012,7 @@ TEST(CApiTest, Indexing_Expr_Without_Predicate) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -1134,7 +1131,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1272,7 +1269,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Range) {
         int64_t offset;
         PreInsert(segment, N, &offset);
         auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                              dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                              dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
         assert(ins_res.error_code == Success);
     }
 
@@ -1422,7 +1419,7 @@ TEST(CApiTest, Indexing_With_float_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1557,7 +1554,7 @@ TEST(CApiTest, Indexing_Expr_With_float_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(
@@ -1701,7 +1698,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -1838,7 +1835,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Range) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
@@ -1988,7 +1985,7 @@ TEST(CApiTest, Indexing_With_binary_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* dsl_string = R"({
@@ -2129,7 +2126,7 @@ TEST(CApiTest, Indexing_Expr_With_binary_Predicate_Term) {
     int64_t offset;
     PreInsert(segment, N, &offset);
     auto ins_res = Insert(segment, offset, N, dataset.row_ids_.data(), dataset.timestamps_.data(),
-                          dataset.raw_.raw_data, dataset.raw_.sizeof_per_row, dataset.raw_.count);
+                          dataset.raw_.raw_data, dataset.raw_.size, dataset.raw_.count);
     assert(ins_res.error_code == Success);
 
     const char* serialized_expr_plan = R"(vector_anns: <
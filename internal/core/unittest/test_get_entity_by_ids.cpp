@@ -44,6 +44,22 @@ TEST(GetEntityByIds, ScalarIndex) {
     }
 }
 
+TEST(GetEntityByIds, VarCharScalarIndex) {
+    auto index = std::make_unique<ScalarIndexVector>();
+    // the keys differ only in the trailing '\0'
+    std::vector<std::string> data = {"b", "a", std::string("a\0", 2)};
+    index->append_data(data.data(), data.size(), SegOffset(0));
+    index->build();
+
+    auto req_ids = std::make_unique<IdArray>();
+    req_ids->mutable_str_id()->add_data(std::string("a\0", 2));
+    req_ids->mutable_str_id()->add_data("c");
+    auto [res_ids, res_offsets] = index->do_search_ids(*req_ids);
+    ASSERT_EQ(res_offsets.size(), 1);
+    ASSERT_EQ(res_offsets[0].get(), 2);
+    ASSERT_EQ(res_ids->str_id().data(0), std::string("a\0", 2));
+}
+
 TEST(GetEntityByIds, AUTOID) {
     auto schema = std::make_shared<Schema>();
     auto fid_64 = schema->AddDebugField("i64", DataType::INT64);
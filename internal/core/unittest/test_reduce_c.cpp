@@ -15,19 +15,26 @@
 #include "segcore/ReduceStructure.h"
 
 TEST(SearchResultPair, Greater) {
-    auto pair1 = SearchResultPair(0, 1.0, nullptr, 0, 0, 10);
-    auto pair2 = SearchResultPair(1, 2.0, nullptr, 1, 0, 10);
+    auto pair1 = SearchResultPair(int64_t(0), 1.0, nullptr, 0, 0, 10);
+    auto pair2 = SearchResultPair(int64_t(1), 2.0, nullptr, 1, 0, 10);
     ASSERT_EQ(pair1 > pair2, false);
 
-    pair1.primary_key_ = INVALID_ID;
-    pair2.primary_key_ = 1;
+    pair1.primary_key_ = std::monostate{};
+    pair2.primary_key_ = int64_t(1);
     ASSERT_EQ(pair1 > pair2, false);
 
-    pair1.primary_key_ = 0;
-    pair2.primary_key_ = INVALID_ID;
+    pair1.primary_key_ = int64_t(0);
+    pair2.primary_key_ = std::monostate{};
     ASSERT_EQ(pair1 > pair2, true);
 
-    pair1.primary_key_ = INVALID_ID;
-    pair2.primary_key_ = INVALID_ID;
+    pair1.primary_key_ = std::monostate{};
+    pair2.primary_key_ = std::monostate{};
     ASSERT_EQ(pair1 > pair2, false);
+
+    // varchar primary keys
+    pair1.primary_key_ = std::string("b");
+    pair2.primary_key_ = std::string("a");
+    ASSERT_EQ(pair1 > pair2, false);
+    pair2.primary_key_ = std::monostate{};
+    ASSERT_EQ(pair1 > pair2, true);
 }
@@ -385,3 +385,52 @@ TEST(Sealed, Delete) {
     segment->Delete(reserved_offset, new_count, reinterpret_cast<const int64_t*>(new_pks.data()),
                     reinterpret_cast<const Timestamp*>(new_timestamps.data()));
 }
+
+TEST(Sealed, DeleteUpsertedPk) {
+    auto dim = 16;
+    auto N = 6;
+    auto metric_type = MetricType::METRIC_L2;
+    auto schema = std::make_shared<Schema>();
+    auto fakevec_id = schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, dim, metric_type);
+    auto counter_id = schema->AddDebugField("counter", DataType::INT64);
+    schema->set_primary_key(FieldOffset(1));
+
+    // pk 1 is upserted 3 times, the rows are written at 1, 2 and 3
+    auto dataset = DataGen(schema, N);
+    auto pk_col = dataset.get_mutable_col<int64_t>(1);
+    std::vector<int64_t> upserted_pks{1, 1, 1, 4, 5, 6};
+    std::copy(upserted_pks.begin(), upserted_pks.end(), pk_col);
+    for (int i = 0; i < N; ++i) {
+        dataset.timestamps_[i] = i + 1;
+    }
+
+    auto segment = CreateSealedSegment(schema);
+    SealedLoader(dataset, *segment);
+
+    // the deletes of the upserts only delete the rows before them
+    int64_t row_count = 2;
+    std::vector<idx_t> pks{1, 1};
+    std::vector<Timestamp> timestamps{2, 3};
+    LoadDeletedRecordInfo info = {timestamps.data(), pks.data(), row_count};
+    segment->LoadDeletedRecord(info);
+
+    auto bitset = segment->get_filtered_bitmap(BitsetView(), N, 10);
+    ASSERT_EQ(bitset.size(), N);
+    ASSERT_TRUE(bitset.test(0));
+    ASSERT_TRUE(bitset.test(1));
+    ASSERT_FALSE(bitset.test(2));
+
+    // a delete after the last upsert deletes every row of the pk
+    int64_t new_count = 1;
+    std::vector<idx_t> new_pks{1};
+    std::vector<Timestamp> new_timestamps{5};
+    auto reserved_offset = segment->PreDelete(new_count);
+    ASSERT_EQ(reserved_offset, row_count);
+    segment->Delete(reserved_offset, new_count, new_pks.data(), new_timestamps.data());
+
+    bitset = segment->get_filtered_bitmap(BitsetView(), N, 10);
+    ASSERT_EQ(bitset.size(), N);
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ(bitset.test(i), i < 3);
+    }
+}
@@ -14,6 +14,7 @@
 #include <string>
 
 #include "segcore/SegmentGrowingImpl.h"
+#include "segcore/Utils.h"
 
 using namespace milvus;
 
@@ -85,7 +86,7 @@ TEST(SegmentCoreTest, MockTest) {
     // auto index_meta = std::make_shared<IndexMeta>(schema);
     auto segment = CreateGrowingSegment(schema);
 
-    RowBasedRawData data_chunk{raw_data.data(), (int)line_sizeof, N};
+    RowBasedRawData data_chunk{raw_data.data(), (int64_t)raw_data.size(), N};
     auto offset = segment->PreInsert(N);
     segment->Insert(offset, N, uids.data(), timestamps.data(), data_chunk);
     SearchResult search_result;
@@ -102,3 +103,83 @@ TEST(SegmentCoreTest, SmallIndex) {
     schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
     schema->AddDebugField("age", DataType::INT32);
 }
+
+TEST(SegmentCoreTest, UpdateSchema) {
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddDebugField("age", DataType::INT32);
+    int N = 10;
+    auto [raw_data, timestamps, uids] = generate_data(N);
+    auto segment = CreateGrowingSegment(schema);
+    RowBasedRawData data_chunk{raw_data.data(), (int64_t)raw_data.size(), N};
+    auto offset = segment->PreInsert(N);
+    segment->Insert(offset, N, uids.data(), timestamps.data(), data_chunk);
+
+    // the rows inserted take the default value of the appended field
+    auto new_schema = std::make_shared<Schema>(*schema);
+    new_schema->AddDebugField("score", DataType::INT64);
+    int64_t default_score = 7;
+    segment->UpdateSchema(new_schema, &default_score);
+    ASSERT_EQ(segment->get_schema().size(), 3);
+    auto growing = dynamic_cast<SegmentGrowingImpl*>(segment.get());
+    auto scores = growing->get_insert_record().get_field_data<int64_t>(FieldOffset(2));
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ((*scores)[i], default_score);
+    }
+
+    // the fields can't be removed
+    ASSERT_ANY_THROW(segment->UpdateSchema(schema, nullptr));
+}
+
+TEST(SegmentCoreTest, VarCharPrimaryKey) {
+    using namespace milvus::segcore;
+    auto schema = std::make_shared<Schema>();
+    schema->AddDebugField("fakevec", DataType::VECTOR_FLOAT, 16, MetricType::METRIC_L2);
+    schema->AddField(FieldName("pk"), FieldId(100), DataType::VARCHAR, 16);
+    schema->set_primary_key(FieldOffset(1));
+
+    // the keys differ only in the trailing '\0'
+    std::vector<std::string> pks = {"a", std::string("a\0", 2), "bc"};
+    int N = pks.size();
+    std::vector<char> raw_data;
+    std::vector<Timestamp> timestamps(N, 1);
+    std::vector<int64_t> uids;
+    for (int i = 0; i < N; ++i) {
+        uids.push_back(i);
+        float vec[16] = {0};
+        raw_data.insert(raw_data.end(), (const char*)std::begin(vec), (const char*)std::end(vec));
+        WriteVarChar(pks[i], raw_data);
+    }
+    auto segment = CreateGrowingSegment(schema);
+    RowBasedRawData data_chunk{raw_data.data(), (int64_t)raw_data.size(), N};
+    auto offset = segment->PreInsert(N);
+    segment->Insert(offset, N, uids.data(), timestamps.data(), data_chunk);
+
+    auto growing = dynamic_cast<SegmentGrowingImpl*>(segment.get());
+    auto values = growing->get_insert_record().get_field_data<std::string>(FieldOffset(1));
+    for (int i = 0; i < N; ++i) {
+        ASSERT_EQ((*values)[i], pks[i]);
+    }
+    IdArray ids;
+    ids.mutable_str_id()->add_data(pks[1]);
+    auto [res_ids, res_offsets] = growing->search_ids(ids, 2);
+    ASSERT_EQ(res_offsets.size(), 1);
+    ASSERT_EQ(res_offsets[0].get(), 1);
+    ASSERT_EQ(res_ids->str_id().data(0), pks[1]);
+
+    // only the row of the deleted key is deleted
+    std::vector<char> del_pks;
+    WriteVarChar(pks[1], del_pks);
+    Timestamp del_ts = 2;
+    auto del_offset = segment->PreDelete(1);
+    segment->Delete(del_offset, 1, del_pks.data(), &del_ts);
+    auto bitmap = growing->get_deleted_bitmap(1, del_ts, N)->bitmap_ptr;
+    ASSERT_FALSE(bitmap->test(0));
+    ASSERT_TRUE(bitmap->test(1));
+    ASSERT_FALSE(bitmap->test(2));
+
+    // the rows must take all the data
+    RowBasedRawData short_chunk{raw_data.data(), (int64_t)raw_data.size() - 1, N};
+    ASSERT_ANY_THROW(segment->Insert(segment->PreInsert(N), N, uids.data(), timestamps.data(), short_chunk));
+}
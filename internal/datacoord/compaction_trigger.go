@@ -25,6 +25,8 @@ import (
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"go.uber.org/zap"
 )
 
@@ -72,6 +74,7 @@ type compactionTrigger struct {
 	singleCompactionPolicy          singleCompactionPolicy
 	mergeCompactionPolicy           mergeCompactionPolicy
 	compactionHandler               compactionPlanContext
+	handler                         Handler
 	globalTrigger                   *time.Ticker
 	forceMu                         sync.Mutex
 	mergeCompactionSegmentThreshold int
@@ -79,7 +82,7 @@ type compactionTrigger struct {
 	wg                              sync.WaitGroup
 }
 
-func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator) *compactionTrigger {
+func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, allocator allocator, handler Handler) *compactionTrigger {
 	return &compactionTrigger{
 		meta:                            meta,
 		allocator:                       allocator,
@@ -87,6 +90,7 @@ func newCompactionTrigger(meta *meta, compactionHandler compactionPlanContext, a
 		singleCompactionPolicy:          (singleCompactionFunc)(chooseAllBinlogs),
 		mergeCompactionPolicy:           (mergeCompactionFunc)(greedyMergeCompaction),
 		compactionHandler:               compactionHandler,
+		handler:                         handler,
 		mergeCompactionSegmentThreshold: maxLittleSegmentNum,
 	}
 }
@@ -353,6 +357,8 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 	if len(plans) == 0 {
 		return nil
 	}
+	// the segments to merge are of the same channel, hence the same collection
+	ttl := t.getCollectionTTL(segments[0].GetCollectionID())
 
 	res := make([]*datapb.CompactionPlan, 0, len(plans))
 	for _, plan := range plans {
@@ -364,6 +370,7 @@ func (t *compactionTrigger) mergeCompaction(segments []*SegmentInfo, signal *com
 			log.Warn("failed to fill plan", zap.Error(err))
 			continue
 		}
+		plan.CollectionTtl = ttl.Nanoseconds()
 
 		log.Debug("exec merge compaction plan", zap.Any("plan", plan))
 		if err := t.compactionHandler.execCompactionPlan(signal, plan); err != nil {
@@ -414,7 +421,23 @@ func (t *compactionTrigger) fillOriginPlan(plan *datapb.CompactionPlan) error {
 	return nil
 }
 
-func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timetravel *timetravel) bool {
+// getCollectionTTL returns the ttl of the collection, zero means the entities never expire
+func (t *compactionTrigger) getCollectionTTL(collectionID UniqueID) time.Duration {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	coll := t.handler.GetCollection(ctx, collectionID)
+	if coll == nil {
+		return 0
+	}
+	ttl, err := funcutil.GetCollectionTTL(coll.GetProperties())
+	if err != nil {
+		log.Warn("invalid collection ttl", zap.Int64("collectionID", collectionID), zap.Error(err))
+		return 0
+	}
+	return ttl
+}
+
+func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timetravel *timetravel, ttl time.Duration) bool {
 	// single compaction only merge insert and delta log beyond the timetravel
 	// segment's insert binlogs dont have time range info, so we wait until the segment's last expire time is less than timetravel
 	// to ensure that all insert logs is beyond the timetravel.
@@ -435,7 +458,28 @@ func (t *compactionTrigger) shouldDoSingleCompaction(segment *SegmentInfo, timet
 	}
 
 	// currently delta log size and delete ratio policy is applied
-	return float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize
+	if float32(totalDeletedRows)/float32(segment.NumOfRows) >= singleCompactionRatioThreshold || totalDeleteLogSize > singleCompactionDeltaLogMaxSize {
+		return true
+	}
+
+	// the expired entities are dropped when their ratio reaches the threshold as well
+	return ttl > 0 && float32(countExpiredRows(segment, tsoutil.ComposeTSByTime(time.Now().Add(-ttl), 0)))/float32(segment.NumOfRows) >= singleCompactionRatioThreshold
+}
+
+// countExpiredRows returns the number of rows in the insert binlogs that are all inserted before expireTs
+func countExpiredRows(segment *SegmentInfo, expireTs Timestamp) int64 {
+	var expiredRows int64
+	// all the fields hold the same rows, so the binlogs of the first field are enough
+	for _, fbl := range segment.GetBinlogs() {
+		for _, l := range fbl.GetBinlogs() {
+			// the binlogs without time range info are skipped
+			if l.GetTimestampTo() != 0 && l.GetTimestampTo() < expireTs {
+				expiredRows += l.GetEntriesNum()
+			}
+		}
+		break
+	}
+	return expiredRows
 }
 
 func (t *compactionTrigger) globalSingleCompaction(segments []*SegmentInfo, isForce bool, signal *compactionSignal) []*datapb.CompactionPlan {
@@ -462,7 +506,8 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 		return nil, nil
 	}
 
-	if !isForce && !t.shouldDoSingleCompaction(segment, signal.timetravel) {
+	ttl := t.getCollectionTTL(segment.GetCollectionID())
+	if !isForce && !t.shouldDoSingleCompaction(segment, signal.timetravel, ttl) {
 		return nil, nil
 	}
 
@@ -474,6 +519,7 @@ func (t *compactionTrigger) singleCompaction(segment *SegmentInfo, isForce bool,
 	if err := t.fillOriginPlan(plan); err != nil {
 		return nil, err
 	}
+	plan.CollectionTtl = ttl.Nanoseconds()
 	return plan, t.compactionHandler.execCompactionPlan(signal, plan)
 }
 
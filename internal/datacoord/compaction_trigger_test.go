@@ -22,9 +22,11 @@ import (
 	"testing"
 	"time"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -278,6 +280,7 @@ func Test_compactionTrigger_forceTriggerCompaction(t *testing.T) {
 				singleCompactionPolicy: tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:  tt.fields.mergeCompactionPolicy,
 				compactionHandler:      tt.fields.compactionHandler,
+				handler:                newMockHandler(),
 				globalTrigger:          tt.fields.globalTrigger,
 			}
 			_, err := tr.forceTriggerCompaction(tt.args.collectionID, tt.args.timetravel)
@@ -671,6 +674,7 @@ func Test_compactionTrigger_triggerCompaction(t *testing.T) {
 				singleCompactionPolicy:          tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:           tt.fields.mergeCompactionPolicy,
 				compactionHandler:               tt.fields.compactionHandler,
+				handler:                         newMockHandler(),
 				mergeCompactionSegmentThreshold: tt.fields.mergeCompactionSegmentThreshold,
 			}
 			tr.start()
@@ -1064,6 +1068,7 @@ func Test_compactionTrigger_singleTriggerCompaction(t *testing.T) {
 				singleCompactionPolicy: tt.fields.singleCompactionPolicy,
 				mergeCompactionPolicy:  tt.fields.mergeCompactionPolicy,
 				compactionHandler:      tt.fields.compactionHandler,
+				handler:                newMockHandler(),
 				globalTrigger:          tt.fields.globalTrigger,
 			}
 			tr.start()
@@ -1096,6 +1101,7 @@ func Test_newCompactionTrigger(t *testing.T) {
 		meta              *meta
 		compactionHandler compactionPlanContext
 		allocator         allocator
+		handler           Handler
 	}
 	tests := []struct {
 		name string
@@ -1107,22 +1113,24 @@ func Test_newCompactionTrigger(t *testing.T) {
 				&meta{},
 				&compactionPlanHandler{},
 				newMockAllocator(),
+				newMockHandler(),
 			},
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator)
+			got := newCompactionTrigger(tt.args.meta, tt.args.compactionHandler, tt.args.allocator, tt.args.handler)
 			assert.Equal(t, tt.args.meta, got.meta)
 			assert.Equal(t, tt.args.compactionHandler, got.compactionHandler)
 			assert.Equal(t, tt.args.allocator, got.allocator)
+			assert.Equal(t, tt.args.handler, got.handler)
 		})
 	}
 }
 
 func Test_handleSignal(t *testing.T) {
 
-	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator())
+	got := newCompactionTrigger(&meta{segments: NewSegmentsInfo()}, &compactionPlanHandler{}, newMockAllocator(), newMockHandler())
 	signal := &compactionSignal{
 		segmentID: 1,
 	}
@@ -1130,3 +1138,47 @@ func Test_handleSignal(t *testing.T) {
 		got.handleSignal(signal)
 	})
 }
+
+func Test_shouldDoSingleCompactionWithTTL(t *testing.T) {
+	m := &meta{
+		segments: NewSegmentsInfo(),
+		collections: map[UniqueID]*datapb.CollectionInfo{
+			1: {
+				ID:         1,
+				Properties: []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}},
+			},
+		},
+	}
+	tr := newCompactionTrigger(m, &compactionPlanHandler{}, newMockAllocator(), newMockHandlerWithMeta(m))
+	ttl := tr.getCollectionTTL(1)
+	assert.Equal(t, time.Hour, ttl)
+	assert.Equal(t, time.Duration(0), tr.getCollectionTTL(2))
+
+	expiredTs := tsoutil.ComposeTSByTime(time.Now().Add(-2*time.Hour), 0)
+	aliveTs := tsoutil.GetCurrentTime()
+	segment := &SegmentInfo{
+		SegmentInfo: &datapb.SegmentInfo{
+			ID:           1,
+			CollectionID: 1,
+			NumOfRows:    100,
+			Binlogs: []*datapb.FieldBinlog{
+				{
+					FieldID: 1,
+					Binlogs: []*datapb.Binlog{
+						{EntriesNum: 10, TimestampFrom: expiredTs, TimestampTo: expiredTs},
+						{EntriesNum: 90, TimestampFrom: expiredTs, TimestampTo: aliveTs},
+					},
+				},
+			},
+		},
+	}
+	tt := &timetravel{time: aliveTs}
+	assert.Equal(t, int64(10), countExpiredRows(segment, tsoutil.ComposeTSByTime(time.Now().Add(-ttl), 0)))
+	assert.False(t, tr.shouldDoSingleCompaction(segment, tt, ttl))
+
+	segment.Binlogs[0].Binlogs[0].EntriesNum = 50
+	segment.Binlogs[0].Binlogs[1].EntriesNum = 50
+	assert.True(t, tr.shouldDoSingleCompaction(segment, tt, ttl))
+	// the entities never expire without ttl
+	assert.False(t, tr.shouldDoSingleCompaction(segment, tt, 0))
+}
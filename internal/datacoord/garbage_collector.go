@@ -57,6 +57,15 @@ type garbageCollector struct {
 	stopOnce  sync.Once
 	wg        sync.WaitGroup
 	closeCh   chan struct{}
+
+	pinMu sync.Mutex
+	pins  map[UniqueID]*segmentPin // pinID -> pin
+}
+
+// segmentPin protects the segments from being removed until it is released or expired
+type segmentPin struct {
+	segmentIDs map[UniqueID]struct{}
+	expireAt   time.Time
 }
 
 // newGarbageCollector create garbage collector with meta and option
@@ -67,6 +76,7 @@ func newGarbageCollector(meta *meta, opt GcOption) *garbageCollector {
 		meta:    meta,
 		option:  opt,
 		closeCh: make(chan struct{}),
+		pins:    make(map[UniqueID]*segmentPin),
 	}
 }
 
@@ -154,11 +164,64 @@ func (gc *garbageCollector) clearEtcd() {
 		if !gc.isExpire(sinfo.GetDroppedAt()) {
 			continue
 		}
-		logs := getLogs(sinfo)
-		if gc.removeLogs(logs) {
-			_ = gc.meta.DropSegment(sinfo.GetID())
+		gc.removeSegment(sinfo)
+	}
+}
+
+// removeSegment removes the logs and the meta of a dropped segment unless it's pinned,
+// the pin lock is held so that the segment is not pinned while it's being removed
+func (gc *garbageCollector) removeSegment(sinfo *SegmentInfo) {
+	gc.pinMu.Lock()
+	defer gc.pinMu.Unlock()
+	if gc.isPinned(sinfo.GetID()) {
+		return
+	}
+	logs := getLogs(sinfo)
+	if gc.removeLogs(logs) {
+		_ = gc.meta.DropSegment(sinfo.GetID())
+	}
+}
+
+// pinSegments protects all the segments of a collection in meta, including the dropped ones, from being removed
+// until unpinned or the lease expires, returns the pinned segments
+func (gc *garbageCollector) pinSegments(pinID UniqueID, collectionID UniqueID, lease time.Duration) []*SegmentInfo {
+	gc.pinMu.Lock()
+	defer gc.pinMu.Unlock()
+	segments := gc.meta.SelectSegments(func(segment *SegmentInfo) bool {
+		return segment.GetCollectionID() == collectionID
+	})
+	pin := &segmentPin{
+		segmentIDs: make(map[UniqueID]struct{}, len(segments)),
+		expireAt:   time.Now().Add(lease),
+	}
+	for _, segment := range segments {
+		pin.segmentIDs[segment.GetID()] = struct{}{}
+	}
+	gc.pins[pinID] = pin
+	return segments
+}
+
+// unpinSegments releases a pin, it's a no-op if the pin is not found or expired
+func (gc *garbageCollector) unpinSegments(pinID UniqueID) {
+	gc.pinMu.Lock()
+	defer gc.pinMu.Unlock()
+	delete(gc.pins, pinID)
+}
+
+// isPinned checks whether a segment is protected by any pin and removes the expired pins, caller should hold pinMu
+func (gc *garbageCollector) isPinned(segmentID UniqueID) bool {
+	now := time.Now()
+	pinned := false
+	for pinID, pin := range gc.pins {
+		if now.After(pin.expireAt) {
+			delete(gc.pins, pinID)
+			continue
+		}
+		if _, ok := pin.segmentIDs[segmentID]; ok {
+			pinned = true
 		}
 	}
+	return pinned
 }
 
 func (gc *garbageCollector) isExpire(dropts Timestamp) bool {
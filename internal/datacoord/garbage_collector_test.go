@@ -169,6 +169,16 @@ func Test_garbageCollector_scan(t *testing.T) {
 			bucketName:       bucketName,
 			rootPath:         rootPath,
 		})
+		// the pinned segment is not removed
+		pinned := gc.pinSegments(1000, 1, time.Hour)
+		assert.Equal(t, 1, len(pinned))
+		gc.clearEtcd()
+		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts)
+		assert.NotNil(t, meta.segments.GetSegment(100))
+		gc.unpinSegments(1000)
+
+		// the expired pin is ignored
+		gc.pinSegments(1001, 1, -time.Second)
 		gc.clearEtcd()
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, insertLogPrefix), inserts[1:])
 		validateMinioPrefixElements(t, cli, bucketName, path.Join(rootPath, statsLogPrefix), stats[1:])
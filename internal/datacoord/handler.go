@@ -33,6 +33,8 @@ type Handler interface {
 	GetVChanPositions(channel string, collectionID UniqueID, partitionID UniqueID) *datapb.VchannelInfo
 	CheckShouldDropChannel(channel string) bool
 	FinishDropChannel(channel string)
+	// GetCollection gets the collection info, it is loaded from RootCoord if absent in meta
+	GetCollection(ctx context.Context, collectionID UniqueID) *datapb.CollectionInfo
 }
 
 // ServerHandler is a helper of Server
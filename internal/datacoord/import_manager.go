@@ -0,0 +1,282 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"fmt"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+const importTaskPrefix = metaPrefix + "/import-task"
+
+func importTaskKey(taskID UniqueID) string {
+	return importTaskPrefix + "/" + strconv.FormatInt(taskID, 10)
+}
+
+func isImportFinished(state commonpb.ImportState) bool {
+	return state == commonpb.ImportState_ImportCompleted || state == commonpb.ImportState_ImportFailed
+}
+
+// importTaskState summarizes the states of the segments: the task is completed if all the segments are completed,
+// failed if any segment failed and the others are finished, otherwise it is still in progress
+func importTaskState(task *datapb.ImportTaskInfo) commonpb.ImportState {
+	finished, failed := 0, 0
+	for _, segment := range task.GetSegments() {
+		switch segment.GetState() {
+		case commonpb.ImportState_ImportCompleted:
+			finished++
+		case commonpb.ImportState_ImportFailed:
+			finished++
+			failed++
+		}
+	}
+	switch {
+	case finished < len(task.GetSegments()):
+		for _, segment := range task.GetSegments() {
+			if segment.GetState() != commonpb.ImportState_ImportPending {
+				return commonpb.ImportState_ImportStarted
+			}
+		}
+		return commonpb.ImportState_ImportPending
+	case failed > 0:
+		return commonpb.ImportState_ImportFailed
+	default:
+		return commonpb.ImportState_ImportCompleted
+	}
+}
+
+// groupImportFiles groups the files to import by the segment size limit, and returns the total size of each group.
+// The row based files are packed into groups of at most maxSize bytes unless a single file is larger,
+// the column based files are the columns of the same rows so they are always a single group
+func groupImportFiles(rowBased bool, files []string, sizes []int64, maxSize int64) ([][]string, []int64) {
+	if !rowBased {
+		var total int64
+		for _, size := range sizes {
+			total += size
+		}
+		return [][]string{files}, []int64{total}
+	}
+
+	var groups [][]string
+	var groupSizes []int64
+	for i, file := range files {
+		last := len(groups) - 1
+		if last >= 0 && groupSizes[last]+sizes[i] <= maxSize {
+			groups[last] = append(groups[last], file)
+			groupSizes[last] += sizes[i]
+			continue
+		}
+		groups = append(groups, []string{file})
+		groupSizes = append(groupSizes, sizes[i])
+	}
+	return groups, groupSizes
+}
+
+// importManager keeps the states of the import tasks, the states are persisted in the kv so they survive restarts.
+// A task fails if it's not finished before the timeout, and it's removed after the retention once finished
+type importManager struct {
+	mu        sync.RWMutex
+	kv        kv.BaseKV
+	tasks     map[UniqueID]*datapb.ImportTaskInfo // taskID -> task
+	timeout   time.Duration
+	retention time.Duration
+}
+
+func newImportManager(kv kv.BaseKV, timeout, retention time.Duration) (*importManager, error) {
+	m := &importManager{
+		kv:        kv,
+		tasks:     make(map[UniqueID]*datapb.ImportTaskInfo),
+		timeout:   timeout,
+		retention: retention,
+	}
+	_, values, err := kv.LoadWithPrefix(importTaskPrefix)
+	if err != nil {
+		return nil, err
+	}
+	for _, value := range values {
+		task := &datapb.ImportTaskInfo{}
+		if err := proto.Unmarshal([]byte(value), task); err != nil {
+			return nil, fmt.Errorf("DataCoord failed to unmarshal datapb.ImportTaskInfo, err: %w", err)
+		}
+		m.tasks[task.GetTaskID()] = task
+	}
+	return m, nil
+}
+
+// saveTask persists the task, caller should hold the lock
+func (m *importManager) saveTask(task *datapb.ImportTaskInfo) error {
+	if task.GetFinishTime() == 0 && isImportFinished(importTaskState(task)) {
+		task.FinishTime = time.Now().Unix()
+	}
+	value, err := proto.Marshal(task)
+	if err != nil {
+		return err
+	}
+	return m.kv.Save(importTaskKey(task.GetTaskID()), string(value))
+}
+
+// addTask records a new import task
+func (m *importManager) addTask(task *datapb.ImportTaskInfo) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if task.GetCreateTime() == 0 {
+		task.CreateTime = time.Now().Unix()
+	}
+	if err := m.saveTask(task); err != nil {
+		return err
+	}
+	m.tasks[task.GetTaskID()] = task
+	return nil
+}
+
+// setTaskState sets the state of all the unfinished segments of a task
+func (m *importManager) setTaskState(taskID UniqueID, state commonpb.ImportState, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return fmt.Errorf("import task %d not found", taskID)
+	}
+	for _, segment := range task.GetSegments() {
+		if isImportFinished(segment.GetState()) {
+			continue
+		}
+		segment.State = state
+		segment.Reason = reason
+	}
+	return m.saveTask(task)
+}
+
+// getSegment returns the copies of the task and the unfinished segment the result reported for
+func (m *importManager) getSegment(taskID, segmentID UniqueID) (*datapb.ImportTaskInfo, *datapb.ImportSegmentInfo, error) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return nil, nil, fmt.Errorf("import task %d not found", taskID)
+	}
+	for _, segment := range task.GetSegments() {
+		if segment.GetSegmentID() != segmentID {
+			continue
+		}
+		if isImportFinished(segment.GetState()) {
+			return nil, nil, fmt.Errorf("segment %d of import task %d is already finished", segmentID, taskID)
+		}
+		return proto.Clone(task).(*datapb.ImportTaskInfo), proto.Clone(segment).(*datapb.ImportSegmentInfo), nil
+	}
+	return nil, nil, fmt.Errorf("segment %d not found in import task %d", segmentID, taskID)
+}
+
+// completeSegment records the result of a segment reported by DataNode
+func (m *importManager) completeSegment(result *datapb.ImportResult, reason string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	task, ok := m.tasks[result.GetTaskID()]
+	if !ok {
+		return fmt.Errorf("import task %d not found", result.GetTaskID())
+	}
+	for _, segment := range task.GetSegments() {
+		if segment.GetSegmentID() != result.GetSegmentID() {
+			continue
+		}
+		if reason != "" {
+			segment.State = commonpb.ImportState_ImportFailed
+			segment.Reason = reason
+		} else {
+			segment.State = commonpb.ImportState_ImportCompleted
+			segment.Reason = ""
+			segment.NumOfRows = result.GetNumOfRows()
+		}
+		return m.saveTask(task)
+	}
+	return fmt.Errorf("segment %d not found in import task %d", result.GetSegmentID(), result.GetTaskID())
+}
+
+// expireTasks fails the tasks not finished before the timeout, and removes the tasks finished for longer than the retention
+func (m *importManager) expireTasks(now time.Time) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for taskID, task := range m.tasks {
+		if task.GetFinishTime() == 0 {
+			if now.Sub(time.Unix(task.GetCreateTime(), 0)) < m.timeout {
+				continue
+			}
+			log.Warn("import task timeout", zap.Int64("taskID", taskID), zap.Duration("timeout", m.timeout))
+			for _, segment := range task.GetSegments() {
+				if !isImportFinished(segment.GetState()) {
+					segment.State = commonpb.ImportState_ImportFailed
+					segment.Reason = fmt.Sprintf("import task timeout after %s", m.timeout)
+				}
+			}
+			task.FinishTime = now.Unix()
+			if err := m.saveTask(task); err != nil {
+				log.Warn("failed to save import task", zap.Int64("taskID", taskID), zap.Error(err))
+			}
+			continue
+		}
+		if now.Sub(time.Unix(task.GetFinishTime(), 0)) < m.retention {
+			continue
+		}
+		if err := m.kv.Remove(importTaskKey(taskID)); err != nil {
+			log.Warn("failed to remove import task", zap.Int64("taskID", taskID), zap.Error(err))
+			continue
+		}
+		delete(m.tasks, taskID)
+	}
+}
+
+// getTaskState fills the state of a task into the response, returns false if the task is not found
+func (m *importManager) getTaskState(taskID UniqueID, resp *milvuspb.GetImportStateResponse) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	task, ok := m.tasks[taskID]
+	if !ok {
+		return false
+	}
+	resp.State = importTaskState(task)
+	resp.CollectionID = task.GetCollectionID()
+	resp.RowCount = 0
+	resp.SegmentIDs = nil
+	resp.FileStates = make([]*milvuspb.ImportFileState, 0, len(task.GetSegments()))
+	for _, segment := range task.GetSegments() {
+		if segment.GetState() == commonpb.ImportState_ImportCompleted {
+			resp.RowCount += segment.GetNumOfRows()
+			if segment.GetNumOfRows() > 0 {
+				resp.SegmentIDs = append(resp.SegmentIDs, segment.GetSegmentID())
+			}
+		}
+		resp.FileStates = append(resp.FileStates, &milvuspb.ImportFileState{
+			Files:     segment.GetFiles(),
+			State:     segment.GetState(),
+			Reason:    segment.GetReason(),
+			RowCount:  segment.GetNumOfRows(),
+			SegmentID: segment.GetSegmentID(),
+		})
+	}
+	return true
+}
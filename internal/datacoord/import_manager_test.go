@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datacoord
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+)
+
+func TestImportTaskState(t *testing.T) {
+	newTask := func(states ...commonpb.ImportState) *datapb.ImportTaskInfo {
+		task := &datapb.ImportTaskInfo{TaskID: 1}
+		for i, state := range states {
+			task.Segments = append(task.Segments, &datapb.ImportSegmentInfo{SegmentID: int64(i), State: state})
+		}
+		return task
+	}
+
+	assert.Equal(t, commonpb.ImportState_ImportPending,
+		importTaskState(newTask(commonpb.ImportState_ImportPending, commonpb.ImportState_ImportPending)))
+	assert.Equal(t, commonpb.ImportState_ImportStarted,
+		importTaskState(newTask(commonpb.ImportState_ImportPending, commonpb.ImportState_ImportStarted)))
+	assert.Equal(t, commonpb.ImportState_ImportStarted,
+		importTaskState(newTask(commonpb.ImportState_ImportFailed, commonpb.ImportState_ImportStarted)))
+	assert.Equal(t, commonpb.ImportState_ImportFailed,
+		importTaskState(newTask(commonpb.ImportState_ImportFailed, commonpb.ImportState_ImportCompleted)))
+	assert.Equal(t, commonpb.ImportState_ImportCompleted,
+		importTaskState(newTask(commonpb.ImportState_ImportCompleted, commonpb.ImportState_ImportCompleted)))
+}
+
+func TestGroupImportFiles(t *testing.T) {
+	files := []string{"a.json", "b.json", "c.json", "d.json"}
+	groups, sizes := groupImportFiles(true, files, []int64{3, 4, 20, 5}, 10)
+	assert.Equal(t, [][]string{{"a.json", "b.json"}, {"c.json"}, {"d.json"}}, groups)
+	assert.Equal(t, []int64{7, 20, 5}, sizes)
+
+	// the column based files are always a single group
+	files = []string{"a.npy", "b.npy"}
+	groups, sizes = groupImportFiles(false, files, []int64{20, 30}, 10)
+	assert.Equal(t, [][]string{files}, groups)
+	assert.Equal(t, []int64{50}, sizes)
+}
+
+func TestImportManager(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newImportManager(kv, time.Hour, time.Hour)
+	require.NoError(t, err)
+	err = m.addTask(&datapb.ImportTaskInfo{
+		TaskID:       1,
+		CollectionID: 10,
+		Segments: []*datapb.ImportSegmentInfo{
+			{SegmentID: 100, Files: []string{"a.json"}, State: commonpb.ImportState_ImportStarted},
+			{SegmentID: 101, Files: []string{"b.json"}, State: commonpb.ImportState_ImportStarted},
+			{SegmentID: 102, Files: []string{"c.json"}, State: commonpb.ImportState_ImportStarted},
+		},
+	})
+	assert.Nil(t, err)
+
+	_, segment, err := m.getSegment(1, 101)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b.json"}, segment.GetFiles())
+	_, _, err = m.getSegment(1, 200)
+	assert.NotNil(t, err)
+	_, _, err = m.getSegment(2, 100)
+	assert.NotNil(t, err)
+
+	assert.Nil(t, m.completeSegment(&datapb.ImportResult{TaskID: 1, SegmentID: 100, NumOfRows: 5}, ""))
+	assert.Nil(t, m.completeSegment(&datapb.ImportResult{TaskID: 1, SegmentID: 101}, "parse error"))
+	assert.NotNil(t, m.completeSegment(&datapb.ImportResult{TaskID: 1, SegmentID: 200}, ""))
+	assert.NotNil(t, m.completeSegment(&datapb.ImportResult{TaskID: 2, SegmentID: 100}, ""))
+	// the finished segment can't be reported again
+	_, _, err = m.getSegment(1, 100)
+	assert.NotNil(t, err)
+
+	resp := &milvuspb.GetImportStateResponse{}
+	assert.True(t, m.getTaskState(1, resp))
+	assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+	assert.EqualValues(t, 10, resp.GetCollectionID())
+	assert.EqualValues(t, 5, resp.GetRowCount())
+	assert.Equal(t, []int64{100}, resp.GetSegmentIDs())
+	assert.Equal(t, 3, len(resp.GetFileStates()))
+	assert.Equal(t, "parse error", resp.GetFileStates()[1].GetReason())
+
+	// the finished segments are not changed
+	assert.Nil(t, m.setTaskState(1, commonpb.ImportState_ImportFailed, "datanode down"))
+	assert.NotNil(t, m.setTaskState(2, commonpb.ImportState_ImportFailed, "datanode down"))
+	assert.True(t, m.getTaskState(1, resp))
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.GetFileStates()[0].GetState())
+	assert.Equal(t, "parse error", resp.GetFileStates()[1].GetReason())
+	assert.Equal(t, "datanode down", resp.GetFileStates()[2].GetReason())
+
+	assert.False(t, m.getTaskState(2, resp))
+
+	// the states are reloaded from the kv
+	m, err = newImportManager(kv, time.Hour, time.Hour)
+	require.NoError(t, err)
+	assert.True(t, m.getTaskState(1, resp))
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	assert.EqualValues(t, 5, resp.GetRowCount())
+}
+
+func TestImportManager_ExpireTasks(t *testing.T) {
+	kv := memkv.NewMemoryKV()
+	m, err := newImportManager(kv, time.Hour, 2*time.Hour)
+	require.NoError(t, err)
+	now := time.Now()
+	err = m.addTask(&datapb.ImportTaskInfo{
+		TaskID:     1,
+		CreateTime: now.Unix(),
+		Segments: []*datapb.ImportSegmentInfo{
+			{SegmentID: 100, State: commonpb.ImportState_ImportCompleted},
+			{SegmentID: 101, State: commonpb.ImportState_ImportStarted},
+		},
+	})
+	assert.Nil(t, err)
+
+	m.expireTasks(now.Add(time.Minute))
+	resp := &milvuspb.GetImportStateResponse{}
+	assert.True(t, m.getTaskState(1, resp))
+	assert.Equal(t, commonpb.ImportState_ImportStarted, resp.GetState())
+
+	// the unfinished segments fail after the timeout
+	m.expireTasks(now.Add(time.Hour))
+	assert.True(t, m.getTaskState(1, resp))
+	assert.Equal(t, commonpb.ImportState_ImportFailed, resp.GetState())
+	assert.Equal(t, commonpb.ImportState_ImportCompleted, resp.GetFileStates()[0].GetState())
+	assert.NotEmpty(t, resp.GetFileStates()[1].GetReason())
+
+	// the finished task is removed after the retention
+	m.expireTasks(now.Add(2 * time.Hour))
+	assert.True(t, m.getTaskState(1, resp))
+	m.expireTasks(now.Add(3 * time.Hour))
+	assert.False(t, m.getTaskState(1, resp))
+	_, values, err := kv.LoadWithPrefix(importTaskPrefix)
+	assert.Nil(t, err)
+	assert.Empty(t, values)
+}
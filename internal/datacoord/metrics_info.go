@@ -19,11 +19,13 @@ package datacoord
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 	"go.uber.org/zap"
@@ -106,6 +108,7 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 		SystemConfigurations: metricsinfo.DataCoordConfiguration{
 			SegmentMaxSize: Params.DataCoordCfg.SegmentMaxSize,
 		},
+		QuotaMetrics: s.getQuotaMetrics(),
 	}
 
 	metricsinfo.FillDeployMetricsWithEnv(&ret.BaseComponentInfos.SystemInfo)
@@ -113,6 +116,60 @@ func (s *Server) getDataCoordMetrics() metricsinfo.DataCoordInfos {
 	return ret
 }
 
+// getQuotaMetrics composes the metrics used by RootCoord to apply the backpressure of DML
+func (s *Server) getQuotaMetrics() metricsinfo.DataCoordQuotaMetrics {
+	ret := metricsinfo.DataCoordQuotaMetrics{}
+
+	// only the channels which are being watched by DataNodes are taken into account
+	if s.channelManager != nil {
+		now := time.Now()
+		for _, info := range s.channelManager.GetChannels() {
+			for _, ch := range info.Channels {
+				ts, ok := s.channelTimeTicks.Load(ch.Name)
+				if !ok {
+					continue
+				}
+				physical, _ := tsoutil.ParseTS(ts.(Timestamp))
+				if delay := now.Sub(physical); delay > ret.MaxTimeTickDelay {
+					ret.MaxTimeTickDelay = delay
+				}
+			}
+		}
+	}
+
+	if s.meta != nil {
+		sizePerRecords := make(map[UniqueID]int64)
+		segments := s.meta.SelectSegments(func(segment *SegmentInfo) bool {
+			state := segment.GetState()
+			return state == commonpb.SegmentState_Growing || state == commonpb.SegmentState_Sealed ||
+				state == commonpb.SegmentState_Flushing
+		})
+		for _, segment := range segments {
+			sizePerRecord, ok := sizePerRecords[segment.GetCollectionID()]
+			if !ok {
+				collection := s.meta.GetCollection(segment.GetCollectionID())
+				if collection == nil {
+					continue
+				}
+				size, err := typeutil.EstimateSizePerRecord(collection.GetSchema())
+				if err != nil {
+					log.Warn("failed to estimate size per record", zap.Int64("collectionID", segment.GetCollectionID()), zap.Error(err))
+					continue
+				}
+				sizePerRecord = int64(size)
+				sizePerRecords[segment.GetCollectionID()] = sizePerRecord
+			}
+			// currRows is reported by DataNodes and not persisted, so it may lag behind after DataCoord restarts
+			rows := segment.currRows
+			if rows < segment.GetNumOfRows() {
+				rows = segment.GetNumOfRows()
+			}
+			ret.UnflushedBytes += rows * sizePerRecord
+		}
+	}
+	return ret
+}
+
 // getDataNodeMetrics composes DataNode infos
 // this function will invoke GetMetrics with DataNode specified in NodeInfo
 func (s *Server) getDataNodeMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *Session) (metricsinfo.DataNodeInfos, error) {
@@ -20,11 +20,14 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -112,3 +115,42 @@ func TestGetDataNodeMetrics(t *testing.T) {
 	assert.True(t, info.HasError)
 
 }
+
+func TestGetQuotaMetrics(t *testing.T) {
+	svr := newTestServer(t, nil)
+	defer closeTestServer(t, svr)
+
+	metrics := svr.getQuotaMetrics()
+	assert.Equal(t, time.Duration(0), metrics.MaxTimeTickDelay)
+	assert.Equal(t, int64(0), metrics.UnflushedBytes)
+
+	schema := newTestSchema()
+	svr.meta.AddCollection(&datapb.CollectionInfo{ID: 0, Schema: schema})
+	sizePerRecord, err := typeutil.EstimateSizePerRecord(schema)
+	assert.Nil(t, err)
+	segments := []*datapb.SegmentInfo{
+		{ID: 1, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Growing, NumOfRows: 100},
+		{ID: 2, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Sealed, NumOfRows: 200},
+		{ID: 3, CollectionID: 0, InsertChannel: "ch1", State: commonpb.SegmentState_Flushed, NumOfRows: 300},
+		// the collection is unknown
+		{ID: 4, CollectionID: 1, InsertChannel: "ch2", State: commonpb.SegmentState_Growing, NumOfRows: 400},
+	}
+	for _, segment := range segments {
+		err := svr.meta.AddSegment(NewSegmentInfo(segment))
+		assert.Nil(t, err)
+	}
+	svr.meta.SetCurrentRows(1, 150)
+
+	err = svr.channelManager.AddNode(0)
+	assert.Nil(t, err)
+	err = svr.channelManager.Watch(&channel{"ch1", 0})
+	assert.Nil(t, err)
+	svr.channelTimeTicks.Store("ch1", tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0))
+	// the channel is not watched
+	svr.channelTimeTicks.Store("ch2", tsoutil.ComposeTSByTime(time.Now().Add(-time.Hour), 0))
+
+	metrics = svr.getQuotaMetrics()
+	assert.True(t, metrics.MaxTimeTickDelay >= time.Minute)
+	assert.True(t, metrics.MaxTimeTickDelay < time.Hour)
+	assert.Equal(t, int64((150+200)*sizePerRecord), metrics.UnflushedBytes)
+}
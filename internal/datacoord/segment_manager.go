@@ -80,6 +80,8 @@ type Manager interface {
 	ExpireAllocations(channel string, ts Timestamp) error
 	// DropSegmentsOfChannel drops all segments in a channel
 	DropSegmentsOfChannel(ctx context.Context, channel string)
+	// AllocImportSegments allocates the segments to import files of numBytes bytes into by the segment size limit
+	AllocImportSegments(ctx context.Context, collectionID UniqueID, channelName string, numBytes int64) ([]UniqueID, error)
 }
 
 // Allocation records the allocation info
@@ -273,6 +275,38 @@ func (s *SegmentManager) AllocSegment(ctx context.Context, collectionID UniqueID
 	return allocations, nil
 }
 
+// AllocImportSegments allocates a segment for every segmentMaxBytes bytes of the imported files, at least one.
+// The segments are added as flushed segments once the files are imported
+func (s *SegmentManager) AllocImportSegments(ctx context.Context, collectionID UniqueID, channelName string, numBytes int64) ([]UniqueID, error) {
+	sp, _ := trace.StartSpanFromContext(ctx)
+	defer sp.Finish()
+
+	maxBytes := segmentMaxBytes()
+	num := int((numBytes + maxBytes - 1) / maxBytes)
+	if num < 1 {
+		num = 1
+	}
+	segmentIDs := make([]UniqueID, 0, num)
+	for i := 0; i < num; i++ {
+		id, err := s.allocator.allocID(ctx)
+		if err != nil {
+			return nil, err
+		}
+		segmentIDs = append(segmentIDs, id)
+	}
+	log.Debug("datacoord: alloc import segments",
+		zap.Int64("CollectionID", collectionID),
+		zap.String("Channel", channelName),
+		zap.Int64("Bytes", numBytes),
+		zap.Int64s("SegmentIDs", segmentIDs))
+	return segmentIDs, nil
+}
+
+// segmentMaxBytes returns the segment size limit in bytes
+func segmentMaxBytes() int64 {
+	return int64(Params.DataCoordCfg.SegmentMaxSize * 1024 * 1024)
+}
+
 func satisfy(segment *SegmentInfo, collectionID, partitionID UniqueID, channel string) bool {
 	return segment.GetCollectionID() == collectionID && segment.GetPartitionID() == partitionID &&
 		segment.GetInsertChannel() == channel
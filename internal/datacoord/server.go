@@ -28,7 +28,9 @@ import (
 
 	datanodeclient "github.com/milvus-io/milvus/internal/distributed/datanode/client"
 	rootcoordclient "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
+	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
+	miniokv "github.com/milvus-io/milvus/internal/kv/minio"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
 	"github.com/milvus-io/milvus/internal/msgstream"
@@ -53,6 +55,9 @@ import (
 const (
 	connEtcdMaxRetryTime = 100000
 	allPartitionID       = 0 // paritionID means no filtering
+
+	// importExpireInterval is the interval to check the timeout and the retention of the import tasks
+	importExpireInterval = time.Minute
 )
 
 var (
@@ -104,6 +109,7 @@ type Server struct {
 
 	etcdCli          *clientv3.Client
 	kvClient         *etcdkv.EtcdKV
+	blobKv           kv.DataKV
 	meta             *meta
 	segmentManager   Manager
 	allocator        allocator
@@ -118,8 +124,13 @@ type Server struct {
 	compactionTrigger trigger
 	compactionHandler compactionPlanContext
 
+	importManager *importManager
+
 	metricsCacheManager *metricsinfo.MetricsCacheManager
 
+	// channelTimeTicks records the latest time tick reported by DataNodes of each channel, channel name -> Timestamp
+	channelTimeTicks sync.Map
+
 	flushCh   chan UniqueID
 	msFactory msgstream.Factory
 
@@ -271,6 +282,10 @@ func (s *Server) Start() error {
 		return err
 	}
 
+	if err = s.initBlobKv(); err != nil {
+		return err
+	}
+
 	s.handler = newServerHandler(s)
 
 	if err = s.initCluster(); err != nil {
@@ -331,7 +346,7 @@ func (s *Server) stopCompactionHandler() {
 }
 
 func (s *Server) createCompactionTrigger() {
-	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator)
+	s.compactionTrigger = newCompactionTrigger(s.meta, s.compactionHandler, s.allocator, s.handler)
 	s.compactionTrigger.start()
 }
 
@@ -425,17 +440,36 @@ func (s *Server) initMeta() error {
 		if err != nil {
 			return err
 		}
+		s.importManager, err = newImportManager(s.kvClient, Params.DataCoordCfg.ImportTaskTimeout, Params.DataCoordCfg.ImportTaskRetention)
+		if err != nil {
+			return err
+		}
 		return nil
 	}
 	return retry.Do(s.ctx, reloadEtcdFn, retry.Attempts(connEtcdMaxRetryTime))
 }
 
+// initBlobKv connects the object storage, which holds the files to import
+func (s *Server) initBlobKv() error {
+	var err error
+	s.blobKv, err = miniokv.NewMinIOKV(s.ctx, &miniokv.Option{
+		Address:           Params.MinioCfg.Address,
+		AccessKeyID:       Params.MinioCfg.AccessKeyID,
+		SecretAccessKeyID: Params.MinioCfg.SecretAccessKey,
+		UseSSL:            Params.MinioCfg.UseSSL,
+		BucketName:        Params.MinioCfg.BucketName,
+		CreateBucket:      true,
+	})
+	return err
+}
+
 func (s *Server) startServerLoop() {
 	s.serverLoopCtx, s.serverLoopCancel = context.WithCancel(s.ctx)
-	s.serverLoopWg.Add(3)
+	s.serverLoopWg.Add(4)
 	s.startDataNodeTtLoop(s.serverLoopCtx)
 	s.startWatchService(s.serverLoopCtx)
 	s.startFlushLoop(s.serverLoopCtx)
+	s.startImportLoop(s.serverLoopCtx)
 	s.garbageCollector.start()
 }
 
@@ -505,6 +539,7 @@ func (s *Server) handleTimetickMessage(ctx context.Context, ttMsg *msgstream.Dat
 		// if lag behind, log every 1 mins about
 		log.RatedWarn(60.0, "time tick lag behind for more than 1 minutes", zap.String("channel", ch), zap.Time("timetick", physical))
 	}
+	s.channelTimeTicks.Store(ch, ts)
 
 	s.updateSegmentStatistics(ttMsg.GetSegmentsStats())
 
@@ -686,6 +721,25 @@ func (s *Server) startFlushLoop(ctx context.Context) {
 	}()
 }
 
+// startImportLoop starts a goroutine to fail the timeout import tasks and remove the expired ones
+func (s *Server) startImportLoop(ctx context.Context) {
+	go func() {
+		defer logutil.LogPanic()
+		defer s.serverLoopWg.Done()
+		ticker := time.NewTicker(importExpireInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				logutil.Logger(s.ctx).Debug("import loop shutdown")
+				return
+			case now := <-ticker.C:
+				s.importManager.expireTasks(now)
+			}
+		}
+	}()
+}
+
 // post function after flush is done
 // 1. check segment id is valid
 // 2. notify RootCoord segment is flushed
@@ -820,6 +874,7 @@ func (s *Server) loadCollectionFromRootCoord(ctx context.Context, collectionID i
 		Schema:         resp.Schema,
 		Partitions:     presp.PartitionIDs,
 		StartPositions: resp.GetStartPositions(),
+		Properties:     resp.GetProperties(),
 	}
 	s.meta.AddCollection(collInfo)
 	return nil
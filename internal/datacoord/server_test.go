@@ -24,6 +24,7 @@ import (
 	"os"
 	"path"
 	"strconv"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -748,6 +749,11 @@ func (s *spySegmentManager) DropSegmentsOfChannel(ctx context.Context, channel s
 	s.spyCh <- struct{}{}
 }
 
+// AllocImportSegments allocates the segments to import files of numBytes bytes into by the segment size limit
+func (s *spySegmentManager) AllocImportSegments(ctx context.Context, collectionID UniqueID, channelName string, numBytes int64) ([]UniqueID, error) {
+	panic("not implemented") // TODO: Implement
+}
+
 func TestSaveBinlogPaths(t *testing.T) {
 	t.Run("Normal SaveRequest", func(t *testing.T) {
 		svr := newTestServer(t, nil)
@@ -2230,6 +2236,313 @@ func TestGetFlushState(t *testing.T) {
 	})
 }
 
+func TestImport(t *testing.T) {
+	t.Run("import with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.Import(context.TODO(), &milvuspb.ImportRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("import with invalid files", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateHealthy
+
+		resp, err := svr.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "test",
+			RowBased:       true,
+			Files:          []string{"a.npy"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("import without datanode", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.blobKv = memkv.NewMemoryKV()
+		err := svr.blobKv.Save("a.json", "{}")
+		assert.Nil(t, err)
+
+		resp, err := svr.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "test",
+			RowBased:       true,
+			Files:          []string{"a.json"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("import file not exist", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.blobKv = memkv.NewMemoryKV()
+		svr.sessionManager.AddSession(&NodeInfo{NodeID: 0, Address: "localhost:8080"})
+
+		resp, err := svr.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "test",
+			RowBased:       true,
+			Files:          []string{"a.json"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+	})
+
+	t.Run("import and report", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+		svr.sessionManager.AddSession(&NodeInfo{NodeID: 0, Address: "localhost:8080"})
+		svr.blobKv = memkv.NewMemoryKV()
+		err := svr.blobKv.Save("a.json", strings.Repeat("a", 10))
+		assert.Nil(t, err)
+		err = svr.blobKv.Save("b.json", strings.Repeat("b", 15))
+		assert.Nil(t, err)
+		// a.json is imported into a segment, and b.json is split into two segments
+		maxSize := Params.DataCoordCfg.SegmentMaxSize
+		Params.DataCoordCfg.SegmentMaxSize = 10.0 / 1024 / 1024
+		defer func() { Params.DataCoordCfg.SegmentMaxSize = maxSize }()
+
+		resp, err := svr.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "test",
+			RowBased:       true,
+			Files:          []string{"a.json", "b.json"},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+
+		stateResp, err := svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: resp.GetTaskID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, stateResp.GetStatus().GetErrorCode())
+		assert.Equal(t, commonpb.ImportState_ImportStarted, stateResp.GetState())
+		assert.EqualValues(t, 1314, stateResp.GetCollectionID())
+		files := stateResp.GetFileStates()
+		assert.Equal(t, 3, len(files))
+		assert.Equal(t, []string{"a.json"}, files[0].GetFiles())
+		assert.Equal(t, []string{"b.json"}, files[1].GetFiles())
+		assert.Equal(t, []string{"b.json"}, files[2].GetFiles())
+
+		status, err := svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			TaskID:    resp.GetTaskID(),
+			SegmentID: files[0].GetSegmentID(),
+			NumOfRows: 10,
+			InsertLogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "/by-dev/test/0/1/1/1/1"}}},
+			},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		segment := svr.meta.GetSegment(files[0].GetSegmentID())
+		assert.NotNil(t, segment)
+		assert.Equal(t, commonpb.SegmentState_Flushed, segment.GetState())
+		assert.EqualValues(t, 10, segment.GetNumOfRows())
+		assert.EqualValues(t, 1314, segment.GetCollectionID())
+
+		// a finished segment can't be reported again
+		status, err = svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			TaskID:    resp.GetTaskID(),
+			SegmentID: files[0].GetSegmentID(),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		// the empty segment is not added
+		status, err = svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			TaskID:    resp.GetTaskID(),
+			SegmentID: files[1].GetSegmentID(),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Nil(t, svr.meta.GetSegment(files[1].GetSegmentID()))
+
+		status, err = svr.ReportImport(context.TODO(), &datapb.ImportResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "mock error"},
+			TaskID:    resp.GetTaskID(),
+			SegmentID: files[2].GetSegmentID(),
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Nil(t, svr.meta.GetSegment(files[2].GetSegmentID()))
+
+		stateResp, err = svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: resp.GetTaskID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, stateResp.GetState())
+		assert.EqualValues(t, 10, stateResp.GetRowCount())
+		assert.Equal(t, []int64{files[0].GetSegmentID()}, stateResp.GetSegmentIDs())
+		assert.Equal(t, "mock error", stateResp.GetFileStates()[2].GetReason())
+
+		// the state is reloaded from the kv
+		svr.importManager, err = newImportManager(svr.kvClient, time.Hour, time.Hour)
+		assert.Nil(t, err)
+		stateResp, err = svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: resp.GetTaskID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ImportState_ImportFailed, stateResp.GetState())
+		assert.EqualValues(t, 10, stateResp.GetRowCount())
+
+		// unknown task
+		status, err = svr.ReportImport(context.TODO(), &datapb.ImportResult{TaskID: -1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+		stateResp, err = svr.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: -1})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, stateResp.GetStatus().GetErrorCode())
+	})
+}
+
+func TestAddFlushedSegments(t *testing.T) {
+	t.Run("add flushed segments with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		status, err := svr.AddFlushedSegments(context.TODO(), &datapb.AddFlushedSegmentsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("add flushed segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		segment := &datapb.SegmentInfo{
+			ID:            100,
+			CollectionID:  1314,
+			PartitionID:   0,
+			InsertChannel: "vchan1",
+			NumOfRows:     10,
+			Binlogs: []*datapb.FieldBinlog{
+				{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: "/by-dev/test/1314/0/100/100/1"}}},
+			},
+		}
+		status, err := svr.AddFlushedSegments(context.TODO(), &datapb.AddFlushedSegmentsRequest{
+			CollectionID: 1314,
+			Segments:     []*datapb.SegmentInfo{segment},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		info := svr.meta.GetSegment(100)
+		assert.NotNil(t, info)
+		assert.Equal(t, commonpb.SegmentState_Flushed, info.GetState())
+		assert.EqualValues(t, 10, info.GetNumOfRows())
+		assert.Equal(t, 1, len(info.GetBinlogs()))
+
+		// the segment already exists
+		status, err = svr.AddFlushedSegments(context.TODO(), &datapb.AddFlushedSegmentsRequest{
+			CollectionID: 1314,
+			Segments:     []*datapb.SegmentInfo{segment},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+
+		// the segment belongs to another collection
+		status, err = svr.AddFlushedSegments(context.TODO(), &datapb.AddFlushedSegmentsRequest{
+			CollectionID: 1315,
+			Segments:     []*datapb.SegmentInfo{{ID: 101, CollectionID: 1314}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+}
+
+func TestBroadcastAlteredCollection(t *testing.T) {
+	t.Run("broadcast altered collection with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		status, err := svr.BroadcastAlteredCollection(context.TODO(), &datapb.AlterCollectionRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("broadcast altered collection", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		old := &datapb.CollectionInfo{ID: 1314, Properties: []*commonpb.KeyValuePair{{Key: "k", Value: "v1"}}}
+		svr.meta.AddCollection(old)
+		properties := []*commonpb.KeyValuePair{{Key: "k", Value: "v2"}}
+		status, err := svr.BroadcastAlteredCollection(context.TODO(), &datapb.AlterCollectionRequest{
+			CollectionID: 1314,
+			Properties:   properties,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Equal(t, properties, svr.meta.GetCollection(1314).GetProperties())
+		assert.Equal(t, "v1", old.GetProperties()[0].GetValue())
+
+		// the collection not cached is loaded on demand
+		status, err = svr.BroadcastAlteredCollection(context.TODO(), &datapb.AlterCollectionRequest{
+			CollectionID: 1315,
+			Properties:   properties,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		assert.Nil(t, svr.meta.GetCollection(1315))
+	})
+}
+
+func TestPinSegments(t *testing.T) {
+	t.Run("pin segments with closed server", func(t *testing.T) {
+		svr := &Server{}
+		svr.isServing = ServerStateStopped
+
+		resp, err := svr.PinSegments(context.TODO(), &datapb.PinSegmentsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+		status, err := svr.UnpinSegments(context.TODO(), &datapb.UnpinSegmentsRequest{})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, status.GetErrorCode())
+	})
+
+	t.Run("pin and unpin segments", func(t *testing.T) {
+		svr := newTestServer(t, nil)
+		defer closeTestServer(t, svr)
+
+		flushed := buildSegment(1314, 0, 100, "vchan1")
+		flushed.State = commonpb.SegmentState_Flushed
+		dropped := buildSegment(1314, 0, 101, "vchan1")
+		dropped.State = commonpb.SegmentState_Dropped
+		for _, segment := range []*SegmentInfo{flushed, dropped, buildSegment(1315, 0, 102, "vchan2")} {
+			require.NoError(t, svr.meta.AddSegment(segment))
+		}
+
+		resp, err := svr.PinSegments(context.TODO(), &datapb.PinSegmentsRequest{CollectionID: 1314})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, resp.GetStatus().GetErrorCode())
+
+		resp, err = svr.PinSegments(context.TODO(), &datapb.PinSegmentsRequest{CollectionID: 1314, LeaseSeconds: 60})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.GetStatus().GetErrorCode())
+		var segmentIDs []int64
+		for _, segment := range resp.GetSegments() {
+			segmentIDs = append(segmentIDs, segment.GetID())
+		}
+		assert.ElementsMatch(t, []int64{100, 101}, segmentIDs)
+		svr.garbageCollector.pinMu.Lock()
+		assert.True(t, svr.garbageCollector.isPinned(101))
+		assert.False(t, svr.garbageCollector.isPinned(102))
+		svr.garbageCollector.pinMu.Unlock()
+
+		status, err := svr.UnpinSegments(context.TODO(), &datapb.UnpinSegmentsRequest{PinID: resp.GetPinID()})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.GetErrorCode())
+		svr.garbageCollector.pinMu.Lock()
+		assert.False(t, svr.garbageCollector.isPinned(101))
+		svr.garbageCollector.pinMu.Unlock()
+	})
+}
+
 func newTestServer(t *testing.T, receiveCh chan interface{}, opts ...Option) *Server {
 	Params.Init()
 	Params.DataCoordCfg.TimeTickChannelName = Params.DataCoordCfg.TimeTickChannelName + strconv.Itoa(rand.Int())
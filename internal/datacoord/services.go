@@ -23,6 +23,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/logutil"
@@ -30,6 +31,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"go.uber.org/zap"
@@ -959,3 +961,403 @@ func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateR
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 	return resp, nil
 }
+
+// Import splits the files into segments and assigns them to a DataNode, the DataNode imports the files in the background
+func (s *Server) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	log.Debug("received import request", zap.String("collectionName", req.GetCollectionName()),
+		zap.String("partitionName", req.GetPartitionName()), zap.Bool("rowBased", req.GetRowBased()), zap.Strings("files", req.GetFiles()))
+
+	resp := &milvuspb.ImportResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to import because of closed server", zap.String("collectionName", req.GetCollectionName()))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	if err := importutil.ValidateFiles(req.GetRowBased(), req.GetFiles()); err != nil {
+		resp.Status.ErrorCode = commonpb.ErrorCode_IllegalArgument
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	task, err := s.newImportTask(ctx, req)
+	if err != nil {
+		log.Warn("failed to create import task", zap.String("collectionName", req.GetCollectionName()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	sessions := s.sessionManager.GetSessions()
+	if len(sessions) == 0 {
+		resp.Status.Reason = "no available DataNode to import"
+		return resp, nil
+	}
+	nodeID := sessions[int(task.GetTaskID())%len(sessions)].info.NodeID
+
+	info := &datapb.ImportTaskInfo{
+		TaskID:       task.GetTaskID(),
+		CollectionID: task.GetCollectionID(),
+		PartitionID:  task.GetPartitionID(),
+		NodeID:       nodeID,
+	}
+	for _, segment := range task.GetSegments() {
+		for _, segmentID := range segment.GetSegmentIDs() {
+			info.Segments = append(info.Segments, &datapb.ImportSegmentInfo{
+				SegmentID:   segmentID,
+				ChannelName: segment.GetChannelName(),
+				Files:       segment.GetFiles(),
+				State:       commonpb.ImportState_ImportStarted,
+			})
+		}
+	}
+	if err := s.importManager.addTask(info); err != nil {
+		log.Warn("failed to save import task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	if err := s.sessionManager.Import(ctx, nodeID, task); err != nil {
+		if err := s.importManager.setTaskState(task.GetTaskID(), commonpb.ImportState_ImportFailed, err.Error()); err != nil {
+			log.Warn("failed to save import task", zap.Int64("taskID", task.GetTaskID()), zap.Error(err))
+		}
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to assign import task", zap.Int64("taskID", task.GetTaskID()), zap.Int64("nodeID", nodeID),
+		zap.Int("segments", len(task.GetSegments())))
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.TaskID = task.GetTaskID()
+	return resp, nil
+}
+
+// newImportTask resolves the collection and the partition of the request, groups the files by the segment size limit
+// and allocates the segments of each group by the segment manager
+func (s *Server) newImportTask(ctx context.Context, req *milvuspb.ImportRequest) (*datapb.ImportTask, error) {
+	dresp, err := s.rootCoordClient.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeCollection,
+			SourceID: Params.DataCoordCfg.NodeID,
+		},
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+	})
+	if err = VerifyResponse(dresp, err); err != nil {
+		return nil, err
+	}
+	channels := dresp.GetVirtualChannelNames()
+	if len(channels) == 0 {
+		return nil, fmt.Errorf("collection %s has no channels", req.GetCollectionName())
+	}
+
+	presp, err := s.rootCoordClient.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_ShowPartitions,
+			SourceID: Params.DataCoordCfg.NodeID,
+		},
+		DbName:         req.GetDbName(),
+		CollectionName: req.GetCollectionName(),
+		CollectionID:   dresp.GetCollectionID(),
+	})
+	if err = VerifyResponse(presp, err); err != nil {
+		return nil, err
+	}
+	partitionName := req.GetPartitionName()
+	if partitionName == "" {
+		partitionName = Params.CommonCfg.DefaultPartitionName
+	}
+	partitionID := int64(-1)
+	for i, name := range presp.GetPartitionNames() {
+		if name == partitionName && i < len(presp.GetPartitionIDs()) {
+			partitionID = presp.GetPartitionIDs()[i]
+			break
+		}
+	}
+	if partitionID < 0 {
+		return nil, fmt.Errorf("partition %s does not exist in collection %s", partitionName, req.GetCollectionName())
+	}
+
+	taskID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		return nil, err
+	}
+	ts, err := s.allocator.allocTimestamp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sizes := make([]int64, 0, len(req.GetFiles()))
+	for _, file := range req.GetFiles() {
+		size, err := s.blobKv.GetSize(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the size of file %s, err: %w", file, err)
+		}
+		sizes = append(sizes, size)
+	}
+	fileGroups, groupSizes := groupImportFiles(req.GetRowBased(), req.GetFiles(), sizes, segmentMaxBytes())
+	segments := make([]*datapb.ImportSegment, 0, len(fileGroups))
+	for i, files := range fileGroups {
+		channel := channels[i%len(channels)]
+		segmentIDs, err := s.segmentManager.AllocImportSegments(ctx, dresp.GetCollectionID(), channel, groupSizes[i])
+		if err != nil {
+			return nil, err
+		}
+		segments = append(segments, &datapb.ImportSegment{
+			SegmentIDs:  segmentIDs,
+			ChannelName: channel,
+			Files:       files,
+		})
+	}
+
+	return &datapb.ImportTask{
+		Base: &commonpb.MsgBase{
+			SourceID: Params.DataCoordCfg.NodeID,
+		},
+		TaskID:       taskID,
+		CollectionID: dresp.GetCollectionID(),
+		PartitionID:  partitionID,
+		Schema:       dresp.GetSchema(),
+		RowBased:     req.GetRowBased(),
+		Segments:     segments,
+		Timestamp:    ts,
+	}, nil
+}
+
+// GetImportState gets the state of an import task and its segments
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Debug("received get import state request", zap.Int64("taskID", req.GetTaskID()))
+
+	resp := &milvuspb.GetImportStateResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to get import state because of closed server", zap.Int64("taskID", req.GetTaskID()))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	if !s.importManager.getTaskState(req.GetTaskID(), resp) {
+		resp.Status.Reason = fmt.Sprintf("import task %d not found", req.GetTaskID())
+		return resp, nil
+	}
+
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// ReportImport records the result of a segment imported by DataNode, the segment is added as a flushed segment if it succeeded
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	log.Debug("receive import result", zap.Int64("taskID", req.GetTaskID()), zap.Int64("segmentID", req.GetSegmentID()),
+		zap.Any("errorCode", req.GetStatus().GetErrorCode()), zap.Int64("numOfRows", req.GetNumOfRows()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to report import result", zap.Int64("taskID", req.GetTaskID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	task, segment, err := s.importManager.getSegment(req.GetTaskID(), req.GetSegmentID())
+	if err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	// the segment is left empty if the files have less rows than segments
+	if req.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success || req.GetNumOfRows() == 0 {
+		if err := s.importManager.completeSegment(req, req.GetStatus().GetReason()); err != nil {
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+		resp.ErrorCode = commonpb.ErrorCode_Success
+		return resp, nil
+	}
+
+	info := &datapb.SegmentInfo{
+		ID:            req.GetSegmentID(),
+		CollectionID:  task.GetCollectionID(),
+		PartitionID:   task.GetPartitionID(),
+		InsertChannel: segment.GetChannelName(),
+		NumOfRows:     req.GetNumOfRows(),
+		Binlogs:       req.GetInsertLogs(),
+		Statslogs:     req.GetField2StatslogPaths(),
+	}
+	if err := s.addFlushedSegment(ctx, info); err != nil {
+		log.Error("failed to add imported segment", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		if err := s.importManager.completeSegment(req, err.Error()); err != nil {
+			log.Warn("failed to record import result", zap.Int64("segmentID", req.GetSegmentID()), zap.Error(err))
+		}
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+	if err := s.importManager.completeSegment(req, ""); err != nil {
+		resp.Reason = err.Error()
+		return resp, nil
+	}
+
+	log.Debug("success to add imported segment", zap.Int64("taskID", req.GetTaskID()), zap.Int64("segmentID", req.GetSegmentID()))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// AddFlushedSegments adds the segments whose binlogs are already in the object storage as flushed segments
+func (s *Server) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	log.Debug("receive add flushed segments request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segments", len(req.GetSegments())))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to add flushed segments", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	for _, segment := range req.GetSegments() {
+		if segment.GetCollectionID() != req.GetCollectionID() {
+			resp.Reason = fmt.Sprintf("segment %d does not belong to collection %d", segment.GetID(), req.GetCollectionID())
+			return resp, nil
+		}
+		if s.meta.GetSegment(segment.GetID()) != nil {
+			resp.Reason = fmt.Sprintf("segment %d already exists", segment.GetID())
+			return resp, nil
+		}
+	}
+	for _, segment := range req.GetSegments() {
+		if err := s.addFlushedSegment(ctx, segment); err != nil {
+			log.Error("failed to add flushed segment", zap.Int64("segmentID", segment.GetID()), zap.Error(err))
+			resp.Reason = err.Error()
+			return resp, nil
+		}
+	}
+
+	log.Debug("success to add flushed segments", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segments", len(req.GetSegments())))
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// addFlushedSegment saves a segment whose binlogs are written without the flowgraph of DataNode,
+// the segment carries the start position of the channel so that it is visible in the recovery info
+func (s *Server) addFlushedSegment(ctx context.Context, info *datapb.SegmentInfo) error {
+	if s.meta.GetCollection(info.GetCollectionID()) == nil {
+		if err := s.loadCollectionFromRootCoord(ctx, info.GetCollectionID()); err != nil {
+			log.Warn("failed to load collection from rootcoord", zap.Int64("collectionID", info.GetCollectionID()), zap.Error(err))
+		}
+	}
+	var position *internalpb.MsgPosition
+	if collection := s.meta.GetCollection(info.GetCollectionID()); collection != nil {
+		position = getCollectionStartPosition(info.GetInsertChannel(), collection)
+	}
+
+	info = proto.Clone(info).(*datapb.SegmentInfo)
+	info.State = commonpb.SegmentState_Flushed
+	info.MaxRowNum = info.GetNumOfRows()
+	info.StartPosition = position
+	info.DmlPosition = position
+	return s.meta.AddSegment(NewSegmentInfo(info))
+}
+
+// PinSegments protects all the segments of a collection, including the dropped ones still in meta,
+// from the garbage collection until they are unpinned or the lease expires, and returns the pinned segments
+func (s *Server) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	log.Debug("receive pin segments request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("leaseSeconds", req.GetLeaseSeconds()))
+
+	resp := &datapb.PinSegmentsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to pin segments", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)))
+		resp.Status.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+	if req.GetLeaseSeconds() <= 0 {
+		resp.Status.Reason = fmt.Sprintf("invalid lease %d seconds", req.GetLeaseSeconds())
+		return resp, nil
+	}
+
+	pinID, err := s.allocator.allocID(ctx)
+	if err != nil {
+		log.Warn("failed to alloc pin ID", zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	segments := s.garbageCollector.pinSegments(pinID, req.GetCollectionID(), time.Duration(req.GetLeaseSeconds())*time.Second)
+	resp.Segments = make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		resp.Segments = append(resp.Segments, segment.SegmentInfo)
+	}
+
+	log.Debug("success to pin segments", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int64("pinID", pinID), zap.Int("segments", len(segments)))
+	resp.PinID = pinID
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// UnpinSegments releases the segments pinned by PinSegments
+func (s *Server) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	log.Debug("receive unpin segments request", zap.Int64("pinID", req.GetPinID()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to unpin segments", zap.Int64("pinID", req.GetPinID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	s.garbageCollector.unpinSegments(req.GetPinID())
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
+
+// BroadcastAlteredCollection refreshes the properties of the collection cached by DataCoord after it's altered,
+// nothing is done if the collection is not cached since it's loaded from RootCoord on demand
+func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	log.Debug("receive broadcast altered collection request", zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Any("properties", req.GetProperties()))
+
+	resp := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+
+	if s.isClosed() {
+		log.Warn("failed to broadcast altered collection", zap.Int64("collectionID", req.GetCollectionID()),
+			zap.Error(errDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)))
+		resp.Reason = msgDataCoordIsUnhealthy(Params.DataCoordCfg.NodeID)
+		return resp, nil
+	}
+
+	if collection := s.meta.GetCollection(req.GetCollectionID()); collection != nil {
+		// the cached info may be in use, so replace it instead of changing it in place
+		collection = proto.Clone(collection).(*datapb.CollectionInfo)
+		collection.Properties = req.GetProperties()
+		s.meta.AddCollection(collection)
+	}
+	resp.ErrorCode = commonpb.ErrorCode_Success
+	return resp, nil
+}
@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"errors"
+	"math"
 	"path"
 	"strconv"
 	"time"
@@ -257,6 +258,8 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		return nil, nil, nil, err
 	}
 
+	tsFrom, tsTo := getTimestampRange(data)
+
 	for _, blob := range inlogs {
 		// Blob Key is generated by Serialize from int64 fieldID in collection schema, which won't raise error in ParseInt
 		fID, _ := strconv.ParseInt(blob.GetKey(), 10, 64)
@@ -269,7 +272,13 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 		kvs[key] = value
 		inpaths[fID] = &datapb.FieldBinlog{
 			FieldID: fID,
-			Binlogs: []*datapb.Binlog{{LogSize: int64(fileLen), LogPath: key}},
+			Binlogs: []*datapb.Binlog{{
+				EntriesNum:    int64(data.Data[fID].RowNum()),
+				TimestampFrom: tsFrom,
+				TimestampTo:   tsTo,
+				LogSize:       int64(fileLen),
+				LogPath:       key,
+			}},
 		}
 	}
 
@@ -293,6 +302,24 @@ func (b *binlogIO) genInsertBlobs(data *InsertData, partID, segID UniqueID, meta
 	return kvs, inpaths, statspaths, nil
 }
 
+// getTimestampRange returns the min and max timestamps of the insert data, zeros are returned if there's no timestamp
+func getTimestampRange(data *InsertData) (Timestamp, Timestamp) {
+	tsData, ok := data.Data[common.TimeStampField].(*storage.Int64FieldData)
+	if !ok || len(tsData.Data) == 0 {
+		return 0, 0
+	}
+	from, to := Timestamp(math.MaxUint64), Timestamp(0)
+	for _, ts := range tsData.Data {
+		if Timestamp(ts) < from {
+			from = Timestamp(ts)
+		}
+		if Timestamp(ts) > to {
+			to = Timestamp(ts)
+		}
+	}
+	return from, to
+}
+
 func (b *binlogIO) idxGenerator(n int, done <-chan struct{}) (<-chan UniqueID, error) {
 
 	idStart, _, err := b.allocIDBatch(uint32(n))
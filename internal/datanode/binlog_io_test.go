@@ -45,7 +45,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		iData := genInsertData()
 		dData := &DeleteData{
 			RowCount: 1,
-			Pks:      []int64{888},
+			Pks:      newInt64PrimaryKeys(888),
 			Tss:      []uint64{666666},
 		}
 
@@ -77,7 +77,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		f := &MetaFactory{}
 		meta := f.GetCollectionMeta(UniqueID(10001), "uploads")
 		dData := &DeleteData{
-			Pks: []int64{},
+			Pks: []storage.PrimaryKey{},
 			Tss: []uint64{},
 		}
 
@@ -97,7 +97,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 
 		iData = genInsertData()
 		dData = &DeleteData{
-			Pks:      []int64{},
+			Pks:      []storage.PrimaryKey{},
 			Tss:      []uint64{1},
 			RowCount: 1,
 		}
@@ -109,7 +109,7 @@ func TestBinlogIOInterfaceMethods(t *testing.T) {
 		bin := &binlogIO{mkv, alloc}
 		iData = genInsertData()
 		dData = &DeleteData{
-			Pks:      []int64{1},
+			Pks:      newInt64PrimaryKeys(1),
 			Tss:      []uint64{1},
 			RowCount: 1,
 		}
@@ -211,7 +211,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 				if test.isvalid {
 
 					k, v, err := b.genDeltaBlobs(&DeleteData{
-						Pks: []int64{test.deletepk},
+						Pks: newInt64PrimaryKeys(test.deletepk),
 						Tss: []uint64{test.ts},
 					}, meta.GetID(), 10, 1)
 
@@ -226,7 +226,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 	})
 
 	t.Run("Test genDeltaBlobs error", func(t *testing.T) {
-		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: []int64{1}, Tss: []uint64{}}, 1, 1, 1)
+		k, v, err := b.genDeltaBlobs(&DeleteData{Pks: newInt64PrimaryKeys(1), Tss: []uint64{}}, 1, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
@@ -235,7 +235,7 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		errAlloc.isvalid = false
 
 		bin := binlogIO{memkv.NewMemoryKV(), errAlloc}
-		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: []int64{1}, Tss: []uint64{1}}, 1, 1, 1)
+		k, v, err = bin.genDeltaBlobs(&DeleteData{Pks: newInt64PrimaryKeys(1), Tss: []uint64{1}}, 1, 1, 1)
 		assert.Error(t, err)
 		assert.Empty(t, k)
 		assert.Empty(t, v)
@@ -251,6 +251,10 @@ func TestBinlogIOInnerMethods(t *testing.T) {
 		assert.Equal(t, 3, len(pstats))
 		assert.Equal(t, 11, len(pin))
 		assert.Equal(t, 14, len(kvs))
+		binlog := pin[common.TimeStampField].GetBinlogs()[0]
+		assert.Equal(t, int64(2), binlog.GetEntriesNum())
+		assert.Equal(t, Timestamp(3), binlog.GetTimestampFrom())
+		assert.Equal(t, Timestamp(4), binlog.GetTimestampTo())
 
 		log.Debug("test paths",
 			zap.Any("kvs no.", len(kvs)),
@@ -32,6 +32,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
@@ -114,15 +116,15 @@ func (t *compactionTask) getChannelName() string {
 	return t.plan.GetChannel()
 }
 
-func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[UniqueID]Timestamp, *DelDataBuf, error) {
+func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelTs Timestamp) (map[storage.PrimaryKey]Timestamp, *DelDataBuf, error) {
 
 	dCodec := storage.NewDeleteCodec()
 
 	var (
-		pk2ts = make(map[UniqueID]Timestamp)
+		pk2ts = make(map[storage.PrimaryKey]Timestamp)
 		dbuff = &DelDataBuf{
 			delData: &DeleteData{
-				Pks: make([]UniqueID, 0),
+				Pks: make([]storage.PrimaryKey, 0),
 				Tss: make([]Timestamp, 0)},
 			Binlog: datapb.Binlog{
 				TimestampFrom: math.MaxUint64,
@@ -143,7 +145,10 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 			ts := dData.Tss[i]
 
 			if timetravelTs != Timestamp(0) && dData.Tss[i] <= timetravelTs {
-				pk2ts[pk] = ts
+				// the deltalogs are not ordered by timestamp, the latest delete of a pk decides which rows it drops
+				if lastTs, ok := pk2ts[pk]; !ok || ts > lastTs {
+					pk2ts[pk] = ts
+				}
 				continue
 			}
 
@@ -166,7 +171,8 @@ func (t *compactionTask) mergeDeltalogs(dBlobs map[UniqueID][]*Blob, timetravelT
 	return pk2ts, dbuff, nil
 }
 
-func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp, schema *schemapb.CollectionSchema) ([]*InsertData, int64, error) {
+// merge drops the deleted and the expired entities, the entities inserted before expireTs are expired if expireTs isn't zero
+func (t *compactionTask) merge(mergeItr iterator, delta map[storage.PrimaryKey]Timestamp, schema *schemapb.CollectionSchema, expireTs Timestamp) ([]*InsertData, int64, error) {
 
 	var (
 		dim int // dimension of vector field
@@ -177,11 +183,15 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 		iDatas      = make([]*InsertData, 0)
 		fID2Type    = make(map[UniqueID]schemapb.DataType)
 		fID2Content = make(map[UniqueID][]interface{})
+		fID2Default = make(map[UniqueID]interface{})
 	)
 
 	// get dim
 	for _, fs := range schema.GetFields() {
 		fID2Type[fs.GetFieldID()] = fs.GetDataType()
+		if v := typeutil.GetDefaultValue(fs); v != nil {
+			fID2Default[fs.GetFieldID()] = v
+		}
 		if fs.GetDataType() == schemapb.DataType_FloatVector ||
 			fs.GetDataType() == schemapb.DataType_BinaryVector {
 			for _, t := range fs.GetTypeParams() {
@@ -206,7 +216,12 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 			return nil, 0, errors.New("unexpected error")
 		}
 
-		if _, ok := delta[v.PK]; ok {
+		// a delete only drops the rows inserted before it, the row upserted under the same timestamp is kept
+		if dts, ok := delta[v.PK]; ok && uint64(v.Timestamp) < dts {
+			continue
+		}
+
+		if expireTs > 0 && uint64(v.Timestamp) < expireTs {
 			continue
 		}
 
@@ -216,6 +231,13 @@ func (t *compactionTask) merge(mergeItr iterator, delta map[UniqueID]Timestamp,
 			return nil, 0, errors.New("unexpected error")
 		}
 
+		// the segments written before a field is added have no binlog of the field
+		for fID, v := range fID2Default {
+			if _, ok := row[fID]; !ok {
+				row[fID] = v
+			}
+		}
+
 		for fID, vInter := range row {
 			if _, ok := fID2Content[fID]; !ok {
 				fID2Content[fID] = make([]interface{}, 0)
@@ -399,7 +421,13 @@ func (t *compactionTask) compact() error {
 		return err
 	}
 
-	iDatas, numRows, err := t.merge(mergeItr, deltaPk2Ts, meta.GetSchema())
+	// the entities older than the collection ttl at the start of the compaction are expired
+	var expireTs Timestamp
+	if t.plan.GetCollectionTtl() > 0 {
+		expireTs = tsoutil.AddPhysicalTimeOnTs(-time.Duration(t.plan.GetCollectionTtl()).Milliseconds(), t.plan.GetStartTime())
+	}
+
+	iDatas, numRows, err := t.merge(mergeItr, deltaPk2Ts, meta.GetSchema(), expireTs)
 	if err != nil {
 		log.Error("compact wrong", zap.Int64("planID", t.plan.GetPlanID()), zap.Error(err))
 		return err
@@ -458,6 +486,24 @@ func (t *compactionTask) compact() error {
 }
 
 // TODO copy maybe expensive, but this seems to be the only convinent way.
+// getValidData returns the validity of the rows, nil is the value of a null row
+func getValidData(content []interface{}) []bool {
+	var validData []bool
+	for i, c := range content {
+		if c != nil {
+			continue
+		}
+		if validData == nil {
+			validData = make([]bool, len(content))
+			for j := range validData {
+				validData[j] = true
+			}
+		}
+		validData[i] = false
+	}
+	return validData
+}
+
 func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}, numRows int64) (storage.FieldData, error) {
 	var rst storage.FieldData
 	numOfRows := []int64{numRows}
@@ -470,11 +516,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(bool)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Int8:
@@ -485,11 +532,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(int8)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Int16:
@@ -500,11 +548,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(int16)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Int32:
@@ -515,11 +564,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(int32)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Int64:
@@ -530,11 +580,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(int64)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Float:
@@ -545,11 +596,12 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(float32)
-			if !ok {
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_Double:
@@ -560,11 +612,48 @@ func interface2FieldData(schemaDataType schemapb.DataType, content []interface{}
 
 		for _, c := range content {
 			r, ok := c.(float64)
-			if !ok {
+			if !ok && c != nil {
+				return nil, errTransferType
+			}
+			data.Data = append(data.Data, r)
+		}
+		data.ValidData = getValidData(content)
+		rst = data
+
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		var data = &storage.StringFieldData{
+			NumRows: numOfRows,
+			Data:    make([]string, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.(string)
+			if !ok && c != nil {
 				return nil, errTransferType
 			}
 			data.Data = append(data.Data, r)
 		}
+		data.ValidData = getValidData(content)
+		rst = data
+
+	case schemapb.DataType_JSON:
+		var data = &storage.JSONFieldData{
+			NumRows: numOfRows,
+			Data:    make([][]byte, 0, len(content)),
+		}
+
+		for _, c := range content {
+			r, ok := c.([]byte)
+			if !ok && c != nil {
+				return nil, errTransferType
+			}
+			if c == nil {
+				// a null row holds the json null, the payload can't store an empty document
+				r = []byte("null")
+			}
+			data.Data = append(data.Data, r)
+		}
+		data.ValidData = getValidData(content)
 		rst = data
 
 	case schemapb.DataType_FloatVector:
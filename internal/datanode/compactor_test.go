@@ -21,6 +21,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/golang/protobuf/proto"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -77,13 +78,14 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			{true, schemapb.DataType_Double, []interface{}{float64(1), float64(2)}, "valid float64"},
 			{true, schemapb.DataType_FloatVector, []interface{}{[]float32{1.0, 2.0}}, "valid floatvector"},
 			{true, schemapb.DataType_BinaryVector, []interface{}{[]byte{255}}, "valid binaryvector"},
+			{true, schemapb.DataType_Int64, []interface{}{int64(1), nil}, "valid int64 with null"},
 			{false, schemapb.DataType_Bool, []interface{}{1, 2}, "invalid bool"},
-			{false, schemapb.DataType_Int8, []interface{}{nil, nil}, "invalid int8"},
-			{false, schemapb.DataType_Int16, []interface{}{nil, nil}, "invalid int16"},
-			{false, schemapb.DataType_Int32, []interface{}{nil, nil}, "invalid int32"},
-			{false, schemapb.DataType_Int64, []interface{}{nil, nil}, "invalid int64"},
-			{false, schemapb.DataType_Float, []interface{}{nil, nil}, "invalid float32"},
-			{false, schemapb.DataType_Double, []interface{}{nil, nil}, "invalid float64"},
+			{false, schemapb.DataType_Int8, []interface{}{"1", "2"}, "invalid int8"},
+			{false, schemapb.DataType_Int16, []interface{}{"1", "2"}, "invalid int16"},
+			{false, schemapb.DataType_Int32, []interface{}{"1", "2"}, "invalid int32"},
+			{false, schemapb.DataType_Int64, []interface{}{"1", "2"}, "invalid int64"},
+			{false, schemapb.DataType_Float, []interface{}{"1", "2"}, "invalid float32"},
+			{false, schemapb.DataType_Double, []interface{}{"1", "2"}, "invalid float64"},
 			{false, schemapb.DataType_FloatVector, []interface{}{nil, nil}, "invalid floatvector"},
 			{false, schemapb.DataType_BinaryVector, []interface{}{nil, nil}, "invalid binaryvector"},
 			{false, schemapb.DataType_String, nil, "invalid data type"},
@@ -95,6 +97,9 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 					fd, err := interface2FieldData(test.tp, test.content, 2)
 					assert.NoError(t, err)
 					assert.Equal(t, 2, fd.RowNum())
+					for i, c := range test.content {
+						assert.Equal(t, c == nil, storage.IsNull(fd, i))
+					}
 				} else {
 					fd, err := interface2FieldData(test.tp, test.content, 2)
 					assert.Error(t, err)
@@ -156,7 +161,7 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 						assert.Equal(t, 3, len(pk2ts))
 						assert.Equal(t, int64(3), db.GetEntriesNum())
 						assert.Equal(t, int64(3), db.delData.RowCount)
-						assert.ElementsMatch(t, []UniqueID{1, 4, 5}, db.delData.Pks)
+						assert.ElementsMatch(t, newInt64PrimaryKeys(1, 4, 5), db.delData.Pks)
 						assert.ElementsMatch(t, []Timestamp{30000, 50000, 50000}, db.delData.Tss)
 
 					} else {
@@ -232,6 +237,21 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 			}
 		})
 
+		t.Run("Merge the deletes of the same pk to the latest one", func(t *testing.T) {
+			dBlobs := make(map[UniqueID][]*Blob)
+			d, err := getDeltaBlobs(100, []UniqueID{1, 1}, []Timestamp{30000, 20000})
+			require.NoError(t, err)
+			dBlobs[100] = d
+			d, err = getDeltaBlobs(200, []UniqueID{1}, []Timestamp{10000})
+			require.NoError(t, err)
+			dBlobs[200] = d
+
+			task := &compactionTask{}
+			pk2ts, _, err := task.mergeDeltalogs(dBlobs, 40000)
+			assert.NoError(t, err)
+			assert.Equal(t, Timestamp(30000), pk2ts[storage.NewInt64PrimaryKey(1)])
+		})
+
 	})
 
 	t.Run("Test merge", func(t *testing.T) {
@@ -246,22 +266,96 @@ func TestCompactionTaskInnerMethods(t *testing.T) {
 
 		mitr := storage.NewMergeIterator([]iterator{iitr})
 
-		dm := map[UniqueID]Timestamp{
-			1: 10000,
+		dm := map[storage.PrimaryKey]Timestamp{
+			storage.NewInt64PrimaryKey(1): 10000,
 		}
 
 		ct := &compactionTask{}
-		idata, numOfRow, err := ct.merge(mitr, dm, meta.GetSchema())
+		idata, numOfRow, err := ct.merge(mitr, dm, meta.GetSchema(), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(1), numOfRow)
+		assert.Equal(t, 1, len(idata))
+
+	})
+
+	t.Run("Test merge with expired entities", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// the row inserted at ts 3 is expired
+		ct := &compactionTask{}
+		idata, numOfRow, err := ct.merge(mitr, map[storage.PrimaryKey]Timestamp{}, meta.GetSchema(), 4)
 		assert.NoError(t, err)
 		assert.Equal(t, int64(1), numOfRow)
 		assert.Equal(t, 1, len(idata))
+	})
 
+	t.Run("Test merge with added field", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// the field is added after the segment is written
+		schema := proto.Clone(meta.GetSchema()).(*schemapb.CollectionSchema)
+		schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+			FieldID:      200,
+			Name:         "added_field",
+			DataType:     schemapb.DataType_Int32,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+		})
+		schema.Version++
+
+		ct := &compactionTask{}
+		idata, numOfRow, err := ct.merge(mitr, map[storage.PrimaryKey]Timestamp{}, schema, 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), numOfRow)
+		assert.Equal(t, 1, len(idata))
+		assert.Equal(t, []int32{7, 7}, idata[0].Data[200].(*storage.Int32FieldData).Data)
+	})
+
+	t.Run("Test merge with upsert", func(t *testing.T) {
+		iData := genInsertData()
+		meta := NewMetaFactory().GetCollectionMeta(1, "test")
+
+		iblobs, err := getInsertBlobs(100, iData, meta)
+		require.NoError(t, err)
+
+		iitr, err := storage.NewInsertBinlogIterator(iblobs, 106)
+		require.NoError(t, err)
+
+		mitr := storage.NewMergeIterator([]iterator{iitr})
+
+		// the row of pk 1 is inserted at ts 3 along with the delete
+		dm := map[storage.PrimaryKey]Timestamp{
+			storage.NewInt64PrimaryKey(1): 3,
+		}
+
+		ct := &compactionTask{}
+		idata, numOfRow, err := ct.merge(mitr, dm, meta.GetSchema(), 0)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(2), numOfRow)
+		assert.Equal(t, 1, len(idata))
 	})
 }
 
 func getDeltaBlobs(segID UniqueID, pks []UniqueID, tss []Timestamp) ([]*Blob, error) {
 	deltaData := &DeleteData{
-		Pks:      pks,
+		Pks:      newInt64PrimaryKeys(pks...),
 		Tss:      tss,
 		RowCount: int64(len(pks)),
 	}
@@ -333,12 +427,12 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		mockbIO := &binlogIO{mockKv, alloc}
 		replica, err := newReplica(context.TODO(), rc, collID)
 		require.NoError(t, err)
-		replica.addFlushedSegmentWithPKs(segID, collID, partID, "channelname", 2, []UniqueID{1})
+		replica.addFlushedSegmentWithPKs(segID, collID, partID, "channelname", 2, newInt64PrimaryKeys(1))
 
 		iData := genInsertData()
 		meta := NewMetaFactory().GetCollectionMeta(collID, "test_compact_coll_name")
 		dData := &DeleteData{
-			Pks:      []UniqueID{1},
+			Pks:      newInt64PrimaryKeys(1),
 			Tss:      []Timestamp{20000},
 			RowCount: 1,
 		}
@@ -425,21 +519,21 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		replica, err := newReplica(context.TODO(), rc, collID)
 		require.NoError(t, err)
 
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, newInt64PrimaryKeys(1))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, newInt64PrimaryKeys(9))
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
 
 		meta := NewMetaFactory().GetCollectionMeta(collID, "test_compact_coll_name")
 		iData1 := genInsertDataWithPKs([2]int64{1, 2})
 		dData1 := &DeleteData{
-			Pks:      []UniqueID{1},
+			Pks:      newInt64PrimaryKeys(1),
 			Tss:      []Timestamp{20000},
 			RowCount: 1,
 		}
 		iData2 := genInsertDataWithPKs([2]int64{9, 10})
 		dData2 := &DeleteData{
-			Pks:      []UniqueID{9},
+			Pks:      newInt64PrimaryKeys(9),
 			Tss:      []Timestamp{30000},
 			RowCount: 1,
 		}
@@ -494,8 +588,8 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		plan.PlanID++
 
 		plan.Timetravel = Timestamp(25000)
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, newInt64PrimaryKeys(1))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, newInt64PrimaryKeys(9))
 		replica.removeSegments(19530)
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
@@ -518,8 +612,8 @@ func TestCompactorInterfaceMethods(t *testing.T) {
 		plan.PlanID++
 
 		plan.Timetravel = Timestamp(10000)
-		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, []UniqueID{1})
-		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, []UniqueID{9})
+		replica.addFlushedSegmentWithPKs(segID1, collID, partID, "channelname", 2, newInt64PrimaryKeys(1))
+		replica.addFlushedSegmentWithPKs(segID2, collID, partID, "channelname", 2, newInt64PrimaryKeys(9))
 		replica.removeSegments(19530)
 		require.True(t, replica.hasSegment(segID1, true))
 		require.True(t, replica.hasSegment(segID2, true))
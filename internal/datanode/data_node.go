@@ -112,7 +112,7 @@ type DataNode struct {
 
 	session *sessionutil.Session
 	watchKv kv.MetaKv
-	blobKv  kv.BaseKV
+	blobKv  kv.DataKV
 
 	closer io.Closer
 
@@ -753,3 +753,32 @@ func (node *DataNode) Compaction(ctx context.Context, req *datapb.CompactionPlan
 		ErrorCode: commonpb.ErrorCode_Success,
 	}, nil
 }
+
+// Import handles import task from DataCoord, the files are imported in the background
+// and the result of each segment is reported to DataCoord by ReportImport
+func (node *DataNode) Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error) {
+	log.Info("DataNode receive import task",
+		zap.Int64("taskID", req.GetTaskID()),
+		zap.Int64("collectionID", req.GetCollectionID()),
+		zap.Int("segments", len(req.GetSegments())))
+
+	if !node.isHealthy() {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    msgDataNodeIsUnhealthy(Params.DataNodeCfg.NodeID),
+		}, nil
+	}
+	if req.GetSchema() == nil || len(req.GetSegments()) == 0 {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    errIllegalImportTask.Error(),
+		}, nil
+	}
+
+	task := newImportTask(node.ctx, node.blobKv, newAllocator(node.rootCoord), node.dataCoord, req)
+	go task.execute()
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+	}, nil
+}
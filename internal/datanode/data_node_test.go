@@ -38,6 +38,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
@@ -316,6 +317,19 @@ func TestDataNode(t *testing.T) {
 			zap.String("response", resp.Response))
 	})
 
+	t.Run("Test Import", func(t *testing.T) {
+		emptyNode := &DataNode{}
+		emptyNode.State.Store(internalpb.StateCode_Abnormal)
+		status, err := emptyNode.Import(ctx, &datapb.ImportTask{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		// no segments to import
+		status, err = node.Import(ctx, &datapb.ImportTask{Schema: &schemapb.CollectionSchema{}})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	t.Run("Test BackGroundGC", func(t *testing.T) {
 		ctx, cancel := context.WithCancel(context.Background())
 		node := newIDLEDataNodeMock(ctx)
@@ -30,6 +30,7 @@ import (
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/opentracing/opentracing-go"
 	"go.uber.org/zap"
 )
@@ -149,9 +150,9 @@ func (ddn *ddNode) Operate(in []Msg) []Msg {
 		case commonpb.MsgType_Delete:
 			dmsg := msg.(*msgstream.DeleteMsg)
 			log.Debug("DDNode receive delete messages",
-				zap.Int("num", len(dmsg.GetPrimaryKeys())),
+				zap.Int("num", typeutil.GetSizeOfIDs(dmsg.GetPrimaryKeys())),
 				zap.String("vChannelName", ddn.vchannelName))
-			for i := 0; i < len(dmsg.PrimaryKeys); i++ {
+			for i := 0; i < typeutil.GetSizeOfIDs(dmsg.PrimaryKeys); i++ {
 				dmsg.HashValues = append(dmsg.HashValues, uint32(0))
 			}
 			forwardMsgs = append(forwardMsgs, dmsg)
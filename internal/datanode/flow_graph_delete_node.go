@@ -119,15 +119,16 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 		)
 	}
 
-	segIDToPkMap := make(map[UniqueID][]int64)
+	segIDToPkMap := make(map[UniqueID][]storage.PrimaryKey)
 	segIDToTsMap := make(map[UniqueID][]uint64)
 
-	m := dn.filterSegmentByPK(msg.PartitionID, msg.PrimaryKeys)
-	for i, pk := range msg.PrimaryKeys {
+	primaryKeys := storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys)
+	m := dn.filterSegmentByPK(msg.PartitionID, primaryKeys)
+	for i, pk := range primaryKeys {
 		segIDs, ok := m[pk]
 		if !ok {
 			log.Warn("primary key not exist in all segments",
-				zap.Int64("primary key", pk),
+				zap.Any("primary key", pk),
 				zap.String("vChannelName", dn.channelName))
 			continue
 		}
@@ -159,7 +160,7 @@ func (dn *deleteNode) bufferDeleteMsg(msg *msgstream.DeleteMsg, tr TimeRange) er
 			delData.Pks = append(delData.Pks, pks[i])
 			delData.Tss = append(delData.Tss, tss[i])
 			log.Debug("delete",
-				zap.Int64("primary key", pks[i]),
+				zap.Any("primary key", pks[i]),
 				zap.Uint64("ts", tss[i]),
 				zap.Int64("segmentID", segID),
 				zap.String("vChannelName", dn.channelName))
@@ -188,7 +189,7 @@ func (dn *deleteNode) showDelBuf() {
 			length := len(delDataBuf.delData.Pks)
 			for i := 0; i < length; i++ {
 				log.Debug("del data",
-					zap.Int64("pk", delDataBuf.delData.Pks[i]),
+					zap.Any("pk", delDataBuf.delData.Pks[i]),
 					zap.Uint64("ts", delDataBuf.delData.Tss[i]),
 					zap.Int64("segmentID", segID),
 					zap.String("vchannel", dn.channelName),
@@ -276,14 +277,12 @@ func (dn *deleteNode) Operate(in []Msg) []Msg {
 // filterSegmentByPK returns the bloom filter check result.
 // If the key may exists in the segment, returns it in map.
 // If the key not exists in the segment, the segment is filter out.
-func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []int64) map[int64][]int64 {
-	result := make(map[int64][]int64)
-	buf := make([]byte, 8)
+func (dn *deleteNode) filterSegmentByPK(partID UniqueID, pks []storage.PrimaryKey) map[storage.PrimaryKey][]int64 {
+	result := make(map[storage.PrimaryKey][]int64)
 	segments := dn.replica.filterSegments(dn.channelName, partID)
 	for _, pk := range pks {
 		for _, segment := range segments {
-			common.Endian.PutUint64(buf, uint64(pk))
-			exist := segment.pkFilter.Test(buf)
+			exist := segment.pkFilter.Test(pk.Bytes())
 			if exist {
 				result[pk] = append(result[pk], segment.segmentID)
 			}
@@ -27,6 +27,7 @@ import (
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/stretchr/testify/assert"
 )
@@ -226,13 +227,14 @@ func TestFlowGraphDeleteNode_Operate(t *testing.T) {
 		dn, err := newDeleteNode(context.Background(), fm, make(chan string, 1), c)
 		assert.Nil(t, err)
 
-		results := dn.filterSegmentByPK(0, pks)
-		expected := map[int64][]int64{
-			pks[0]: segIDs[0:3],
-			pks[1]: segIDs[0:3],
-			pks[2]: segIDs[0:3],
-			pks[3]: segIDs[3:5],
-			pks[4]: segIDs[3:5],
+		primaryKeys := newInt64PrimaryKeys(pks...)
+		results := dn.filterSegmentByPK(0, primaryKeys)
+		expected := map[storage.PrimaryKey][]int64{
+			primaryKeys[0]: segIDs[0:3],
+			primaryKeys[1]: segIDs[0:3],
+			primaryKeys[2]: segIDs[0:3],
+			primaryKeys[3]: segIDs[3:5],
+			primaryKeys[4]: segIDs[3:5],
 		}
 		for key, value := range expected {
 			assert.ElementsMatch(t, value, results[key])
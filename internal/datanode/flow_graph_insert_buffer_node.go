@@ -35,6 +35,7 @@ import (
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
@@ -426,6 +427,19 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		log.Error("Get schema wrong:", zap.Error(err))
 		return err
 	}
+	// the schema is altered by AddField after it's cached
+	if msg.GetSchemaVersion() > collSchema.GetVersion() {
+		collSchema, err = ibNode.replica.refreshCollectionSchema(collectionID, msg.EndTs())
+		if err != nil {
+			log.Error("Refresh schema wrong:", zap.Error(err))
+			return err
+		}
+	}
+	// the rows written before the fields are added take the default values of these fields
+	if err := typeutil.AlignRowData(collSchema, msg.GetFieldIDs(), msg.RowData); err != nil {
+		log.Error("Align row data wrong:", zap.Error(err))
+		return err
+	}
 
 	// Get Dimension
 	// TODO GOOSE: under assumption that there's only 1 Vector field in one collection schema
@@ -630,9 +644,62 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 			}
 			if field.IsPrimaryKey {
 				// update segment pk filter
-				ibNode.replica.updateSegmentPKRange(currentSegID, fieldData.Data)
+				pks := make([]storage.PrimaryKey, 0, len(msg.RowData))
+				for _, pk := range fieldData.Data[len(fieldData.Data)-len(msg.RowData):] {
+					pks = append(pks, storage.NewInt64PrimaryKey(pk))
+				}
+				ibNode.replica.updateSegmentPKRange(currentSegID, pks)
+			}
+
+		case schemapb.DataType_VarChar:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.StringFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([]string, 0),
+				}
 			}
 
+			fieldData := idata.Data[field.FieldID].(*storage.StringFieldData)
+			for _, r := range blobReaders {
+				v, err := typeutil.ReadVarChar(r)
+				if err != nil {
+					log.Error("read varchar field from row data wrong", zap.Error(err))
+					return err
+				}
+
+				fieldData.Data = append(fieldData.Data, v)
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
+			if field.IsPrimaryKey {
+				// update segment pk filter
+				pks := make([]storage.PrimaryKey, 0, len(msg.RowData))
+				for _, pk := range fieldData.Data[len(fieldData.Data)-len(msg.RowData):] {
+					pks = append(pks, storage.NewVarCharPrimaryKey(pk))
+				}
+				ibNode.replica.updateSegmentPKRange(currentSegID, pks)
+			}
+
+		case schemapb.DataType_JSON:
+			if _, ok := idata.Data[field.FieldID]; !ok {
+				idata.Data[field.FieldID] = &storage.JSONFieldData{
+					NumRows: make([]int64, 0, 1),
+					Data:    make([][]byte, 0),
+				}
+			}
+
+			fieldData := idata.Data[field.FieldID].(*storage.JSONFieldData)
+			for _, r := range blobReaders {
+				v, err := typeutil.ReadVarChar(r)
+				if err != nil {
+					log.Error("read json field from row data wrong", zap.Error(err))
+					return err
+				}
+
+				fieldData.Data = append(fieldData.Data, []byte(v))
+			}
+			fieldData.NumRows = append(fieldData.NumRows, int64(len(msg.RowData)))
+
 		case schemapb.DataType_Float:
 			if _, ok := idata.Data[field.FieldID]; !ok {
 				idata.Data[field.FieldID] = &storage.FloatFieldData{
@@ -671,6 +738,22 @@ func (ibNode *insertBufferNode) bufferInsertMsg(msg *msgstream.InsertMsg, endPos
 		}
 	}
 
+	// 1.3 Get the validity of nullable fields
+	fieldsValidData := make(map[UniqueID][]bool)
+	for _, fieldValidData := range msg.GetValidData() {
+		fieldsValidData[fieldValidData.GetFieldID()] = fieldValidData.GetValidData()
+	}
+	for _, field := range collSchema.Fields {
+		fieldData, ok := idata.Data[field.FieldID]
+		if !field.GetNullable() || !ok {
+			continue
+		}
+		if err := storage.AppendValidData(fieldData, len(msg.RowData), fieldsValidData[field.FieldID]); err != nil {
+			log.Error("Append valid data wrong:", zap.Error(err))
+			return err
+		}
+	}
+
 	// update buffer size
 	buffer.updateSize(int64(len(msg.RowData)))
 
@@ -353,6 +353,7 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 	field2Insert := make(map[UniqueID]*datapb.Binlog, len(binLogs))
 	kvs := make(map[string]string, len(binLogs))
 	field2Logidx := make(map[UniqueID]UniqueID, len(binLogs))
+	tsFrom, tsTo := getTimestampRange(data.buffer)
 	for idx, blob := range binLogs {
 		fieldID, err := strconv.ParseInt(blob.GetKey(), 10, 64)
 		if err != nil {
@@ -369,8 +370,8 @@ func (m *rendezvousFlushManager) flushBufferData(data *BufferData, segmentID Uni
 		kvs[key] = string(blob.Value[:])
 		field2Insert[fieldID] = &datapb.Binlog{
 			EntriesNum:    data.size,
-			TimestampFrom: 0, //TODO
-			TimestampTo:   0, //TODO,
+			TimestampFrom: tsFrom,
+			TimestampTo:   tsTo,
 			LogPath:       key,
 			LogSize:       int64(len(blob.Value)),
 		}
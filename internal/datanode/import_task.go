@@ -0,0 +1,298 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/kv"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/importutil"
+	"github.com/milvus-io/milvus/internal/util/retry"
+)
+
+var errIllegalImportTask = errors.New("import task illegal")
+
+const (
+	// importReadChunkSize is the number of bytes read from the blob storage at a time when parsing the import files
+	importReadChunkSize = 16 * 1024 * 1024
+	// importBatchSize is the number of bytes of the rows parsed before they are added to the segment being imported
+	importBatchSize = 16 * 1024 * 1024
+)
+
+// importTask reads the files of an import task from the blob storage,
+// writes them as binlogs of new segments and reports the results to DataCoord
+type importTask struct {
+	ctx       context.Context
+	kv        kv.DataKV
+	uploader  uploader
+	allocator allocatorInterface
+	dc        types.DataCoord
+	req       *datapb.ImportTask
+	// the rows of a segment are written once they take segmentMaxSize bytes
+	segmentMaxSize int
+}
+
+func newImportTask(ctx context.Context, blobKv kv.DataKV, alloc allocatorInterface, dc types.DataCoord, req *datapb.ImportTask) *importTask {
+	return &importTask{
+		ctx:       ctx,
+		kv:        blobKv,
+		uploader:  &binlogIO{blobKv, alloc},
+		allocator: alloc,
+		dc:        dc,
+		req:       req,
+
+		segmentMaxSize: int(Params.DataCoordCfg.SegmentMaxSize * 1024 * 1024),
+	}
+}
+
+// execute imports the groups of files one by one, a failed segment does not stop the others
+func (t *importTask) execute() {
+	for _, segment := range t.req.GetSegments() {
+		for _, result := range t.importSegments(segment) {
+			t.report(result)
+		}
+	}
+}
+
+// importSegments parses a group of files and writes the rows into the segments allocated for them,
+// a segment is written once its rows reach the size limit so only a segment of rows is held in memory.
+// The last segment takes the rest of the rows, and the segments left unused are empty.
+func (t *importTask) importSegments(segment *datapb.ImportSegment) []*datapb.ImportResult {
+	segmentIDs := segment.GetSegmentIDs()
+	results := make([]*datapb.ImportResult, 0, len(segmentIDs))
+	for _, segmentID := range segmentIDs {
+		results = append(results, &datapb.ImportResult{
+			Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError},
+			TaskID:    t.req.GetTaskID(),
+			SegmentID: segmentID,
+		})
+	}
+	log.Info("import segments start",
+		zap.Int64("taskID", t.req.GetTaskID()),
+		zap.Int64s("segmentIDs", segmentIDs),
+		zap.Strings("files", segment.GetFiles()))
+
+	var iDatas []*InsertData
+	var size int
+	next := 0
+	flush := func() error {
+		result := results[next]
+		next++
+		t.importSegment(result, iDatas)
+		iDatas, size = nil, 0
+		if result.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+			return errors.New(result.GetStatus().GetReason())
+		}
+		return nil
+	}
+	handler := func(iData *InsertData) error {
+		iDatas = append(iDatas, iData)
+		size += importutil.InsertDataSize(iData)
+		if size >= t.segmentMaxSize && next < len(results)-1 {
+			return flush()
+		}
+		return nil
+	}
+
+	err := t.parse(segment.GetFiles(), handler)
+	for err == nil && next < len(results) {
+		err = flush()
+	}
+	if err != nil {
+		// the segments written are dropped with the others, since the files are imported as a whole
+		log.Warn("import segments failed", zap.Int64s("segmentIDs", segmentIDs), zap.Error(err))
+		for _, result := range results {
+			result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			result.Status.Reason = err.Error()
+			result.InsertLogs = nil
+			result.Field2StatslogPaths = nil
+			result.NumOfRows = 0
+		}
+	}
+	return results
+}
+
+// importSegment writes the rows as the binlogs of the segment and fills the result
+func (t *importTask) importSegment(result *datapb.ImportResult, iDatas []*InsertData) {
+	var numRows int64
+	for _, iData := range iDatas {
+		if err := t.fillSystemFields(iData); err != nil {
+			log.Warn("import segment failed to alloc row IDs", zap.Int64("segmentID", result.GetSegmentID()), zap.Error(err))
+			result.Status.Reason = err.Error()
+			return
+		}
+		numRows += int64(iData.Data[common.RowIDField].RowNum())
+	}
+
+	// the segment is left empty if there are less rows than segments
+	if numRows > 0 {
+		meta := &etcdpb.CollectionMeta{
+			ID:     t.req.GetCollectionID(),
+			Schema: t.req.GetSchema(),
+		}
+		paths, err := t.uploader.upload(t.ctx, result.GetSegmentID(), t.req.GetPartitionID(), iDatas, &DeleteData{}, meta)
+		if err != nil {
+			log.Warn("import segment failed to upload binlogs", zap.Int64("segmentID", result.GetSegmentID()), zap.Error(err))
+			result.Status.Reason = err.Error()
+			return
+		}
+		result.InsertLogs = paths.inPaths
+		result.Field2StatslogPaths = paths.statsPaths
+	}
+
+	log.Info("import segment done", zap.Int64("segmentID", result.GetSegmentID()), zap.Int64("numRows", numRows))
+	result.Status.ErrorCode = commonpb.ErrorCode_Success
+	result.NumOfRows = numRows
+}
+
+// parse decodes the row based files one by one, or the column based files together, and hands the rows to handler
+// in batches. The files are streamed from the blob storage instead of being loaded as a whole.
+func (t *importTask) parse(files []string, handler func(*InsertData) error) error {
+	if t.req.GetRowBased() {
+		for _, file := range files {
+			reader, err := newBlobReader(t.kv, file, importReadChunkSize)
+			if err != nil {
+				return fmt.Errorf("failed to read file %s, err: %w", file, err)
+			}
+			if _, err := importutil.ParseJSONRows(reader, t.req.GetSchema(), importBatchSize, handler); err != nil {
+				return fmt.Errorf("failed to parse file %s, err: %w", file, err)
+			}
+		}
+		return nil
+	}
+
+	columns := make(map[string]io.Reader, len(files))
+	for _, file := range files {
+		reader, err := newBlobReader(t.kv, file, importReadChunkSize)
+		if err != nil {
+			return fmt.Errorf("failed to read file %s, err: %w", file, err)
+		}
+		columns[importutil.ColumnName(file)] = reader
+	}
+	_, err := importutil.ParseNumpyColumns(columns, t.req.GetSchema(), importBatchSize, handler)
+	return err
+}
+
+// blobReader reads an object of the blob storage chunk by chunk, so only one chunk is held in memory
+type blobReader struct {
+	kv        kv.DataKV
+	key       string
+	size      int64
+	offset    int64
+	chunkSize int64
+	buf       []byte
+}
+
+func newBlobReader(dataKV kv.DataKV, key string, chunkSize int64) (*blobReader, error) {
+	size, err := dataKV.GetSize(key)
+	if err != nil {
+		return nil, err
+	}
+	return &blobReader{
+		kv:        dataKV,
+		key:       key,
+		size:      size,
+		chunkSize: chunkSize,
+	}, nil
+}
+
+// Read implements io.Reader
+func (r *blobReader) Read(p []byte) (int, error) {
+	if len(r.buf) == 0 {
+		if r.offset >= r.size {
+			return 0, io.EOF
+		}
+		end := r.offset + r.chunkSize
+		if end > r.size {
+			end = r.size
+		}
+		chunk, err := r.kv.LoadPartial(r.key, r.offset, end)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read file %s, err: %w", r.key, err)
+		}
+		r.buf = chunk
+		r.offset = end
+	}
+	n := copy(p, r.buf)
+	r.buf = r.buf[n:]
+	return n, nil
+}
+
+// fillSystemFields allocates the row IDs and sets the timestamps of the rows,
+// the auto generated primary keys are the same as the row IDs
+func (t *importTask) fillSystemFields(iData *InsertData) error {
+	var rowNum int
+	for _, fieldData := range iData.Data {
+		rowNum = fieldData.RowNum()
+		break
+	}
+	start, count, err := t.allocator.allocIDBatch(uint32(rowNum))
+	if err != nil {
+		return err
+	}
+	if int(count) != rowNum {
+		return fmt.Errorf("failed to alloc %d row IDs, only %d are allocated", rowNum, count)
+	}
+
+	rowIDs := make([]int64, rowNum)
+	timestamps := make([]int64, rowNum)
+	for i := 0; i < rowNum; i++ {
+		rowIDs[i] = start + int64(i)
+		timestamps[i] = int64(t.req.GetTimestamp())
+	}
+	numRows := []int64{int64(rowNum)}
+	iData.Data[common.RowIDField] = &storage.Int64FieldData{NumRows: numRows, Data: rowIDs}
+	iData.Data[common.TimeStampField] = &storage.Int64FieldData{NumRows: numRows, Data: timestamps}
+	for _, field := range t.req.GetSchema().GetFields() {
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			iData.Data[field.GetFieldID()] = &storage.Int64FieldData{NumRows: numRows, Data: rowIDs}
+		}
+	}
+	return nil
+}
+
+// report sends the result of a segment to DataCoord, retry on network errors
+func (t *importTask) report(result *datapb.ImportResult) {
+	err := retry.Do(t.ctx, func() error {
+		status, err := t.dc.ReportImport(t.ctx, result)
+		if err != nil {
+			return err
+		}
+		if status.GetErrorCode() != commonpb.ErrorCode_Success {
+			return fmt.Errorf("data coord ReportImport failed, reason = %s", status.GetReason())
+		}
+		return nil
+	})
+	if err != nil {
+		log.Warn("failed to report import result",
+			zap.Int64("taskID", result.GetTaskID()),
+			zap.Int64("segmentID", result.GetSegmentID()),
+			zap.Error(err))
+	}
+}
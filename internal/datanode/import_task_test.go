@@ -0,0 +1,209 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package datanode
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	memkv "github.com/milvus-io/milvus/internal/kv/mem"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+func newImportTestSchema() *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test_import",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+		},
+	}
+}
+
+func encodeNumpy(t *testing.T, descr string, shape string, data interface{}) string {
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }\n", descr, shape)
+	var buf bytes.Buffer
+	buf.WriteString("\x93NUMPY\x01\x00")
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, uint16(len(dict))))
+	buf.WriteString(dict)
+	require.NoError(t, binary.Write(&buf, binary.LittleEndian, data))
+	return buf.String()
+}
+
+func TestImportTask(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	mockKv := memkv.NewMemoryKV()
+	require.NoError(t, mockKv.Save("import/rows.json", `{"rows": [
+		{"age": 1, "vec": [1, 2]},
+		{"age": 2, "vec": [3, 4]},
+		{"age": 3, "vec": [5, 6]}
+	]}`))
+	require.NoError(t, mockKv.Save("import/rows2.json", `{"rows": [
+		{"age": 4, "vec": [7, 8]},
+		{"age": 5, "vec": [9, 10]}
+	]}`))
+	require.NoError(t, mockKv.Save("import/invalid.json", `{"rows": [{"age": 1}]}`))
+	require.NoError(t, mockKv.Save("import/age.npy", encodeNumpy(t, "<i4", "2,", []int32{1, 2})))
+	require.NoError(t, mockKv.Save("import/vec.npy", encodeNumpy(t, "<f4", "2, 2", []float32{1, 2, 3, 4})))
+
+	t.Run("row based", func(t *testing.T) {
+		dc := &DataCoordFactory{}
+		task := newImportTask(ctx, mockKv, NewAllocatorFactory(), dc, &datapb.ImportTask{
+			TaskID:       1,
+			CollectionID: 10,
+			PartitionID:  20,
+			Schema:       newImportTestSchema(),
+			RowBased:     true,
+			Segments: []*datapb.ImportSegment{
+				{SegmentIDs: []int64{100}, Files: []string{"import/rows.json"}},
+				{SegmentIDs: []int64{101}, Files: []string{"import/invalid.json"}},
+				{SegmentIDs: []int64{102}, Files: []string{"import/not_exist.json"}},
+			},
+			Timestamp: 1000,
+		})
+		task.execute()
+
+		require.Equal(t, 3, len(dc.importResults))
+		result := dc.importResults[0]
+		assert.Equal(t, commonpb.ErrorCode_Success, result.GetStatus().GetErrorCode())
+		assert.EqualValues(t, 1, result.GetTaskID())
+		assert.EqualValues(t, 100, result.GetSegmentID())
+		assert.EqualValues(t, 3, result.GetNumOfRows())
+		// RowID, Timestamp, pk, age and vec
+		assert.Equal(t, 5, len(result.GetInsertLogs()))
+		assert.NotEmpty(t, result.GetField2StatslogPaths())
+		for _, fieldBinlog := range result.GetInsertLogs() {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				_, err := mockKv.Load(binlog.GetLogPath())
+				assert.NoError(t, err)
+			}
+		}
+
+		for _, result := range dc.importResults[1:] {
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.GetStatus().GetErrorCode())
+			assert.NotEmpty(t, result.GetStatus().GetReason())
+			assert.Empty(t, result.GetInsertLogs())
+		}
+	})
+
+	t.Run("column based", func(t *testing.T) {
+		dc := &DataCoordFactory{}
+		task := newImportTask(ctx, mockKv, NewAllocatorFactory(), dc, &datapb.ImportTask{
+			TaskID:       2,
+			CollectionID: 10,
+			PartitionID:  20,
+			Schema:       newImportTestSchema(),
+			Segments: []*datapb.ImportSegment{
+				{SegmentIDs: []int64{200}, Files: []string{"import/age.npy", "import/vec.npy"}},
+				{SegmentIDs: []int64{201}, Files: []string{"import/age.npy"}},
+			},
+			Timestamp: 1000,
+		})
+		task.execute()
+
+		require.Equal(t, 2, len(dc.importResults))
+		assert.Equal(t, commonpb.ErrorCode_Success, dc.importResults[0].GetStatus().GetErrorCode())
+		assert.EqualValues(t, 2, dc.importResults[0].GetNumOfRows())
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, dc.importResults[1].GetStatus().GetErrorCode())
+		assert.True(t, strings.Contains(dc.importResults[1].GetStatus().GetReason(), "vec"))
+	})
+
+	t.Run("split into segments", func(t *testing.T) {
+		dc := &DataCoordFactory{}
+		task := newImportTask(ctx, mockKv, NewAllocatorFactory(), dc, &datapb.ImportTask{
+			TaskID:       4,
+			CollectionID: 10,
+			PartitionID:  20,
+			Schema:       newImportTestSchema(),
+			RowBased:     true,
+			Segments: []*datapb.ImportSegment{
+				{SegmentIDs: []int64{400, 401, 402}, Files: []string{"import/rows.json", "import/rows2.json"}},
+				{SegmentIDs: []int64{403, 404}, Files: []string{"import/rows.json", "import/invalid.json"}},
+			},
+			Timestamp: 1000,
+		})
+		// every batch of rows fills a segment
+		task.segmentMaxSize = 1
+		task.execute()
+
+		require.Equal(t, 5, len(dc.importResults))
+		for _, result := range dc.importResults[:3] {
+			assert.Equal(t, commonpb.ErrorCode_Success, result.GetStatus().GetErrorCode())
+		}
+		assert.EqualValues(t, 3, dc.importResults[0].GetNumOfRows())
+		assert.Equal(t, 5, len(dc.importResults[0].GetInsertLogs()))
+		assert.EqualValues(t, 2, dc.importResults[1].GetNumOfRows())
+		assert.Equal(t, 5, len(dc.importResults[1].GetInsertLogs()))
+		// no rows are left for the last segment
+		assert.EqualValues(t, 0, dc.importResults[2].GetNumOfRows())
+		assert.Empty(t, dc.importResults[2].GetInsertLogs())
+
+		// the segment written before the invalid file fails with the others
+		for _, result := range dc.importResults[3:] {
+			assert.Equal(t, commonpb.ErrorCode_UnexpectedError, result.GetStatus().GetErrorCode())
+			assert.Empty(t, result.GetInsertLogs())
+			assert.EqualValues(t, 0, result.GetNumOfRows())
+		}
+	})
+
+	t.Run("alloc failed", func(t *testing.T) {
+		dc := &DataCoordFactory{}
+		alloc := NewAllocatorFactory()
+		alloc.errAllocBatch = true
+		task := newImportTask(ctx, mockKv, alloc, dc, &datapb.ImportTask{
+			TaskID:   3,
+			Schema:   newImportTestSchema(),
+			RowBased: true,
+			Segments: []*datapb.ImportSegment{{SegmentIDs: []int64{300}, Files: []string{"import/rows.json"}}},
+		})
+		task.execute()
+
+		require.Equal(t, 1, len(dc.importResults))
+		assert.Equal(t, commonpb.ErrorCode_UnexpectedError, dc.importResults[0].GetStatus().GetErrorCode())
+	})
+}
+
+func TestBlobReader(t *testing.T) {
+	mockKv := memkv.NewMemoryKV()
+	require.NoError(t, mockKv.Save("import/file", "0123456789"))
+
+	reader, err := newBlobReader(mockKv, "import/file", 3)
+	require.NoError(t, err)
+	content, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.Equal(t, "0123456789", string(content))
+
+	_, err = newBlobReader(mockKv, "import/not_exist", 3)
+	assert.Error(t, err)
+}
@@ -165,6 +165,8 @@ type DataCoordFactory struct {
 
 	DropVirtualChannelError      bool
 	DropVirtualChannelNotSuccess bool
+
+	importResults []*datapb.ImportResult
 }
 
 func (ds *DataCoordFactory) CompleteCompaction(ctx context.Context, req *datapb.CompactionResult) (*commonpb.Status, error) {
@@ -207,6 +209,35 @@ func (ds *DataCoordFactory) DropVirtualChannel(ctx context.Context, req *datapb.
 	}, nil
 }
 
+func (ds *DataCoordFactory) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return &milvuspb.ImportResponse{}, nil
+}
+
+func (ds *DataCoordFactory) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{}, nil
+}
+
+func (ds *DataCoordFactory) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	ds.importResults = append(ds.importResults, req)
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
+func (ds *DataCoordFactory) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (ds *DataCoordFactory) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	return &datapb.PinSegmentsResponse{}, nil
+}
+
+func (ds *DataCoordFactory) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
+func (ds *DataCoordFactory) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{}, nil
+}
+
 func (mf *MetaFactory) GetCollectionMeta(collectionID UniqueID, collectionName string) *etcdpb.CollectionMeta {
 	sch := schemapb.CollectionSchema{
 		Name:        collectionName,
@@ -487,7 +518,7 @@ func (df *DataFactory) GenMsgStreamDeleteMsg(pks []int64, chanName string) *msgs
 			CollectionName: "col1",
 			PartitionName:  "default",
 			ShardName:      chanName,
-			PrimaryKeys:    pks,
+			PrimaryKeys:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: pks}}},
 			Timestamps:     timestamps,
 		},
 	}
@@ -816,3 +847,11 @@ func genEmptyInsertData() *InsertData {
 			},
 		}}
 }
+
+func newInt64PrimaryKeys(pks ...int64) []s.PrimaryKey {
+	primaryKeys := make([]s.PrimaryKey, 0, len(pks))
+	for _, pk := range pks {
+		primaryKeys = append(primaryKeys, s.NewInt64PrimaryKey(pk))
+	}
+	return primaryKeys
+}
@@ -19,7 +19,6 @@ package datanode
 import (
 	"context"
 	"fmt"
-	"math"
 	"sync"
 	"sync/atomic"
 
@@ -48,6 +47,7 @@ const (
 type Replica interface {
 	getCollectionID() UniqueID
 	getCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
+	refreshCollectionSchema(collectionID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error)
 	getCollectionAndPartitionID(segID UniqueID) (collID, partitionID UniqueID, err error)
 
 	listAllSegmentIDs() []UniqueID
@@ -59,7 +59,7 @@ type Replica interface {
 	listSegmentsCheckPoints() map[UniqueID]segmentCheckPoint
 	updateSegmentEndPosition(segID UniqueID, endPos *internalpb.MsgPosition)
 	updateSegmentCheckPoint(segID UniqueID)
-	updateSegmentPKRange(segID UniqueID, rowIDs []int64)
+	updateSegmentPKRange(segID UniqueID, pks []storage.PrimaryKey)
 	mergeFlushedSegments(segID, collID, partID UniqueID, compactedFrom []UniqueID, channelName string, numOfRows int64)
 	hasSegment(segID UniqueID, countFlushed bool) bool
 	removeSegments(segID ...UniqueID)
@@ -88,9 +88,8 @@ type Segment struct {
 	endPos     *internalpb.MsgPosition
 
 	pkFilter *bloom.BloomFilter //  bloom filter of pk inside a segment
-	// TODO silverxia, needs to change to interface to support `string` type PK
-	minPK int64 //	minimal pk value, shortcut for checking whether a pk is inside this segment
-	maxPK int64 //  maximal pk value, same above
+	minPK    storage.PrimaryKey //	minimal pk value, shortcut for checking whether a pk is inside this segment, nil represents no value
+	maxPK    storage.PrimaryKey //  maximal pk value, same above
 }
 
 // SegmentReplica is the data replication of persistent data in datanode.
@@ -109,23 +108,25 @@ type SegmentReplica struct {
 	minIOKV     kv.BaseKV
 }
 
-func (s *Segment) updatePKRange(pks []int64) {
-	buf := make([]byte, 8)
+func (s *Segment) updatePKRange(pks []storage.PrimaryKey) {
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		s.pkFilter.Add(buf)
-		if pk > s.maxPK {
-			s.maxPK = pk
-		}
-		if pk < s.minPK {
-			s.minPK = pk
-		}
+		s.pkFilter.Add(pk.Bytes())
+		s.updatePKMinMax(pk, pk)
 	}
 
 	log.Info("update pk range",
 		zap.Int64("collectionID", s.collectionID), zap.Int64("partitionID", s.partitionID), zap.Int64("segmentID", s.segmentID),
 		zap.String("channel", s.channelName),
-		zap.Int64("num_rows", s.numRows), zap.Int64("minPK", s.minPK), zap.Int64("maxPK", s.maxPK))
+		zap.Int64("num_rows", s.numRows), zap.Any("minPK", s.minPK), zap.Any("maxPK", s.maxPK))
+}
+
+func (s *Segment) updatePKMinMax(min, max storage.PrimaryKey) {
+	if s.maxPK == nil || max.GT(s.maxPK) {
+		s.maxPK = max
+	}
+	if s.minPK == nil || min.LT(s.minPK) {
+		s.minPK = min
+	}
 }
 
 var _ Replica = &SegmentReplica{}
@@ -256,8 +257,6 @@ func (replica *SegmentReplica) addNewSegment(segID, collID, partitionID UniqueID
 		endPos:     endPos,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	seg.isNew.Store(true)
@@ -342,8 +341,6 @@ func (replica *SegmentReplica) addNormalSegment(segID, collID, partitionID Uniqu
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 	if cp != nil {
 		seg.checkPoint = *cp
@@ -391,8 +388,6 @@ func (replica *SegmentReplica) addFlushedSegment(segID, collID, partitionID Uniq
 
 		//TODO silverxia, normal segments bloom filter and pk range should be loaded from serialized files
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	err := replica.initPKBloomFilter(seg, statsBinlogs)
@@ -457,13 +452,7 @@ func (replica *SegmentReplica) initPKBloomFilter(s *Segment, statsBinlogs []*dat
 		if err != nil {
 			return err
 		}
-		if s.minPK > stat.Min {
-			s.minPK = stat.Min
-		}
-
-		if s.maxPK < stat.Max {
-			s.maxPK = stat.Max
-		}
+		s.updatePKMinMax(stat.Min, stat.Max)
 	}
 	return nil
 }
@@ -526,7 +515,7 @@ func (replica *SegmentReplica) updateSegmentEndPosition(segID UniqueID, endPos *
 	log.Warn("No match segment", zap.Int64("ID", segID))
 }
 
-func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []int64) {
+func (replica *SegmentReplica) updateSegmentPKRange(segID UniqueID, pks []storage.PrimaryKey) {
 	replica.segMu.Lock()
 	defer replica.segMu.Unlock()
 
@@ -666,6 +655,26 @@ func (replica *SegmentReplica) getCollectionSchema(collID UniqueID, ts Timestamp
 	return replica.collSchema, nil
 }
 
+// refreshCollectionSchema gets collection schema from rootcoord for a certain timestamp and replaces the cached one,
+// it's called when the schema is altered after it's cached.
+func (replica *SegmentReplica) refreshCollectionSchema(collID UniqueID, ts Timestamp) (*schemapb.CollectionSchema, error) {
+	if !replica.validCollection(collID) {
+		log.Warn("Mismatch collection for the replica",
+			zap.Int64("Want", replica.collectionID),
+			zap.Int64("Actual", collID),
+		)
+		return nil, fmt.Errorf("not supported collection %v", collID)
+	}
+
+	sch, err := replica.metaService.getCollectionSchema(context.Background(), collID, ts)
+	if err != nil {
+		log.Error("Grpc error", zap.Error(err))
+		return nil, err
+	}
+	replica.collSchema = sch
+	return sch, nil
+}
+
 func (replica *SegmentReplica) validCollection(collID UniqueID) bool {
 	return collID == replica.collectionID
 }
@@ -711,8 +720,6 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID Unique
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	replica.segMu.Lock()
@@ -741,7 +748,7 @@ func (replica *SegmentReplica) mergeFlushedSegments(segID, collID, partID Unique
 }
 
 // for tests only
-func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, pks []UniqueID) {
+func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID UniqueID, channelName string, numOfRows int64, pks []storage.PrimaryKey) {
 	if collID != replica.collectionID {
 		log.Warn("Mismatch collection",
 			zap.Int64("input ID", collID),
@@ -764,8 +771,6 @@ func (replica *SegmentReplica) addFlushedSegmentWithPKs(segID, collID, partID Un
 		numRows:      numOfRows,
 
 		pkFilter: bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive),
-		minPK:    math.MaxInt64, // use max value, represents no value
-		maxPK:    math.MinInt64, // use min value represents no value
 	}
 
 	seg.updatePKRange(pks)
@@ -20,7 +20,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"math"
 	"math/rand"
 	"testing"
 
@@ -266,12 +265,12 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 				replica, err := newReplica(context.TODO(), rc, test.replicaCollID)
 				require.NoError(t, err)
 				if test.isvalid {
-					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, []int64{9})
+					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, newInt64PrimaryKeys(9))
 
 					assert.True(t, replica.hasSegment(100, true))
 					assert.False(t, replica.hasSegment(100, false))
 				} else {
-					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, []int64{9})
+					replica.addFlushedSegmentWithPKs(100, test.incollID, 10, "a", 1, newInt64PrimaryKeys(9))
 					assert.False(t, replica.hasSegment(100, true))
 					assert.False(t, replica.hasSegment(100, false))
 				}
@@ -623,8 +622,8 @@ func TestSegmentReplica_InterfaceMethod(t *testing.T) {
 		sr, err := newReplica(context.Background(), rc, 1)
 		assert.Nil(t, err)
 
-		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, []UniqueID{1})
-		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, []UniqueID{1})
+		sr.addFlushedSegmentWithPKs(1, 1, 0, "channel", 10, newInt64PrimaryKeys(1))
+		sr.addFlushedSegmentWithPKs(2, 1, 0, "channel", 10, newInt64PrimaryKeys(1))
 		require.True(t, sr.hasSegment(1, true))
 		require.True(t, sr.hasSegment(2, true))
 
@@ -740,8 +739,6 @@ func TestInnerFunctionSegment(t *testing.T) {
 func TestSegmentReplica_UpdatePKRange(t *testing.T) {
 	seg := &Segment{
 		pkFilter: bloom.NewWithEstimates(100000, 0.005),
-		maxPK:    math.MinInt64,
-		minPK:    math.MaxInt64,
 	}
 
 	cases := make([]int64, 0, 100)
@@ -750,10 +747,11 @@ func TestSegmentReplica_UpdatePKRange(t *testing.T) {
 	}
 	buf := make([]byte, 8)
 	for _, c := range cases {
-		seg.updatePKRange([]int64{c})
+		pk := storage.NewInt64PrimaryKey(c)
+		seg.updatePKRange([]storage.PrimaryKey{pk})
 
-		assert.LessOrEqual(t, seg.minPK, c)
-		assert.GreaterOrEqual(t, seg.maxPK, c)
+		assert.False(t, seg.minPK.GT(pk))
+		assert.False(t, seg.maxPK.LT(pk))
 
 		common.Endian.PutUint64(buf, uint64(c))
 		assert.True(t, seg.pkFilter.Test(buf))
@@ -788,14 +786,15 @@ func TestReplica_UpdatePKRange(t *testing.T) {
 	}
 	buf := make([]byte, 8)
 	for _, c := range cases {
-		replica.updateSegmentPKRange(1, []int64{c}) // new segment
-		replica.updateSegmentPKRange(2, []int64{c}) // normal segment
-		replica.updateSegmentPKRange(3, []int64{c}) // non-exist segment
-
-		assert.LessOrEqual(t, segNew.minPK, c)
-		assert.GreaterOrEqual(t, segNew.maxPK, c)
-		assert.LessOrEqual(t, segNormal.minPK, c)
-		assert.GreaterOrEqual(t, segNormal.maxPK, c)
+		pk := storage.NewInt64PrimaryKey(c)
+		replica.updateSegmentPKRange(1, []storage.PrimaryKey{pk}) // new segment
+		replica.updateSegmentPKRange(2, []storage.PrimaryKey{pk}) // normal segment
+		replica.updateSegmentPKRange(3, []storage.PrimaryKey{pk}) // non-exist segment
+
+		assert.False(t, segNew.minPK.GT(pk))
+		assert.False(t, segNew.maxPK.LT(pk))
+		assert.False(t, segNormal.minPK.GT(pk))
+		assert.False(t, segNormal.maxPK.LT(pk))
 
 		common.Endian.PutUint64(buf, uint64(c))
 		assert.True(t, segNew.pkFilter.Test(buf))
@@ -120,6 +120,27 @@ func Test_NewClient(t *testing.T) {
 
 		r21, err := client.DropVirtualChannel(ctx, nil)
 		retCheck(retNotNil, r21, err)
+
+		r22, err := client.Import(ctx, nil)
+		retCheck(retNotNil, r22, err)
+
+		r23, err := client.GetImportState(ctx, nil)
+		retCheck(retNotNil, r23, err)
+
+		r24, err := client.ReportImport(ctx, nil)
+		retCheck(retNotNil, r24, err)
+
+		r25, err := client.AddFlushedSegments(ctx, nil)
+		retCheck(retNotNil, r25, err)
+
+		r26, err := client.PinSegments(ctx, nil)
+		retCheck(retNotNil, r26, err)
+
+		r27, err := client.UnpinSegments(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.BroadcastAlteredCollection(ctx, nil)
+		retCheck(retNotNil, r28, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
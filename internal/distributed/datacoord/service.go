@@ -143,8 +143,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		Timeout: 10 * time.Second, // Wait 10 second for the ping ack before assuming the connection is dead
 	}
 
+	creds, err := Params.ServerCredentials()
+	if err != nil {
+		log.Error("failed to load grpc server credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
@@ -327,3 +335,38 @@ func (s *Server) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateR
 func (s *Server) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error) {
 	return s.dataCoord.DropVirtualChannel(ctx, req)
 }
+
+// Import imports the files into a collection by DataNodes
+func (s *Server) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return s.dataCoord.Import(ctx, req)
+}
+
+// GetImportState gets the state of an import task
+func (s *Server) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.dataCoord.GetImportState(ctx, req)
+}
+
+// ReportImport reports the imported segment to DataCoord
+func (s *Server) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return s.dataCoord.ReportImport(ctx, req)
+}
+
+// AddFlushedSegments adds the segments whose binlogs are in the object storage as flushed segments
+func (s *Server) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.AddFlushedSegments(ctx, req)
+}
+
+// PinSegments protects the segments of a collection from the garbage collection until they are unpinned
+func (s *Server) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	return s.dataCoord.PinSegments(ctx, req)
+}
+
+// UnpinSegments releases the segments pinned by PinSegments
+func (s *Server) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return s.dataCoord.UnpinSegments(ctx, req)
+}
+
+// BroadcastAlteredCollection notifies DataCoord of the properties of an altered collection
+func (s *Server) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.dataCoord.BroadcastAlteredCollection(ctx, req)
+}
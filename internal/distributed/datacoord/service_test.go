@@ -31,30 +31,37 @@ import (
 
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockDataCoord struct {
-	states               *internalpb.ComponentStates
-	status               *commonpb.Status
-	err                  error
-	initErr              error
-	startErr             error
-	stopErr              error
-	regErr               error
-	strResp              *milvuspb.StringResponse
-	infoResp             *datapb.GetSegmentInfoResponse
-	flushResp            *datapb.FlushResponse
-	assignResp           *datapb.AssignSegmentIDResponse
-	segStateResp         *datapb.GetSegmentStatesResponse
-	binResp              *datapb.GetInsertBinlogPathsResponse
-	colStatResp          *datapb.GetCollectionStatisticsResponse
-	partStatResp         *datapb.GetPartitionStatisticsResponse
-	recoverResp          *datapb.GetRecoveryInfoResponse
-	flushSegResp         *datapb.GetFlushedSegmentsResponse
-	metricResp           *milvuspb.GetMetricsResponse
-	compactionStateResp  *milvuspb.GetCompactionStateResponse
-	manualCompactionResp *milvuspb.ManualCompactionResponse
-	compactionPlansResp  *milvuspb.GetCompactionPlansResponse
-	watchChannelsResp    *datapb.WatchChannelsResponse
-	getFlushStateResp    *milvuspb.GetFlushStateResponse
-	dropVChanResp        *datapb.DropVirtualChannelResponse
+	states                 *internalpb.ComponentStates
+	status                 *commonpb.Status
+	err                    error
+	initErr                error
+	startErr               error
+	stopErr                error
+	regErr                 error
+	strResp                *milvuspb.StringResponse
+	infoResp               *datapb.GetSegmentInfoResponse
+	flushResp              *datapb.FlushResponse
+	assignResp             *datapb.AssignSegmentIDResponse
+	segStateResp           *datapb.GetSegmentStatesResponse
+	binResp                *datapb.GetInsertBinlogPathsResponse
+	colStatResp            *datapb.GetCollectionStatisticsResponse
+	partStatResp           *datapb.GetPartitionStatisticsResponse
+	recoverResp            *datapb.GetRecoveryInfoResponse
+	flushSegResp           *datapb.GetFlushedSegmentsResponse
+	metricResp             *milvuspb.GetMetricsResponse
+	compactionStateResp    *milvuspb.GetCompactionStateResponse
+	manualCompactionResp   *milvuspb.ManualCompactionResponse
+	compactionPlansResp    *milvuspb.GetCompactionPlansResponse
+	watchChannelsResp      *datapb.WatchChannelsResponse
+	getFlushStateResp      *milvuspb.GetFlushStateResponse
+	dropVChanResp          *datapb.DropVirtualChannelResponse
+	importResp             *milvuspb.ImportResponse
+	importStateResp        *milvuspb.GetImportStateResponse
+	reportImportResp       *commonpb.Status
+	addFlushedSegmentsResp *commonpb.Status
+	pinSegmentsResp        *datapb.PinSegmentsResponse
+	unpinSegmentsResp      *commonpb.Status
+	broadcastAlteredResp   *commonpb.Status
 }
 
 func (m *MockDataCoord) Init() error {
@@ -164,6 +171,34 @@ func (m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.Drop
 	return m.dropVChanResp, m.err
 }
 
+func (m *MockDataCoord) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return m.importResp, m.err
+}
+
+func (m *MockDataCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return m.importStateResp, m.err
+}
+
+func (m *MockDataCoord) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return m.reportImportResp, m.err
+}
+
+func (m *MockDataCoord) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	return m.addFlushedSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	return m.pinSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return m.unpinSegmentsResp, m.err
+}
+
+func (m *MockDataCoord) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return m.broadcastAlteredResp, m.err
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 func Test_NewServer(t *testing.T) {
 	ctx := context.Background()
@@ -338,6 +373,69 @@ func Test_NewServer(t *testing.T) {
 		assert.NotNil(t, resp)
 	})
 
+	t.Run("Import", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			importResp: &milvuspb.ImportResponse{},
+		}
+		resp, err := server.Import(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("GetImportState", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			importStateResp: &milvuspb.GetImportStateResponse{},
+		}
+		resp, err := server.GetImportState(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("ReportImport", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			reportImportResp: &commonpb.Status{},
+		}
+		resp, err := server.ReportImport(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("AddFlushedSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			addFlushedSegmentsResp: &commonpb.Status{},
+		}
+		resp, err := server.AddFlushedSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("PinSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			pinSegmentsResp: &datapb.PinSegmentsResponse{},
+		}
+		resp, err := server.PinSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("UnpinSegments", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			unpinSegmentsResp: &commonpb.Status{},
+		}
+		resp, err := server.UnpinSegments(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
+	t.Run("BroadcastAlteredCollection", func(t *testing.T) {
+		server.dataCoord = &MockDataCoord{
+			broadcastAlteredResp: &commonpb.Status{},
+		}
+		resp, err := server.BroadcastAlteredCollection(ctx, nil)
+		assert.Nil(t, err)
+		assert.NotNil(t, resp)
+	})
+
 	err := server.Stop()
 	assert.Nil(t, err)
 }
@@ -50,6 +50,11 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
 			ClientMaxSendSize: ClientParams.ClientMaxSendSize,
+			TLSMode:           ClientParams.TLSMode,
+			CaPemPath:         ClientParams.CaPemPath,
+			ClientPemPath:     ClientParams.ClientPemPath,
+			ClientKeyPath:     ClientParams.ClientKeyPath,
+			ServerName:        ClientParams.ServerName,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.DataNodeRole)
@@ -181,3 +186,17 @@ func (c *Client) Compaction(ctx context.Context, req *datapb.CompactionPlan) (*c
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// Import imports the files of an import task into segments
+func (c *Client) Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(datapb.DataNodeClient).Import(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
@@ -127,8 +127,16 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 		return
 	}
 
+	creds, err := Params.ServerCredentials()
+	if err != nil {
+		log.Error("failed to load grpc server credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
@@ -353,3 +361,8 @@ func (s *Server) GetMetrics(ctx context.Context, request *milvuspb.GetMetricsReq
 func (s *Server) Compaction(ctx context.Context, request *datapb.CompactionPlan) (*commonpb.Status, error) {
 	return s.datanode.Compaction(ctx, request)
 }
+
+// Import imports the files of an import task into segments
+func (s *Server) Import(ctx context.Context, request *datapb.ImportTask) (*commonpb.Status, error) {
+	return s.datanode.Import(ctx, request)
+}
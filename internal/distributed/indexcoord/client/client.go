@@ -57,6 +57,11 @@ func NewClient(ctx context.Context, metaRoot string, etcdCli *clientv3.Client) (
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
 			ClientMaxSendSize: ClientParams.ClientMaxSendSize,
+			TLSMode:           ClientParams.TLSMode,
+			CaPemPath:         ClientParams.CaPemPath,
+			ClientPemPath:     ClientParams.ClientPemPath,
+			ClientKeyPath:     ClientParams.ClientKeyPath,
+			ServerName:        ClientParams.ServerName,
 		},
 		sess: sess,
 	}
@@ -50,6 +50,11 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
 			ClientMaxSendSize: ClientParams.ClientMaxSendSize,
+			TLSMode:           ClientParams.TLSMode,
+			CaPemPath:         ClientParams.CaPemPath,
+			ClientPemPath:     ClientParams.ClientPemPath,
+			ClientKeyPath:     ClientParams.ClientKeyPath,
+			ServerName:        ClientParams.ServerName,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.IndexNodeRole)
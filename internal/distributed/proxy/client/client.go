@@ -50,6 +50,12 @@ func NewClient(ctx context.Context, addr string) (*Client, error) {
 		grpcClient: &grpcclient.ClientBase{
 			ClientMaxRecvSize: ClientParams.ClientMaxRecvSize,
 			ClientMaxSendSize: ClientParams.ClientMaxSendSize,
+			TLSMode:           ClientParams.TLSMode,
+			CaPemPath:         ClientParams.CaPemPath,
+			ClientPemPath:     ClientParams.ClientPemPath,
+			ClientKeyPath:     ClientParams.ClientKeyPath,
+			ServerName:        ClientParams.ServerName,
+			NodeToken:         ClientParams.NodeToken,
 		},
 	}
 	client.grpcClient.SetRole(typeutil.ProxyRole)
@@ -167,3 +173,45 @@ func (c *Client) SendRetrieveResult(ctx context.Context, results *internalpb.Ret
 	}
 	return ret.(*commonpb.Status), err
 }
+
+// InvalidateCredentialCache invalidate the credential cache of specific user
+func (c *Client) InvalidateCredentialCache(ctx context.Context, req *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(proxypb.ProxyClient).InvalidateCredentialCache(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// RefreshPolicyInfoCache refresh the privileges cached by proxy
+func (c *Client) RefreshPolicyInfoCache(ctx context.Context, req *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(proxypb.ProxyClient).RefreshPolicyInfoCache(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
+
+// SetDMLQuota set the backpressure factor of DML requests on proxy
+func (c *Client) SetDMLQuota(ctx context.Context, req *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	ret, err := c.grpcClient.ReCall(ctx, func(client interface{}) (interface{}, error) {
+		if !funcutil.CheckCtxValid(ctx) {
+			return nil, ctx.Err()
+		}
+		return client.(proxypb.ProxyClient).SetDMLQuota(ctx, req)
+	})
+	if err != nil || ret == nil {
+		return nil, err
+	}
+	return ret.(*commonpb.Status), err
+}
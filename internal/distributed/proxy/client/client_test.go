@@ -74,6 +74,15 @@ func Test_NewClient(t *testing.T) {
 
 		r6, err := client.SendRetrieveResult(ctx, nil)
 		retCheck(retNotNil, r6, err)
+
+		r7, err := client.InvalidateCredentialCache(ctx, nil)
+		retCheck(retNotNil, r7, err)
+
+		r8, err := client.RefreshPolicyInfoCache(ctx, nil)
+		retCheck(retNotNil, r8, err)
+
+		r9, err := client.SetDMLQuota(ctx, nil)
+		retCheck(retNotNil, r9, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
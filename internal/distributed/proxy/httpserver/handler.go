@@ -0,0 +1,270 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// RouterPrefix is the path prefix of the REST APIs
+const RouterPrefix = "/api/v1"
+
+// errInvalidRequest is returned when the body of the request can not be decoded
+var errInvalidRequest = errors.New("invalid request")
+
+// handlerFunc decodes the json body and calls the proxy, the returned message is encoded as the response
+type handlerFunc func(ctx context.Context, body []byte) (proto.Message, error)
+
+// Handlers serves the REST APIs by translating the json requests to the milvuspb requests of the proxy
+type Handlers struct {
+	proxy          types.ProxyComponent
+	maxRequestSize int64
+}
+
+// NewHandlers creates the handlers of the proxy, the requests whose body exceeds maxRequestSize are rejected
+func NewHandlers(proxy types.ProxyComponent, maxRequestSize int64) *Handlers {
+	return &Handlers{
+		proxy:          proxy,
+		maxRequestSize: maxRequestSize,
+	}
+}
+
+// RegisterRoutes registers the REST APIs to the mux, all the APIs accept POST requests with json body
+func (h *Handlers) RegisterRoutes(mux *http.ServeMux) {
+	mux.HandleFunc(RouterPrefix+"/collection/create", h.wrap(h.handleCreateCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/drop", h.wrap(h.handleDropCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/has", h.wrap(h.handleHasCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/describe", h.wrap(h.handleDescribeCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/list", h.wrap(h.handleShowCollections))
+	mux.HandleFunc(RouterPrefix+"/collection/load", h.wrap(h.handleLoadCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/release", h.wrap(h.handleReleaseCollection))
+	mux.HandleFunc(RouterPrefix+"/collection/statistics", h.wrap(h.handleGetCollectionStatistics))
+	mux.HandleFunc(RouterPrefix+"/entities/insert", h.wrap(h.handleInsert))
+	mux.HandleFunc(RouterPrefix+"/entities/delete", h.wrap(h.handleDelete))
+	mux.HandleFunc(RouterPrefix+"/entities/search", h.wrap(h.handleSearch))
+	mux.HandleFunc(RouterPrefix+"/entities/query", h.wrap(h.handleQuery))
+}
+
+// writeResponse encodes the message as json with the field names defined in proto files
+func writeResponse(w http.ResponseWriter, code int, msg proto.Message) {
+	marshaler := &jsonpb.Marshaler{OrigName: true, EmitDefaults: true}
+	var buffer bytes.Buffer
+	if err := marshaler.Marshal(&buffer, msg); err != nil {
+		log.Warn("failed to marshal response", zap.Error(err))
+		code = http.StatusInternalServerError
+		buffer.Reset()
+		_ = marshaler.Marshal(&buffer, &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: err.Error()})
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if _, err := w.Write(buffer.Bytes()); err != nil {
+		log.Warn("failed to send response", zap.Error(err))
+	}
+}
+
+func writeStatus(w http.ResponseWriter, code int, errorCode commonpb.ErrorCode, reason string) {
+	writeResponse(w, code, &commonpb.Status{ErrorCode: errorCode, Reason: reason})
+}
+
+// authenticate passes the authorization header to the proxy as the grpc metadata,
+// so that the credential and privileges are checked in the same way as grpc requests
+func authenticate(r *http.Request) (context.Context, error) {
+	ctx := r.Context()
+	if authorization := r.Header.Get(util.HeaderAuthorize); authorization != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(util.HeaderAuthorize, authorization))
+	}
+	if !proxy.Params.CommonCfg.AuthorizationEnabled {
+		return ctx, nil
+	}
+	return proxy.AuthenticationInterceptor(ctx)
+}
+
+// wrap reads the body of the request and writes the response returned by fn,
+// the requests which can not be decoded fail with IllegalArgument error code
+func (h *Handlers) wrap(fn handlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			writeStatus(w, http.StatusMethodNotAllowed, commonpb.ErrorCode_IllegalArgument,
+				fmt.Sprintf("method %s is not allowed, please use POST", r.Method))
+			return
+		}
+		ctx, err := authenticate(r)
+		if err != nil {
+			writeStatus(w, http.StatusUnauthorized, commonpb.ErrorCode_PermissionDenied, err.Error())
+			return
+		}
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, h.maxRequestSize))
+		if err != nil {
+			writeStatus(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument,
+				fmt.Sprintf("failed to read request body, err: %s", err.Error()))
+			return
+		}
+		resp, err := fn(ctx, body)
+		if errors.Is(err, errInvalidRequest) {
+			writeStatus(w, http.StatusBadRequest, commonpb.ErrorCode_IllegalArgument, err.Error())
+			return
+		}
+		if err != nil {
+			log.Warn("failed to handle http request", zap.String("path", r.URL.Path), zap.Error(err))
+			writeStatus(w, http.StatusInternalServerError, commonpb.ErrorCode_UnexpectedError, err.Error())
+			return
+		}
+		writeResponse(w, http.StatusOK, resp)
+	}
+}
+
+// unmarshalProto decodes the body as the json format of the proto message
+func unmarshalProto(body []byte, msg proto.Message) error {
+	if err := jsonpb.Unmarshal(bytes.NewReader(body), msg); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+// unmarshalJSON decodes the body to the json wrapper of the request
+func unmarshalJSON(body []byte, v interface{}) error {
+	if err := json.Unmarshal(body, v); err != nil {
+		return fmt.Errorf("%w: %s", errInvalidRequest, err.Error())
+	}
+	return nil
+}
+
+func (h *Handlers) handleCreateCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	wrapper := &CreateCollectionRequest{}
+	if err := unmarshalJSON(body, wrapper); err != nil {
+		return nil, err
+	}
+	req, err := wrapper.AsProto()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidRequest, err.Error())
+	}
+	return h.proxy.CreateCollection(ctx, req)
+}
+
+func (h *Handlers) handleDropCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.DropCollectionRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.DropCollection(ctx, req)
+}
+
+func (h *Handlers) handleHasCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.HasCollectionRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.HasCollection(ctx, req)
+}
+
+func (h *Handlers) handleDescribeCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.DescribeCollectionRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.DescribeCollection(ctx, req)
+}
+
+func (h *Handlers) handleShowCollections(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.ShowCollectionsRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.ShowCollections(ctx, req)
+}
+
+func (h *Handlers) handleLoadCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.LoadCollectionRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.LoadCollection(ctx, req)
+}
+
+func (h *Handlers) handleReleaseCollection(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.ReleaseCollectionRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.ReleaseCollection(ctx, req)
+}
+
+func (h *Handlers) handleGetCollectionStatistics(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.GetCollectionStatisticsRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.GetCollectionStatistics(ctx, req)
+}
+
+func (h *Handlers) handleInsert(ctx context.Context, body []byte) (proto.Message, error) {
+	wrapper := &InsertRequest{}
+	if err := unmarshalJSON(body, wrapper); err != nil {
+		return nil, err
+	}
+	req, err := wrapper.AsProto()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidRequest, err.Error())
+	}
+	return h.proxy.Insert(ctx, req)
+}
+
+func (h *Handlers) handleDelete(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.DeleteRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.Delete(ctx, req)
+}
+
+func (h *Handlers) handleSearch(ctx context.Context, body []byte) (proto.Message, error) {
+	wrapper := &SearchRequest{}
+	if err := unmarshalJSON(body, wrapper); err != nil {
+		return nil, err
+	}
+	req, err := wrapper.AsProto()
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", errInvalidRequest, err.Error())
+	}
+	return h.proxy.Search(ctx, req)
+}
+
+func (h *Handlers) handleQuery(ctx context.Context, body []byte) (proto.Message, error) {
+	req := &milvuspb.QueryRequest{}
+	if err := unmarshalProto(body, req); err != nil {
+		return nil, err
+	}
+	return h.proxy.Query(ctx, req)
+}
@@ -0,0 +1,255 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/proxy"
+	"github.com/milvus-io/milvus/internal/types"
+)
+
+type mockProxy struct {
+	types.ProxyComponent
+
+	createCollectionReq *milvuspb.CreateCollectionRequest
+	insertReq           *milvuspb.InsertRequest
+	searchReq           *milvuspb.SearchRequest
+	err                 error
+}
+
+func (m *mockProxy) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	m.createCollectionReq = req
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, m.err
+}
+
+func (m *mockProxy) HasCollection(ctx context.Context, req *milvuspb.HasCollectionRequest) (*milvuspb.BoolResponse, error) {
+	return &milvuspb.BoolResponse{
+		Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		Value:  req.CollectionName == "collection1",
+	}, m.err
+}
+
+func (m *mockProxy) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_RateLimit, Reason: "rate limited"}, m.err
+}
+
+func (m *mockProxy) Insert(ctx context.Context, req *milvuspb.InsertRequest) (*milvuspb.MutationResult, error) {
+	m.insertReq = req
+	return &milvuspb.MutationResult{
+		Status:    &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+		InsertCnt: int64(req.NumRows),
+	}, m.err
+}
+
+func (m *mockProxy) Search(ctx context.Context, req *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
+	m.searchReq = req
+	return &milvuspb.SearchResults{Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}}, m.err
+}
+
+func newTestServer(t *testing.T, p *mockProxy) *httptest.Server {
+	mux := http.NewServeMux()
+	NewHandlers(p, 1024*1024).RegisterRoutes(mux)
+	server := httptest.NewServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+func post(t *testing.T, server *httptest.Server, path string, body interface{}) (int, map[string]interface{}) {
+	var data []byte
+	switch b := body.(type) {
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		data, err = json.Marshal(body)
+		assert.Nil(t, err)
+	}
+	resp, err := http.Post(server.URL+RouterPrefix+path, "application/json", bytes.NewReader(data))
+	assert.Nil(t, err)
+	defer resp.Body.Close()
+	result := make(map[string]interface{})
+	assert.Nil(t, json.NewDecoder(resp.Body).Decode(&result))
+	return resp.StatusCode, result
+}
+
+func TestHandlers(t *testing.T) {
+	p := &mockProxy{}
+	server := newTestServer(t, p)
+
+	t.Run("create collection", func(t *testing.T) {
+		code, result := post(t, server, "/collection/create", map[string]interface{}{
+			"collection_name": "collection1",
+			"shards_num":      2,
+			"schema": map[string]interface{}{
+				"name": "collection1",
+				"fields": []map[string]interface{}{
+					{"name": "id", "data_type": "Int64", "is_primary_key": true},
+					{"name": "vec", "data_type": "FloatVector", "type_params": []map[string]string{{"key": "dim", "value": "2"}}},
+				},
+			},
+		})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "Success", result["error_code"])
+		assert.Equal(t, int32(2), p.createCollectionReq.ShardsNum)
+		schema := &schemapb.CollectionSchema{}
+		assert.Nil(t, proto.Unmarshal(p.createCollectionReq.Schema, schema))
+		assert.Equal(t, 2, len(schema.Fields))
+		assert.Equal(t, schemapb.DataType_FloatVector, schema.Fields[1].DataType)
+
+		code, result = post(t, server, "/collection/create", map[string]interface{}{"collection_name": "collection1"})
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "IllegalArgument", result["error_code"])
+	})
+
+	t.Run("has collection", func(t *testing.T) {
+		code, result := post(t, server, "/collection/has", `{"collection_name": "collection1"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, true, result["value"])
+
+		code, result = post(t, server, "/collection/has", `{"not_exist_field": 1}`)
+		assert.Equal(t, http.StatusBadRequest, code)
+		assert.Equal(t, "IllegalArgument", result["error_code"])
+	})
+
+	t.Run("error code", func(t *testing.T) {
+		code, result := post(t, server, "/collection/drop", `{"collection_name": "collection1"}`)
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "RateLimit", result["error_code"])
+		assert.Equal(t, "rate limited", result["reason"])
+	})
+
+	t.Run("insert", func(t *testing.T) {
+		code, result := post(t, server, "/entities/insert", map[string]interface{}{
+			"collection_name": "collection1",
+			"fields_data": []map[string]interface{}{
+				{"field_name": "id", "type": "Int64", "field": []int64{1, 2}},
+				{"field_name": "vec", "type": "FloatVector", "field": [][]float32{{0.1, 0.2}, {0.3, 0.4}}},
+			},
+		})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "2", result["insert_cnt"])
+		assert.Equal(t, uint32(2), p.insertReq.NumRows)
+		assert.Equal(t, []int64{1, 2}, p.insertReq.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, int64(2), p.insertReq.FieldsData[1].GetVectors().GetDim())
+
+		code, _ = post(t, server, "/entities/insert", map[string]interface{}{
+			"collection_name": "collection1",
+			"fields_data": []map[string]interface{}{
+				{"field_name": "id", "type": "Int64", "field": []int64{1, 2}},
+				{"field_name": "vec", "type": "FloatVector", "field": [][]float32{{0.1, 0.2}}},
+			},
+		})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("search", func(t *testing.T) {
+		code, _ := post(t, server, "/entities/search", map[string]interface{}{
+			"collection_name": "collection1",
+			"expr":            "id > 0",
+			"search_params":   map[string]string{"anns_field": "vec", "topk": "10"},
+			"vectors":         [][]float32{{0.1, 0.2}},
+		})
+		assert.Equal(t, http.StatusOK, code)
+		assert.Equal(t, "id > 0", p.searchReq.Dsl)
+		assert.Equal(t, commonpb.DslType_BoolExprV1, p.searchReq.DslType)
+		assert.Equal(t, "anns_field", p.searchReq.SearchParams[0].Key)
+		placeholderGroup := &milvuspb.PlaceholderGroup{}
+		assert.Nil(t, proto.Unmarshal(p.searchReq.PlaceholderGroup, placeholderGroup))
+		assert.Equal(t, 1, len(placeholderGroup.Placeholders[0].Values))
+		assert.Equal(t, 8, len(placeholderGroup.Placeholders[0].Values[0]))
+
+		code, _ = post(t, server, "/entities/search", map[string]interface{}{"collection_name": "collection1"})
+		assert.Equal(t, http.StatusBadRequest, code)
+	})
+
+	t.Run("proxy error", func(t *testing.T) {
+		p.err = errors.New("mock error")
+		defer func() {
+			p.err = nil
+		}()
+		code, result := post(t, server, "/collection/has", `{"collection_name": "collection1"}`)
+		assert.Equal(t, http.StatusInternalServerError, code)
+		assert.Equal(t, "UnexpectedError", result["error_code"])
+	})
+
+	t.Run("method not allowed", func(t *testing.T) {
+		resp, err := http.Get(server.URL + RouterPrefix + "/collection/has")
+		assert.Nil(t, err)
+		resp.Body.Close()
+		assert.Equal(t, http.StatusMethodNotAllowed, resp.StatusCode)
+	})
+
+	t.Run("authentication", func(t *testing.T) {
+		authEnabled := proxy.Params.CommonCfg.AuthorizationEnabled
+		defer func() {
+			proxy.Params.CommonCfg.AuthorizationEnabled = authEnabled
+		}()
+		proxy.Params.CommonCfg.AuthorizationEnabled = true
+		code, result := post(t, server, "/collection/has", `{"collection_name": "collection1"}`)
+		assert.Equal(t, http.StatusUnauthorized, code)
+		assert.Equal(t, "PermissionDenied", result["error_code"])
+	})
+}
+
+func TestFieldData_AsProto(t *testing.T) {
+	cases := []struct {
+		field *FieldData
+		rows  int
+	}{
+		{&FieldData{FieldName: "f", Type: "Bool", Field: json.RawMessage(`[true, false]`)}, 2},
+		{&FieldData{FieldName: "f", Type: "Int32", Field: json.RawMessage(`[1, 2, 3]`)}, 3},
+		{&FieldData{FieldName: "f", Type: "Float", Field: json.RawMessage(`[1.5]`)}, 1},
+		{&FieldData{FieldName: "f", Type: "Double", Field: json.RawMessage(`[1.5, 2.5]`)}, 2},
+		{&FieldData{FieldName: "f", Type: "String", Field: json.RawMessage(`["a", "b"]`)}, 2},
+		{&FieldData{FieldName: "f", Type: "JSON", Field: json.RawMessage(`[{"a": 1}, [1, 2], "b"]`)}, 3},
+		{&FieldData{FieldName: "f", Type: "Int64", Field: json.RawMessage(`[1, null]`), ValidData: []bool{true, false}}, 2},
+		{&FieldData{FieldName: "f", Type: "BinaryVector", Field: json.RawMessage(`["AQ==", "Ag=="]`)}, 2},
+	}
+	for _, c := range cases {
+		fieldData, rows, err := c.field.AsProto()
+		assert.Nil(t, err)
+		assert.Equal(t, c.rows, rows)
+		assert.Equal(t, c.field.Type, fieldData.Type.String())
+	}
+
+	_, _, err := (&FieldData{FieldName: "f", Type: "NotExist", Field: json.RawMessage(`[1]`)}).AsProto()
+	assert.NotNil(t, err)
+	_, _, err = (&FieldData{FieldName: "f", Type: "Int64", Field: json.RawMessage(`["a"]`)}).AsProto()
+	assert.NotNil(t, err)
+	_, _, err = (&FieldData{FieldName: "f", Type: "FloatVector", Field: json.RawMessage(`[[1], [1, 2]]`)}).AsProto()
+	assert.NotNil(t, err)
+	_, _, err = (&FieldData{FieldName: "f", Type: "Int64", Field: json.RawMessage(`[1, 2]`), ValidData: []bool{true}}).AsProto()
+	assert.NotNil(t, err)
+
+	fieldData, _, err := (&FieldData{FieldName: "f", Type: "JSON", Field: json.RawMessage(`[{"a": 1}, null]`), ValidData: []bool{true, false}}).AsProto()
+	assert.Nil(t, err)
+	assert.Equal(t, [][]byte{[]byte(`{"a": 1}`), []byte(`null`)}, fieldData.GetScalars().GetJsonData().GetData())
+	assert.Equal(t, []bool{true, false}, fieldData.GetValidData())
+}
@@ -0,0 +1,321 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package httpserver
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// CreateCollectionRequest is the json body of creating collection,
+// the schema is a json object instead of the serialized bytes carried by milvuspb.CreateCollectionRequest
+type CreateCollectionRequest struct {
+	DbName           string          `json:"db_name"`
+	CollectionName   string          `json:"collection_name"`
+	Schema           json.RawMessage `json:"schema"`
+	ShardsNum        int32           `json:"shards_num"`
+	ConsistencyLevel string          `json:"consistency_level"`
+}
+
+// AsProto converts the request to milvuspb.CreateCollectionRequest
+func (r *CreateCollectionRequest) AsProto() (*milvuspb.CreateCollectionRequest, error) {
+	if len(r.Schema) == 0 {
+		return nil, fmt.Errorf("schema of collection %s is empty", r.CollectionName)
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := jsonpb.Unmarshal(bytes.NewReader(r.Schema), schema); err != nil {
+		return nil, fmt.Errorf("invalid schema, err: %w", err)
+	}
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+	level := commonpb.ConsistencyLevel_Strong
+	if r.ConsistencyLevel != "" {
+		value, ok := commonpb.ConsistencyLevel_value[r.ConsistencyLevel]
+		if !ok {
+			return nil, fmt.Errorf("invalid consistency level %s", r.ConsistencyLevel)
+		}
+		level = commonpb.ConsistencyLevel(value)
+	}
+	return &milvuspb.CreateCollectionRequest{
+		DbName:           r.DbName,
+		CollectionName:   r.CollectionName,
+		Schema:           schemaBytes,
+		ShardsNum:        r.ShardsNum,
+		ConsistencyLevel: level,
+	}, nil
+}
+
+// FieldData is the column of a field to insert, the json format of Field depends on the type:
+// an array of bool, number or string for scalar fields, an array of json values for JSON,
+// an array of float arrays for FloatVector, and an array of base64 encoded bytes for BinaryVector.
+// ValidData marks the null rows of a nullable field, whose values in Field can be null
+type FieldData struct {
+	FieldName string          `json:"field_name"`
+	Type      string          `json:"type"`
+	Field     json.RawMessage `json:"field"`
+	ValidData []bool          `json:"valid_data"`
+}
+
+// AsProto converts the column to schemapb.FieldData and returns the number of rows of it
+func (f *FieldData) AsProto() (*schemapb.FieldData, int, error) {
+	value, ok := schemapb.DataType_value[f.Type]
+	if !ok {
+		return nil, 0, fmt.Errorf("invalid data type %s of field %s", f.Type, f.FieldName)
+	}
+	dataType := schemapb.DataType(value)
+	fieldData := &schemapb.FieldData{
+		Type:      dataType,
+		FieldName: f.FieldName,
+	}
+	var scalars *schemapb.ScalarField
+	var numRows int
+	var err error
+	switch dataType {
+	case schemapb.DataType_Bool:
+		var data []bool
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		var data []int32
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}}
+	case schemapb.DataType_Int64:
+		var data []int64
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}}
+	case schemapb.DataType_Float:
+		var data []float32
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}}
+	case schemapb.DataType_Double:
+		var data []float64
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		var data []string
+		err = json.Unmarshal(f.Field, &data)
+		numRows = len(data)
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}}
+	case schemapb.DataType_JSON:
+		var docs []json.RawMessage
+		err = json.Unmarshal(f.Field, &docs)
+		numRows = len(docs)
+		data := make([][]byte, 0, len(docs))
+		for _, doc := range docs {
+			data = append(data, doc)
+		}
+		scalars = &schemapb.ScalarField{Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: data}}}
+	case schemapb.DataType_FloatVector:
+		var data [][]float32
+		if err = json.Unmarshal(f.Field, &data); err != nil {
+			break
+		}
+		dim, flattened, err := flattenFloatVectors(data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid data of field %s, err: %w", f.FieldName, err)
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  int64(dim),
+			Data: &schemapb.VectorField_FloatVector{FloatVector: &schemapb.FloatArray{Data: flattened}},
+		}}
+		return fieldData, len(data), nil
+	case schemapb.DataType_BinaryVector:
+		var data [][]byte
+		if err = json.Unmarshal(f.Field, &data); err != nil {
+			break
+		}
+		dim, flattened, err := flattenBinaryVectors(data)
+		if err != nil {
+			return nil, 0, fmt.Errorf("invalid data of field %s, err: %w", f.FieldName, err)
+		}
+		fieldData.Field = &schemapb.FieldData_Vectors{Vectors: &schemapb.VectorField{
+			Dim:  int64(dim),
+			Data: &schemapb.VectorField_BinaryVector{BinaryVector: flattened},
+		}}
+		return fieldData, len(data), nil
+	default:
+		return nil, 0, fmt.Errorf("unsupported data type %s of field %s", f.Type, f.FieldName)
+	}
+	if err != nil {
+		return nil, 0, fmt.Errorf("invalid data of field %s, err: %w", f.FieldName, err)
+	}
+	if len(f.ValidData) > 0 {
+		if len(f.ValidData) != numRows {
+			return nil, 0, fmt.Errorf("the number of valid data of field %s is %d, but the number of rows is %d", f.FieldName, len(f.ValidData), numRows)
+		}
+		fieldData.ValidData = f.ValidData
+	}
+	fieldData.Field = &schemapb.FieldData_Scalars{Scalars: scalars}
+	return fieldData, numRows, nil
+}
+
+// flattenFloatVectors concatenates the float vectors which must have the same dimension
+func flattenFloatVectors(vectors [][]float32) (int, []float32, error) {
+	if len(vectors) == 0 {
+		return 0, nil, nil
+	}
+	dim := len(vectors[0])
+	flattened := make([]float32, 0, dim*len(vectors))
+	for _, vector := range vectors {
+		if len(vector) != dim || dim == 0 {
+			return 0, nil, fmt.Errorf("the dimensions of vectors are not the same or zero")
+		}
+		flattened = append(flattened, vector...)
+	}
+	return dim, flattened, nil
+}
+
+// flattenBinaryVectors concatenates the binary vectors which must have the same number of bytes,
+// the dimension of a binary vector is the number of bits
+func flattenBinaryVectors(vectors [][]byte) (int, []byte, error) {
+	if len(vectors) == 0 {
+		return 0, nil, nil
+	}
+	size := len(vectors[0])
+	flattened := make([]byte, 0, size*len(vectors))
+	for _, vector := range vectors {
+		if len(vector) != size || size == 0 {
+			return 0, nil, fmt.Errorf("the dimensions of vectors are not the same or zero")
+		}
+		flattened = append(flattened, vector...)
+	}
+	return size * 8, flattened, nil
+}
+
+// InsertRequest is the json body of inserting entities in columns
+type InsertRequest struct {
+	DbName         string       `json:"db_name"`
+	CollectionName string       `json:"collection_name"`
+	PartitionName  string       `json:"partition_name"`
+	FieldsData     []*FieldData `json:"fields_data"`
+}
+
+// AsProto converts the request to milvuspb.InsertRequest, all the columns must have the same number of rows
+func (r *InsertRequest) AsProto() (*milvuspb.InsertRequest, error) {
+	req := &milvuspb.InsertRequest{
+		DbName:         r.DbName,
+		CollectionName: r.CollectionName,
+		PartitionName:  r.PartitionName,
+		FieldsData:     make([]*schemapb.FieldData, 0, len(r.FieldsData)),
+	}
+	numRows := -1
+	for _, f := range r.FieldsData {
+		fieldData, rows, err := f.AsProto()
+		if err != nil {
+			return nil, err
+		}
+		if numRows >= 0 && rows != numRows {
+			return nil, fmt.Errorf("the number of rows of field %s is %d, but others are %d", f.FieldName, rows, numRows)
+		}
+		numRows = rows
+		req.FieldsData = append(req.FieldsData, fieldData)
+	}
+	if numRows <= 0 {
+		return nil, fmt.Errorf("no entities to insert")
+	}
+	req.NumRows = uint32(numRows)
+	return req, nil
+}
+
+// SearchRequest is the json body of searching, the target vectors are given by Vectors for float vector fields
+// or BinaryVectors for binary vector fields instead of the serialized placeholder group
+type SearchRequest struct {
+	DbName             string            `json:"db_name"`
+	CollectionName     string            `json:"collection_name"`
+	PartitionNames     []string          `json:"partition_names"`
+	Expr               string            `json:"expr"`
+	OutputFields       []string          `json:"output_fields"`
+	SearchParams       map[string]string `json:"search_params"`
+	TravelTimestamp    uint64            `json:"travel_timestamp"`
+	GuaranteeTimestamp uint64            `json:"guarantee_timestamp"`
+	Vectors            [][]float32       `json:"vectors"`
+	BinaryVectors      [][]byte          `json:"binary_vectors"`
+}
+
+// AsProto converts the request to milvuspb.SearchRequest
+func (r *SearchRequest) AsProto() (*milvuspb.SearchRequest, error) {
+	placeholderGroup, err := r.placeholderGroup()
+	if err != nil {
+		return nil, err
+	}
+	placeholderGroupBytes, err := proto.Marshal(placeholderGroup)
+	if err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(r.SearchParams))
+	for key := range r.SearchParams {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	searchParams := make([]*commonpb.KeyValuePair, 0, len(keys))
+	for _, key := range keys {
+		searchParams = append(searchParams, &commonpb.KeyValuePair{Key: key, Value: r.SearchParams[key]})
+	}
+	return &milvuspb.SearchRequest{
+		DbName:             r.DbName,
+		CollectionName:     r.CollectionName,
+		PartitionNames:     r.PartitionNames,
+		Dsl:                r.Expr,
+		DslType:            commonpb.DslType_BoolExprV1,
+		PlaceholderGroup:   placeholderGroupBytes,
+		OutputFields:       r.OutputFields,
+		SearchParams:       searchParams,
+		TravelTimestamp:    r.TravelTimestamp,
+		GuaranteeTimestamp: r.GuaranteeTimestamp,
+	}, nil
+}
+
+func (r *SearchRequest) placeholderGroup() (*milvuspb.PlaceholderGroup, error) {
+	if len(r.Vectors) > 0 && len(r.BinaryVectors) > 0 {
+		return nil, fmt.Errorf("vectors and binary_vectors can not be set at the same time")
+	}
+	placeholder := &milvuspb.PlaceholderValue{Tag: "$0"}
+	if len(r.Vectors) > 0 {
+		placeholder.Type = milvuspb.PlaceholderType_FloatVector
+		for _, vector := range r.Vectors {
+			var buffer bytes.Buffer
+			if err := binary.Write(&buffer, common.Endian, vector); err != nil {
+				return nil, err
+			}
+			placeholder.Values = append(placeholder.Values, buffer.Bytes())
+		}
+	} else if len(r.BinaryVectors) > 0 {
+		placeholder.Type = milvuspb.PlaceholderType_BinaryVector
+		placeholder.Values = r.BinaryVectors
+	} else {
+		return nil, fmt.Errorf("no vectors to search")
+	}
+	return &milvuspb.PlaceholderGroup{Placeholders: []*milvuspb.PlaceholderValue{placeholder}}, nil
+}
@@ -18,16 +18,21 @@ package grpcproxy
 
 import (
 	"context"
+	"crypto/tls"
+	"errors"
 	"fmt"
 	"io"
 	"net"
+	"net/http"
 	"strconv"
 	"sync"
 	"time"
 
+	grpc_middleware "github.com/grpc-ecosystem/go-grpc-middleware"
 	ot "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	dcc "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 	icc "github.com/milvus-io/milvus/internal/distributed/indexcoord/client"
+	"github.com/milvus-io/milvus/internal/distributed/proxy/httpserver"
 	qcc "github.com/milvus-io/milvus/internal/distributed/querycoord/client"
 	rcc "github.com/milvus-io/milvus/internal/distributed/rootcoord/client"
 	"github.com/milvus-io/milvus/internal/log"
@@ -38,6 +43,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proxy"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
@@ -58,6 +64,7 @@ type Server struct {
 	wg         sync.WaitGroup
 	proxy      types.ProxyComponent
 	grpcServer *grpc.Server
+	httpServer *http.Server
 
 	grpcErrChan chan error
 
@@ -112,13 +119,24 @@ func (s *Server) startGrpcLoop(grpcPort int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	creds, err := Params.ServerCredentials()
+	if err != nil {
+		log.Error("failed to load grpc server credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
 		grpc.MaxSendMsgSize(Params.ServerMaxSendSize),
-		grpc.UnaryInterceptor(ot.UnaryServerInterceptor(opts...)),
+		grpc.UnaryInterceptor(grpc_middleware.ChainUnaryServer(
+			ot.UnaryServerInterceptor(opts...),
+			proxy.UnaryServerAuthInterceptor(Params.NodeToken),
+		)),
 		grpc.StreamInterceptor(ot.StreamServerInterceptor(opts...)))
 	proxypb.RegisterProxyServer(s.grpcServer, s)
 	milvuspb.RegisterMilvusServiceServer(s.grpcServer, s)
@@ -168,6 +186,9 @@ func (s *Server) init() error {
 	proxy.Params.InitOnce()
 	proxy.Params.ProxyCfg.NetworkAddress = Params.GetAddress()
 	log.Debug("init Proxy's parameter table done", zap.String("address", Params.GetAddress()))
+	if proxy.Params.CommonCfg.AuthorizationEnabled && Params.NodeToken == "" {
+		return errors.New("common.security.nodeToken must be set if authorization is enabled")
+	}
 
 	serviceName := fmt.Sprintf("Proxy ip: %s, port: %d", Params.IP, Params.Port)
 	closer := trace.InitTracing(serviceName)
@@ -330,6 +351,47 @@ func (s *Server) start() error {
 		return err
 	}
 
+	if proxy.Params.ProxyCfg.HTTPEnabled {
+		if err := s.startHTTPServer(proxy.Params.ProxyCfg.HTTPPort); err != nil {
+			log.Warn("failed to start Proxy's http server", zap.Error(err))
+			return err
+		}
+	}
+
+	return nil
+}
+
+// startHTTPServer serves the REST APIs on the port, the tls mode is the same as the grpc server
+func (s *Server) startHTTPServer(port int) error {
+	lis, err := net.Listen("tcp", ":"+strconv.Itoa(port))
+	if err != nil {
+		return err
+	}
+	if Params.TLSMode != paramtable.TLSModeDisabled {
+		tlsConfig, err := crypto.ServerTLSConfig(Params.ServerPemPath, Params.ServerKeyPath, Params.CaPemPath,
+			Params.TLSMode == paramtable.TLSModeMutual)
+		if err != nil {
+			lis.Close()
+			return err
+		}
+		lis = tls.NewListener(lis, tlsConfig)
+	}
+
+	mux := http.NewServeMux()
+	httpserver.NewHandlers(s.proxy, int64(Params.ServerMaxRecvSize)).RegisterRoutes(mux)
+	s.httpServer = &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: proxy.Params.ProxyCfg.HTTPReadHeaderTimeout,
+	}
+
+	s.wg.Add(1)
+	go func() {
+		defer s.wg.Done()
+		log.Debug("Proxy http server listen on tcp", zap.Int("port", port))
+		if err := s.httpServer.Serve(lis); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Warn("failed to serve on Proxy's http listener", zap.Error(err))
+		}
+	}()
 	return nil
 }
 
@@ -352,6 +414,13 @@ func (s *Server) Stop() error {
 		s.grpcServer.GracefulStop()
 	}
 
+	if s.httpServer != nil {
+		log.Debug("Shutdown http server...")
+		if err := s.httpServer.Shutdown(context.Background()); err != nil {
+			log.Warn("failed to shutdown http server", zap.Error(err))
+		}
+	}
+
 	err = s.proxy.Stop()
 	if err != nil {
 		return err
@@ -382,6 +451,21 @@ func (s *Server) ReleaseDQLMessageStream(ctx context.Context, request *proxypb.R
 	return s.proxy.ReleaseDQLMessageStream(ctx, request)
 }
 
+// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user
+func (s *Server) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.InvalidateCredentialCache(ctx, request)
+}
+
+// RefreshPolicyInfoCache notifies Proxy to drop the cached privileges
+func (s *Server) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return s.proxy.RefreshPolicyInfoCache(ctx, request)
+}
+
+// SetDMLQuota notifies Proxy to slow down or reject the DML requests
+func (s *Server) SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	return s.proxy.SetDMLQuota(ctx, request)
+}
+
 // CreateCollection notifies Proxy to create a collection
 func (s *Server) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return s.proxy.CreateCollection(ctx, request)
@@ -490,6 +574,11 @@ func (s *Server) Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*
 	return s.proxy.Delete(ctx, request)
 }
 
+// Upsert notifies Proxy to upsert rows
+func (s *Server) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return s.proxy.Upsert(ctx, request)
+}
+
 func (s *Server) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return s.proxy.Search(ctx, request)
 }
@@ -553,6 +642,96 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.proxy.AlterAlias(ctx, request)
 }
 
+// RenameCollection notifies Proxy to rename a collection
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.RenameCollection(ctx, request)
+}
+
+// AlterCollection notifies Proxy to alter the properties of a collection
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.proxy.AlterCollection(ctx, request)
+}
+
+// AddField notifies Proxy to add a field to a collection
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.proxy.AddField(ctx, request)
+}
+
+// CreateDatabase notifies Proxy to create a database
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateDatabase(ctx, request)
+}
+
+// DropDatabase notifies Proxy to drop a database
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.proxy.DropDatabase(ctx, request)
+}
+
+// ListDatabases notifies Proxy to list all databases
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.proxy.ListDatabases(ctx, request)
+}
+
+// CreateCredential notifies Proxy to create a credential
+func (s *Server) CreateCredential(ctx context.Context, request *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateCredential(ctx, request)
+}
+
+// UpdateCredential notifies Proxy to update the password of a credential
+func (s *Server) UpdateCredential(ctx context.Context, request *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.UpdateCredential(ctx, request)
+}
+
+// DeleteCredential notifies Proxy to delete a credential
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.proxy.DeleteCredential(ctx, request)
+}
+
+// ListUsers notifies Proxy to list the usernames of all credentials
+func (s *Server) ListUsers(ctx context.Context, request *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return s.proxy.ListUsers(ctx, request)
+}
+
+// CreateRole notifies Proxy to create a role
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.CreateRole(ctx, request)
+}
+
+// DropRole notifies Proxy to drop a role
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.DropRole(ctx, request)
+}
+
+// OperateUserRole notifies Proxy to add the user to the role or remove the user from the role
+func (s *Server) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.proxy.OperateUserRole(ctx, request)
+}
+
+// GrantPrivilege notifies Proxy to grant a privilege to the role
+func (s *Server) GrantPrivilege(ctx context.Context, request *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.GrantPrivilege(ctx, request)
+}
+
+// RevokePrivilege notifies Proxy to revoke a privilege from the role
+func (s *Server) RevokePrivilege(ctx context.Context, request *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return s.proxy.RevokePrivilege(ctx, request)
+}
+
+// ListGrants notifies Proxy to list the privileges granted to the role
+func (s *Server) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.proxy.ListGrants(ctx, request)
+}
+
+// Import notifies Proxy to import the files into a collection
+func (s *Server) Import(ctx context.Context, request *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return s.proxy.Import(ctx, request)
+}
+
+// GetImportState notifies Proxy to get the state of an import task
+func (s *Server) GetImportState(ctx context.Context, request *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return s.proxy.GetImportState(ctx, request)
+}
+
 // GetCompactionState gets the state of a compaction
 func (s *Server) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
 	return s.proxy.GetCompactionState(ctx, req)
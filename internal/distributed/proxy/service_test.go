@@ -139,6 +139,74 @@ func (m *MockRootCoord) AlterAlias(ctx context.Context, req *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockRootCoord) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockRootCoord) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return nil, nil
+}
+
 func (m *MockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
 	return nil, nil
 }
@@ -419,6 +487,34 @@ func (m *MockDataCoord) DropVirtualChannel(ctx context.Context, req *datapb.Drop
 	return &datapb.DropVirtualChannelResponse{}, nil
 }
 
+func (m *MockDataCoord) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockDataCoord) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 ///////////////////////////////////////////////////////////////////////////////////////////////////////////////////////
 type MockProxy struct {
 	MockBase
@@ -453,6 +549,18 @@ func (m *MockProxy) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.Rel
 	return nil, nil
 }
 
+func (m *MockProxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
 	return nil, nil
 }
@@ -541,6 +649,10 @@ func (m *MockProxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	return nil, nil
 }
 
+func (m *MockProxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) Search(ctx context.Context, request *milvuspb.SearchRequest) (*milvuspb.SearchResults, error) {
 	return nil, nil
 }
@@ -597,6 +709,78 @@ func (m *MockProxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAlias
 	return nil, nil
 }
 
+func (m *MockProxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return nil, nil
+}
+
+func (m *MockProxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, nil
+}
+
 func (m *MockProxy) SetRootCoordClient(rootCoord types.RootCoord) {
 
 }
@@ -682,6 +866,21 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("InvalidateCredentialCache", func(t *testing.T) {
+		_, err := server.InvalidateCredentialCache(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RefreshPolicyInfoCache", func(t *testing.T) {
+		_, err := server.RefreshPolicyInfoCache(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("SetDMLQuota", func(t *testing.T) {
+		_, err := server.SetDMLQuota(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("CreateCollection", func(t *testing.T) {
 		_, err := server.CreateCollection(ctx, nil)
 		assert.Nil(t, err)
@@ -792,6 +991,11 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("Upsert", func(t *testing.T) {
+		_, err := server.Upsert(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("Search", func(t *testing.T) {
 		_, err := server.Search(ctx, nil)
 		assert.Nil(t, err)
@@ -862,6 +1066,96 @@ func Test_NewServer(t *testing.T) {
 		assert.Nil(t, err)
 	})
 
+	t.Run("RenameCollection", func(t *testing.T) {
+		_, err := server.RenameCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AlterCollection", func(t *testing.T) {
+		_, err := server.AlterCollection(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("AddField", func(t *testing.T) {
+		_, err := server.AddField(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateDatabase", func(t *testing.T) {
+		_, err := server.CreateDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropDatabase", func(t *testing.T) {
+		_, err := server.DropDatabase(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListDatabases", func(t *testing.T) {
+		_, err := server.ListDatabases(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateCredential", func(t *testing.T) {
+		_, err := server.CreateCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("UpdateCredential", func(t *testing.T) {
+		_, err := server.UpdateCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DeleteCredential", func(t *testing.T) {
+		_, err := server.DeleteCredential(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListUsers", func(t *testing.T) {
+		_, err := server.ListUsers(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("CreateRole", func(t *testing.T) {
+		_, err := server.CreateRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("DropRole", func(t *testing.T) {
+		_, err := server.DropRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("OperateUserRole", func(t *testing.T) {
+		_, err := server.OperateUserRole(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GrantPrivilege", func(t *testing.T) {
+		_, err := server.GrantPrivilege(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("RevokePrivilege", func(t *testing.T) {
+		_, err := server.RevokePrivilege(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("ListGrants", func(t *testing.T) {
+		_, err := server.ListGrants(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("Import", func(t *testing.T) {
+		_, err := server.Import(ctx, nil)
+		assert.Nil(t, err)
+	})
+
+	t.Run("GetImportState", func(t *testing.T) {
+		_, err := server.GetImportState(ctx, nil)
+		assert.Nil(t, err)
+	})
+
 	t.Run("GetCompactionState", func(t *testing.T) {
 		_, err := server.GetCompactionState(ctx, nil)
 		assert.Nil(t, err)
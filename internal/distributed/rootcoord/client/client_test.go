@@ -136,6 +136,57 @@ func Test_NewClient(t *testing.T) {
 
 		r26, err := client.AlterAlias(ctx, nil)
 		retCheck(retNotNil, r26, err)
+
+		r27, err := client.CreateDatabase(ctx, nil)
+		retCheck(retNotNil, r27, err)
+
+		r28, err := client.DropDatabase(ctx, nil)
+		retCheck(retNotNil, r28, err)
+
+		r29, err := client.ListDatabases(ctx, nil)
+		retCheck(retNotNil, r29, err)
+
+		r30, err := client.RenameCollection(ctx, nil)
+		retCheck(retNotNil, r30, err)
+
+		r31, err := client.CreateCredential(ctx, nil)
+		retCheck(retNotNil, r31, err)
+
+		r32, err := client.UpdateCredential(ctx, nil)
+		retCheck(retNotNil, r32, err)
+
+		r33, err := client.DeleteCredential(ctx, nil)
+		retCheck(retNotNil, r33, err)
+
+		r34, err := client.ListUsers(ctx, nil)
+		retCheck(retNotNil, r34, err)
+
+		r35, err := client.GetCredential(ctx, nil)
+		retCheck(retNotNil, r35, err)
+
+		r36, err := client.CreateRole(ctx, nil)
+		retCheck(retNotNil, r36, err)
+
+		r37, err := client.DropRole(ctx, nil)
+		retCheck(retNotNil, r37, err)
+
+		r38, err := client.OperateUserRole(ctx, nil)
+		retCheck(retNotNil, r38, err)
+
+		r39, err := client.GrantPrivilege(ctx, nil)
+		retCheck(retNotNil, r39, err)
+
+		r40, err := client.RevokePrivilege(ctx, nil)
+		retCheck(retNotNil, r40, err)
+
+		r41, err := client.ListGrants(ctx, nil)
+		retCheck(retNotNil, r41, err)
+
+		r42, err := client.AlterCollection(ctx, nil)
+		retCheck(retNotNil, r42, err)
+
+		r43, err := client.AddField(ctx, nil)
+		retCheck(retNotNil, r43, err)
 	}
 
 	client.grpcClient = &mock.ClientBase{
@@ -92,6 +92,36 @@ func (s *Server) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasReq
 	return s.rootCoord.AlterAlias(ctx, request)
 }
 
+// RenameCollection renames the specified collection.
+func (s *Server) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RenameCollection(ctx, request)
+}
+
+// AlterCollection alters the properties of the specified collection.
+func (s *Server) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AlterCollection(ctx, request)
+}
+
+// AddField adds a field to the specified collection.
+func (s *Server) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return s.rootCoord.AddField(ctx, request)
+}
+
+// CreateDatabase creates a database.
+func (s *Server) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateDatabase(ctx, request)
+}
+
+// DropDatabase drops the specified database.
+func (s *Server) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropDatabase(ctx, request)
+}
+
+// ListDatabases lists all databases.
+func (s *Server) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return s.rootCoord.ListDatabases(ctx, request)
+}
+
 // NewServer create a new RootCoord grpc server.
 func NewServer(ctx context.Context, factory msgstream.Factory) (*Server, error) {
 	ctx1, cancel := context.WithCancel(ctx)
@@ -249,8 +279,16 @@ func (s *Server) startGrpcLoop(port int) {
 	ctx, cancel := context.WithCancel(s.ctx)
 	defer cancel()
 
+	creds, err := Params.ServerCredentials()
+	if err != nil {
+		log.Error("failed to load grpc server credentials", zap.Error(err))
+		s.grpcErrChan <- err
+		return
+	}
+
 	opts := trace.GetInterceptorOpts()
 	s.grpcServer = grpc.NewServer(
+		grpc.Creds(creds),
 		grpc.KeepaliveEnforcementPolicy(kaep),
 		grpc.KeepaliveParams(kasp),
 		grpc.MaxRecvMsgSize(Params.ServerMaxRecvSize),
@@ -432,3 +470,58 @@ func (s *Server) SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFl
 func (s *Server) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return s.rootCoord.GetMetrics(ctx, in)
 }
+
+// CreateCredential creates a new credential.
+func (s *Server) CreateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return s.rootCoord.CreateCredential(ctx, request)
+}
+
+// UpdateCredential updates the password of an existing credential.
+func (s *Server) UpdateCredential(ctx context.Context, request *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return s.rootCoord.UpdateCredential(ctx, request)
+}
+
+// DeleteCredential deletes a credential.
+func (s *Server) DeleteCredential(ctx context.Context, request *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DeleteCredential(ctx, request)
+}
+
+// ListUsers lists the usernames of all credentials.
+func (s *Server) ListUsers(ctx context.Context, request *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return s.rootCoord.ListUsers(ctx, request)
+}
+
+// GetCredential gets the encrypted credential of a user.
+func (s *Server) GetCredential(ctx context.Context, request *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	return s.rootCoord.GetCredential(ctx, request)
+}
+
+// CreateRole creates a new role.
+func (s *Server) CreateRole(ctx context.Context, request *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.CreateRole(ctx, request)
+}
+
+// DropRole drops a role.
+func (s *Server) DropRole(ctx context.Context, request *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.DropRole(ctx, request)
+}
+
+// OperateUserRole adds the user to the role or removes the user from the role.
+func (s *Server) OperateUserRole(ctx context.Context, request *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return s.rootCoord.OperateUserRole(ctx, request)
+}
+
+// GrantPrivilege grants a privilege to the role.
+func (s *Server) GrantPrivilege(ctx context.Context, request *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.GrantPrivilege(ctx, request)
+}
+
+// RevokePrivilege revokes a privilege from the role.
+func (s *Server) RevokePrivilege(ctx context.Context, request *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return s.rootCoord.RevokePrivilege(ctx, request)
+}
+
+// ListGrants lists the privileges granted to the role or the user.
+func (s *Server) ListGrants(ctx context.Context, request *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return s.rootCoord.ListGrants(ctx, request)
+}
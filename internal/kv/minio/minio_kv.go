@@ -141,6 +141,11 @@ func (kv *MinIOKV) Load(key string) (string, error) {
 	return buf.String(), nil
 }
 
+// LoadReader returns a reader streaming the object with @key, the caller should close it.
+func (kv *MinIOKV) LoadReader(key string) (io.ReadCloser, error) {
+	return kv.minioClient.GetObject(kv.ctx, kv.bucketName, key, minio.GetObjectOptions{})
+}
+
 // FGetObject downloads file from minio to local storage system.
 func (kv *MinIOKV) FGetObject(key, localPath string) error {
 	return kv.minioClient.FGetObject(kv.ctx, kv.bucketName, key, localPath+key, minio.GetObjectOptions{})
@@ -199,6 +204,12 @@ func (kv *MinIOKV) Save(key, value string) error {
 	return err
 }
 
+// SaveReader saves @size bytes read from @reader as the object with @key.
+func (kv *MinIOKV) SaveReader(key string, reader io.Reader, size int64) error {
+	_, err := kv.minioClient.PutObject(kv.ctx, kv.bucketName, key, reader, size, minio.PutObjectOptions{})
+	return err
+}
+
 // MultiSave saves multiple objects, the path is the key of @kvs.
 // The object value is the value of @kvs.
 func (kv *MinIOKV) MultiSave(kvs map[string]string) error {
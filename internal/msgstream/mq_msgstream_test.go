@@ -38,6 +38,7 @@ import (
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
@@ -580,7 +581,7 @@ func TestStream_PulsarMsgStream_DeleteRepackFunc(t *testing.T) {
 		CollectionName: "Collection",
 		ShardName:      "chan-1",
 		Timestamps:     []Timestamp{1},
-		PrimaryKeys:    []int64{1},
+		PrimaryKeys:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
 	}
 	deleteMsg := &DeleteMsg{
 		BaseMsg:       baseMsg,
@@ -1805,7 +1806,7 @@ func getTsMsg(msgType MsgType, reqID UniqueID) TsMsg {
 			CollectionName: "Collection",
 			ShardName:      "1",
 			Timestamps:     []Timestamp{time},
-			PrimaryKeys:    []int64{1},
+			PrimaryKeys:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1}}}},
 		}
 		deleteMsg := &DeleteMsg{
 			BaseMsg:       baseMsg,
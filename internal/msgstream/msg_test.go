@@ -150,7 +150,7 @@ func TestDeleteMsg(t *testing.T) {
 			CollectionName: "test_collection",
 			ShardName:      "test-channel",
 			Timestamps:     []uint64{2, 1, 3},
-			PrimaryKeys:    []int64{1, 2, 3},
+			PrimaryKeys:    &schemapb.IDs{IdField: &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}}}},
 		},
 	}
 
@@ -23,6 +23,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // InsertRepackFunc is used to repack messages after hash by primary key
@@ -96,7 +98,7 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 		}
 
 		timestampLen := len(deleteRequest.Timestamps)
-		pkLen := len(deleteRequest.PrimaryKeys)
+		pkLen := typeutil.GetSizeOfIDs(deleteRequest.PrimaryKeys)
 		keysLen := len(keys)
 
 		if keysLen != timestampLen || keysLen != pkLen {
@@ -110,6 +112,8 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 				result[key] = &msgPack
 			}
 
+			primaryKeys := &schemapb.IDs{}
+			typeutil.AppendPKs(primaryKeys, typeutil.GetPK(deleteRequest.PrimaryKeys, int64(index)))
 			sliceRequest := internalpb.DeleteRequest{
 				Base: &commonpb.MsgBase{
 					MsgType:   commonpb.MsgType_Delete,
@@ -124,7 +128,7 @@ func DeleteRepackFunc(tsMsgs []TsMsg, hashKeys [][]int32) (map[int32]*MsgPack, e
 				PartitionName:  deleteRequest.PartitionName,
 				ShardName:      deleteRequest.ShardName,
 				Timestamps:     []uint64{deleteRequest.Timestamps[index]},
-				PrimaryKeys:    []int64{deleteRequest.PrimaryKeys[index]},
+				PrimaryKeys:    primaryKeys,
 			}
 
 			deleteMsg := &DeleteMsg{
@@ -49,6 +49,7 @@ const (
 	ErrorCode_OutOfMemory           ErrorCode = 24
 	ErrorCode_IndexNotExist         ErrorCode = 25
 	ErrorCode_EmptyCollection       ErrorCode = 26
+	ErrorCode_RateLimit             ErrorCode = 27
 	// internal error code.
 	ErrorCode_DDRequestRace ErrorCode = 1000
 )
@@ -80,6 +81,7 @@ var ErrorCode_name = map[int32]string{
 	24:   "OutOfMemory",
 	25:   "IndexNotExist",
 	26:   "EmptyCollection",
+	27:   "RateLimit",
 	1000: "DDRequestRace",
 }
 
@@ -110,6 +112,7 @@ var ErrorCode_value = map[string]int32{
 	"OutOfMemory":           24,
 	"IndexNotExist":         25,
 	"EmptyCollection":       26,
+	"RateLimit":             27,
 	"DDRequestRace":         1000,
 }
 
@@ -211,6 +214,12 @@ const (
 	MsgType_CreateAlias        MsgType = 108
 	MsgType_DropAlias          MsgType = 109
 	MsgType_AlterAlias         MsgType = 110
+	MsgType_CreateDatabase     MsgType = 111
+	MsgType_DropDatabase       MsgType = 112
+	MsgType_ListDatabases      MsgType = 113
+	MsgType_RenameCollection   MsgType = 114
+	MsgType_AlterCollection    MsgType = 115
+	MsgType_AddField           MsgType = 116
 	// DEFINITION REQUESTS: PARTITION
 	MsgType_CreatePartition   MsgType = 200
 	MsgType_DropPartition     MsgType = 201
@@ -234,6 +243,7 @@ const (
 	MsgType_Insert MsgType = 400
 	MsgType_Delete MsgType = 401
 	MsgType_Flush  MsgType = 402
+	MsgType_Upsert MsgType = 403
 	// QUERY
 	MsgType_Search                   MsgType = 500
 	MsgType_SearchResult             MsgType = 501
@@ -264,6 +274,20 @@ const (
 	MsgType_SegmentStatistics MsgType = 1206
 	MsgType_SegmentFlushDone  MsgType = 1207
 	MsgType_DataNodeTt        MsgType = 1208
+	// Credential
+	MsgType_CreateCredential MsgType = 1500
+	MsgType_GetCredential    MsgType = 1501
+	MsgType_DeleteCredential MsgType = 1502
+	MsgType_UpdateCredential MsgType = 1503
+	MsgType_ListUsers        MsgType = 1504
+	// RBAC
+	MsgType_CreateRole             MsgType = 1600
+	MsgType_DropRole               MsgType = 1601
+	MsgType_OperateUserRole        MsgType = 1602
+	MsgType_GrantPrivilege         MsgType = 1603
+	MsgType_RevokePrivilege        MsgType = 1604
+	MsgType_ListGrants             MsgType = 1605
+	MsgType_RefreshPolicyInfoCache MsgType = 1606
 )
 
 var MsgType_name = map[int32]string{
@@ -279,6 +303,12 @@ var MsgType_name = map[int32]string{
 	108:  "CreateAlias",
 	109:  "DropAlias",
 	110:  "AlterAlias",
+	111:  "CreateDatabase",
+	112:  "DropDatabase",
+	113:  "ListDatabases",
+	114:  "RenameCollection",
+	115:  "AlterCollection",
+	116:  "AddField",
 	200:  "CreatePartition",
 	201:  "DropPartition",
 	202:  "HasPartition",
@@ -298,6 +328,7 @@ var MsgType_name = map[int32]string{
 	400:  "Insert",
 	401:  "Delete",
 	402:  "Flush",
+	403:  "Upsert",
 	500:  "Search",
 	501:  "SearchResult",
 	502:  "GetIndexState",
@@ -325,6 +356,18 @@ var MsgType_name = map[int32]string{
 	1206: "SegmentStatistics",
 	1207: "SegmentFlushDone",
 	1208: "DataNodeTt",
+	1500: "CreateCredential",
+	1501: "GetCredential",
+	1502: "DeleteCredential",
+	1503: "UpdateCredential",
+	1504: "ListUsers",
+	1600: "CreateRole",
+	1601: "DropRole",
+	1602: "OperateUserRole",
+	1603: "GrantPrivilege",
+	1604: "RevokePrivilege",
+	1605: "ListGrants",
+	1606: "RefreshPolicyInfoCache",
 }
 
 var MsgType_value = map[string]int32{
@@ -340,6 +383,12 @@ var MsgType_value = map[string]int32{
 	"CreateAlias":              108,
 	"DropAlias":                109,
 	"AlterAlias":               110,
+	"CreateDatabase":           111,
+	"DropDatabase":             112,
+	"ListDatabases":            113,
+	"RenameCollection":         114,
+	"AlterCollection":          115,
+	"AddField":                 116,
 	"CreatePartition":          200,
 	"DropPartition":            201,
 	"HasPartition":             202,
@@ -359,6 +408,7 @@ var MsgType_value = map[string]int32{
 	"Insert":                   400,
 	"Delete":                   401,
 	"Flush":                    402,
+	"Upsert":                   403,
 	"Search":                   500,
 	"SearchResult":             501,
 	"GetIndexState":            502,
@@ -386,6 +436,18 @@ var MsgType_value = map[string]int32{
 	"SegmentStatistics":        1206,
 	"SegmentFlushDone":         1207,
 	"DataNodeTt":               1208,
+	"CreateCredential":         1500,
+	"GetCredential":            1501,
+	"DeleteCredential":         1502,
+	"UpdateCredential":         1503,
+	"ListUsers":                1504,
+	"CreateRole":               1600,
+	"DropRole":                 1601,
+	"OperateUserRole":          1602,
+	"GrantPrivilege":           1603,
+	"RevokePrivilege":          1604,
+	"ListGrants":               1605,
+	"RefreshPolicyInfoCache":   1606,
 }
 
 func (x MsgType) String() string {
@@ -483,6 +545,37 @@ func (ConsistencyLevel) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_555bd8c177793206, []int{6}
 }
 
+type ImportState int32
+
+const (
+	ImportState_ImportPending   ImportState = 0
+	ImportState_ImportFailed    ImportState = 1
+	ImportState_ImportStarted   ImportState = 2
+	ImportState_ImportCompleted ImportState = 3
+)
+
+var ImportState_name = map[int32]string{
+	0: "ImportPending",
+	1: "ImportFailed",
+	2: "ImportStarted",
+	3: "ImportCompleted",
+}
+
+var ImportState_value = map[string]int32{
+	"ImportPending":   0,
+	"ImportFailed":    1,
+	"ImportStarted":   2,
+	"ImportCompleted": 3,
+}
+
+func (x ImportState) String() string {
+	return proto.EnumName(ImportState_name, int32(x))
+}
+
+func (ImportState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_555bd8c177793206, []int{7}
+}
+
 type Status struct {
 	ErrorCode            ErrorCode `protobuf:"varint,1,opt,name=error_code,json=errorCode,proto3,enum=milvus.proto.common.ErrorCode" json:"error_code,omitempty"`
 	Reason               string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
@@ -869,6 +962,7 @@ func init() {
 	proto.RegisterEnum("milvus.proto.common.DslType", DslType_name, DslType_value)
 	proto.RegisterEnum("milvus.proto.common.CompactionState", CompactionState_name, CompactionState_value)
 	proto.RegisterEnum("milvus.proto.common.ConsistencyLevel", ConsistencyLevel_name, ConsistencyLevel_value)
+	proto.RegisterEnum("milvus.proto.common.ImportState", ImportState_name, ImportState_value)
 	proto.RegisterType((*Status)(nil), "milvus.proto.common.Status")
 	proto.RegisterType((*KeyValuePair)(nil), "milvus.proto.common.KeyValuePair")
 	proto.RegisterType((*KeyDataPair)(nil), "milvus.proto.common.KeyDataPair")
@@ -882,100 +976,114 @@ func init() {
 func init() { proto.RegisterFile("common.proto", fileDescriptor_555bd8c177793206) }
 
 var fileDescriptor_555bd8c177793206 = []byte{
-	// 1509 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x5b, 0x73, 0x1b, 0x4b,
-	0x11, 0xf6, 0x6a, 0x15, 0xcb, 0x6a, 0xcb, 0xf6, 0x64, 0x7c, 0x89, 0x4f, 0x08, 0x54, 0xca, 0x4f,
-	0x29, 0x57, 0x9d, 0x04, 0x48, 0x01, 0x4f, 0xe7, 0xc1, 0xd2, 0xda, 0x8e, 0x2a, 0xb1, 0x63, 0x24,
-	0x27, 0x9c, 0xe2, 0x81, 0xd4, 0x78, 0xb7, 0x2d, 0x0d, 0x99, 0x9d, 0x11, 0x33, 0xb3, 0x8e, 0xc5,
-	0x13, 0xfc, 0x03, 0x38, 0xff, 0x82, 0x2a, 0xa0, 0xb8, 0x43, 0xf1, 0x0b, 0xb8, 0x3f, 0x73, 0xf9,
-	0x03, 0xfc, 0x00, 0xae, 0xe7, 0x4a, 0xf5, 0xec, 0x4a, 0xda, 0x53, 0x75, 0xf2, 0xc4, 0xdb, 0xf6,
-	0xd7, 0xdd, 0x5f, 0xf7, 0x74, 0xf7, 0xf4, 0x0e, 0x74, 0x52, 0x93, 0xe7, 0x46, 0xdf, 0x9f, 0x58,
-	0xe3, 0x0d, 0xdf, 0xcc, 0xa5, 0xba, 0x2a, 0x5c, 0x29, 0xdd, 0x2f, 0x55, 0x7b, 0x2f, 0x60, 0x79,
-	0xe8, 0x85, 0x2f, 0x1c, 0x7f, 0x0b, 0x00, 0xad, 0x35, 0xf6, 0x45, 0x6a, 0x32, 0xdc, 0x8d, 0xee,
-	0x46, 0xf7, 0xd6, 0x3f, 0xff, 0x99, 0xfb, 0x9f, 0xe0, 0x73, 0xff, 0x90, 0xcc, 0x7a, 0x26, 0xc3,
-	0x41, 0x1b, 0x67, 0x9f, 0x7c, 0x07, 0x96, 0x2d, 0x0a, 0x67, 0xf4, 0x6e, 0xe3, 0x6e, 0x74, 0xaf,
-	0x3d, 0xa8, 0xa4, 0xbd, 0x2f, 0x42, 0xe7, 0x31, 0x4e, 0x9f, 0x0b, 0x55, 0xe0, 0x99, 0x90, 0x96,
-	0x33, 0x88, 0x5f, 0xe2, 0x34, 0xf0, 0xb7, 0x07, 0xf4, 0xc9, 0xb7, 0xe0, 0xc6, 0x15, 0xa9, 0x2b,
-	0xc7, 0x52, 0xd8, 0x7b, 0x08, 0xab, 0x8f, 0x71, 0x9a, 0x08, 0x2f, 0x5e, 0xe3, 0xc6, 0xa1, 0x99,
-	0x09, 0x2f, 0x82, 0x57, 0x67, 0x10, 0xbe, 0xf7, 0xee, 0x40, 0xb3, 0xab, 0xcc, 0xc5, 0x82, 0x32,
-	0x0a, 0xca, 0x8a, 0xf2, 0x4d, 0x68, 0x1d, 0x64, 0x99, 0x45, 0xe7, 0xf8, 0x3a, 0x34, 0xe4, 0xa4,
-	0x62, 0x6b, 0xc8, 0x09, 0x91, 0x4d, 0x8c, 0xf5, 0x81, 0x2c, 0x1e, 0x84, 0xef, 0xbd, 0x77, 0x22,
-	0x68, 0x9d, 0xb8, 0x51, 0x57, 0x38, 0xe4, 0x5f, 0x82, 0x95, 0xdc, 0x8d, 0x5e, 0xf8, 0xe9, 0x64,
-	0x56, 0x9a, 0x3b, 0x9f, 0x58, 0x9a, 0x13, 0x37, 0x3a, 0x9f, 0x4e, 0x70, 0xd0, 0xca, 0xcb, 0x0f,
-	0xca, 0x24, 0x77, 0xa3, 0x7e, 0x52, 0x31, 0x97, 0x02, 0xbf, 0x03, 0x6d, 0x2f, 0x73, 0x74, 0x5e,
-	0xe4, 0x93, 0xdd, 0xf8, 0x6e, 0x74, 0xaf, 0x39, 0x58, 0x00, 0xfc, 0x36, 0xac, 0x38, 0x53, 0xd8,
-	0x14, 0xfb, 0xc9, 0x6e, 0x33, 0xb8, 0xcd, 0xe5, 0xbd, 0xb7, 0xa0, 0x7d, 0xe2, 0x46, 0x8f, 0x50,
-	0x64, 0x68, 0xf9, 0x67, 0xa1, 0x79, 0x21, 0x5c, 0x99, 0xd1, 0xea, 0xeb, 0x33, 0xa2, 0x13, 0x0c,
-	0x82, 0xe5, 0xde, 0xd7, 0xa0, 0x93, 0x9c, 0x3c, 0xf9, 0x3f, 0x18, 0x28, 0x75, 0x37, 0x16, 0x36,
-	0x3b, 0x15, 0xf9, 0xac, 0x63, 0x0b, 0x60, 0xff, 0xd7, 0x4d, 0x68, 0xcf, 0xc7, 0x83, 0xaf, 0x42,
-	0x6b, 0x58, 0xa4, 0x29, 0x3a, 0xc7, 0x96, 0xf8, 0x26, 0x6c, 0x3c, 0xd3, 0x78, 0x3d, 0xc1, 0xd4,
-	0x63, 0x16, 0x6c, 0x58, 0xc4, 0x6f, 0xc2, 0x5a, 0xcf, 0x68, 0x8d, 0xa9, 0x3f, 0x12, 0x52, 0x61,
-	0xc6, 0x1a, 0x7c, 0x0b, 0xd8, 0x19, 0xda, 0x5c, 0x3a, 0x27, 0x8d, 0x4e, 0x50, 0x4b, 0xcc, 0x58,
-	0xcc, 0x6f, 0xc1, 0x66, 0xcf, 0x28, 0x85, 0xa9, 0x97, 0x46, 0x9f, 0x1a, 0x7f, 0x78, 0x2d, 0x9d,
-	0x77, 0xac, 0x49, 0xb4, 0x7d, 0xa5, 0x70, 0x24, 0xd4, 0x81, 0x1d, 0x15, 0x39, 0x6a, 0xcf, 0x6e,
-	0x10, 0x47, 0x05, 0x26, 0x32, 0x47, 0x4d, 0x4c, 0xac, 0x55, 0x43, 0xfb, 0x3a, 0xc3, 0x6b, 0xea,
-	0x0f, 0x5b, 0xe1, 0x6f, 0xc0, 0x76, 0x85, 0xd6, 0x02, 0x88, 0x1c, 0x59, 0x9b, 0x6f, 0xc0, 0x6a,
-	0xa5, 0x3a, 0x7f, 0x7a, 0xf6, 0x98, 0x41, 0x8d, 0x61, 0x60, 0x5e, 0x0d, 0x30, 0x35, 0x36, 0x63,
-	0xab, 0xb5, 0x14, 0x9e, 0x63, 0xea, 0x8d, 0xed, 0x27, 0xac, 0x43, 0x09, 0x57, 0xe0, 0x10, 0x85,
-	0x4d, 0xc7, 0x03, 0x74, 0x85, 0xf2, 0x6c, 0x8d, 0x33, 0xe8, 0x1c, 0x49, 0x85, 0xa7, 0xc6, 0x1f,
-	0x99, 0x42, 0x67, 0x6c, 0x9d, 0xaf, 0x03, 0x9c, 0xa0, 0x17, 0x55, 0x05, 0x36, 0x28, 0x6c, 0x4f,
-	0xa4, 0x63, 0xac, 0x00, 0xc6, 0x77, 0x80, 0xf7, 0x84, 0xd6, 0xc6, 0xf7, 0x2c, 0x0a, 0x8f, 0x47,
-	0x46, 0x65, 0x68, 0xd9, 0x4d, 0x4a, 0xe7, 0x63, 0xb8, 0x54, 0xc8, 0xf8, 0xc2, 0x3a, 0x41, 0x85,
-	0x73, 0xeb, 0xcd, 0x85, 0x75, 0x85, 0x93, 0xf5, 0x16, 0x25, 0xdf, 0x2d, 0xa4, 0xca, 0x42, 0x49,
-	0xca, 0xb6, 0x6c, 0x53, 0x8e, 0x55, 0xf2, 0xa7, 0x4f, 0xfa, 0xc3, 0x73, 0xb6, 0xc3, 0xb7, 0xe1,
-	0x66, 0x85, 0x9c, 0xa0, 0xb7, 0x32, 0x0d, 0xc5, 0xbb, 0x45, 0xa9, 0x3e, 0x2d, 0xfc, 0xd3, 0xcb,
-	0x13, 0xcc, 0x8d, 0x9d, 0xb2, 0x5d, 0x6a, 0x68, 0x60, 0x9a, 0xb5, 0x88, 0xbd, 0x41, 0x11, 0x0e,
-	0xf3, 0x89, 0x9f, 0x2e, 0xca, 0xcb, 0x6e, 0x73, 0x0e, 0x6b, 0x49, 0x32, 0xc0, 0x6f, 0x14, 0xe8,
-	0xfc, 0x40, 0xa4, 0xc8, 0xfe, 0xde, 0xda, 0x7f, 0x1b, 0x20, 0xf8, 0xd2, 0x42, 0x42, 0xce, 0x61,
-	0x7d, 0x21, 0x9d, 0x1a, 0x8d, 0x6c, 0x89, 0x77, 0x60, 0xe5, 0x99, 0x96, 0xce, 0x15, 0x98, 0xb1,
-	0x88, 0xea, 0xd6, 0xd7, 0x67, 0xd6, 0x8c, 0xe8, 0x4a, 0xb3, 0x06, 0x69, 0x8f, 0xa4, 0x96, 0x6e,
-	0x1c, 0x26, 0x06, 0x60, 0xb9, 0x2a, 0x60, 0x73, 0xdf, 0x41, 0x67, 0x88, 0x23, 0x1a, 0x8e, 0x92,
-	0x7b, 0x0b, 0x58, 0x5d, 0x5e, 0xb0, 0xcf, 0xd3, 0x8e, 0x68, 0x78, 0x8f, 0xad, 0x79, 0x25, 0xf5,
-	0x88, 0x35, 0x88, 0x6c, 0x88, 0x42, 0x05, 0xe2, 0x55, 0x68, 0x1d, 0xa9, 0x22, 0x44, 0x69, 0x86,
-	0x98, 0x24, 0x90, 0xd9, 0x0d, 0x52, 0x25, 0xd6, 0x4c, 0x26, 0x98, 0xb1, 0xe5, 0xfd, 0xef, 0xb5,
-	0xc3, 0xfe, 0x08, 0x6b, 0x60, 0x0d, 0xda, 0xcf, 0x74, 0x86, 0x97, 0x52, 0x63, 0xc6, 0x96, 0x42,
-	0x2b, 0x42, 0xcb, 0x6a, 0x35, 0xc9, 0xe8, 0xc4, 0xe4, 0x5d, 0xc3, 0x90, 0xea, 0xf9, 0x48, 0xb8,
-	0x1a, 0x74, 0x49, 0xfd, 0x4d, 0xd0, 0xa5, 0x56, 0x5e, 0xd4, 0xdd, 0x47, 0x54, 0xe7, 0xe1, 0xd8,
-	0xbc, 0x5a, 0x60, 0x8e, 0x8d, 0x29, 0xd2, 0x31, 0xfa, 0xe1, 0xd4, 0x79, 0xcc, 0x7b, 0x46, 0x5f,
-	0xca, 0x91, 0x63, 0x92, 0x22, 0x3d, 0x31, 0x22, 0xab, 0xb9, 0x7f, 0x9d, 0x3a, 0x3c, 0x40, 0x85,
-	0xc2, 0xd5, 0x59, 0x5f, 0x86, 0x61, 0x0c, 0xa9, 0x1e, 0x28, 0x29, 0x1c, 0x53, 0x74, 0x14, 0xca,
-	0xb2, 0x14, 0x73, 0x6a, 0xc2, 0x81, 0xf2, 0x68, 0x4b, 0x59, 0xf3, 0x2d, 0xd8, 0x28, 0xed, 0xcf,
-	0x84, 0xf5, 0x32, 0x90, 0xfc, 0x26, 0x0a, 0xed, 0xb6, 0x66, 0xb2, 0xc0, 0x7e, 0x4b, 0x77, 0xbf,
-	0xf3, 0x48, 0xb8, 0x05, 0xf4, 0xbb, 0x88, 0xef, 0xc0, 0xcd, 0xd9, 0xd1, 0x16, 0xf8, 0xef, 0x23,
-	0xbe, 0x09, 0xeb, 0x74, 0xb4, 0x39, 0xe6, 0xd8, 0x1f, 0x02, 0x48, 0x87, 0xa8, 0x81, 0x7f, 0x0c,
-	0x0c, 0xd5, 0x29, 0x6a, 0xf8, 0x9f, 0x42, 0x30, 0x62, 0xa8, 0xba, 0xee, 0xd8, 0xbb, 0x11, 0x65,
-	0x3a, 0x0b, 0x56, 0xc1, 0xec, 0xbd, 0x60, 0x48, 0xac, 0x73, 0xc3, 0xf7, 0x83, 0x61, 0xc5, 0x39,
-	0x47, 0x3f, 0x08, 0xe8, 0x23, 0xa1, 0x33, 0x73, 0x79, 0x39, 0x47, 0x3f, 0x8c, 0xf8, 0x2e, 0x6c,
-	0x92, 0x7b, 0x57, 0x28, 0xa1, 0xd3, 0x85, 0xfd, 0x47, 0x11, 0x67, 0xb3, 0x42, 0x86, 0xa9, 0x66,
-	0xdf, 0x6f, 0x84, 0xa2, 0x54, 0x09, 0x94, 0xd8, 0x0f, 0x1a, 0x7c, 0xbd, 0xac, 0x6e, 0x29, 0xff,
-	0xb0, 0xc1, 0x57, 0x61, 0xb9, 0xaf, 0x1d, 0x5a, 0xcf, 0xbe, 0x43, 0x93, 0xb7, 0x5c, 0xde, 0x5d,
-	0xf6, 0x5d, 0x9a, 0xef, 0x1b, 0x61, 0xf2, 0xd8, 0x3b, 0x41, 0x51, 0x6e, 0x19, 0xf6, 0x8f, 0x38,
-	0x1c, 0xb5, 0xbe, 0x72, 0xfe, 0x19, 0x53, 0xa4, 0x63, 0xf4, 0x8b, 0xeb, 0xc4, 0xfe, 0x15, 0xf3,
-	0xdb, 0xb0, 0x3d, 0xc3, 0xc2, 0x02, 0x98, 0x5f, 0xa4, 0x7f, 0xc7, 0xfc, 0x0e, 0xdc, 0x3a, 0x46,
-	0xbf, 0x98, 0x03, 0x72, 0x92, 0xce, 0xcb, 0xd4, 0xb1, 0xff, 0xc4, 0xfc, 0x53, 0xb0, 0x73, 0x8c,
-	0x7e, 0x5e, 0xdf, 0x9a, 0xf2, 0xbf, 0x31, 0x5f, 0x83, 0x95, 0x01, 0x6d, 0x08, 0xbc, 0x42, 0xf6,
-	0x6e, 0x4c, 0x4d, 0x9a, 0x89, 0x55, 0x3a, 0xef, 0xc5, 0x54, 0xba, 0xaf, 0x08, 0x9f, 0x8e, 0x93,
-	0xbc, 0x37, 0x16, 0x5a, 0xa3, 0x72, 0xec, 0xfd, 0x98, 0x6f, 0x03, 0x1b, 0x60, 0x6e, 0xae, 0xb0,
-	0x06, 0x7f, 0x40, 0x9b, 0x9f, 0x07, 0xe3, 0x2f, 0x17, 0x68, 0xa7, 0x73, 0xc5, 0x87, 0x31, 0x95,
-	0xba, 0xb4, 0xff, 0xb8, 0xe6, 0xa3, 0x98, 0x7f, 0x1a, 0x76, 0xcb, 0xdb, 0x3a, 0xab, 0x3f, 0x29,
-	0x47, 0xd8, 0xd7, 0x97, 0x86, 0x7d, 0xab, 0x39, 0x67, 0x4c, 0x50, 0x79, 0x31, 0xf7, 0xfb, 0x76,
-	0x93, 0x5a, 0x54, 0x79, 0x04, 0xd3, 0x3f, 0x37, 0xf9, 0x06, 0x40, 0x79, 0x77, 0x02, 0xf0, 0x97,
-	0x26, 0xa5, 0x7e, 0x8c, 0x9e, 0x56, 0xff, 0x15, 0xda, 0x69, 0x40, 0xff, 0x3a, 0x43, 0xeb, 0x2b,
-	0x85, 0xfd, 0xad, 0x49, 0xa5, 0x38, 0x97, 0x39, 0x9e, 0xcb, 0xf4, 0x25, 0xfb, 0x51, 0x9b, 0x4a,
-	0x11, 0x32, 0x3d, 0x35, 0x19, 0x92, 0x8d, 0x63, 0x3f, 0x6e, 0x53, 0xbf, 0x69, 0x5e, 0xca, 0x7e,
-	0xff, 0x24, 0xc8, 0xd5, 0x56, 0xec, 0x27, 0xec, 0xa7, 0xf4, 0x0b, 0x82, 0x4a, 0x3e, 0x1f, 0x3e,
-	0x65, 0x3f, 0x6b, 0x53, 0xa8, 0x03, 0xa5, 0x4c, 0x2a, 0xfc, 0x7c, 0x6a, 0x7f, 0xde, 0xa6, 0xb1,
-	0xaf, 0x45, 0xaf, 0xba, 0xf1, 0x8b, 0x36, 0xd5, 0xb4, 0xc2, 0xc3, 0xac, 0x24, 0xb4, 0xe8, 0x7e,
-	0x19, 0x58, 0xe9, 0x65, 0x45, 0x99, 0x9c, 0x7b, 0xf6, 0xab, 0xf6, 0xfe, 0x1e, 0xb4, 0x12, 0xa7,
-	0xc2, 0xaa, 0x6a, 0x41, 0x9c, 0x38, 0xc5, 0x96, 0xe8, 0x66, 0x77, 0x8d, 0x51, 0x87, 0xd7, 0x13,
-	0xfb, 0xfc, 0x73, 0x2c, 0xda, 0xef, 0xc2, 0x46, 0xcf, 0xe4, 0x13, 0x31, 0x9f, 0x88, 0xb0, 0x9d,
-	0xca, 0xb5, 0x86, 0x59, 0x79, 0xea, 0x25, 0x5a, 0x0f, 0x87, 0xd7, 0x98, 0x16, 0x9e, 0x36, 0x62,
-	0x44, 0x22, 0x39, 0xd1, 0xd0, 0x66, 0xac, 0xb1, 0xff, 0x36, 0xb0, 0x9e, 0xd1, 0x4e, 0x3a, 0x8f,
-	0x3a, 0x9d, 0x3e, 0xc1, 0x2b, 0x54, 0x61, 0xb7, 0x7a, 0x6b, 0xf4, 0x88, 0x2d, 0x85, 0x17, 0x03,
-	0x86, 0x3f, 0x7f, 0xb9, 0x81, 0xbb, 0xf4, 0x8b, 0x0c, 0xcf, 0x82, 0x75, 0x80, 0xc3, 0x2b, 0xd4,
-	0xbe, 0x10, 0x4a, 0x4d, 0x59, 0x4c, 0x72, 0xaf, 0x70, 0xde, 0xe4, 0xf2, 0x9b, 0xb4, 0x88, 0xbb,
-	0x5f, 0xf8, 0xea, 0xc3, 0x91, 0xf4, 0xe3, 0xe2, 0x82, 0x9e, 0x2d, 0x0f, 0xca, 0x77, 0xcc, 0x9b,
-	0xd2, 0x54, 0x5f, 0x0f, 0xa4, 0xf6, 0x68, 0xb5, 0x50, 0x0f, 0xc2, 0xd3, 0xe6, 0x41, 0xf9, 0xb4,
-	0x99, 0x5c, 0x5c, 0x2c, 0x07, 0xf9, 0xe1, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x51, 0x37, 0xf3,
-	0x7a, 0x2b, 0x0b, 0x00, 0x00,
+	// 1729 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x49, 0x73, 0x5c, 0x49,
+	0x11, 0x56, 0x2f, 0x96, 0xdc, 0xd5, 0x2d, 0x29, 0x5d, 0x5a, 0xac, 0xf1, 0x18, 0xc2, 0xa1, 0x93,
+	0x43, 0x11, 0x63, 0x03, 0x0e, 0xe0, 0x34, 0x07, 0xa9, 0x5b, 0x92, 0x3b, 0xac, 0x8d, 0xd7, 0x92,
+	0x99, 0x98, 0x03, 0x8e, 0xd2, 0x7b, 0xa9, 0xee, 0xc2, 0xf5, 0xaa, 0xde, 0x54, 0x55, 0xcb, 0x6a,
+	0x4e, 0xf0, 0x0f, 0x60, 0xf8, 0x03, 0xfc, 0x00, 0x20, 0xd8, 0xe1, 0xc8, 0x1e, 0x0c, 0xdb, 0x99,
+	0x1d, 0x8e, 0xfc, 0x00, 0xd6, 0x59, 0x89, 0xac, 0xf7, 0xba, 0xdf, 0x73, 0xc4, 0xcc, 0x89, 0x5b,
+	0xe5, 0x57, 0x99, 0x5f, 0x66, 0x65, 0x66, 0x65, 0x15, 0xeb, 0xc4, 0x26, 0x4d, 0x8d, 0xbe, 0x97,
+	0x59, 0xe3, 0x0d, 0x5f, 0x49, 0xa5, 0xba, 0x1c, 0xbb, 0x5c, 0xba, 0x97, 0x6f, 0x6d, 0x3e, 0x61,
+	0xf3, 0x03, 0x2f, 0xfc, 0xd8, 0xf1, 0x97, 0x19, 0x43, 0x6b, 0x8d, 0x7d, 0x12, 0x9b, 0x04, 0x37,
+	0x6a, 0x77, 0x6a, 0x77, 0x97, 0x3e, 0xf6, 0xe1, 0x7b, 0xef, 0x63, 0x73, 0x6f, 0x97, 0xd4, 0xba,
+	0x26, 0xc1, 0xa8, 0x85, 0xd3, 0x25, 0x5f, 0x67, 0xf3, 0x16, 0x85, 0x33, 0x7a, 0xa3, 0x7e, 0xa7,
+	0x76, 0xb7, 0x15, 0x15, 0xd2, 0xe6, 0x27, 0x58, 0xe7, 0x11, 0x4e, 0x1e, 0x0b, 0x35, 0xc6, 0x13,
+	0x21, 0x2d, 0x07, 0xd6, 0x78, 0x8a, 0x93, 0xc0, 0xdf, 0x8a, 0x68, 0xc9, 0x57, 0xd9, 0xb5, 0x4b,
+	0xda, 0x2e, 0x0c, 0x73, 0x61, 0xf3, 0x01, 0x6b, 0x3f, 0xc2, 0x49, 0x4f, 0x78, 0xf1, 0x01, 0x66,
+	0x9c, 0x35, 0x13, 0xe1, 0x45, 0xb0, 0xea, 0x44, 0x61, 0xbd, 0x79, 0x9b, 0x35, 0x77, 0x94, 0x39,
+	0x2f, 0x29, 0x6b, 0x61, 0xb3, 0xa0, 0x7c, 0x89, 0x2d, 0x6c, 0x27, 0x89, 0x45, 0xe7, 0xf8, 0x12,
+	0xab, 0xcb, 0xac, 0x60, 0xab, 0xcb, 0x8c, 0xc8, 0x32, 0x63, 0x7d, 0x20, 0x6b, 0x44, 0x61, 0xbd,
+	0xf9, 0x7a, 0x8d, 0x2d, 0x1c, 0xba, 0xe1, 0x8e, 0x70, 0xc8, 0x3f, 0xc9, 0xae, 0xa7, 0x6e, 0xf8,
+	0xc4, 0x4f, 0xb2, 0x69, 0x6a, 0x6e, 0xbf, 0x6f, 0x6a, 0x0e, 0xdd, 0xf0, 0x74, 0x92, 0x61, 0xb4,
+	0x90, 0xe6, 0x0b, 0x8a, 0x24, 0x75, 0xc3, 0x7e, 0xaf, 0x60, 0xce, 0x05, 0x7e, 0x9b, 0xb5, 0xbc,
+	0x4c, 0xd1, 0x79, 0x91, 0x66, 0x1b, 0x8d, 0x3b, 0xb5, 0xbb, 0xcd, 0xa8, 0x04, 0xf8, 0x2d, 0x76,
+	0xdd, 0x99, 0xb1, 0x8d, 0xb1, 0xdf, 0xdb, 0x68, 0x06, 0xb3, 0x99, 0xbc, 0xf9, 0x32, 0x6b, 0x1d,
+	0xba, 0xe1, 0x43, 0x14, 0x09, 0x5a, 0xfe, 0x11, 0xd6, 0x3c, 0x17, 0x2e, 0x8f, 0xa8, 0xfd, 0xc1,
+	0x11, 0xd1, 0x09, 0xa2, 0xa0, 0xb9, 0xf9, 0x19, 0xd6, 0xe9, 0x1d, 0x1e, 0xfc, 0x1f, 0x0c, 0x14,
+	0xba, 0x1b, 0x09, 0x9b, 0x1c, 0x89, 0x74, 0x5a, 0xb1, 0x12, 0xd8, 0x7a, 0xa3, 0xc9, 0x5a, 0xb3,
+	0xf6, 0xe0, 0x6d, 0xb6, 0x30, 0x18, 0xc7, 0x31, 0x3a, 0x07, 0x73, 0x7c, 0x85, 0x2d, 0x9f, 0x69,
+	0xbc, 0xca, 0x30, 0xf6, 0x98, 0x04, 0x1d, 0xa8, 0xf1, 0x1b, 0x6c, 0xb1, 0x6b, 0xb4, 0xc6, 0xd8,
+	0xef, 0x09, 0xa9, 0x30, 0x81, 0x3a, 0x5f, 0x65, 0x70, 0x82, 0x36, 0x95, 0xce, 0x49, 0xa3, 0x7b,
+	0xa8, 0x25, 0x26, 0xd0, 0xe0, 0x37, 0xd9, 0x4a, 0xd7, 0x28, 0x85, 0xb1, 0x97, 0x46, 0x1f, 0x19,
+	0xbf, 0x7b, 0x25, 0x9d, 0x77, 0xd0, 0x24, 0xda, 0xbe, 0x52, 0x38, 0x14, 0x6a, 0xdb, 0x0e, 0xc7,
+	0x29, 0x6a, 0x0f, 0xd7, 0x88, 0xa3, 0x00, 0x7b, 0x32, 0x45, 0x4d, 0x4c, 0xb0, 0x50, 0x41, 0xfb,
+	0x3a, 0xc1, 0x2b, 0xaa, 0x0f, 0x5c, 0xe7, 0x2f, 0xb0, 0xb5, 0x02, 0xad, 0x38, 0x10, 0x29, 0x42,
+	0x8b, 0x2f, 0xb3, 0x76, 0xb1, 0x75, 0x7a, 0x7c, 0xf2, 0x08, 0x58, 0x85, 0x21, 0x32, 0xcf, 0x22,
+	0x8c, 0x8d, 0x4d, 0xa0, 0x5d, 0x09, 0xe1, 0x31, 0xc6, 0xde, 0xd8, 0x7e, 0x0f, 0x3a, 0x14, 0x70,
+	0x01, 0x0e, 0x50, 0xd8, 0x78, 0x14, 0xa1, 0x1b, 0x2b, 0x0f, 0x8b, 0x1c, 0x58, 0x67, 0x4f, 0x2a,
+	0x3c, 0x32, 0x7e, 0xcf, 0x8c, 0x75, 0x02, 0x4b, 0x7c, 0x89, 0xb1, 0x43, 0xf4, 0xa2, 0xc8, 0xc0,
+	0x32, 0xb9, 0xed, 0x8a, 0x78, 0x84, 0x05, 0x00, 0x7c, 0x9d, 0xf1, 0xae, 0xd0, 0xda, 0xf8, 0xae,
+	0x45, 0xe1, 0x71, 0xcf, 0xa8, 0x04, 0x2d, 0xdc, 0xa0, 0x70, 0x9e, 0xc3, 0xa5, 0x42, 0xe0, 0xa5,
+	0x76, 0x0f, 0x15, 0xce, 0xb4, 0x57, 0x4a, 0xed, 0x02, 0x27, 0xed, 0x55, 0x0a, 0x7e, 0x67, 0x2c,
+	0x55, 0x12, 0x52, 0x92, 0x97, 0x65, 0x8d, 0x62, 0x2c, 0x82, 0x3f, 0x3a, 0xe8, 0x0f, 0x4e, 0x61,
+	0x9d, 0xaf, 0xb1, 0x1b, 0x05, 0x72, 0x88, 0xde, 0xca, 0x38, 0x24, 0xef, 0x26, 0x85, 0x7a, 0x3c,
+	0xf6, 0xc7, 0x17, 0x87, 0x98, 0x1a, 0x3b, 0x81, 0x0d, 0x2a, 0x68, 0x60, 0x9a, 0x96, 0x08, 0x5e,
+	0x20, 0x0f, 0xbb, 0x69, 0xe6, 0x27, 0x65, 0x7a, 0xe1, 0x16, 0x5f, 0x64, 0xad, 0x48, 0x78, 0x3c,
+	0x90, 0xa9, 0xf4, 0xf0, 0x22, 0xe7, 0x6c, 0xb1, 0xd7, 0x8b, 0xf0, 0xb5, 0x31, 0x3a, 0x1f, 0x89,
+	0x18, 0xe1, 0xef, 0x0b, 0x5b, 0xaf, 0x30, 0x16, 0xa8, 0x68, 0x3e, 0x21, 0xe7, 0x6c, 0xa9, 0x94,
+	0x8e, 0x8c, 0x46, 0x98, 0xe3, 0x1d, 0x76, 0xfd, 0x4c, 0x4b, 0xe7, 0xc6, 0x98, 0x40, 0x8d, 0xd2,
+	0xd8, 0xd7, 0x27, 0xd6, 0x0c, 0xe9, 0x86, 0x43, 0x9d, 0x76, 0xf7, 0xa4, 0x96, 0x6e, 0x14, 0x1a,
+	0x88, 0xb1, 0xf9, 0x22, 0x9f, 0xcd, 0x2d, 0xc7, 0x3a, 0x03, 0x1c, 0x52, 0xaf, 0xe4, 0xdc, 0xab,
+	0x0c, 0xaa, 0x72, 0xc9, 0x3e, 0x3b, 0x45, 0x8d, 0x7a, 0x79, 0xdf, 0x9a, 0x67, 0x52, 0x0f, 0xa1,
+	0x4e, 0x64, 0x03, 0x14, 0x2a, 0x10, 0xb7, 0xd9, 0xc2, 0x9e, 0x1a, 0x07, 0x2f, 0xcd, 0xe0, 0x93,
+	0x04, 0x52, 0xbb, 0x46, 0x5b, 0x3d, 0x6b, 0xb2, 0x0c, 0x13, 0x98, 0xdf, 0xfa, 0x4a, 0x27, 0x8c,
+	0x93, 0x30, 0x15, 0x16, 0x59, 0xeb, 0x4c, 0x27, 0x78, 0x21, 0x35, 0x26, 0x30, 0x17, 0x2a, 0x13,
+	0x2a, 0x58, 0x49, 0x51, 0x42, 0x27, 0x26, 0xeb, 0x0a, 0x86, 0x94, 0xde, 0x87, 0xc2, 0x55, 0xa0,
+	0x0b, 0x2a, 0x77, 0x0f, 0x5d, 0x6c, 0xe5, 0x79, 0xd5, 0x7c, 0x48, 0x69, 0x1f, 0x8c, 0xcc, 0xb3,
+	0x12, 0x73, 0x30, 0x22, 0x4f, 0xfb, 0xe8, 0x07, 0x13, 0xe7, 0x31, 0xed, 0x1a, 0x7d, 0x21, 0x87,
+	0x0e, 0x24, 0x79, 0x3a, 0x30, 0x22, 0xa9, 0x98, 0x7f, 0x96, 0x0a, 0x1e, 0xa1, 0x42, 0xe1, 0xaa,
+	0xac, 0x4f, 0x43, 0x6f, 0x86, 0x50, 0xb7, 0x95, 0x14, 0x0e, 0x14, 0x1d, 0x85, 0xa2, 0xcc, 0xc5,
+	0x94, 0x8a, 0xb0, 0xad, 0x3c, 0xda, 0x5c, 0xd6, 0x44, 0x9d, 0xeb, 0xd3, 0x24, 0xa7, 0x01, 0x02,
+	0x86, 0xba, 0x8b, 0x4c, 0x66, 0x48, 0x46, 0xc7, 0x3a, 0x90, 0xce, 0x4f, 0x11, 0x07, 0xaf, 0x51,
+	0xa4, 0x11, 0x6a, 0x91, 0x56, 0xdd, 0x5b, 0x3a, 0x54, 0xa0, 0xaf, 0x80, 0x8e, 0x92, 0xbe, 0x9d,
+	0x24, 0x7b, 0x12, 0x55, 0x02, 0x9e, 0xaf, 0xb2, 0xe5, 0xdc, 0xe3, 0x89, 0xb0, 0x5e, 0x06, 0x95,
+	0x5f, 0xd4, 0x42, 0x83, 0x59, 0x93, 0x95, 0xd8, 0x1b, 0x34, 0x7c, 0x3a, 0x0f, 0x85, 0x2b, 0xa1,
+	0x5f, 0xd6, 0xf8, 0x3a, 0xbb, 0x31, 0x4d, 0x66, 0x89, 0xff, 0xaa, 0xc6, 0x57, 0xd8, 0x12, 0x25,
+	0x73, 0x86, 0x39, 0xf8, 0x75, 0x00, 0x29, 0x6d, 0x15, 0xf0, 0x37, 0x81, 0xa1, 0xc8, 0x5b, 0x05,
+	0xff, 0x6d, 0x70, 0x46, 0x0c, 0x45, 0x9f, 0x39, 0x78, 0xb3, 0x46, 0x91, 0x4e, 0x9d, 0x15, 0x30,
+	0xbc, 0x15, 0x14, 0x89, 0x75, 0xa6, 0xf8, 0x76, 0x50, 0x2c, 0x38, 0x67, 0xe8, 0x3b, 0x01, 0x7d,
+	0x28, 0x74, 0x62, 0x2e, 0x2e, 0x66, 0xe8, 0xbb, 0x35, 0xbe, 0xc1, 0x56, 0xc8, 0x7c, 0x47, 0x28,
+	0xa1, 0xe3, 0x52, 0xff, 0xbd, 0x1a, 0x87, 0x69, 0xe9, 0xc2, 0x3d, 0x82, 0xaf, 0xd6, 0x43, 0x52,
+	0x8a, 0x00, 0x72, 0xec, 0x6b, 0x75, 0xbe, 0x94, 0xd7, 0x33, 0x97, 0xbf, 0x5e, 0xe7, 0x6d, 0x36,
+	0xdf, 0xd7, 0x0e, 0xad, 0x87, 0x2f, 0x52, 0xaf, 0xcf, 0xe7, 0xc3, 0x03, 0xbe, 0x44, 0x37, 0xea,
+	0x5a, 0xe8, 0x75, 0x78, 0x3d, 0x6c, 0x9c, 0x65, 0x41, 0xeb, 0xcb, 0x41, 0xc8, 0x67, 0x1e, 0xfc,
+	0xa3, 0x11, 0xce, 0x5d, 0x1d, 0x80, 0xff, 0x6c, 0x90, 0xdb, 0x7d, 0xf4, 0xe5, 0x6d, 0x86, 0x7f,
+	0x35, 0xf8, 0x2d, 0xb6, 0x36, 0xc5, 0xc2, 0x38, 0x9a, 0xdd, 0xe3, 0x7f, 0x37, 0xf8, 0x6d, 0x76,
+	0x73, 0x1f, 0x7d, 0x59, 0x72, 0x32, 0x92, 0xce, 0xcb, 0xd8, 0xc1, 0x7f, 0x1a, 0xfc, 0x45, 0xb6,
+	0xbe, 0x8f, 0x7e, 0x96, 0xec, 0xca, 0xe6, 0x7f, 0x1b, 0x7c, 0x91, 0x5d, 0x8f, 0x68, 0x5e, 0xe1,
+	0x25, 0xc2, 0x9b, 0x0d, 0xaa, 0xd8, 0x54, 0x2c, 0xc2, 0x79, 0xab, 0x41, 0x79, 0xfc, 0xb4, 0xf0,
+	0xf1, 0xa8, 0x97, 0x76, 0x47, 0x42, 0x6b, 0x54, 0x0e, 0xde, 0x6e, 0xf0, 0x35, 0xea, 0xbf, 0xd4,
+	0x5c, 0x62, 0x05, 0x7e, 0x87, 0xde, 0x21, 0x1e, 0x94, 0x3f, 0x35, 0x46, 0x3b, 0x99, 0x6d, 0xbc,
+	0xdb, 0xa0, 0xbc, 0xe7, 0xfa, 0xcf, 0xef, 0xbc, 0xd7, 0xe0, 0x1f, 0x62, 0x1b, 0xf9, 0xb0, 0x98,
+	0x16, 0x83, 0x36, 0x87, 0xd8, 0xd7, 0x17, 0x06, 0x3e, 0xdf, 0x9c, 0x31, 0xf6, 0x50, 0x79, 0x31,
+	0xb3, 0xfb, 0x42, 0x93, 0xea, 0x55, 0x58, 0x04, 0xd5, 0xdf, 0x35, 0xf9, 0x32, 0x63, 0xf9, 0xd5,
+	0x0d, 0xc0, 0xef, 0x9b, 0x14, 0xfa, 0x3e, 0x7a, 0x7a, 0x88, 0x2e, 0xd1, 0x4e, 0x02, 0xfa, 0x87,
+	0x29, 0x5a, 0x9d, 0x68, 0xf0, 0xc7, 0x26, 0xa5, 0xe2, 0x54, 0xa6, 0x78, 0x2a, 0xe3, 0xa7, 0xf0,
+	0x8d, 0x16, 0xa5, 0x22, 0x44, 0x7a, 0x64, 0x12, 0x24, 0x1d, 0x07, 0xdf, 0x6c, 0x51, 0xf1, 0xa9,
+	0x79, 0xf2, 0xe2, 0x7f, 0x2b, 0xc8, 0xc5, 0x50, 0xee, 0xf7, 0xe0, 0xdb, 0xf4, 0x20, 0xb2, 0x42,
+	0x3e, 0x1d, 0x1c, 0xc3, 0x77, 0x5a, 0xe4, 0x6a, 0x5b, 0x29, 0x13, 0x0b, 0x3f, 0x6b, 0xe1, 0xef,
+	0xb6, 0xe8, 0x0e, 0x54, 0xbc, 0x17, 0xd5, 0xf8, 0x5e, 0x8b, 0x72, 0x5a, 0xe0, 0xa1, 0x71, 0x7a,
+	0x34, 0x67, 0xbf, 0x1f, 0x58, 0xe9, 0xe6, 0x53, 0x24, 0xa7, 0x1e, 0x7e, 0x10, 0xf4, 0x8a, 0x79,
+	0x68, 0x31, 0x41, 0xed, 0xa5, 0x50, 0xf0, 0xa7, 0x76, 0xd1, 0x37, 0x15, 0xec, 0xcf, 0x6d, 0x52,
+	0xcd, 0x3b, 0xb2, 0x02, 0xff, 0x25, 0xc0, 0x67, 0x59, 0xf2, 0x3c, 0xc3, 0x5f, 0xdb, 0xe1, 0x7c,
+	0xd2, 0xf9, 0x33, 0x87, 0xd6, 0xc1, 0xdf, 0xda, 0xe4, 0x39, 0x77, 0x14, 0x19, 0x85, 0xf0, 0xc3,
+	0x0e, 0x25, 0x89, 0xba, 0x3f, 0x88, 0x3f, 0xea, 0xd0, 0xf1, 0x8e, 0x33, 0xb4, 0xc2, 0x23, 0x99,
+	0x04, 0xf4, 0xc7, 0x1d, 0x4a, 0xdd, 0xbe, 0x15, 0xda, 0x9f, 0x58, 0x79, 0x29, 0x15, 0x0e, 0x11,
+	0x7e, 0xd2, 0xc9, 0xef, 0xe8, 0xa5, 0x79, 0x8a, 0x25, 0xfa, 0xd3, 0x0e, 0x39, 0x20, 0x87, 0x41,
+	0xdd, 0xc1, 0xcf, 0x3a, 0xd4, 0xad, 0x11, 0x5e, 0x58, 0x74, 0xa3, 0x13, 0xa3, 0x64, 0x1c, 0x6a,
+	0x16, 0x1e, 0x7b, 0xf8, 0x79, 0x67, 0x6b, 0x93, 0x2d, 0xf4, 0x9c, 0x0a, 0x2f, 0xc4, 0x02, 0x6b,
+	0xf4, 0x9c, 0x82, 0x39, 0x1a, 0xa8, 0x3b, 0xc6, 0xa8, 0xdd, 0xab, 0xcc, 0x3e, 0xfe, 0x28, 0xd4,
+	0xb6, 0x76, 0xd8, 0x72, 0xd7, 0xa4, 0x99, 0x98, 0xdd, 0x84, 0xf0, 0x28, 0xe4, 0xaf, 0x09, 0x26,
+	0x01, 0x80, 0x39, 0x9a, 0xca, 0xbb, 0x57, 0x18, 0x8f, 0x3d, 0x3d, 0x44, 0x35, 0x12, 0xc9, 0x88,
+	0xf2, 0x94, 0x40, 0x7d, 0xeb, 0x15, 0x06, 0x5d, 0xa3, 0x9d, 0x74, 0x1e, 0x75, 0x3c, 0x39, 0xc0,
+	0x4b, 0x54, 0xe1, 0x49, 0xf3, 0xd6, 0xe8, 0x21, 0xcc, 0x85, 0x7f, 0x1b, 0x86, 0xff, 0x57, 0xfe,
+	0xf0, 0xed, 0xd0, 0x47, 0x85, 0x2c, 0x29, 0x9a, 0xdd, 0x4b, 0xd4, 0x7e, 0x2c, 0x94, 0x9a, 0x40,
+	0x83, 0xe4, 0xee, 0xd8, 0x79, 0x93, 0xca, 0xcf, 0x85, 0x97, 0xf5, 0x55, 0xd6, 0xee, 0xa7, 0xf4,
+	0x7b, 0x9e, 0x45, 0x96, 0x8b, 0x27, 0xa8, 0x13, 0x19, 0xb8, 0xe9, 0x6b, 0x11, 0xa0, 0xe2, 0x35,
+	0xae, 0x95, 0x4a, 0x03, 0x2f, 0x6c, 0x08, 0x30, 0xfc, 0xa8, 0x02, 0x54, 0x46, 0xdd, 0xd8, 0xf9,
+	0xf8, 0xab, 0x0f, 0x86, 0xd2, 0x8f, 0xc6, 0xe7, 0xf4, 0x31, 0xbd, 0x9f, 0xff, 0x54, 0x5f, 0x92,
+	0xa6, 0x58, 0xdd, 0x97, 0xda, 0xa3, 0xd5, 0x42, 0xdd, 0x0f, 0x9f, 0xd7, 0xfb, 0xf9, 0xe7, 0x35,
+	0x3b, 0x3f, 0x9f, 0x0f, 0xf2, 0x83, 0xff, 0x0d, 0x00, 0x15, 0xc2, 0x80, 0xad, 0x0d, 0x0d, 0x00,
+	0x00,
 }
@@ -1304,6 +1304,7 @@ type CollectionInfo struct {
 	Schema               *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
 	Partitions           []int64                    `protobuf:"varint,3,rep,packed,name=partitions,proto3" json:"partitions,omitempty"`
 	StartPositions       []*commonpb.KeyDataPair    `protobuf:"bytes,4,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,5,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
 	XXX_unrecognized     []byte                     `json:"-"`
 	XXX_sizecache        int32                      `json:"-"`
@@ -1362,6 +1363,13 @@ func (m *CollectionInfo) GetStartPositions() []*commonpb.KeyDataPair {
 	return nil
 }
 
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 type SegmentInfo struct {
 	ID             int64                   `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
 	CollectionID   int64                   `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
@@ -2594,16 +2602,18 @@ func (m *CompactionSegmentBinlogs) GetDeltalogs() []*FieldBinlog {
 }
 
 type CompactionPlan struct {
-	PlanID               int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
-	SegmentBinlogs       []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
-	StartTime            uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	TimeoutInSeconds     int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
-	Type                 CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
-	Timetravel           uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
-	Channel              string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	PlanID           int64                       `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
+	SegmentBinlogs   []*CompactionSegmentBinlogs `protobuf:"bytes,2,rep,name=segmentBinlogs,proto3" json:"segmentBinlogs,omitempty"`
+	StartTime        uint64                      `protobuf:"varint,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	TimeoutInSeconds int32                       `protobuf:"varint,4,opt,name=timeout_in_seconds,json=timeoutInSeconds,proto3" json:"timeout_in_seconds,omitempty"`
+	Type             CompactionType              `protobuf:"varint,5,opt,name=type,proto3,enum=milvus.proto.data.CompactionType" json:"type,omitempty"`
+	Timetravel       uint64                      `protobuf:"varint,6,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	Channel          string                      `protobuf:"bytes,7,opt,name=channel,proto3" json:"channel,omitempty"`
+	// the entities older than start_time minus collection_ttl are dropped, in nanoseconds
+	CollectionTtl        int64    `protobuf:"varint,8,opt,name=collection_ttl,json=collectionTtl,proto3" json:"collection_ttl,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *CompactionPlan) Reset()         { *m = CompactionPlan{} }
@@ -2680,6 +2690,13 @@ func (m *CompactionPlan) GetChannel() string {
 	return ""
 }
 
+func (m *CompactionPlan) GetCollectionTtl() int64 {
+	if m != nil {
+		return m.CollectionTtl
+	}
+	return 0
+}
+
 type CompactionResult struct {
 	PlanID               int64          `protobuf:"varint,1,opt,name=planID,proto3" json:"planID,omitempty"`
 	SegmentID            int64          `protobuf:"varint,2,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
@@ -3082,432 +3099,1343 @@ func (m *DropVirtualChannelResponse) GetStatus() *commonpb.Status {
 	return nil
 }
 
-func init() {
-	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
-	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
-	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
-	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
-	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
-	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
-	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
-	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
-	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
-	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
-	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
-	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
-	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
-	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
-	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
-	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
-	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
-	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
-	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
-	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
-	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
-	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
-	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
-	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
-	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
-	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
-	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
-	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
-	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
-	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
-	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
-	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
-	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
-	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
-	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
-	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
-	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
-	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
-	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
-	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
-	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
-	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
-	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
-	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
-	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
-	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
-	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
-	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
-	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
-	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
-	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
-	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+// The files imported into the segments allocated by DataCoord, the rows of the files are split evenly into the segments
+type ImportSegment struct {
+	ChannelName          string   `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Files                []string `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	SegmentIDs           []int64  `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
-
-var fileDescriptor_82cd95f524594f49 = []byte{
-	// 2842 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5b, 0xdd, 0x6f, 0x1b, 0xc7,
-	0x11, 0xf7, 0xf1, 0x43, 0x22, 0x87, 0x1f, 0xa2, 0x57, 0x8e, 0x42, 0x33, 0x8e, 0x2c, 0x5f, 0x12,
-	0x47, 0x76, 0x1c, 0xc9, 0x96, 0x1b, 0x34, 0xa8, 0x93, 0x06, 0x91, 0x65, 0xc9, 0x44, 0x25, 0x57,
-	0x39, 0x2a, 0x76, 0xd1, 0x14, 0x25, 0x4e, 0xbc, 0x15, 0x75, 0x35, 0xef, 0x8e, 0xbe, 0x5b, 0xca,
-	0x56, 0x5e, 0x62, 0x34, 0x40, 0x81, 0x16, 0x41, 0x9a, 0xa2, 0xaf, 0x05, 0x5a, 0xf4, 0xa9, 0x45,
-	0x5f, 0xda, 0xc7, 0xb6, 0xff, 0x40, 0xd0, 0xbe, 0xf7, 0x5f, 0xe8, 0x5b, 0xff, 0x86, 0x62, 0x3f,
-	0x6e, 0xef, 0x83, 0x47, 0xf2, 0x28, 0xf9, 0xe3, 0x4d, 0xbb, 0x37, 0xb3, 0x33, 0x3b, 0x3b, 0xf3,
-	0x9b, 0x99, 0xe5, 0x0a, 0x6a, 0x86, 0x4e, 0xf4, 0x76, 0xc7, 0x71, 0x5c, 0x63, 0xa5, 0xef, 0x3a,
-	0xc4, 0x41, 0x67, 0x2d, 0xb3, 0x77, 0x34, 0xf0, 0xf8, 0x68, 0x85, 0x7e, 0x6e, 0x94, 0x3b, 0x8e,
-	0x65, 0x39, 0x36, 0x9f, 0x6a, 0x54, 0x4d, 0x9b, 0x60, 0xd7, 0xd6, 0x7b, 0x62, 0x5c, 0x0e, 0x33,
-	0x34, 0xca, 0x5e, 0xe7, 0x10, 0x5b, 0x3a, 0x1f, 0xa9, 0x4f, 0xa0, 0xbc, 0xd9, 0x1b, 0x78, 0x87,
-	0x1a, 0x7e, 0x34, 0xc0, 0x1e, 0x41, 0xd7, 0x21, 0xb7, 0xaf, 0x7b, 0xb8, 0xae, 0x2c, 0x29, 0xcb,
-	0xa5, 0xb5, 0x0b, 0x2b, 0x11, 0x59, 0x42, 0xca, 0x8e, 0xd7, 0x5d, 0xd7, 0x3d, 0xac, 0x31, 0x4a,
-	0x84, 0x20, 0x67, 0xec, 0x37, 0x37, 0xea, 0x99, 0x25, 0x65, 0x39, 0xab, 0xb1, 0xbf, 0x91, 0x0a,
-	0xe5, 0x8e, 0xd3, 0xeb, 0xe1, 0x0e, 0x31, 0x1d, 0xbb, 0xb9, 0x51, 0xcf, 0xb1, 0x6f, 0x91, 0x39,
-	0xf5, 0x77, 0x0a, 0x54, 0x84, 0x68, 0xaf, 0xef, 0xd8, 0x1e, 0x46, 0x37, 0x61, 0xc6, 0x23, 0x3a,
-	0x19, 0x78, 0x42, 0xfa, 0x6b, 0x89, 0xd2, 0x5b, 0x8c, 0x44, 0x13, 0xa4, 0xa9, 0xc4, 0x67, 0x87,
-	0xc5, 0xa3, 0x45, 0x00, 0x0f, 0x77, 0x2d, 0x6c, 0x93, 0xe6, 0x86, 0x57, 0xcf, 0x2d, 0x65, 0x97,
-	0xb3, 0x5a, 0x68, 0x46, 0xfd, 0x8d, 0x02, 0xb5, 0x96, 0x3f, 0xf4, 0xad, 0x73, 0x0e, 0xf2, 0x1d,
-	0x67, 0x60, 0x13, 0xa6, 0x60, 0x45, 0xe3, 0x03, 0x74, 0x09, 0xca, 0x9d, 0x43, 0xdd, 0xb6, 0x71,
-	0xaf, 0x6d, 0xeb, 0x16, 0x66, 0xaa, 0x14, 0xb5, 0x92, 0x98, 0xbb, 0xa7, 0x5b, 0x38, 0x95, 0x46,
-	0x4b, 0x50, 0xea, 0xeb, 0x2e, 0x31, 0x23, 0x36, 0x0b, 0x4f, 0xa9, 0x7f, 0x50, 0x60, 0xe1, 0x63,
-	0xcf, 0x33, 0xbb, 0xf6, 0x90, 0x66, 0x0b, 0x30, 0x63, 0x3b, 0x06, 0x6e, 0x6e, 0x30, 0xd5, 0xb2,
-	0x9a, 0x18, 0xa1, 0xd7, 0xa0, 0xd8, 0xc7, 0xd8, 0x6d, 0xbb, 0x4e, 0xcf, 0x57, 0xac, 0x40, 0x27,
-	0x34, 0xa7, 0x87, 0xd1, 0x27, 0x70, 0xd6, 0x8b, 0x2d, 0xe4, 0xd5, 0xb3, 0x4b, 0xd9, 0xe5, 0xd2,
-	0xda, 0x1b, 0x2b, 0x43, 0x5e, 0xb6, 0x12, 0x17, 0xaa, 0x0d, 0x73, 0xab, 0x4f, 0x33, 0x30, 0x2f,
-	0xe9, 0xb8, 0xae, 0xf4, 0x6f, 0x6a, 0x39, 0x0f, 0x77, 0xa5, 0x7a, 0x7c, 0x90, 0xc6, 0x72, 0xd2,
-	0xe4, 0xd9, 0xb0, 0xc9, 0x53, 0x38, 0x58, 0xdc, 0x9e, 0xf9, 0x21, 0x7b, 0xa2, 0x8b, 0x50, 0xc2,
-	0x4f, 0xfa, 0xa6, 0x8b, 0xdb, 0xc4, 0xb4, 0x70, 0x7d, 0x66, 0x49, 0x59, 0xce, 0x69, 0xc0, 0xa7,
-	0xf6, 0x4c, 0x2b, 0xec, 0x91, 0xb3, 0xa9, 0x3d, 0x52, 0xfd, 0xa3, 0x02, 0xaf, 0x0e, 0x9d, 0x92,
-	0x70, 0x71, 0x0d, 0x6a, 0x6c, 0xe7, 0x81, 0x65, 0xa8, 0xb3, 0x53, 0x83, 0x5f, 0x1e, 0x67, 0xf0,
-	0x80, 0x5c, 0x1b, 0xe2, 0x0f, 0x29, 0x99, 0x49, 0xaf, 0xe4, 0x43, 0x78, 0x75, 0x0b, 0x13, 0x21,
-	0x80, 0x7e, 0xc3, 0xde, 0xc9, 0x21, 0x20, 0x1a, 0x4b, 0x99, 0xa1, 0x58, 0xfa, 0x6b, 0x46, 0xc6,
-	0x12, 0x13, 0xd5, 0xb4, 0x0f, 0x1c, 0x74, 0x01, 0x8a, 0x92, 0x44, 0x78, 0x45, 0x30, 0x81, 0xbe,
-	0x0b, 0x79, 0xaa, 0x29, 0x77, 0x89, 0xea, 0xda, 0xa5, 0xe4, 0x3d, 0x85, 0xd6, 0xd4, 0x38, 0x3d,
-	0x6a, 0x42, 0xd5, 0x23, 0xba, 0x4b, 0xda, 0x7d, 0xc7, 0x63, 0xe7, 0xcc, 0x1c, 0xa7, 0xb4, 0xa6,
-	0x46, 0x57, 0x90, 0x10, 0xb9, 0xe3, 0x75, 0x77, 0x05, 0xa5, 0x56, 0x61, 0x9c, 0xfe, 0x10, 0xdd,
-	0x81, 0x32, 0xb6, 0x8d, 0x60, 0xa1, 0x5c, 0xea, 0x85, 0x4a, 0xd8, 0x36, 0xe4, 0x32, 0xc1, 0xf9,
-	0xe4, 0xd3, 0x9f, 0xcf, 0x57, 0x0a, 0xd4, 0x87, 0x0f, 0xe8, 0x34, 0x40, 0x79, 0x8b, 0x33, 0x61,
-	0x7e, 0x40, 0x63, 0x23, 0x5c, 0x1e, 0x92, 0x26, 0x58, 0x54, 0x13, 0x5e, 0x09, 0xb4, 0x61, 0x5f,
-	0x9e, 0x9b, 0xb3, 0x7c, 0xa9, 0xc0, 0x42, 0x5c, 0xd6, 0x69, 0xf6, 0xfd, 0x1d, 0xc8, 0x9b, 0xf6,
-	0x81, 0xe3, 0x6f, 0x7b, 0x71, 0x4c, 0x9c, 0x51, 0x59, 0x9c, 0x58, 0xb5, 0xe0, 0xb5, 0x2d, 0x4c,
-	0x9a, 0xb6, 0x87, 0x5d, 0xb2, 0x6e, 0xda, 0x3d, 0xa7, 0xbb, 0xab, 0x93, 0xc3, 0x53, 0xc4, 0x48,
-	0xc4, 0xdd, 0x33, 0x31, 0x77, 0x57, 0xff, 0xa4, 0xc0, 0x85, 0x64, 0x79, 0x62, 0xeb, 0x0d, 0x28,
-	0x1c, 0x98, 0xb8, 0x67, 0x50, 0x9b, 0x29, 0xcc, 0x66, 0x72, 0x4c, 0x63, 0xa5, 0x4f, 0x89, 0xc5,
-	0x0e, 0x2f, 0x8d, 0x70, 0xd0, 0x16, 0x71, 0x4d, 0xbb, 0xbb, 0x6d, 0x7a, 0x44, 0xe3, 0xf4, 0x21,
-	0x7b, 0x66, 0xd3, 0x7b, 0xe6, 0xaf, 0x14, 0x58, 0xdc, 0xc2, 0xe4, 0xb6, 0x84, 0x5a, 0xfa, 0xdd,
-	0xf4, 0x88, 0xd9, 0xf1, 0x9e, 0x6f, 0x11, 0x91, 0x90, 0x33, 0xd5, 0x5f, 0x2b, 0x70, 0x71, 0xa4,
-	0x32, 0xc2, 0x74, 0x02, 0x4a, 0x7c, 0xa0, 0x4d, 0x86, 0x92, 0x1f, 0xe0, 0xe3, 0xfb, 0x7a, 0x6f,
-	0x80, 0x77, 0x75, 0xd3, 0xe5, 0x50, 0x72, 0x42, 0x60, 0xfd, 0x8b, 0x02, 0xaf, 0x6f, 0x61, 0xb2,
-	0xeb, 0xa7, 0x99, 0x97, 0x68, 0x9d, 0x14, 0x15, 0xc5, 0xd7, 0xfc, 0x30, 0x13, 0xb5, 0x7d, 0x29,
-	0xe6, 0x5b, 0x64, 0x71, 0x10, 0x0a, 0xc8, 0xdb, 0xbc, 0x16, 0x10, 0xc6, 0x53, 0x9f, 0x66, 0xa1,
-	0x7c, 0x5f, 0xd4, 0x07, 0x2c, 0x8d, 0xc4, 0xed, 0xa0, 0x24, 0xdb, 0x21, 0x54, 0x52, 0x24, 0x55,
-	0x19, 0x5b, 0x50, 0xf1, 0x30, 0x7e, 0x78, 0x92, 0xa4, 0x51, 0xa6, 0x8c, 0x12, 0xec, 0xb7, 0xe1,
-	0xec, 0xc0, 0x3e, 0xa0, 0x65, 0x2d, 0x36, 0xc4, 0x2e, 0x78, 0x75, 0x39, 0x19, 0x79, 0x86, 0x19,
-	0xd1, 0x5d, 0x98, 0x8b, 0xaf, 0x95, 0x4f, 0xb5, 0x56, 0x9c, 0x0d, 0x35, 0xa1, 0x66, 0xb8, 0x4e,
-	0xbf, 0x8f, 0x8d, 0xb6, 0xe7, 0x2f, 0x35, 0x93, 0x6e, 0x29, 0xc1, 0xe7, 0x2f, 0xa5, 0xfe, 0x52,
-	0x81, 0x85, 0x07, 0x3a, 0xe9, 0x1c, 0x6e, 0x58, 0xe2, 0x70, 0x4e, 0xe1, 0xda, 0x1f, 0x42, 0xf1,
-	0x48, 0x1c, 0x84, 0x8f, 0x5f, 0x17, 0x13, 0x14, 0x0a, 0x1f, 0xb9, 0x16, 0x70, 0xa8, 0xdf, 0x2a,
-	0x70, 0x8e, 0x35, 0x11, 0xbe, 0x76, 0x2f, 0x3e, 0xc8, 0x26, 0x34, 0x12, 0xe8, 0x32, 0x54, 0x2d,
-	0xdd, 0x7d, 0xd8, 0x0a, 0x68, 0xf2, 0x8c, 0x26, 0x36, 0xab, 0x3e, 0x01, 0x10, 0xa3, 0x1d, 0xaf,
-	0x7b, 0x02, 0xfd, 0xdf, 0x87, 0x59, 0x21, 0x55, 0xc4, 0xdb, 0xa4, 0x83, 0xf5, 0xc9, 0xd5, 0x7f,
-	0x29, 0x50, 0x0d, 0x10, 0x94, 0x45, 0x55, 0x15, 0x32, 0x32, 0x96, 0x32, 0xcd, 0x0d, 0xf4, 0x21,
-	0xcc, 0xf0, 0xb6, 0x51, 0xac, 0xfd, 0x56, 0x74, 0x6d, 0xd1, 0x52, 0x86, 0x60, 0x98, 0x4d, 0x68,
-	0x82, 0x89, 0xda, 0x48, 0xa2, 0x0e, 0xef, 0x30, 0xb2, 0x5a, 0x68, 0x06, 0x35, 0x61, 0x2e, 0x5a,
-	0xb4, 0xf9, 0x31, 0xb3, 0x34, 0x0a, 0x6d, 0x36, 0x74, 0xa2, 0x33, 0xb0, 0xa9, 0x46, 0x6a, 0x36,
-	0x4f, 0xfd, 0x5f, 0x1e, 0x4a, 0xa1, 0x5d, 0x0e, 0xed, 0x24, 0x7e, 0xa4, 0x99, 0xc9, 0xb8, 0x99,
-	0x1d, 0xee, 0x1c, 0xde, 0x82, 0xaa, 0xc9, 0x72, 0x75, 0x5b, 0xb8, 0x22, 0x03, 0xd7, 0xa2, 0x56,
-	0xe1, 0xb3, 0x22, 0x2e, 0xd0, 0x22, 0x94, 0xec, 0x81, 0xd5, 0x76, 0x0e, 0xda, 0xae, 0xf3, 0xd8,
-	0x13, 0x2d, 0x48, 0xd1, 0x1e, 0x58, 0x3f, 0x3c, 0xd0, 0x9c, 0xc7, 0x5e, 0x50, 0xe5, 0xce, 0x4c,
-	0x59, 0xe5, 0x2e, 0x42, 0xc9, 0xd2, 0x9f, 0xd0, 0x55, 0xdb, 0xf6, 0xc0, 0x62, 0xdd, 0x49, 0x56,
-	0x2b, 0x5a, 0xfa, 0x13, 0xcd, 0x79, 0x7c, 0x6f, 0x60, 0xa1, 0x65, 0xa8, 0xf5, 0x74, 0x8f, 0xb4,
-	0xc3, 0xed, 0x4d, 0x81, 0xb5, 0x37, 0x55, 0x3a, 0x7f, 0x27, 0x68, 0x71, 0x86, 0xeb, 0xe5, 0xe2,
-	0x29, 0xea, 0x65, 0xc3, 0xea, 0x05, 0x0b, 0x41, 0xfa, 0x7a, 0xd9, 0xb0, 0x7a, 0x72, 0x99, 0xf7,
-	0x61, 0x76, 0x9f, 0x55, 0x40, 0x5e, 0xbd, 0x34, 0x12, 0xa1, 0x36, 0x69, 0xf1, 0xc3, 0x0b, 0x25,
-	0xcd, 0x27, 0x47, 0x1f, 0x40, 0x91, 0xa5, 0x1e, 0xc6, 0x5b, 0x4e, 0xc5, 0x1b, 0x30, 0x50, 0x6e,
-	0x03, 0xf7, 0x88, 0xce, 0xb8, 0x2b, 0xe9, 0xb8, 0x25, 0x03, 0xba, 0x0e, 0xf3, 0x1d, 0x17, 0xeb,
-	0x04, 0x1b, 0xeb, 0xc7, 0xb7, 0x1d, 0xab, 0xaf, 0x33, 0x67, 0xaa, 0x57, 0x97, 0x94, 0xe5, 0x82,
-	0x96, 0xf4, 0x89, 0x02, 0x43, 0x47, 0x8e, 0x36, 0x5d, 0xc7, 0xaa, 0xcf, 0x71, 0x60, 0x88, 0xce,
-	0xa2, 0xd7, 0x01, 0x7c, 0xe8, 0xd6, 0x49, 0xbd, 0xc6, 0x4e, 0xb1, 0x28, 0x66, 0x3e, 0x26, 0xea,
-	0x17, 0x70, 0x2e, 0xf0, 0x90, 0xd0, 0x69, 0x0c, 0x1f, 0xac, 0x72, 0xd2, 0x83, 0x1d, 0x5f, 0xbb,
-	0xfe, 0x2d, 0x07, 0x0b, 0x2d, 0xfd, 0x08, 0x3f, 0xff, 0x32, 0x39, 0x15, 0x1e, 0x6f, 0xc3, 0x59,
-	0x56, 0x19, 0xaf, 0x85, 0xf4, 0x19, 0x93, 0x81, 0xc3, 0xc7, 0x39, 0xcc, 0x88, 0x3e, 0xa2, 0xa5,
-	0x03, 0xee, 0x3c, 0xdc, 0x75, 0xcc, 0x20, 0xfb, 0xbe, 0x9e, 0xb0, 0xce, 0x6d, 0x49, 0xa5, 0x85,
-	0x39, 0xd0, 0xee, 0x30, 0xb4, 0xf1, 0xbc, 0xfb, 0xf6, 0xd8, 0xfe, 0x2b, 0xb0, 0x7e, 0x1c, 0xe1,
-	0x50, 0x1d, 0x66, 0x45, 0x76, 0x67, 0x71, 0x5f, 0xd0, 0xfc, 0x21, 0xda, 0x85, 0x79, 0xbe, 0x83,
-	0x96, 0x70, 0x6a, 0xbe, 0xf9, 0x42, 0xaa, 0xcd, 0x27, 0xb1, 0x46, 0x63, 0xa2, 0x38, 0x6d, 0x4c,
-	0xd4, 0x61, 0x56, 0xf8, 0x29, 0xc3, 0x82, 0x82, 0xe6, 0x0f, 0x69, 0x13, 0x01, 0x81, 0xc5, 0x26,
-	0xdc, 0x05, 0x7c, 0x1f, 0x0a, 0xd2, 0x87, 0x33, 0xa9, 0x7d, 0x58, 0xf2, 0xc4, 0x51, 0x38, 0x1b,
-	0x43, 0x61, 0xf5, 0xdf, 0x0a, 0x94, 0x37, 0xa8, 0xd2, 0xdb, 0x4e, 0x97, 0xe5, 0x8c, 0xb7, 0xa0,
-	0xea, 0xe2, 0x8e, 0xe3, 0x1a, 0x6d, 0x6c, 0x13, 0xd7, 0xc4, 0xbc, 0xdf, 0xcc, 0x69, 0x15, 0x3e,
-	0x7b, 0x87, 0x4f, 0x52, 0x32, 0x0a, 0xac, 0x1e, 0xd1, 0xad, 0x7e, 0xfb, 0x80, 0x06, 0x70, 0x86,
-	0x93, 0xc9, 0x59, 0x16, 0xbf, 0x97, 0xa0, 0x1c, 0x90, 0x11, 0x87, 0xc9, 0xcf, 0x69, 0x25, 0x39,
-	0xb7, 0xe7, 0xa0, 0x37, 0xa1, 0xca, 0xac, 0xd6, 0xee, 0x39, 0xdd, 0x36, 0xed, 0xcd, 0x44, 0x3a,
-	0x29, 0x1b, 0x42, 0x2d, 0x7a, 0x1a, 0x51, 0x2a, 0xcf, 0xfc, 0x1c, 0x8b, 0x84, 0x22, 0xa9, 0x5a,
-	0xe6, 0xe7, 0x98, 0x66, 0xf3, 0x0a, 0xcd, 0x8e, 0xf7, 0x1c, 0x03, 0xef, 0x9d, 0xb0, 0x96, 0x48,
-	0x71, 0x2f, 0x77, 0x01, 0x8a, 0x72, 0x07, 0x62, 0x4b, 0xc1, 0x04, 0xda, 0x84, 0xaa, 0x5f, 0x66,
-	0xb6, 0x79, 0xf7, 0x90, 0x1b, 0x59, 0xdb, 0x85, 0xf2, 0x9b, 0xa7, 0x55, 0x7c, 0x36, 0x36, 0x54,
-	0x37, 0xa1, 0x1c, 0xfe, 0x4c, 0xa5, 0xb6, 0xe2, 0x8e, 0x22, 0x27, 0xa8, 0xbf, 0xdd, 0x1b, 0x58,
-	0xf4, 0x4c, 0x05, 0x74, 0xf8, 0x43, 0xf5, 0x4b, 0x05, 0x2a, 0x22, 0x29, 0xb7, 0xe4, 0xbd, 0x31,
-	0xdb, 0x9a, 0xc2, 0xb6, 0xc6, 0xfe, 0x46, 0xdf, 0x8b, 0x5e, 0x3a, 0xbd, 0x99, 0x18, 0xe6, 0x6c,
-	0x11, 0x56, 0xff, 0x46, 0x32, 0x72, 0x9a, 0x6e, 0xf5, 0x29, 0x75, 0x34, 0x71, 0x34, 0xcc, 0xd1,
-	0xea, 0x30, 0xab, 0x1b, 0x86, 0x8b, 0x3d, 0x4f, 0xe8, 0xe1, 0x0f, 0xe9, 0x97, 0x23, 0xec, 0x7a,
-	0xbe, 0xcb, 0x67, 0x35, 0x7f, 0x88, 0x3e, 0x80, 0x82, 0x2c, 0x98, 0xb3, 0x49, 0x45, 0x52, 0x58,
-	0x4f, 0xd1, 0x5d, 0x49, 0x0e, 0xf5, 0xeb, 0x0c, 0x54, 0x85, 0xc1, 0xd6, 0x45, 0xd6, 0x1c, 0x1f,
-	0x7c, 0xeb, 0x50, 0x3e, 0x08, 0xa2, 0x7b, 0xdc, 0x2d, 0x4a, 0x18, 0x04, 0x22, 0x3c, 0x93, 0x02,
-	0x30, 0x9a, 0xb7, 0x73, 0xa7, 0xca, 0xdb, 0xf9, 0x29, 0x31, 0x4a, 0xfd, 0x09, 0x94, 0x42, 0x5f,
-	0x18, 0xb8, 0xf2, 0x7b, 0x15, 0x61, 0x0a, 0x7f, 0x88, 0x6e, 0x06, 0x65, 0x09, 0xb7, 0xc1, 0xf9,
-	0x04, 0x21, 0xb1, 0x8a, 0x44, 0xfd, 0xb3, 0x02, 0x33, 0x62, 0xe5, 0x8b, 0x50, 0x12, 0x68, 0xc2,
-	0x4a, 0x36, 0xbe, 0x3a, 0x88, 0x29, 0x5a, 0xb3, 0x3d, 0x3b, 0x38, 0x39, 0x0f, 0x85, 0x18, 0x90,
-	0xcc, 0x0a, 0x44, 0xf7, 0x3f, 0x85, 0xd0, 0x83, 0x7e, 0x62, 0xc0, 0xf1, 0xad, 0xc2, 0xee, 0x84,
-	0x35, 0xdc, 0x71, 0x8e, 0xb0, 0x7b, 0x7c, 0xfa, 0x9b, 0xb7, 0x5b, 0x21, 0x4f, 0x4d, 0xd9, 0xda,
-	0x49, 0x06, 0x74, 0x2b, 0x30, 0x77, 0x36, 0xe9, 0xe2, 0x21, 0x0c, 0x1d, 0xc2, 0xcf, 0x02, 0xb3,
-	0x7f, 0xc3, 0xef, 0x10, 0xa3, 0x5b, 0x39, 0x69, 0x49, 0xf2, 0x4c, 0x3a, 0x06, 0xf5, 0xb7, 0x0a,
-	0x9c, 0xdf, 0xc2, 0x64, 0x33, 0xda, 0x97, 0xbf, 0x6c, 0xad, 0x2c, 0x68, 0x24, 0x29, 0x75, 0x9a,
-	0x53, 0x6f, 0x40, 0x41, 0xde, 0x30, 0xf0, 0xdb, 0x5d, 0x39, 0x56, 0x7f, 0xa1, 0x40, 0x5d, 0x48,
-	0x61, 0x32, 0x69, 0x35, 0xdc, 0xc3, 0x04, 0x1b, 0x2f, 0xba, 0xe5, 0xfd, 0xbd, 0x02, 0xb5, 0x30,
-	0x94, 0x33, 0x34, 0x7e, 0x0f, 0xf2, 0xec, 0x66, 0x41, 0x68, 0x30, 0xd1, 0x59, 0x39, 0x35, 0x85,
-	0x0c, 0x56, 0xa1, 0xed, 0xc9, 0xac, 0x23, 0x86, 0x41, 0x3e, 0xc9, 0x4e, 0x9d, 0x4f, 0xd4, 0xaf,
-	0x32, 0x50, 0x0f, 0x9a, 0x85, 0x17, 0x0e, 0xd9, 0x23, 0x4a, 0xc9, 0xec, 0x33, 0x2a, 0x25, 0x73,
-	0xd3, 0xc2, 0xf4, 0x3f, 0x33, 0x50, 0x0d, 0xcc, 0xb1, 0xdb, 0xd3, 0x6d, 0xb4, 0x00, 0x33, 0xfd,
-	0x9e, 0x1e, 0xdc, 0xf9, 0x89, 0x11, 0x6a, 0xc9, 0xda, 0x23, 0x6a, 0x80, 0x77, 0x92, 0xcc, 0x3f,
-	0xc2, 0xc2, 0x5a, 0x6c, 0x09, 0xda, 0x84, 0xf1, 0x32, 0x9e, 0xb5, 0xd2, 0xa2, 0xde, 0xe1, 0xe7,
-	0x4c, 0xbb, 0xe8, 0x6b, 0x80, 0xe8, 0x07, 0x67, 0x40, 0xda, 0xa6, 0xdd, 0xf6, 0x70, 0xc7, 0xb1,
-	0x0d, 0x8f, 0x61, 0x6f, 0x5e, 0xab, 0x89, 0x2f, 0x4d, 0xbb, 0xc5, 0xe7, 0xd1, 0x7b, 0x90, 0x23,
-	0xc7, 0x7d, 0x0e, 0xc0, 0xd5, 0x44, 0x60, 0x0b, 0xf4, 0xda, 0x3b, 0xee, 0x63, 0x8d, 0x91, 0xa3,
-	0x45, 0x00, 0xba, 0x14, 0x71, 0xf5, 0x23, 0xdc, 0xf3, 0x7f, 0xad, 0x0c, 0x66, 0xa8, 0x23, 0xfa,
-	0xb7, 0x11, 0xb3, 0x1c, 0xf5, 0xc5, 0x50, 0xfd, 0x7b, 0x06, 0x6a, 0xc1, 0x92, 0x1a, 0xf6, 0x06,
-	0x3d, 0x32, 0xd2, 0x7e, 0xe3, 0x5b, 0xb0, 0x49, 0xb9, 0xfc, 0x23, 0x28, 0x89, 0x9b, 0x91, 0x29,
-	0x0e, 0x1a, 0x38, 0xcb, 0xf6, 0x18, 0xcf, 0xcb, 0x3f, 0x23, 0xcf, 0x9b, 0x99, 0xd6, 0xf3, 0x5a,
-	0xb0, 0xe0, 0x43, 0x56, 0x40, 0xb0, 0x83, 0x89, 0x3e, 0xa6, 0x56, 0xb8, 0x08, 0x25, 0x9e, 0x8a,
-	0x78, 0x0e, 0xe6, 0xe5, 0x33, 0xec, 0xcb, 0xbe, 0x52, 0xfd, 0x29, 0x9c, 0x63, 0x21, 0x1f, 0xbf,
-	0x40, 0x4d, 0x73, 0x9b, 0xad, 0xca, 0xe2, 0x9c, 0x16, 0xe2, 0xdc, 0xbb, 0x8b, 0x5a, 0x64, 0x4e,
-	0xdd, 0x86, 0x57, 0x62, 0xeb, 0x9f, 0x02, 0xd2, 0xd5, 0x7f, 0x28, 0x70, 0x7e, 0xc3, 0x75, 0xfa,
-	0xf7, 0x4d, 0x97, 0x0c, 0xf4, 0x5e, 0xf4, 0x4a, 0xfe, 0xf9, 0xb4, 0x17, 0x77, 0x43, 0x59, 0x84,
-	0x83, 0xce, 0xb5, 0x84, 0x23, 0x1b, 0x56, 0x4a, 0x1c, 0x55, 0x28, 0xe7, 0xfc, 0x37, 0x9b, 0xa4,
-	0xbc, 0xa0, 0x9b, 0x80, 0xa4, 0x69, 0x92, 0x6c, 0xe2, 0x7d, 0x43, 0xf6, 0xa4, 0xf7, 0x0d, 0x23,
-	0xbc, 0x3f, 0xf7, 0x8c, 0xbc, 0x7f, 0xda, 0xf2, 0x18, 0xdd, 0x85, 0xe8, 0x5d, 0x10, 0x83, 0x9d,
-	0x13, 0x5d, 0x22, 0xad, 0x03, 0x04, 0xf7, 0x22, 0xe2, 0x3d, 0x45, 0x9a, 0x65, 0x42, 0x5c, 0xf4,
-	0xb4, 0x24, 0xd2, 0xb0, 0xfb, 0xcc, 0x48, 0x1f, 0xff, 0x09, 0x34, 0x92, 0xbc, 0xf4, 0x14, 0x9e,
-	0x7f, 0xf5, 0x06, 0x9c, 0x1d, 0xca, 0xd0, 0xa8, 0x0a, 0xf0, 0xa9, 0xdd, 0x11, 0xa5, 0x4b, 0xed,
-	0x0c, 0x2a, 0x43, 0xc1, 0x2f, 0x64, 0x6a, 0xca, 0xd5, 0x56, 0x38, 0x51, 0x51, 0xf4, 0x46, 0xaf,
-	0xc2, 0xfc, 0xa7, 0xb6, 0x81, 0x0f, 0x4c, 0x1b, 0x1b, 0xc1, 0xa7, 0xda, 0x19, 0x34, 0x0f, 0x73,
-	0x4d, 0xdb, 0xc6, 0x6e, 0x68, 0x52, 0xa1, 0x93, 0x3b, 0xd8, 0xed, 0xe2, 0xd0, 0x64, 0x66, 0xed,
-	0x9b, 0x79, 0x28, 0xd2, 0xce, 0xf1, 0xb6, 0xe3, 0xb8, 0x06, 0xea, 0x03, 0x62, 0x3f, 0x7a, 0x5a,
-	0x7d, 0xc7, 0x96, 0xaf, 0x03, 0xd0, 0xf5, 0x11, 0xc6, 0x1c, 0x26, 0x15, 0x91, 0xdb, 0xb8, 0x3c,
-	0x82, 0x23, 0x46, 0xae, 0x9e, 0x41, 0x16, 0x93, 0x48, 0x53, 0xdd, 0x9e, 0xd9, 0x79, 0xe8, 0x5f,
-	0x6f, 0x8f, 0x91, 0x18, 0x23, 0xf5, 0x25, 0xc6, 0x1e, 0x1d, 0x88, 0x01, 0xff, 0x65, 0xda, 0x3f,
-	0x29, 0xf5, 0x0c, 0x7a, 0x04, 0xe7, 0xb6, 0x30, 0x09, 0x7e, 0x8c, 0xf4, 0x05, 0xae, 0x8d, 0x16,
-	0x38, 0x44, 0x3c, 0xa5, 0xc8, 0x6d, 0xc8, 0xb3, 0x92, 0x14, 0x25, 0x95, 0x7d, 0xe1, 0x27, 0x72,
-	0x8d, 0xa5, 0xd1, 0x04, 0x72, 0xb5, 0x9f, 0xc1, 0x5c, 0xec, 0x09, 0x10, 0xba, 0x92, 0xc0, 0x96,
-	0xfc, 0x98, 0xab, 0x71, 0x35, 0x0d, 0xa9, 0x94, 0xd5, 0x85, 0x6a, 0xf4, 0x27, 0x53, 0xb4, 0x9c,
-	0xc0, 0x9f, 0xf8, 0x7c, 0xa3, 0x71, 0x25, 0x05, 0xa5, 0x14, 0x64, 0x41, 0x2d, 0xfe, 0x24, 0x05,
-	0x5d, 0x1d, 0xbb, 0x40, 0xd4, 0xdd, 0xde, 0x49, 0x45, 0x2b, 0xc5, 0x1d, 0x33, 0x27, 0x18, 0x7a,
-	0x12, 0x81, 0x56, 0x92, 0x97, 0x19, 0xf5, 0x56, 0xa3, 0xb1, 0x9a, 0x9a, 0x5e, 0x8a, 0xfe, 0x39,
-	0x6f, 0x85, 0x93, 0x9e, 0x15, 0xa0, 0x1b, 0xc9, 0xcb, 0x8d, 0x79, 0x0f, 0xd1, 0x58, 0x9b, 0x86,
-	0x45, 0x2a, 0xf1, 0x05, 0xeb, 0x61, 0x13, 0x7e, 0x9a, 0x8f, 0xc7, 0x9d, 0xbf, 0xde, 0xe8, 0x37,
-	0x07, 0x8d, 0x1b, 0x53, 0x70, 0x48, 0x05, 0x9c, 0xf8, 0xa3, 0x1f, 0x3f, 0x0c, 0x57, 0x27, 0x7a,
-	0xcd, 0xc9, 0x62, 0xf0, 0x33, 0x98, 0x8b, 0xfd, 0x90, 0x90, 0x18, 0x35, 0xc9, 0x3f, 0x36, 0x34,
-	0xc6, 0x01, 0x3a, 0x0f, 0xc9, 0xd8, 0x95, 0x00, 0x1a, 0xe1, 0xfd, 0x09, 0xd7, 0x06, 0x8d, 0xab,
-	0x69, 0x48, 0xe5, 0x46, 0x3c, 0x06, 0x97, 0xb1, 0xb6, 0x1a, 0x5d, 0x4b, 0x5e, 0x23, 0xf9, 0x4a,
-	0xa0, 0xf1, 0x6e, 0x4a, 0x6a, 0x29, 0xb4, 0x0d, 0xb0, 0x85, 0xc9, 0x0e, 0x26, 0x2e, 0xf5, 0x91,
-	0xcb, 0x89, 0x26, 0x0f, 0x08, 0x7c, 0x31, 0x6f, 0x4f, 0xa4, 0x93, 0x02, 0x7e, 0x04, 0xc8, 0xcf,
-	0x73, 0xa1, 0x9f, 0xb1, 0xde, 0x18, 0xdb, 0xbe, 0xf0, 0x5e, 0x63, 0xd2, 0xd9, 0x3c, 0x82, 0xda,
-	0x8e, 0x6e, 0xd3, 0xa4, 0x1d, 0xac, 0x7b, 0x2d, 0x51, 0xb1, 0x38, 0xd9, 0x08, 0x6b, 0x8d, 0xa4,
-	0x96, 0x9b, 0x79, 0x2c, 0x73, 0xa8, 0x2e, 0x43, 0x10, 0xc7, 0xb1, 0x25, 0xb0, 0x46, 0x8c, 0x70,
-	0x04, 0xb6, 0x8c, 0xa1, 0x97, 0x82, 0x9f, 0x2a, 0xec, 0x69, 0x59, 0x8c, 0xe0, 0x81, 0x49, 0x0e,
-	0x69, 0x57, 0xeb, 0xa5, 0x51, 0x81, 0x11, 0x4e, 0xa1, 0x82, 0xa0, 0x97, 0x2a, 0x18, 0x50, 0x89,
-	0x74, 0x07, 0x28, 0xe9, 0xb7, 0xa8, 0xa4, 0xfe, 0xa4, 0xb1, 0x3c, 0x99, 0x50, 0x4a, 0x39, 0x84,
-	0x8a, 0xef, 0xaf, 0xdc, 0xb8, 0x57, 0x46, 0x69, 0x1a, 0xd0, 0x8c, 0x08, 0xb7, 0x64, 0xd2, 0x70,
-	0xb8, 0x0d, 0x17, 0x7e, 0x28, 0x5d, 0xc3, 0x30, 0x2e, 0xdc, 0x46, 0x57, 0x93, 0xea, 0x99, 0xb5,
-	0xff, 0xe4, 0xa0, 0xe0, 0x5f, 0xe6, 0xbf, 0x84, 0x8a, 0xec, 0x25, 0x94, 0x48, 0x9f, 0xc1, 0x5c,
-	0xec, 0xdd, 0x4f, 0x22, 0x82, 0x26, 0xbf, 0x0d, 0x9a, 0x04, 0x01, 0x0f, 0xc4, 0x7f, 0x03, 0x48,
-	0xb4, 0x7c, 0x7b, 0x54, 0x99, 0x15, 0x07, 0xca, 0x09, 0x0b, 0x3f, 0x77, 0x58, 0xbc, 0x07, 0x10,
-	0x82, 0xad, 0xf1, 0xb7, 0x39, 0x34, 0x12, 0x27, 0x28, 0xbc, 0x7e, 0xf3, 0xc7, 0x37, 0xba, 0x26,
-	0x39, 0x1c, 0xec, 0xd3, 0x2f, 0xab, 0x9c, 0xf4, 0x5d, 0xd3, 0x11, 0x7f, 0xad, 0xfa, 0x27, 0xba,
-	0xca, 0xb8, 0x57, 0xa9, 0x80, 0xfe, 0xfe, 0xfe, 0x0c, 0x1b, 0xdd, 0xfc, 0x7f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xf4, 0x7a, 0xd1, 0x47, 0x2f, 0x32, 0x00, 0x00,
+func (m *ImportSegment) Reset()         { *m = ImportSegment{} }
+func (m *ImportSegment) String() string { return proto.CompactTextString(m) }
+func (*ImportSegment) ProtoMessage()    {}
+func (*ImportSegment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{50}
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// DataCoordClient is the client API for DataCoord service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type DataCoordClient interface {
-	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
-	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
-	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
-	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
-	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
-	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
-	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
-	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
-	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
-	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
-	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
-	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
-	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
-	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
-	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
-	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
-	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
-	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
-	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
-	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
-	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+func (m *ImportSegment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportSegment.Unmarshal(m, b)
 }
-
-type dataCoordClient struct {
-	cc *grpc.ClientConn
+func (m *ImportSegment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportSegment.Marshal(b, m, deterministic)
 }
-
-func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
-	return &dataCoordClient{cc}
+func (m *ImportSegment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSegment.Merge(m, src)
 }
-
-func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
-	out := new(internalpb.ComponentStates)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ImportSegment) XXX_Size() int {
+	return xxx_messageInfo_ImportSegment.Size(m)
 }
-
-func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ImportSegment) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSegment.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportSegment proto.InternalMessageInfo
+
+func (m *ImportSegment) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
 	}
-	return out, nil
+	return ""
 }
 
-func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
-	out := new(FlushResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportSegment) GetFiles() []string {
+	if m != nil {
+		return m.Files
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
-	out := new(AssignSegmentIDResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportSegment) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
-	out := new(GetSegmentInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ImportTask struct {
+	Base         *commonpb.MsgBase          `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID       int64                      `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID int64                      `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64                      `protobuf:"varint,4,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	Schema       *schemapb.CollectionSchema `protobuf:"bytes,5,opt,name=schema,proto3" json:"schema,omitempty"`
+	RowBased     bool                       `protobuf:"varint,6,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	Segments     []*ImportSegment           `protobuf:"bytes,7,rep,name=segments,proto3" json:"segments,omitempty"`
+	// The timestamp of all the imported rows
+	Timestamp            uint64   `protobuf:"varint,8,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
-	out := new(GetSegmentStatesResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ImportTask) Reset()         { *m = ImportTask{} }
+func (m *ImportTask) String() string { return proto.CompactTextString(m) }
+func (*ImportTask) ProtoMessage()    {}
+func (*ImportTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{51}
 }
 
-func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
-	out := new(GetInsertBinlogPathsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ImportTask) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTask.Unmarshal(m, b)
+}
+func (m *ImportTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTask.Marshal(b, m, deterministic)
+}
+func (m *ImportTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTask.Merge(m, src)
+}
+func (m *ImportTask) XXX_Size() int {
+	return xxx_messageInfo_ImportTask.Size(m)
+}
+func (m *ImportTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTask.DiscardUnknown(m)
 }
 
-func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
-	out := new(GetCollectionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ImportTask proto.InternalMessageInfo
+
+func (m *ImportTask) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
-	out := new(GetPartitionStatisticsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
-	out := new(milvuspb.StringResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
 	}
-	return out, nil
+	return 0
 }
 
-func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
-	out := new(GetRecoveryInfoResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
-	out := new(GetFlushedSegmentsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetRowBased() bool {
+	if m != nil {
+		return m.RowBased
 	}
-	return out, nil
+	return false
 }
 
-func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
-	out := new(milvuspb.GetMetricsResponse)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetSegments() []*ImportSegment {
+	if m != nil {
+		return m.Segments
 	}
-	return out, nil
+	return nil
 }
 
-func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
-	out := new(commonpb.Status)
-	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ImportTask) GetTimestamp() uint64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return 0
+}
+
+// The result of a segment reported by DataNode, the binlogs are empty if the import fails
+type ImportResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	SegmentID            int64            `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	NumOfRows            int64            `protobuf:"varint,4,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	InsertLogs           []*FieldBinlog   `protobuf:"bytes,5,rep,name=insert_logs,json=insertLogs,proto3" json:"insert_logs,omitempty"`
+	Field2StatslogPaths  []*FieldBinlog   `protobuf:"bytes,6,rep,name=field2StatslogPaths,proto3" json:"field2StatslogPaths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ImportResult) Reset()         { *m = ImportResult{} }
+func (m *ImportResult) String() string { return proto.CompactTextString(m) }
+func (*ImportResult) ProtoMessage()    {}
+func (*ImportResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{52}
+}
+
+func (m *ImportResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResult.Unmarshal(m, b)
+}
+func (m *ImportResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResult.Marshal(b, m, deterministic)
+}
+func (m *ImportResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResult.Merge(m, src)
+}
+func (m *ImportResult) XXX_Size() int {
+	return xxx_messageInfo_ImportResult.Size(m)
+}
+func (m *ImportResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportResult proto.InternalMessageInfo
+
+func (m *ImportResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ImportResult) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportResult) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ImportResult) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+func (m *ImportResult) GetInsertLogs() []*FieldBinlog {
+	if m != nil {
+		return m.InsertLogs
+	}
+	return nil
+}
+
+func (m *ImportResult) GetField2StatslogPaths() []*FieldBinlog {
+	if m != nil {
+		return m.Field2StatslogPaths
+	}
+	return nil
+}
+
+// The state of an import task persisted by DataCoord
+type ImportTaskInfo struct {
+	TaskID       int64                `protobuf:"varint,1,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	CollectionID int64                `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID  int64                `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NodeID       int64                `protobuf:"varint,4,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	Segments     []*ImportSegmentInfo `protobuf:"bytes,5,rep,name=segments,proto3" json:"segments,omitempty"`
+	// The unix time in seconds when the task is created
+	CreateTime int64 `protobuf:"varint,6,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	// The unix time in seconds when all the segments are finished, 0 if the task is still in progress
+	FinishTime           int64    `protobuf:"varint,7,opt,name=finish_time,json=finishTime,proto3" json:"finish_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportTaskInfo) Reset()         { *m = ImportTaskInfo{} }
+func (m *ImportTaskInfo) String() string { return proto.CompactTextString(m) }
+func (*ImportTaskInfo) ProtoMessage()    {}
+func (*ImportTaskInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{53}
+}
+
+func (m *ImportTaskInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportTaskInfo.Unmarshal(m, b)
+}
+func (m *ImportTaskInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportTaskInfo.Marshal(b, m, deterministic)
+}
+func (m *ImportTaskInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportTaskInfo.Merge(m, src)
+}
+func (m *ImportTaskInfo) XXX_Size() int {
+	return xxx_messageInfo_ImportTaskInfo.Size(m)
+}
+func (m *ImportTaskInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportTaskInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportTaskInfo proto.InternalMessageInfo
+
+func (m *ImportTaskInfo) GetTaskID() int64 {
+	if m != nil {
+		return m.TaskID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetSegments() []*ImportSegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *ImportTaskInfo) GetCreateTime() int64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
+func (m *ImportTaskInfo) GetFinishTime() int64 {
+	if m != nil {
+		return m.FinishTime
+	}
+	return 0
+}
+
+type ImportSegmentInfo struct {
+	SegmentID            int64                `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	ChannelName          string               `protobuf:"bytes,2,opt,name=channel_name,json=channelName,proto3" json:"channel_name,omitempty"`
+	Files                []string             `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,4,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Reason               string               `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	NumOfRows            int64                `protobuf:"varint,6,opt,name=num_of_rows,json=numOfRows,proto3" json:"num_of_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ImportSegmentInfo) Reset()         { *m = ImportSegmentInfo{} }
+func (m *ImportSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*ImportSegmentInfo) ProtoMessage()    {}
+func (*ImportSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{54}
+}
+
+func (m *ImportSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportSegmentInfo.Unmarshal(m, b)
+}
+func (m *ImportSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *ImportSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportSegmentInfo.Merge(m, src)
+}
+func (m *ImportSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_ImportSegmentInfo.Size(m)
+}
+func (m *ImportSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportSegmentInfo proto.InternalMessageInfo
+
+func (m *ImportSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *ImportSegmentInfo) GetChannelName() string {
+	if m != nil {
+		return m.ChannelName
+	}
+	return ""
+}
+
+func (m *ImportSegmentInfo) GetFiles() []string {
+	if m != nil {
+		return m.Files
+	}
+	return nil
+}
+
+func (m *ImportSegmentInfo) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
+}
+
+func (m *ImportSegmentInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ImportSegmentInfo) GetNumOfRows() int64 {
+	if m != nil {
+		return m.NumOfRows
+	}
+	return 0
+}
+
+// Adds segments whose binlogs are already in the object storage as flushed segments, used by restore
+type AddFlushedSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Segments             []*SegmentInfo    `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *AddFlushedSegmentsRequest) Reset()         { *m = AddFlushedSegmentsRequest{} }
+func (m *AddFlushedSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFlushedSegmentsRequest) ProtoMessage()    {}
+func (*AddFlushedSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{55}
+}
+
+func (m *AddFlushedSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFlushedSegmentsRequest.Unmarshal(m, b)
+}
+func (m *AddFlushedSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFlushedSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *AddFlushedSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFlushedSegmentsRequest.Merge(m, src)
+}
+func (m *AddFlushedSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFlushedSegmentsRequest.Size(m)
+}
+func (m *AddFlushedSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFlushedSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddFlushedSegmentsRequest proto.InternalMessageInfo
+
+func (m *AddFlushedSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AddFlushedSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AddFlushedSegmentsRequest) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+// Protects all the segments of a collection from the garbage collection until they are unpinned or the lease expires,
+// used by backup to copy the binlogs of the segments, including the dropped ones still in meta
+type PinSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	LeaseSeconds         int64             `protobuf:"varint,3,opt,name=lease_seconds,json=leaseSeconds,proto3" json:"lease_seconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *PinSegmentsRequest) Reset()         { *m = PinSegmentsRequest{} }
+func (m *PinSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*PinSegmentsRequest) ProtoMessage()    {}
+func (*PinSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{56}
+}
+
+func (m *PinSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinSegmentsRequest.Unmarshal(m, b)
+}
+func (m *PinSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *PinSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinSegmentsRequest.Merge(m, src)
+}
+func (m *PinSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_PinSegmentsRequest.Size(m)
+}
+func (m *PinSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinSegmentsRequest proto.InternalMessageInfo
+
+func (m *PinSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *PinSegmentsRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PinSegmentsRequest) GetLeaseSeconds() int64 {
+	if m != nil {
+		return m.LeaseSeconds
+	}
+	return 0
+}
+
+type PinSegmentsResponse struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	PinID  int64            `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	// all the pinned segments, including the dropped ones
+	Segments             []*SegmentInfo `protobuf:"bytes,3,rep,name=segments,proto3" json:"segments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *PinSegmentsResponse) Reset()         { *m = PinSegmentsResponse{} }
+func (m *PinSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*PinSegmentsResponse) ProtoMessage()    {}
+func (*PinSegmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{57}
+}
+
+func (m *PinSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PinSegmentsResponse.Unmarshal(m, b)
+}
+func (m *PinSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PinSegmentsResponse.Marshal(b, m, deterministic)
+}
+func (m *PinSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PinSegmentsResponse.Merge(m, src)
+}
+func (m *PinSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_PinSegmentsResponse.Size(m)
+}
+func (m *PinSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PinSegmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PinSegmentsResponse proto.InternalMessageInfo
+
+func (m *PinSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *PinSegmentsResponse) GetPinID() int64 {
+	if m != nil {
+		return m.PinID
+	}
+	return 0
+}
+
+func (m *PinSegmentsResponse) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+type UnpinSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	PinID                int64             `protobuf:"varint,2,opt,name=pinID,proto3" json:"pinID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *UnpinSegmentsRequest) Reset()         { *m = UnpinSegmentsRequest{} }
+func (m *UnpinSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*UnpinSegmentsRequest) ProtoMessage()    {}
+func (*UnpinSegmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{58}
+}
+
+func (m *UnpinSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnpinSegmentsRequest.Unmarshal(m, b)
+}
+func (m *UnpinSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnpinSegmentsRequest.Marshal(b, m, deterministic)
+}
+func (m *UnpinSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnpinSegmentsRequest.Merge(m, src)
+}
+func (m *UnpinSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_UnpinSegmentsRequest.Size(m)
+}
+func (m *UnpinSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnpinSegmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnpinSegmentsRequest proto.InternalMessageInfo
+
+func (m *UnpinSegmentsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *UnpinSegmentsRequest) GetPinID() int64 {
+	if m != nil {
+		return m.PinID
+	}
+	return 0
+}
+
+// Notifies DataCoord of the properties of an altered collection so that its collection cache is refreshed
+type AlterCollectionRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64                    `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{59}
+}
+
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
+}
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
+}
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
+}
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
+
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *AlterCollectionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type PartitionBackup struct {
+	PartitionID          int64    `protobuf:"varint,1,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	PartitionName        string   `protobuf:"bytes,2,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PartitionBackup) Reset()         { *m = PartitionBackup{} }
+func (m *PartitionBackup) String() string { return proto.CompactTextString(m) }
+func (*PartitionBackup) ProtoMessage()    {}
+func (*PartitionBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{60}
+}
+
+func (m *PartitionBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PartitionBackup.Unmarshal(m, b)
+}
+func (m *PartitionBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PartitionBackup.Marshal(b, m, deterministic)
+}
+func (m *PartitionBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PartitionBackup.Merge(m, src)
+}
+func (m *PartitionBackup) XXX_Size() int {
+	return xxx_messageInfo_PartitionBackup.Size(m)
+}
+func (m *PartitionBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PartitionBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PartitionBackup proto.InternalMessageInfo
+
+func (m *PartitionBackup) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PartitionBackup) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
+}
+
+// The meta of a collection backup, the binlog paths of the segments are the paths before backup
+type CollectionBackup struct {
+	BackupName           string                     `protobuf:"bytes,1,opt,name=backup_name,json=backupName,proto3" json:"backup_name,omitempty"`
+	BackupTimestamp      uint64                     `protobuf:"varint,2,opt,name=backup_timestamp,json=backupTimestamp,proto3" json:"backup_timestamp,omitempty"`
+	DbName               string                     `protobuf:"bytes,3,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                     `protobuf:"bytes,4,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	CollectionID         int64                      `protobuf:"varint,5,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Schema               *schemapb.CollectionSchema `protobuf:"bytes,6,opt,name=schema,proto3" json:"schema,omitempty"`
+	ShardsNum            int32                      `protobuf:"varint,7,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	ConsistencyLevel     commonpb.ConsistencyLevel  `protobuf:"varint,8,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	VirtualChannelNames  []string                   `protobuf:"bytes,9,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
+	Partitions           []*PartitionBackup         `protobuf:"bytes,10,rep,name=partitions,proto3" json:"partitions,omitempty"`
+	Segments             []*SegmentInfo             `protobuf:"bytes,11,rep,name=segments,proto3" json:"segments,omitempty"`
+	Properties           []*commonpb.KeyValuePair   `protobuf:"bytes,12,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *CollectionBackup) Reset()         { *m = CollectionBackup{} }
+func (m *CollectionBackup) String() string { return proto.CompactTextString(m) }
+func (*CollectionBackup) ProtoMessage()    {}
+func (*CollectionBackup) Descriptor() ([]byte, []int) {
+	return fileDescriptor_82cd95f524594f49, []int{61}
+}
+
+func (m *CollectionBackup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CollectionBackup.Unmarshal(m, b)
+}
+func (m *CollectionBackup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CollectionBackup.Marshal(b, m, deterministic)
+}
+func (m *CollectionBackup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CollectionBackup.Merge(m, src)
+}
+func (m *CollectionBackup) XXX_Size() int {
+	return xxx_messageInfo_CollectionBackup.Size(m)
+}
+func (m *CollectionBackup) XXX_DiscardUnknown() {
+	xxx_messageInfo_CollectionBackup.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CollectionBackup proto.InternalMessageInfo
+
+func (m *CollectionBackup) GetBackupName() string {
+	if m != nil {
+		return m.BackupName
+	}
+	return ""
+}
+
+func (m *CollectionBackup) GetBackupTimestamp() uint64 {
+	if m != nil {
+		return m.BackupTimestamp
+	}
+	return 0
+}
+
+func (m *CollectionBackup) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *CollectionBackup) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *CollectionBackup) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *CollectionBackup) GetSchema() *schemapb.CollectionSchema {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *CollectionBackup) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
+}
+
+func (m *CollectionBackup) GetConsistencyLevel() commonpb.ConsistencyLevel {
+	if m != nil {
+		return m.ConsistencyLevel
+	}
+	return commonpb.ConsistencyLevel_Strong
+}
+
+func (m *CollectionBackup) GetVirtualChannelNames() []string {
+	if m != nil {
+		return m.VirtualChannelNames
+	}
+	return nil
+}
+
+func (m *CollectionBackup) GetPartitions() []*PartitionBackup {
+	if m != nil {
+		return m.Partitions
+	}
+	return nil
+}
+
+func (m *CollectionBackup) GetSegments() []*SegmentInfo {
+	if m != nil {
+		return m.Segments
+	}
+	return nil
+}
+
+func (m *CollectionBackup) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("milvus.proto.data.ChannelWatchState", ChannelWatchState_name, ChannelWatchState_value)
+	proto.RegisterEnum("milvus.proto.data.CompactionType", CompactionType_name, CompactionType_value)
+	proto.RegisterType((*FlushRequest)(nil), "milvus.proto.data.FlushRequest")
+	proto.RegisterType((*FlushResponse)(nil), "milvus.proto.data.FlushResponse")
+	proto.RegisterType((*SegmentIDRequest)(nil), "milvus.proto.data.SegmentIDRequest")
+	proto.RegisterType((*AssignSegmentIDRequest)(nil), "milvus.proto.data.AssignSegmentIDRequest")
+	proto.RegisterType((*SegmentIDAssignment)(nil), "milvus.proto.data.SegmentIDAssignment")
+	proto.RegisterType((*AssignSegmentIDResponse)(nil), "milvus.proto.data.AssignSegmentIDResponse")
+	proto.RegisterType((*GetSegmentStatesRequest)(nil), "milvus.proto.data.GetSegmentStatesRequest")
+	proto.RegisterType((*SegmentStateInfo)(nil), "milvus.proto.data.SegmentStateInfo")
+	proto.RegisterType((*GetSegmentStatesResponse)(nil), "milvus.proto.data.GetSegmentStatesResponse")
+	proto.RegisterType((*GetSegmentInfoRequest)(nil), "milvus.proto.data.GetSegmentInfoRequest")
+	proto.RegisterType((*GetSegmentInfoResponse)(nil), "milvus.proto.data.GetSegmentInfoResponse")
+	proto.RegisterType((*GetInsertBinlogPathsRequest)(nil), "milvus.proto.data.GetInsertBinlogPathsRequest")
+	proto.RegisterType((*GetInsertBinlogPathsResponse)(nil), "milvus.proto.data.GetInsertBinlogPathsResponse")
+	proto.RegisterType((*GetCollectionStatisticsRequest)(nil), "milvus.proto.data.GetCollectionStatisticsRequest")
+	proto.RegisterType((*GetCollectionStatisticsResponse)(nil), "milvus.proto.data.GetCollectionStatisticsResponse")
+	proto.RegisterType((*GetPartitionStatisticsRequest)(nil), "milvus.proto.data.GetPartitionStatisticsRequest")
+	proto.RegisterType((*GetPartitionStatisticsResponse)(nil), "milvus.proto.data.GetPartitionStatisticsResponse")
+	proto.RegisterType((*GetSegmentInfoChannelRequest)(nil), "milvus.proto.data.GetSegmentInfoChannelRequest")
+	proto.RegisterType((*VchannelInfo)(nil), "milvus.proto.data.VchannelInfo")
+	proto.RegisterType((*WatchDmChannelsRequest)(nil), "milvus.proto.data.WatchDmChannelsRequest")
+	proto.RegisterType((*FlushSegmentsRequest)(nil), "milvus.proto.data.FlushSegmentsRequest")
+	proto.RegisterType((*SegmentMsg)(nil), "milvus.proto.data.SegmentMsg")
+	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.data.CollectionInfo")
+	proto.RegisterType((*SegmentInfo)(nil), "milvus.proto.data.SegmentInfo")
+	proto.RegisterType((*SegmentStartPosition)(nil), "milvus.proto.data.SegmentStartPosition")
+	proto.RegisterType((*SaveBinlogPathsRequest)(nil), "milvus.proto.data.SaveBinlogPathsRequest")
+	proto.RegisterType((*CheckPoint)(nil), "milvus.proto.data.CheckPoint")
+	proto.RegisterType((*DeltaLogInfo)(nil), "milvus.proto.data.DeltaLogInfo")
+	proto.RegisterType((*DataNodeTtMsg)(nil), "milvus.proto.data.DataNodeTtMsg")
+	proto.RegisterType((*SegmentStats)(nil), "milvus.proto.data.SegmentStats")
+	proto.RegisterType((*ChannelStatus)(nil), "milvus.proto.data.ChannelStatus")
+	proto.RegisterType((*DataNodeInfo)(nil), "milvus.proto.data.DataNodeInfo")
+	proto.RegisterType((*SegmentBinlogs)(nil), "milvus.proto.data.SegmentBinlogs")
+	proto.RegisterType((*FieldBinlog)(nil), "milvus.proto.data.FieldBinlog")
+	proto.RegisterType((*Binlog)(nil), "milvus.proto.data.Binlog")
+	proto.RegisterType((*GetRecoveryInfoResponse)(nil), "milvus.proto.data.GetRecoveryInfoResponse")
+	proto.RegisterType((*GetRecoveryInfoRequest)(nil), "milvus.proto.data.GetRecoveryInfoRequest")
+	proto.RegisterType((*GetFlushedSegmentsRequest)(nil), "milvus.proto.data.GetFlushedSegmentsRequest")
+	proto.RegisterType((*GetFlushedSegmentsResponse)(nil), "milvus.proto.data.GetFlushedSegmentsResponse")
+	proto.RegisterType((*SegmentFlushCompletedMsg)(nil), "milvus.proto.data.SegmentFlushCompletedMsg")
+	proto.RegisterType((*ChannelWatchInfo)(nil), "milvus.proto.data.ChannelWatchInfo")
+	proto.RegisterType((*CompactionSegmentBinlogs)(nil), "milvus.proto.data.CompactionSegmentBinlogs")
+	proto.RegisterType((*CompactionPlan)(nil), "milvus.proto.data.CompactionPlan")
+	proto.RegisterType((*CompactionResult)(nil), "milvus.proto.data.CompactionResult")
+	proto.RegisterType((*SegmentFieldBinlogMeta)(nil), "milvus.proto.data.SegmentFieldBinlogMeta")
+	proto.RegisterType((*WatchChannelsRequest)(nil), "milvus.proto.data.WatchChannelsRequest")
+	proto.RegisterType((*WatchChannelsResponse)(nil), "milvus.proto.data.WatchChannelsResponse")
+	proto.RegisterType((*DropVirtualChannelRequest)(nil), "milvus.proto.data.DropVirtualChannelRequest")
+	proto.RegisterType((*DropVirtualChannelSegment)(nil), "milvus.proto.data.DropVirtualChannelSegment")
+	proto.RegisterType((*DropVirtualChannelResponse)(nil), "milvus.proto.data.DropVirtualChannelResponse")
+	proto.RegisterType((*ImportSegment)(nil), "milvus.proto.data.ImportSegment")
+	proto.RegisterType((*ImportTask)(nil), "milvus.proto.data.ImportTask")
+	proto.RegisterType((*ImportResult)(nil), "milvus.proto.data.ImportResult")
+	proto.RegisterType((*ImportTaskInfo)(nil), "milvus.proto.data.ImportTaskInfo")
+	proto.RegisterType((*ImportSegmentInfo)(nil), "milvus.proto.data.ImportSegmentInfo")
+	proto.RegisterType((*AddFlushedSegmentsRequest)(nil), "milvus.proto.data.AddFlushedSegmentsRequest")
+	proto.RegisterType((*PinSegmentsRequest)(nil), "milvus.proto.data.PinSegmentsRequest")
+	proto.RegisterType((*PinSegmentsResponse)(nil), "milvus.proto.data.PinSegmentsResponse")
+	proto.RegisterType((*UnpinSegmentsRequest)(nil), "milvus.proto.data.UnpinSegmentsRequest")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.data.AlterCollectionRequest")
+	proto.RegisterType((*PartitionBackup)(nil), "milvus.proto.data.PartitionBackup")
+	proto.RegisterType((*CollectionBackup)(nil), "milvus.proto.data.CollectionBackup")
+}
+
+func init() { proto.RegisterFile("data_coord.proto", fileDescriptor_82cd95f524594f49) }
+
+var fileDescriptor_82cd95f524594f49 = []byte{
+	// 3537 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3b, 0x5b, 0x73, 0x1c, 0x47,
+	0xd5, 0x9e, 0xbd, 0x69, 0xf7, 0xec, 0x45, 0xeb, 0xb6, 0x22, 0xaf, 0xd7, 0x37, 0x79, 0x12, 0x3b,
+	0xb2, 0xe3, 0xc8, 0xb6, 0xfc, 0xe5, 0xfb, 0x52, 0xb9, 0x7c, 0xc1, 0xb2, 0x62, 0x47, 0x20, 0x1b,
+	0x65, 0xa4, 0xc4, 0x54, 0x42, 0x65, 0x6b, 0xb4, 0xd3, 0x92, 0x06, 0xed, 0xce, 0x6c, 0x66, 0x66,
+	0x65, 0x2b, 0x2f, 0x71, 0x41, 0x15, 0x55, 0xa4, 0x42, 0x02, 0xc5, 0x2b, 0x05, 0x14, 0x0f, 0x14,
+	0x14, 0x50, 0x45, 0x1e, 0xe1, 0x17, 0xa4, 0xe0, 0x95, 0x27, 0x1e, 0x79, 0xe1, 0x09, 0x7e, 0x03,
+	0xd5, 0x97, 0xe9, 0xb9, 0xf5, 0xec, 0x8e, 0x56, 0xb2, 0xfd, 0xb6, 0xdd, 0x73, 0xfa, 0x9c, 0xd3,
+	0xa7, 0xcf, 0xbd, 0x7b, 0xa1, 0x69, 0xe8, 0x9e, 0xde, 0xe9, 0xda, 0xb6, 0x63, 0x2c, 0x0c, 0x1c,
+	0xdb, 0xb3, 0xd1, 0xf1, 0xbe, 0xd9, 0xdb, 0x1b, 0xba, 0x6c, 0xb4, 0x40, 0x3e, 0xb7, 0x6b, 0x5d,
+	0xbb, 0xdf, 0xb7, 0x2d, 0x36, 0xd5, 0x6e, 0x98, 0x96, 0x87, 0x1d, 0x4b, 0xef, 0xf1, 0x71, 0x2d,
+	0xbc, 0xa0, 0x5d, 0x73, 0xbb, 0x3b, 0xb8, 0xaf, 0xb3, 0x91, 0xfa, 0x08, 0x6a, 0x77, 0x7a, 0x43,
+	0x77, 0x47, 0xc3, 0x1f, 0x0f, 0xb1, 0xeb, 0xa1, 0xeb, 0x50, 0xd8, 0xd4, 0x5d, 0xdc, 0x52, 0xe6,
+	0x94, 0xf9, 0xea, 0xe2, 0x99, 0x85, 0x08, 0x2d, 0x4e, 0xe5, 0x9e, 0xbb, 0xbd, 0xa4, 0xbb, 0x58,
+	0xa3, 0x90, 0x08, 0x41, 0xc1, 0xd8, 0x5c, 0x59, 0x6e, 0xe5, 0xe6, 0x94, 0xf9, 0xbc, 0x46, 0x7f,
+	0x23, 0x15, 0x6a, 0x5d, 0xbb, 0xd7, 0xc3, 0x5d, 0xcf, 0xb4, 0xad, 0x95, 0xe5, 0x56, 0x81, 0x7e,
+	0x8b, 0xcc, 0xa9, 0x3f, 0x57, 0xa0, 0xce, 0x49, 0xbb, 0x03, 0xdb, 0x72, 0x31, 0xba, 0x09, 0x25,
+	0xd7, 0xd3, 0xbd, 0xa1, 0xcb, 0xa9, 0x9f, 0x96, 0x52, 0x5f, 0xa7, 0x20, 0x1a, 0x07, 0xcd, 0x44,
+	0x3e, 0x9f, 0x24, 0x8f, 0xce, 0x01, 0xb8, 0x78, 0xbb, 0x8f, 0x2d, 0x6f, 0x65, 0xd9, 0x6d, 0x15,
+	0xe6, 0xf2, 0xf3, 0x79, 0x2d, 0x34, 0xa3, 0xfe, 0x54, 0x81, 0xe6, 0xba, 0x3f, 0xf4, 0xa5, 0x33,
+	0x03, 0xc5, 0xae, 0x3d, 0xb4, 0x3c, 0xca, 0x60, 0x5d, 0x63, 0x03, 0x74, 0x01, 0x6a, 0xdd, 0x1d,
+	0xdd, 0xb2, 0x70, 0xaf, 0x63, 0xe9, 0x7d, 0x4c, 0x59, 0xa9, 0x68, 0x55, 0x3e, 0x77, 0x5f, 0xef,
+	0xe3, 0x4c, 0x1c, 0xcd, 0x41, 0x75, 0xa0, 0x3b, 0x9e, 0x19, 0x91, 0x59, 0x78, 0x4a, 0xfd, 0x95,
+	0x02, 0xb3, 0xb7, 0x5c, 0xd7, 0xdc, 0xb6, 0x12, 0x9c, 0xcd, 0x42, 0xc9, 0xb2, 0x0d, 0xbc, 0xb2,
+	0x4c, 0x59, 0xcb, 0x6b, 0x7c, 0x84, 0x4e, 0x43, 0x65, 0x80, 0xb1, 0xd3, 0x71, 0xec, 0x9e, 0xcf,
+	0x58, 0x99, 0x4c, 0x68, 0x76, 0x0f, 0xa3, 0x77, 0xe1, 0xb8, 0x1b, 0x43, 0xe4, 0xb6, 0xf2, 0x73,
+	0xf9, 0xf9, 0xea, 0xe2, 0xf3, 0x0b, 0x09, 0x2d, 0x5b, 0x88, 0x13, 0xd5, 0x92, 0xab, 0xd5, 0xc7,
+	0x39, 0x38, 0x21, 0xe0, 0x18, 0xaf, 0xe4, 0x37, 0x91, 0x9c, 0x8b, 0xb7, 0x05, 0x7b, 0x6c, 0x90,
+	0x45, 0x72, 0x42, 0xe4, 0xf9, 0xb0, 0xc8, 0x33, 0x28, 0x58, 0x5c, 0x9e, 0xc5, 0x84, 0x3c, 0xd1,
+	0x79, 0xa8, 0xe2, 0x47, 0x03, 0xd3, 0xc1, 0x1d, 0xcf, 0xec, 0xe3, 0x56, 0x69, 0x4e, 0x99, 0x2f,
+	0x68, 0xc0, 0xa6, 0x36, 0xcc, 0x7e, 0x58, 0x23, 0xa7, 0x32, 0x6b, 0xa4, 0xfa, 0x6b, 0x05, 0x4e,
+	0x26, 0x4e, 0x89, 0xab, 0xb8, 0x06, 0x4d, 0xba, 0xf3, 0x40, 0x32, 0x44, 0xd9, 0x89, 0xc0, 0x2f,
+	0x8d, 0x12, 0x78, 0x00, 0xae, 0x25, 0xd6, 0x87, 0x98, 0xcc, 0x65, 0x67, 0x72, 0x17, 0x4e, 0xde,
+	0xc5, 0x1e, 0x27, 0x40, 0xbe, 0x61, 0x77, 0x72, 0x17, 0x10, 0xb5, 0xa5, 0x5c, 0xc2, 0x96, 0xfe,
+	0x94, 0x83, 0x66, 0x98, 0xd4, 0x8a, 0xb5, 0x65, 0xa3, 0x33, 0x50, 0x11, 0x20, 0x5c, 0x2b, 0x82,
+	0x09, 0xf4, 0x7f, 0x50, 0x24, 0x9c, 0x32, 0x95, 0x68, 0x2c, 0x5e, 0x90, 0xef, 0x29, 0x84, 0x53,
+	0x63, 0xf0, 0x68, 0x05, 0x1a, 0xae, 0xa7, 0x3b, 0x5e, 0x67, 0x60, 0xbb, 0xf4, 0x9c, 0xa9, 0xe2,
+	0x54, 0x17, 0xd5, 0x28, 0x06, 0xe1, 0x22, 0xef, 0xb9, 0xdb, 0x6b, 0x1c, 0x52, 0xab, 0xd3, 0x95,
+	0xfe, 0x10, 0xbd, 0x0d, 0x35, 0x6c, 0x19, 0x01, 0xa2, 0x42, 0x66, 0x44, 0x55, 0x6c, 0x19, 0x02,
+	0x4d, 0x70, 0x3e, 0xc5, 0xec, 0xe7, 0xf3, 0xb9, 0x02, 0xad, 0xe4, 0x01, 0x1d, 0xc6, 0x51, 0xbe,
+	0xce, 0x16, 0x61, 0x76, 0x40, 0x23, 0x2d, 0x5c, 0x1c, 0x92, 0xc6, 0x97, 0xa8, 0x26, 0x3c, 0x17,
+	0x70, 0x43, 0xbf, 0x3c, 0x31, 0x65, 0xf9, 0x81, 0x02, 0xb3, 0x71, 0x5a, 0x87, 0xd9, 0xf7, 0xff,
+	0x40, 0xd1, 0xb4, 0xb6, 0x6c, 0x7f, 0xdb, 0xe7, 0x46, 0xd8, 0x19, 0xa1, 0xc5, 0x80, 0xd5, 0x3e,
+	0x9c, 0xbe, 0x8b, 0xbd, 0x15, 0xcb, 0xc5, 0x8e, 0xb7, 0x64, 0x5a, 0x3d, 0x7b, 0x7b, 0x4d, 0xf7,
+	0x76, 0x0e, 0x61, 0x23, 0x11, 0x75, 0xcf, 0xc5, 0xd4, 0x5d, 0xfd, 0xad, 0x02, 0x67, 0xe4, 0xf4,
+	0xf8, 0xd6, 0xdb, 0x50, 0xde, 0x32, 0x71, 0xcf, 0x58, 0x59, 0x66, 0x0e, 0x23, 0xaf, 0x89, 0x31,
+	0xb1, 0x95, 0x01, 0x01, 0xe6, 0x3b, 0xbc, 0x90, 0xa2, 0xa0, 0xeb, 0x9e, 0x63, 0x5a, 0xdb, 0xab,
+	0xa6, 0xeb, 0x69, 0x0c, 0x3e, 0x24, 0xcf, 0x7c, 0x76, 0xcd, 0xfc, 0x4c, 0x81, 0x73, 0x77, 0xb1,
+	0x77, 0x5b, 0xb8, 0x5a, 0xf2, 0xdd, 0x74, 0x3d, 0xb3, 0xeb, 0x3e, 0xd9, 0x24, 0x42, 0x12, 0x33,
+	0xd5, 0x2f, 0x15, 0x38, 0x9f, 0xca, 0x0c, 0x17, 0x1d, 0x77, 0x25, 0xbe, 0xa3, 0x95, 0xbb, 0x92,
+	0x6f, 0xe1, 0xfd, 0xf7, 0xf5, 0xde, 0x10, 0xaf, 0xe9, 0xa6, 0xc3, 0x5c, 0xc9, 0x84, 0x8e, 0xf5,
+	0xf7, 0x0a, 0x9c, 0xbd, 0x8b, 0xbd, 0x35, 0x3f, 0xcc, 0x3c, 0x43, 0xe9, 0x64, 0xc8, 0x28, 0xbe,
+	0x60, 0x87, 0x29, 0xe5, 0xf6, 0x99, 0x88, 0xef, 0x1c, 0xb5, 0x83, 0x90, 0x41, 0xde, 0x66, 0xb9,
+	0x00, 0x17, 0x9e, 0xfa, 0x38, 0x0f, 0xb5, 0xf7, 0x79, 0x7e, 0x40, 0x3e, 0x27, 0xe4, 0xa0, 0xc8,
+	0xe5, 0x10, 0x4a, 0x29, 0x64, 0x59, 0xc6, 0x5d, 0xa8, 0xbb, 0x18, 0xef, 0x4e, 0x12, 0x34, 0x6a,
+	0x64, 0xa1, 0x3f, 0x42, 0xab, 0x70, 0x7c, 0x68, 0x6d, 0x91, 0xb4, 0x16, 0x1b, 0x7c, 0x17, 0x2c,
+	0xbb, 0x1c, 0xef, 0x79, 0x92, 0x0b, 0xd1, 0x3b, 0x30, 0x1d, 0xc7, 0x55, 0xcc, 0x84, 0x2b, 0xbe,
+	0x0c, 0xad, 0x40, 0xd3, 0x70, 0xec, 0xc1, 0x00, 0x1b, 0x1d, 0xd7, 0x47, 0x55, 0xca, 0x86, 0x8a,
+	0xaf, 0xf3, 0x51, 0xa9, 0x3f, 0x52, 0x60, 0xf6, 0x81, 0xee, 0x75, 0x77, 0x96, 0xfb, 0xfc, 0x70,
+	0x0e, 0xa1, 0xda, 0x6f, 0x42, 0x65, 0x8f, 0x1f, 0x84, 0xef, 0xbf, 0xce, 0x4b, 0x18, 0x0a, 0x1f,
+	0xb9, 0x16, 0xac, 0x50, 0xbf, 0x56, 0x60, 0x86, 0x16, 0x11, 0x3e, 0x77, 0x4f, 0xdf, 0xc8, 0xc6,
+	0x14, 0x12, 0xe8, 0x12, 0x34, 0xfa, 0xba, 0xb3, 0xbb, 0x1e, 0xc0, 0x14, 0x29, 0x4c, 0x6c, 0x56,
+	0x7d, 0x04, 0xc0, 0x47, 0xf7, 0xdc, 0xed, 0x09, 0xf8, 0x7f, 0x15, 0xa6, 0x38, 0x55, 0x6e, 0x6f,
+	0xe3, 0x0e, 0xd6, 0x07, 0x57, 0x7f, 0x9c, 0x83, 0x46, 0xe0, 0x41, 0xa9, 0x55, 0x35, 0x20, 0x27,
+	0x6c, 0x29, 0xb7, 0xb2, 0x8c, 0xde, 0x84, 0x12, 0x2b, 0x1b, 0x39, 0xee, 0x8b, 0x51, 0xdc, 0xec,
+	0xdb, 0x42, 0xc8, 0x0d, 0xd3, 0x09, 0x8d, 0x2f, 0x22, 0x32, 0x12, 0x5e, 0x87, 0x55, 0x18, 0x79,
+	0x2d, 0x34, 0x83, 0x56, 0x60, 0x3a, 0x9a, 0xb4, 0xf9, 0x36, 0x33, 0x97, 0xe6, 0x6d, 0x96, 0x75,
+	0x4f, 0xa7, 0xce, 0xa6, 0x11, 0xc9, 0xd9, 0x5c, 0x74, 0x0b, 0x60, 0xe0, 0xd8, 0x03, 0xec, 0x78,
+	0x26, 0xf6, 0xad, 0x25, 0x83, 0xcf, 0x0a, 0x2d, 0x52, 0xff, 0x53, 0x84, 0x6a, 0x48, 0x50, 0x09,
+	0x61, 0xc4, 0xb5, 0x22, 0x37, 0xde, 0xf5, 0xe6, 0x93, 0xc5, 0xc7, 0x45, 0x68, 0x98, 0x34, 0xdc,
+	0x77, 0xb8, 0x36, 0x53, 0xff, 0x5c, 0xd1, 0xea, 0x6c, 0x96, 0x9b, 0x16, 0x3a, 0x07, 0x55, 0x6b,
+	0xd8, 0xef, 0xd8, 0x5b, 0x1d, 0xc7, 0x7e, 0xe8, 0xf2, 0x2a, 0xa6, 0x62, 0x0d, 0xfb, 0xdf, 0xde,
+	0xd2, 0xec, 0x87, 0x6e, 0x90, 0x28, 0x97, 0x0e, 0x98, 0x28, 0x9f, 0x83, 0x6a, 0x5f, 0x7f, 0x44,
+	0xb0, 0x76, 0xac, 0x61, 0x9f, 0x16, 0x38, 0x79, 0xad, 0xd2, 0xd7, 0x1f, 0x69, 0xf6, 0xc3, 0xfb,
+	0xc3, 0x3e, 0x9a, 0x87, 0x66, 0x4f, 0x77, 0xbd, 0x4e, 0xb8, 0x42, 0x2a, 0xd3, 0x0a, 0xa9, 0x41,
+	0xe6, 0xdf, 0x0e, 0xaa, 0xa4, 0x64, 0xca, 0x5d, 0x39, 0x44, 0xca, 0x6d, 0xf4, 0x7b, 0x01, 0x22,
+	0xc8, 0x9e, 0x72, 0x1b, 0xfd, 0x9e, 0x40, 0xf3, 0x2a, 0x4c, 0x6d, 0xd2, 0x24, 0xca, 0x6d, 0x55,
+	0x53, 0x9d, 0xdc, 0x1d, 0x92, 0x3f, 0xb1, 0x5c, 0x4b, 0xf3, 0xc1, 0xd1, 0x1b, 0x50, 0xa1, 0xd1,
+	0x8b, 0xae, 0xad, 0x65, 0x5a, 0x1b, 0x2c, 0x20, 0xab, 0x0d, 0xdc, 0xf3, 0x74, 0xba, 0xba, 0x9e,
+	0x6d, 0xb5, 0x58, 0x80, 0xae, 0xc3, 0x89, 0xae, 0x83, 0x75, 0x0f, 0x1b, 0x4b, 0xfb, 0xb7, 0xed,
+	0xfe, 0x40, 0xa7, 0xca, 0xd4, 0x6a, 0xcc, 0x29, 0xf3, 0x65, 0x4d, 0xf6, 0x89, 0xf8, 0x96, 0xae,
+	0x18, 0xdd, 0x71, 0xec, 0x7e, 0x6b, 0x9a, 0xf9, 0x96, 0xe8, 0x2c, 0x3a, 0x0b, 0xe0, 0x7b, 0x7f,
+	0xdd, 0x6b, 0x35, 0xe9, 0x29, 0x56, 0xf8, 0xcc, 0x2d, 0x4f, 0xfd, 0x14, 0x66, 0x02, 0x0d, 0x09,
+	0x9d, 0x46, 0xf2, 0x60, 0x95, 0x49, 0x0f, 0x76, 0x74, 0xfa, 0xfb, 0x55, 0x01, 0x66, 0xd7, 0xf5,
+	0x3d, 0xfc, 0xe4, 0x33, 0xed, 0x4c, 0x2e, 0x7d, 0x15, 0x8e, 0xd3, 0xe4, 0x7a, 0x31, 0xc4, 0x4f,
+	0xab, 0x90, 0xe9, 0x38, 0x93, 0x0b, 0xd1, 0x5b, 0x24, 0xfb, 0xc0, 0xdd, 0xdd, 0x35, 0xdb, 0x0c,
+	0x02, 0xf8, 0x59, 0x09, 0x9e, 0xdb, 0x02, 0x4a, 0x0b, 0xaf, 0x40, 0x6b, 0x49, 0xef, 0xc8, 0x42,
+	0xf7, 0x8b, 0x23, 0x4b, 0xb8, 0x40, 0xfa, 0x09, 0x27, 0xd9, 0x82, 0x29, 0x9e, 0x20, 0x50, 0xbb,
+	0x2f, 0x6b, 0xfe, 0x10, 0xad, 0xc1, 0x09, 0xb6, 0x83, 0x75, 0xae, 0xd4, 0x6c, 0xf3, 0xe5, 0x4c,
+	0x9b, 0x97, 0x2d, 0x8d, 0xda, 0x44, 0xe5, 0xa0, 0x36, 0xd1, 0x82, 0x29, 0xae, 0xa7, 0xd4, 0x17,
+	0x94, 0x35, 0x7f, 0x48, 0xea, 0x10, 0x08, 0x24, 0x36, 0xa6, 0x9d, 0xf0, 0xff, 0x50, 0x16, 0x3a,
+	0x9c, 0xcb, 0xac, 0xc3, 0x62, 0x4d, 0xdc, 0x0b, 0xe7, 0x63, 0x5e, 0x58, 0xfd, 0x9b, 0x02, 0xb5,
+	0x65, 0xc2, 0xf4, 0xaa, 0xbd, 0x4d, 0x63, 0xc6, 0x45, 0x68, 0x38, 0xb8, 0x6b, 0x3b, 0x46, 0x07,
+	0x5b, 0x9e, 0x43, 0x42, 0x91, 0x42, 0xad, 0xae, 0xce, 0x66, 0xdf, 0x66, 0x93, 0x04, 0x8c, 0x38,
+	0x56, 0xd7, 0xd3, 0xfb, 0x83, 0xce, 0x16, 0x31, 0xe0, 0x1c, 0x03, 0x13, 0xb3, 0xd4, 0x7e, 0x2f,
+	0x40, 0x2d, 0x00, 0xf3, 0x6c, 0x4a, 0xbf, 0xa0, 0x55, 0xc5, 0xdc, 0x86, 0x8d, 0x5e, 0x80, 0x06,
+	0x95, 0x5a, 0xa7, 0x67, 0x6f, 0x77, 0x48, 0x79, 0xc7, 0xc3, 0x49, 0xcd, 0xe0, 0x6c, 0x91, 0xd3,
+	0x88, 0x42, 0xb9, 0xe6, 0x27, 0x98, 0x07, 0x14, 0x01, 0xb5, 0x6e, 0x7e, 0x82, 0xd5, 0xbf, 0x2a,
+	0x50, 0x27, 0x01, 0xf6, 0xbe, 0x6d, 0xe0, 0x8d, 0x09, 0xd3, 0x91, 0x0c, 0xad, 0xbd, 0x33, 0x50,
+	0x11, 0x3b, 0xe0, 0x5b, 0x0a, 0x26, 0xd0, 0x1d, 0x68, 0xf8, 0x99, 0x6a, 0x87, 0x15, 0x20, 0x85,
+	0xd4, 0xf4, 0x30, 0x14, 0xdf, 0x5c, 0xad, 0xee, 0x2f, 0xa3, 0x43, 0xf5, 0x0e, 0xd4, 0xc2, 0x9f,
+	0x09, 0xd5, 0xf5, 0xb8, 0xa2, 0x88, 0x09, 0xa2, 0x6f, 0xf7, 0x87, 0x7d, 0x72, 0xa6, 0xdc, 0x75,
+	0xf8, 0x43, 0xd2, 0x97, 0xa8, 0xf3, 0xa0, 0xbc, 0x2e, 0x5a, 0xcf, 0x74, 0x6b, 0x0a, 0xdd, 0x1a,
+	0xfd, 0x8d, 0x5e, 0x8b, 0xf6, 0xad, 0x5e, 0x90, 0x9a, 0x39, 0x45, 0x42, 0x53, 0xe8, 0x48, 0x44,
+	0xce, 0x52, 0xf0, 0x3e, 0x26, 0x8a, 0xc6, 0x8f, 0x86, 0x2a, 0x5a, 0x0b, 0xa6, 0x74, 0xc3, 0x70,
+	0xb0, 0xeb, 0x72, 0x3e, 0xfc, 0x21, 0xf9, 0xb2, 0x87, 0x1d, 0xd7, 0x57, 0xf9, 0xbc, 0xe6, 0x0f,
+	0xd1, 0x1b, 0x50, 0x16, 0x39, 0x77, 0x5e, 0x96, 0x67, 0x85, 0xf9, 0xe4, 0x05, 0x9a, 0x58, 0xa1,
+	0x7e, 0x91, 0x83, 0x06, 0x17, 0xd8, 0x12, 0x8f, 0x9a, 0xa3, 0x8d, 0x6f, 0x09, 0x6a, 0x5b, 0x81,
+	0x75, 0x8f, 0x6a, 0xc4, 0x84, 0x9d, 0x40, 0x64, 0xcd, 0x38, 0x03, 0x8c, 0xc6, 0xed, 0xc2, 0xa1,
+	0xe2, 0x76, 0xf1, 0x80, 0x3e, 0x4a, 0xfd, 0x2e, 0x54, 0x43, 0x5f, 0xa8, 0x73, 0x65, 0xad, 0x19,
+	0x2e, 0x0a, 0x7f, 0x88, 0x6e, 0x06, 0x69, 0x09, 0x93, 0xc1, 0x29, 0x09, 0x91, 0x58, 0x46, 0xa2,
+	0xfe, 0x4e, 0x81, 0x12, 0xc7, 0x4c, 0xfa, 0xd5, 0xcc, 0x71, 0xd0, 0x94, 0x8d, 0x61, 0x07, 0x3e,
+	0x45, 0x72, 0xb6, 0xa3, 0x73, 0x27, 0xa7, 0xa0, 0x1c, 0x73, 0x24, 0x53, 0xdc, 0xa3, 0xfb, 0x9f,
+	0x42, 0xde, 0x63, 0xaa, 0xc7, 0x1d, 0xc7, 0xd7, 0x0a, 0x6d, 0x2b, 0x6b, 0xb8, 0x6b, 0xef, 0x61,
+	0x67, 0xff, 0xf0, 0xcd, 0xbb, 0xd7, 0x43, 0x9a, 0x9a, 0xb1, 0x3a, 0x14, 0x0b, 0xd0, 0xeb, 0x81,
+	0xb8, 0xf3, 0xb2, 0x3a, 0x20, 0xec, 0x3a, 0xb8, 0x9e, 0x05, 0x62, 0xff, 0x09, 0x6b, 0x43, 0x46,
+	0xb7, 0x32, 0x69, 0x4a, 0x72, 0x24, 0x15, 0x83, 0xfa, 0x33, 0x05, 0x4e, 0xdd, 0xc5, 0xde, 0x9d,
+	0x68, 0x69, 0xff, 0xac, 0xb9, 0xea, 0x43, 0x5b, 0xc6, 0xd4, 0x61, 0x4e, 0xbd, 0x0d, 0x65, 0xd1,
+	0xa4, 0x60, 0x0d, 0x62, 0x31, 0x56, 0x7f, 0xa8, 0x40, 0x8b, 0x53, 0xa1, 0x34, 0x49, 0x36, 0xdc,
+	0xc3, 0x1e, 0x36, 0x9e, 0x76, 0xd5, 0xfc, 0x4b, 0x05, 0x9a, 0x61, 0x57, 0x4e, 0xbe, 0xa2, 0x57,
+	0xa0, 0x48, 0x9b, 0x13, 0x9c, 0x83, 0xb1, 0xca, 0xca, 0xa0, 0x89, 0xcb, 0xa0, 0x19, 0xda, 0x86,
+	0x88, 0x3a, 0x7c, 0x18, 0xc4, 0x93, 0xfc, 0x81, 0xe3, 0x89, 0xfa, 0x79, 0x0e, 0x5a, 0x41, 0xb1,
+	0xf0, 0xd4, 0x5d, 0x76, 0x4a, 0x2a, 0x99, 0x3f, 0xa2, 0x54, 0xb2, 0x70, 0x50, 0x37, 0xfd, 0x4f,
+	0xda, 0xe6, 0xf0, 0xc5, 0xb1, 0xd6, 0xd3, 0x2d, 0x72, 0x6b, 0x3a, 0xe8, 0xe9, 0x41, 0xdb, 0x90,
+	0x8f, 0xd0, 0xba, 0xc8, 0x3d, 0xa2, 0x02, 0x78, 0x49, 0x26, 0xfe, 0x14, 0x09, 0x6b, 0x31, 0x14,
+	0xa4, 0x08, 0x63, 0x69, 0x3c, 0x2d, 0xa5, 0x79, 0xbe, 0xc3, 0xce, 0x99, 0x54, 0xd1, 0x57, 0x01,
+	0x91, 0x0f, 0xf6, 0xd0, 0xeb, 0x98, 0x56, 0xc7, 0xc5, 0x5d, 0xdb, 0x32, 0x5c, 0xea, 0x7b, 0x8b,
+	0x5a, 0x93, 0x7f, 0x59, 0xb1, 0xd6, 0xd9, 0x3c, 0x7a, 0x05, 0x0a, 0xde, 0xfe, 0x80, 0x39, 0xe0,
+	0xc6, 0xe2, 0x85, 0x91, 0x7c, 0x6d, 0xec, 0x0f, 0xb0, 0x46, 0xc1, 0x49, 0x23, 0x86, 0xa0, 0xf2,
+	0x1c, 0x7d, 0x0f, 0xf7, 0xfc, 0x0b, 0xcf, 0x60, 0x86, 0x28, 0xa2, 0xdf, 0x8d, 0x98, 0x62, 0x5e,
+	0x9f, 0x0f, 0x49, 0x68, 0x09, 0x1c, 0x43, 0xc7, 0xf3, 0x7a, 0xb4, 0x19, 0x90, 0xd7, 0xea, 0xc1,
+	0xec, 0x86, 0xd7, 0x53, 0xff, 0x9c, 0x83, 0x66, 0x40, 0x59, 0xc3, 0xee, 0xb0, 0xe7, 0xa5, 0x8a,
+	0x79, 0x74, 0xa5, 0x36, 0x2e, 0xe4, 0xbf, 0x05, 0x55, 0xde, 0x40, 0x39, 0x80, 0x3e, 0x00, 0x5b,
+	0xb2, 0x3a, 0x42, 0x41, 0x8b, 0x47, 0xa4, 0xa0, 0xa5, 0x83, 0x2a, 0xe8, 0x3a, 0xcc, 0xfa, 0x9e,
+	0x2d, 0x00, 0xb8, 0x87, 0x3d, 0x7d, 0x44, 0x4a, 0x71, 0x1e, 0xaa, 0x2c, 0x62, 0xb1, 0x50, 0xcd,
+	0xb2, 0x6c, 0xd8, 0x14, 0xe5, 0xa7, 0xfa, 0x11, 0xcc, 0x50, 0xcf, 0x10, 0x6f, 0xd5, 0x66, 0xe9,
+	0x9b, 0xab, 0x50, 0x0b, 0xe5, 0xeb, 0xcc, 0x08, 0x2a, 0x5a, 0x64, 0x4e, 0x5d, 0x85, 0xe7, 0x62,
+	0xf8, 0x0f, 0xe1, 0xf9, 0xd5, 0xbf, 0x28, 0x70, 0x6a, 0xd9, 0xb1, 0x07, 0xef, 0x9b, 0x8e, 0x37,
+	0xd4, 0x7b, 0xd1, 0xe6, 0xff, 0x93, 0xa9, 0x42, 0xde, 0x09, 0x05, 0x1b, 0xe6, 0x9b, 0xae, 0x4a,
+	0x8e, 0x2c, 0xc9, 0x14, 0x3f, 0xaa, 0x50, 0x68, 0xfa, 0x57, 0x1e, 0x4e, 0xa5, 0xc2, 0x8d, 0x71,
+	0xb8, 0x59, 0x62, 0xb1, 0xb4, 0x2d, 0x91, 0x9f, 0xb4, 0x2d, 0x91, 0xa2, 0xfd, 0x85, 0x23, 0xd2,
+	0xfe, 0x83, 0x66, 0xd1, 0xe8, 0x1d, 0x88, 0xb6, 0x8c, 0x5a, 0xa5, 0xcc, 0x75, 0x7a, 0x74, 0x21,
+	0x5a, 0x02, 0x08, 0xda, 0x27, 0xad, 0xa9, 0xcc, 0x68, 0x42, 0xab, 0xc8, 0x69, 0x09, 0x4f, 0xc3,
+	0x3d, 0x5d, 0x30, 0xa1, 0xbe, 0x0b, 0x6d, 0x99, 0x96, 0x1e, 0x46, 0xf3, 0x2d, 0xa8, 0xaf, 0xf4,
+	0x07, 0xb6, 0xe3, 0xdf, 0x7d, 0x65, 0x7c, 0x1b, 0xb3, 0x65, 0xf6, 0x30, 0x53, 0x82, 0x8a, 0xc6,
+	0x06, 0xe3, 0x2e, 0x24, 0xbe, 0x59, 0x28, 0x2b, 0xcd, 0x9c, 0xfa, 0xf7, 0x1c, 0x00, 0x23, 0xb8,
+	0xa1, 0xbb, 0xbb, 0x13, 0x98, 0xd6, 0x2c, 0x94, 0x3c, 0xdd, 0xdd, 0x15, 0xba, 0xca, 0x47, 0x47,
+	0x73, 0x31, 0x19, 0xba, 0x70, 0x28, 0x4e, 0x72, 0xe1, 0x70, 0x1a, 0x2a, 0xa4, 0xb1, 0x4d, 0x18,
+	0x35, 0xa8, 0x22, 0x95, 0xb5, 0xb2, 0x63, 0x3f, 0x24, 0xec, 0x1b, 0xa4, 0xfc, 0x15, 0x16, 0x3f,
+	0x95, 0x5a, 0xfe, 0x46, 0x4e, 0x23, 0xb0, 0xf2, 0x68, 0xd7, 0xa2, 0x1c, 0xeb, 0x5a, 0xa8, 0x7f,
+	0xc8, 0x41, 0x8d, 0xad, 0xe4, 0xb1, 0x6f, 0xa2, 0x04, 0x38, 0x4d, 0xb6, 0x11, 0x1f, 0x92, 0x1f,
+	0x13, 0x30, 0x0b, 0x63, 0x02, 0x66, 0xf1, 0xa8, 0x02, 0x66, 0x69, 0x62, 0x97, 0xa1, 0x7e, 0x96,
+	0x83, 0x46, 0xa0, 0x85, 0x34, 0x85, 0x0e, 0xf6, 0xae, 0x8c, 0xd4, 0xab, 0xc9, 0x6e, 0x5d, 0x82,
+	0x77, 0x72, 0x85, 0xc8, 0x3b, 0xb9, 0x6f, 0x84, 0x74, 0x82, 0x09, 0xe6, 0x85, 0x71, 0x3a, 0xc1,
+	0xaa, 0x4d, 0xa1, 0x17, 0xe7, 0xa1, 0xca, 0x5a, 0xf4, 0xc1, 0x63, 0xb2, 0xbc, 0x06, 0x6c, 0x8a,
+	0x26, 0x78, 0xe7, 0xa1, 0xba, 0x65, 0x5a, 0xa6, 0xbb, 0xc3, 0x00, 0xd8, 0x85, 0x0b, 0xb0, 0x29,
+	0x02, 0xa0, 0xfe, 0x43, 0x81, 0xe3, 0x09, 0x0a, 0x63, 0xe2, 0xc6, 0xc4, 0x5e, 0xe2, 0x7f, 0xfd,
+	0xc2, 0xa2, 0x40, 0x33, 0x48, 0xf9, 0x45, 0x1b, 0xe7, 0x26, 0xdc, 0xa4, 0x9a, 0x85, 0x92, 0x83,
+	0x75, 0xd7, 0xb6, 0xa8, 0x61, 0x56, 0x34, 0x3e, 0x8a, 0x2b, 0x5f, 0x29, 0xee, 0x32, 0xff, 0xa8,
+	0xc0, 0xa9, 0x5b, 0x86, 0xf1, 0x54, 0x8b, 0xd7, 0xd7, 0x12, 0xa1, 0x7d, 0x5c, 0x75, 0x17, 0x04,
+	0xf3, 0x2f, 0x15, 0x40, 0x6b, 0xa6, 0xf5, 0x74, 0x18, 0x7d, 0x1e, 0xea, 0x3d, 0xac, 0xbb, 0x58,
+	0xa4, 0xfd, 0xdc, 0x69, 0xd2, 0x49, 0x9e, 0xf2, 0x93, 0x07, 0xb3, 0x27, 0x22, 0x1c, 0x1d, 0xa6,
+	0xc4, 0x9e, 0x81, 0xe2, 0xc0, 0x0c, 0xd8, 0x61, 0x83, 0x43, 0x09, 0xec, 0x23, 0x98, 0x79, 0xcf,
+	0x1a, 0x1c, 0x85, 0xc4, 0xa4, 0xbc, 0xa9, 0x5f, 0x91, 0xc7, 0xaf, 0x3d, 0x0f, 0x3b, 0x81, 0xd3,
+	0x7f, 0xb2, 0x87, 0x12, 0xbd, 0x49, 0xce, 0x4f, 0x72, 0x93, 0xfc, 0x01, 0x4c, 0x8b, 0xa7, 0x35,
+	0x4b, 0x7a, 0x77, 0x77, 0x38, 0x88, 0xbb, 0x28, 0x45, 0x7a, 0x31, 0x2c, 0x86, 0x61, 0xa3, 0xae,
+	0x8b, 0x59, 0x62, 0xd6, 0xea, 0xbf, 0x0b, 0xa4, 0xd2, 0xf2, 0xf9, 0xe5, 0xd8, 0x49, 0x39, 0x40,
+	0x7f, 0x75, 0x42, 0x9d, 0x69, 0x60, 0x53, 0xd4, 0x19, 0x5c, 0x86, 0x26, 0x07, 0x08, 0x82, 0x18,
+	0xeb, 0x11, 0x4e, 0xb3, 0xf9, 0x0d, 0x7f, 0x1a, 0x9d, 0x84, 0x29, 0x63, 0x93, 0xe1, 0xc9, 0x33,
+	0x53, 0x37, 0x36, 0x29, 0x8e, 0x17, 0x61, 0x3a, 0x54, 0x0a, 0x52, 0x00, 0xd6, 0x22, 0x0c, 0x55,
+	0x88, 0xd2, 0x57, 0xcf, 0x45, 0x89, 0x94, 0x83, 0x40, 0x5f, 0x9a, 0x24, 0xd0, 0x93, 0xa2, 0x7a,
+	0x47, 0x77, 0x0c, 0x57, 0x5c, 0x62, 0x17, 0xb5, 0x0a, 0x9b, 0x21, 0x0d, 0x51, 0x0d, 0x8e, 0x77,
+	0x6d, 0xcb, 0x35, 0x5d, 0x0f, 0x5b, 0xdd, 0xfd, 0x4e, 0x0f, 0x93, 0xb2, 0xb7, 0x4c, 0x3d, 0xde,
+	0x45, 0xe9, 0x51, 0xde, 0x0e, 0xa0, 0x57, 0x09, 0xb0, 0xd6, 0xec, 0xc6, 0x66, 0xd0, 0x22, 0x3c,
+	0xb7, 0xc7, 0x12, 0xbf, 0x4e, 0xd8, 0xf5, 0xb2, 0xcb, 0xad, 0x8a, 0x76, 0x62, 0x2f, 0x92, 0x15,
+	0xd2, 0x2a, 0x89, 0xa4, 0xa4, 0xa1, 0x07, 0x10, 0x30, 0x97, 0x4f, 0xa6, 0xa4, 0xd4, 0xb4, 0x62,
+	0xda, 0x12, 0x79, 0x24, 0x11, 0x36, 0xce, 0xea, 0xc1, 0x8c, 0x33, 0xa6, 0xcb, 0xb5, 0x09, 0x74,
+	0xf9, 0xca, 0x0d, 0x38, 0x9e, 0xe8, 0x34, 0xa1, 0x06, 0xc0, 0x7b, 0x56, 0x97, 0xb7, 0xe0, 0x9a,
+	0xc7, 0x50, 0x0d, 0xca, 0x7e, 0x43, 0xae, 0xa9, 0x5c, 0x59, 0x87, 0x46, 0xb4, 0x0b, 0x81, 0x4e,
+	0xc2, 0x89, 0xf7, 0x2c, 0x03, 0x6f, 0x99, 0x16, 0x36, 0x82, 0x4f, 0xcd, 0x63, 0xe8, 0x04, 0x4c,
+	0xaf, 0x58, 0x16, 0x76, 0x42, 0x93, 0x0a, 0x99, 0xbc, 0x87, 0x9d, 0x6d, 0x1c, 0x9a, 0xcc, 0x2d,
+	0xfe, 0xa6, 0x05, 0x15, 0x72, 0x03, 0x72, 0xdb, 0xb6, 0x1d, 0x03, 0x0d, 0x00, 0xd1, 0xf7, 0x7f,
+	0xfd, 0x81, 0x6d, 0x89, 0x87, 0xb2, 0xe8, 0x7a, 0x4a, 0xb6, 0x9f, 0x04, 0xe5, 0x2e, 0xa4, 0x7d,
+	0x29, 0x65, 0x45, 0x0c, 0x5c, 0x3d, 0x86, 0xfa, 0x94, 0x22, 0x31, 0x93, 0x0d, 0xb3, 0xbb, 0xeb,
+	0x3f, 0xd3, 0x18, 0x41, 0x31, 0x06, 0xea, 0x53, 0x8c, 0xbd, 0xbf, 0xe5, 0x03, 0xf6, 0x48, 0xd3,
+	0xf7, 0xed, 0xea, 0x31, 0xf4, 0x31, 0xcc, 0x90, 0x07, 0x71, 0xe2, 0x5d, 0x9e, 0x4f, 0x70, 0x31,
+	0x9d, 0x60, 0x02, 0xf8, 0x80, 0x24, 0x57, 0xa1, 0x48, 0xc3, 0x34, 0x92, 0xb5, 0x2f, 0xc3, 0xff,
+	0x16, 0x69, 0xcf, 0xa5, 0x03, 0x08, 0x6c, 0xdf, 0x83, 0xe9, 0xd8, 0x6b, 0x78, 0x74, 0x59, 0xb2,
+	0x4c, 0xfe, 0xbf, 0x86, 0xf6, 0x95, 0x2c, 0xa0, 0x82, 0xd6, 0x36, 0x34, 0xa2, 0xaf, 0x07, 0xd1,
+	0xbc, 0x64, 0xbd, 0xf4, 0x25, 0x73, 0xfb, 0x72, 0x06, 0x48, 0x41, 0xa8, 0x0f, 0xcd, 0xf8, 0xeb,
+	0x6c, 0x74, 0x65, 0x24, 0x82, 0xa8, 0xba, 0xbd, 0x94, 0x09, 0x56, 0x90, 0xdb, 0x87, 0x19, 0xd9,
+	0xeb, 0x60, 0xb4, 0x20, 0x47, 0x93, 0xf6, 0x6c, 0xb9, 0x7d, 0x2d, 0x33, 0xbc, 0x20, 0xfd, 0x7d,
+	0x76, 0xa5, 0x23, 0x7b, 0x61, 0x8b, 0x6e, 0xc8, 0xd1, 0x8d, 0x78, 0x1a, 0xdc, 0x5e, 0x3c, 0xc8,
+	0x12, 0xc1, 0xc4, 0xa7, 0xf4, 0x2e, 0x46, 0xf2, 0x4a, 0x15, 0x5d, 0x97, 0xe3, 0x4b, 0x7f, 0x7e,
+	0xdb, 0xbe, 0x71, 0x80, 0x15, 0x82, 0x01, 0x3b, 0xfe, 0xfe, 0xdd, 0x37, 0xc3, 0x6b, 0x63, 0xb5,
+	0x66, 0x32, 0x1b, 0xfc, 0x10, 0xa6, 0x63, 0x0f, 0x62, 0xa4, 0x56, 0x23, 0x7f, 0x34, 0xd3, 0x1e,
+	0x95, 0x02, 0x32, 0x93, 0x8c, 0x5d, 0x6d, 0xa1, 0x14, 0xed, 0x97, 0x5c, 0x7f, 0xb5, 0xaf, 0x64,
+	0x01, 0x15, 0x1b, 0x71, 0xa9, 0xbb, 0x8c, 0xa5, 0xfd, 0xe8, 0xaa, 0x1c, 0x87, 0xbc, 0x3a, 0x68,
+	0xbf, 0x9c, 0x11, 0x5a, 0x10, 0xed, 0x00, 0xdc, 0xc5, 0xde, 0x3d, 0xec, 0x39, 0x44, 0x47, 0x2e,
+	0x49, 0x45, 0x1e, 0x00, 0xf8, 0x64, 0x5e, 0x1c, 0x0b, 0x27, 0x08, 0x7c, 0x07, 0x90, 0x1f, 0xe7,
+	0x42, 0xcf, 0xb1, 0x9e, 0x1f, 0xd9, 0x86, 0x67, 0x0d, 0x81, 0x71, 0x67, 0xf3, 0x31, 0x34, 0xef,
+	0xe9, 0x16, 0xc9, 0x1f, 0x02, 0xbc, 0x57, 0xa5, 0x8c, 0xc5, 0xc1, 0x52, 0xa4, 0x95, 0x0a, 0x2d,
+	0x36, 0xf3, 0x50, 0xc4, 0x50, 0x5d, 0x98, 0x20, 0x46, 0x0b, 0x52, 0x34, 0x49, 0xc0, 0x14, 0xdf,
+	0x32, 0x02, 0x5e, 0x10, 0x7e, 0xac, 0xc0, 0xe9, 0x24, 0xc0, 0x03, 0xd3, 0xdb, 0x21, 0xb7, 0x33,
+	0x6e, 0x16, 0x16, 0x28, 0xe0, 0x01, 0x58, 0xe0, 0xf0, 0x82, 0x05, 0x03, 0xea, 0x91, 0xf6, 0x35,
+	0x92, 0xbd, 0xa9, 0x92, 0x35, 0xd0, 0xdb, 0xf3, 0xe3, 0x01, 0x05, 0x95, 0x1d, 0xa8, 0xfb, 0xfa,
+	0xca, 0x84, 0x7b, 0x39, 0x8d, 0xd3, 0x00, 0x26, 0xc5, 0xdc, 0xe4, 0xa0, 0x61, 0x73, 0x4b, 0x76,
+	0x26, 0x51, 0xb6, 0x8e, 0xf6, 0x28, 0x73, 0x4b, 0x6f, 0x77, 0xaa, 0xc7, 0xd0, 0x3a, 0x94, 0x58,
+	0xa7, 0x00, 0xa9, 0x52, 0x66, 0xfd, 0x86, 0xd8, 0x28, 0x0f, 0xe8, 0xc3, 0x08, 0xa4, 0xbb, 0x34,
+	0x96, 0x87, 0x3a, 0x10, 0x28, 0x55, 0x12, 0x21, 0xa0, 0x94, 0x00, 0x9b, 0x02, 0x2b, 0x88, 0xdd,
+	0x87, 0x9a, 0x86, 0xc9, 0x07, 0xbe, 0x8f, 0xf3, 0xa9, 0xcd, 0x9f, 0x6c, 0x56, 0xac, 0x03, 0x4a,
+	0x36, 0x3b, 0xa4, 0xc7, 0x90, 0xda, 0x13, 0x19, 0x47, 0xe2, 0x23, 0xa8, 0x86, 0xba, 0x01, 0xe8,
+	0xa2, 0xac, 0x9a, 0x48, 0x54, 0xe3, 0xed, 0x4b, 0xe3, 0xc0, 0x84, 0x48, 0x1e, 0x40, 0x3d, 0x52,
+	0xcf, 0x4b, 0x2d, 0x43, 0x56, 0xf1, 0x8f, 0x63, 0x7c, 0x0b, 0xda, 0x4b, 0x8e, 0xad, 0x1b, 0x5d,
+	0xdd, 0xf5, 0x68, 0x41, 0x8f, 0x8d, 0x20, 0xfc, 0xcb, 0x73, 0x43, 0x69, 0xd9, 0x3f, 0x86, 0xce,
+	0xe2, 0x2f, 0x8a, 0x50, 0xf6, 0x9f, 0x4a, 0x3d, 0x83, 0x3a, 0xe1, 0x19, 0x24, 0xee, 0x1f, 0xc2,
+	0x74, 0xec, 0x8f, 0x19, 0x52, 0x71, 0xca, 0xff, 0xbc, 0x31, 0xee, 0xd8, 0x1e, 0xf0, 0xbf, 0x6b,
+	0x8f, 0xd4, 0x07, 0xd9, 0x7f, 0x31, 0xc6, 0x21, 0x7e, 0xe2, 0xc1, 0xfa, 0x3e, 0x40, 0x28, 0x98,
+	0x8e, 0xbe, 0x2b, 0x27, 0xf1, 0x61, 0x1c, 0xc3, 0x77, 0x84, 0xbb, 0x3b, 0x9b, 0xea, 0x26, 0x48,
+	0x3b, 0x7b, 0x0c, 0x9e, 0xa5, 0x9b, 0x1f, 0xdc, 0xd8, 0x36, 0xbd, 0x9d, 0xe1, 0x26, 0xf9, 0x72,
+	0x8d, 0x81, 0xbe, 0x6c, 0xda, 0xfc, 0xd7, 0x35, 0x5f, 0x33, 0xae, 0xd1, 0xd5, 0xd7, 0x08, 0xf2,
+	0xc1, 0xe6, 0x66, 0x89, 0x8e, 0x6e, 0xfe, 0x77, 0x00, 0x73, 0x22, 0x8f, 0xa2, 0x18, 0x40, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// DataCoordClient is the client API for DataCoord service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type DataCoordClient interface {
+	GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error)
+	GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
+	AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error)
+	GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error)
+	GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error)
+	GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error)
+	GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error)
+	GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error)
+	GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error)
+	SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error)
+	GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error)
+	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
+	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error)
+	GetCompactionState(ctx context.Context, in *milvuspb.GetCompactionStateRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionStateResponse, error)
+	GetCompactionStateWithPlans(ctx context.Context, in *milvuspb.GetCompactionPlansRequest, opts ...grpc.CallOption) (*milvuspb.GetCompactionPlansResponse, error)
+	WatchChannels(ctx context.Context, in *WatchChannelsRequest, opts ...grpc.CallOption) (*WatchChannelsResponse, error)
+	GetFlushState(ctx context.Context, in *milvuspb.GetFlushStateRequest, opts ...grpc.CallOption) (*milvuspb.GetFlushStateResponse, error)
+	DropVirtualChannel(ctx context.Context, in *DropVirtualChannelRequest, opts ...grpc.CallOption) (*DropVirtualChannelResponse, error)
+	Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error)
+	GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddFlushedSegments(ctx context.Context, in *AddFlushedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	PinSegments(ctx context.Context, in *PinSegmentsRequest, opts ...grpc.CallOption) (*PinSegmentsResponse, error)
+	UnpinSegments(ctx context.Context, in *UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+}
+
+type dataCoordClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewDataCoordClient(cc *grpc.ClientConn) DataCoordClient {
+	return &dataCoordClient{cc}
+}
+
+func (c *dataCoordClient) GetComponentStates(ctx context.Context, in *internalpb.GetComponentStatesRequest, opts ...grpc.CallOption) (*internalpb.ComponentStates, error) {
+	out := new(internalpb.ComponentStates)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetComponentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetTimeTickChannel(ctx context.Context, in *internalpb.GetTimeTickChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetTimeTickChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetStatisticsChannel(ctx context.Context, in *internalpb.GetStatisticsChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetStatisticsChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error) {
+	out := new(FlushResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Flush", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AssignSegmentID(ctx context.Context, in *AssignSegmentIDRequest, opts ...grpc.CallOption) (*AssignSegmentIDResponse, error) {
+	out := new(AssignSegmentIDResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AssignSegmentID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfo(ctx context.Context, in *GetSegmentInfoRequest, opts ...grpc.CallOption) (*GetSegmentInfoResponse, error) {
+	out := new(GetSegmentInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentStates(ctx context.Context, in *GetSegmentStatesRequest, opts ...grpc.CallOption) (*GetSegmentStatesResponse, error) {
+	out := new(GetSegmentStatesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetInsertBinlogPaths(ctx context.Context, in *GetInsertBinlogPathsRequest, opts ...grpc.CallOption) (*GetInsertBinlogPathsResponse, error) {
+	out := new(GetInsertBinlogPathsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetInsertBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetCollectionStatistics(ctx context.Context, in *GetCollectionStatisticsRequest, opts ...grpc.CallOption) (*GetCollectionStatisticsResponse, error) {
+	out := new(GetCollectionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetCollectionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetPartitionStatistics(ctx context.Context, in *GetPartitionStatisticsRequest, opts ...grpc.CallOption) (*GetPartitionStatisticsResponse, error) {
+	out := new(GetPartitionStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetPartitionStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetSegmentInfoChannel(ctx context.Context, in *GetSegmentInfoChannelRequest, opts ...grpc.CallOption) (*milvuspb.StringResponse, error) {
+	out := new(milvuspb.StringResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetSegmentInfoChannel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) SaveBinlogPaths(ctx context.Context, in *SaveBinlogPathsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/SaveBinlogPaths", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetRecoveryInfo(ctx context.Context, in *GetRecoveryInfoRequest, opts ...grpc.CallOption) (*GetRecoveryInfoResponse, error) {
+	out := new(GetRecoveryInfoResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetRecoveryInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetFlushedSegments(ctx context.Context, in *GetFlushedSegmentsRequest, opts ...grpc.CallOption) (*GetFlushedSegmentsResponse, error) {
+	out := new(GetFlushedSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetFlushedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error) {
+	out := new(milvuspb.GetMetricsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetMetrics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) CompleteCompaction(ctx context.Context, in *CompactionResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/CompleteCompaction", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
 func (c *dataCoordClient) ManualCompaction(ctx context.Context, in *milvuspb.ManualCompactionRequest, opts ...grpc.CallOption) (*milvuspb.ManualCompactionResponse, error) {
@@ -3564,6 +4492,69 @@ func (c *dataCoordClient) DropVirtualChannel(ctx context.Context, in *DropVirtua
 	return out, nil
 }
 
+func (c *dataCoordClient) Import(ctx context.Context, in *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	out := new(milvuspb.ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) GetImportState(ctx context.Context, in *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	out := new(milvuspb.GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) ReportImport(ctx context.Context, in *ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/ReportImport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) AddFlushedSegments(ctx context.Context, in *AddFlushedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/AddFlushedSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) PinSegments(ctx context.Context, in *PinSegmentsRequest, opts ...grpc.CallOption) (*PinSegmentsResponse, error) {
+	out := new(PinSegmentsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/PinSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) UnpinSegments(ctx context.Context, in *UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/UnpinSegments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataCoordClient) BroadcastAlteredCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataCoord/BroadcastAlteredCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataCoordServer is the server API for DataCoord service.
 type DataCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -3589,6 +4580,13 @@ type DataCoordServer interface {
 	WatchChannels(context.Context, *WatchChannelsRequest) (*WatchChannelsResponse, error)
 	GetFlushState(context.Context, *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
 	DropVirtualChannel(context.Context, *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error)
+	Import(context.Context, *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+	GetImportState(context.Context, *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	ReportImport(context.Context, *ImportResult) (*commonpb.Status, error)
+	AddFlushedSegments(context.Context, *AddFlushedSegmentsRequest) (*commonpb.Status, error)
+	PinSegments(context.Context, *PinSegmentsRequest) (*PinSegmentsResponse, error)
+	UnpinSegments(context.Context, *UnpinSegmentsRequest) (*commonpb.Status, error)
+	BroadcastAlteredCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedDataCoordServer can be embedded to have forward compatible implementations.
@@ -3661,6 +4659,27 @@ func (*UnimplementedDataCoordServer) GetFlushState(ctx context.Context, req *mil
 func (*UnimplementedDataCoordServer) DropVirtualChannel(ctx context.Context, req *DropVirtualChannelRequest) (*DropVirtualChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DropVirtualChannel not implemented")
 }
+func (*UnimplementedDataCoordServer) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedDataCoordServer) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
+func (*UnimplementedDataCoordServer) ReportImport(ctx context.Context, req *ImportResult) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportImport not implemented")
+}
+func (*UnimplementedDataCoordServer) AddFlushedSegments(ctx context.Context, req *AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddFlushedSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) PinSegments(ctx context.Context, req *PinSegmentsRequest) (*PinSegmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PinSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) UnpinSegments(ctx context.Context, req *UnpinSegmentsRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnpinSegments not implemented")
+}
+func (*UnimplementedDataCoordServer) BroadcastAlteredCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BroadcastAlteredCollection not implemented")
+}
 
 func RegisterDataCoordServer(s *grpc.Server, srv DataCoordServer) {
 	s.RegisterService(&_DataCoord_serviceDesc, srv)
@@ -4062,6 +5081,132 @@ func _DataCoord_DropVirtualChannel_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataCoord_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).Import(ctx, req.(*milvuspb.ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).GetImportState(ctx, req.(*milvuspb.GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_ReportImport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).ReportImport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/ReportImport",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).ReportImport(ctx, req.(*ImportResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_AddFlushedSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFlushedSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).AddFlushedSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/AddFlushedSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).AddFlushedSegments(ctx, req.(*AddFlushedSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_PinSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PinSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).PinSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/PinSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).PinSegments(ctx, req.(*PinSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_UnpinSegments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnpinSegmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).UnpinSegments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/UnpinSegments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).UnpinSegments(ctx, req.(*UnpinSegmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataCoord_BroadcastAlteredCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataCoord/BroadcastAlteredCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataCoordServer).BroadcastAlteredCollection(ctx, req.(*AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataCoord",
 	HandlerType: (*DataCoordServer)(nil),
@@ -4154,6 +5299,34 @@ var _DataCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DropVirtualChannel",
 			Handler:    _DataCoord_DropVirtualChannel_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _DataCoord_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _DataCoord_GetImportState_Handler,
+		},
+		{
+			MethodName: "ReportImport",
+			Handler:    _DataCoord_ReportImport_Handler,
+		},
+		{
+			MethodName: "AddFlushedSegments",
+			Handler:    _DataCoord_AddFlushedSegments_Handler,
+		},
+		{
+			MethodName: "PinSegments",
+			Handler:    _DataCoord_PinSegments_Handler,
+		},
+		{
+			MethodName: "UnpinSegments",
+			Handler:    _DataCoord_UnpinSegments_Handler,
+		},
+		{
+			MethodName: "BroadcastAlteredCollection",
+			Handler:    _DataCoord_BroadcastAlteredCollection_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
@@ -4170,6 +5343,7 @@ type DataNodeClient interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
 	Compaction(ctx context.Context, in *CompactionPlan, opts ...grpc.CallOption) (*commonpb.Status, error)
+	Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type dataNodeClient struct {
@@ -4234,6 +5408,15 @@ func (c *dataNodeClient) Compaction(ctx context.Context, in *CompactionPlan, opt
 	return out, nil
 }
 
+func (c *dataNodeClient) Import(ctx context.Context, in *ImportTask, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.data.DataNode/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DataNodeServer is the server API for DataNode service.
 type DataNodeServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -4243,6 +5426,7 @@ type DataNodeServer interface {
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	Compaction(context.Context, *CompactionPlan) (*commonpb.Status, error)
+	Import(context.Context, *ImportTask) (*commonpb.Status, error)
 }
 
 // UnimplementedDataNodeServer can be embedded to have forward compatible implementations.
@@ -4267,6 +5451,9 @@ func (*UnimplementedDataNodeServer) GetMetrics(ctx context.Context, req *milvusp
 func (*UnimplementedDataNodeServer) Compaction(ctx context.Context, req *CompactionPlan) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Compaction not implemented")
 }
+func (*UnimplementedDataNodeServer) Import(ctx context.Context, req *ImportTask) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
 
 func RegisterDataNodeServer(s *grpc.Server, srv DataNodeServer) {
 	s.RegisterService(&_DataNode_serviceDesc, srv)
@@ -4380,6 +5567,24 @@ func _DataNode_Compaction_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataNode_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataNodeServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.data.DataNode/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataNodeServer).Import(ctx, req.(*ImportTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _DataNode_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.data.DataNode",
 	HandlerType: (*DataNodeServer)(nil),
@@ -4408,6 +5613,10 @@ var _DataNode_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Compaction",
 			Handler:    _DataNode_Compaction_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _DataNode_Import_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "data_coord.proto",
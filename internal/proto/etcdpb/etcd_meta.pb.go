@@ -255,6 +255,8 @@ type CollectionInfo struct {
 	ShardsNum                  int32                      `protobuf:"varint,10,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
 	StartPositions             []*commonpb.KeyDataPair    `protobuf:"bytes,11,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
 	ConsistencyLevel           commonpb.ConsistencyLevel  `protobuf:"varint,12,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	DbId                       int64                      `protobuf:"varint,13,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	Properties                 []*commonpb.KeyValuePair   `protobuf:"bytes,14,rep,name=properties,proto3" json:"properties,omitempty"`
 	XXX_NoUnkeyedLiteral       struct{}                   `json:"-"`
 	XXX_unrecognized           []byte                     `json:"-"`
 	XXX_sizecache              int32                      `json:"-"`
@@ -369,6 +371,75 @@ func (m *CollectionInfo) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *CollectionInfo) GetDbId() int64 {
+	if m != nil {
+		return m.DbId
+	}
+	return 0
+}
+
+func (m *CollectionInfo) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
+type DatabaseInfo struct {
+	ID                   int64    `protobuf:"varint,1,opt,name=ID,proto3" json:"ID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreateTime           uint64   `protobuf:"varint,3,opt,name=create_time,json=createTime,proto3" json:"create_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DatabaseInfo) Reset()         { *m = DatabaseInfo{} }
+func (m *DatabaseInfo) String() string { return proto.CompactTextString(m) }
+func (*DatabaseInfo) ProtoMessage()    {}
+func (*DatabaseInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_975d306d62b73e88, []int{5}
+}
+
+func (m *DatabaseInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DatabaseInfo.Unmarshal(m, b)
+}
+func (m *DatabaseInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DatabaseInfo.Marshal(b, m, deterministic)
+}
+func (m *DatabaseInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DatabaseInfo.Merge(m, src)
+}
+func (m *DatabaseInfo) XXX_Size() int {
+	return xxx_messageInfo_DatabaseInfo.Size(m)
+}
+func (m *DatabaseInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_DatabaseInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DatabaseInfo proto.InternalMessageInfo
+
+func (m *DatabaseInfo) GetID() int64 {
+	if m != nil {
+		return m.ID
+	}
+	return 0
+}
+
+func (m *DatabaseInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DatabaseInfo) GetCreateTime() uint64 {
+	if m != nil {
+		return m.CreateTime
+	}
+	return 0
+}
+
 type SegmentIndexInfo struct {
 	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64    `protobuf:"varint,2,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
@@ -386,7 +457,7 @@ func (m *SegmentIndexInfo) Reset()         { *m = SegmentIndexInfo{} }
 func (m *SegmentIndexInfo) String() string { return proto.CompactTextString(m) }
 func (*SegmentIndexInfo) ProtoMessage()    {}
 func (*SegmentIndexInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{5}
+	return fileDescriptor_975d306d62b73e88, []int{6}
 }
 
 func (m *SegmentIndexInfo) XXX_Unmarshal(b []byte) error {
@@ -472,7 +543,7 @@ func (m *CollectionMeta) Reset()         { *m = CollectionMeta{} }
 func (m *CollectionMeta) String() string { return proto.CompactTextString(m) }
 func (*CollectionMeta) ProtoMessage()    {}
 func (*CollectionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_975d306d62b73e88, []int{6}
+	return fileDescriptor_975d306d62b73e88, []int{7}
 }
 
 func (m *CollectionMeta) XXX_Unmarshal(b []byte) error {
@@ -541,6 +612,7 @@ func init() {
 	proto.RegisterType((*IndexInfo)(nil), "milvus.proto.etcd.IndexInfo")
 	proto.RegisterType((*FieldIndexInfo)(nil), "milvus.proto.etcd.FieldIndexInfo")
 	proto.RegisterType((*CollectionInfo)(nil), "milvus.proto.etcd.CollectionInfo")
+	proto.RegisterType((*DatabaseInfo)(nil), "milvus.proto.etcd.DatabaseInfo")
 	proto.RegisterType((*SegmentIndexInfo)(nil), "milvus.proto.etcd.SegmentIndexInfo")
 	proto.RegisterType((*CollectionMeta)(nil), "milvus.proto.etcd.CollectionMeta")
 }
@@ -548,54 +620,57 @@ func init() {
 func init() { proto.RegisterFile("etcd_meta.proto", fileDescriptor_975d306d62b73e88) }
 
 var fileDescriptor_975d306d62b73e88 = []byte{
-	// 777 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x6f, 0xeb, 0x44,
-	0x14, 0x95, 0x9b, 0x34, 0x79, 0xbe, 0x71, 0xd3, 0x76, 0xf8, 0xd0, 0xa8, 0x2a, 0xe0, 0x67, 0xe9,
-	0x3d, 0x2c, 0x21, 0x5a, 0xd1, 0x87, 0xd8, 0x21, 0x01, 0xb5, 0x9e, 0x14, 0x01, 0x55, 0x99, 0x57,
-	0xb1, 0x60, 0x63, 0x4d, 0xec, 0xdb, 0x66, 0x24, 0xcf, 0x38, 0x78, 0xc6, 0xd5, 0xcb, 0x8e, 0x35,
-	0x3f, 0x81, 0x3f, 0xc8, 0x82, 0xff, 0x80, 0x90, 0x67, 0x6c, 0xe7, 0xa3, 0xe9, 0x92, 0x5d, 0xee,
-	0xb9, 0x1f, 0xbe, 0xf7, 0xcc, 0x39, 0x81, 0x63, 0x34, 0x59, 0x9e, 0x4a, 0x34, 0xfc, 0x62, 0x59,
-	0x95, 0xa6, 0x24, 0xa7, 0x52, 0x14, 0x8f, 0xb5, 0x76, 0xd1, 0x45, 0x93, 0x3d, 0x0b, 0xb2, 0x52,
-	0xca, 0x52, 0x39, 0xe8, 0x2c, 0xd0, 0xd9, 0x02, 0x65, 0x5b, 0x1e, 0xfd, 0xe5, 0x01, 0xdc, 0xa1,
-	0xe2, 0xca, 0xfc, 0x8c, 0x86, 0x93, 0x29, 0x1c, 0xcc, 0x12, 0xea, 0x85, 0x5e, 0x3c, 0x60, 0x07,
-	0xb3, 0x84, 0xbc, 0x86, 0x63, 0x55, 0xcb, 0xf4, 0xf7, 0x1a, 0xab, 0x55, 0xaa, 0xca, 0x1c, 0x35,
-	0x3d, 0xb0, 0xc9, 0x23, 0x55, 0xcb, 0x5f, 0x1a, 0xf4, 0xa6, 0x01, 0xc9, 0x17, 0x70, 0x2a, 0x94,
-	0xc6, 0xca, 0xa4, 0xd9, 0x82, 0x2b, 0x85, 0xc5, 0x2c, 0xd1, 0x74, 0x10, 0x0e, 0x62, 0x9f, 0x9d,
-	0xb8, 0xc4, 0x75, 0x8f, 0x93, 0xcf, 0xe1, 0xd8, 0x0d, 0xec, 0x6b, 0xe9, 0x30, 0xf4, 0x62, 0x9f,
-	0x4d, 0x2d, 0xdc, 0x57, 0x46, 0x7f, 0x78, 0xe0, 0xdf, 0x56, 0xe5, 0xfb, 0xd5, 0xde, 0xdd, 0xbe,
-	0x81, 0x31, 0xcf, 0xf3, 0x0a, 0xb5, 0xdb, 0x69, 0x72, 0x75, 0x7e, 0xb1, 0x75, 0x7b, 0x7b, 0xf5,
-	0xf7, 0xae, 0x86, 0x75, 0xc5, 0xcd, 0xae, 0x15, 0xea, 0xba, 0xd8, 0xb7, 0xab, 0x4b, 0xac, 0x77,
-	0x8d, 0xfe, 0xf4, 0xc0, 0x9f, 0xa9, 0x1c, 0xdf, 0xcf, 0xd4, 0x7d, 0x49, 0x3e, 0x01, 0x10, 0x4d,
-	0x90, 0x2a, 0x2e, 0xd1, 0xae, 0xe2, 0x33, 0xdf, 0x22, 0x37, 0x5c, 0x22, 0xa1, 0x30, 0xb6, 0xc1,
-	0x2c, 0x69, 0x59, 0xea, 0x42, 0x92, 0x40, 0xe0, 0x1a, 0x97, 0xbc, 0xe2, 0xd2, 0x7d, 0x6e, 0x72,
-	0xf5, 0x72, 0xef, 0xc2, 0x3f, 0xe2, 0xea, 0x57, 0x5e, 0xd4, 0x78, 0xcb, 0x45, 0xc5, 0x26, 0xb6,
-	0xed, 0xd6, 0x76, 0x45, 0x09, 0x4c, 0xdf, 0x0a, 0x2c, 0xf2, 0xf5, 0x42, 0x14, 0xc6, 0xf7, 0xa2,
-	0xc0, 0xbc, 0x27, 0xa6, 0x0b, 0x9f, 0xdf, 0x25, 0xfa, 0x77, 0x08, 0xd3, 0xeb, 0xb2, 0x28, 0x30,
-	0x33, 0xa2, 0x54, 0x76, 0xcc, 0x2e, 0xb5, 0xdf, 0xc2, 0xc8, 0xa9, 0xa4, 0x65, 0xf6, 0xd5, 0xf6,
-	0xa2, 0xad, 0x82, 0xd6, 0x43, 0xde, 0x59, 0x80, 0xb5, 0x4d, 0xe4, 0x33, 0x98, 0x64, 0x15, 0x72,
-	0x83, 0xa9, 0x11, 0x12, 0xe9, 0x20, 0xf4, 0xe2, 0x21, 0x03, 0x07, 0xdd, 0x09, 0x89, 0x24, 0x82,
-	0x60, 0xc9, 0x2b, 0x23, 0xec, 0x02, 0x89, 0xa6, 0xc3, 0x70, 0x10, 0x0f, 0xd8, 0x16, 0x46, 0x5e,
-	0xc3, 0xb4, 0x8f, 0x1b, 0x76, 0x35, 0x3d, 0xb4, 0x6f, 0xb4, 0x83, 0x92, 0xb7, 0x70, 0x74, 0xdf,
-	0x90, 0x92, 0xda, 0xfb, 0x50, 0xd3, 0xd1, 0x3e, 0x6e, 0x1b, 0x23, 0x5c, 0x6c, 0x93, 0xc7, 0x82,
-	0xfb, 0x3e, 0x46, 0x4d, 0xae, 0xe0, 0xa3, 0x47, 0x51, 0x99, 0x9a, 0x17, 0x9d, 0x2e, 0xec, 0x2b,
-	0x6b, 0x3a, 0xb6, 0x9f, 0xfd, 0xa0, 0x4d, 0xb6, 0xda, 0x70, 0xdf, 0xfe, 0x1a, 0x3e, 0x5e, 0x2e,
-	0x56, 0x5a, 0x64, 0x4f, 0x9a, 0x5e, 0xd8, 0xa6, 0x0f, 0xbb, 0xec, 0x56, 0xd7, 0x77, 0x70, 0xde,
-	0xdf, 0x90, 0x3a, 0x56, 0x72, 0xcb, 0x94, 0x36, 0x5c, 0x2e, 0x35, 0xf5, 0xc3, 0x41, 0x3c, 0x64,
-	0x67, 0x7d, 0xcd, 0xb5, 0x2b, 0xb9, 0xeb, 0x2b, 0x1a, 0x1d, 0xea, 0x05, 0xaf, 0x72, 0x9d, 0xaa,
-	0x5a, 0x52, 0x08, 0xbd, 0xf8, 0x90, 0xf9, 0x0e, 0xb9, 0xa9, 0x25, 0x99, 0xc1, 0xb1, 0x36, 0xbc,
-	0x32, 0xe9, 0xb2, 0xd4, 0x76, 0x82, 0xa6, 0x13, 0x4b, 0x4a, 0xf8, 0x9c, 0xe0, 0x12, 0x6e, 0xb8,
-	0xd5, 0xdb, 0xd4, 0x36, 0xde, 0x76, 0x7d, 0x84, 0xc1, 0x69, 0x56, 0x2a, 0x2d, 0xb4, 0x41, 0x95,
-	0xad, 0xd2, 0x02, 0x1f, 0xb1, 0xa0, 0x41, 0xe8, 0xc5, 0xd3, 0x5d, 0x51, 0xb4, 0xc3, 0xae, 0xd7,
-	0xd5, 0x3f, 0x35, 0xc5, 0xec, 0x24, 0xdb, 0x41, 0xa2, 0xbf, 0x3d, 0x38, 0x79, 0x87, 0x0f, 0x12,
-	0x95, 0x59, 0x2b, 0x39, 0x82, 0x20, 0x5b, 0x8b, 0xb2, 0x13, 0xe3, 0x16, 0x46, 0x42, 0x98, 0x6c,
-	0x48, 0xa4, 0xd5, 0xf5, 0x26, 0x44, 0xce, 0xc1, 0xd7, 0xed, 0xe4, 0xc4, 0xea, 0x6e, 0xc0, 0xd6,
-	0x80, 0x73, 0x4b, 0xf3, 0xe4, 0xee, 0x0f, 0xc7, 0xba, 0xc5, 0x86, 0x9b, 0x6e, 0x39, 0xdc, 0x76,
-	0x2e, 0x85, 0xf1, 0xbc, 0x16, 0xb6, 0x67, 0xe4, 0x32, 0x6d, 0x48, 0x5e, 0x42, 0x80, 0x8a, 0xcf,
-	0x0b, 0x74, 0xca, 0xa3, 0xe3, 0xd0, 0x8b, 0x5f, 0xb0, 0x89, 0xc3, 0xec, 0x61, 0xd1, 0x3f, 0xde,
-	0xa6, 0xd5, 0xf6, 0xfe, 0x8b, 0xfd, 0xdf, 0x56, 0xfb, 0x14, 0xa0, 0x27, 0xa0, 0x33, 0xda, 0x06,
-	0x42, 0x5e, 0x6d, 0xd8, 0x2c, 0x35, 0xfc, 0xa1, 0xb3, 0xd9, 0x51, 0x8f, 0xde, 0xf1, 0x07, 0xfd,
-	0xc4, 0xb1, 0xa3, 0xa7, 0x8e, 0xfd, 0xe1, 0xcd, 0x6f, 0x5f, 0x3d, 0x08, 0xb3, 0xa8, 0xe7, 0x8d,
-	0x16, 0x2e, 0xdd, 0x19, 0x5f, 0x8a, 0xb2, 0xfd, 0x75, 0x29, 0x94, 0xc1, 0x4a, 0xf1, 0xe2, 0xd2,
-	0x5e, 0x76, 0xd9, 0x38, 0x72, 0x39, 0x9f, 0x8f, 0x6c, 0xf4, 0xe6, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x9f, 0xd3, 0xc4, 0x4c, 0xc9, 0x06, 0x00, 0x00,
+	// 829 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xcd, 0x6e, 0xe4, 0x44,
+	0x10, 0x96, 0x33, 0x7f, 0xeb, 0x1a, 0x67, 0x92, 0xf4, 0x02, 0x6a, 0x45, 0x01, 0xbc, 0x96, 0xb2,
+	0x58, 0x42, 0x24, 0x22, 0x8b, 0xb8, 0x21, 0xb1, 0xc4, 0x5a, 0x69, 0x04, 0x44, 0xa1, 0x13, 0x71,
+	0xe0, 0x62, 0xf5, 0xd8, 0x95, 0x4c, 0x4b, 0x76, 0xdb, 0xb8, 0xdb, 0xd1, 0xce, 0x8d, 0x33, 0x8f,
+	0xc0, 0x83, 0xf1, 0x0a, 0x1c, 0x78, 0x09, 0xe4, 0x6e, 0xdb, 0x33, 0x93, 0x4c, 0x04, 0x17, 0x6e,
+	0xae, 0xaf, 0x7e, 0xba, 0xea, 0xf3, 0x57, 0x05, 0x07, 0xa8, 0x93, 0x34, 0xce, 0x51, 0xf3, 0xb3,
+	0xb2, 0x2a, 0x74, 0x41, 0x8e, 0x72, 0x91, 0x3d, 0xd4, 0xca, 0x5a, 0x67, 0x8d, 0xf7, 0xd8, 0x4b,
+	0x8a, 0x3c, 0x2f, 0xa4, 0x85, 0x8e, 0x3d, 0x95, 0x2c, 0x31, 0x6f, 0xc3, 0x83, 0x3f, 0x1c, 0x80,
+	0x5b, 0x94, 0x5c, 0xea, 0x1f, 0x51, 0x73, 0x32, 0x83, 0xbd, 0x79, 0x44, 0x1d, 0xdf, 0x09, 0x07,
+	0x6c, 0x6f, 0x1e, 0x91, 0xd7, 0x70, 0x20, 0xeb, 0x3c, 0xfe, 0xb5, 0xc6, 0x6a, 0x15, 0xcb, 0x22,
+	0x45, 0x45, 0xf7, 0x8c, 0x73, 0x5f, 0xd6, 0xf9, 0x4f, 0x0d, 0x7a, 0xd5, 0x80, 0xe4, 0x73, 0x38,
+	0x12, 0x52, 0x61, 0xa5, 0xe3, 0x64, 0xc9, 0xa5, 0xc4, 0x6c, 0x1e, 0x29, 0x3a, 0xf0, 0x07, 0xa1,
+	0xcb, 0x0e, 0xad, 0xe3, 0xb2, 0xc7, 0xc9, 0x67, 0x70, 0x60, 0x0b, 0xf6, 0xb1, 0x74, 0xe8, 0x3b,
+	0xa1, 0xcb, 0x66, 0x06, 0xee, 0x23, 0x83, 0xdf, 0x1c, 0x70, 0xaf, 0xab, 0xe2, 0xfd, 0x6a, 0x67,
+	0x6f, 0x5f, 0xc3, 0x84, 0xa7, 0x69, 0x85, 0xca, 0xf6, 0x34, 0xbd, 0x38, 0x39, 0xdb, 0x9a, 0xbd,
+	0x9d, 0xfa, 0xad, 0x8d, 0x61, 0x5d, 0x70, 0xd3, 0x6b, 0x85, 0xaa, 0xce, 0x76, 0xf5, 0x6a, 0x1d,
+	0xeb, 0x5e, 0x83, 0xdf, 0x1d, 0x70, 0xe7, 0x32, 0xc5, 0xf7, 0x73, 0x79, 0x57, 0x90, 0x8f, 0x01,
+	0x44, 0x63, 0xc4, 0x92, 0xe7, 0x68, 0x5a, 0x71, 0x99, 0x6b, 0x90, 0x2b, 0x9e, 0x23, 0xa1, 0x30,
+	0x31, 0xc6, 0x3c, 0x6a, 0x59, 0xea, 0x4c, 0x12, 0x81, 0x67, 0x13, 0x4b, 0x5e, 0xf1, 0xdc, 0x3e,
+	0x37, 0xbd, 0x78, 0xb5, 0xb3, 0xe1, 0xef, 0x71, 0xf5, 0x33, 0xcf, 0x6a, 0xbc, 0xe6, 0xa2, 0x62,
+	0x53, 0x93, 0x76, 0x6d, 0xb2, 0x82, 0x08, 0x66, 0xef, 0x04, 0x66, 0xe9, 0xba, 0x21, 0x0a, 0x93,
+	0x3b, 0x91, 0x61, 0xda, 0x13, 0xd3, 0x99, 0xcf, 0xf7, 0x12, 0xfc, 0x39, 0x82, 0xd9, 0x65, 0x91,
+	0x65, 0x98, 0x68, 0x51, 0x48, 0x53, 0xe6, 0x31, 0xb5, 0xdf, 0xc0, 0xd8, 0xaa, 0xa4, 0x65, 0xf6,
+	0x74, 0xbb, 0xd1, 0x56, 0x41, 0xeb, 0x22, 0x37, 0x06, 0x60, 0x6d, 0x12, 0xf9, 0x14, 0xa6, 0x49,
+	0x85, 0x5c, 0x63, 0xac, 0x45, 0x8e, 0x74, 0xe0, 0x3b, 0xe1, 0x90, 0x81, 0x85, 0x6e, 0x45, 0x8e,
+	0x24, 0x00, 0xaf, 0xe4, 0x95, 0x16, 0xa6, 0x81, 0x48, 0xd1, 0xa1, 0x3f, 0x08, 0x07, 0x6c, 0x0b,
+	0x23, 0xaf, 0x61, 0xd6, 0xdb, 0x0d, 0xbb, 0x8a, 0x8e, 0xcc, 0x3f, 0x7a, 0x84, 0x92, 0x77, 0xb0,
+	0x7f, 0xd7, 0x90, 0x12, 0x9b, 0xf9, 0x50, 0xd1, 0xf1, 0x2e, 0x6e, 0x9b, 0x45, 0x38, 0xdb, 0x26,
+	0x8f, 0x79, 0x77, 0xbd, 0x8d, 0x8a, 0x5c, 0xc0, 0x87, 0x0f, 0xa2, 0xd2, 0x35, 0xcf, 0x3a, 0x5d,
+	0x98, 0xbf, 0xac, 0xe8, 0xc4, 0x3c, 0xfb, 0xb2, 0x75, 0xb6, 0xda, 0xb0, 0x6f, 0x7f, 0x05, 0x1f,
+	0x95, 0xcb, 0x95, 0x12, 0xc9, 0x93, 0xa4, 0x17, 0x26, 0xe9, 0x83, 0xce, 0xbb, 0x95, 0xf5, 0x2d,
+	0x9c, 0xf4, 0x33, 0xc4, 0x96, 0x95, 0xd4, 0x30, 0xa5, 0x34, 0xcf, 0x4b, 0x45, 0x5d, 0x7f, 0x10,
+	0x0e, 0xd9, 0x71, 0x1f, 0x73, 0x69, 0x43, 0x6e, 0xfb, 0x88, 0x46, 0x87, 0x6a, 0xc9, 0xab, 0x54,
+	0xc5, 0xb2, 0xce, 0x29, 0xf8, 0x4e, 0x38, 0x62, 0xae, 0x45, 0xae, 0xea, 0x9c, 0xcc, 0xe1, 0x40,
+	0x69, 0x5e, 0xe9, 0xb8, 0x2c, 0x94, 0xa9, 0xa0, 0xe8, 0xd4, 0x90, 0xe2, 0x3f, 0x27, 0xb8, 0x88,
+	0x6b, 0x6e, 0xf4, 0x36, 0x33, 0x89, 0xd7, 0x5d, 0x1e, 0x61, 0x70, 0x94, 0x14, 0x52, 0x09, 0xa5,
+	0x51, 0x26, 0xab, 0x38, 0xc3, 0x07, 0xcc, 0xa8, 0xe7, 0x3b, 0xe1, 0xec, 0xe2, 0x74, 0x67, 0xb1,
+	0xcb, 0x75, 0xf4, 0x0f, 0x4d, 0x30, 0x3b, 0x4c, 0x1e, 0x21, 0xe4, 0x25, 0x8c, 0xd2, 0x45, 0x2c,
+	0x52, 0xba, 0x6f, 0x04, 0x37, 0x4c, 0x17, 0xf3, 0x94, 0xbc, 0x05, 0x28, 0xab, 0xa2, 0xc4, 0x4a,
+	0x0b, 0x54, 0x74, 0xf6, 0x5f, 0xf7, 0x63, 0x23, 0x29, 0xb8, 0x01, 0xaf, 0x99, 0x63, 0xc1, 0x15,
+	0xee, 0x54, 0x35, 0x81, 0xa1, 0xd9, 0xdb, 0x3d, 0xb3, 0xb7, 0xe6, 0xfb, 0x5f, 0xa5, 0x1a, 0xfc,
+	0xe5, 0xc0, 0xe1, 0x0d, 0xde, 0xe7, 0x28, 0xf5, 0x7a, 0xed, 0x02, 0xf0, 0x92, 0xf5, 0x06, 0x75,
+	0x6f, 0x6c, 0x61, 0xc4, 0x87, 0xe9, 0x86, 0x9e, 0xdb, 0x25, 0xdc, 0x84, 0xc8, 0x09, 0xb8, 0xaa,
+	0xad, 0x1c, 0x99, 0x97, 0x07, 0x6c, 0x0d, 0xd8, 0xd5, 0x6e, 0xf4, 0x69, 0xaf, 0xe3, 0x80, 0x75,
+	0xe6, 0xe6, 0x6a, 0x8f, 0xb6, 0xcf, 0x0c, 0x85, 0xc9, 0xa2, 0x16, 0x26, 0x67, 0x6c, 0x3d, 0xad,
+	0x49, 0x5e, 0x81, 0x87, 0x92, 0x2f, 0x32, 0xb4, 0x6b, 0x42, 0x27, 0xbe, 0x13, 0xbe, 0x60, 0x53,
+	0x8b, 0x99, 0xc1, 0x82, 0xbf, 0x9d, 0xcd, 0xbb, 0xb0, 0xf3, 0xe4, 0xfe, 0xdf, 0x77, 0xe1, 0x13,
+	0x80, 0x9e, 0x80, 0xee, 0x2a, 0x6c, 0x20, 0xe4, 0x74, 0xe3, 0x26, 0xc4, 0x9a, 0xdf, 0x77, 0x37,
+	0x61, 0xbf, 0x47, 0x6f, 0xf9, 0xbd, 0x7a, 0x72, 0x5e, 0xc6, 0x4f, 0xcf, 0xcb, 0x77, 0x6f, 0x7e,
+	0xf9, 0xf2, 0x5e, 0xe8, 0x65, 0xbd, 0x68, 0x64, 0x75, 0x6e, 0xc7, 0xf8, 0x42, 0x14, 0xed, 0xd7,
+	0xb9, 0x90, 0x1a, 0x2b, 0xc9, 0xb3, 0x73, 0x33, 0xd9, 0x79, 0x73, 0x3e, 0xca, 0xc5, 0x62, 0x6c,
+	0xac, 0x37, 0xff, 0x0c, 0x00, 0x9b, 0x85, 0x34, 0xb2, 0x76, 0x07, 0x00, 0x00,
 }
@@ -1118,6 +1118,9 @@ type InsertRequest struct {
 	Timestamps           []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
 	RowIDs               []int64           `protobuf:"varint,11,rep,packed,name=rowIDs,proto3" json:"rowIDs,omitempty"`
 	RowData              []*commonpb.Blob  `protobuf:"bytes,12,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	SchemaVersion        int32             `protobuf:"varint,13,opt,name=schema_version,json=schemaVersion,proto3" json:"schema_version,omitempty"`
+	ValidData            []*FieldValidData `protobuf:"bytes,14,rep,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+	FieldIDs             []int64           `protobuf:"varint,15,rep,packed,name=fieldIDs,proto3" json:"fieldIDs,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1232,6 +1235,74 @@ func (m *InsertRequest) GetRowData() []*commonpb.Blob {
 	return nil
 }
 
+func (m *InsertRequest) GetSchemaVersion() int32 {
+	if m != nil {
+		return m.SchemaVersion
+	}
+	return 0
+}
+
+func (m *InsertRequest) GetValidData() []*FieldValidData {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
+func (m *InsertRequest) GetFieldIDs() []int64 {
+	if m != nil {
+		return m.FieldIDs
+	}
+	return nil
+}
+
+type FieldValidData struct {
+	FieldID              int64    `protobuf:"varint,1,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	ValidData            []bool   `protobuf:"varint,2,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FieldValidData) Reset()         { *m = FieldValidData{} }
+func (m *FieldValidData) String() string { return proto.CompactTextString(m) }
+func (*FieldValidData) ProtoMessage()    {}
+func (*FieldValidData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{19}
+}
+
+func (m *FieldValidData) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FieldValidData.Unmarshal(m, b)
+}
+func (m *FieldValidData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FieldValidData.Marshal(b, m, deterministic)
+}
+func (m *FieldValidData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FieldValidData.Merge(m, src)
+}
+func (m *FieldValidData) XXX_Size() int {
+	return xxx_messageInfo_FieldValidData.Size(m)
+}
+func (m *FieldValidData) XXX_DiscardUnknown() {
+	xxx_messageInfo_FieldValidData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FieldValidData proto.InternalMessageInfo
+
+func (m *FieldValidData) GetFieldID() int64 {
+	if m != nil {
+		return m.FieldID
+	}
+	return 0
+}
+
+func (m *FieldValidData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 type SearchRequest struct {
 	Base            *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ResultChannelID string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
@@ -1240,23 +1311,25 @@ type SearchRequest struct {
 	PartitionIDs    []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
 	Dsl             string            `protobuf:"bytes,6,opt,name=dsl,proto3" json:"dsl,omitempty"`
 	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	SerializedExprPlan   []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	PlaceholderGroup   []byte           `protobuf:"bytes,7,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType            commonpb.DslType `protobuf:"varint,8,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	SerializedExprPlan []byte           `protobuf:"bytes,9,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64          `protobuf:"varint,10,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64           `protobuf:"varint,11,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64           `protobuf:"varint,12,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64           `protobuf:"varint,13,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// the entities inserted before expire_timestamp are expired by the collection ttl
+	ExpireTimestamp      uint64   `protobuf:"varint,14,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
 func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
 func (*SearchRequest) ProtoMessage()    {}
 func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{19}
+	return fileDescriptor_41f4a519b878ee3b, []int{20}
 }
 
 func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
@@ -1368,6 +1441,13 @@ func (m *SearchRequest) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+func (m *SearchRequest) GetExpireTimestamp() uint64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
 type SearchResults struct {
 	Base                     *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                   *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1391,7 +1471,7 @@ func (m *SearchResults) Reset()         { *m = SearchResults{} }
 func (m *SearchResults) String() string { return proto.CompactTextString(m) }
 func (*SearchResults) ProtoMessage()    {}
 func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{20}
+	return fileDescriptor_41f4a519b878ee3b, []int{21}
 }
 
 func (m *SearchResults) XXX_Unmarshal(b []byte) error {
@@ -1497,26 +1577,30 @@ func (m *SearchResults) GetSlicedOffset() int64 {
 }
 
 type RetrieveRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	ResultChannelID      string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
-	DbID                 int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
-	CollectionID         int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionIDs         []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	SerializedExprPlan   []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
-	OutputFieldsId       []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	TimeoutTimestamp     uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Base               *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	ResultChannelID    string            `protobuf:"bytes,2,opt,name=result_channelID,json=resultChannelID,proto3" json:"result_channelID,omitempty"`
+	DbID               int64             `protobuf:"varint,3,opt,name=dbID,proto3" json:"dbID,omitempty"`
+	CollectionID       int64             `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionIDs       []int64           `protobuf:"varint,5,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	SerializedExprPlan []byte            `protobuf:"bytes,6,opt,name=serialized_expr_plan,json=serializedExprPlan,proto3" json:"serialized_expr_plan,omitempty"`
+	OutputFieldsId     []int64           `protobuf:"varint,7,rep,packed,name=output_fields_id,json=outputFieldsId,proto3" json:"output_fields_id,omitempty"`
+	TravelTimestamp    uint64            `protobuf:"varint,8,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp uint64            `protobuf:"varint,9,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	TimeoutTimestamp   uint64            `protobuf:"varint,10,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
+	// only the entities with the smallest primary keys are returned if limit is set
+	Limit int64 `protobuf:"varint,11,opt,name=limit,proto3" json:"limit,omitempty"`
+	// the entities inserted before expire_timestamp are expired by the collection ttl
+	ExpireTimestamp      uint64   `protobuf:"varint,12,opt,name=expire_timestamp,json=expireTimestamp,proto3" json:"expire_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *RetrieveRequest) Reset()         { *m = RetrieveRequest{} }
 func (m *RetrieveRequest) String() string { return proto.CompactTextString(m) }
 func (*RetrieveRequest) ProtoMessage()    {}
 func (*RetrieveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{21}
+	return fileDescriptor_41f4a519b878ee3b, []int{22}
 }
 
 func (m *RetrieveRequest) XXX_Unmarshal(b []byte) error {
@@ -1607,6 +1691,20 @@ func (m *RetrieveRequest) GetTimeoutTimestamp() uint64 {
 	return 0
 }
 
+func (m *RetrieveRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+func (m *RetrieveRequest) GetExpireTimestamp() uint64 {
+	if m != nil {
+		return m.ExpireTimestamp
+	}
+	return 0
+}
+
 type RetrieveResults struct {
 	Base                      *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	Status                    *commonpb.Status      `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
@@ -1625,7 +1723,7 @@ func (m *RetrieveResults) Reset()         { *m = RetrieveResults{} }
 func (m *RetrieveResults) String() string { return proto.CompactTextString(m) }
 func (*RetrieveResults) ProtoMessage()    {}
 func (*RetrieveResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{22}
+	return fileDescriptor_41f4a519b878ee3b, []int{23}
 }
 
 func (m *RetrieveResults) XXX_Unmarshal(b []byte) error {
@@ -1702,6 +1800,121 @@ func (m *RetrieveResults) GetGlobalSealedSegmentIDs() []int64 {
 	return nil
 }
 
+// IteratorCursor is the state of query and search iterators, it's encoded to an opaque token for users
+type IteratorCursor struct {
+	TravelTimestamp uint64 `protobuf:"varint,1,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	// query iterator continues after the last primary key
+	//
+	// Types that are valid to be assigned to LastPk:
+	//	*IteratorCursor_LastIntPk
+	//	*IteratorCursor_LastStrPk
+	LastPk               isIteratorCursor_LastPk `protobuf_oneof:"last_pk"`
+	LastDistance         float32                 `protobuf:"fixed32,3,opt,name=last_distance,json=lastDistance,proto3" json:"last_distance,omitempty"`
+	ReturnedCount        int64                   `protobuf:"varint,4,opt,name=returned_count,json=returnedCount,proto3" json:"returned_count,omitempty"`
+	TieCount             int64                   `protobuf:"varint,5,opt,name=tie_count,json=tieCount,proto3" json:"tie_count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *IteratorCursor) Reset()         { *m = IteratorCursor{} }
+func (m *IteratorCursor) String() string { return proto.CompactTextString(m) }
+func (*IteratorCursor) ProtoMessage()    {}
+func (*IteratorCursor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{24}
+}
+
+func (m *IteratorCursor) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IteratorCursor.Unmarshal(m, b)
+}
+func (m *IteratorCursor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IteratorCursor.Marshal(b, m, deterministic)
+}
+func (m *IteratorCursor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IteratorCursor.Merge(m, src)
+}
+func (m *IteratorCursor) XXX_Size() int {
+	return xxx_messageInfo_IteratorCursor.Size(m)
+}
+func (m *IteratorCursor) XXX_DiscardUnknown() {
+	xxx_messageInfo_IteratorCursor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IteratorCursor proto.InternalMessageInfo
+
+func (m *IteratorCursor) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+type isIteratorCursor_LastPk interface {
+	isIteratorCursor_LastPk()
+}
+
+type IteratorCursor_LastIntPk struct {
+	LastIntPk int64 `protobuf:"varint,2,opt,name=last_int_pk,json=lastIntPk,proto3,oneof"`
+}
+
+type IteratorCursor_LastStrPk struct {
+	LastStrPk string `protobuf:"bytes,6,opt,name=last_str_pk,json=lastStrPk,proto3,oneof"`
+}
+
+func (*IteratorCursor_LastIntPk) isIteratorCursor_LastPk() {}
+
+func (*IteratorCursor_LastStrPk) isIteratorCursor_LastPk() {}
+
+func (m *IteratorCursor) GetLastPk() isIteratorCursor_LastPk {
+	if m != nil {
+		return m.LastPk
+	}
+	return nil
+}
+
+func (m *IteratorCursor) GetLastIntPk() int64 {
+	if x, ok := m.GetLastPk().(*IteratorCursor_LastIntPk); ok {
+		return x.LastIntPk
+	}
+	return 0
+}
+
+func (m *IteratorCursor) GetLastStrPk() string {
+	if x, ok := m.GetLastPk().(*IteratorCursor_LastStrPk); ok {
+		return x.LastStrPk
+	}
+	return ""
+}
+
+func (m *IteratorCursor) GetLastDistance() float32 {
+	if m != nil {
+		return m.LastDistance
+	}
+	return 0
+}
+
+func (m *IteratorCursor) GetReturnedCount() int64 {
+	if m != nil {
+		return m.ReturnedCount
+	}
+	return 0
+}
+
+func (m *IteratorCursor) GetTieCount() int64 {
+	if m != nil {
+		return m.TieCount
+	}
+	return 0
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IteratorCursor) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IteratorCursor_LastIntPk)(nil),
+		(*IteratorCursor_LastStrPk)(nil),
+	}
+}
+
 type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	ShardName            string            `protobuf:"bytes,2,opt,name=shardName,proto3" json:"shardName,omitempty"`
@@ -1711,8 +1924,8 @@ type DeleteRequest struct {
 	DbID                 int64             `protobuf:"varint,6,opt,name=dbID,proto3" json:"dbID,omitempty"`
 	CollectionID         int64             `protobuf:"varint,7,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
 	PartitionID          int64             `protobuf:"varint,8,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	PrimaryKeys          []int64           `protobuf:"varint,9,rep,packed,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
 	Timestamps           []uint64          `protobuf:"varint,10,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	PrimaryKeys          *schemapb.IDs     `protobuf:"bytes,11,opt,name=primary_keys,json=primaryKeys,proto3" json:"primary_keys,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -1722,7 +1935,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{23}
+	return fileDescriptor_41f4a519b878ee3b, []int{25}
 }
 
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
@@ -1799,16 +2012,16 @@ func (m *DeleteRequest) GetPartitionID() int64 {
 	return 0
 }
 
-func (m *DeleteRequest) GetPrimaryKeys() []int64 {
+func (m *DeleteRequest) GetTimestamps() []uint64 {
 	if m != nil {
-		return m.PrimaryKeys
+		return m.Timestamps
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetTimestamps() []uint64 {
+func (m *DeleteRequest) GetPrimaryKeys() *schemapb.IDs {
 	if m != nil {
-		return m.Timestamps
+		return m.PrimaryKeys
 	}
 	return nil
 }
@@ -1829,7 +2042,7 @@ func (m *LoadIndex) Reset()         { *m = LoadIndex{} }
 func (m *LoadIndex) String() string { return proto.CompactTextString(m) }
 func (*LoadIndex) ProtoMessage()    {}
 func (*LoadIndex) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{24}
+	return fileDescriptor_41f4a519b878ee3b, []int{26}
 }
 
 func (m *LoadIndex) XXX_Unmarshal(b []byte) error {
@@ -1904,7 +2117,7 @@ func (m *IndexStats) Reset()         { *m = IndexStats{} }
 func (m *IndexStats) String() string { return proto.CompactTextString(m) }
 func (*IndexStats) ProtoMessage()    {}
 func (*IndexStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{25}
+	return fileDescriptor_41f4a519b878ee3b, []int{27}
 }
 
 func (m *IndexStats) XXX_Unmarshal(b []byte) error {
@@ -1952,7 +2165,7 @@ func (m *FieldStats) Reset()         { *m = FieldStats{} }
 func (m *FieldStats) String() string { return proto.CompactTextString(m) }
 func (*FieldStats) ProtoMessage()    {}
 func (*FieldStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{26}
+	return fileDescriptor_41f4a519b878ee3b, []int{28}
 }
 
 func (m *FieldStats) XXX_Unmarshal(b []byte) error {
@@ -2008,7 +2221,7 @@ func (m *SegmentStats) Reset()         { *m = SegmentStats{} }
 func (m *SegmentStats) String() string { return proto.CompactTextString(m) }
 func (*SegmentStats) ProtoMessage()    {}
 func (*SegmentStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{27}
+	return fileDescriptor_41f4a519b878ee3b, []int{29}
 }
 
 func (m *SegmentStats) XXX_Unmarshal(b []byte) error {
@@ -2070,7 +2283,7 @@ func (m *QueryNodeStats) Reset()         { *m = QueryNodeStats{} }
 func (m *QueryNodeStats) String() string { return proto.CompactTextString(m) }
 func (*QueryNodeStats) ProtoMessage()    {}
 func (*QueryNodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{28}
+	return fileDescriptor_41f4a519b878ee3b, []int{30}
 }
 
 func (m *QueryNodeStats) XXX_Unmarshal(b []byte) error {
@@ -2126,7 +2339,7 @@ func (m *MsgPosition) Reset()         { *m = MsgPosition{} }
 func (m *MsgPosition) String() string { return proto.CompactTextString(m) }
 func (*MsgPosition) ProtoMessage()    {}
 func (*MsgPosition) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{29}
+	return fileDescriptor_41f4a519b878ee3b, []int{31}
 }
 
 func (m *MsgPosition) XXX_Unmarshal(b []byte) error {
@@ -2189,7 +2402,7 @@ func (m *ChannelTimeTickMsg) Reset()         { *m = ChannelTimeTickMsg{} }
 func (m *ChannelTimeTickMsg) String() string { return proto.CompactTextString(m) }
 func (*ChannelTimeTickMsg) ProtoMessage()    {}
 func (*ChannelTimeTickMsg) Descriptor() ([]byte, []int) {
-	return fileDescriptor_41f4a519b878ee3b, []int{30}
+	return fileDescriptor_41f4a519b878ee3b, []int{32}
 }
 
 func (m *ChannelTimeTickMsg) XXX_Unmarshal(b []byte) error {
@@ -2238,6 +2451,63 @@ func (m *ChannelTimeTickMsg) GetDefaultTimestamp() uint64 {
 	return 0
 }
 
+type CredentialInfo struct {
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// hashed password
+	EncryptedPassword string `protobuf:"bytes,2,opt,name=encrypted_password,json=encryptedPassword,proto3" json:"encrypted_password,omitempty"`
+	// sha256 of the raw password verified against encrypted_password, only cached by proxy
+	Sha256Password       string   `protobuf:"bytes,3,opt,name=sha256_password,json=sha256Password,proto3" json:"sha256_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CredentialInfo) Reset()         { *m = CredentialInfo{} }
+func (m *CredentialInfo) String() string { return proto.CompactTextString(m) }
+func (*CredentialInfo) ProtoMessage()    {}
+func (*CredentialInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_41f4a519b878ee3b, []int{33}
+}
+
+func (m *CredentialInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CredentialInfo.Unmarshal(m, b)
+}
+func (m *CredentialInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CredentialInfo.Marshal(b, m, deterministic)
+}
+func (m *CredentialInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CredentialInfo.Merge(m, src)
+}
+func (m *CredentialInfo) XXX_Size() int {
+	return xxx_messageInfo_CredentialInfo.Size(m)
+}
+func (m *CredentialInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CredentialInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CredentialInfo proto.InternalMessageInfo
+
+func (m *CredentialInfo) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetEncryptedPassword() string {
+	if m != nil {
+		return m.EncryptedPassword
+	}
+	return ""
+}
+
+func (m *CredentialInfo) GetSha256Password() string {
+	if m != nil {
+		return m.Sha256Password
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("milvus.proto.internal.StateCode", StateCode_name, StateCode_value)
 	proto.RegisterType((*ComponentInfo)(nil), "milvus.proto.internal.ComponentInfo")
@@ -2259,10 +2529,12 @@ func init() {
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.internal.AlterAliasRequest")
 	proto.RegisterType((*CreateIndexRequest)(nil), "milvus.proto.internal.CreateIndexRequest")
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.internal.InsertRequest")
+	proto.RegisterType((*FieldValidData)(nil), "milvus.proto.internal.FieldValidData")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.internal.SearchRequest")
 	proto.RegisterType((*SearchResults)(nil), "milvus.proto.internal.SearchResults")
 	proto.RegisterType((*RetrieveRequest)(nil), "milvus.proto.internal.RetrieveRequest")
 	proto.RegisterType((*RetrieveResults)(nil), "milvus.proto.internal.RetrieveResults")
+	proto.RegisterType((*IteratorCursor)(nil), "milvus.proto.internal.IteratorCursor")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.internal.DeleteRequest")
 	proto.RegisterType((*LoadIndex)(nil), "milvus.proto.internal.LoadIndex")
 	proto.RegisterType((*IndexStats)(nil), "milvus.proto.internal.IndexStats")
@@ -2271,128 +2543,147 @@ func init() {
 	proto.RegisterType((*QueryNodeStats)(nil), "milvus.proto.internal.QueryNodeStats")
 	proto.RegisterType((*MsgPosition)(nil), "milvus.proto.internal.MsgPosition")
 	proto.RegisterType((*ChannelTimeTickMsg)(nil), "milvus.proto.internal.ChannelTimeTickMsg")
+	proto.RegisterType((*CredentialInfo)(nil), "milvus.proto.internal.CredentialInfo")
 }
 
 func init() { proto.RegisterFile("internal.proto", fileDescriptor_41f4a519b878ee3b) }
 
 var fileDescriptor_41f4a519b878ee3b = []byte{
-	// 1886 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x73, 0x1c, 0x47,
-	0x15, 0x67, 0x76, 0x56, 0xda, 0xdd, 0xb7, 0x2b, 0x79, 0xdd, 0x92, 0x9d, 0xf1, 0x9f, 0xc4, 0xca,
-	0x24, 0x80, 0x88, 0x0b, 0xdb, 0x28, 0x40, 0x52, 0x14, 0x85, 0x63, 0x69, 0xc1, 0x6c, 0x39, 0x36,
-	0x62, 0x64, 0x52, 0x05, 0x97, 0xa9, 0xde, 0x9d, 0xd6, 0x6a, 0xf0, 0xcc, 0xf4, 0xa4, 0xbb, 0x47,
-	0xf2, 0xfa, 0xc4, 0x81, 0x13, 0x14, 0x54, 0x71, 0xe0, 0x08, 0x37, 0xae, 0x70, 0xe4, 0x04, 0x54,
-	0x71, 0xe2, 0x2b, 0xf0, 0x01, 0xf8, 0x12, 0x9c, 0xa8, 0x7e, 0xdd, 0x33, 0xfb, 0x47, 0x2b, 0x59,
-	0x52, 0x2a, 0xc4, 0xa9, 0xca, 0xad, 0xfb, 0xf7, 0x5e, 0x77, 0xbf, 0xf7, 0x7b, 0xef, 0x75, 0xbf,
-	0x9d, 0x85, 0xd5, 0x38, 0x53, 0x4c, 0x64, 0x34, 0xb9, 0x93, 0x0b, 0xae, 0x38, 0xb9, 0x92, 0xc6,
-	0xc9, 0x61, 0x21, 0xcd, 0xec, 0x4e, 0x29, 0xbc, 0xde, 0x19, 0xf2, 0x34, 0xe5, 0x99, 0x81, 0xaf,
-	0x77, 0xe4, 0xf0, 0x80, 0xa5, 0xd4, 0xcc, 0xfc, 0xbf, 0x3b, 0xb0, 0xb2, 0xc3, 0xd3, 0x9c, 0x67,
-	0x2c, 0x53, 0xfd, 0x6c, 0x9f, 0x93, 0xab, 0xb0, 0x9c, 0xf1, 0x88, 0xf5, 0x7b, 0x9e, 0xb3, 0xe1,
-	0x6c, 0xba, 0x81, 0x9d, 0x11, 0x02, 0x75, 0xc1, 0x13, 0xe6, 0xd5, 0x36, 0x9c, 0xcd, 0x56, 0x80,
-	0x63, 0x72, 0x1f, 0x40, 0x2a, 0xaa, 0x58, 0x38, 0xe4, 0x11, 0xf3, 0xdc, 0x0d, 0x67, 0x73, 0x75,
-	0x6b, 0xe3, 0xce, 0x42, 0x2b, 0xee, 0xec, 0x69, 0xc5, 0x1d, 0x1e, 0xb1, 0xa0, 0x25, 0xcb, 0x21,
-	0xf9, 0x00, 0x80, 0x3d, 0x57, 0x82, 0x86, 0x71, 0xb6, 0xcf, 0xbd, 0xfa, 0x86, 0xbb, 0xd9, 0xde,
-	0x7a, 0x73, 0x76, 0x03, 0x6b, 0xfc, 0x23, 0x36, 0xfe, 0x88, 0x26, 0x05, 0xdb, 0xa5, 0xb1, 0x08,
-	0x5a, 0xb8, 0x48, 0x9b, 0xeb, 0xff, 0xdb, 0x81, 0x4b, 0x95, 0x03, 0x78, 0x86, 0x24, 0xdf, 0x81,
-	0x25, 0x3c, 0x02, 0x3d, 0x68, 0x6f, 0xbd, 0x7d, 0x82, 0x45, 0x33, 0x7e, 0x07, 0x66, 0x09, 0xf9,
-	0x09, 0xac, 0xc9, 0x62, 0x30, 0x2c, 0x45, 0x21, 0xa2, 0xd2, 0xab, 0xa1, 0x69, 0x67, 0xdb, 0x89,
-	0x4c, 0x6f, 0x60, 0x4d, 0x7a, 0x17, 0x96, 0xf5, 0x4e, 0x85, 0x44, 0x96, 0xda, 0x5b, 0x37, 0x16,
-	0x3a, 0xb9, 0x87, 0x2a, 0x81, 0x55, 0xf5, 0x6f, 0xc0, 0xb5, 0x87, 0x4c, 0xcd, 0x79, 0x17, 0xb0,
-	0x8f, 0x0b, 0x26, 0x95, 0x15, 0x3e, 0x8d, 0x53, 0xf6, 0x34, 0x1e, 0x3e, 0xdb, 0x39, 0xa0, 0x59,
-	0xc6, 0x92, 0x52, 0xf8, 0x3a, 0xdc, 0x78, 0xc8, 0x70, 0x41, 0x2c, 0x55, 0x3c, 0x94, 0x73, 0xe2,
-	0x2b, 0xb0, 0xf6, 0x90, 0xa9, 0x5e, 0x34, 0x07, 0x7f, 0x04, 0xcd, 0x27, 0x3a, 0xd8, 0x3a, 0x0d,
-	0xbe, 0x0d, 0x0d, 0x1a, 0x45, 0x82, 0x49, 0x69, 0x59, 0xbc, 0xb9, 0xd0, 0xe2, 0x07, 0x46, 0x27,
-	0x28, 0x95, 0x17, 0xa5, 0x89, 0xff, 0x73, 0x80, 0x7e, 0x16, 0xab, 0x5d, 0x2a, 0x68, 0x2a, 0x4f,
-	0x4c, 0xb0, 0x1e, 0x74, 0xa4, 0xa2, 0x42, 0x85, 0x39, 0xea, 0x59, 0xca, 0xcf, 0x90, 0x0d, 0x6d,
-	0x5c, 0x66, 0x76, 0xf7, 0x7f, 0x0a, 0xb0, 0xa7, 0x44, 0x9c, 0x8d, 0x3e, 0x8c, 0xa5, 0xd2, 0x67,
-	0x1d, 0x6a, 0x3d, 0xed, 0x84, 0xbb, 0xd9, 0x0a, 0xec, 0x6c, 0x2a, 0x1c, 0xb5, 0xb3, 0x87, 0xe3,
-	0x3e, 0xb4, 0x4b, 0xba, 0x1f, 0xcb, 0x11, 0xb9, 0x07, 0xf5, 0x01, 0x95, 0xec, 0x54, 0x7a, 0x1e,
-	0xcb, 0xd1, 0x36, 0x95, 0x2c, 0x40, 0x4d, 0xff, 0x57, 0x2e, 0xbc, 0xb6, 0x23, 0x18, 0x26, 0x7f,
-	0x92, 0xb0, 0xa1, 0x8a, 0x79, 0x66, 0xb9, 0x3f, 0xff, 0x6e, 0xe4, 0x35, 0x68, 0x44, 0x83, 0x30,
-	0xa3, 0x69, 0x49, 0xf6, 0x72, 0x34, 0x78, 0x42, 0x53, 0x46, 0xbe, 0x02, 0xab, 0xc3, 0x6a, 0x7f,
-	0x8d, 0x60, 0xce, 0xb5, 0x82, 0x39, 0x94, 0xbc, 0x0d, 0x2b, 0x39, 0x15, 0x2a, 0xae, 0xd4, 0xea,
-	0xa8, 0x36, 0x0b, 0xea, 0x80, 0x46, 0x83, 0x7e, 0xcf, 0x5b, 0xc2, 0x60, 0xe1, 0x98, 0xf8, 0xd0,
-	0x99, 0xec, 0xd5, 0xef, 0x79, 0xcb, 0x28, 0x9b, 0xc1, 0xc8, 0x06, 0xb4, 0xab, 0x8d, 0xfa, 0x3d,
-	0xaf, 0x81, 0x2a, 0xd3, 0x90, 0x0e, 0x8e, 0xb9, 0x8b, 0xbc, 0xe6, 0x86, 0xb3, 0xd9, 0x09, 0xec,
-	0x8c, 0xdc, 0x83, 0xb5, 0xc3, 0x58, 0xa8, 0x82, 0x26, 0x36, 0x3f, 0xb5, 0x1d, 0xd2, 0x6b, 0x61,
-	0x04, 0x17, 0x89, 0xc8, 0x16, 0xac, 0xe7, 0x07, 0x63, 0x19, 0x0f, 0xe7, 0x96, 0x00, 0x2e, 0x59,
-	0x28, 0xf3, 0xff, 0xe9, 0xc0, 0x95, 0x9e, 0xe0, 0xf9, 0x2b, 0x11, 0x8a, 0x92, 0xe4, 0xfa, 0x29,
-	0x24, 0x2f, 0x1d, 0x27, 0xd9, 0xff, 0x4d, 0x0d, 0xae, 0x9a, 0x8c, 0xda, 0x2d, 0x89, 0xfd, 0x14,
-	0xbc, 0xf8, 0x2a, 0x5c, 0x9a, 0x9c, 0x6a, 0x14, 0x16, 0xbb, 0xf1, 0x65, 0x58, 0xad, 0x02, 0x6c,
-	0xf4, 0xfe, 0xbf, 0x29, 0xe5, 0xff, 0xba, 0x06, 0xeb, 0x3a, 0xa8, 0x5f, 0xb0, 0xa1, 0xd9, 0xf8,
-	0xa3, 0x03, 0xc4, 0x64, 0xc7, 0x83, 0x24, 0xa6, 0xf2, 0xb3, 0xe4, 0x62, 0x1d, 0x96, 0xa8, 0xb6,
-	0xc1, 0x52, 0x60, 0x26, 0xbe, 0x84, 0xae, 0x8e, 0xd6, 0xa7, 0x65, 0x5d, 0x75, 0xa8, 0x3b, 0x7d,
-	0xe8, 0x1f, 0x1c, 0xb8, 0xfc, 0x20, 0x51, 0x4c, 0xbc, 0xa2, 0xa4, 0xfc, 0xa3, 0x56, 0x46, 0xad,
-	0x9f, 0x45, 0xec, 0xf9, 0x67, 0x69, 0xe0, 0xeb, 0x00, 0xfb, 0x31, 0x4b, 0xa2, 0xe9, 0xec, 0x6d,
-	0x21, 0xf2, 0x89, 0x32, 0xd7, 0x83, 0x06, 0x6e, 0x52, 0x65, 0x6d, 0x39, 0xd5, 0x3d, 0x80, 0xe9,
-	0x07, 0x6d, 0x0f, 0xd0, 0x3c, 0x73, 0x0f, 0x80, 0xcb, 0x6c, 0x0f, 0xf0, 0x67, 0x17, 0x56, 0xfa,
-	0x99, 0x64, 0x42, 0x5d, 0x9c, 0xbc, 0x9b, 0xd0, 0x92, 0x07, 0x54, 0xa0, 0xa3, 0x96, 0xbe, 0x09,
-	0x30, 0x4d, 0xad, 0xfb, 0x32, 0x6a, 0xeb, 0x67, 0xbc, 0x1c, 0x96, 0x4e, 0xbb, 0x1c, 0x96, 0x4f,
-	0xa1, 0xb8, 0xf1, 0xf2, 0xcb, 0xa1, 0x79, 0xfc, 0xf5, 0xd5, 0x0e, 0xb2, 0x51, 0xaa, 0x9b, 0xd6,
-	0x9e, 0xd7, 0x42, 0xf9, 0x04, 0x20, 0x6f, 0x00, 0xa8, 0x38, 0x65, 0x52, 0xd1, 0x34, 0x37, 0xef,
-	0x68, 0x3d, 0x98, 0x42, 0xf4, 0xdb, 0x2d, 0xf8, 0x51, 0xbf, 0x27, 0xbd, 0xf6, 0x86, 0xab, 0x9b,
-	0x38, 0x33, 0x23, 0xdf, 0x84, 0xa6, 0xe0, 0x47, 0x61, 0x44, 0x15, 0xf5, 0x3a, 0x18, 0xbc, 0x6b,
-	0x0b, 0xc9, 0xde, 0x4e, 0xf8, 0x20, 0x68, 0x08, 0x7e, 0xd4, 0xa3, 0x8a, 0xfa, 0x7f, 0xaa, 0xc3,
-	0xca, 0x1e, 0xa3, 0x62, 0x78, 0x70, 0xf1, 0x80, 0x7d, 0x0d, 0xba, 0x82, 0xc9, 0x22, 0x51, 0xe1,
-	0xd0, 0x3c, 0xf3, 0xfd, 0x9e, 0x8d, 0xdb, 0x25, 0x83, 0xef, 0x94, 0x70, 0x45, 0xaa, 0x7b, 0x0a,
-	0xa9, 0xf5, 0x05, 0xa4, 0xfa, 0xd0, 0x99, 0x62, 0x50, 0x7a, 0x4b, 0xe8, 0xfa, 0x0c, 0x46, 0xba,
-	0xe0, 0x46, 0x32, 0xc1, 0x78, 0xb5, 0x02, 0x3d, 0x24, 0xb7, 0xe1, 0x72, 0x9e, 0xd0, 0x21, 0x3b,
-	0xe0, 0x49, 0xc4, 0x44, 0x38, 0x12, 0xbc, 0xc8, 0x31, 0x66, 0x9d, 0xa0, 0x3b, 0x25, 0x78, 0xa8,
-	0x71, 0xf2, 0x1e, 0x34, 0x23, 0x99, 0x84, 0x6a, 0x9c, 0x33, 0x0c, 0xda, 0xea, 0x09, 0xbe, 0xf7,
-	0x64, 0xf2, 0x74, 0x9c, 0xb3, 0xa0, 0x11, 0x99, 0x01, 0xb9, 0x07, 0xeb, 0x92, 0x89, 0x98, 0x26,
-	0xf1, 0x0b, 0x16, 0x85, 0xec, 0x79, 0x2e, 0xc2, 0x3c, 0xa1, 0x19, 0x46, 0xb6, 0x13, 0x90, 0x89,
-	0xec, 0xfb, 0xcf, 0x73, 0xb1, 0x9b, 0xd0, 0x8c, 0x6c, 0x42, 0x97, 0x17, 0x2a, 0x2f, 0x54, 0x88,
-	0xd5, 0x27, 0xc3, 0x38, 0xc2, 0x40, 0xbb, 0xc1, 0xaa, 0xc1, 0x7f, 0x80, 0x70, 0x3f, 0xd2, 0xd4,
-	0x2a, 0x41, 0x0f, 0x59, 0x12, 0x56, 0x19, 0xe0, 0xb5, 0x37, 0x9c, 0xcd, 0x7a, 0x70, 0xc9, 0xe0,
-	0x4f, 0x4b, 0x98, 0xdc, 0x85, 0xb5, 0x51, 0x41, 0x05, 0xcd, 0x14, 0x63, 0x53, 0xda, 0x1d, 0xd4,
-	0x26, 0x95, 0x68, 0xb2, 0xe0, 0x36, 0x5c, 0xd6, 0x6a, 0xbc, 0x50, 0x53, 0xea, 0x2b, 0xa8, 0xde,
-	0xb5, 0x82, 0x4a, 0xd9, 0xff, 0xdd, 0x54, 0x9e, 0xe8, 0x90, 0xca, 0x0b, 0xe4, 0xc9, 0x45, 0x5a,
-	0xff, 0x85, 0xc9, 0xe5, 0x2e, 0x4e, 0xae, 0x5b, 0xd0, 0x4e, 0x99, 0x12, 0xf1, 0xd0, 0x04, 0xd1,
-	0x54, 0x3f, 0x18, 0x08, 0x23, 0x75, 0x0b, 0xda, 0x59, 0x91, 0x86, 0x1f, 0x17, 0x4c, 0xc4, 0x4c,
-	0xda, 0xcb, 0x13, 0xb2, 0x22, 0xfd, 0xb1, 0x41, 0xc8, 0x1a, 0x2c, 0x29, 0x9e, 0x87, 0xcf, 0xca,
-	0xa2, 0x57, 0x3c, 0x7f, 0x44, 0xbe, 0x0b, 0xd7, 0x25, 0xa3, 0x09, 0x8b, 0xc2, 0xaa, 0x48, 0x65,
-	0x28, 0x91, 0x0b, 0x16, 0x79, 0x0d, 0x8c, 0x9b, 0x67, 0x34, 0xf6, 0x2a, 0x85, 0x3d, 0x2b, 0xd7,
-	0x61, 0xa9, 0x0c, 0x9f, 0x5a, 0xd6, 0xc4, 0xfe, 0x98, 0x4c, 0x44, 0xd5, 0x82, 0xf7, 0xc1, 0x1b,
-	0x25, 0x7c, 0x40, 0x93, 0xf0, 0xd8, 0xa9, 0xd8, 0x88, 0xbb, 0xc1, 0x55, 0x23, 0xdf, 0x9b, 0x3b,
-	0x52, 0xbb, 0x27, 0x93, 0x78, 0xc8, 0xa2, 0x70, 0x90, 0xf0, 0x81, 0x07, 0x98, 0x7f, 0x60, 0x20,
-	0x5d, 0xf5, 0x3a, 0xef, 0xac, 0x82, 0xa6, 0x61, 0xc8, 0x8b, 0x4c, 0x61, 0x36, 0xb9, 0xc1, 0xaa,
-	0xc1, 0x9f, 0x14, 0xe9, 0x8e, 0x46, 0xc9, 0x5b, 0xb0, 0x62, 0x35, 0xf9, 0xfe, 0xbe, 0x64, 0x0a,
-	0xd3, 0xc8, 0x0d, 0x3a, 0x06, 0xfc, 0x11, 0x62, 0xfe, 0x5f, 0x5c, 0xb8, 0x14, 0x68, 0x76, 0xd9,
-	0x21, 0xfb, 0xdc, 0xdf, 0x1e, 0x27, 0x55, 0xf1, 0xf2, 0xb9, 0xaa, 0xb8, 0x71, 0xe6, 0x2a, 0x6e,
-	0x9e, 0xab, 0x8a, 0x5b, 0xe7, 0xab, 0x62, 0x38, 0xa1, 0x8a, 0xff, 0x36, 0x13, 0xb1, 0x57, 0xb5,
-	0x8e, 0xdf, 0x01, 0x37, 0x8e, 0x4c, 0x6b, 0xd6, 0xde, 0xf2, 0x66, 0x37, 0xb7, 0x9f, 0xd0, 0xfa,
-	0x3d, 0x19, 0x68, 0x25, 0x72, 0x1f, 0xda, 0x96, 0x7d, 0x7c, 0xf8, 0x96, 0xf0, 0xe1, 0x7b, 0x63,
-	0xe1, 0x1a, 0x0c, 0x87, 0x7e, 0xf4, 0x02, 0xd3, 0x5a, 0x49, 0x3d, 0x26, 0xdf, 0x83, 0x1b, 0xc7,
-	0xab, 0x5b, 0x58, 0x8e, 0x22, 0x6f, 0x19, 0x03, 0x7a, 0x6d, 0xbe, 0xbc, 0x4b, 0x12, 0x23, 0xf2,
-	0x0d, 0x58, 0x9f, 0xaa, 0xef, 0xc9, 0xc2, 0x86, 0xf9, 0xcd, 0x3c, 0x91, 0x4d, 0x96, 0x9c, 0x56,
-	0xe1, 0xcd, 0xd3, 0x2a, 0xdc, 0xff, 0x4f, 0x0d, 0x56, 0x7a, 0x2c, 0x61, 0x8a, 0x7d, 0xd1, 0x5e,
-	0x9d, 0xd8, 0x5e, 0xbd, 0x09, 0x9d, 0x5c, 0xc4, 0x29, 0x15, 0xe3, 0xf0, 0x19, 0x1b, 0x97, 0x97,
-	0x66, 0xdb, 0x62, 0x8f, 0xd8, 0x58, 0xbe, 0xac, 0xc7, 0xf2, 0xff, 0xeb, 0x40, 0xeb, 0x43, 0x4e,
-	0x23, 0xfc, 0x19, 0x70, 0x41, 0x8e, 0xab, 0x0e, 0xaf, 0x36, 0xdf, 0xe1, 0xdd, 0x84, 0x49, 0x27,
-	0x6f, 0x59, 0x9e, 0x6a, 0xed, 0xa7, 0x5a, 0xf4, 0xfa, 0x6c, 0x8b, 0x7e, 0x0b, 0xda, 0xb1, 0x36,
-	0x28, 0xcc, 0xa9, 0x3a, 0x30, 0xb7, 0x58, 0x2b, 0x00, 0x84, 0x76, 0x35, 0xa2, 0x7b, 0xf8, 0x52,
-	0x01, 0x7b, 0xf8, 0xe5, 0x33, 0xf7, 0xf0, 0x76, 0x13, 0xec, 0xe1, 0x7f, 0xe9, 0x00, 0xa0, 0xe3,
-	0xba, 0x82, 0x8f, 0x6f, 0xea, 0x5c, 0x64, 0x53, 0x7d, 0xbd, 0xea, 0x37, 0x47, 0xb0, 0x84, 0xaa,
-	0x49, 0xc6, 0x4b, 0x4b, 0x0e, 0xc9, 0x8a, 0x34, 0x30, 0x22, 0x9b, 0xed, 0xd2, 0xff, 0xad, 0x03,
-	0x80, 0x25, 0x6b, 0xcc, 0x98, 0xcf, 0x0d, 0xe7, 0xf4, 0x5f, 0x37, 0xb5, 0x59, 0xea, 0xb6, 0x4b,
-	0xea, 0xf4, 0x6d, 0xa4, 0x7f, 0x95, 0x2e, 0xf0, 0xa1, 0xfa, 0xa6, 0x3c, 0x71, 0xde, 0xb2, 0x8b,
-	0x63, 0xff, 0xf7, 0x0e, 0x74, 0xac, 0x75, 0xc6, 0xa4, 0x99, 0x28, 0x3b, 0xf3, 0x51, 0xc6, 0x6e,
-	0x24, 0xe5, 0x62, 0x1c, 0xca, 0xf8, 0x05, 0xb3, 0x06, 0x81, 0x81, 0xf6, 0xe2, 0x17, 0x8c, 0x5c,
-	0x83, 0x26, 0x52, 0xc2, 0x8f, 0xa4, 0x7d, 0xd1, 0x1a, 0x9a, 0x06, 0x7e, 0x24, 0xf5, 0xa5, 0x2e,
-	0xd8, 0x90, 0x65, 0x2a, 0x19, 0x87, 0x29, 0x8f, 0xe2, 0xfd, 0x98, 0x45, 0x98, 0x0d, 0xcd, 0xa0,
-	0x5b, 0x0a, 0x1e, 0x5b, 0xdc, 0xff, 0x97, 0x03, 0xab, 0xba, 0x81, 0x19, 0x3f, 0xe1, 0x11, 0x33,
-	0x96, 0x9d, 0x3f, 0x63, 0x3f, 0x40, 0x5f, 0x2c, 0x3d, 0xe6, 0xfb, 0xef, 0x5b, 0x27, 0xfd, 0x9d,
-	0x30, 0xc5, 0x41, 0xd0, 0x94, 0x6c, 0x64, 0xce, 0xdc, 0xb6, 0x37, 0xf1, 0x99, 0x28, 0x9e, 0x04,
-	0xd6, 0x5e, 0xc6, 0x86, 0xe2, 0x5f, 0x38, 0xd0, 0x7e, 0x2c, 0x47, 0xbb, 0x5c, 0x62, 0x31, 0xeb,
-	0x52, 0xb6, 0x17, 0xa8, 0xb9, 0x49, 0x1c, 0x2c, 0x96, 0xf6, 0x70, 0xf2, 0x35, 0x51, 0xff, 0x92,
-	0x4f, 0xe5, 0xc8, 0x46, 0xbc, 0x13, 0x98, 0x09, 0xb9, 0x0e, 0xcd, 0x54, 0x8e, 0xb0, 0xb1, 0xb7,
-	0x15, 0x56, 0xcd, 0x75, 0xd8, 0x26, 0x2f, 0x65, 0x1d, 0x5f, 0xca, 0x09, 0xe0, 0xff, 0xd5, 0x01,
-	0x62, 0x9f, 0xa2, 0x4f, 0xf4, 0xc9, 0x19, 0x13, 0x76, 0xfa, 0x8b, 0x68, 0x0d, 0xcb, 0x75, 0x06,
-	0x9b, 0xbb, 0x87, 0xdc, 0x63, 0xbf, 0xf5, 0x6e, 0xc3, 0xe5, 0x88, 0xed, 0x53, 0xfd, 0x6a, 0xce,
-	0x9b, 0xdc, 0xb5, 0x82, 0xea, 0x71, 0x7f, 0xe7, 0x7d, 0x68, 0x55, 0xff, 0xf4, 0x90, 0x2e, 0x74,
-	0xfa, 0x59, 0xac, 0xb0, 0x67, 0x89, 0xb3, 0x51, 0xf7, 0x4b, 0xa4, 0x0d, 0x8d, 0x1f, 0x32, 0x9a,
-	0xa8, 0x83, 0x71, 0xd7, 0x21, 0x1d, 0x68, 0x3e, 0x18, 0x64, 0x5c, 0xa4, 0x34, 0xe9, 0xd6, 0xb6,
-	0xdf, 0xfb, 0xd9, 0xb7, 0x46, 0xb1, 0x3a, 0x28, 0x06, 0xda, 0x93, 0xbb, 0xc6, 0xb5, 0xaf, 0xc7,
-	0xdc, 0x8e, 0xee, 0x96, 0x51, 0xbb, 0x8b, 0xde, 0x56, 0xd3, 0x7c, 0x30, 0x58, 0x46, 0xe4, 0xdd,
-	0xff, 0x05, 0x00, 0x00, 0xff, 0xff, 0x48, 0xeb, 0xcc, 0x3e, 0x0f, 0x1b, 0x00, 0x00,
+	// 2174 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x73, 0x1c, 0x47,
+	0x15, 0xcf, 0xec, 0xac, 0xb4, 0xbb, 0x6f, 0x57, 0xab, 0x75, 0x5b, 0x71, 0xc6, 0x5f, 0xf1, 0x66,
+	0x92, 0x80, 0x12, 0x57, 0x6c, 0xa3, 0x90, 0x8f, 0x02, 0x0a, 0xc7, 0xd2, 0x82, 0xb3, 0x38, 0x36,
+	0x62, 0x64, 0x5c, 0x05, 0x97, 0xa9, 0xde, 0x9d, 0xd6, 0xaa, 0xd1, 0x7c, 0xa5, 0xbb, 0x47, 0xd2,
+	0xfa, 0xc4, 0x21, 0x5c, 0xa0, 0xa0, 0x8a, 0x03, 0x47, 0xf8, 0x37, 0x72, 0x02, 0xaa, 0x38, 0x71,
+	0xe4, 0xca, 0x7f, 0x41, 0x15, 0x37, 0x4e, 0x54, 0x7f, 0xcc, 0xc7, 0xae, 0x56, 0xb2, 0xac, 0x54,
+	0x88, 0xa9, 0xca, 0x6d, 0xfa, 0xf7, 0x5e, 0xf7, 0x74, 0xff, 0xde, 0xaf, 0x5f, 0xbf, 0x9e, 0x81,
+	0x2e, 0x8d, 0x05, 0x61, 0x31, 0x0e, 0x6f, 0xa5, 0x2c, 0x11, 0x09, 0x7a, 0x39, 0xa2, 0xe1, 0x41,
+	0xc6, 0x75, 0xeb, 0x56, 0x6e, 0xbc, 0xd2, 0x19, 0x27, 0x51, 0x94, 0xc4, 0x1a, 0xbe, 0xd2, 0xe1,
+	0xe3, 0x3d, 0x12, 0x61, 0xdd, 0x72, 0xff, 0x62, 0xc1, 0xca, 0x56, 0x12, 0xa5, 0x49, 0x4c, 0x62,
+	0x31, 0x8c, 0x77, 0x13, 0x74, 0x09, 0x96, 0xe3, 0x24, 0x20, 0xc3, 0x81, 0x63, 0xf5, 0xad, 0x75,
+	0xdb, 0x33, 0x2d, 0x84, 0xa0, 0xce, 0x92, 0x90, 0x38, 0xb5, 0xbe, 0xb5, 0xde, 0xf2, 0xd4, 0x33,
+	0xba, 0x0b, 0xc0, 0x05, 0x16, 0xc4, 0x1f, 0x27, 0x01, 0x71, 0xec, 0xbe, 0xb5, 0xde, 0xdd, 0xe8,
+	0xdf, 0x5a, 0x38, 0x8b, 0x5b, 0x3b, 0xd2, 0x71, 0x2b, 0x09, 0x88, 0xd7, 0xe2, 0xf9, 0x23, 0xfa,
+	0x08, 0x80, 0x1c, 0x09, 0x86, 0x7d, 0x1a, 0xef, 0x26, 0x4e, 0xbd, 0x6f, 0xaf, 0xb7, 0x37, 0x5e,
+	0x9b, 0x1d, 0xc0, 0x4c, 0xfe, 0x01, 0x99, 0x3e, 0xc1, 0x61, 0x46, 0xb6, 0x31, 0x65, 0x5e, 0x4b,
+	0x75, 0x92, 0xd3, 0x75, 0xff, 0x69, 0xc1, 0x6a, 0xb1, 0x00, 0xf5, 0x0e, 0x8e, 0xbe, 0x03, 0x4b,
+	0xea, 0x15, 0x6a, 0x05, 0xed, 0x8d, 0x37, 0x4e, 0x98, 0xd1, 0xcc, 0xba, 0x3d, 0xdd, 0x05, 0xfd,
+	0x14, 0x2e, 0xf2, 0x6c, 0x34, 0xce, 0x4d, 0xbe, 0x42, 0xb9, 0x53, 0xeb, 0xdb, 0x67, 0x1e, 0x09,
+	0x55, 0x07, 0x30, 0x53, 0x7a, 0x17, 0x96, 0xe5, 0x48, 0x19, 0x57, 0x2c, 0xb5, 0x37, 0xae, 0x2e,
+	0x5c, 0xe4, 0x8e, 0x72, 0xf1, 0x8c, 0xab, 0x7b, 0x15, 0x2e, 0xdf, 0x27, 0x62, 0x6e, 0x75, 0x1e,
+	0xf9, 0x34, 0x23, 0x5c, 0x18, 0xe3, 0x63, 0x1a, 0x91, 0xc7, 0x74, 0xbc, 0xbf, 0xb5, 0x87, 0xe3,
+	0x98, 0x84, 0xb9, 0xf1, 0x3a, 0x5c, 0xbd, 0x4f, 0x54, 0x07, 0xca, 0x05, 0x1d, 0xf3, 0x39, 0xf3,
+	0xcb, 0x70, 0xf1, 0x3e, 0x11, 0x83, 0x60, 0x0e, 0x7e, 0x02, 0xcd, 0x47, 0x32, 0xd8, 0x52, 0x06,
+	0xef, 0x43, 0x03, 0x07, 0x01, 0x23, 0x9c, 0x1b, 0x16, 0xaf, 0x2d, 0x9c, 0xf1, 0x3d, 0xed, 0xe3,
+	0xe5, 0xce, 0x8b, 0x64, 0xe2, 0xfe, 0x02, 0x60, 0x18, 0x53, 0xb1, 0x8d, 0x19, 0x8e, 0xf8, 0x89,
+	0x02, 0x1b, 0x40, 0x87, 0x0b, 0xcc, 0x84, 0x9f, 0x2a, 0x3f, 0xa7, 0x76, 0x56, 0x35, 0xb4, 0x55,
+	0x37, 0x3d, 0xba, 0xfb, 0x33, 0x80, 0x1d, 0xc1, 0x68, 0x3c, 0xf9, 0x84, 0x72, 0x21, 0xdf, 0x75,
+	0x20, 0xfd, 0xe4, 0x22, 0xec, 0xf5, 0x96, 0x67, 0x5a, 0x95, 0x70, 0xd4, 0xce, 0x1e, 0x8e, 0xbb,
+	0xd0, 0xce, 0xe9, 0x7e, 0xc8, 0x27, 0xe8, 0x0e, 0xd4, 0x47, 0x98, 0x93, 0x53, 0xe9, 0x79, 0xc8,
+	0x27, 0x9b, 0x98, 0x13, 0x4f, 0x79, 0xba, 0xbf, 0xb6, 0xe1, 0x95, 0x2d, 0x46, 0x94, 0xf8, 0xc3,
+	0x90, 0x8c, 0x05, 0x4d, 0x62, 0xc3, 0xfd, 0xf3, 0x8f, 0x86, 0x5e, 0x81, 0x46, 0x30, 0xf2, 0x63,
+	0x1c, 0xe5, 0x64, 0x2f, 0x07, 0xa3, 0x47, 0x38, 0x22, 0xe8, 0x1b, 0xd0, 0x1d, 0x17, 0xe3, 0x4b,
+	0x44, 0x69, 0xae, 0xe5, 0xcd, 0xa1, 0xe8, 0x0d, 0x58, 0x49, 0x31, 0x13, 0xb4, 0x70, 0xab, 0x2b,
+	0xb7, 0x59, 0x50, 0x06, 0x34, 0x18, 0x0d, 0x07, 0xce, 0x92, 0x0a, 0x96, 0x7a, 0x46, 0x2e, 0x74,
+	0xca, 0xb1, 0x86, 0x03, 0x67, 0x59, 0xd9, 0x66, 0x30, 0xd4, 0x87, 0x76, 0x31, 0xd0, 0x70, 0xe0,
+	0x34, 0x94, 0x4b, 0x15, 0x92, 0xc1, 0xd1, 0xb9, 0xc8, 0x69, 0xf6, 0xad, 0xf5, 0x8e, 0x67, 0x5a,
+	0xe8, 0x0e, 0x5c, 0x3c, 0xa0, 0x4c, 0x64, 0x38, 0x34, 0xfa, 0x94, 0xf3, 0xe0, 0x4e, 0x4b, 0x45,
+	0x70, 0x91, 0x09, 0x6d, 0xc0, 0x5a, 0xba, 0x37, 0xe5, 0x74, 0x3c, 0xd7, 0x05, 0x54, 0x97, 0x85,
+	0x36, 0xf7, 0x6f, 0x16, 0xbc, 0x3c, 0x60, 0x49, 0xfa, 0x42, 0x84, 0x22, 0x27, 0xb9, 0x7e, 0x0a,
+	0xc9, 0x4b, 0xc7, 0x49, 0x76, 0x7f, 0x5b, 0x83, 0x4b, 0x5a, 0x51, 0xdb, 0x39, 0xb1, 0x5f, 0xc2,
+	0x2a, 0xbe, 0x09, 0xab, 0xe5, 0x5b, 0xfd, 0xf8, 0xe4, 0x65, 0xbc, 0x09, 0xdd, 0x22, 0xc0, 0xda,
+	0xef, 0x7f, 0x2b, 0x29, 0xf7, 0x37, 0x35, 0x58, 0x93, 0x41, 0xfd, 0x9a, 0x0d, 0xc9, 0xc6, 0x9f,
+	0x2c, 0x40, 0x5a, 0x1d, 0xf7, 0x42, 0x8a, 0xf9, 0x57, 0xc9, 0xc5, 0x1a, 0x2c, 0x61, 0x39, 0x07,
+	0x43, 0x81, 0x6e, 0xb8, 0x1c, 0x7a, 0x32, 0x5a, 0x5f, 0xd6, 0xec, 0x8a, 0x97, 0xda, 0xd5, 0x97,
+	0xfe, 0xd1, 0x82, 0x0b, 0xf7, 0x42, 0x41, 0xd8, 0x0b, 0x4a, 0xca, 0x5f, 0x6b, 0x79, 0xd4, 0x86,
+	0x71, 0x40, 0x8e, 0xbe, 0xca, 0x09, 0x5e, 0x07, 0xd8, 0xa5, 0x24, 0x0c, 0xaa, 0xea, 0x6d, 0x29,
+	0xe4, 0x0b, 0x29, 0xd7, 0x81, 0x86, 0x1a, 0xa4, 0x50, 0x6d, 0xde, 0x94, 0x35, 0x80, 0xae, 0x07,
+	0x4d, 0x0d, 0xd0, 0x3c, 0x73, 0x0d, 0xa0, 0xba, 0x99, 0x1a, 0xe0, 0xf3, 0x3a, 0xac, 0x0c, 0x63,
+	0x4e, 0x98, 0x38, 0x3f, 0x79, 0xd7, 0xa0, 0xc5, 0xf7, 0x30, 0x0b, 0x1e, 0x95, 0xf4, 0x95, 0x40,
+	0x95, 0x5a, 0xfb, 0x59, 0xd4, 0xd6, 0xcf, 0x98, 0x1c, 0x96, 0x4e, 0x4b, 0x0e, 0xcb, 0xa7, 0x50,
+	0xdc, 0x78, 0x76, 0x72, 0x68, 0x1e, 0x3f, 0x7d, 0xe5, 0x02, 0xc9, 0x24, 0x92, 0x45, 0xeb, 0xc0,
+	0x69, 0x29, 0x7b, 0x09, 0xa0, 0x57, 0x01, 0x04, 0x8d, 0x08, 0x17, 0x38, 0x4a, 0xf5, 0x39, 0x5a,
+	0xf7, 0x2a, 0x88, 0x3c, 0xbb, 0x59, 0x72, 0x38, 0x1c, 0x70, 0xa7, 0xdd, 0xb7, 0x65, 0x11, 0xa7,
+	0x5b, 0xe8, 0xdb, 0xd0, 0x64, 0xc9, 0xa1, 0x1f, 0x60, 0x81, 0x9d, 0x8e, 0x0a, 0xde, 0xe5, 0x85,
+	0x64, 0x6f, 0x86, 0xc9, 0xc8, 0x6b, 0xb0, 0xe4, 0x70, 0x80, 0x05, 0x96, 0x64, 0xe8, 0xb3, 0xdf,
+	0x3f, 0x20, 0x8c, 0xd3, 0x24, 0x76, 0x56, 0xfa, 0xd6, 0xfa, 0x92, 0xb7, 0xa2, 0xd1, 0x27, 0x1a,
+	0x44, 0x03, 0x80, 0x03, 0x1c, 0xd2, 0x40, 0x0f, 0xdf, 0x55, 0xc3, 0xbf, 0x79, 0x42, 0x49, 0xfe,
+	0x43, 0xa9, 0xa8, 0x27, 0xd2, 0x5b, 0xbe, 0xc1, 0x6b, 0x1d, 0xe4, 0x8f, 0xe8, 0x0a, 0x34, 0x8d,
+	0xdc, 0xb8, 0xb3, 0xaa, 0x26, 0x5f, 0xb4, 0xdd, 0x21, 0x74, 0x67, 0x3b, 0x56, 0xb5, 0x6a, 0xcd,
+	0x6a, 0xf5, 0xfa, 0xcc, 0x6c, 0x64, 0xb5, 0xda, 0xac, 0xbc, 0xc6, 0xfd, 0x47, 0x1d, 0x56, 0x76,
+	0x08, 0x66, 0xe3, 0xbd, 0xf3, 0x8b, 0xf0, 0x2d, 0xe8, 0x31, 0xc2, 0xb3, 0x50, 0xf8, 0x63, 0x5d,
+	0xba, 0x0c, 0x07, 0x46, 0x8b, 0xab, 0x1a, 0xdf, 0xca, 0xe1, 0x42, 0x28, 0xf6, 0x29, 0x42, 0xa9,
+	0x2f, 0x10, 0x8a, 0x0b, 0x9d, 0x8a, 0x2a, 0xb8, 0xb3, 0xa4, 0x18, 0x99, 0xc1, 0x50, 0x0f, 0xec,
+	0x80, 0x87, 0x4a, 0x83, 0x2d, 0x4f, 0x3e, 0xa2, 0x9b, 0x70, 0x21, 0x0d, 0xf1, 0x98, 0xec, 0x25,
+	0x61, 0x40, 0x98, 0x3f, 0x61, 0x49, 0x96, 0x2a, 0x1d, 0x76, 0xbc, 0x5e, 0xc5, 0x70, 0x5f, 0xe2,
+	0xe8, 0x03, 0x68, 0x06, 0x3c, 0xf4, 0xc5, 0x34, 0x25, 0x4a, 0x88, 0xdd, 0x13, 0xd6, 0x3e, 0xe0,
+	0xe1, 0xe3, 0x69, 0x4a, 0xbc, 0x46, 0xa0, 0x1f, 0xd0, 0x1d, 0x58, 0xe3, 0x84, 0x51, 0x1c, 0xd2,
+	0xa7, 0x24, 0xf0, 0xc9, 0x51, 0xca, 0xfc, 0x34, 0xc4, 0xb1, 0x52, 0x6b, 0xc7, 0x43, 0xa5, 0xed,
+	0x07, 0x47, 0x29, 0xdb, 0x0e, 0x71, 0x8c, 0xd6, 0xa1, 0x97, 0x64, 0x22, 0xcd, 0x84, 0xaf, 0xa2,
+	0xc4, 0x7d, 0x1a, 0x28, 0xf1, 0xda, 0x5e, 0x57, 0xe3, 0x2a, 0xba, 0x7c, 0x18, 0x48, 0x6a, 0x05,
+	0xc3, 0x07, 0x24, 0xf4, 0x0b, 0x55, 0x3b, 0xed, 0xbe, 0xb5, 0x5e, 0xf7, 0x56, 0x35, 0xfe, 0x38,
+	0x87, 0xd1, 0x6d, 0xb8, 0x38, 0xc9, 0x30, 0xc3, 0xb1, 0x20, 0xa4, 0xe2, 0xdd, 0x51, 0xde, 0xa8,
+	0x30, 0x95, 0x1d, 0x6e, 0xc2, 0x05, 0xe9, 0x96, 0x64, 0xa2, 0xe2, 0xbe, 0xa2, 0xdc, 0x7b, 0xc6,
+	0x50, 0x3a, 0xbf, 0x05, 0x3d, 0x72, 0x94, 0x52, 0x56, 0x1d, 0xba, 0xab, 0x27, 0xa2, 0xf1, 0xc2,
+	0xd5, 0xfd, 0x7d, 0x45, 0x52, 0x32, 0xfa, 0xfc, 0x1c, 0x92, 0x3a, 0xcf, 0xcd, 0x67, 0xa1, 0x0e,
+	0xed, 0xc5, 0x3a, 0xbc, 0x01, 0xed, 0x88, 0x08, 0x46, 0xc7, 0x3a, 0xde, 0x3a, 0xf9, 0x81, 0x86,
+	0x54, 0x50, 0x6f, 0x40, 0x3b, 0xce, 0x22, 0xff, 0xd3, 0x8c, 0x30, 0x4a, 0xb8, 0x39, 0x3b, 0x20,
+	0xce, 0xa2, 0x9f, 0x68, 0x04, 0x5d, 0x84, 0x25, 0x91, 0xa4, 0xfe, 0x7e, 0x9e, 0xf3, 0x44, 0x92,
+	0x3e, 0x40, 0xdf, 0x83, 0x2b, 0x9c, 0xe0, 0x90, 0x04, 0x7e, 0x91, 0xa3, 0xb8, 0xcf, 0x15, 0x17,
+	0x24, 0x70, 0x1a, 0x2a, 0xc4, 0x8e, 0xf6, 0xd8, 0x29, 0x1c, 0x76, 0x8c, 0x5d, 0x46, 0xb0, 0x98,
+	0x78, 0xa5, 0x5b, 0x53, 0x5d, 0x0f, 0x50, 0x69, 0x2a, 0x3a, 0x7c, 0x08, 0xce, 0x24, 0x4c, 0x46,
+	0x38, 0xf4, 0x8f, 0xbd, 0x55, 0xdd, 0x43, 0x6c, 0xef, 0x92, 0xb6, 0xef, 0xcc, 0xbd, 0x52, 0x2e,
+	0x8f, 0x87, 0x74, 0x4c, 0x02, 0x7f, 0x14, 0x26, 0x23, 0x07, 0x94, 0x54, 0x41, 0x43, 0x32, 0xe9,
+	0x49, 0x89, 0x1a, 0x07, 0x49, 0xc3, 0x38, 0xc9, 0x62, 0xa1, 0x84, 0x67, 0x7b, 0x5d, 0x8d, 0x3f,
+	0xca, 0xa2, 0x2d, 0x89, 0xa2, 0xd7, 0x61, 0xc5, 0x78, 0x26, 0xbb, 0xbb, 0x9c, 0x08, 0xa5, 0x38,
+	0xdb, 0xeb, 0x68, 0xf0, 0xc7, 0x0a, 0x73, 0xff, 0x65, 0xc3, 0xaa, 0x27, 0xd9, 0x25, 0x07, 0xe4,
+	0xff, 0x3e, 0xd1, 0x9c, 0xb4, 0xe1, 0x97, 0x9f, 0x6b, 0xc3, 0x37, 0xce, 0xbc, 0xe1, 0x9b, 0xcf,
+	0xb5, 0xe1, 0x5b, 0xcf, 0xb7, 0xe1, 0xe1, 0x84, 0x0d, 0xbf, 0x06, 0x4b, 0x21, 0x8d, 0x68, 0x1e,
+	0x75, 0xdd, 0x58, 0x98, 0x06, 0x3a, 0x8b, 0xd3, 0xc0, 0x9f, 0x67, 0x42, 0xfe, 0xa2, 0x26, 0x82,
+	0xb7, 0xc1, 0xa6, 0x81, 0x2e, 0x6d, 0xdb, 0x1b, 0xce, 0xec, 0xe0, 0xe6, 0x13, 0xe4, 0x70, 0xc0,
+	0x3d, 0xe9, 0x84, 0xee, 0x42, 0xdb, 0x84, 0x4f, 0x9d, 0xa5, 0x4b, 0xea, 0x64, 0x7f, 0x75, 0x61,
+	0x1f, 0x15, 0x4f, 0x75, 0xa4, 0xeb, 0xd2, 0x94, 0xcb, 0x67, 0xf4, 0x7d, 0xb8, 0x7a, 0x3c, 0x3d,
+	0x30, 0xc3, 0x51, 0xe0, 0x2c, 0x2b, 0x45, 0x5c, 0x9e, 0xcf, 0x0f, 0x39, 0x89, 0x01, 0xfa, 0x16,
+	0xac, 0x55, 0x12, 0x44, 0xd9, 0xb1, 0xa1, 0xbf, 0x39, 0x94, 0xb6, 0xb2, 0xcb, 0x69, 0x29, 0xa2,
+	0x79, 0x5a, 0x8a, 0x70, 0xff, 0x6d, 0x41, 0x77, 0x28, 0x08, 0xc3, 0x22, 0x61, 0x5b, 0x19, 0xe3,
+	0x09, 0x5b, 0x28, 0x4e, 0x6b, 0xb1, 0x38, 0xfb, 0xd0, 0x0e, 0x31, 0x17, 0x3e, 0x8d, 0x85, 0x9f,
+	0xee, 0xab, 0xe0, 0xd9, 0x1f, 0xbf, 0xe4, 0xb5, 0x24, 0x38, 0x8c, 0xc5, 0xf6, 0x7e, 0xe1, 0xc1,
+	0x05, 0x93, 0x1e, 0xea, 0xd8, 0xce, 0x3d, 0x76, 0x04, 0xdb, 0xde, 0x97, 0x99, 0x45, 0x79, 0x04,
+	0x94, 0x0b, 0x1c, 0x8f, 0x75, 0x11, 0x5b, 0xf3, 0x3a, 0x12, 0x1c, 0x18, 0x4c, 0x16, 0x65, 0x8c,
+	0x88, 0x8c, 0xc5, 0x24, 0x30, 0x69, 0x4a, 0x6f, 0xeb, 0x95, 0x1c, 0xd5, 0x59, 0xea, 0x2a, 0xb4,
+	0x04, 0x25, 0xc6, 0x43, 0x67, 0xf3, 0xa6, 0xa0, 0x44, 0x19, 0x37, 0x5b, 0xd0, 0x50, 0x2f, 0x4a,
+	0xf7, 0xdd, 0x5f, 0xd9, 0xb0, 0x32, 0x20, 0x21, 0x11, 0xe4, 0xeb, 0xa2, 0xfc, 0xc4, 0xa2, 0xfc,
+	0x59, 0x65, 0xf7, 0x77, 0xa1, 0x93, 0x32, 0x1a, 0x61, 0x36, 0xf5, 0xf7, 0xc9, 0x94, 0x3b, 0xed,
+	0x67, 0xec, 0xae, 0xb6, 0xf1, 0x7e, 0x40, 0xa6, 0xfc, 0x47, 0xf5, 0x66, 0xab, 0x07, 0xee, 0x7f,
+	0x2c, 0x68, 0x7d, 0x92, 0xe0, 0x40, 0x5d, 0x2e, 0xcf, 0x19, 0x83, 0xe2, 0xde, 0x50, 0x9b, 0xbf,
+	0x37, 0x5c, 0x83, 0xf2, 0x7e, 0x68, 0xa2, 0x50, 0x02, 0xd5, 0x62, 0xba, 0x3e, 0x5b, 0x4c, 0xdf,
+	0x80, 0x36, 0x95, 0x13, 0xf2, 0x53, 0x2c, 0xf6, 0xf4, 0xe1, 0xd0, 0xf2, 0x40, 0x41, 0xdb, 0x12,
+	0x91, 0x37, 0xc3, 0xdc, 0x41, 0xdd, 0x0c, 0x97, 0xcf, 0x7c, 0x33, 0x34, 0x83, 0xa8, 0x9b, 0xe1,
+	0x67, 0x96, 0xfc, 0x14, 0x1d, 0x90, 0x23, 0x99, 0xd7, 0x8e, 0x0f, 0x6a, 0x9d, 0x67, 0x50, 0x79,
+	0x6a, 0xc9, 0xa3, 0x9c, 0x91, 0x10, 0x8b, 0x32, 0x0f, 0x70, 0x43, 0x0e, 0x8a, 0xb3, 0xc8, 0xd3,
+	0x26, 0x93, 0x03, 0xb8, 0xfb, 0x3b, 0x0b, 0x40, 0x25, 0x32, 0x3d, 0x8d, 0x79, 0xed, 0x58, 0xa7,
+	0xdf, 0x99, 0x6b, 0xb3, 0xd4, 0x6d, 0xe6, 0xd4, 0x71, 0x39, 0x98, 0x63, 0x2f, 0x5a, 0x43, 0x71,
+	0x2d, 0x2a, 0x17, 0x6f, 0xd8, 0x55, 0xcf, 0xee, 0x1f, 0x2c, 0xe8, 0x98, 0xd9, 0xe9, 0x29, 0xcd,
+	0x44, 0xd9, 0x9a, 0x8f, 0xb2, 0x2a, 0xf2, 0xa2, 0x84, 0x4d, 0x7d, 0x4e, 0x9f, 0x12, 0x33, 0x21,
+	0xd0, 0xd0, 0x0e, 0x7d, 0x4a, 0xd0, 0x65, 0x68, 0x2a, 0x4a, 0x92, 0x43, 0x6e, 0x0a, 0x85, 0x86,
+	0xa4, 0x21, 0x39, 0xe4, 0xf2, 0xac, 0x64, 0x64, 0x4c, 0x62, 0x11, 0x4e, 0xfd, 0x28, 0x09, 0xe8,
+	0x2e, 0x25, 0x81, 0x52, 0x43, 0xd3, 0xeb, 0xe5, 0x86, 0x87, 0x06, 0x77, 0xff, 0x6e, 0x41, 0x57,
+	0xd6, 0x85, 0x53, 0xf9, 0x5f, 0x42, 0xcf, 0xec, 0xf9, 0x15, 0xfb, 0x91, 0x5a, 0x8b, 0xa1, 0x47,
+	0xff, 0x55, 0x78, 0xfd, 0xa4, 0x9f, 0x54, 0x15, 0x0e, 0xbc, 0x26, 0x27, 0x13, 0xfd, 0xce, 0x4d,
+	0x73, 0x3e, 0x9d, 0x89, 0xe2, 0x32, 0xb0, 0xe6, 0x88, 0xd2, 0x14, 0xff, 0xd2, 0x82, 0xf6, 0x43,
+	0x3e, 0xd9, 0x4e, 0xb8, 0xda, 0xec, 0xe8, 0x35, 0xe8, 0x98, 0x63, 0x45, 0x67, 0x1a, 0x4b, 0x6d,
+	0x96, 0xf6, 0xb8, 0xfc, 0x46, 0x2d, 0x2b, 0x85, 0x88, 0x4f, 0x4c, 0xc4, 0x3b, 0x9e, 0x6e, 0xc8,
+	0xfb, 0x6b, 0xc4, 0x27, 0xea, 0x6a, 0x65, 0x76, 0x58, 0xd1, 0x96, 0x61, 0x2b, 0x0f, 0x90, 0xba,
+	0x3a, 0x40, 0x4a, 0xc0, 0xfd, 0x5c, 0x7e, 0x0f, 0xd4, 0xe3, 0x7f, 0xa1, 0x1f, 0x19, 0x4a, 0xb0,
+	0xd5, 0xef, 0xec, 0x35, 0xb5, 0x5d, 0x67, 0xb0, 0xb9, 0x54, 0x66, 0x1f, 0x4b, 0x65, 0x37, 0xe1,
+	0x42, 0x40, 0x76, 0xb1, 0xac, 0x25, 0xe6, 0xa7, 0xdc, 0x33, 0x86, 0xb2, 0xe4, 0xf9, 0xcc, 0x82,
+	0xee, 0x16, 0x23, 0x01, 0x89, 0x05, 0xc5, 0xa1, 0xfa, 0x41, 0x75, 0x05, 0x9a, 0x19, 0x27, 0xac,
+	0xc2, 0x5d, 0xd1, 0x46, 0xef, 0x00, 0x22, 0xf1, 0x98, 0x4d, 0x53, 0xb9, 0x1f, 0x53, 0xcc, 0xf9,
+	0x61, 0xc2, 0x02, 0x73, 0x60, 0x5c, 0x28, 0x2c, 0xdb, 0xc6, 0x20, 0xcf, 0x07, 0xbe, 0x87, 0x37,
+	0xde, 0x7b, 0xbf, 0xf4, 0x35, 0xdf, 0xc3, 0x34, 0x9c, 0x3b, 0xbe, 0xfd, 0x21, 0xb4, 0x8a, 0xdf,
+	0x98, 0xa8, 0x07, 0x1d, 0xf9, 0x57, 0x4b, 0x55, 0xa4, 0x34, 0x9e, 0xf4, 0x5e, 0x42, 0x6d, 0x68,
+	0x7c, 0x4c, 0x70, 0x28, 0xf6, 0xa6, 0x3d, 0x0b, 0x75, 0xa0, 0x79, 0x6f, 0x14, 0x27, 0x2c, 0xc2,
+	0x61, 0xaf, 0xb6, 0xf9, 0xc1, 0xcf, 0xdf, 0x9b, 0x50, 0xb1, 0x97, 0x8d, 0x24, 0xa1, 0xb7, 0x35,
+	0xc3, 0xef, 0xd0, 0xc4, 0x3c, 0xdd, 0xce, 0xc5, 0x73, 0x5b, 0x91, 0x5e, 0x34, 0xd3, 0xd1, 0x68,
+	0x59, 0x21, 0xef, 0xfe, 0x77, 0x00, 0x6a, 0xe3, 0x41, 0x15, 0xec, 0x1d, 0x00, 0x00,
 }
@@ -83,6 +83,31 @@ func (PlaceholderType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{1}
 }
 
+type OperateUserRoleType int32
+
+const (
+	OperateUserRoleType_AddUserToRole      OperateUserRoleType = 0
+	OperateUserRoleType_RemoveUserFromRole OperateUserRoleType = 1
+)
+
+var OperateUserRoleType_name = map[int32]string{
+	0: "AddUserToRole",
+	1: "RemoveUserFromRole",
+}
+
+var OperateUserRoleType_value = map[string]int32{
+	"AddUserToRole":      0,
+	"RemoveUserFromRole": 1,
+}
+
+func (x OperateUserRoleType) String() string {
+	return proto.EnumName(OperateUserRoleType_name, int32(x))
+}
+
+func (OperateUserRoleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{2}
+}
+
 type CreateAliasRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
@@ -265,580 +290,574 @@ func (m *AlterAliasRequest) GetAlias() string {
 }
 
 //*
-// Create collection in milvus
-type CreateCollectionRequest struct {
+// Rename a collection, aliases of the collection keep pointing at it
+type RenameCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The unique collection name in milvus.(Required)
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The serialized `schema.CollectionSchema`(Required)
-	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
-	// Once set, no modification is allowed (Optional)
-	// https://github.com/milvus-io/milvus/issues/6690
-	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
+	// The current collection name.(Required)
+	OldName string `protobuf:"bytes,3,opt,name=old_name,json=oldName,proto3" json:"old_name,omitempty"`
+	// The new collection name, must not collide with existing collection names or aliases.(Required)
+	NewName              string   `protobuf:"bytes,4,opt,name=new_name,json=newName,proto3" json:"new_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
-func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCollectionRequest) ProtoMessage()    {}
-func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *RenameCollectionRequest) Reset()         { *m = RenameCollectionRequest{} }
+func (m *RenameCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*RenameCollectionRequest) ProtoMessage()    {}
+func (*RenameCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{3}
 }
 
-func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+func (m *RenameCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RenameCollectionRequest.Unmarshal(m, b)
 }
-func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+func (m *RenameCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RenameCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+func (m *RenameCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RenameCollectionRequest.Merge(m, src)
 }
-func (m *CreateCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+func (m *RenameCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_RenameCollectionRequest.Size(m)
 }
-func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+func (m *RenameCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RenameCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_RenameCollectionRequest proto.InternalMessageInfo
 
-func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *RenameCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateCollectionRequest) GetDbName() string {
+func (m *RenameCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetCollectionName() string {
+func (m *RenameCollectionRequest) GetOldName() string {
 	if m != nil {
-		return m.CollectionName
+		return m.OldName
 	}
 	return ""
 }
 
-func (m *CreateCollectionRequest) GetSchema() []byte {
-	if m != nil {
-		return m.Schema
-	}
-	return nil
-}
-
-func (m *CreateCollectionRequest) GetShardsNum() int32 {
-	if m != nil {
-		return m.ShardsNum
-	}
-	return 0
-}
-
-func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
+func (m *RenameCollectionRequest) GetNewName() string {
 	if m != nil {
-		return m.ConsistencyLevel
+		return m.NewName
 	}
-	return commonpb.ConsistencyLevel_Strong
+	return ""
 }
 
 //*
-// Drop collection in milvus, also will drop data in collection.
-type DropCollectionRequest struct {
+// Alter the properties of an existing collection
+type AlterCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	// The unique collection name in milvus.(Required)
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The properties to set, an existing property with the same key is overwritten, an empty value removes the property.(Required)
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
-func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropCollectionRequest) ProtoMessage()    {}
-func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *AlterCollectionRequest) Reset()         { *m = AlterCollectionRequest{} }
+func (m *AlterCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*AlterCollectionRequest) ProtoMessage()    {}
+func (*AlterCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{4}
 }
 
-func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
+func (m *AlterCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AlterCollectionRequest.Unmarshal(m, b)
 }
-func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
+func (m *AlterCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AlterCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
+func (m *AlterCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AlterCollectionRequest.Merge(m, src)
 }
-func (m *DropCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropCollectionRequest.Size(m)
+func (m *AlterCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_AlterCollectionRequest.Size(m)
 }
-func (m *DropCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
+func (m *AlterCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AlterCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AlterCollectionRequest proto.InternalMessageInfo
 
-func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AlterCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropCollectionRequest) GetDbName() string {
+func (m *AlterCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropCollectionRequest) GetCollectionName() string {
+func (m *AlterCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
+func (m *AlterCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 //*
-// Check collection exist in milvus or not.
-type HasCollectionRequest struct {
+// Add a scalar field to an existing collection, the rows inserted before take the default value of the field
+type AddFieldRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to check.
+	// The unique collection name in milvus.(Required)
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
-	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	// The schema of the new field, the default value is required and the field id is assigned by milvus.(Required)
+	Field                *schemapb.FieldSchema `protobuf:"bytes,4,opt,name=field,proto3" json:"field,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
-func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasCollectionRequest) ProtoMessage()    {}
-func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *AddFieldRequest) Reset()         { *m = AddFieldRequest{} }
+func (m *AddFieldRequest) String() string { return proto.CompactTextString(m) }
+func (*AddFieldRequest) ProtoMessage()    {}
+func (*AddFieldRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{5}
 }
 
-func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
+func (m *AddFieldRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddFieldRequest.Unmarshal(m, b)
 }
-func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
+func (m *AddFieldRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddFieldRequest.Marshal(b, m, deterministic)
 }
-func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
+func (m *AddFieldRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddFieldRequest.Merge(m, src)
 }
-func (m *HasCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasCollectionRequest.Size(m)
+func (m *AddFieldRequest) XXX_Size() int {
+	return xxx_messageInfo_AddFieldRequest.Size(m)
 }
-func (m *HasCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
+func (m *AddFieldRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddFieldRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_AddFieldRequest proto.InternalMessageInfo
 
-func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *AddFieldRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasCollectionRequest) GetDbName() string {
+func (m *AddFieldRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetCollectionName() string {
+func (m *AddFieldRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+func (m *AddFieldRequest) GetField() *schemapb.FieldSchema {
 	if m != nil {
-		return m.TimeStamp
+		return m.Field
 	}
-	return 0
+	return nil
 }
 
-type BoolResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+//*
+// Create a database, collections and aliases are scoped by database
+type CreateDatabaseRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The unique database name in milvus.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
-func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
-func (*BoolResponse) ProtoMessage()    {}
-func (*BoolResponse) Descriptor() ([]byte, []int) {
+func (m *CreateDatabaseRequest) Reset()         { *m = CreateDatabaseRequest{} }
+func (m *CreateDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDatabaseRequest) ProtoMessage()    {}
+func (*CreateDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{6}
 }
 
-func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
+func (m *CreateDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDatabaseRequest.Unmarshal(m, b)
 }
-func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
+func (m *CreateDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *BoolResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_BoolResponse.Merge(m, src)
+func (m *CreateDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDatabaseRequest.Merge(m, src)
 }
-func (m *BoolResponse) XXX_Size() int {
-	return xxx_messageInfo_BoolResponse.Size(m)
+func (m *CreateDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDatabaseRequest.Size(m)
 }
-func (m *BoolResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
+func (m *CreateDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateDatabaseRequest proto.InternalMessageInfo
 
-func (m *BoolResponse) GetStatus() *commonpb.Status {
+func (m *CreateDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *BoolResponse) GetValue() bool {
+func (m *CreateDatabaseRequest) GetDbName() string {
 	if m != nil {
-		return m.Value
+		return m.DbName
 	}
-	return false
+	return ""
 }
 
-type StringResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+//*
+// Drop a database, the database must not contain any collection
+type DropDatabaseRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The unique database name in milvus.(Required)
+	DbName               string   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *StringResponse) Reset()         { *m = StringResponse{} }
-func (m *StringResponse) String() string { return proto.CompactTextString(m) }
-func (*StringResponse) ProtoMessage()    {}
-func (*StringResponse) Descriptor() ([]byte, []int) {
+func (m *DropDatabaseRequest) Reset()         { *m = DropDatabaseRequest{} }
+func (m *DropDatabaseRequest) String() string { return proto.CompactTextString(m) }
+func (*DropDatabaseRequest) ProtoMessage()    {}
+func (*DropDatabaseRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{7}
 }
 
-func (m *StringResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
+func (m *DropDatabaseRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropDatabaseRequest.Unmarshal(m, b)
 }
-func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
+func (m *DropDatabaseRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropDatabaseRequest.Marshal(b, m, deterministic)
 }
-func (m *StringResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_StringResponse.Merge(m, src)
+func (m *DropDatabaseRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropDatabaseRequest.Merge(m, src)
 }
-func (m *StringResponse) XXX_Size() int {
-	return xxx_messageInfo_StringResponse.Size(m)
+func (m *DropDatabaseRequest) XXX_Size() int {
+	return xxx_messageInfo_DropDatabaseRequest.Size(m)
 }
-func (m *StringResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_StringResponse.DiscardUnknown(m)
+func (m *DropDatabaseRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropDatabaseRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_StringResponse proto.InternalMessageInfo
+var xxx_messageInfo_DropDatabaseRequest proto.InternalMessageInfo
 
-func (m *StringResponse) GetStatus() *commonpb.Status {
+func (m *DropDatabaseRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *StringResponse) GetValue() string {
+func (m *DropDatabaseRequest) GetDbName() string {
 	if m != nil {
-		return m.Value
+		return m.DbName
 	}
 	return ""
 }
 
 //*
-// Get collection meta datas like: schema, collectionID, shards number ...
-type DescribeCollectionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+// List all databases
+type ListDatabasesRequest struct {
 	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to describe, you can pass collection_name or collectionID
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The collection ID you want to describe
-	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// If time_stamp is not zero, will describe collection success when time_stamp >= created collection timestamp, otherwise will throw error.
-	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
-func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionRequest) ProtoMessage()    {}
-func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+func (m *ListDatabasesRequest) Reset()         { *m = ListDatabasesRequest{} }
+func (m *ListDatabasesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesRequest) ProtoMessage()    {}
+func (*ListDatabasesRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{8}
 }
 
-func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
+func (m *ListDatabasesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesRequest.Unmarshal(m, b)
 }
-func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
+func (m *ListDatabasesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
+func (m *ListDatabasesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesRequest.Merge(m, src)
 }
-func (m *DescribeCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
+func (m *ListDatabasesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesRequest.Size(m)
 }
-func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
+func (m *ListDatabasesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesRequest proto.InternalMessageInfo
 
-func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *ListDatabasesRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeCollectionRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
+type ListDatabasesResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Database name array
+	DbNames []string `protobuf:"bytes,2,rep,name=db_names,json=dbNames,proto3" json:"db_names,omitempty"`
+	// Database id array
+	DbIds []int64 `protobuf:"varint,3,rep,packed,name=db_ids,json=dbIds,proto3" json:"db_ids,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps    []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeCollectionRequest) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
+func (m *ListDatabasesResponse) Reset()         { *m = ListDatabasesResponse{} }
+func (m *ListDatabasesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListDatabasesResponse) ProtoMessage()    {}
+func (*ListDatabasesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{9}
 }
 
-func (m *DescribeCollectionRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
-	if m != nil {
-		return m.TimeStamp
-	}
-	return 0
-}
-
-//*
-// DescribeCollection Response
-type DescribeCollectionResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// The schema param when you created collection.
-	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
-	// The collection id
-	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// System design related, users should not perceive
-	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
-	// System design related, users should not perceive
-	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
-	// Hybrid timestamp in milvus
-	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
-	// The shards number you set.
-	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
-	// The aliases of this collection
-	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
-	// The message ID/posititon when collection is created
-	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
-	// The consistency level that the collection used, modification is not supported now.
-	ConsistencyLevel     commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
-	XXX_unrecognized     []byte                    `json:"-"`
-	XXX_sizecache        int32                     `json:"-"`
-}
-
-func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
-func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeCollectionResponse) ProtoMessage()    {}
-func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{9}
-}
-
-func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
+func (m *ListDatabasesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListDatabasesResponse.Unmarshal(m, b)
 }
-func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
+func (m *ListDatabasesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListDatabasesResponse.Marshal(b, m, deterministic)
 }
-func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
+func (m *ListDatabasesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListDatabasesResponse.Merge(m, src)
 }
-func (m *DescribeCollectionResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
+func (m *ListDatabasesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListDatabasesResponse.Size(m)
 }
-func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
+func (m *ListDatabasesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListDatabasesResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListDatabasesResponse proto.InternalMessageInfo
 
-func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
+func (m *ListDatabasesResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
+func (m *ListDatabasesResponse) GetDbNames() []string {
 	if m != nil {
-		return m.Schema
+		return m.DbNames
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCollectionID() int64 {
+func (m *ListDatabasesResponse) GetDbIds() []int64 {
 	if m != nil {
-		return m.CollectionID
+		return m.DbIds
 	}
-	return 0
+	return nil
 }
 
-func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
+func (m *ListDatabasesResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.VirtualChannelNames
+		return m.CreatedTimestamps
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
-	if m != nil {
-		return m.PhysicalChannelNames
-	}
-	return nil
+//*
+// Create collection in milvus
+type CreateCollectionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The unique collection name in milvus.(Required)
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The serialized `schema.CollectionSchema`(Required)
+	Schema []byte `protobuf:"bytes,4,opt,name=schema,proto3" json:"schema,omitempty"`
+	// Once set, no modification is allowed (Optional)
+	// https://github.com/milvus-io/milvus/issues/6690
+	ShardsNum int32 `protobuf:"varint,5,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The consistency level that the collection used, modification is not supported now.
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,6,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The properties of the collection, such as the ttl in "collection.ttl.seconds" (Optional)
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
+func (m *CreateCollectionRequest) Reset()         { *m = CreateCollectionRequest{} }
+func (m *CreateCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCollectionRequest) ProtoMessage()    {}
+func (*CreateCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{10}
+}
+
+func (m *CreateCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCollectionRequest.Unmarshal(m, b)
+}
+func (m *CreateCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCollectionRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCollectionRequest.Merge(m, src)
+}
+func (m *CreateCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCollectionRequest.Size(m)
+}
+func (m *CreateCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCollectionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCollectionRequest proto.InternalMessageInfo
+
+func (m *CreateCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CreatedTimestamp
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+func (m *CreateCollectionRequest) GetDbName() string {
 	if m != nil {
-		return m.CreatedUtcTimestamp
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+func (m *CreateCollectionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.ShardsNum
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeCollectionResponse) GetAliases() []string {
+func (m *CreateCollectionRequest) GetSchema() []byte {
 	if m != nil {
-		return m.Aliases
+		return m.Schema
 	}
 	return nil
 }
 
-func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
+func (m *CreateCollectionRequest) GetShardsNum() int32 {
 	if m != nil {
-		return m.StartPositions
+		return m.ShardsNum
 	}
-	return nil
+	return 0
 }
 
-func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
+func (m *CreateCollectionRequest) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	if m != nil {
 		return m.ConsistencyLevel
 	}
 	return commonpb.ConsistencyLevel_Strong
 }
 
+func (m *CreateCollectionRequest) GetProperties() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Properties
+	}
+	return nil
+}
+
 //*
-// Load collection data into query nodes, then you can do vector search on this collection.
-type LoadCollectionRequest struct {
+// Drop collection in milvus, also will drop data in collection.
+type DropCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to load
+	// The unique collection name in milvus.(Required)
 	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
-func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadCollectionRequest) ProtoMessage()    {}
-func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{10}
+func (m *DropCollectionRequest) Reset()         { *m = DropCollectionRequest{} }
+func (m *DropCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropCollectionRequest) ProtoMessage()    {}
+func (*DropCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{11}
 }
 
-func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
+func (m *DropCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropCollectionRequest.Unmarshal(m, b)
 }
-func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
+func (m *DropCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
+func (m *DropCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropCollectionRequest.Merge(m, src)
 }
-func (m *LoadCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadCollectionRequest.Size(m)
+func (m *DropCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropCollectionRequest.Size(m)
 }
-func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
+func (m *DropCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropCollectionRequest proto.InternalMessageInfo
 
-func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *DropCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadCollectionRequest) GetDbName() string {
+func (m *DropCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *LoadCollectionRequest) GetCollectionName() string {
+func (m *DropCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
@@ -846,4086 +865,5708 @@ func (m *LoadCollectionRequest) GetCollectionName() string {
 }
 
 //*
-// Release collection data from query nodes, then you can't do vector search on this collection.
-type ReleaseCollectionRequest struct {
+// Check collection exist in milvus or not.
+type HasCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to release
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection name you want to check.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// If time_stamp is not zero, will return true when time_stamp >= created collection timestamp, otherwise will return false.
+	TimeStamp            uint64   `protobuf:"varint,4,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
-func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleaseCollectionRequest) ProtoMessage()    {}
-func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{11}
+func (m *HasCollectionRequest) Reset()         { *m = HasCollectionRequest{} }
+func (m *HasCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasCollectionRequest) ProtoMessage()    {}
+func (*HasCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{12}
 }
 
-func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
+func (m *HasCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasCollectionRequest.Unmarshal(m, b)
 }
-func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
+func (m *HasCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
+func (m *HasCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasCollectionRequest.Merge(m, src)
 }
-func (m *ReleaseCollectionRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
+func (m *HasCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasCollectionRequest.Size(m)
 }
-func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
+func (m *HasCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
+var xxx_messageInfo_HasCollectionRequest proto.InternalMessageInfo
 
-func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
+func (m *HasCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleaseCollectionRequest) GetDbName() string {
+func (m *HasCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ReleaseCollectionRequest) GetCollectionName() string {
+func (m *HasCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-//*
-// Get collection statistics like row_count.
-type GetCollectionStatisticsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want get statistics
-	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
-func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
-func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{12}
+func (m *HasCollectionRequest) GetTimeStamp() uint64 {
+	if m != nil {
+		return m.TimeStamp
+	}
+	return 0
 }
 
-func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
+type BoolResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                bool             `protobuf:"varint,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
-func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
+
+func (m *BoolResponse) Reset()         { *m = BoolResponse{} }
+func (m *BoolResponse) String() string { return proto.CompactTextString(m) }
+func (*BoolResponse) ProtoMessage()    {}
+func (*BoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{13}
 }
-func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
+
+func (m *BoolResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BoolResponse.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
+func (m *BoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BoolResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
+func (m *BoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BoolResponse.Merge(m, src)
+}
+func (m *BoolResponse) XXX_Size() int {
+	return xxx_messageInfo_BoolResponse.Size(m)
+}
+func (m *BoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BoolResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_BoolResponse proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *BoolResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetCollectionStatisticsRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
+func (m *BoolResponse) GetValue() bool {
 	if m != nil {
-		return m.CollectionName
+		return m.Value
 	}
-	return ""
+	return false
 }
 
-//*
-// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
-type GetCollectionStatisticsResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection statistics data
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type StringResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Value                string           `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
-func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
-func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{13}
+func (m *StringResponse) Reset()         { *m = StringResponse{} }
+func (m *StringResponse) String() string { return proto.CompactTextString(m) }
+func (*StringResponse) ProtoMessage()    {}
+func (*StringResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{14}
 }
 
-func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
+func (m *StringResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StringResponse.Unmarshal(m, b)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
+func (m *StringResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StringResponse.Marshal(b, m, deterministic)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
+func (m *StringResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StringResponse.Merge(m, src)
 }
-func (m *GetCollectionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
+func (m *StringResponse) XXX_Size() int {
+	return xxx_messageInfo_StringResponse.Size(m)
 }
-func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
+func (m *StringResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_StringResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
+var xxx_messageInfo_StringResponse proto.InternalMessageInfo
 
-func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *StringResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *StringResponse) GetValue() string {
 	if m != nil {
-		return m.Stats
+		return m.Value
 	}
-	return nil
+	return ""
 }
 
-//
-// List collections
-type ShowCollectionsRequest struct {
+//*
+// Get collection meta datas like: schema, collectionID, shards number ...
+type DescribeCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// Not useful for now
-	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
-	// Decide return Loaded collections or All collections(Optional)
-	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
-	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	// The collection name you want to describe, you can pass collection_name or collectionID
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection ID you want to describe
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// If time_stamp is not zero, will describe collection success when time_stamp >= created collection timestamp, otherwise will throw error.
+	TimeStamp            uint64   `protobuf:"varint,5,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
-func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsRequest) ProtoMessage()    {}
-func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{14}
+func (m *DescribeCollectionRequest) Reset()         { *m = DescribeCollectionRequest{} }
+func (m *DescribeCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionRequest) ProtoMessage()    {}
+func (*DescribeCollectionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{15}
 }
 
-func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
+func (m *DescribeCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionRequest.Unmarshal(m, b)
 }
-func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
+func (m *DescribeCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
+func (m *DescribeCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionRequest.Merge(m, src)
 }
-func (m *ShowCollectionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
+func (m *DescribeCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionRequest.Size(m)
 }
-func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
+func (m *DescribeCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionRequest proto.InternalMessageInfo
 
-func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowCollectionsRequest) GetDbName() string {
+func (m *DescribeCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
+func (m *DescribeCollectionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.TimeStamp
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *ShowCollectionsRequest) GetType() ShowType {
+func (m *DescribeCollectionRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.Type
+		return m.CollectionID
 	}
-	return ShowType_All
+	return 0
 }
 
-func (m *ShowCollectionsRequest) GetCollectionNames() []string {
+func (m *DescribeCollectionRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.CollectionNames
+		return m.TimeStamp
 	}
-	return nil
+	return 0
 }
 
-//
-// Return basic collection infos.
-type ShowCollectionsResponse struct {
+//*
+// DescribeCollection Response
+type DescribeCollectionResponse struct {
 	// Contain error_code and reason
 	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// Collection name array
-	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
-	// Collection Id array
-	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
-	// Hybrid timestamps in milvus
-	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// The schema param when you created collection.
+	Schema *schemapb.CollectionSchema `protobuf:"bytes,2,opt,name=schema,proto3" json:"schema,omitempty"`
+	// The collection id
+	CollectionID int64 `protobuf:"varint,3,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// System design related, users should not perceive
+	VirtualChannelNames []string `protobuf:"bytes,4,rep,name=virtual_channel_names,json=virtualChannelNames,proto3" json:"virtual_channel_names,omitempty"`
+	// System design related, users should not perceive
+	PhysicalChannelNames []string `protobuf:"bytes,5,rep,name=physical_channel_names,json=physicalChannelNames,proto3" json:"physical_channel_names,omitempty"`
+	// Hybrid timestamp in milvus
+	CreatedTimestamp uint64 `protobuf:"varint,6,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
 	// The utc timestamp calculated by created_timestamp
-	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// Load percentage on querynode when type is InMemory
-	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	CreatedUtcTimestamp uint64 `protobuf:"varint,7,opt,name=created_utc_timestamp,json=createdUtcTimestamp,proto3" json:"created_utc_timestamp,omitempty"`
+	// The shards number you set.
+	ShardsNum int32 `protobuf:"varint,8,opt,name=shards_num,json=shardsNum,proto3" json:"shards_num,omitempty"`
+	// The aliases of this collection
+	Aliases []string `protobuf:"bytes,9,rep,name=aliases,proto3" json:"aliases,omitempty"`
+	// The message ID/posititon when collection is created
+	StartPositions []*commonpb.KeyDataPair `protobuf:"bytes,10,rep,name=start_positions,json=startPositions,proto3" json:"start_positions,omitempty"`
+	// The consistency level that the collection used, modification is not supported now.
+	ConsistencyLevel commonpb.ConsistencyLevel `protobuf:"varint,11,opt,name=consistency_level,json=consistencyLevel,proto3,enum=milvus.proto.common.ConsistencyLevel" json:"consistency_level,omitempty"`
+	// The database id that the collection belongs to
+	DbId int64 `protobuf:"varint,12,opt,name=db_id,json=dbId,proto3" json:"db_id,omitempty"`
+	// The properties of the collection
+	Properties           []*commonpb.KeyValuePair `protobuf:"bytes,13,rep,name=properties,proto3" json:"properties,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
-func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowCollectionsResponse) ProtoMessage()    {}
-func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{15}
+func (m *DescribeCollectionResponse) Reset()         { *m = DescribeCollectionResponse{} }
+func (m *DescribeCollectionResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeCollectionResponse) ProtoMessage()    {}
+func (*DescribeCollectionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{16}
 }
 
-func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
+func (m *DescribeCollectionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeCollectionResponse.Unmarshal(m, b)
 }
-func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
+func (m *DescribeCollectionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeCollectionResponse.Marshal(b, m, deterministic)
 }
-func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
+func (m *DescribeCollectionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeCollectionResponse.Merge(m, src)
 }
-func (m *ShowCollectionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
+func (m *DescribeCollectionResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeCollectionResponse.Size(m)
 }
-func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
+func (m *DescribeCollectionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeCollectionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeCollectionResponse proto.InternalMessageInfo
 
-func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
+func (m *DescribeCollectionResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCollectionNames() []string {
+func (m *DescribeCollectionResponse) GetSchema() *schemapb.CollectionSchema {
 	if m != nil {
-		return m.CollectionNames
+		return m.Schema
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
+func (m *DescribeCollectionResponse) GetCollectionID() int64 {
 	if m != nil {
-		return m.CollectionIds
+		return m.CollectionID
 	}
-	return nil
+	return 0
 }
 
-func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *DescribeCollectionResponse) GetVirtualChannelNames() []string {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.VirtualChannelNames
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *DescribeCollectionResponse) GetPhysicalChannelNames() []string {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.PhysicalChannelNames
 	}
 	return nil
 }
 
-func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
+func (m *DescribeCollectionResponse) GetCreatedTimestamp() uint64 {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.CreatedTimestamp
 	}
-	return nil
+	return 0
 }
 
-//
-// Create partition in created collection.
-type CreatePartitionRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to create.
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DescribeCollectionResponse) GetCreatedUtcTimestamp() uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamp
+	}
+	return 0
 }
 
-func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
-func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreatePartitionRequest) ProtoMessage()    {}
-func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{16}
+func (m *DescribeCollectionResponse) GetShardsNum() int32 {
+	if m != nil {
+		return m.ShardsNum
+	}
+	return 0
 }
 
-func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
-}
-func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
-}
-func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
-}
-func (m *CreatePartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreatePartitionRequest.Size(m)
-}
-func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
+func (m *DescribeCollectionResponse) GetAliases() []string {
+	if m != nil {
+		return m.Aliases
+	}
+	return nil
 }
 
-var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
-
-func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeCollectionResponse) GetStartPositions() []*commonpb.KeyDataPair {
 	if m != nil {
-		return m.Base
+		return m.StartPositions
 	}
 	return nil
 }
 
-func (m *CreatePartitionRequest) GetDbName() string {
+func (m *DescribeCollectionResponse) GetConsistencyLevel() commonpb.ConsistencyLevel {
 	if m != nil {
-		return m.DbName
+		return m.ConsistencyLevel
 	}
-	return ""
+	return commonpb.ConsistencyLevel_Strong
 }
 
-func (m *CreatePartitionRequest) GetCollectionName() string {
+func (m *DescribeCollectionResponse) GetDbId() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.DbId
 	}
-	return ""
+	return 0
 }
 
-func (m *CreatePartitionRequest) GetPartitionName() string {
+func (m *DescribeCollectionResponse) GetProperties() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.PartitionName
+		return m.Properties
 	}
-	return ""
+	return nil
 }
 
-//
-// Drop partition in created collection.
-type DropPartitionRequest struct {
+//*
+// Load collection data into query nodes, then you can do vector search on this collection.
+type LoadCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to drop
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The collection name you want to load
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
-func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*DropPartitionRequest) ProtoMessage()    {}
-func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *LoadCollectionRequest) Reset()         { *m = LoadCollectionRequest{} }
+func (m *LoadCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadCollectionRequest) ProtoMessage()    {}
+func (*LoadCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{17}
 }
 
-func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
+func (m *LoadCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadCollectionRequest.Unmarshal(m, b)
 }
-func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
+func (m *LoadCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
+func (m *LoadCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadCollectionRequest.Merge(m, src)
 }
-func (m *DropPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_DropPartitionRequest.Size(m)
+func (m *LoadCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadCollectionRequest.Size(m)
 }
-func (m *DropPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
+func (m *LoadCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_LoadCollectionRequest proto.InternalMessageInfo
 
-func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *LoadCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropPartitionRequest) GetDbName() string {
+func (m *LoadCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetCollectionName() string {
+func (m *LoadCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-//
-// Check if partition exist in collection or not.
-type HasPartitionRequest struct {
+//*
+// Release collection data from query nodes, then you can't do vector search on this collection.
+type ReleaseCollectionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to check
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// The collection name you want to release
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
-func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
-func (*HasPartitionRequest) ProtoMessage()    {}
-func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
+func (m *ReleaseCollectionRequest) Reset()         { *m = ReleaseCollectionRequest{} }
+func (m *ReleaseCollectionRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseCollectionRequest) ProtoMessage()    {}
+func (*ReleaseCollectionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{18}
 }
 
-func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
+func (m *ReleaseCollectionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseCollectionRequest.Unmarshal(m, b)
 }
-func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
+func (m *ReleaseCollectionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseCollectionRequest.Marshal(b, m, deterministic)
 }
-func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
+func (m *ReleaseCollectionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseCollectionRequest.Merge(m, src)
 }
-func (m *HasPartitionRequest) XXX_Size() int {
-	return xxx_messageInfo_HasPartitionRequest.Size(m)
+func (m *ReleaseCollectionRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseCollectionRequest.Size(m)
 }
-func (m *HasPartitionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
+func (m *ReleaseCollectionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseCollectionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleaseCollectionRequest proto.InternalMessageInfo
 
-func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleaseCollectionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *HasPartitionRequest) GetDbName() string {
+func (m *ReleaseCollectionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetCollectionName() string {
+func (m *ReleaseCollectionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *HasPartitionRequest) GetPartitionName() string {
-	if m != nil {
-		return m.PartitionName
-	}
-	return ""
-}
-
-//
-// Load specific partitions data of one collection into query nodes
-// Then you can get these data as result when you do vector search on this collection.
-type LoadPartitionsRequest struct {
+//*
+// Get collection statistics like row_count.
+type GetCollectionStatisticsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to load
-	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// The collection name you want get statistics
+	CollectionName       string   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
-func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadPartitionsRequest) ProtoMessage()    {}
-func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
+func (m *GetCollectionStatisticsRequest) Reset()         { *m = GetCollectionStatisticsRequest{} }
+func (m *GetCollectionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsRequest) ProtoMessage()    {}
+func (*GetCollectionStatisticsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{19}
 }
 
-func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
+func (m *GetCollectionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Unmarshal(m, b)
 }
-func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
+func (m *GetCollectionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
+func (m *GetCollectionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsRequest.Merge(m, src)
 }
-func (m *LoadPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
+func (m *GetCollectionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsRequest.Size(m)
 }
-func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
+func (m *GetCollectionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCollectionStatisticsRequest proto.InternalMessageInfo
 
-func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *GetCollectionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadPartitionsRequest) GetDbName() string {
+func (m *GetCollectionStatisticsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *LoadPartitionsRequest) GetCollectionName() string {
+func (m *GetCollectionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *LoadPartitionsRequest) GetPartitionNames() []string {
+//*
+// Will return collection statistics in stats field like [{key:"row_count",value:"1"}]
+type GetCollectionStatisticsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Collection statistics data
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCollectionStatisticsResponse) Reset()         { *m = GetCollectionStatisticsResponse{} }
+func (m *GetCollectionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCollectionStatisticsResponse) ProtoMessage()    {}
+func (*GetCollectionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{20}
+}
+
+func (m *GetCollectionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetCollectionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCollectionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCollectionStatisticsResponse.Merge(m, src)
+}
+func (m *GetCollectionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCollectionStatisticsResponse.Size(m)
+}
+func (m *GetCollectionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCollectionStatisticsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCollectionStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetCollectionStatisticsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.PartitionNames
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCollectionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Stats
 	}
 	return nil
 }
 
 //
-// Release specific partitions data of one collection from query nodes.
-// Then you can not get these data as result when you do vector search on this collection.
-type ReleasePartitionsRequest struct {
+// List collections
+type ShowCollectionsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition names you want to release
-	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// Not useful for now
+	TimeStamp uint64 `protobuf:"varint,3,opt,name=time_stamp,json=timeStamp,proto3" json:"time_stamp,omitempty"`
+	// Decide return Loaded collections or All collections(Optional)
+	Type ShowType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	// When type is InMemory, will return these collection's inMemory_percentages.(Optional)
+	CollectionNames      []string `protobuf:"bytes,5,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
-func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ReleasePartitionsRequest) ProtoMessage()    {}
-func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{20}
+func (m *ShowCollectionsRequest) Reset()         { *m = ShowCollectionsRequest{} }
+func (m *ShowCollectionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsRequest) ProtoMessage()    {}
+func (*ShowCollectionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{21}
 }
 
-func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
+func (m *ShowCollectionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsRequest.Unmarshal(m, b)
 }
-func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
+func (m *ShowCollectionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsRequest.Marshal(b, m, deterministic)
 }
-func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
+func (m *ShowCollectionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsRequest.Merge(m, src)
 }
-func (m *ReleasePartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
+func (m *ShowCollectionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsRequest.Size(m)
 }
-func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
+func (m *ShowCollectionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsRequest proto.InternalMessageInfo
 
-func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowCollectionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ReleasePartitionsRequest) GetDbName() string {
+func (m *ShowCollectionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ReleasePartitionsRequest) GetCollectionName() string {
+func (m *ShowCollectionsRequest) GetTimeStamp() uint64 {
 	if m != nil {
-		return m.CollectionName
+		return m.TimeStamp
 	}
-	return ""
+	return 0
 }
 
-func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
+func (m *ShowCollectionsRequest) GetType() ShowType {
 	if m != nil {
-		return m.PartitionNames
+		return m.Type
+	}
+	return ShowType_All
+}
+
+func (m *ShowCollectionsRequest) GetCollectionNames() []string {
+	if m != nil {
+		return m.CollectionNames
 	}
 	return nil
 }
 
 //
-// Get partition statistics like row_count.
-type GetPartitionStatisticsRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The partition name you want to collect statistics
-	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+// Return basic collection infos.
+type ShowCollectionsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Collection name array
+	CollectionNames []string `protobuf:"bytes,2,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	// Collection Id array
+	CollectionIds []int64 `protobuf:"varint,3,rep,packed,name=collection_ids,json=collectionIds,proto3" json:"collection_ids,omitempty"`
+	// Hybrid timestamps in milvus
+	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// The utc timestamp calculated by created_timestamp
+	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// Load percentage on querynode when type is InMemory
+	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
-func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
-func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{21}
+func (m *ShowCollectionsResponse) Reset()         { *m = ShowCollectionsResponse{} }
+func (m *ShowCollectionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowCollectionsResponse) ProtoMessage()    {}
+func (*ShowCollectionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{22}
 }
 
-func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
+func (m *ShowCollectionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowCollectionsResponse.Unmarshal(m, b)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
+func (m *ShowCollectionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowCollectionsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
+func (m *ShowCollectionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowCollectionsResponse.Merge(m, src)
 }
-func (m *GetPartitionStatisticsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
+func (m *ShowCollectionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowCollectionsResponse.Size(m)
 }
-func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
+func (m *ShowCollectionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowCollectionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowCollectionsResponse proto.InternalMessageInfo
 
-func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowCollectionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetDbName() string {
+func (m *ShowCollectionsResponse) GetCollectionNames() []string {
 	if m != nil {
-		return m.DbName
+		return m.CollectionNames
 	}
-	return ""
+	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
+func (m *ShowCollectionsResponse) GetCollectionIds() []int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.CollectionIds
 	}
-	return ""
+	return nil
 }
 
-func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
+func (m *ShowCollectionsResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.PartitionName
+		return m.CreatedTimestamps
 	}
-	return ""
-}
-
-type GetPartitionStatisticsResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
-func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
-func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{22}
-}
-
-func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
-}
-func (m *GetPartitionStatisticsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
-}
-func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
-
-func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+func (m *ShowCollectionsResponse) GetCreatedUtcTimestamps() []uint64 {
 	if m != nil {
-		return m.Status
+		return m.CreatedUtcTimestamps
 	}
 	return nil
 }
 
-func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+func (m *ShowCollectionsResponse) GetInMemoryPercentages() []int64 {
 	if m != nil {
-		return m.Stats
+		return m.InMemoryPercentages
 	}
 	return nil
 }
 
 //
-// List all partitions for particular collection
-type ShowPartitionsRequest struct {
+// Create partition in created collection.
+type CreatePartitionRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name you want to describe, you can pass collection_name or collectionID
+	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The collection id in milvus
-	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	// When type is InMemory, will return these patitions's inMemory_percentages.(Optional)
-	PartitionNames []string `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// Decide return Loaded partitions or All partitions(Optional)
-	Type                 ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	// The partition name you want to create.
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
-func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsRequest) ProtoMessage()    {}
-func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+func (m *CreatePartitionRequest) Reset()         { *m = CreatePartitionRequest{} }
+func (m *CreatePartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePartitionRequest) ProtoMessage()    {}
+func (*CreatePartitionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{23}
 }
 
-func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+func (m *CreatePartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePartitionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+func (m *CreatePartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+func (m *CreatePartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePartitionRequest.Merge(m, src)
 }
-func (m *ShowPartitionsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+func (m *CreatePartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePartitionRequest.Size(m)
 }
-func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
+func (m *CreatePartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreatePartitionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
+func (m *CreatePartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsRequest) GetDbName() string {
+func (m *CreatePartitionRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionName() string {
+func (m *CreatePartitionRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *ShowPartitionsRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *ShowPartitionsRequest) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
-
-func (m *ShowPartitionsRequest) GetType() ShowType {
+func (m *CreatePartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.Type
+		return m.PartitionName
 	}
-	return ShowType_All
+	return ""
 }
 
 //
-// List all partitions for particular collection response.
-// The returned datas are all rows, we can format to columns by therir index.
-type ShowPartitionsResponse struct {
-	// Contain error_code and reason
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// All partition names for this collection
-	PartitionNames []string `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	// All partition ids for this collection
-	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
-	// All hybrid timestamps
-	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
-	// All utc timestamps calculated by created_timestamps
-	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
-	// Load percentage on querynode
-	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
+// Drop partition in created collection.
+type DropPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to drop
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
-func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowPartitionsResponse) ProtoMessage()    {}
-func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
+func (m *DropPartitionRequest) Reset()         { *m = DropPartitionRequest{} }
+func (m *DropPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*DropPartitionRequest) ProtoMessage()    {}
+func (*DropPartitionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{24}
 }
 
-func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
-}
-func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
+func (m *DropPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropPartitionRequest.Unmarshal(m, b)
 }
-func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
+func (m *DropPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowPartitionsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
+func (m *DropPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropPartitionRequest.Merge(m, src)
 }
-func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
+func (m *DropPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_DropPartitionRequest.Size(m)
 }
-
-var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
-
-func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
-	if m != nil {
-		return m.Status
-	}
-	return nil
+func (m *DropPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropPartitionRequest.DiscardUnknown(m)
 }
 
-func (m *ShowPartitionsResponse) GetPartitionNames() []string {
-	if m != nil {
-		return m.PartitionNames
-	}
-	return nil
-}
+var xxx_messageInfo_DropPartitionRequest proto.InternalMessageInfo
 
-func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
+func (m *DropPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.PartitionIDs
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
+func (m *DropPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.CreatedTimestamps
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+func (m *DropPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.CreatedUtcTimestamps
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+func (m *DropPartitionRequest) GetPartitionName() string {
 	if m != nil {
-		return m.InMemoryPercentages
+		return m.PartitionName
 	}
-	return nil
+	return ""
 }
 
-type DescribeSegmentRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Check if partition exist in collection or not.
+type HasPartitionRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition name you want to check
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
-func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentRequest) ProtoMessage()    {}
-func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
+func (m *HasPartitionRequest) Reset()         { *m = HasPartitionRequest{} }
+func (m *HasPartitionRequest) String() string { return proto.CompactTextString(m) }
+func (*HasPartitionRequest) ProtoMessage()    {}
+func (*HasPartitionRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{25}
 }
 
-func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
+func (m *HasPartitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HasPartitionRequest.Unmarshal(m, b)
 }
-func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
+func (m *HasPartitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HasPartitionRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
+func (m *HasPartitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HasPartitionRequest.Merge(m, src)
 }
-func (m *DescribeSegmentRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
+func (m *HasPartitionRequest) XXX_Size() int {
+	return xxx_messageInfo_HasPartitionRequest.Size(m)
 }
-func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
+func (m *HasPartitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HasPartitionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
+var xxx_messageInfo_HasPartitionRequest proto.InternalMessageInfo
 
-func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
+func (m *HasPartitionRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeSegmentRequest) GetCollectionID() int64 {
+func (m *HasPartitionRequest) GetDbName() string {
 	if m != nil {
-		return m.CollectionID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeSegmentRequest) GetSegmentID() int64 {
+func (m *HasPartitionRequest) GetCollectionName() string {
 	if m != nil {
-		return m.SegmentID
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-type DescribeSegmentResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
-	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
-	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *HasPartitionRequest) GetPartitionName() string {
+	if m != nil {
+		return m.PartitionName
+	}
+	return ""
 }
 
-func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
-func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeSegmentResponse) ProtoMessage()    {}
-func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
+//
+// Load specific partitions data of one collection into query nodes
+// Then you can get these data as result when you do vector search on this collection.
+type LoadPartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition names you want to load
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LoadPartitionsRequest) Reset()         { *m = LoadPartitionsRequest{} }
+func (m *LoadPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadPartitionsRequest) ProtoMessage()    {}
+func (*LoadPartitionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{26}
 }
 
-func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
+func (m *LoadPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadPartitionsRequest.Unmarshal(m, b)
 }
-func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
+func (m *LoadPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadPartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
+func (m *LoadPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadPartitionsRequest.Merge(m, src)
 }
-func (m *DescribeSegmentResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
+func (m *LoadPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadPartitionsRequest.Size(m)
 }
-func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
+func (m *LoadPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
+var xxx_messageInfo_LoadPartitionsRequest proto.InternalMessageInfo
 
-func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
+func (m *LoadPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeSegmentResponse) GetIndexID() int64 {
-	if m != nil {
-		return m.IndexID
-	}
-	return 0
-}
-
-func (m *DescribeSegmentResponse) GetBuildID() int64 {
+func (m *LoadPartitionsRequest) GetDbName() string {
 	if m != nil {
-		return m.BuildID
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *DescribeSegmentResponse) GetEnableIndex() bool {
+func (m *LoadPartitionsRequest) GetCollectionName() string {
 	if m != nil {
-		return m.EnableIndex
+		return m.CollectionName
 	}
-	return false
+	return ""
 }
 
-func (m *DescribeSegmentResponse) GetFieldID() int64 {
+func (m *LoadPartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.FieldID
+		return m.PartitionNames
 	}
-	return 0
+	return nil
 }
 
-type ShowSegmentsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Release specific partitions data of one collection from query nodes.
+// Then you can not get these data as result when you do vector search on this collection.
+type ReleasePartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition names you want to release
+	PartitionNames       []string `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
-func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsRequest) ProtoMessage()    {}
-func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
+func (m *ReleasePartitionsRequest) Reset()         { *m = ReleasePartitionsRequest{} }
+func (m *ReleasePartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleasePartitionsRequest) ProtoMessage()    {}
+func (*ReleasePartitionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{27}
 }
 
-func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
+func (m *ReleasePartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleasePartitionsRequest.Unmarshal(m, b)
 }
-func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
+func (m *ReleasePartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleasePartitionsRequest.Marshal(b, m, deterministic)
 }
-func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
+func (m *ReleasePartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleasePartitionsRequest.Merge(m, src)
 }
-func (m *ShowSegmentsRequest) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
+func (m *ReleasePartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleasePartitionsRequest.Size(m)
 }
-func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
+func (m *ReleasePartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleasePartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ReleasePartitionsRequest proto.InternalMessageInfo
 
-func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
+func (m *ReleasePartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *ShowSegmentsRequest) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
-
-func (m *ShowSegmentsRequest) GetPartitionID() int64 {
+func (m *ReleasePartitionsRequest) GetDbName() string {
 	if m != nil {
-		return m.PartitionID
+		return m.DbName
 	}
-	return 0
-}
-
-type ShowSegmentsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
-}
-
-func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
-func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
-func (*ShowSegmentsResponse) ProtoMessage()    {}
-func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{28}
-}
-
-func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
-}
-func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
-}
-func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
-}
-func (m *ShowSegmentsResponse) XXX_Size() int {
-	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
-}
-func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
-
-func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
+func (m *ReleasePartitionsRequest) GetCollectionName() string {
 	if m != nil {
-		return m.Status
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
+func (m *ReleasePartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.SegmentIDs
+		return m.PartitionNames
 	}
 	return nil
 }
 
 //
-// Create index for vector datas
-type CreateIndexRequest struct {
+// Get partition statistics like row_count.
+type GetPartitionStatisticsRequest struct {
 	// Not useful for now
 	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
+	// The database name, the default database if empty
 	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The particular collection name you want to create index.
+	// The collection name in milvus
 	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this particular collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// Support keys: index_type,metric_type, params. Different index_type may has different params.
-	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+	// The partition name you want to collect statistics
+	PartitionName        string   `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
-func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateIndexRequest) ProtoMessage()    {}
-func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{29}
+func (m *GetPartitionStatisticsRequest) Reset()         { *m = GetPartitionStatisticsRequest{} }
+func (m *GetPartitionStatisticsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsRequest) ProtoMessage()    {}
+func (*GetPartitionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{28}
 }
 
-func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
+func (m *GetPartitionStatisticsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Unmarshal(m, b)
 }
-func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+func (m *GetPartitionStatisticsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Marshal(b, m, deterministic)
 }
-func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
+func (m *GetPartitionStatisticsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsRequest.Merge(m, src)
 }
-func (m *CreateIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateIndexRequest.Size(m)
+func (m *GetPartitionStatisticsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsRequest.Size(m)
 }
-func (m *CreateIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
+func (m *GetPartitionStatisticsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPartitionStatisticsRequest proto.InternalMessageInfo
 
-func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *GetPartitionStatisticsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *CreateIndexRequest) GetDbName() string {
+func (m *GetPartitionStatisticsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetCollectionName() string {
+func (m *GetPartitionStatisticsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetFieldName() string {
+func (m *GetPartitionStatisticsRequest) GetPartitionName() string {
 	if m != nil {
-		return m.FieldName
+		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
-	if m != nil {
-		return m.ExtraParams
-	}
-	return nil
+type GetPartitionStatisticsResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Stats                []*commonpb.KeyValuePair `protobuf:"bytes,2,rep,name=stats,proto3" json:"stats,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-//
-// Get created index information.
-// Current release of Milvus only supports showing latest built index.
-type DescribeIndexRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The particular collection name in Milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this particular collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// No need to set up for now @2021.06.30
-	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetPartitionStatisticsResponse) Reset()         { *m = GetPartitionStatisticsResponse{} }
+func (m *GetPartitionStatisticsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPartitionStatisticsResponse) ProtoMessage()    {}
+func (*GetPartitionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{29}
 }
 
-func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
-func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexRequest) ProtoMessage()    {}
-func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{30}
+func (m *GetPartitionStatisticsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Unmarshal(m, b)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPartitionStatisticsResponse.Merge(m, src)
+}
+func (m *GetPartitionStatisticsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPartitionStatisticsResponse.Size(m)
+}
+func (m *GetPartitionStatisticsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPartitionStatisticsResponse.DiscardUnknown(m)
 }
 
-func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
+var xxx_messageInfo_GetPartitionStatisticsResponse proto.InternalMessageInfo
+
+func (m *GetPartitionStatisticsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
-func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
+
+func (m *GetPartitionStatisticsResponse) GetStats() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Stats
+	}
+	return nil
 }
-func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
+
+//
+// List all partitions for particular collection
+type ShowPartitionsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name you want to describe, you can pass collection_name or collectionID
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The collection id in milvus
+	CollectionID int64 `protobuf:"varint,4,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	// When type is InMemory, will return these patitions's inMemory_percentages.(Optional)
+	PartitionNames []string `protobuf:"bytes,5,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// Decide return Loaded partitions or All partitions(Optional)
+	Type                 ShowType `protobuf:"varint,6,opt,name=type,proto3,enum=milvus.proto.milvus.ShowType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *DescribeIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexRequest.Size(m)
+
+func (m *ShowPartitionsRequest) Reset()         { *m = ShowPartitionsRequest{} }
+func (m *ShowPartitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsRequest) ProtoMessage()    {}
+func (*ShowPartitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{30}
 }
-func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
+
+func (m *ShowPartitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsRequest.Unmarshal(m, b)
+}
+func (m *ShowPartitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsRequest.Marshal(b, m, deterministic)
+}
+func (m *ShowPartitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsRequest.Merge(m, src)
+}
+func (m *ShowPartitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsRequest.Size(m)
+}
+func (m *ShowPartitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsRequest proto.InternalMessageInfo
 
-func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowPartitionsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeIndexRequest) GetDbName() string {
+func (m *ShowPartitionsRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetCollectionName() string {
+func (m *ShowPartitionsRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DescribeIndexRequest) GetFieldName() string {
+func (m *ShowPartitionsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.FieldName
+		return m.CollectionID
 	}
-	return ""
+	return 0
 }
 
-func (m *DescribeIndexRequest) GetIndexName() string {
+func (m *ShowPartitionsRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionNames
 	}
-	return ""
+	return nil
+}
+
+func (m *ShowPartitionsRequest) GetType() ShowType {
+	if m != nil {
+		return m.Type
+	}
+	return ShowType_All
 }
 
 //
-// Index informations
-type IndexDescription struct {
-	// Index name
-	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	// Index id
-	IndexID int64 `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	// Will return index_type, metric_type, params(like nlist).
-	Params []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
-	// The vector field name
-	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+// List all partitions for particular collection response.
+// The returned datas are all rows, we can format to columns by therir index.
+type ShowPartitionsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// All partition names for this collection
+	PartitionNames []string `protobuf:"bytes,2,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	// All partition ids for this collection
+	PartitionIDs []int64 `protobuf:"varint,3,rep,packed,name=partitionIDs,proto3" json:"partitionIDs,omitempty"`
+	// All hybrid timestamps
+	CreatedTimestamps []uint64 `protobuf:"varint,4,rep,packed,name=created_timestamps,json=createdTimestamps,proto3" json:"created_timestamps,omitempty"`
+	// All utc timestamps calculated by created_timestamps
+	CreatedUtcTimestamps []uint64 `protobuf:"varint,5,rep,packed,name=created_utc_timestamps,json=createdUtcTimestamps,proto3" json:"created_utc_timestamps,omitempty"`
+	// Load percentage on querynode
+	InMemoryPercentages  []int64  `protobuf:"varint,6,rep,packed,name=inMemory_percentages,json=inMemoryPercentages,proto3" json:"inMemory_percentages,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
-func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
-func (*IndexDescription) ProtoMessage()    {}
-func (*IndexDescription) Descriptor() ([]byte, []int) {
+func (m *ShowPartitionsResponse) Reset()         { *m = ShowPartitionsResponse{} }
+func (m *ShowPartitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowPartitionsResponse) ProtoMessage()    {}
+func (*ShowPartitionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{31}
 }
 
-func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
+func (m *ShowPartitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowPartitionsResponse.Unmarshal(m, b)
 }
-func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
+func (m *ShowPartitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowPartitionsResponse.Marshal(b, m, deterministic)
 }
-func (m *IndexDescription) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_IndexDescription.Merge(m, src)
+func (m *ShowPartitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowPartitionsResponse.Merge(m, src)
 }
-func (m *IndexDescription) XXX_Size() int {
-	return xxx_messageInfo_IndexDescription.Size(m)
+func (m *ShowPartitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowPartitionsResponse.Size(m)
 }
-func (m *IndexDescription) XXX_DiscardUnknown() {
-	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
+func (m *ShowPartitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowPartitionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
+var xxx_messageInfo_ShowPartitionsResponse proto.InternalMessageInfo
 
-func (m *IndexDescription) GetIndexName() string {
+func (m *ShowPartitionsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.IndexName
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *IndexDescription) GetIndexID() int64 {
+func (m *ShowPartitionsResponse) GetPartitionNames() []string {
 	if m != nil {
-		return m.IndexID
+		return m.PartitionNames
 	}
-	return 0
+	return nil
 }
 
-func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
+func (m *ShowPartitionsResponse) GetPartitionIDs() []int64 {
 	if m != nil {
-		return m.Params
+		return m.PartitionIDs
 	}
 	return nil
 }
 
-func (m *IndexDescription) GetFieldName() string {
+func (m *ShowPartitionsResponse) GetCreatedTimestamps() []uint64 {
 	if m != nil {
-		return m.FieldName
+		return m.CreatedTimestamps
 	}
-	return ""
+	return nil
 }
 
-//
-// Describe index response
-type DescribeIndexResponse struct {
-	// Response status
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// All index informations, for now only return tha latest index you created for the collection.
-	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ShowPartitionsResponse) GetCreatedUtcTimestamps() []uint64 {
+	if m != nil {
+		return m.CreatedUtcTimestamps
+	}
+	return nil
 }
 
-func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
-func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
-func (*DescribeIndexResponse) ProtoMessage()    {}
-func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
+func (m *ShowPartitionsResponse) GetInMemoryPercentages() []int64 {
+	if m != nil {
+		return m.InMemoryPercentages
+	}
+	return nil
+}
+
+type DescribeSegmentRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	SegmentID            int64             `protobuf:"varint,3,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *DescribeSegmentRequest) Reset()         { *m = DescribeSegmentRequest{} }
+func (m *DescribeSegmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentRequest) ProtoMessage()    {}
+func (*DescribeSegmentRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{32}
 }
 
-func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
+func (m *DescribeSegmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentRequest.Unmarshal(m, b)
 }
-func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
+func (m *DescribeSegmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentRequest.Marshal(b, m, deterministic)
 }
-func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
+func (m *DescribeSegmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentRequest.Merge(m, src)
 }
-func (m *DescribeIndexResponse) XXX_Size() int {
-	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+func (m *DescribeSegmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentRequest.Size(m)
 }
-func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
+func (m *DescribeSegmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
+var xxx_messageInfo_DescribeSegmentRequest proto.InternalMessageInfo
 
-func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
+func (m *DescribeSegmentRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
+func (m *DescribeSegmentRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.IndexDescriptions
+		return m.CollectionID
 	}
-	return nil
+	return 0
 }
 
-//
-//  Get index building progress
-type GetIndexBuildProgressRequest struct {
-	// Not useful for now
-	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	// Not useful for now
-	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	// The collection name in milvus
-	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	// The vector field name in this collection
-	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	// Not useful for now
-	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *DescribeSegmentRequest) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
 }
 
-func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
-func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
-func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
+type DescribeSegmentResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexID              int64            `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	BuildID              int64            `protobuf:"varint,3,opt,name=buildID,proto3" json:"buildID,omitempty"`
+	EnableIndex          bool             `protobuf:"varint,4,opt,name=enable_index,json=enableIndex,proto3" json:"enable_index,omitempty"`
+	FieldID              int64            `protobuf:"varint,5,opt,name=fieldID,proto3" json:"fieldID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *DescribeSegmentResponse) Reset()         { *m = DescribeSegmentResponse{} }
+func (m *DescribeSegmentResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeSegmentResponse) ProtoMessage()    {}
+func (*DescribeSegmentResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{33}
 }
 
-func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
+func (m *DescribeSegmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeSegmentResponse.Unmarshal(m, b)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
+func (m *DescribeSegmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeSegmentResponse.Marshal(b, m, deterministic)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
+func (m *DescribeSegmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeSegmentResponse.Merge(m, src)
 }
-func (m *GetIndexBuildProgressRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
+func (m *DescribeSegmentResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeSegmentResponse.Size(m)
 }
-func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
+func (m *DescribeSegmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeSegmentResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeSegmentResponse proto.InternalMessageInfo
 
-func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeSegmentResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetIndexBuildProgressRequest) GetDbName() string {
+func (m *DescribeSegmentResponse) GetIndexID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.IndexID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
+func (m *DescribeSegmentResponse) GetBuildID() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.BuildID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetIndexBuildProgressRequest) GetFieldName() string {
+func (m *DescribeSegmentResponse) GetEnableIndex() bool {
 	if m != nil {
-		return m.FieldName
+		return m.EnableIndex
 	}
-	return ""
+	return false
 }
 
-func (m *GetIndexBuildProgressRequest) GetIndexName() string {
+func (m *DescribeSegmentResponse) GetFieldID() int64 {
 	if m != nil {
-		return m.IndexName
+		return m.FieldID
 	}
-	return ""
+	return 0
 }
 
-type GetIndexBuildProgressResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
-	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type ShowSegmentsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	CollectionID         int64             `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64             `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
-func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
-func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
+func (m *ShowSegmentsRequest) Reset()         { *m = ShowSegmentsRequest{} }
+func (m *ShowSegmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsRequest) ProtoMessage()    {}
+func (*ShowSegmentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{34}
 }
 
-func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
+func (m *ShowSegmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsRequest.Unmarshal(m, b)
 }
-func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
+func (m *ShowSegmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
+func (m *ShowSegmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsRequest.Merge(m, src)
 }
-func (m *GetIndexBuildProgressResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
+func (m *ShowSegmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsRequest.Size(m)
 }
-func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
+func (m *ShowSegmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
+var xxx_messageInfo_ShowSegmentsRequest proto.InternalMessageInfo
 
-func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
+func (m *ShowSegmentsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
+func (m *ShowSegmentsRequest) GetCollectionID() int64 {
 	if m != nil {
-		return m.IndexedRows
+		return m.CollectionID
 	}
 	return 0
 }
 
-func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
+func (m *ShowSegmentsRequest) GetPartitionID() int64 {
 	if m != nil {
-		return m.TotalRows
+		return m.PartitionID
 	}
 	return 0
 }
 
-type GetIndexStateRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ShowSegmentsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	SegmentIDs           []int64          `protobuf:"varint,2,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
-func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateRequest) ProtoMessage()    {}
-func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
+func (m *ShowSegmentsResponse) Reset()         { *m = ShowSegmentsResponse{} }
+func (m *ShowSegmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*ShowSegmentsResponse) ProtoMessage()    {}
+func (*ShowSegmentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{35}
 }
 
-func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
+func (m *ShowSegmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShowSegmentsResponse.Unmarshal(m, b)
 }
-func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
+func (m *ShowSegmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShowSegmentsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
+func (m *ShowSegmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShowSegmentsResponse.Merge(m, src)
 }
-func (m *GetIndexStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateRequest.Size(m)
+func (m *ShowSegmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_ShowSegmentsResponse.Size(m)
 }
-func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
+func (m *ShowSegmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShowSegmentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ShowSegmentsResponse proto.InternalMessageInfo
 
-func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
+func (m *ShowSegmentsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ShowSegmentsResponse) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+//
+// Create index for vector datas
+type CreateIndexRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The particular collection name you want to create index.
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this particular collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Support keys: index_type,metric_type, params. Different index_type may has different params.
+	ExtraParams          []*commonpb.KeyValuePair `protobuf:"bytes,5,rep,name=extra_params,json=extraParams,proto3" json:"extra_params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CreateIndexRequest) Reset()         { *m = CreateIndexRequest{} }
+func (m *CreateIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateIndexRequest) ProtoMessage()    {}
+func (*CreateIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{36}
+}
+
+func (m *CreateIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateIndexRequest.Unmarshal(m, b)
+}
+func (m *CreateIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateIndexRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateIndexRequest.Merge(m, src)
+}
+func (m *CreateIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateIndexRequest.Size(m)
+}
+func (m *CreateIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateIndexRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateIndexRequest proto.InternalMessageInfo
+
+func (m *CreateIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetIndexStateRequest) GetDbName() string {
+func (m *CreateIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetIndexStateRequest) GetCollectionName() string {
+func (m *CreateIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *GetIndexStateRequest) GetFieldName() string {
+func (m *CreateIndexRequest) GetFieldName() string {
 	if m != nil {
 		return m.FieldName
 	}
 	return ""
 }
 
-func (m *GetIndexStateRequest) GetIndexName() string {
+func (m *CreateIndexRequest) GetExtraParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.IndexName
+		return m.ExtraParams
 	}
-	return ""
+	return nil
 }
 
-type GetIndexStateResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
-	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
-func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetIndexStateResponse) ProtoMessage()    {}
-func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{36}
-}
-
-func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
-}
-func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
-}
-func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
-}
-func (m *GetIndexStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetIndexStateResponse.Size(m)
-}
-func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
-
-func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
-	if m != nil {
-		return m.Status
-	}
-	return nil
-}
-
-func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
-	if m != nil {
-		return m.State
-	}
-	return commonpb.IndexState_IndexStateNone
-}
-
-func (m *GetIndexStateResponse) GetFailReason() string {
-	if m != nil {
-		return m.FailReason
-	}
-	return ""
-}
-
-type DropIndexRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+//
+// Get created index information.
+// Current release of Milvus only supports showing latest built index.
+type DescribeIndexRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The particular collection name in Milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this particular collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// No need to set up for now @2021.06.30
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
-func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
-func (*DropIndexRequest) ProtoMessage()    {}
-func (*DropIndexRequest) Descriptor() ([]byte, []int) {
+func (m *DescribeIndexRequest) Reset()         { *m = DescribeIndexRequest{} }
+func (m *DescribeIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexRequest) ProtoMessage()    {}
+func (*DescribeIndexRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{37}
 }
 
-func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
+func (m *DescribeIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexRequest.Unmarshal(m, b)
 }
-func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
+func (m *DescribeIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DropIndexRequest.Merge(m, src)
+func (m *DescribeIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexRequest.Merge(m, src)
 }
-func (m *DropIndexRequest) XXX_Size() int {
-	return xxx_messageInfo_DropIndexRequest.Size(m)
+func (m *DescribeIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexRequest.Size(m)
 }
-func (m *DropIndexRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
+func (m *DescribeIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
+var xxx_messageInfo_DescribeIndexRequest proto.InternalMessageInfo
 
-func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
+func (m *DescribeIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DropIndexRequest) GetDbName() string {
+func (m *DescribeIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DropIndexRequest) GetCollectionName() string {
+func (m *DescribeIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DropIndexRequest) GetFieldName() string {
+func (m *DescribeIndexRequest) GetFieldName() string {
 	if m != nil {
 		return m.FieldName
 	}
 	return ""
 }
 
-func (m *DropIndexRequest) GetIndexName() string {
+func (m *DescribeIndexRequest) GetIndexName() string {
 	if m != nil {
 		return m.IndexName
 	}
 	return ""
 }
 
-type InsertRequest struct {
-	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
-	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+//
+// Index informations
+type IndexDescription struct {
+	// Index name
+	IndexName string `protobuf:"bytes,1,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	// Index id
+	IndexID int64 `protobuf:"varint,2,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	// Will return index_type, metric_type, params(like nlist).
+	Params []*commonpb.KeyValuePair `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	// The vector field name
+	FieldName            string   `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
-func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
-func (*InsertRequest) ProtoMessage()    {}
-func (*InsertRequest) Descriptor() ([]byte, []int) {
+func (m *IndexDescription) Reset()         { *m = IndexDescription{} }
+func (m *IndexDescription) String() string { return proto.CompactTextString(m) }
+func (*IndexDescription) ProtoMessage()    {}
+func (*IndexDescription) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{38}
 }
 
-func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
+func (m *IndexDescription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndexDescription.Unmarshal(m, b)
 }
-func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+func (m *IndexDescription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndexDescription.Marshal(b, m, deterministic)
 }
-func (m *InsertRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_InsertRequest.Merge(m, src)
+func (m *IndexDescription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndexDescription.Merge(m, src)
 }
-func (m *InsertRequest) XXX_Size() int {
-	return xxx_messageInfo_InsertRequest.Size(m)
+func (m *IndexDescription) XXX_Size() int {
+	return xxx_messageInfo_IndexDescription.Size(m)
 }
-func (m *InsertRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+func (m *IndexDescription) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndexDescription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+var xxx_messageInfo_IndexDescription proto.InternalMessageInfo
 
-func (m *InsertRequest) GetBase() *commonpb.MsgBase {
+func (m *IndexDescription) GetIndexName() string {
 	if m != nil {
-		return m.Base
+		return m.IndexName
 	}
-	return nil
+	return ""
 }
 
-func (m *InsertRequest) GetDbName() string {
+func (m *IndexDescription) GetIndexID() int64 {
 	if m != nil {
-		return m.DbName
+		return m.IndexID
 	}
-	return ""
+	return 0
 }
 
-func (m *InsertRequest) GetCollectionName() string {
+func (m *IndexDescription) GetParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.CollectionName
+		return m.Params
 	}
-	return ""
+	return nil
 }
 
-func (m *InsertRequest) GetPartitionName() string {
+func (m *IndexDescription) GetFieldName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
-	if m != nil {
-		return m.FieldsData
-	}
-	return nil
+//
+// Describe index response
+type DescribeIndexResponse struct {
+	// Response status
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// All index informations, for now only return tha latest index you created for the collection.
+	IndexDescriptions    []*IndexDescription `protobuf:"bytes,2,rep,name=index_descriptions,json=indexDescriptions,proto3" json:"index_descriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *InsertRequest) GetHashKeys() []uint32 {
+func (m *DescribeIndexResponse) Reset()         { *m = DescribeIndexResponse{} }
+func (m *DescribeIndexResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeIndexResponse) ProtoMessage()    {}
+func (*DescribeIndexResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{39}
+}
+
+func (m *DescribeIndexResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeIndexResponse.Unmarshal(m, b)
+}
+func (m *DescribeIndexResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeIndexResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeIndexResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeIndexResponse.Merge(m, src)
+}
+func (m *DescribeIndexResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeIndexResponse.Size(m)
+}
+func (m *DescribeIndexResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeIndexResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeIndexResponse proto.InternalMessageInfo
+
+func (m *DescribeIndexResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.HashKeys
+		return m.Status
 	}
 	return nil
 }
 
-func (m *InsertRequest) GetNumRows() uint32 {
+func (m *DescribeIndexResponse) GetIndexDescriptions() []*IndexDescription {
 	if m != nil {
-		return m.NumRows
+		return m.IndexDescriptions
 	}
-	return 0
+	return nil
 }
 
-type MutationResult struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
-	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
-	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
-	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
-	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
-	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
-	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
-	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+//
+//  Get index building progress
+type GetIndexBuildProgressRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// The database name, the default database if empty
+	DbName string `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	// The collection name in milvus
+	CollectionName string `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The vector field name in this collection
+	FieldName string `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	// Not useful for now
+	IndexName            string   `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *MutationResult) Reset()         { *m = MutationResult{} }
-func (m *MutationResult) String() string { return proto.CompactTextString(m) }
-func (*MutationResult) ProtoMessage()    {}
-func (*MutationResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{39}
+func (m *GetIndexBuildProgressRequest) Reset()         { *m = GetIndexBuildProgressRequest{} }
+func (m *GetIndexBuildProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressRequest) ProtoMessage()    {}
+func (*GetIndexBuildProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{40}
 }
 
-func (m *MutationResult) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
+func (m *GetIndexBuildProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Unmarshal(m, b)
 }
-func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
+func (m *GetIndexBuildProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Marshal(b, m, deterministic)
 }
-func (m *MutationResult) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MutationResult.Merge(m, src)
+func (m *GetIndexBuildProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressRequest.Merge(m, src)
 }
-func (m *MutationResult) XXX_Size() int {
-	return xxx_messageInfo_MutationResult.Size(m)
+func (m *GetIndexBuildProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressRequest.Size(m)
 }
-func (m *MutationResult) XXX_DiscardUnknown() {
-	xxx_messageInfo_MutationResult.DiscardUnknown(m)
+func (m *GetIndexBuildProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MutationResult proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexBuildProgressRequest proto.InternalMessageInfo
 
-func (m *MutationResult) GetStatus() *commonpb.Status {
+func (m *GetIndexBuildProgressRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *MutationResult) GetIDs() *schemapb.IDs {
+func (m *GetIndexBuildProgressRequest) GetDbName() string {
 	if m != nil {
-		return m.IDs
+		return m.DbName
 	}
-	return nil
+	return ""
 }
 
-func (m *MutationResult) GetSuccIndex() []uint32 {
+func (m *GetIndexBuildProgressRequest) GetCollectionName() string {
 	if m != nil {
-		return m.SuccIndex
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *MutationResult) GetErrIndex() []uint32 {
+func (m *GetIndexBuildProgressRequest) GetFieldName() string {
 	if m != nil {
-		return m.ErrIndex
+		return m.FieldName
 	}
-	return nil
+	return ""
 }
 
-func (m *MutationResult) GetAcknowledged() bool {
+func (m *GetIndexBuildProgressRequest) GetIndexName() string {
 	if m != nil {
-		return m.Acknowledged
+		return m.IndexName
 	}
-	return false
+	return ""
 }
 
-func (m *MutationResult) GetInsertCnt() int64 {
-	if m != nil {
-		return m.InsertCnt
-	}
-	return 0
+type GetIndexBuildProgressResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IndexedRows          int64            `protobuf:"varint,2,opt,name=indexed_rows,json=indexedRows,proto3" json:"indexed_rows,omitempty"`
+	TotalRows            int64            `protobuf:"varint,3,opt,name=total_rows,json=totalRows,proto3" json:"total_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *MutationResult) GetDeleteCnt() int64 {
+func (m *GetIndexBuildProgressResponse) Reset()         { *m = GetIndexBuildProgressResponse{} }
+func (m *GetIndexBuildProgressResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexBuildProgressResponse) ProtoMessage()    {}
+func (*GetIndexBuildProgressResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{41}
+}
+
+func (m *GetIndexBuildProgressResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Unmarshal(m, b)
+}
+func (m *GetIndexBuildProgressResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Marshal(b, m, deterministic)
+}
+func (m *GetIndexBuildProgressResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexBuildProgressResponse.Merge(m, src)
+}
+func (m *GetIndexBuildProgressResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexBuildProgressResponse.Size(m)
+}
+func (m *GetIndexBuildProgressResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexBuildProgressResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetIndexBuildProgressResponse proto.InternalMessageInfo
+
+func (m *GetIndexBuildProgressResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.DeleteCnt
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *MutationResult) GetUpsertCnt() int64 {
+func (m *GetIndexBuildProgressResponse) GetIndexedRows() int64 {
 	if m != nil {
-		return m.UpsertCnt
+		return m.IndexedRows
 	}
 	return 0
 }
 
-func (m *MutationResult) GetTimestamp() uint64 {
+func (m *GetIndexBuildProgressResponse) GetTotalRows() int64 {
 	if m != nil {
-		return m.Timestamp
+		return m.TotalRows
 	}
 	return 0
 }
 
-type DeleteRequest struct {
+type GetIndexStateRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
-	HashKeys             []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
-func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
-func (*DeleteRequest) ProtoMessage()    {}
-func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{40}
+func (m *GetIndexStateRequest) Reset()         { *m = GetIndexStateRequest{} }
+func (m *GetIndexStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateRequest) ProtoMessage()    {}
+func (*GetIndexStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{42}
 }
 
-func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
+func (m *GetIndexStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateRequest.Unmarshal(m, b)
 }
-func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
+func (m *GetIndexStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateRequest.Marshal(b, m, deterministic)
 }
-func (m *DeleteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeleteRequest.Merge(m, src)
+func (m *GetIndexStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateRequest.Merge(m, src)
 }
-func (m *DeleteRequest) XXX_Size() int {
-	return xxx_messageInfo_DeleteRequest.Size(m)
+func (m *GetIndexStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateRequest.Size(m)
 }
-func (m *DeleteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
+func (m *GetIndexStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStateRequest proto.InternalMessageInfo
 
-func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
+func (m *GetIndexStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *DeleteRequest) GetDbName() string {
+func (m *GetIndexStateRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetCollectionName() string {
+func (m *GetIndexStateRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetPartitionName() string {
+func (m *GetIndexStateRequest) GetFieldName() string {
 	if m != nil {
-		return m.PartitionName
+		return m.FieldName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetExpr() string {
+func (m *GetIndexStateRequest) GetIndexName() string {
 	if m != nil {
-		return m.Expr
+		return m.IndexName
 	}
 	return ""
 }
 
-func (m *DeleteRequest) GetHashKeys() []uint32 {
-	if m != nil {
-		return m.HashKeys
-	}
-	return nil
-}
-
-type PlaceholderValue struct {
-	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
-	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
-	// values is a 2d-array, every array contains a vector
-	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetIndexStateResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.IndexState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.IndexState" json:"state,omitempty"`
+	FailReason           string              `protobuf:"bytes,3,opt,name=fail_reason,json=failReason,proto3" json:"fail_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
-func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderValue) ProtoMessage()    {}
-func (*PlaceholderValue) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{41}
+func (m *GetIndexStateResponse) Reset()         { *m = GetIndexStateResponse{} }
+func (m *GetIndexStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetIndexStateResponse) ProtoMessage()    {}
+func (*GetIndexStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{43}
 }
 
-func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
+func (m *GetIndexStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetIndexStateResponse.Unmarshal(m, b)
 }
-func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
+func (m *GetIndexStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetIndexStateResponse.Marshal(b, m, deterministic)
 }
-func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderValue.Merge(m, src)
+func (m *GetIndexStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetIndexStateResponse.Merge(m, src)
 }
-func (m *PlaceholderValue) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderValue.Size(m)
+func (m *GetIndexStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetIndexStateResponse.Size(m)
 }
-func (m *PlaceholderValue) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
+func (m *GetIndexStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetIndexStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
+var xxx_messageInfo_GetIndexStateResponse proto.InternalMessageInfo
 
-func (m *PlaceholderValue) GetTag() string {
+func (m *GetIndexStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Tag
+		return m.Status
 	}
-	return ""
+	return nil
 }
 
-func (m *PlaceholderValue) GetType() PlaceholderType {
+func (m *GetIndexStateResponse) GetState() commonpb.IndexState {
 	if m != nil {
-		return m.Type
+		return m.State
 	}
-	return PlaceholderType_None
+	return commonpb.IndexState_IndexStateNone
 }
 
-func (m *PlaceholderValue) GetValues() [][]byte {
+func (m *GetIndexStateResponse) GetFailReason() string {
 	if m != nil {
-		return m.Values
+		return m.FailReason
 	}
-	return nil
+	return ""
 }
 
-type PlaceholderGroup struct {
-	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
-}
-
-func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
-func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
-func (*PlaceholderGroup) ProtoMessage()    {}
-func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{42}
-}
-
-func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
-}
-func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
-}
-func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
-}
-func (m *PlaceholderGroup) XXX_Size() int {
-	return xxx_messageInfo_PlaceholderGroup.Size(m)
-}
-func (m *PlaceholderGroup) XXX_DiscardUnknown() {
-	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
-
-func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
-	if m != nil {
-		return m.Placeholders
-	}
-	return nil
-}
-
-type SearchRequest struct {
-	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
-	// serialized `PlaceholderGroup`
-	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
-	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
-	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+type DropIndexRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string            `protobuf:"bytes,4,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IndexName            string            `protobuf:"bytes,5,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
-func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
-func (*SearchRequest) ProtoMessage()    {}
-func (*SearchRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{43}
+func (m *DropIndexRequest) Reset()         { *m = DropIndexRequest{} }
+func (m *DropIndexRequest) String() string { return proto.CompactTextString(m) }
+func (*DropIndexRequest) ProtoMessage()    {}
+func (*DropIndexRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{44}
 }
 
-func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
+func (m *DropIndexRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropIndexRequest.Unmarshal(m, b)
 }
-func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
+func (m *DropIndexRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropIndexRequest.Marshal(b, m, deterministic)
 }
-func (m *SearchRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchRequest.Merge(m, src)
+func (m *DropIndexRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropIndexRequest.Merge(m, src)
 }
-func (m *SearchRequest) XXX_Size() int {
-	return xxx_messageInfo_SearchRequest.Size(m)
+func (m *DropIndexRequest) XXX_Size() int {
+	return xxx_messageInfo_DropIndexRequest.Size(m)
 }
-func (m *SearchRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
+func (m *DropIndexRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropIndexRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropIndexRequest proto.InternalMessageInfo
 
-func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+func (m *DropIndexRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDbName() string {
+func (m *DropIndexRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetCollectionName() string {
+func (m *DropIndexRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPartitionNames() []string {
+func (m *DropIndexRequest) GetFieldName() string {
 	if m != nil {
-		return m.PartitionNames
+		return m.FieldName
 	}
-	return nil
+	return ""
 }
 
-func (m *SearchRequest) GetDsl() string {
+func (m *DropIndexRequest) GetIndexName() string {
 	if m != nil {
-		return m.Dsl
+		return m.IndexName
 	}
 	return ""
 }
 
-func (m *SearchRequest) GetPlaceholderGroup() []byte {
+type InsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *InsertRequest) Reset()         { *m = InsertRequest{} }
+func (m *InsertRequest) String() string { return proto.CompactTextString(m) }
+func (*InsertRequest) ProtoMessage()    {}
+func (*InsertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{45}
+}
+
+func (m *InsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InsertRequest.Unmarshal(m, b)
+}
+func (m *InsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InsertRequest.Marshal(b, m, deterministic)
+}
+func (m *InsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InsertRequest.Merge(m, src)
+}
+func (m *InsertRequest) XXX_Size() int {
+	return xxx_messageInfo_InsertRequest.Size(m)
+}
+func (m *InsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InsertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InsertRequest proto.InternalMessageInfo
+
+func (m *InsertRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.PlaceholderGroup
+		return m.Base
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetDslType() commonpb.DslType {
+func (m *InsertRequest) GetDbName() string {
 	if m != nil {
-		return m.DslType
+		return m.DbName
 	}
-	return commonpb.DslType_Dsl
+	return ""
 }
 
-func (m *SearchRequest) GetOutputFields() []string {
+func (m *InsertRequest) GetCollectionName() string {
 	if m != nil {
-		return m.OutputFields
+		return m.CollectionName
 	}
-	return nil
+	return ""
 }
 
-func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
+func (m *InsertRequest) GetPartitionName() string {
 	if m != nil {
-		return m.SearchParams
+		return m.PartitionName
+	}
+	return ""
+}
+
+func (m *InsertRequest) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *SearchRequest) GetTravelTimestamp() uint64 {
+func (m *InsertRequest) GetHashKeys() []uint32 {
 	if m != nil {
-		return m.TravelTimestamp
+		return m.HashKeys
 	}
-	return 0
+	return nil
 }
 
-func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
+func (m *InsertRequest) GetNumRows() uint32 {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.NumRows
 	}
 	return 0
 }
 
-type Hits struct {
-	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
-	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
-	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type MutationResult struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IDs                  *schemapb.IDs    `protobuf:"bytes,2,opt,name=IDs,proto3" json:"IDs,omitempty"`
+	SuccIndex            []uint32         `protobuf:"varint,3,rep,packed,name=succ_index,json=succIndex,proto3" json:"succ_index,omitempty"`
+	ErrIndex             []uint32         `protobuf:"varint,4,rep,packed,name=err_index,json=errIndex,proto3" json:"err_index,omitempty"`
+	Acknowledged         bool             `protobuf:"varint,5,opt,name=acknowledged,proto3" json:"acknowledged,omitempty"`
+	InsertCnt            int64            `protobuf:"varint,6,opt,name=insert_cnt,json=insertCnt,proto3" json:"insert_cnt,omitempty"`
+	DeleteCnt            int64            `protobuf:"varint,7,opt,name=delete_cnt,json=deleteCnt,proto3" json:"delete_cnt,omitempty"`
+	UpsertCnt            int64            `protobuf:"varint,8,opt,name=upsert_cnt,json=upsertCnt,proto3" json:"upsert_cnt,omitempty"`
+	Timestamp            uint64           `protobuf:"varint,9,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *Hits) Reset()         { *m = Hits{} }
-func (m *Hits) String() string { return proto.CompactTextString(m) }
-func (*Hits) ProtoMessage()    {}
-func (*Hits) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{44}
+func (m *MutationResult) Reset()         { *m = MutationResult{} }
+func (m *MutationResult) String() string { return proto.CompactTextString(m) }
+func (*MutationResult) ProtoMessage()    {}
+func (*MutationResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{46}
 }
 
-func (m *Hits) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Hits.Unmarshal(m, b)
+func (m *MutationResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MutationResult.Unmarshal(m, b)
 }
-func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
+func (m *MutationResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MutationResult.Marshal(b, m, deterministic)
 }
-func (m *Hits) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Hits.Merge(m, src)
+func (m *MutationResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MutationResult.Merge(m, src)
 }
-func (m *Hits) XXX_Size() int {
-	return xxx_messageInfo_Hits.Size(m)
+func (m *MutationResult) XXX_Size() int {
+	return xxx_messageInfo_MutationResult.Size(m)
 }
-func (m *Hits) XXX_DiscardUnknown() {
-	xxx_messageInfo_Hits.DiscardUnknown(m)
+func (m *MutationResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_MutationResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Hits proto.InternalMessageInfo
+var xxx_messageInfo_MutationResult proto.InternalMessageInfo
 
-func (m *Hits) GetIDs() []int64 {
+func (m *MutationResult) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *MutationResult) GetIDs() *schemapb.IDs {
 	if m != nil {
 		return m.IDs
 	}
 	return nil
 }
 
-func (m *Hits) GetRowData() [][]byte {
+func (m *MutationResult) GetSuccIndex() []uint32 {
 	if m != nil {
-		return m.RowData
+		return m.SuccIndex
 	}
 	return nil
 }
 
-func (m *Hits) GetScores() []float32 {
+func (m *MutationResult) GetErrIndex() []uint32 {
 	if m != nil {
-		return m.Scores
+		return m.ErrIndex
 	}
 	return nil
 }
 
-type SearchResults struct {
-	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
-	XXX_unrecognized     []byte                     `json:"-"`
-	XXX_sizecache        int32                      `json:"-"`
+func (m *MutationResult) GetAcknowledged() bool {
+	if m != nil {
+		return m.Acknowledged
+	}
+	return false
 }
 
-func (m *SearchResults) Reset()         { *m = SearchResults{} }
-func (m *SearchResults) String() string { return proto.CompactTextString(m) }
-func (*SearchResults) ProtoMessage()    {}
-func (*SearchResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{45}
+func (m *MutationResult) GetInsertCnt() int64 {
+	if m != nil {
+		return m.InsertCnt
+	}
+	return 0
 }
 
-func (m *SearchResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
-}
-func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
-}
-func (m *SearchResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SearchResults.Merge(m, src)
-}
-func (m *SearchResults) XXX_Size() int {
-	return xxx_messageInfo_SearchResults.Size(m)
-}
-func (m *SearchResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_SearchResults.DiscardUnknown(m)
+func (m *MutationResult) GetDeleteCnt() int64 {
+	if m != nil {
+		return m.DeleteCnt
+	}
+	return 0
 }
 
-var xxx_messageInfo_SearchResults proto.InternalMessageInfo
-
-func (m *SearchResults) GetStatus() *commonpb.Status {
+func (m *MutationResult) GetUpsertCnt() int64 {
 	if m != nil {
-		return m.Status
+		return m.UpsertCnt
 	}
-	return nil
+	return 0
 }
 
-func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+func (m *MutationResult) GetTimestamp() uint64 {
 	if m != nil {
-		return m.Results
+		return m.Timestamp
 	}
-	return nil
+	return 0
 }
 
-type FlushRequest struct {
+type DeleteRequest struct {
 	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
 	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string            `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	Expr                 string            `protobuf:"bytes,5,opt,name=expr,proto3" json:"expr,omitempty"`
+	HashKeys             []uint32          `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	DryRun               bool              `protobuf:"varint,7,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
-func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
-func (*FlushRequest) ProtoMessage()    {}
-func (*FlushRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{46}
+func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
+func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteRequest) ProtoMessage()    {}
+func (*DeleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{47}
 }
 
-func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
+func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
 }
-func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
+func (m *DeleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteRequest.Marshal(b, m, deterministic)
 }
-func (m *FlushRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushRequest.Merge(m, src)
+func (m *DeleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteRequest.Merge(m, src)
 }
-func (m *FlushRequest) XXX_Size() int {
-	return xxx_messageInfo_FlushRequest.Size(m)
+func (m *DeleteRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteRequest.Size(m)
 }
-func (m *FlushRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
+func (m *DeleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteRequest proto.InternalMessageInfo
 
-func (m *FlushRequest) GetBase() *commonpb.MsgBase {
+func (m *DeleteRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *FlushRequest) GetDbName() string {
+func (m *DeleteRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *FlushRequest) GetCollectionNames() []string {
+func (m *DeleteRequest) GetCollectionName() string {
 	if m != nil {
-		return m.CollectionNames
+		return m.CollectionName
 	}
-	return nil
-}
-
-type FlushResponse struct {
-	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
-	XXX_unrecognized     []byte                         `json:"-"`
-	XXX_sizecache        int32                          `json:"-"`
-}
-
-func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
-func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
-func (*FlushResponse) ProtoMessage()    {}
-func (*FlushResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{47}
-}
-
-func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
-}
-func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
-}
-func (m *FlushResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_FlushResponse.Merge(m, src)
-}
-func (m *FlushResponse) XXX_Size() int {
-	return xxx_messageInfo_FlushResponse.Size(m)
-}
-func (m *FlushResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
+	return ""
 }
 
-var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
-
-func (m *FlushResponse) GetStatus() *commonpb.Status {
+func (m *DeleteRequest) GetPartitionName() string {
 	if m != nil {
-		return m.Status
+		return m.PartitionName
 	}
-	return nil
+	return ""
 }
 
-func (m *FlushResponse) GetDbName() string {
+func (m *DeleteRequest) GetExpr() string {
 	if m != nil {
-		return m.DbName
+		return m.Expr
 	}
 	return ""
 }
 
-func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
+func (m *DeleteRequest) GetHashKeys() []uint32 {
 	if m != nil {
-		return m.CollSegIDs
+		return m.HashKeys
 	}
 	return nil
 }
 
-type QueryRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	Expr                 string            `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
-	OutputFields         []string          `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
-	PartitionNames       []string          `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	TravelTimestamp      uint64            `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
-	GuaranteeTimestamp   uint64            `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *DeleteRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
 }
 
-func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
-func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryRequest) ProtoMessage()    {}
-func (*QueryRequest) Descriptor() ([]byte, []int) {
+type UpsertRequest struct {
+	Base                 *commonpb.MsgBase     `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionName        string                `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,5,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	HashKeys             []uint32              `protobuf:"varint,6,rep,packed,name=hash_keys,json=hashKeys,proto3" json:"hash_keys,omitempty"`
+	NumRows              uint32                `protobuf:"varint,7,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *UpsertRequest) Reset()         { *m = UpsertRequest{} }
+func (m *UpsertRequest) String() string { return proto.CompactTextString(m) }
+func (*UpsertRequest) ProtoMessage()    {}
+func (*UpsertRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{48}
 }
 
-func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+func (m *UpsertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpsertRequest.Unmarshal(m, b)
 }
-func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+func (m *UpsertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpsertRequest.Marshal(b, m, deterministic)
 }
-func (m *QueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryRequest.Merge(m, src)
+func (m *UpsertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpsertRequest.Merge(m, src)
 }
-func (m *QueryRequest) XXX_Size() int {
-	return xxx_messageInfo_QueryRequest.Size(m)
+func (m *UpsertRequest) XXX_Size() int {
+	return xxx_messageInfo_UpsertRequest.Size(m)
 }
-func (m *QueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+func (m *UpsertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpsertRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_UpsertRequest proto.InternalMessageInfo
 
-func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+func (m *UpsertRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetDbName() string {
+func (m *UpsertRequest) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetCollectionName() string {
+func (m *UpsertRequest) GetCollectionName() string {
 	if m != nil {
 		return m.CollectionName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetExpr() string {
+func (m *UpsertRequest) GetPartitionName() string {
 	if m != nil {
-		return m.Expr
+		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *QueryRequest) GetOutputFields() []string {
+func (m *UpsertRequest) GetFieldsData() []*schemapb.FieldData {
 	if m != nil {
-		return m.OutputFields
+		return m.FieldsData
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetPartitionNames() []string {
+func (m *UpsertRequest) GetHashKeys() []uint32 {
 	if m != nil {
-		return m.PartitionNames
+		return m.HashKeys
 	}
 	return nil
 }
 
-func (m *QueryRequest) GetTravelTimestamp() uint64 {
-	if m != nil {
-		return m.TravelTimestamp
-	}
-	return 0
-}
-
-func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+func (m *UpsertRequest) GetNumRows() uint32 {
 	if m != nil {
-		return m.GuaranteeTimestamp
+		return m.NumRows
 	}
 	return 0
 }
 
-type QueryResults struct {
-	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type PlaceholderValue struct {
+	Tag  string          `protobuf:"bytes,1,opt,name=tag,proto3" json:"tag,omitempty"`
+	Type PlaceholderType `protobuf:"varint,2,opt,name=type,proto3,enum=milvus.proto.milvus.PlaceholderType" json:"type,omitempty"`
+	// values is a 2d-array, every array contains a vector
+	Values               [][]byte `protobuf:"bytes,3,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *QueryResults) Reset()         { *m = QueryResults{} }
-func (m *QueryResults) String() string { return proto.CompactTextString(m) }
-func (*QueryResults) ProtoMessage()    {}
-func (*QueryResults) Descriptor() ([]byte, []int) {
+func (m *PlaceholderValue) Reset()         { *m = PlaceholderValue{} }
+func (m *PlaceholderValue) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderValue) ProtoMessage()    {}
+func (*PlaceholderValue) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{49}
 }
 
-func (m *QueryResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+func (m *PlaceholderValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderValue.Unmarshal(m, b)
 }
-func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+func (m *PlaceholderValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderValue.Marshal(b, m, deterministic)
 }
-func (m *QueryResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryResults.Merge(m, src)
+func (m *PlaceholderValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderValue.Merge(m, src)
 }
-func (m *QueryResults) XXX_Size() int {
-	return xxx_messageInfo_QueryResults.Size(m)
+func (m *PlaceholderValue) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderValue.Size(m)
 }
-func (m *QueryResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+func (m *PlaceholderValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderValue.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+var xxx_messageInfo_PlaceholderValue proto.InternalMessageInfo
 
-func (m *QueryResults) GetStatus() *commonpb.Status {
+func (m *PlaceholderValue) GetTag() string {
 	if m != nil {
-		return m.Status
+		return m.Tag
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+func (m *PlaceholderValue) GetType() PlaceholderType {
 	if m != nil {
-		return m.FieldsData
+		return m.Type
+	}
+	return PlaceholderType_None
+}
+
+func (m *PlaceholderValue) GetValues() [][]byte {
+	if m != nil {
+		return m.Values
 	}
 	return nil
 }
 
-type VectorIDs struct {
-	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
-	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
-	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
-	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+type PlaceholderGroup struct {
+	Placeholders         []*PlaceholderValue `protobuf:"bytes,1,rep,name=placeholders,proto3" json:"placeholders,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
-func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
-func (*VectorIDs) ProtoMessage()    {}
-func (*VectorIDs) Descriptor() ([]byte, []int) {
+func (m *PlaceholderGroup) Reset()         { *m = PlaceholderGroup{} }
+func (m *PlaceholderGroup) String() string { return proto.CompactTextString(m) }
+func (*PlaceholderGroup) ProtoMessage()    {}
+func (*PlaceholderGroup) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{50}
 }
 
-func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
-}
-func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
-}
-func (m *VectorIDs) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorIDs.Merge(m, src)
+func (m *PlaceholderGroup) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PlaceholderGroup.Unmarshal(m, b)
 }
-func (m *VectorIDs) XXX_Size() int {
-	return xxx_messageInfo_VectorIDs.Size(m)
+func (m *PlaceholderGroup) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PlaceholderGroup.Marshal(b, m, deterministic)
 }
-func (m *VectorIDs) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+func (m *PlaceholderGroup) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PlaceholderGroup.Merge(m, src)
 }
-
-var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
-
-func (m *VectorIDs) GetCollectionName() string {
-	if m != nil {
-		return m.CollectionName
-	}
-	return ""
+func (m *PlaceholderGroup) XXX_Size() int {
+	return xxx_messageInfo_PlaceholderGroup.Size(m)
 }
-
-func (m *VectorIDs) GetFieldName() string {
-	if m != nil {
-		return m.FieldName
-	}
-	return ""
+func (m *PlaceholderGroup) XXX_DiscardUnknown() {
+	xxx_messageInfo_PlaceholderGroup.DiscardUnknown(m)
 }
 
-func (m *VectorIDs) GetIdArray() *schemapb.IDs {
-	if m != nil {
-		return m.IdArray
-	}
-	return nil
-}
+var xxx_messageInfo_PlaceholderGroup proto.InternalMessageInfo
 
-func (m *VectorIDs) GetPartitionNames() []string {
+func (m *PlaceholderGroup) GetPlaceholders() []*PlaceholderValue {
 	if m != nil {
-		return m.PartitionNames
+		return m.Placeholders
 	}
 	return nil
 }
 
-type VectorsArray struct {
-	// Types that are valid to be assigned to Array:
-	//	*VectorsArray_IdArray
-	//	*VectorsArray_DataArray
-	Array                isVectorsArray_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type SearchRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	PartitionNames []string          `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	Dsl            string            `protobuf:"bytes,5,opt,name=dsl,proto3" json:"dsl,omitempty"`
+	// serialized `PlaceholderGroup`
+	PlaceholderGroup     []byte                   `protobuf:"bytes,6,opt,name=placeholder_group,json=placeholderGroup,proto3" json:"placeholder_group,omitempty"`
+	DslType              commonpb.DslType         `protobuf:"varint,7,opt,name=dsl_type,json=dslType,proto3,enum=milvus.proto.common.DslType" json:"dsl_type,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,8,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	SearchParams         []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,10,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,11,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
-func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
-func (*VectorsArray) ProtoMessage()    {}
-func (*VectorsArray) Descriptor() ([]byte, []int) {
+func (m *SearchRequest) Reset()         { *m = SearchRequest{} }
+func (m *SearchRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchRequest) ProtoMessage()    {}
+func (*SearchRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{51}
 }
 
-func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+func (m *SearchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchRequest.Unmarshal(m, b)
 }
-func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+func (m *SearchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchRequest.Marshal(b, m, deterministic)
 }
-func (m *VectorsArray) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_VectorsArray.Merge(m, src)
+func (m *SearchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchRequest.Merge(m, src)
 }
-func (m *VectorsArray) XXX_Size() int {
-	return xxx_messageInfo_VectorsArray.Size(m)
+func (m *SearchRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchRequest.Size(m)
 }
-func (m *VectorsArray) XXX_DiscardUnknown() {
-	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+func (m *SearchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+var xxx_messageInfo_SearchRequest proto.InternalMessageInfo
 
-type isVectorsArray_Array interface {
-	isVectorsArray_Array()
+func (m *SearchRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
 }
 
-type VectorsArray_IdArray struct {
-	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+func (m *SearchRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
 
-type VectorsArray_DataArray struct {
-	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+func (m *SearchRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
 }
 
-func (*VectorsArray_IdArray) isVectorsArray_Array() {}
-
-func (*VectorsArray_DataArray) isVectorsArray_Array() {}
-
-func (m *VectorsArray) GetArray() isVectorsArray_Array {
+func (m *SearchRequest) GetPartitionNames() []string {
 	if m != nil {
-		return m.Array
+		return m.PartitionNames
 	}
 	return nil
 }
 
-func (m *VectorsArray) GetIdArray() *VectorIDs {
-	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
-		return x.IdArray
+func (m *SearchRequest) GetDsl() string {
+	if m != nil {
+		return m.Dsl
 	}
-	return nil
+	return ""
 }
 
-func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
-	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
-		return x.DataArray
+func (m *SearchRequest) GetPlaceholderGroup() []byte {
+	if m != nil {
+		return m.PlaceholderGroup
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*VectorsArray) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*VectorsArray_IdArray)(nil),
-		(*VectorsArray_DataArray)(nil),
+func (m *SearchRequest) GetDslType() commonpb.DslType {
+	if m != nil {
+		return m.DslType
 	}
+	return commonpb.DslType_Dsl
 }
 
-type CalcDistanceRequest struct {
-	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
-	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
-	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
-}
-
-func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
-func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceRequest) ProtoMessage()    {}
-func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{52}
-}
-
-func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
-}
-func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
-}
-func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
-}
-func (m *CalcDistanceRequest) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceRequest.Size(m)
-}
-func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
-
-func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+func (m *SearchRequest) GetOutputFields() []string {
 	if m != nil {
-		return m.Base
+		return m.OutputFields
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+func (m *SearchRequest) GetSearchParams() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.OpLeft
+		return m.SearchParams
 	}
 	return nil
 }
 
-func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+func (m *SearchRequest) GetTravelTimestamp() uint64 {
 	if m != nil {
-		return m.OpRight
+		return m.TravelTimestamp
 	}
-	return nil
+	return 0
 }
 
-func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+func (m *SearchRequest) GetGuaranteeTimestamp() uint64 {
 	if m != nil {
-		return m.Params
+		return m.GuaranteeTimestamp
 	}
-	return nil
-}
-
-type CalcDistanceResults struct {
-	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
-	//
-	// Types that are valid to be assigned to Array:
-	//	*CalcDistanceResults_IntDist
-	//	*CalcDistanceResults_FloatDist
-	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
-	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
-	XXX_unrecognized     []byte                      `json:"-"`
-	XXX_sizecache        int32                       `json:"-"`
+	return 0
 }
 
-func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
-func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
-func (*CalcDistanceResults) ProtoMessage()    {}
-func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{53}
+type Hits struct {
+	IDs                  []int64   `protobuf:"varint,1,rep,packed,name=IDs,proto3" json:"IDs,omitempty"`
+	RowData              [][]byte  `protobuf:"bytes,2,rep,name=row_data,json=rowData,proto3" json:"row_data,omitempty"`
+	Scores               []float32 `protobuf:"fixed32,3,rep,packed,name=scores,proto3" json:"scores,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+func (m *Hits) Reset()         { *m = Hits{} }
+func (m *Hits) String() string { return proto.CompactTextString(m) }
+func (*Hits) ProtoMessage()    {}
+func (*Hits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{52}
 }
-func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+
+func (m *Hits) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Hits.Unmarshal(m, b)
 }
-func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+func (m *Hits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Hits.Marshal(b, m, deterministic)
 }
-func (m *CalcDistanceResults) XXX_Size() int {
-	return xxx_messageInfo_CalcDistanceResults.Size(m)
+func (m *Hits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Hits.Merge(m, src)
 }
-func (m *CalcDistanceResults) XXX_DiscardUnknown() {
-	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+func (m *Hits) XXX_Size() int {
+	return xxx_messageInfo_Hits.Size(m)
+}
+func (m *Hits) XXX_DiscardUnknown() {
+	xxx_messageInfo_Hits.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+var xxx_messageInfo_Hits proto.InternalMessageInfo
 
-func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+func (m *Hits) GetIDs() []int64 {
 	if m != nil {
-		return m.Status
+		return m.IDs
 	}
 	return nil
 }
 
-type isCalcDistanceResults_Array interface {
-	isCalcDistanceResults_Array()
+func (m *Hits) GetRowData() [][]byte {
+	if m != nil {
+		return m.RowData
+	}
+	return nil
 }
 
-type CalcDistanceResults_IntDist struct {
-	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+func (m *Hits) GetScores() []float32 {
+	if m != nil {
+		return m.Scores
+	}
+	return nil
 }
 
-type CalcDistanceResults_FloatDist struct {
-	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+type SearchResults struct {
+	Status               *commonpb.Status           `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Results              *schemapb.SearchResultData `protobuf:"bytes,2,opt,name=results,proto3" json:"results,omitempty"`
+	IteratorCursor       string                     `protobuf:"bytes,3,opt,name=iterator_cursor,json=iteratorCursor,proto3" json:"iterator_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
-
-func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+func (m *SearchResults) Reset()         { *m = SearchResults{} }
+func (m *SearchResults) String() string { return proto.CompactTextString(m) }
+func (*SearchResults) ProtoMessage()    {}
+func (*SearchResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{53}
+}
 
-func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
-	if m != nil {
-		return m.Array
-	}
-	return nil
+func (m *SearchResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchResults.Unmarshal(m, b)
+}
+func (m *SearchResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchResults.Marshal(b, m, deterministic)
+}
+func (m *SearchResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchResults.Merge(m, src)
+}
+func (m *SearchResults) XXX_Size() int {
+	return xxx_messageInfo_SearchResults.Size(m)
+}
+func (m *SearchResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchResults.DiscardUnknown(m)
 }
 
-func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
-		return x.IntDist
+var xxx_messageInfo_SearchResults proto.InternalMessageInfo
+
+func (m *SearchResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
 	}
 	return nil
 }
 
-func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
-	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
-		return x.FloatDist
+func (m *SearchResults) GetResults() *schemapb.SearchResultData {
+	if m != nil {
+		return m.Results
 	}
 	return nil
 }
 
-// XXX_OneofWrappers is for the internal use of the proto package.
-func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
-	return []interface{}{
-		(*CalcDistanceResults_IntDist)(nil),
-		(*CalcDistanceResults_FloatDist)(nil),
+func (m *SearchResults) GetIteratorCursor() string {
+	if m != nil {
+		return m.IteratorCursor
 	}
+	return ""
 }
 
-type PersistentSegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type FlushRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionNames      []string          `protobuf:"bytes,3,rep,name=collection_names,json=collectionNames,proto3" json:"collection_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
-func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*PersistentSegmentInfo) ProtoMessage()    {}
-func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+func (m *FlushRequest) Reset()         { *m = FlushRequest{} }
+func (m *FlushRequest) String() string { return proto.CompactTextString(m) }
+func (*FlushRequest) ProtoMessage()    {}
+func (*FlushRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{54}
 }
 
-func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
-}
-func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+func (m *FlushRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushRequest.Unmarshal(m, b)
 }
-func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+func (m *FlushRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushRequest.Marshal(b, m, deterministic)
 }
-func (m *PersistentSegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+func (m *FlushRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushRequest.Merge(m, src)
 }
-func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+func (m *FlushRequest) XXX_Size() int {
+	return xxx_messageInfo_FlushRequest.Size(m)
 }
-
-var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
-
-func (m *PersistentSegmentInfo) GetSegmentID() int64 {
-	if m != nil {
-		return m.SegmentID
-	}
-	return 0
+func (m *FlushRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushRequest.DiscardUnknown(m)
 }
 
-func (m *PersistentSegmentInfo) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
+var xxx_messageInfo_FlushRequest proto.InternalMessageInfo
 
-func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+func (m *FlushRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.PartitionID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *PersistentSegmentInfo) GetNumRows() int64 {
+func (m *FlushRequest) GetDbName() string {
 	if m != nil {
-		return m.NumRows
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+func (m *FlushRequest) GetCollectionNames() []string {
 	if m != nil {
-		return m.State
+		return m.CollectionNames
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return nil
 }
 
-type GetPersistentSegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type FlushResponse struct {
+	Status               *commonpb.Status               `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	DbName               string                         `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollSegIDs           map[string]*schemapb.LongArray `protobuf:"bytes,3,rep,name=coll_segIDs,json=collSegIDs,proto3" json:"coll_segIDs,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}                       `json:"-"`
+	XXX_unrecognized     []byte                         `json:"-"`
+	XXX_sizecache        int32                          `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
-func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+func (m *FlushResponse) Reset()         { *m = FlushResponse{} }
+func (m *FlushResponse) String() string { return proto.CompactTextString(m) }
+func (*FlushResponse) ProtoMessage()    {}
+func (*FlushResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{55}
 }
 
-func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+func (m *FlushResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FlushResponse.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *FlushResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FlushResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+func (m *FlushResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlushResponse.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+func (m *FlushResponse) XXX_Size() int {
+	return xxx_messageInfo_FlushResponse.Size(m)
 }
-func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+func (m *FlushResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlushResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_FlushResponse proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *FlushResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+func (m *FlushResponse) GetDbName() string {
 	if m != nil {
 		return m.DbName
 	}
 	return ""
 }
 
-func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+func (m *FlushResponse) GetCollSegIDs() map[string]*schemapb.LongArray {
 	if m != nil {
-		return m.CollectionName
+		return m.CollSegIDs
 	}
-	return ""
+	return nil
 }
 
-type GetPersistentSegmentInfoResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+type QueryRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string                   `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName       string                   `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	Expr                 string                   `protobuf:"bytes,4,opt,name=expr,proto3" json:"expr,omitempty"`
+	OutputFields         []string                 `protobuf:"bytes,5,rep,name=output_fields,json=outputFields,proto3" json:"output_fields,omitempty"`
+	PartitionNames       []string                 `protobuf:"bytes,6,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	TravelTimestamp      uint64                   `protobuf:"varint,7,opt,name=travel_timestamp,json=travelTimestamp,proto3" json:"travel_timestamp,omitempty"`
+	GuaranteeTimestamp   uint64                   `protobuf:"varint,8,opt,name=guarantee_timestamp,json=guaranteeTimestamp,proto3" json:"guarantee_timestamp,omitempty"`
+	QueryParams          []*commonpb.KeyValuePair `protobuf:"bytes,9,rep,name=query_params,json=queryParams,proto3" json:"query_params,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
-func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
-func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+func (m *QueryRequest) Reset()         { *m = QueryRequest{} }
+func (m *QueryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRequest) ProtoMessage()    {}
+func (*QueryRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_02345ba45cc0e303, []int{56}
 }
 
-func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+func (m *QueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryRequest.Unmarshal(m, b)
+}
+func (m *QueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryRequest.Marshal(b, m, deterministic)
+}
+func (m *QueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRequest.Merge(m, src)
+}
+func (m *QueryRequest) XXX_Size() int {
+	return xxx_messageInfo_QueryRequest.Size(m)
+}
+func (m *QueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRequest proto.InternalMessageInfo
+
+func (m *QueryRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetExpr() string {
+	if m != nil {
+		return m.Expr
+	}
+	return ""
+}
+
+func (m *QueryRequest) GetOutputFields() []string {
+	if m != nil {
+		return m.OutputFields
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+func (m *QueryRequest) GetTravelTimestamp() uint64 {
+	if m != nil {
+		return m.TravelTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetGuaranteeTimestamp() uint64 {
+	if m != nil {
+		return m.GuaranteeTimestamp
+	}
+	return 0
+}
+
+func (m *QueryRequest) GetQueryParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.QueryParams
+	}
+	return nil
+}
+
+type QueryResults struct {
+	Status               *commonpb.Status      `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	FieldsData           []*schemapb.FieldData `protobuf:"bytes,2,rep,name=fields_data,json=fieldsData,proto3" json:"fields_data,omitempty"`
+	IteratorCursor       string                `protobuf:"bytes,3,opt,name=iterator_cursor,json=iteratorCursor,proto3" json:"iterator_cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QueryResults) Reset()         { *m = QueryResults{} }
+func (m *QueryResults) String() string { return proto.CompactTextString(m) }
+func (*QueryResults) ProtoMessage()    {}
+func (*QueryResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{57}
+}
+
+func (m *QueryResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QueryResults.Unmarshal(m, b)
+}
+func (m *QueryResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QueryResults.Marshal(b, m, deterministic)
+}
+func (m *QueryResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResults.Merge(m, src)
+}
+func (m *QueryResults) XXX_Size() int {
+	return xxx_messageInfo_QueryResults.Size(m)
+}
+func (m *QueryResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResults proto.InternalMessageInfo
+
+func (m *QueryResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *QueryResults) GetFieldsData() []*schemapb.FieldData {
+	if m != nil {
+		return m.FieldsData
+	}
+	return nil
+}
+
+func (m *QueryResults) GetIteratorCursor() string {
+	if m != nil {
+		return m.IteratorCursor
+	}
+	return ""
+}
+
+type VectorIDs struct {
+	CollectionName       string        `protobuf:"bytes,1,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	FieldName            string        `protobuf:"bytes,2,opt,name=field_name,json=fieldName,proto3" json:"field_name,omitempty"`
+	IdArray              *schemapb.IDs `protobuf:"bytes,3,opt,name=id_array,json=idArray,proto3" json:"id_array,omitempty"`
+	PartitionNames       []string      `protobuf:"bytes,4,rep,name=partition_names,json=partitionNames,proto3" json:"partition_names,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *VectorIDs) Reset()         { *m = VectorIDs{} }
+func (m *VectorIDs) String() string { return proto.CompactTextString(m) }
+func (*VectorIDs) ProtoMessage()    {}
+func (*VectorIDs) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{58}
+}
+
+func (m *VectorIDs) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorIDs.Unmarshal(m, b)
+}
+func (m *VectorIDs) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorIDs.Marshal(b, m, deterministic)
+}
+func (m *VectorIDs) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorIDs.Merge(m, src)
+}
+func (m *VectorIDs) XXX_Size() int {
+	return xxx_messageInfo_VectorIDs.Size(m)
+}
+func (m *VectorIDs) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorIDs.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorIDs proto.InternalMessageInfo
+
+func (m *VectorIDs) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetFieldName() string {
+	if m != nil {
+		return m.FieldName
+	}
+	return ""
+}
+
+func (m *VectorIDs) GetIdArray() *schemapb.IDs {
+	if m != nil {
+		return m.IdArray
+	}
+	return nil
+}
+
+func (m *VectorIDs) GetPartitionNames() []string {
+	if m != nil {
+		return m.PartitionNames
+	}
+	return nil
+}
+
+type VectorsArray struct {
+	// Types that are valid to be assigned to Array:
+	//	*VectorsArray_IdArray
+	//	*VectorsArray_DataArray
+	Array                isVectorsArray_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *VectorsArray) Reset()         { *m = VectorsArray{} }
+func (m *VectorsArray) String() string { return proto.CompactTextString(m) }
+func (*VectorsArray) ProtoMessage()    {}
+func (*VectorsArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{59}
+}
+
+func (m *VectorsArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_VectorsArray.Unmarshal(m, b)
+}
+func (m *VectorsArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_VectorsArray.Marshal(b, m, deterministic)
+}
+func (m *VectorsArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VectorsArray.Merge(m, src)
+}
+func (m *VectorsArray) XXX_Size() int {
+	return xxx_messageInfo_VectorsArray.Size(m)
+}
+func (m *VectorsArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_VectorsArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VectorsArray proto.InternalMessageInfo
+
+type isVectorsArray_Array interface {
+	isVectorsArray_Array()
+}
+
+type VectorsArray_IdArray struct {
+	IdArray *VectorIDs `protobuf:"bytes,1,opt,name=id_array,json=idArray,proto3,oneof"`
+}
+
+type VectorsArray_DataArray struct {
+	DataArray *schemapb.VectorField `protobuf:"bytes,2,opt,name=data_array,json=dataArray,proto3,oneof"`
+}
+
+func (*VectorsArray_IdArray) isVectorsArray_Array() {}
+
+func (*VectorsArray_DataArray) isVectorsArray_Array() {}
+
+func (m *VectorsArray) GetArray() isVectorsArray_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetIdArray() *VectorIDs {
+	if x, ok := m.GetArray().(*VectorsArray_IdArray); ok {
+		return x.IdArray
+	}
+	return nil
+}
+
+func (m *VectorsArray) GetDataArray() *schemapb.VectorField {
+	if x, ok := m.GetArray().(*VectorsArray_DataArray); ok {
+		return x.DataArray
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*VectorsArray) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*VectorsArray_IdArray)(nil),
+		(*VectorsArray_DataArray)(nil),
+	}
+}
+
+type CalcDistanceRequest struct {
+	Base                 *commonpb.MsgBase        `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	OpLeft               *VectorsArray            `protobuf:"bytes,2,opt,name=op_left,json=opLeft,proto3" json:"op_left,omitempty"`
+	OpRight              *VectorsArray            `protobuf:"bytes,3,opt,name=op_right,json=opRight,proto3" json:"op_right,omitempty"`
+	Params               []*commonpb.KeyValuePair `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *CalcDistanceRequest) Reset()         { *m = CalcDistanceRequest{} }
+func (m *CalcDistanceRequest) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceRequest) ProtoMessage()    {}
+func (*CalcDistanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{60}
+}
+
+func (m *CalcDistanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceRequest.Unmarshal(m, b)
+}
+func (m *CalcDistanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceRequest.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceRequest.Merge(m, src)
+}
+func (m *CalcDistanceRequest) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceRequest.Size(m)
+}
+func (m *CalcDistanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceRequest proto.InternalMessageInfo
+
+func (m *CalcDistanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpLeft() *VectorsArray {
+	if m != nil {
+		return m.OpLeft
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetOpRight() *VectorsArray {
+	if m != nil {
+		return m.OpRight
+	}
+	return nil
+}
+
+func (m *CalcDistanceRequest) GetParams() []*commonpb.KeyValuePair {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type CalcDistanceResults struct {
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// num(op_left)*num(op_right) distance values, "HAMMIN" return integer distance
+	//
+	// Types that are valid to be assigned to Array:
+	//	*CalcDistanceResults_IntDist
+	//	*CalcDistanceResults_FloatDist
+	Array                isCalcDistanceResults_Array `protobuf_oneof:"array"`
+	XXX_NoUnkeyedLiteral struct{}                    `json:"-"`
+	XXX_unrecognized     []byte                      `json:"-"`
+	XXX_sizecache        int32                       `json:"-"`
+}
+
+func (m *CalcDistanceResults) Reset()         { *m = CalcDistanceResults{} }
+func (m *CalcDistanceResults) String() string { return proto.CompactTextString(m) }
+func (*CalcDistanceResults) ProtoMessage()    {}
+func (*CalcDistanceResults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{61}
+}
+
+func (m *CalcDistanceResults) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CalcDistanceResults.Unmarshal(m, b)
+}
+func (m *CalcDistanceResults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CalcDistanceResults.Marshal(b, m, deterministic)
+}
+func (m *CalcDistanceResults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CalcDistanceResults.Merge(m, src)
+}
+func (m *CalcDistanceResults) XXX_Size() int {
+	return xxx_messageInfo_CalcDistanceResults.Size(m)
+}
+func (m *CalcDistanceResults) XXX_DiscardUnknown() {
+	xxx_messageInfo_CalcDistanceResults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CalcDistanceResults proto.InternalMessageInfo
+
+func (m *CalcDistanceResults) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type isCalcDistanceResults_Array interface {
+	isCalcDistanceResults_Array()
+}
+
+type CalcDistanceResults_IntDist struct {
+	IntDist *schemapb.IntArray `protobuf:"bytes,2,opt,name=int_dist,json=intDist,proto3,oneof"`
+}
+
+type CalcDistanceResults_FloatDist struct {
+	FloatDist *schemapb.FloatArray `protobuf:"bytes,3,opt,name=float_dist,json=floatDist,proto3,oneof"`
+}
+
+func (*CalcDistanceResults_IntDist) isCalcDistanceResults_Array() {}
+
+func (*CalcDistanceResults_FloatDist) isCalcDistanceResults_Array() {}
+
+func (m *CalcDistanceResults) GetArray() isCalcDistanceResults_Array {
+	if m != nil {
+		return m.Array
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetIntDist() *schemapb.IntArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_IntDist); ok {
+		return x.IntDist
+	}
+	return nil
+}
+
+func (m *CalcDistanceResults) GetFloatDist() *schemapb.FloatArray {
+	if x, ok := m.GetArray().(*CalcDistanceResults_FloatDist); ok {
+		return x.FloatDist
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*CalcDistanceResults) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*CalcDistanceResults_IntDist)(nil),
+		(*CalcDistanceResults_FloatDist)(nil),
+	}
+}
+
+type PersistentSegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	NumRows              int64                 `protobuf:"varint,4,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,5,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *PersistentSegmentInfo) Reset()         { *m = PersistentSegmentInfo{} }
+func (m *PersistentSegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*PersistentSegmentInfo) ProtoMessage()    {}
+func (*PersistentSegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{62}
+}
+
+func (m *PersistentSegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PersistentSegmentInfo.Unmarshal(m, b)
+}
+func (m *PersistentSegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PersistentSegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *PersistentSegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersistentSegmentInfo.Merge(m, src)
+}
+func (m *PersistentSegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_PersistentSegmentInfo.Size(m)
+}
+func (m *PersistentSegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersistentSegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersistentSegmentInfo proto.InternalMessageInfo
+
+func (m *PersistentSegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *PersistentSegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetPersistentSegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoRequest) Reset()         { *m = GetPersistentSegmentInfoRequest{} }
+func (m *GetPersistentSegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoRequest) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{63}
+}
+
+func (m *GetPersistentSegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoRequest.Size(m)
+}
+func (m *GetPersistentSegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetPersistentSegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetPersistentSegmentInfoResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*PersistentSegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetPersistentSegmentInfoResponse) Reset()         { *m = GetPersistentSegmentInfoResponse{} }
+func (m *GetPersistentSegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPersistentSegmentInfoResponse) ProtoMessage()    {}
+func (*GetPersistentSegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{64}
+}
+
+func (m *GetPersistentSegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+}
+func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type QuerySegmentInfo struct {
+	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
+	MemSize              int64                 `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
+	NumRows              int64                 `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
+	IndexName            string                `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
+	IndexID              int64                 `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
+	NodeID               int64                 `protobuf:"varint,8,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
+	State                commonpb.SegmentState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
+func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
+func (*QuerySegmentInfo) ProtoMessage()    {}
+func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{65}
+}
+
+func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
+}
+func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+}
+func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+}
+func (m *QuerySegmentInfo) XXX_Size() int {
+	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+}
+func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
+
+func (m *QuerySegmentInfo) GetSegmentID() int64 {
+	if m != nil {
+		return m.SegmentID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetPartitionID() int64 {
+	if m != nil {
+		return m.PartitionID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetMemSize() int64 {
+	if m != nil {
+		return m.MemSize
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNumRows() int64 {
+	if m != nil {
+		return m.NumRows
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetIndexName() string {
+	if m != nil {
+		return m.IndexName
+	}
+	return ""
+}
+
+func (m *QuerySegmentInfo) GetIndexID() int64 {
+	if m != nil {
+		return m.IndexID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetNodeID() int64 {
+	if m != nil {
+		return m.NodeID
+	}
+	return 0
+}
+
+func (m *QuerySegmentInfo) GetState() commonpb.SegmentState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.SegmentState_SegmentStateNone
+}
+
+type GetQuerySegmentInfoRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
+	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
+func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
+func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{66}
+}
+
+func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+}
+func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoRequest) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
+}
+
+func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+	if m != nil {
+		return m.CollectionName
+	}
+	return ""
+}
+
+type GetQuerySegmentInfoResponse struct {
+	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
+func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
+func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{67}
+}
+
+func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
+	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+}
+func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+
+func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+type DummyRequest struct {
+	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
+func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
+func (*DummyRequest) ProtoMessage()    {}
+func (*DummyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{68}
+}
+
+func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+}
+func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+}
+func (m *DummyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyRequest.Merge(m, src)
+}
+func (m *DummyRequest) XXX_Size() int {
+	return xxx_messageInfo_DummyRequest.Size(m)
+}
+func (m *DummyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+
+func (m *DummyRequest) GetRequestType() string {
+	if m != nil {
+		return m.RequestType
+	}
+	return ""
+}
+
+type DummyResponse struct {
+	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
+func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
+func (*DummyResponse) ProtoMessage()    {}
+func (*DummyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{69}
+}
+
+func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
+}
+func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
+}
+func (m *DummyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DummyResponse.Merge(m, src)
+}
+func (m *DummyResponse) XXX_Size() int {
+	return xxx_messageInfo_DummyResponse.Size(m)
+}
+func (m *DummyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
+
+func (m *DummyResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+type RegisterLinkRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
+func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkRequest) ProtoMessage()    {}
+func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{70}
+}
+
+func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+}
+func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+}
+func (m *RegisterLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+}
+func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+
+type RegisterLinkResponse struct {
+	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
+func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RegisterLinkResponse) ProtoMessage()    {}
+func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{71}
+}
+
+func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
+}
+func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
+}
+func (m *RegisterLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RegisterLinkResponse.Size(m)
+}
+func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
+
+func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+	if m != nil {
+		return m.Address
+	}
+	return nil
+}
+
+func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+type GetMetricsRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
+func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsRequest) ProtoMessage()    {}
+func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{72}
+}
+
+func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+}
+func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+}
+func (m *GetMetricsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsRequest.Size(m)
+}
+func (m *GetMetricsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+
+func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetMetricsRequest) GetRequest() string {
+	if m != nil {
+		return m.Request
+	}
+	return ""
+}
+
+type GetMetricsResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
+	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
+func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMetricsResponse) ProtoMessage()    {}
+func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{73}
+}
+
+func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+}
+func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+}
+func (m *GetMetricsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMetricsResponse.Size(m)
+}
+func (m *GetMetricsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+
+func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetMetricsResponse) GetResponse() string {
+	if m != nil {
+		return m.Response
+	}
+	return ""
+}
+
+func (m *GetMetricsResponse) GetComponentName() string {
+	if m != nil {
+		return m.ComponentName
+	}
+	return ""
+}
+
+//
+// Do load balancing operation from src_nodeID to dst_nodeID.
+type LoadBalanceRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	SrcNodeID            int64             `protobuf:"varint,2,opt,name=src_nodeID,json=srcNodeID,proto3" json:"src_nodeID,omitempty"`
+	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
+	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
+func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
+func (*LoadBalanceRequest) ProtoMessage()    {}
+func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{74}
+}
+
+func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+}
+func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+}
+func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+}
+func (m *LoadBalanceRequest) XXX_Size() int {
+	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+}
+func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+
+func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSrcNodeID() int64 {
+	if m != nil {
+		return m.SrcNodeID
+	}
+	return 0
+}
+
+func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
+	if m != nil {
+		return m.DstNodeIDs
+	}
+	return nil
+}
+
+func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+	if m != nil {
+		return m.SealedSegmentIDs
+	}
+	return nil
+}
+
+type ManualCompactionRequest struct {
+	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest{} }
+func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionRequest) ProtoMessage()    {}
+func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{75}
+}
+
+func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionRequest.Unmarshal(m, b)
+}
+func (m *ManualCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionRequest.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionRequest.Merge(m, src)
+}
+func (m *ManualCompactionRequest) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionRequest.Size(m)
+}
+func (m *ManualCompactionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionRequest proto.InternalMessageInfo
+
+func (m *ManualCompactionRequest) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
+}
+
+func (m *ManualCompactionRequest) GetTimetravel() uint64 {
+	if m != nil {
+		return m.Timetravel
+	}
+	return 0
+}
+
+type ManualCompactionResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
+func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
+func (*ManualCompactionResponse) ProtoMessage()    {}
+func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{76}
+}
+
+func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManualCompactionResponse.Unmarshal(m, b)
+}
+func (m *ManualCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManualCompactionResponse.Marshal(b, m, deterministic)
+}
+func (m *ManualCompactionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManualCompactionResponse.Merge(m, src)
+}
+func (m *ManualCompactionResponse) XXX_Size() int {
+	return xxx_messageInfo_ManualCompactionResponse.Size(m)
+}
+func (m *ManualCompactionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManualCompactionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManualCompactionResponse proto.InternalMessageInfo
+
+func (m *ManualCompactionResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ManualCompactionResponse) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateRequest{} }
+func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateRequest) ProtoMessage()    {}
+func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{77}
+}
+
+func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateRequest.Merge(m, src)
+}
+func (m *GetCompactionStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateRequest.Size(m)
+}
+func (m *GetCompactionStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateRequest proto.InternalMessageInfo
+
+func (m *GetCompactionStateRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionStateResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
+	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
+	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateResponse{} }
+func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionStateResponse) ProtoMessage()    {}
+func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{78}
+}
+
+func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionStateResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionStateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionStateResponse.Merge(m, src)
+}
+func (m *GetCompactionStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionStateResponse.Size(m)
+}
+func (m *GetCompactionStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionStateResponse proto.InternalMessageInfo
+
+func (m *GetCompactionStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionStateResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionStateResponse) GetExecutingPlanNo() int64 {
+	if m != nil {
+		return m.ExecutingPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetTimeoutPlanNo() int64 {
+	if m != nil {
+		return m.TimeoutPlanNo
+	}
+	return 0
+}
+
+func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
+	if m != nil {
+		return m.CompletedPlanNo
+	}
+	return 0
+}
+
+type GetCompactionPlansRequest struct {
+	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansRequest{} }
+func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlansRequest) ProtoMessage()    {}
+func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{79}
+}
+
+func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlansRequest.Unmarshal(m, b)
+}
+func (m *GetCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlansRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlansRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlansRequest.Merge(m, src)
+}
+func (m *GetCompactionPlansRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlansRequest.Size(m)
+}
+func (m *GetCompactionPlansRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlansRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlansRequest proto.InternalMessageInfo
+
+func (m *GetCompactionPlansRequest) GetCompactionID() int64 {
+	if m != nil {
+		return m.CompactionID
+	}
+	return 0
+}
+
+type GetCompactionPlansResponse struct {
+	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
+	MergeInfos           []*CompactionMergeInfo   `protobuf:"bytes,3,rep,name=mergeInfos,proto3" json:"mergeInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansResponse{} }
+func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompactionPlansResponse) ProtoMessage()    {}
+func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{80}
+}
+
+func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompactionPlansResponse.Unmarshal(m, b)
+}
+func (m *GetCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompactionPlansResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCompactionPlansResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompactionPlansResponse.Merge(m, src)
+}
+func (m *GetCompactionPlansResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
+}
+func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+
+func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.CompactionState_UndefiedState
+}
+
+func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+	if m != nil {
+		return m.MergeInfos
+	}
+	return nil
+}
+
+type CompactionMergeInfo struct {
+	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
+	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
+func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
+func (*CompactionMergeInfo) ProtoMessage()    {}
+func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{81}
+}
+
+func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+}
+func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+}
+func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+}
+func (m *CompactionMergeInfo) XXX_Size() int {
+	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+}
+func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+
+func (m *CompactionMergeInfo) GetSources() []int64 {
+	if m != nil {
+		return m.Sources
+	}
+	return nil
+}
+
+func (m *CompactionMergeInfo) GetTarget() int64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type GetFlushStateRequest struct {
+	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
+func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateRequest) ProtoMessage()    {}
+func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{82}
+}
+
+func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+}
+func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+}
+func (m *GetFlushStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+}
+func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+
+func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+	if m != nil {
+		return m.SegmentIDs
+	}
+	return nil
+}
+
+type GetFlushStateResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
+func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlushStateResponse) ProtoMessage()    {}
+func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{83}
+}
+
+func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.Merge(m, src)
+func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPersistentSegmentInfoResponse.Size(m)
+func (m *GetFlushStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlushStateResponse.Size(m)
 }
-func (m *GetPersistentSegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPersistentSegmentInfoResponse.DiscardUnknown(m)
+func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPersistentSegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
 
-func (m *GetPersistentSegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetPersistentSegmentInfoResponse) GetInfos() []*PersistentSegmentInfo {
+func (m *GetFlushStateResponse) GetFlushed() bool {
 	if m != nil {
-		return m.Infos
+		return m.Flushed
 	}
-	return nil
+	return false
 }
 
-type QuerySegmentInfo struct {
-	SegmentID            int64                 `protobuf:"varint,1,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
-	CollectionID         int64                 `protobuf:"varint,2,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	PartitionID          int64                 `protobuf:"varint,3,opt,name=partitionID,proto3" json:"partitionID,omitempty"`
-	MemSize              int64                 `protobuf:"varint,4,opt,name=mem_size,json=memSize,proto3" json:"mem_size,omitempty"`
-	NumRows              int64                 `protobuf:"varint,5,opt,name=num_rows,json=numRows,proto3" json:"num_rows,omitempty"`
-	IndexName            string                `protobuf:"bytes,6,opt,name=index_name,json=indexName,proto3" json:"index_name,omitempty"`
-	IndexID              int64                 `protobuf:"varint,7,opt,name=indexID,proto3" json:"indexID,omitempty"`
-	NodeID               int64                 `protobuf:"varint,8,opt,name=nodeID,proto3" json:"nodeID,omitempty"`
-	State                commonpb.SegmentState `protobuf:"varint,9,opt,name=state,proto3,enum=milvus.proto.common.SegmentState" json:"state,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type ImportRequest struct {
+	Base           *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	DbName         string            `protobuf:"bytes,2,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
+	CollectionName string            `protobuf:"bytes,3,opt,name=collection_name,json=collectionName,proto3" json:"collection_name,omitempty"`
+	// The partition to import into, the default partition is used if it is empty
+	PartitionName string `protobuf:"bytes,4,opt,name=partition_name,json=partitionName,proto3" json:"partition_name,omitempty"`
+	// Each json file holds rows if it is true, otherwise each numpy file holds a column named by the file name
+	RowBased bool `protobuf:"varint,5,opt,name=row_based,json=rowBased,proto3" json:"row_based,omitempty"`
+	// The paths of the files in the object storage
+	Files                []string                 `protobuf:"bytes,6,rep,name=files,proto3" json:"files,omitempty"`
+	Options              []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=options,proto3" json:"options,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *QuerySegmentInfo) Reset()         { *m = QuerySegmentInfo{} }
-func (m *QuerySegmentInfo) String() string { return proto.CompactTextString(m) }
-func (*QuerySegmentInfo) ProtoMessage()    {}
-func (*QuerySegmentInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{57}
+func (m *ImportRequest) Reset()         { *m = ImportRequest{} }
+func (m *ImportRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportRequest) ProtoMessage()    {}
+func (*ImportRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{84}
 }
 
-func (m *QuerySegmentInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_QuerySegmentInfo.Unmarshal(m, b)
-}
-func (m *QuerySegmentInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_QuerySegmentInfo.Marshal(b, m, deterministic)
+func (m *ImportRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportRequest.Unmarshal(m, b)
 }
-func (m *QuerySegmentInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QuerySegmentInfo.Merge(m, src)
+func (m *ImportRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportRequest.Marshal(b, m, deterministic)
 }
-func (m *QuerySegmentInfo) XXX_Size() int {
-	return xxx_messageInfo_QuerySegmentInfo.Size(m)
+func (m *ImportRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportRequest.Merge(m, src)
 }
-func (m *QuerySegmentInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_QuerySegmentInfo.DiscardUnknown(m)
+func (m *ImportRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportRequest.Size(m)
 }
-
-var xxx_messageInfo_QuerySegmentInfo proto.InternalMessageInfo
-
-func (m *QuerySegmentInfo) GetSegmentID() int64 {
-	if m != nil {
-		return m.SegmentID
-	}
-	return 0
+func (m *ImportRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportRequest.DiscardUnknown(m)
 }
 
-func (m *QuerySegmentInfo) GetCollectionID() int64 {
-	if m != nil {
-		return m.CollectionID
-	}
-	return 0
-}
+var xxx_messageInfo_ImportRequest proto.InternalMessageInfo
 
-func (m *QuerySegmentInfo) GetPartitionID() int64 {
+func (m *ImportRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.PartitionID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetMemSize() int64 {
+func (m *ImportRequest) GetDbName() string {
 	if m != nil {
-		return m.MemSize
+		return m.DbName
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetNumRows() int64 {
+func (m *ImportRequest) GetCollectionName() string {
 	if m != nil {
-		return m.NumRows
+		return m.CollectionName
 	}
-	return 0
+	return ""
 }
 
-func (m *QuerySegmentInfo) GetIndexName() string {
+func (m *ImportRequest) GetPartitionName() string {
 	if m != nil {
-		return m.IndexName
+		return m.PartitionName
 	}
 	return ""
 }
 
-func (m *QuerySegmentInfo) GetIndexID() int64 {
+func (m *ImportRequest) GetRowBased() bool {
 	if m != nil {
-		return m.IndexID
+		return m.RowBased
 	}
-	return 0
+	return false
 }
 
-func (m *QuerySegmentInfo) GetNodeID() int64 {
+func (m *ImportRequest) GetFiles() []string {
 	if m != nil {
-		return m.NodeID
+		return m.Files
 	}
-	return 0
+	return nil
 }
 
-func (m *QuerySegmentInfo) GetState() commonpb.SegmentState {
+func (m *ImportRequest) GetOptions() []*commonpb.KeyValuePair {
 	if m != nil {
-		return m.State
+		return m.Options
 	}
-	return commonpb.SegmentState_SegmentStateNone
+	return nil
 }
 
-type GetQuerySegmentInfoRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	DbName               string            `protobuf:"bytes,2,opt,name=dbName,proto3" json:"dbName,omitempty"`
-	CollectionName       string            `protobuf:"bytes,3,opt,name=collectionName,proto3" json:"collectionName,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+type ImportResponse struct {
+	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	TaskID               int64            `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoRequest) Reset()         { *m = GetQuerySegmentInfoRequest{} }
-func (m *GetQuerySegmentInfoRequest) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoRequest) ProtoMessage()    {}
-func (*GetQuerySegmentInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{58}
+func (m *ImportResponse) Reset()         { *m = ImportResponse{} }
+func (m *ImportResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportResponse) ProtoMessage()    {}
+func (*ImportResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{85}
 }
 
-func (m *GetQuerySegmentInfoRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Unmarshal(m, b)
+func (m *ImportResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportResponse.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Marshal(b, m, deterministic)
+func (m *ImportResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportResponse.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.Merge(m, src)
+func (m *ImportResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportResponse.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoRequest.Size(m)
+func (m *ImportResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportResponse.Size(m)
 }
-func (m *GetQuerySegmentInfoRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoRequest.DiscardUnknown(m)
+func (m *ImportResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImportResponse proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoRequest) GetBase() *commonpb.MsgBase {
+func (m *ImportResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.Base
+		return m.Status
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoRequest) GetDbName() string {
-	if m != nil {
-		return m.DbName
-	}
-	return ""
-}
-
-func (m *GetQuerySegmentInfoRequest) GetCollectionName() string {
+func (m *ImportResponse) GetTaskID() int64 {
 	if m != nil {
-		return m.CollectionName
+		return m.TaskID
 	}
-	return ""
+	return 0
 }
 
-type GetQuerySegmentInfoResponse struct {
-	Status               *commonpb.Status    `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Infos                []*QuerySegmentInfo `protobuf:"bytes,2,rep,name=infos,proto3" json:"infos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+type GetImportStateRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	TaskID               int64             `protobuf:"varint,2,opt,name=taskID,proto3" json:"taskID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GetQuerySegmentInfoResponse) Reset()         { *m = GetQuerySegmentInfoResponse{} }
-func (m *GetQuerySegmentInfoResponse) String() string { return proto.CompactTextString(m) }
-func (*GetQuerySegmentInfoResponse) ProtoMessage()    {}
-func (*GetQuerySegmentInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{59}
+func (m *GetImportStateRequest) Reset()         { *m = GetImportStateRequest{} }
+func (m *GetImportStateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateRequest) ProtoMessage()    {}
+func (*GetImportStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{86}
 }
 
-func (m *GetQuerySegmentInfoResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Unmarshal(m, b)
+func (m *GetImportStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateRequest.Unmarshal(m, b)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Marshal(b, m, deterministic)
+func (m *GetImportStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateRequest.Marshal(b, m, deterministic)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.Merge(m, src)
+func (m *GetImportStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateRequest.Merge(m, src)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_Size() int {
-	return xxx_messageInfo_GetQuerySegmentInfoResponse.Size(m)
+func (m *GetImportStateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateRequest.Size(m)
 }
-func (m *GetQuerySegmentInfoResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetQuerySegmentInfoResponse.DiscardUnknown(m)
+func (m *GetImportStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetQuerySegmentInfoResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateRequest proto.InternalMessageInfo
 
-func (m *GetQuerySegmentInfoResponse) GetStatus() *commonpb.Status {
+func (m *GetImportStateRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetQuerySegmentInfoResponse) GetInfos() []*QuerySegmentInfo {
+func (m *GetImportStateRequest) GetTaskID() int64 {
 	if m != nil {
-		return m.Infos
+		return m.TaskID
 	}
-	return nil
+	return 0
 }
 
-type DummyRequest struct {
-	RequestType          string   `protobuf:"bytes,1,opt,name=request_type,json=requestType,proto3" json:"request_type,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// The state of the files imported into one segment, which is a single file if the import is row based
+type ImportFileState struct {
+	Files                []string             `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	Reason               string               `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	RowCount             int64                `protobuf:"varint,4,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentID            int64                `protobuf:"varint,5,opt,name=segmentID,proto3" json:"segmentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *DummyRequest) Reset()         { *m = DummyRequest{} }
-func (m *DummyRequest) String() string { return proto.CompactTextString(m) }
-func (*DummyRequest) ProtoMessage()    {}
-func (*DummyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{60}
+func (m *ImportFileState) Reset()         { *m = ImportFileState{} }
+func (m *ImportFileState) String() string { return proto.CompactTextString(m) }
+func (*ImportFileState) ProtoMessage()    {}
+func (*ImportFileState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{87}
 }
 
-func (m *DummyRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyRequest.Unmarshal(m, b)
+func (m *ImportFileState) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportFileState.Unmarshal(m, b)
 }
-func (m *DummyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyRequest.Marshal(b, m, deterministic)
+func (m *ImportFileState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportFileState.Marshal(b, m, deterministic)
 }
-func (m *DummyRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyRequest.Merge(m, src)
+func (m *ImportFileState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportFileState.Merge(m, src)
 }
-func (m *DummyRequest) XXX_Size() int {
-	return xxx_messageInfo_DummyRequest.Size(m)
+func (m *ImportFileState) XXX_Size() int {
+	return xxx_messageInfo_ImportFileState.Size(m)
 }
-func (m *DummyRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyRequest.DiscardUnknown(m)
+func (m *ImportFileState) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportFileState.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DummyRequest proto.InternalMessageInfo
+var xxx_messageInfo_ImportFileState proto.InternalMessageInfo
 
-func (m *DummyRequest) GetRequestType() string {
+func (m *ImportFileState) GetFiles() []string {
 	if m != nil {
-		return m.RequestType
+		return m.Files
 	}
-	return ""
+	return nil
 }
 
-type DummyResponse struct {
-	Response             string   `protobuf:"bytes,1,opt,name=response,proto3" json:"response,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ImportFileState) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
 }
 
-func (m *DummyResponse) Reset()         { *m = DummyResponse{} }
-func (m *DummyResponse) String() string { return proto.CompactTextString(m) }
-func (*DummyResponse) ProtoMessage()    {}
-func (*DummyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{61}
+func (m *ImportFileState) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-func (m *DummyResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DummyResponse.Unmarshal(m, b)
-}
-func (m *DummyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DummyResponse.Marshal(b, m, deterministic)
-}
-func (m *DummyResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DummyResponse.Merge(m, src)
-}
-func (m *DummyResponse) XXX_Size() int {
-	return xxx_messageInfo_DummyResponse.Size(m)
-}
-func (m *DummyResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_DummyResponse.DiscardUnknown(m)
+func (m *ImportFileState) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_DummyResponse proto.InternalMessageInfo
-
-func (m *DummyResponse) GetResponse() string {
+func (m *ImportFileState) GetSegmentID() int64 {
 	if m != nil {
-		return m.Response
+		return m.SegmentID
 	}
-	return ""
+	return 0
 }
 
-type RegisterLinkRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetImportStateResponse struct {
+	Status               *commonpb.Status     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	State                commonpb.ImportState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.ImportState" json:"state,omitempty"`
+	RowCount             int64                `protobuf:"varint,3,opt,name=row_count,json=rowCount,proto3" json:"row_count,omitempty"`
+	SegmentIDs           []int64              `protobuf:"varint,4,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+	FileStates           []*ImportFileState   `protobuf:"bytes,5,rep,name=file_states,json=fileStates,proto3" json:"file_states,omitempty"`
+	CollectionID         int64                `protobuf:"varint,6,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *RegisterLinkRequest) Reset()         { *m = RegisterLinkRequest{} }
-func (m *RegisterLinkRequest) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkRequest) ProtoMessage()    {}
-func (*RegisterLinkRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{62}
+func (m *GetImportStateResponse) Reset()         { *m = GetImportStateResponse{} }
+func (m *GetImportStateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetImportStateResponse) ProtoMessage()    {}
+func (*GetImportStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{88}
 }
 
-func (m *RegisterLinkRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkRequest.Unmarshal(m, b)
+func (m *GetImportStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetImportStateResponse.Unmarshal(m, b)
 }
-func (m *RegisterLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkRequest.Marshal(b, m, deterministic)
+func (m *GetImportStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetImportStateResponse.Marshal(b, m, deterministic)
 }
-func (m *RegisterLinkRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkRequest.Merge(m, src)
+func (m *GetImportStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetImportStateResponse.Merge(m, src)
 }
-func (m *RegisterLinkRequest) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkRequest.Size(m)
+func (m *GetImportStateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetImportStateResponse.Size(m)
 }
-func (m *RegisterLinkRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkRequest.DiscardUnknown(m)
+func (m *GetImportStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetImportStateResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_RegisterLinkRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetImportStateResponse proto.InternalMessageInfo
 
-type RegisterLinkResponse struct {
-	Address              *commonpb.Address `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Status               *commonpb.Status  `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *GetImportStateResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
 }
 
-func (m *RegisterLinkResponse) Reset()         { *m = RegisterLinkResponse{} }
-func (m *RegisterLinkResponse) String() string { return proto.CompactTextString(m) }
-func (*RegisterLinkResponse) ProtoMessage()    {}
-func (*RegisterLinkResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{63}
+func (m *GetImportStateResponse) GetState() commonpb.ImportState {
+	if m != nil {
+		return m.State
+	}
+	return commonpb.ImportState_ImportPending
 }
 
-func (m *RegisterLinkResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_RegisterLinkResponse.Unmarshal(m, b)
-}
-func (m *RegisterLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_RegisterLinkResponse.Marshal(b, m, deterministic)
-}
-func (m *RegisterLinkResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_RegisterLinkResponse.Merge(m, src)
-}
-func (m *RegisterLinkResponse) XXX_Size() int {
-	return xxx_messageInfo_RegisterLinkResponse.Size(m)
-}
-func (m *RegisterLinkResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_RegisterLinkResponse.DiscardUnknown(m)
+func (m *GetImportStateResponse) GetRowCount() int64 {
+	if m != nil {
+		return m.RowCount
+	}
+	return 0
 }
 
-var xxx_messageInfo_RegisterLinkResponse proto.InternalMessageInfo
-
-func (m *RegisterLinkResponse) GetAddress() *commonpb.Address {
+func (m *GetImportStateResponse) GetSegmentIDs() []int64 {
 	if m != nil {
-		return m.Address
+		return m.SegmentIDs
 	}
 	return nil
 }
 
-func (m *RegisterLinkResponse) GetStatus() *commonpb.Status {
+func (m *GetImportStateResponse) GetFileStates() []*ImportFileState {
 	if m != nil {
-		return m.Status
+		return m.FileStates
 	}
 	return nil
 }
 
-type GetMetricsRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	Request              string            `protobuf:"bytes,2,opt,name=request,proto3" json:"request,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *GetImportStateResponse) GetCollectionID() int64 {
+	if m != nil {
+		return m.CollectionID
+	}
+	return 0
 }
 
-func (m *GetMetricsRequest) Reset()         { *m = GetMetricsRequest{} }
-func (m *GetMetricsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsRequest) ProtoMessage()    {}
-func (*GetMetricsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{64}
+type CreateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// password, transferred in plain text and stored hashed
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMetricsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsRequest.Unmarshal(m, b)
+func (m *CreateCredentialRequest) Reset()         { *m = CreateCredentialRequest{} }
+func (m *CreateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCredentialRequest) ProtoMessage()    {}
+func (*CreateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{89}
 }
-func (m *GetMetricsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsRequest.Marshal(b, m, deterministic)
+
+func (m *CreateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCredentialRequest.Unmarshal(m, b)
 }
-func (m *GetMetricsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsRequest.Merge(m, src)
+func (m *CreateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsRequest.Size(m)
+func (m *CreateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCredentialRequest.Merge(m, src)
 }
-func (m *GetMetricsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsRequest.DiscardUnknown(m)
+func (m *CreateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCredentialRequest.Size(m)
+}
+func (m *CreateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsRequest proto.InternalMessageInfo
+var xxx_messageInfo_CreateCredentialRequest proto.InternalMessageInfo
 
-func (m *GetMetricsRequest) GetBase() *commonpb.MsgBase {
+func (m *CreateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *GetMetricsRequest) GetRequest() string {
+func (m *CreateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.Request
+		return m.Username
 	}
 	return ""
 }
 
-type GetMetricsResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Response             string           `protobuf:"bytes,2,opt,name=response,proto3" json:"response,omitempty"`
-	ComponentName        string           `protobuf:"bytes,3,opt,name=component_name,json=componentName,proto3" json:"component_name,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *CreateCredentialRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-func (m *GetMetricsResponse) Reset()         { *m = GetMetricsResponse{} }
-func (m *GetMetricsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetMetricsResponse) ProtoMessage()    {}
-func (*GetMetricsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{65}
+type UpdateCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// old password, must match the stored one
+	OldPassword string `protobuf:"bytes,3,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	// new password
+	NewPassword          string   `protobuf:"bytes,4,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetMetricsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetMetricsResponse.Unmarshal(m, b)
+func (m *UpdateCredentialRequest) Reset()         { *m = UpdateCredentialRequest{} }
+func (m *UpdateCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCredentialRequest) ProtoMessage()    {}
+func (*UpdateCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{90}
 }
-func (m *GetMetricsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetMetricsResponse.Marshal(b, m, deterministic)
+
+func (m *UpdateCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCredentialRequest.Unmarshal(m, b)
 }
-func (m *GetMetricsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetMetricsResponse.Merge(m, src)
+func (m *UpdateCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *GetMetricsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetMetricsResponse.Size(m)
+func (m *UpdateCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCredentialRequest.Merge(m, src)
 }
-func (m *GetMetricsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetMetricsResponse.DiscardUnknown(m)
+func (m *UpdateCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCredentialRequest.Size(m)
+}
+func (m *UpdateCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetMetricsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UpdateCredentialRequest proto.InternalMessageInfo
 
-func (m *GetMetricsResponse) GetStatus() *commonpb.Status {
+func (m *UpdateCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetMetricsResponse) GetResponse() string {
+func (m *UpdateCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.Response
+		return m.Username
 	}
 	return ""
 }
 
-func (m *GetMetricsResponse) GetComponentName() string {
+func (m *UpdateCredentialRequest) GetOldPassword() string {
 	if m != nil {
-		return m.ComponentName
+		return m.OldPassword
 	}
 	return ""
 }
 
-//
-// Do load balancing operation from src_nodeID to dst_nodeID.
-type LoadBalanceRequest struct {
-	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
-	SrcNodeID            int64             `protobuf:"varint,2,opt,name=src_nodeID,json=srcNodeID,proto3" json:"src_nodeID,omitempty"`
-	DstNodeIDs           []int64           `protobuf:"varint,3,rep,packed,name=dst_nodeIDs,json=dstNodeIDs,proto3" json:"dst_nodeIDs,omitempty"`
-	SealedSegmentIDs     []int64           `protobuf:"varint,4,rep,packed,name=sealed_segmentIDs,json=sealedSegmentIDs,proto3" json:"sealed_segmentIDs,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+func (m *UpdateCredentialRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
 }
 
-func (m *LoadBalanceRequest) Reset()         { *m = LoadBalanceRequest{} }
-func (m *LoadBalanceRequest) String() string { return proto.CompactTextString(m) }
-func (*LoadBalanceRequest) ProtoMessage()    {}
-func (*LoadBalanceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{66}
+type DeleteCredentialRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username             string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoadBalanceRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoadBalanceRequest.Unmarshal(m, b)
+func (m *DeleteCredentialRequest) Reset()         { *m = DeleteCredentialRequest{} }
+func (m *DeleteCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCredentialRequest) ProtoMessage()    {}
+func (*DeleteCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{91}
 }
-func (m *LoadBalanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoadBalanceRequest.Marshal(b, m, deterministic)
+
+func (m *DeleteCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCredentialRequest.Unmarshal(m, b)
 }
-func (m *LoadBalanceRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoadBalanceRequest.Merge(m, src)
+func (m *DeleteCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCredentialRequest.Marshal(b, m, deterministic)
 }
-func (m *LoadBalanceRequest) XXX_Size() int {
-	return xxx_messageInfo_LoadBalanceRequest.Size(m)
+func (m *DeleteCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCredentialRequest.Merge(m, src)
 }
-func (m *LoadBalanceRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoadBalanceRequest.DiscardUnknown(m)
+func (m *DeleteCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCredentialRequest.Size(m)
+}
+func (m *DeleteCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCredentialRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoadBalanceRequest proto.InternalMessageInfo
+var xxx_messageInfo_DeleteCredentialRequest proto.InternalMessageInfo
 
-func (m *LoadBalanceRequest) GetBase() *commonpb.MsgBase {
+func (m *DeleteCredentialRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
 		return m.Base
 	}
 	return nil
 }
 
-func (m *LoadBalanceRequest) GetSrcNodeID() int64 {
+func (m *DeleteCredentialRequest) GetUsername() string {
 	if m != nil {
-		return m.SrcNodeID
+		return m.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *LoadBalanceRequest) GetDstNodeIDs() []int64 {
-	if m != nil {
-		return m.DstNodeIDs
-	}
-	return nil
+type ListUsersRequest struct {
+	// Not useful for now
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *LoadBalanceRequest) GetSealedSegmentIDs() []int64 {
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{92}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SealedSegmentIDs
+		return m.Base
 	}
 	return nil
 }
 
-type ManualCompactionRequest struct {
-	CollectionID         int64    `protobuf:"varint,1,opt,name=collectionID,proto3" json:"collectionID,omitempty"`
-	Timetravel           uint64   `protobuf:"varint,2,opt,name=timetravel,proto3" json:"timetravel,omitempty"`
+type ListUsersResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// username array
+	Usernames            []string `protobuf:"bytes,2,rep,name=usernames,proto3" json:"usernames,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ManualCompactionRequest) Reset()         { *m = ManualCompactionRequest{} }
-func (m *ManualCompactionRequest) String() string { return proto.CompactTextString(m) }
-func (*ManualCompactionRequest) ProtoMessage()    {}
-func (*ManualCompactionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{67}
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{93}
 }
 
-func (m *ManualCompactionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ManualCompactionRequest.Unmarshal(m, b)
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
 }
-func (m *ManualCompactionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ManualCompactionRequest.Marshal(b, m, deterministic)
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
 }
-func (m *ManualCompactionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManualCompactionRequest.Merge(m, src)
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
 }
-func (m *ManualCompactionRequest) XXX_Size() int {
-	return xxx_messageInfo_ManualCompactionRequest.Size(m)
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
 }
-func (m *ManualCompactionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManualCompactionRequest.DiscardUnknown(m)
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManualCompactionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
 
-func (m *ManualCompactionRequest) GetCollectionID() int64 {
+func (m *ListUsersResponse) GetStatus() *commonpb.Status {
 	if m != nil {
-		return m.CollectionID
+		return m.Status
 	}
-	return 0
+	return nil
 }
 
-func (m *ManualCompactionRequest) GetTimetravel() uint64 {
+func (m *ListUsersResponse) GetUsernames() []string {
 	if m != nil {
-		return m.Timetravel
+		return m.Usernames
 	}
-	return 0
+	return nil
 }
 
-type ManualCompactionResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	CompactionID         int64            `protobuf:"varint,2,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+type CreateRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ManualCompactionResponse) Reset()         { *m = ManualCompactionResponse{} }
-func (m *ManualCompactionResponse) String() string { return proto.CompactTextString(m) }
-func (*ManualCompactionResponse) ProtoMessage()    {}
-func (*ManualCompactionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{68}
+func (m *CreateRoleRequest) Reset()         { *m = CreateRoleRequest{} }
+func (m *CreateRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateRoleRequest) ProtoMessage()    {}
+func (*CreateRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{94}
 }
 
-func (m *ManualCompactionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ManualCompactionResponse.Unmarshal(m, b)
+func (m *CreateRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateRoleRequest.Unmarshal(m, b)
 }
-func (m *ManualCompactionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ManualCompactionResponse.Marshal(b, m, deterministic)
+func (m *CreateRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *ManualCompactionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ManualCompactionResponse.Merge(m, src)
+func (m *CreateRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateRoleRequest.Merge(m, src)
 }
-func (m *ManualCompactionResponse) XXX_Size() int {
-	return xxx_messageInfo_ManualCompactionResponse.Size(m)
+func (m *CreateRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateRoleRequest.Size(m)
 }
-func (m *ManualCompactionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_ManualCompactionResponse.DiscardUnknown(m)
+func (m *CreateRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ManualCompactionResponse proto.InternalMessageInfo
+var xxx_messageInfo_CreateRoleRequest proto.InternalMessageInfo
 
-func (m *ManualCompactionResponse) GetStatus() *commonpb.Status {
+func (m *CreateRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *ManualCompactionResponse) GetCompactionID() int64 {
+func (m *CreateRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.CompactionID
+		return m.RoleName
 	}
-	return 0
+	return ""
 }
 
-type GetCompactionStateRequest struct {
-	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+type DropRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// role name
+	RoleName             string   `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCompactionStateRequest) Reset()         { *m = GetCompactionStateRequest{} }
-func (m *GetCompactionStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionStateRequest) ProtoMessage()    {}
-func (*GetCompactionStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{69}
+func (m *DropRoleRequest) Reset()         { *m = DropRoleRequest{} }
+func (m *DropRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*DropRoleRequest) ProtoMessage()    {}
+func (*DropRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{95}
 }
 
-func (m *GetCompactionStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionStateRequest.Unmarshal(m, b)
+func (m *DropRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DropRoleRequest.Unmarshal(m, b)
 }
-func (m *GetCompactionStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionStateRequest.Marshal(b, m, deterministic)
+func (m *DropRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DropRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionStateRequest.Merge(m, src)
+func (m *DropRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DropRoleRequest.Merge(m, src)
 }
-func (m *GetCompactionStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionStateRequest.Size(m)
+func (m *DropRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_DropRoleRequest.Size(m)
 }
-func (m *GetCompactionStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionStateRequest.DiscardUnknown(m)
+func (m *DropRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DropRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_DropRoleRequest proto.InternalMessageInfo
 
-func (m *GetCompactionStateRequest) GetCompactionID() int64 {
+func (m *DropRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.CompactionID
+		return m.Base
 	}
-	return 0
+	return nil
 }
 
-type GetCompactionStateResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
-	ExecutingPlanNo      int64                    `protobuf:"varint,3,opt,name=executingPlanNo,proto3" json:"executingPlanNo,omitempty"`
-	TimeoutPlanNo        int64                    `protobuf:"varint,4,opt,name=timeoutPlanNo,proto3" json:"timeoutPlanNo,omitempty"`
-	CompletedPlanNo      int64                    `protobuf:"varint,5,opt,name=completedPlanNo,proto3" json:"completedPlanNo,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *DropRoleRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
 }
 
-func (m *GetCompactionStateResponse) Reset()         { *m = GetCompactionStateResponse{} }
-func (m *GetCompactionStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionStateResponse) ProtoMessage()    {}
-func (*GetCompactionStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{70}
+type OperateUserRoleRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// username
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// role name
+	RoleName string `protobuf:"bytes,3,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// add the user to the role or remove the user from the role
+	Type                 OperateUserRoleType `protobuf:"varint,4,opt,name=type,proto3,enum=milvus.proto.milvus.OperateUserRoleType" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-func (m *GetCompactionStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionStateResponse.Unmarshal(m, b)
+func (m *OperateUserRoleRequest) Reset()         { *m = OperateUserRoleRequest{} }
+func (m *OperateUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*OperateUserRoleRequest) ProtoMessage()    {}
+func (*OperateUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{96}
 }
-func (m *GetCompactionStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionStateResponse.Marshal(b, m, deterministic)
+
+func (m *OperateUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OperateUserRoleRequest.Unmarshal(m, b)
 }
-func (m *GetCompactionStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionStateResponse.Merge(m, src)
+func (m *OperateUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OperateUserRoleRequest.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionStateResponse.Size(m)
+func (m *OperateUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OperateUserRoleRequest.Merge(m, src)
 }
-func (m *GetCompactionStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionStateResponse.DiscardUnknown(m)
+func (m *OperateUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_OperateUserRoleRequest.Size(m)
+}
+func (m *OperateUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OperateUserRoleRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_OperateUserRoleRequest proto.InternalMessageInfo
 
-func (m *GetCompactionStateResponse) GetStatus() *commonpb.Status {
+func (m *OperateUserRoleRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetCompactionStateResponse) GetState() commonpb.CompactionState {
-	if m != nil {
-		return m.State
-	}
-	return commonpb.CompactionState_UndefiedState
-}
-
-func (m *GetCompactionStateResponse) GetExecutingPlanNo() int64 {
+func (m *OperateUserRoleRequest) GetUsername() string {
 	if m != nil {
-		return m.ExecutingPlanNo
+		return m.Username
 	}
-	return 0
+	return ""
 }
 
-func (m *GetCompactionStateResponse) GetTimeoutPlanNo() int64 {
+func (m *OperateUserRoleRequest) GetRoleName() string {
 	if m != nil {
-		return m.TimeoutPlanNo
+		return m.RoleName
 	}
-	return 0
+	return ""
 }
 
-func (m *GetCompactionStateResponse) GetCompletedPlanNo() int64 {
+func (m *OperateUserRoleRequest) GetType() OperateUserRoleType {
 	if m != nil {
-		return m.CompletedPlanNo
+		return m.Type
 	}
-	return 0
+	return OperateUserRoleType_AddUserToRole
 }
 
-type GetCompactionPlansRequest struct {
-	CompactionID         int64    `protobuf:"varint,1,opt,name=compactionID,proto3" json:"compactionID,omitempty"`
+type GrantEntity struct {
+	// role name
+	RoleName string `protobuf:"bytes,1,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// object type, Collection or Global
+	ObjectType string `protobuf:"bytes,2,opt,name=object_type,json=objectType,proto3" json:"object_type,omitempty"`
+	// object name, collection name or * for all objects, the grant follows the collection when it's renamed
+	ObjectName string `protobuf:"bytes,3,opt,name=object_name,json=objectName,proto3" json:"object_name,omitempty"`
+	// privilege name, the name of the MsgType or All
+	Privilege string `protobuf:"bytes,4,opt,name=privilege,proto3" json:"privilege,omitempty"`
+	// database name of the collection object, the default database if empty
+	DbName               string   `protobuf:"bytes,5,opt,name=db_name,json=dbName,proto3" json:"db_name,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCompactionPlansRequest) Reset()         { *m = GetCompactionPlansRequest{} }
-func (m *GetCompactionPlansRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionPlansRequest) ProtoMessage()    {}
-func (*GetCompactionPlansRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{71}
+func (m *GrantEntity) Reset()         { *m = GrantEntity{} }
+func (m *GrantEntity) String() string { return proto.CompactTextString(m) }
+func (*GrantEntity) ProtoMessage()    {}
+func (*GrantEntity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{97}
 }
 
-func (m *GetCompactionPlansRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionPlansRequest.Unmarshal(m, b)
+func (m *GrantEntity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantEntity.Unmarshal(m, b)
 }
-func (m *GetCompactionPlansRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionPlansRequest.Marshal(b, m, deterministic)
+func (m *GrantEntity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantEntity.Marshal(b, m, deterministic)
 }
-func (m *GetCompactionPlansRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionPlansRequest.Merge(m, src)
+func (m *GrantEntity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantEntity.Merge(m, src)
 }
-func (m *GetCompactionPlansRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionPlansRequest.Size(m)
+func (m *GrantEntity) XXX_Size() int {
+	return xxx_messageInfo_GrantEntity.Size(m)
 }
-func (m *GetCompactionPlansRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionPlansRequest.DiscardUnknown(m)
+func (m *GrantEntity) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantEntity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionPlansRequest proto.InternalMessageInfo
+var xxx_messageInfo_GrantEntity proto.InternalMessageInfo
 
-func (m *GetCompactionPlansRequest) GetCompactionID() int64 {
+func (m *GrantEntity) GetRoleName() string {
 	if m != nil {
-		return m.CompactionID
+		return m.RoleName
 	}
-	return 0
+	return ""
 }
 
-type GetCompactionPlansResponse struct {
-	Status               *commonpb.Status         `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	State                commonpb.CompactionState `protobuf:"varint,2,opt,name=state,proto3,enum=milvus.proto.common.CompactionState" json:"state,omitempty"`
-	MergeInfos           []*CompactionMergeInfo   `protobuf:"bytes,3,rep,name=mergeInfos,proto3" json:"mergeInfos,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
-	XXX_unrecognized     []byte                   `json:"-"`
-	XXX_sizecache        int32                    `json:"-"`
+func (m *GrantEntity) GetObjectType() string {
+	if m != nil {
+		return m.ObjectType
+	}
+	return ""
 }
 
-func (m *GetCompactionPlansResponse) Reset()         { *m = GetCompactionPlansResponse{} }
-func (m *GetCompactionPlansResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCompactionPlansResponse) ProtoMessage()    {}
-func (*GetCompactionPlansResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{72}
+func (m *GrantEntity) GetObjectName() string {
+	if m != nil {
+		return m.ObjectName
+	}
+	return ""
 }
 
-func (m *GetCompactionPlansResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCompactionPlansResponse.Unmarshal(m, b)
+func (m *GrantEntity) GetPrivilege() string {
+	if m != nil {
+		return m.Privilege
+	}
+	return ""
 }
-func (m *GetCompactionPlansResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCompactionPlansResponse.Marshal(b, m, deterministic)
+
+func (m *GrantEntity) GetDbName() string {
+	if m != nil {
+		return m.DbName
+	}
+	return ""
 }
-func (m *GetCompactionPlansResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCompactionPlansResponse.Merge(m, src)
+
+type GrantPrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the privilege to grant
+	Entity               *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
-func (m *GetCompactionPlansResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCompactionPlansResponse.Size(m)
+
+func (m *GrantPrivilegeRequest) Reset()         { *m = GrantPrivilegeRequest{} }
+func (m *GrantPrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*GrantPrivilegeRequest) ProtoMessage()    {}
+func (*GrantPrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{98}
 }
-func (m *GetCompactionPlansResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCompactionPlansResponse.DiscardUnknown(m)
+
+func (m *GrantPrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GrantPrivilegeRequest.Unmarshal(m, b)
+}
+func (m *GrantPrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GrantPrivilegeRequest.Marshal(b, m, deterministic)
+}
+func (m *GrantPrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GrantPrivilegeRequest.Merge(m, src)
+}
+func (m *GrantPrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_GrantPrivilegeRequest.Size(m)
+}
+func (m *GrantPrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GrantPrivilegeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCompactionPlansResponse proto.InternalMessageInfo
+var xxx_messageInfo_GrantPrivilegeRequest proto.InternalMessageInfo
 
-func (m *GetCompactionPlansResponse) GetStatus() *commonpb.Status {
+func (m *GrantPrivilegeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Status
+		return m.Base
 	}
 	return nil
 }
 
-func (m *GetCompactionPlansResponse) GetState() commonpb.CompactionState {
-	if m != nil {
-		return m.State
-	}
-	return commonpb.CompactionState_UndefiedState
-}
-
-func (m *GetCompactionPlansResponse) GetMergeInfos() []*CompactionMergeInfo {
+func (m *GrantPrivilegeRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.MergeInfos
+		return m.Entity
 	}
 	return nil
 }
 
-type CompactionMergeInfo struct {
-	Sources              []int64  `protobuf:"varint,1,rep,packed,name=sources,proto3" json:"sources,omitempty"`
-	Target               int64    `protobuf:"varint,2,opt,name=target,proto3" json:"target,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type RevokePrivilegeRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the privilege to revoke
+	Entity               *GrantEntity `protobuf:"bytes,2,opt,name=entity,proto3" json:"entity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *CompactionMergeInfo) Reset()         { *m = CompactionMergeInfo{} }
-func (m *CompactionMergeInfo) String() string { return proto.CompactTextString(m) }
-func (*CompactionMergeInfo) ProtoMessage()    {}
-func (*CompactionMergeInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{73}
+func (m *RevokePrivilegeRequest) Reset()         { *m = RevokePrivilegeRequest{} }
+func (m *RevokePrivilegeRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokePrivilegeRequest) ProtoMessage()    {}
+func (*RevokePrivilegeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{99}
 }
 
-func (m *CompactionMergeInfo) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CompactionMergeInfo.Unmarshal(m, b)
+func (m *RevokePrivilegeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokePrivilegeRequest.Unmarshal(m, b)
 }
-func (m *CompactionMergeInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CompactionMergeInfo.Marshal(b, m, deterministic)
+func (m *RevokePrivilegeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokePrivilegeRequest.Marshal(b, m, deterministic)
 }
-func (m *CompactionMergeInfo) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CompactionMergeInfo.Merge(m, src)
+func (m *RevokePrivilegeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokePrivilegeRequest.Merge(m, src)
 }
-func (m *CompactionMergeInfo) XXX_Size() int {
-	return xxx_messageInfo_CompactionMergeInfo.Size(m)
+func (m *RevokePrivilegeRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokePrivilegeRequest.Size(m)
 }
-func (m *CompactionMergeInfo) XXX_DiscardUnknown() {
-	xxx_messageInfo_CompactionMergeInfo.DiscardUnknown(m)
+func (m *RevokePrivilegeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokePrivilegeRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CompactionMergeInfo proto.InternalMessageInfo
+var xxx_messageInfo_RevokePrivilegeRequest proto.InternalMessageInfo
 
-func (m *CompactionMergeInfo) GetSources() []int64 {
+func (m *RevokePrivilegeRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.Sources
+		return m.Base
 	}
 	return nil
 }
 
-func (m *CompactionMergeInfo) GetTarget() int64 {
+func (m *RevokePrivilegeRequest) GetEntity() *GrantEntity {
 	if m != nil {
-		return m.Target
+		return m.Entity
 	}
-	return 0
+	return nil
 }
 
-type GetFlushStateRequest struct {
-	SegmentIDs           []int64  `protobuf:"varint,1,rep,packed,name=segmentIDs,proto3" json:"segmentIDs,omitempty"`
+type ListGrantsRequest struct {
+	// Not useful for now
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// list the grants of the role
+	RoleName string `protobuf:"bytes,2,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	// list the grants of all roles bound to the user, used when role_name is empty
+	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetFlushStateRequest) Reset()         { *m = GetFlushStateRequest{} }
-func (m *GetFlushStateRequest) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateRequest) ProtoMessage()    {}
-func (*GetFlushStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{74}
+func (m *ListGrantsRequest) Reset()         { *m = ListGrantsRequest{} }
+func (m *ListGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsRequest) ProtoMessage()    {}
+func (*ListGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{100}
 }
 
-func (m *GetFlushStateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateRequest.Unmarshal(m, b)
+func (m *ListGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsRequest.Unmarshal(m, b)
 }
-func (m *GetFlushStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateRequest.Marshal(b, m, deterministic)
+func (m *ListGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsRequest.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateRequest.Merge(m, src)
+func (m *ListGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsRequest.Merge(m, src)
 }
-func (m *GetFlushStateRequest) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateRequest.Size(m)
+func (m *ListGrantsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsRequest.Size(m)
 }
-func (m *GetFlushStateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateRequest.DiscardUnknown(m)
+func (m *ListGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListGrantsRequest proto.InternalMessageInfo
 
-func (m *GetFlushStateRequest) GetSegmentIDs() []int64 {
+func (m *ListGrantsRequest) GetBase() *commonpb.MsgBase {
 	if m != nil {
-		return m.SegmentIDs
+		return m.Base
 	}
 	return nil
 }
 
-type GetFlushStateResponse struct {
-	Status               *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Flushed              bool             `protobuf:"varint,2,opt,name=flushed,proto3" json:"flushed,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+func (m *ListGrantsRequest) GetRoleName() string {
+	if m != nil {
+		return m.RoleName
+	}
+	return ""
 }
 
-func (m *GetFlushStateResponse) Reset()         { *m = GetFlushStateResponse{} }
-func (m *GetFlushStateResponse) String() string { return proto.CompactTextString(m) }
-func (*GetFlushStateResponse) ProtoMessage()    {}
-func (*GetFlushStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_02345ba45cc0e303, []int{75}
+func (m *ListGrantsRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *GetFlushStateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetFlushStateResponse.Unmarshal(m, b)
+type ListGrantsResponse struct {
+	// Contain error_code and reason
+	Status *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// grant array
+	Entities             []*GrantEntity `protobuf:"bytes,2,rep,name=entities,proto3" json:"entities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
 }
-func (m *GetFlushStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetFlushStateResponse.Marshal(b, m, deterministic)
+
+func (m *ListGrantsResponse) Reset()         { *m = ListGrantsResponse{} }
+func (m *ListGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGrantsResponse) ProtoMessage()    {}
+func (*ListGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_02345ba45cc0e303, []int{101}
 }
-func (m *GetFlushStateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetFlushStateResponse.Merge(m, src)
+
+func (m *ListGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGrantsResponse.Unmarshal(m, b)
 }
-func (m *GetFlushStateResponse) XXX_Size() int {
-	return xxx_messageInfo_GetFlushStateResponse.Size(m)
+func (m *ListGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGrantsResponse.Marshal(b, m, deterministic)
 }
-func (m *GetFlushStateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetFlushStateResponse.DiscardUnknown(m)
+func (m *ListGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGrantsResponse.Merge(m, src)
+}
+func (m *ListGrantsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGrantsResponse.Size(m)
+}
+func (m *ListGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGrantsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetFlushStateResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListGrantsResponse proto.InternalMessageInfo
 
-func (m *GetFlushStateResponse) GetStatus() *commonpb.Status {
+func (m *ListGrantsResponse) GetStatus() *commonpb.Status {
 	if m != nil {
 		return m.Status
 	}
 	return nil
 }
 
-func (m *GetFlushStateResponse) GetFlushed() bool {
+func (m *ListGrantsResponse) GetEntities() []*GrantEntity {
 	if m != nil {
-		return m.Flushed
+		return m.Entities
 	}
-	return false
+	return nil
 }
 
 func init() {
 	proto.RegisterEnum("milvus.proto.milvus.ShowType", ShowType_name, ShowType_value)
 	proto.RegisterEnum("milvus.proto.milvus.PlaceholderType", PlaceholderType_name, PlaceholderType_value)
+	proto.RegisterEnum("milvus.proto.milvus.OperateUserRoleType", OperateUserRoleType_name, OperateUserRoleType_value)
 	proto.RegisterType((*CreateAliasRequest)(nil), "milvus.proto.milvus.CreateAliasRequest")
 	proto.RegisterType((*DropAliasRequest)(nil), "milvus.proto.milvus.DropAliasRequest")
 	proto.RegisterType((*AlterAliasRequest)(nil), "milvus.proto.milvus.AlterAliasRequest")
+	proto.RegisterType((*RenameCollectionRequest)(nil), "milvus.proto.milvus.RenameCollectionRequest")
+	proto.RegisterType((*AlterCollectionRequest)(nil), "milvus.proto.milvus.AlterCollectionRequest")
+	proto.RegisterType((*AddFieldRequest)(nil), "milvus.proto.milvus.AddFieldRequest")
+	proto.RegisterType((*CreateDatabaseRequest)(nil), "milvus.proto.milvus.CreateDatabaseRequest")
+	proto.RegisterType((*DropDatabaseRequest)(nil), "milvus.proto.milvus.DropDatabaseRequest")
+	proto.RegisterType((*ListDatabasesRequest)(nil), "milvus.proto.milvus.ListDatabasesRequest")
+	proto.RegisterType((*ListDatabasesResponse)(nil), "milvus.proto.milvus.ListDatabasesResponse")
 	proto.RegisterType((*CreateCollectionRequest)(nil), "milvus.proto.milvus.CreateCollectionRequest")
 	proto.RegisterType((*DropCollectionRequest)(nil), "milvus.proto.milvus.DropCollectionRequest")
 	proto.RegisterType((*HasCollectionRequest)(nil), "milvus.proto.milvus.HasCollectionRequest")
@@ -4964,6 +6605,7 @@ func init() {
 	proto.RegisterType((*InsertRequest)(nil), "milvus.proto.milvus.InsertRequest")
 	proto.RegisterType((*MutationResult)(nil), "milvus.proto.milvus.MutationResult")
 	proto.RegisterType((*DeleteRequest)(nil), "milvus.proto.milvus.DeleteRequest")
+	proto.RegisterType((*UpsertRequest)(nil), "milvus.proto.milvus.UpsertRequest")
 	proto.RegisterType((*PlaceholderValue)(nil), "milvus.proto.milvus.PlaceholderValue")
 	proto.RegisterType((*PlaceholderGroup)(nil), "milvus.proto.milvus.PlaceholderGroup")
 	proto.RegisterType((*SearchRequest)(nil), "milvus.proto.milvus.SearchRequest")
@@ -5000,237 +6642,318 @@ func init() {
 	proto.RegisterType((*CompactionMergeInfo)(nil), "milvus.proto.milvus.CompactionMergeInfo")
 	proto.RegisterType((*GetFlushStateRequest)(nil), "milvus.proto.milvus.GetFlushStateRequest")
 	proto.RegisterType((*GetFlushStateResponse)(nil), "milvus.proto.milvus.GetFlushStateResponse")
+	proto.RegisterType((*ImportRequest)(nil), "milvus.proto.milvus.ImportRequest")
+	proto.RegisterType((*ImportResponse)(nil), "milvus.proto.milvus.ImportResponse")
+	proto.RegisterType((*GetImportStateRequest)(nil), "milvus.proto.milvus.GetImportStateRequest")
+	proto.RegisterType((*ImportFileState)(nil), "milvus.proto.milvus.ImportFileState")
+	proto.RegisterType((*GetImportStateResponse)(nil), "milvus.proto.milvus.GetImportStateResponse")
+	proto.RegisterType((*CreateCredentialRequest)(nil), "milvus.proto.milvus.CreateCredentialRequest")
+	proto.RegisterType((*UpdateCredentialRequest)(nil), "milvus.proto.milvus.UpdateCredentialRequest")
+	proto.RegisterType((*DeleteCredentialRequest)(nil), "milvus.proto.milvus.DeleteCredentialRequest")
+	proto.RegisterType((*ListUsersRequest)(nil), "milvus.proto.milvus.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "milvus.proto.milvus.ListUsersResponse")
+	proto.RegisterType((*CreateRoleRequest)(nil), "milvus.proto.milvus.CreateRoleRequest")
+	proto.RegisterType((*DropRoleRequest)(nil), "milvus.proto.milvus.DropRoleRequest")
+	proto.RegisterType((*OperateUserRoleRequest)(nil), "milvus.proto.milvus.OperateUserRoleRequest")
+	proto.RegisterType((*GrantEntity)(nil), "milvus.proto.milvus.GrantEntity")
+	proto.RegisterType((*GrantPrivilegeRequest)(nil), "milvus.proto.milvus.GrantPrivilegeRequest")
+	proto.RegisterType((*RevokePrivilegeRequest)(nil), "milvus.proto.milvus.RevokePrivilegeRequest")
+	proto.RegisterType((*ListGrantsRequest)(nil), "milvus.proto.milvus.ListGrantsRequest")
+	proto.RegisterType((*ListGrantsResponse)(nil), "milvus.proto.milvus.ListGrantsResponse")
 }
 
 func init() { proto.RegisterFile("milvus.proto", fileDescriptor_02345ba45cc0e303) }
 
 var fileDescriptor_02345ba45cc0e303 = []byte{
-	// 3594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x3b, 0x4b, 0x73, 0x1b, 0xc7,
-	0xd1, 0x5c, 0x80, 0x78, 0x35, 0x00, 0x12, 0x1c, 0x52, 0x14, 0x04, 0xbd, 0xa8, 0xb5, 0x65, 0x51,
-	0x92, 0x25, 0x59, 0x94, 0x5f, 0x9f, 0xfc, 0x25, 0xb6, 0x24, 0xc6, 0x12, 0xcb, 0x92, 0x42, 0x2f,
-	0x6d, 0xa7, 0x1c, 0x97, 0x0a, 0xb5, 0xc4, 0x0e, 0xc1, 0x2d, 0x2d, 0x76, 0xe1, 0x9d, 0x81, 0x24,
-	0xfa, 0x94, 0x2a, 0xc7, 0x49, 0xa5, 0xec, 0xd8, 0x95, 0x4a, 0x2a, 0x8f, 0x43, 0x72, 0xc8, 0xe3,
-	0x90, 0x5b, 0x1c, 0xa7, 0x92, 0x54, 0x2e, 0xc9, 0x21, 0x87, 0x1c, 0x52, 0x95, 0xc7, 0xc5, 0x87,
-	0x5c, 0xf2, 0x07, 0xfc, 0x0f, 0x72, 0x48, 0xcd, 0x63, 0x17, 0xbb, 0x8b, 0x59, 0x10, 0x14, 0xac,
-	0x90, 0xbc, 0xed, 0xf4, 0x74, 0xf7, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0xf4, 0x42, 0xa5, 0x63,
-	0x3b, 0xf7, 0x7a, 0xe4, 0x7c, 0xd7, 0xf7, 0xa8, 0x87, 0x66, 0xa3, 0xad, 0xf3, 0xa2, 0xd1, 0xa8,
-	0xb4, 0xbc, 0x4e, 0xc7, 0x73, 0x05, 0xb0, 0x51, 0x21, 0xad, 0x4d, 0xdc, 0x31, 0x45, 0x4b, 0xff,
-	0x89, 0x06, 0xe8, 0x9a, 0x8f, 0x4d, 0x8a, 0xaf, 0x38, 0xb6, 0x49, 0x0c, 0xfc, 0x76, 0x0f, 0x13,
-	0x8a, 0x9e, 0x82, 0xc9, 0x75, 0x93, 0xe0, 0xba, 0xb6, 0xa0, 0x2d, 0x96, 0x97, 0x8e, 0x9c, 0x8f,
-	0xb1, 0x95, 0xec, 0x6e, 0x91, 0xf6, 0x55, 0x93, 0x60, 0x83, 0x63, 0xa2, 0x83, 0x50, 0xb0, 0xd6,
-	0x9b, 0xae, 0xd9, 0xc1, 0xf5, 0xcc, 0x82, 0xb6, 0x58, 0x32, 0xf2, 0xd6, 0xfa, 0x6d, 0xb3, 0x83,
-	0xd1, 0x29, 0x98, 0x6e, 0x79, 0x8e, 0x83, 0x5b, 0xd4, 0xf6, 0x5c, 0x81, 0x90, 0xe5, 0x08, 0x53,
-	0x7d, 0x30, 0x47, 0x9c, 0x83, 0x9c, 0xc9, 0x64, 0xa8, 0x4f, 0xf2, 0x6e, 0xd1, 0xd0, 0x09, 0xd4,
-	0x96, 0x7d, 0xaf, 0xfb, 0xa8, 0xa4, 0x0b, 0x07, 0xcd, 0x46, 0x07, 0xfd, 0xb1, 0x06, 0x33, 0x57,
-	0x1c, 0x8a, 0xfd, 0x3d, 0xaa, 0x94, 0x1f, 0x66, 0xe0, 0xa0, 0x58, 0xb5, 0x6b, 0x21, 0xfa, 0x6e,
-	0x4a, 0x39, 0x0f, 0x79, 0x61, 0x55, 0x5c, 0xcc, 0x8a, 0x21, 0x5b, 0xe8, 0x28, 0x00, 0xd9, 0x34,
-	0x7d, 0x8b, 0x34, 0xdd, 0x5e, 0xa7, 0x9e, 0x5b, 0xd0, 0x16, 0x73, 0x46, 0x49, 0x40, 0x6e, 0xf7,
-	0x3a, 0xc8, 0x80, 0x99, 0x96, 0xe7, 0x12, 0x9b, 0x50, 0xec, 0xb6, 0xb6, 0x9a, 0x0e, 0xbe, 0x87,
-	0x9d, 0x7a, 0x7e, 0x41, 0x5b, 0x9c, 0x5a, 0x3a, 0xa9, 0x94, 0xfb, 0x5a, 0x1f, 0xfb, 0x26, 0x43,
-	0x36, 0x6a, 0xad, 0x04, 0x44, 0x7f, 0x5f, 0x83, 0x03, 0xcc, 0x60, 0xf6, 0x84, 0x62, 0xf4, 0x5f,
-	0x6a, 0x30, 0x77, 0xc3, 0x24, 0x7b, 0x63, 0x95, 0x8e, 0x02, 0x50, 0xbb, 0x83, 0x9b, 0x84, 0x9a,
-	0x9d, 0x2e, 0x5f, 0xa9, 0x49, 0xa3, 0xc4, 0x20, 0x6b, 0x0c, 0xa0, 0xbf, 0x09, 0x95, 0xab, 0x9e,
-	0xe7, 0x18, 0x98, 0x74, 0x3d, 0x97, 0x60, 0x74, 0x09, 0xf2, 0x84, 0x9a, 0xb4, 0x47, 0xa4, 0x90,
-	0x87, 0x95, 0x42, 0xae, 0x71, 0x14, 0x43, 0xa2, 0x32, 0x7b, 0xbd, 0x67, 0x3a, 0x3d, 0x21, 0x63,
-	0xd1, 0x10, 0x0d, 0xfd, 0x2d, 0x98, 0x5a, 0xa3, 0xbe, 0xed, 0xb6, 0x3f, 0x47, 0xe6, 0xa5, 0x80,
-	0xf9, 0x3f, 0x35, 0x38, 0xb4, 0x8c, 0x49, 0xcb, 0xb7, 0xd7, 0xf7, 0xc8, 0x76, 0xd0, 0xa1, 0xd2,
-	0x87, 0xac, 0x2c, 0x73, 0x55, 0x67, 0x8d, 0x18, 0x2c, 0xb1, 0x18, 0xb9, 0xe4, 0x62, 0x7c, 0x3a,
-	0x09, 0x0d, 0xd5, 0xa4, 0xc6, 0x51, 0xdf, 0x17, 0xc2, 0x5d, 0x9a, 0xe1, 0x44, 0x89, 0x3d, 0x26,
-	0xcf, 0x85, 0xfe, 0x68, 0x6b, 0x1c, 0x10, 0x6e, 0xe6, 0xe4, 0xac, 0xb2, 0x8a, 0x59, 0x2d, 0xc1,
-	0x81, 0x7b, 0xb6, 0x4f, 0x7b, 0xa6, 0xd3, 0x6c, 0x6d, 0x9a, 0xae, 0x8b, 0x1d, 0xae, 0x27, 0xe6,
-	0xbe, 0xb2, 0x8b, 0x25, 0x63, 0x56, 0x76, 0x5e, 0x13, 0x7d, 0x4c, 0x59, 0x04, 0x3d, 0x0d, 0xf3,
-	0xdd, 0xcd, 0x2d, 0x62, 0xb7, 0x06, 0x88, 0x72, 0x9c, 0x68, 0x2e, 0xe8, 0x8d, 0x51, 0x9d, 0x85,
-	0x99, 0x16, 0xf7, 0x80, 0x56, 0x93, 0x69, 0x4d, 0xa8, 0x31, 0xcf, 0xd5, 0x58, 0x93, 0x1d, 0xaf,
-	0x05, 0x70, 0x26, 0x56, 0x80, 0xdc, 0xa3, 0xad, 0x08, 0x41, 0x81, 0x13, 0xcc, 0xca, 0xce, 0xd7,
-	0x69, 0xab, 0x4f, 0x13, 0xf7, 0x5d, 0xc5, 0xa4, 0xef, 0xaa, 0x43, 0x81, 0xfb, 0x62, 0x4c, 0xea,
-	0x25, 0x2e, 0x66, 0xd0, 0x44, 0x2b, 0x30, 0x4d, 0xa8, 0xe9, 0xd3, 0x66, 0xd7, 0x23, 0x36, 0xd3,
-	0x0b, 0xa9, 0xc3, 0x42, 0x76, 0xb1, 0xbc, 0xb4, 0xa0, 0x5c, 0xa4, 0x57, 0xf0, 0xd6, 0xb2, 0x49,
-	0xcd, 0x55, 0xd3, 0xf6, 0x8d, 0x29, 0x4e, 0xb8, 0x1a, 0xd0, 0xa9, 0x1d, 0x64, 0x79, 0x7c, 0x07,
-	0x79, 0xd3, 0x33, 0xad, 0xbd, 0xe1, 0x20, 0x3f, 0xd4, 0xa0, 0x6e, 0x60, 0x07, 0x9b, 0x64, 0x6f,
-	0xec, 0x5d, 0xfd, 0x7b, 0x1a, 0x1c, 0xbb, 0x8e, 0x69, 0x64, 0x17, 0x50, 0x93, 0xda, 0x84, 0xda,
-	0xad, 0xdd, 0x8c, 0x03, 0xf4, 0x8f, 0x34, 0x38, 0x9e, 0x2a, 0xd6, 0x38, 0x4e, 0xe1, 0x39, 0xc8,
-	0xb1, 0x2f, 0x52, 0xcf, 0x70, 0x1b, 0x3d, 0x91, 0x66, 0xa3, 0x6f, 0x30, 0x5f, 0xcb, 0x8d, 0x54,
-	0xe0, 0xeb, 0xff, 0xd6, 0x60, 0x7e, 0x6d, 0xd3, 0xbb, 0xdf, 0x17, 0xe9, 0x51, 0x28, 0x28, 0xee,
-	0x26, 0xb3, 0x09, 0x37, 0x89, 0x2e, 0xc2, 0x24, 0xdd, 0xea, 0x62, 0xee, 0x61, 0xa7, 0x96, 0x8e,
-	0x9e, 0x57, 0x84, 0xbf, 0xe7, 0x99, 0x90, 0xaf, 0x6d, 0x75, 0xb1, 0xc1, 0x51, 0xd1, 0x69, 0xa8,
-	0x25, 0x54, 0x1e, 0x38, 0x9a, 0xe9, 0xb8, 0xce, 0x89, 0xfe, 0xfb, 0x0c, 0x1c, 0x1c, 0x98, 0xe2,
-	0x38, 0xca, 0x56, 0x8d, 0x9d, 0x51, 0x8e, 0x8d, 0x4e, 0x42, 0xc4, 0x04, 0x9a, 0xb6, 0xc5, 0x22,
-	0xd4, 0xec, 0x62, 0xd6, 0xa8, 0x46, 0xfc, 0xad, 0x45, 0xd0, 0x39, 0x40, 0x03, 0x6e, 0x50, 0x78,
-	0xdb, 0x49, 0x63, 0x26, 0xe9, 0x07, 0xb9, 0xaf, 0x55, 0x3a, 0x42, 0xa1, 0x82, 0x49, 0x63, 0x4e,
-	0xe1, 0x09, 0x09, 0xba, 0x08, 0x73, 0xb6, 0x7b, 0x0b, 0x77, 0x3c, 0x7f, 0xab, 0xd9, 0xc5, 0x7e,
-	0x0b, 0xbb, 0xd4, 0x6c, 0x63, 0x52, 0xcf, 0x73, 0x89, 0x66, 0x83, 0xbe, 0xd5, 0x7e, 0x97, 0xfe,
-	0x89, 0x06, 0xf3, 0x22, 0x42, 0x5d, 0x35, 0x7d, 0x6a, 0xef, 0xf6, 0x89, 0x7c, 0x12, 0xa6, 0xba,
-	0x81, 0x1c, 0x02, 0x4f, 0xc4, 0xd3, 0xd5, 0x10, 0xca, 0x77, 0xd9, 0xc7, 0x1a, 0xcc, 0xb1, 0xe0,
-	0x71, 0x3f, 0xc9, 0xfc, 0x2b, 0x0d, 0x66, 0x6f, 0x98, 0x64, 0x3f, 0x89, 0xfc, 0x1b, 0x79, 0x04,
-	0x85, 0x32, 0xef, 0xea, 0x15, 0xeb, 0x14, 0x4c, 0xc7, 0x85, 0x0e, 0xa2, 0x95, 0xa9, 0x98, 0xd4,
-	0x44, 0xff, 0x5d, 0xff, 0xac, 0xda, 0x67, 0x92, 0xff, 0x41, 0x83, 0xa3, 0xd7, 0x31, 0x0d, 0xa5,
-	0xde, 0x13, 0x67, 0xda, 0xa8, 0xd6, 0xf2, 0xa1, 0x38, 0x91, 0x95, 0xc2, 0xef, 0xca, 0xc9, 0xf7,
-	0x7e, 0x06, 0x0e, 0xb0, 0x63, 0x61, 0x6f, 0x18, 0xc1, 0x28, 0x97, 0x0d, 0x85, 0xa1, 0xe4, 0x54,
-	0x86, 0x12, 0x9e, 0xa7, 0xf9, 0x91, 0xcf, 0x53, 0xfd, 0xd7, 0x19, 0x11, 0x07, 0x44, 0xb5, 0x31,
-	0xce, 0xb2, 0x28, 0x64, 0xcd, 0x28, 0x65, 0xd5, 0xa1, 0x12, 0x42, 0x56, 0x96, 0x83, 0xf3, 0x31,
-	0x06, 0xdb, 0xb3, 0xc7, 0xe3, 0x07, 0x1a, 0xcc, 0x07, 0xd7, 0xbb, 0x35, 0xdc, 0xee, 0x60, 0x97,
-	0x3e, 0xbc, 0x0d, 0x25, 0x2d, 0x20, 0xa3, 0xb0, 0x80, 0x23, 0x50, 0x22, 0x62, 0x9c, 0xf0, 0xe6,
-	0xd6, 0x07, 0xe8, 0x7f, 0xd4, 0xe0, 0xe0, 0x80, 0x38, 0xe3, 0x2c, 0x62, 0x1d, 0x0a, 0xb6, 0x6b,
-	0xe1, 0x07, 0xa1, 0x34, 0x41, 0x93, 0xf5, 0xac, 0xf7, 0x6c, 0xc7, 0x0a, 0xc5, 0x08, 0x9a, 0xe8,
-	0x04, 0x54, 0xb0, 0x6b, 0xae, 0x3b, 0xb8, 0xc9, 0x71, 0xb9, 0x21, 0x17, 0x8d, 0xb2, 0x80, 0xad,
-	0x30, 0x10, 0x23, 0xde, 0xb0, 0x31, 0x27, 0xce, 0x09, 0x62, 0xd9, 0xd4, 0xbf, 0xad, 0xc1, 0x2c,
-	0xb3, 0x42, 0x29, 0x3d, 0x79, 0xb4, 0xda, 0x5c, 0x80, 0x72, 0xc4, 0xcc, 0xe4, 0x44, 0xa2, 0x20,
-	0xfd, 0x2e, 0xcc, 0xc5, 0xc5, 0x19, 0x47, 0x9b, 0xc7, 0x00, 0xc2, 0xb5, 0x12, 0xbb, 0x21, 0x6b,
-	0x44, 0x20, 0xfa, 0x67, 0x61, 0x12, 0x97, 0xab, 0x69, 0x97, 0x73, 0x4c, 0x7c, 0x49, 0xa2, 0xfe,
-	0xbc, 0xc4, 0x21, 0xbc, 0x7b, 0x19, 0x2a, 0xf8, 0x01, 0xf5, 0xcd, 0x66, 0xd7, 0xf4, 0xcd, 0x8e,
-	0xd8, 0x56, 0x23, 0xb9, 0xde, 0x32, 0x27, 0x5b, 0xe5, 0x54, 0xfa, 0x5f, 0x58, 0x98, 0x26, 0xcd,
-	0x75, 0xaf, 0xcf, 0xf8, 0x28, 0x00, 0x37, 0x67, 0xd1, 0x9d, 0x13, 0xdd, 0x1c, 0xc2, 0x0f, 0xb7,
-	0x5f, 0x68, 0x50, 0xe3, 0x53, 0x10, 0xf3, 0xe9, 0x32, 0xb6, 0x09, 0x1a, 0x2d, 0x41, 0x33, 0x64,
-	0x73, 0xfd, 0x1f, 0xe4, 0xa5, 0x62, 0xb3, 0xa3, 0x2a, 0x56, 0x12, 0x6c, 0x33, 0x0d, 0xfd, 0xa7,
-	0x1a, 0x1c, 0x48, 0xa8, 0x7c, 0x1c, 0x8b, 0x7e, 0x0d, 0x90, 0x98, 0xa1, 0xd5, 0x9f, 0x76, 0x70,
-	0x10, 0x9f, 0x54, 0x9e, 0x3a, 0x49, 0x25, 0x19, 0x33, 0x76, 0x02, 0x42, 0xf4, 0xbf, 0x6b, 0x70,
-	0xe4, 0x3a, 0xa6, 0x1c, 0xf5, 0x2a, 0xf3, 0x2a, 0xab, 0xbe, 0xd7, 0xf6, 0x31, 0x21, 0xfb, 0xd7,
-	0x3e, 0xbe, 0x2f, 0x22, 0x37, 0xd5, 0x94, 0xc6, 0xd1, 0xff, 0x09, 0xa8, 0xf0, 0x31, 0xb0, 0xd5,
-	0xf4, 0xbd, 0xfb, 0x44, 0xda, 0x51, 0x59, 0xc2, 0x0c, 0xef, 0x3e, 0x37, 0x08, 0xea, 0x51, 0xd3,
-	0x11, 0x08, 0xf2, 0xc8, 0xe0, 0x10, 0xd6, 0xcd, 0xf7, 0x60, 0x20, 0x18, 0x63, 0x8e, 0xf7, 0xaf,
-	0x8e, 0x7f, 0xae, 0xc1, 0x81, 0xc4, 0x54, 0xc6, 0xd1, 0xed, 0x33, 0x22, 0xae, 0x14, 0x93, 0x99,
-	0x5a, 0x3a, 0xae, 0xa4, 0x89, 0x0c, 0x26, 0xb0, 0xd1, 0x71, 0x28, 0x6f, 0x98, 0xb6, 0xd3, 0xf4,
-	0xb1, 0x49, 0x3c, 0x57, 0x4e, 0x14, 0x18, 0xc8, 0xe0, 0x10, 0xfd, 0xcf, 0x9a, 0x78, 0x0a, 0xdb,
-	0xe7, 0x1e, 0xef, 0x67, 0x19, 0xa8, 0xae, 0xb8, 0x04, 0xfb, 0x74, 0xef, 0xdf, 0x3d, 0xd0, 0x8b,
-	0x50, 0xe6, 0x13, 0x23, 0x4d, 0xcb, 0xa4, 0xa6, 0x3c, 0xae, 0x8e, 0x29, 0xf3, 0xe6, 0x2f, 0x33,
-	0xbc, 0x65, 0x93, 0x9a, 0x86, 0xd0, 0x0e, 0x61, 0xdf, 0xe8, 0x30, 0x94, 0x36, 0x4d, 0xb2, 0xd9,
-	0xbc, 0x8b, 0xb7, 0x44, 0x40, 0x58, 0x35, 0x8a, 0x0c, 0xf0, 0x0a, 0xde, 0x22, 0xe8, 0x10, 0x14,
-	0xdd, 0x5e, 0x47, 0x6c, 0xb0, 0xc2, 0x82, 0xb6, 0x58, 0x35, 0x0a, 0x6e, 0xaf, 0xc3, 0xb7, 0xd7,
-	0x5f, 0x33, 0x30, 0x75, 0xab, 0xc7, 0x6e, 0x3a, 0x3c, 0xeb, 0xdf, 0x73, 0xe8, 0xc3, 0x19, 0xe3,
-	0x19, 0xc8, 0x8a, 0x98, 0x81, 0x51, 0xd4, 0x95, 0x82, 0xaf, 0x2c, 0x13, 0x83, 0x21, 0xf1, 0x8c,
-	0x77, 0xaf, 0xd5, 0x92, 0xe1, 0x57, 0x96, 0x0b, 0x5b, 0x62, 0x10, 0x11, 0x7c, 0x1d, 0x86, 0x12,
-	0xf6, 0xfd, 0x30, 0x38, 0xe3, 0x53, 0xc1, 0xbe, 0x2f, 0x3a, 0x75, 0xa8, 0x98, 0xad, 0xbb, 0xae,
-	0x77, 0xdf, 0xc1, 0x56, 0x1b, 0x5b, 0x7c, 0xd9, 0x8b, 0x46, 0x0c, 0x26, 0x0c, 0x83, 0x2d, 0x7c,
-	0xb3, 0xe5, 0x52, 0x7e, 0xc5, 0xc8, 0x32, 0xc3, 0x60, 0x90, 0x6b, 0x2e, 0x65, 0xdd, 0x16, 0x76,
-	0x30, 0xc5, 0xbc, 0xbb, 0x20, 0xba, 0x05, 0x44, 0x76, 0xf7, 0xba, 0x21, 0x75, 0x51, 0x74, 0x0b,
-	0x08, 0xeb, 0x3e, 0x02, 0xa5, 0x7e, 0x5a, 0xbf, 0xd4, 0xcf, 0x13, 0x72, 0x80, 0xfe, 0x2f, 0x0d,
-	0xaa, 0xcb, 0x9c, 0xd5, 0x3e, 0x30, 0x3a, 0x04, 0x93, 0xf8, 0x41, 0xd7, 0x97, 0x5b, 0x87, 0x7f,
-	0x0f, 0xb5, 0x23, 0xfd, 0x1e, 0xd4, 0x56, 0x1d, 0xb3, 0x85, 0x37, 0x3d, 0xc7, 0xc2, 0x3e, 0x3f,
-	0xdb, 0x51, 0x0d, 0xb2, 0xd4, 0x6c, 0xcb, 0xe0, 0x81, 0x7d, 0xa2, 0xe7, 0xe5, 0xdd, 0x4e, 0xb8,
-	0xa5, 0xc7, 0x95, 0xa7, 0x6c, 0x84, 0x4d, 0x24, 0x65, 0x3a, 0x0f, 0x79, 0xfe, 0xd4, 0x26, 0xc2,
-	0x8a, 0x8a, 0x21, 0x5b, 0xfa, 0x9d, 0xd8, 0xb8, 0xd7, 0x7d, 0xaf, 0xd7, 0x45, 0x2b, 0x50, 0xe9,
-	0xf6, 0x61, 0xcc, 0x56, 0xd3, 0xcf, 0xf4, 0xa4, 0xd0, 0x46, 0x8c, 0x54, 0xff, 0x2c, 0x0b, 0xd5,
-	0x35, 0x6c, 0xfa, 0xad, 0xcd, 0xfd, 0x90, 0x64, 0x61, 0x1a, 0xb7, 0x88, 0x23, 0x57, 0x8d, 0x7d,
-	0xa2, 0xb3, 0x30, 0x13, 0x99, 0x50, 0xb3, 0xcd, 0x14, 0xc4, 0xed, 0xbe, 0x62, 0xd4, 0xba, 0x49,
-	0xc5, 0x3d, 0x07, 0x45, 0x8b, 0x38, 0x4d, 0xbe, 0x44, 0x05, 0xbe, 0x44, 0xea, 0xf9, 0x2d, 0x13,
-	0x87, 0x2f, 0x4d, 0xc1, 0x12, 0x1f, 0xe8, 0x31, 0xa8, 0x7a, 0x3d, 0xda, 0xed, 0xd1, 0xa6, 0xf0,
-	0x3b, 0xf5, 0x22, 0x17, 0xaf, 0x22, 0x80, 0xdc, 0x2d, 0x11, 0xf4, 0x32, 0x54, 0x09, 0x57, 0x65,
-	0x10, 0x79, 0x97, 0x46, 0x0d, 0x10, 0x2b, 0x82, 0x4e, 0x84, 0xde, 0xe8, 0x34, 0xd4, 0xa8, 0x6f,
-	0xde, 0xc3, 0x4e, 0xe4, 0x11, 0x0d, 0xf8, 0x6e, 0x9b, 0x16, 0xf0, 0xfe, 0x03, 0xda, 0x05, 0x98,
-	0x6d, 0xf7, 0x4c, 0xdf, 0x74, 0x29, 0xc6, 0x11, 0xec, 0x32, 0xc7, 0x46, 0x61, 0x57, 0x48, 0xa0,
-	0xbf, 0x02, 0x93, 0x37, 0x6c, 0xca, 0x15, 0xc9, 0x7c, 0x96, 0xc6, 0xef, 0x39, 0xdc, 0x33, 0x1d,
-	0x82, 0xa2, 0xef, 0xdd, 0x17, 0x3e, 0x38, 0xc3, 0x4d, 0xb0, 0xe0, 0x7b, 0xf7, 0xb9, 0x83, 0xe5,
-	0xa5, 0x07, 0x9e, 0x2f, 0x6d, 0x33, 0x63, 0xc8, 0x96, 0xfe, 0x9e, 0xd6, 0x37, 0x1e, 0xe6, 0x3e,
-	0xc9, 0xc3, 0xf9, 0xcf, 0x17, 0xa1, 0xe0, 0x0b, 0xfa, 0xa1, 0x8f, 0xa6, 0xd1, 0x91, 0xf8, 0x19,
-	0x10, 0x50, 0xe9, 0x5f, 0xd7, 0xa0, 0xf2, 0xb2, 0xd3, 0x23, 0x8f, 0xc2, 0x86, 0x55, 0xcf, 0x09,
-	0x59, 0xf5, 0x53, 0xc6, 0x77, 0x32, 0x50, 0x95, 0x62, 0x8c, 0x13, 0xdb, 0xa4, 0x8a, 0xb2, 0x06,
-	0x65, 0x36, 0x64, 0x93, 0xe0, 0x76, 0x90, 0x8b, 0x29, 0x2f, 0x2d, 0x29, 0x77, 0x7d, 0x4c, 0x0c,
-	0xfe, 0xdc, 0xbc, 0xc6, 0x89, 0xbe, 0xe4, 0x52, 0x7f, 0xcb, 0x80, 0x56, 0x08, 0x68, 0xdc, 0x81,
-	0xe9, 0x44, 0x37, 0xb3, 0x8d, 0xbb, 0x78, 0x2b, 0x70, 0x6b, 0x77, 0xf1, 0x16, 0x7a, 0x3a, 0x5a,
-	0x14, 0x90, 0x76, 0x38, 0xdf, 0xf4, 0xdc, 0xf6, 0x15, 0xdf, 0x37, 0xb7, 0x64, 0xd1, 0xc0, 0xe5,
-	0xcc, 0xf3, 0x9a, 0xfe, 0xa7, 0x0c, 0x54, 0x5e, 0xed, 0x61, 0x7f, 0x6b, 0x37, 0xdd, 0x4b, 0xe0,
-	0xec, 0x27, 0x23, 0xce, 0x7e, 0x60, 0x47, 0xe7, 0x14, 0x3b, 0x5a, 0xe1, 0x97, 0xf2, 0x4a, 0xbf,
-	0xa4, 0xda, 0xb2, 0x85, 0x1d, 0x6d, 0xd9, 0x62, 0xea, 0x96, 0x65, 0xd6, 0x2d, 0x55, 0x38, 0xd6,
-	0x26, 0x8b, 0x45, 0x59, 0x99, 0x9d, 0x46, 0x59, 0xfa, 0xc7, 0x1a, 0x94, 0xde, 0xc0, 0x2d, 0xea,
-	0xf9, 0xcc, 0x5b, 0x28, 0x74, 0xaf, 0x8d, 0x10, 0xc8, 0x66, 0x92, 0x81, 0xec, 0x25, 0x28, 0xda,
-	0x56, 0xd3, 0x64, 0x66, 0xc3, 0x17, 0x6f, 0x58, 0x00, 0x55, 0xb0, 0x2d, 0x6e, 0x5f, 0xa3, 0xe7,
-	0xe4, 0x7f, 0xa0, 0x41, 0x45, 0xc8, 0x4c, 0x04, 0xe5, 0x0b, 0x91, 0xe1, 0x34, 0x95, 0x2d, 0xcb,
-	0x46, 0x38, 0xd1, 0x1b, 0x13, 0xfd, 0x61, 0xaf, 0x00, 0x30, 0xdd, 0x49, 0x72, 0xb1, 0x15, 0x16,
-	0x94, 0xd2, 0x0a, 0x72, 0xae, 0xc7, 0x1b, 0x13, 0x46, 0x89, 0x51, 0x71, 0x16, 0x57, 0x0b, 0x90,
-	0xe3, 0xd4, 0xfa, 0x7f, 0x34, 0x98, 0xbd, 0x66, 0x3a, 0xad, 0x65, 0x9b, 0x50, 0xd3, 0x6d, 0x8d,
-	0x11, 0x32, 0x5d, 0x86, 0x82, 0xd7, 0x6d, 0x3a, 0x78, 0x83, 0x4a, 0x91, 0x4e, 0x0c, 0x99, 0x91,
-	0x50, 0x83, 0x91, 0xf7, 0xba, 0x37, 0xf1, 0x06, 0x45, 0xff, 0x0f, 0x45, 0xaf, 0xdb, 0xf4, 0xed,
-	0xf6, 0x26, 0x95, 0xda, 0x1f, 0x81, 0xb8, 0xe0, 0x75, 0x0d, 0x46, 0x11, 0xc9, 0x84, 0x4c, 0xee,
-	0x30, 0x13, 0xa2, 0xff, 0x63, 0x60, 0xfa, 0x63, 0x98, 0xf6, 0x65, 0x28, 0xda, 0x2e, 0x6d, 0x5a,
-	0x36, 0x09, 0x54, 0x70, 0x54, 0x6d, 0x43, 0x2e, 0xe5, 0x33, 0xe0, 0x6b, 0xea, 0x52, 0x36, 0x36,
-	0x7a, 0x09, 0x60, 0xc3, 0xf1, 0x4c, 0x49, 0x2d, 0x74, 0x70, 0x5c, 0xbd, 0x2b, 0x18, 0x5a, 0x40,
-	0x5f, 0xe2, 0x44, 0x8c, 0x43, 0x7f, 0x49, 0xff, 0xa6, 0xc1, 0x81, 0x55, 0xec, 0x8b, 0x4a, 0x10,
-	0x2a, 0xb3, 0x92, 0x2b, 0xee, 0x86, 0x17, 0x4f, 0x0c, 0x6b, 0x89, 0xc4, 0xf0, 0xe7, 0x93, 0x0c,
-	0x8d, 0xdd, 0x73, 0xc4, 0xf3, 0x44, 0x70, 0xcf, 0x09, 0x1e, 0x61, 0xc4, 0x3d, 0x71, 0x2a, 0x65,
-	0x99, 0xa4, 0xbc, 0xd1, 0xeb, 0xb2, 0xfe, 0x5d, 0x51, 0x10, 0xa1, 0x9c, 0xd4, 0xc3, 0x1b, 0xec,
-	0x3c, 0x48, 0x07, 0x9e, 0x70, 0xe7, 0x4f, 0x40, 0xc2, 0x77, 0xa4, 0x94, 0x69, 0xfc, 0x48, 0x83,
-	0x85, 0x74, 0xa9, 0xc6, 0x39, 0x79, 0x5f, 0x82, 0x9c, 0xed, 0x6e, 0x78, 0x41, 0x92, 0xec, 0x8c,
-	0x3a, 0xa0, 0x56, 0x8e, 0x2b, 0x08, 0xf5, 0xdf, 0x66, 0xa0, 0xc6, 0x7d, 0xf5, 0x2e, 0x2c, 0x7f,
-	0x07, 0x77, 0x9a, 0xc4, 0x7e, 0x07, 0x07, 0xcb, 0xdf, 0xc1, 0x9d, 0x35, 0xfb, 0x1d, 0x1c, 0xb3,
-	0x8c, 0x5c, 0xdc, 0x32, 0xe2, 0x69, 0x84, 0xfc, 0x90, 0x24, 0x68, 0x21, 0x9e, 0x04, 0x9d, 0x87,
-	0xbc, 0xeb, 0x59, 0x78, 0x65, 0x59, 0x5e, 0x12, 0x65, 0xab, 0x6f, 0x6a, 0xa5, 0x1d, 0x9a, 0xda,
-	0x87, 0x1a, 0x34, 0xae, 0x63, 0x9a, 0xd4, 0xdd, 0xee, 0x59, 0xd9, 0x47, 0x1a, 0x1c, 0x56, 0x0a,
-	0x34, 0x8e, 0x81, 0xbd, 0x10, 0x37, 0x30, 0xf5, 0x8d, 0x6d, 0x60, 0x48, 0x69, 0x5b, 0x17, 0xa1,
-	0xb2, 0xdc, 0xeb, 0x74, 0xc2, 0x48, 0xea, 0x04, 0x54, 0x7c, 0xf1, 0x29, 0x2e, 0x34, 0xe2, 0xfc,
-	0x2d, 0x4b, 0x18, 0xbb, 0xb6, 0xe8, 0x67, 0xa1, 0x2a, 0x49, 0xa4, 0xd4, 0x0d, 0x28, 0xfa, 0xf2,
-	0x5b, 0xe2, 0x87, 0x6d, 0xfd, 0x00, 0xcc, 0x1a, 0xb8, 0xcd, 0x4c, 0xdb, 0xbf, 0x69, 0xbb, 0x77,
-	0xe5, 0x30, 0xfa, 0xbb, 0x1a, 0xcc, 0xc5, 0xe1, 0x92, 0xd7, 0xb3, 0x50, 0x30, 0x2d, 0xcb, 0xc7,
-	0x84, 0x0c, 0x5d, 0x96, 0x2b, 0x02, 0xc7, 0x08, 0x90, 0x23, 0x9a, 0xcb, 0x8c, 0xac, 0x39, 0xbd,
-	0x09, 0x33, 0xd7, 0x31, 0xbd, 0x85, 0xa9, 0x3f, 0xd6, 0x83, 0x7a, 0x9d, 0x5d, 0x35, 0x38, 0xb1,
-	0x34, 0x8b, 0xa0, 0xa9, 0x7f, 0xa0, 0x01, 0x8a, 0x8e, 0x30, 0xce, 0x32, 0x47, 0xb5, 0x9c, 0x89,
-	0x6b, 0x59, 0xd4, 0x1c, 0x75, 0xba, 0x9e, 0x8b, 0x5d, 0x1a, 0x8d, 0x59, 0xab, 0x21, 0x94, 0x9b,
-	0xdf, 0x27, 0x1a, 0xa0, 0x9b, 0x9e, 0x69, 0x5d, 0x35, 0x9d, 0xf1, 0xc2, 0x83, 0xa3, 0x00, 0xc4,
-	0x6f, 0x35, 0xe5, 0x6e, 0xcd, 0x48, 0xef, 0xe3, 0xb7, 0x6e, 0x8b, 0x0d, 0x7b, 0x1c, 0xca, 0x16,
-	0xa1, 0xb2, 0x3b, 0x78, 0xdf, 0x05, 0x8b, 0x50, 0xd1, 0xcf, 0x6b, 0x40, 0x09, 0x36, 0x1d, 0x6c,
-	0x35, 0x23, 0xcf, 0x63, 0x93, 0x1c, 0xad, 0x26, 0x3a, 0xd6, 0xfa, 0x8f, 0x64, 0x77, 0xe0, 0xe0,
-	0x2d, 0xd3, 0xed, 0x99, 0xce, 0x35, 0xaf, 0xd3, 0x35, 0x63, 0x75, 0x86, 0x49, 0x37, 0xa7, 0x29,
-	0xdc, 0xdc, 0x31, 0x51, 0x88, 0x26, 0x22, 0x66, 0x2e, 0xeb, 0xa4, 0x11, 0x81, 0xe8, 0x04, 0xea,
-	0x83, 0xec, 0xc7, 0x59, 0x28, 0x2e, 0x54, 0xc0, 0x2a, 0xea, 0x7b, 0xfb, 0x30, 0xfd, 0x45, 0x38,
-	0xc4, 0x8b, 0x02, 0x03, 0x50, 0x2c, 0x11, 0x9f, 0x64, 0xa0, 0x29, 0x18, 0x7c, 0x33, 0xc3, 0x5d,
-	0xdb, 0x00, 0x87, 0x71, 0x04, 0xbf, 0x1c, 0xcf, 0x7f, 0x3f, 0x9e, 0x52, 0xa8, 0x1a, 0x1f, 0x51,
-	0x26, 0xc1, 0x17, 0x61, 0x1a, 0x3f, 0xc0, 0xad, 0x1e, 0xb5, 0xdd, 0xf6, 0xaa, 0x63, 0xba, 0xb7,
-	0x3d, 0x79, 0xa0, 0x24, 0xc1, 0xe8, 0x71, 0xa8, 0x32, 0xed, 0x7b, 0x3d, 0x2a, 0xf1, 0xc4, 0xc9,
-	0x12, 0x07, 0x32, 0x7e, 0x6c, 0xbe, 0x0e, 0xa6, 0xd8, 0x92, 0x78, 0xe2, 0x98, 0x49, 0x82, 0x07,
-	0x54, 0xc9, 0xc0, 0x64, 0x27, 0xaa, 0xfc, 0x54, 0x4b, 0xa8, 0x52, 0x72, 0xd8, 0x2d, 0x55, 0xde,
-	0x00, 0xe8, 0x60, 0xbf, 0x8d, 0x57, 0xb8, 0x53, 0x17, 0x17, 0xf2, 0x45, 0xa5, 0x53, 0xef, 0x33,
-	0xb8, 0x15, 0x10, 0x18, 0x11, 0x5a, 0xfd, 0x3a, 0xcc, 0x2a, 0x50, 0x98, 0xbf, 0x22, 0x5e, 0xcf,
-	0x6f, 0xe1, 0x20, 0x55, 0x13, 0x34, 0xd9, 0xf9, 0x46, 0x4d, 0xbf, 0x8d, 0xa9, 0x34, 0x5a, 0xd9,
-	0xd2, 0x9f, 0xe5, 0x4f, 0x46, 0xfc, 0xfe, 0x1f, 0xb3, 0xd4, 0xf8, 0xfb, 0xb6, 0x36, 0xf0, 0xbe,
-	0xbd, 0xc1, 0xdf, 0x67, 0xa2, 0x74, 0x63, 0xd6, 0x26, 0x6c, 0x30, 0x56, 0xd8, 0x92, 0x3f, 0x29,
-	0x04, 0xcd, 0x33, 0x27, 0xa0, 0x18, 0x14, 0xb7, 0xa0, 0x02, 0x64, 0xaf, 0x38, 0x4e, 0x6d, 0x02,
-	0x55, 0xa0, 0xb8, 0x22, 0x2b, 0x38, 0x6a, 0xda, 0x99, 0x2f, 0xc2, 0x74, 0x22, 0x47, 0x8a, 0x8a,
-	0x30, 0x79, 0xdb, 0x73, 0x71, 0x6d, 0x02, 0xd5, 0xa0, 0x72, 0xd5, 0x76, 0x4d, 0x7f, 0x4b, 0xdc,
-	0x49, 0x6a, 0x16, 0x9a, 0x86, 0x32, 0x8f, 0xcd, 0x25, 0x00, 0x2f, 0xbd, 0x77, 0x1c, 0xaa, 0xb7,
-	0xb8, 0x8c, 0x6b, 0xd8, 0xbf, 0x67, 0xb7, 0x30, 0x6a, 0x42, 0x2d, 0xf9, 0x2b, 0x0f, 0x7a, 0x52,
-	0xbd, 0x4e, 0xea, 0x3f, 0x7e, 0x1a, 0xc3, 0x66, 0xad, 0x4f, 0xa0, 0xb7, 0x60, 0x2a, 0xfe, 0x43,
-	0x0c, 0x52, 0x07, 0x8f, 0xca, 0xbf, 0x66, 0xb6, 0x63, 0xde, 0x84, 0x6a, 0xec, 0xff, 0x16, 0x74,
-	0x5a, 0xc9, 0x5b, 0xf5, 0x0f, 0x4c, 0x43, 0x7d, 0x9f, 0x8b, 0xfe, 0x83, 0x22, 0xa4, 0x8f, 0x57,
-	0xab, 0xa7, 0x48, 0xaf, 0x2c, 0x69, 0xdf, 0x4e, 0x7a, 0x13, 0x66, 0x06, 0x8a, 0xcf, 0xd1, 0x39,
-	0x25, 0xff, 0xb4, 0x22, 0xf5, 0xed, 0x86, 0xb8, 0x0f, 0x68, 0xf0, 0x3f, 0x0e, 0x74, 0x5e, 0xbd,
-	0x02, 0x69, 0x7f, 0xb1, 0x34, 0x2e, 0x8c, 0x8c, 0x1f, 0x2a, 0xee, 0x1b, 0x1a, 0x1c, 0x4c, 0xa9,
-	0x18, 0x47, 0x97, 0x94, 0xec, 0x86, 0x97, 0xbd, 0x37, 0x9e, 0xde, 0x19, 0x51, 0x28, 0x88, 0x0b,
-	0xd3, 0x89, 0x22, 0x6a, 0x74, 0x36, 0xb5, 0xb0, 0x6c, 0xb0, 0x9a, 0xbc, 0xf1, 0xe4, 0x68, 0xc8,
-	0xe1, 0x78, 0x77, 0x60, 0x3a, 0x51, 0x79, 0x9c, 0x32, 0x9e, 0xba, 0x3e, 0x79, 0xbb, 0x05, 0x7d,
-	0x13, 0xaa, 0xb1, 0x12, 0xe1, 0x14, 0x8b, 0x57, 0x95, 0x11, 0x6f, 0xc7, 0xfa, 0x0e, 0x54, 0xa2,
-	0x95, 0xbc, 0x68, 0x31, 0x6d, 0x2f, 0x0d, 0x30, 0xde, 0xc9, 0x56, 0xea, 0x17, 0xea, 0x0d, 0xd9,
-	0x4a, 0x03, 0xb5, 0x8d, 0xa3, 0x6f, 0xa5, 0x08, 0xff, 0xa1, 0x5b, 0x69, 0xc7, 0x43, 0xbc, 0xab,
-	0xc1, 0xbc, 0xba, 0x10, 0x14, 0x2d, 0xa5, 0xd9, 0x66, 0x7a, 0xc9, 0x6b, 0xe3, 0xd2, 0x8e, 0x68,
-	0x42, 0x2d, 0xde, 0x85, 0xa9, 0x78, 0xb9, 0x63, 0x8a, 0x16, 0x95, 0x15, 0xa2, 0x8d, 0xb3, 0x23,
-	0xe1, 0x86, 0x83, 0xbd, 0x0e, 0xe5, 0xc8, 0xdf, 0xb9, 0xe8, 0xd4, 0x10, 0x3b, 0x8e, 0xfe, 0xaa,
-	0xba, 0x9d, 0x26, 0x5f, 0x85, 0x52, 0xf8, 0x53, 0x2d, 0x3a, 0x99, 0x6a, 0xbf, 0x3b, 0x61, 0xb9,
-	0x06, 0xd0, 0xff, 0x63, 0x16, 0x3d, 0xa1, 0xe4, 0x39, 0xf0, 0x4b, 0xed, 0x76, 0x4c, 0xc3, 0xe9,
-	0x8b, 0x47, 0xe6, 0x61, 0xd3, 0x8f, 0x56, 0x45, 0x6c, 0xc7, 0x76, 0x13, 0xaa, 0xb1, 0x5a, 0xa6,
-	0xb4, 0x2d, 0xac, 0x28, 0x31, 0x6b, 0x9c, 0x19, 0x05, 0x35, 0x5c, 0xbf, 0x4d, 0xa8, 0xc6, 0x2a,
-	0x4b, 0x52, 0x46, 0x52, 0x15, 0xd2, 0xa4, 0x8c, 0xa4, 0x2c, 0x54, 0xd1, 0x27, 0xd0, 0xd7, 0x22,
-	0x45, 0x2c, 0xb1, 0x42, 0x21, 0x74, 0x71, 0x28, 0x1f, 0x55, 0x9d, 0x54, 0x63, 0x69, 0x27, 0x24,
-	0xa1, 0x08, 0xd2, 0xaa, 0x84, 0x4a, 0xd3, 0xad, 0x6a, 0x27, 0x2b, 0xb5, 0x06, 0x79, 0x51, 0x2b,
-	0x82, 0xf4, 0x94, 0xaa, 0xb0, 0x48, 0x21, 0x49, 0xe3, 0x31, 0x25, 0x4e, 0xbc, 0x8c, 0x42, 0x30,
-	0x15, 0xb5, 0x00, 0x29, 0x4c, 0x63, 0x85, 0x02, 0xa3, 0x32, 0x35, 0x20, 0x2f, 0x1e, 0x01, 0x53,
-	0x98, 0xc6, 0x1e, 0xb2, 0x1b, 0xc3, 0x71, 0xc4, 0xcb, 0xe1, 0x04, 0x5a, 0x85, 0x1c, 0x0f, 0x7a,
-	0xd1, 0x89, 0x61, 0x0f, 0x69, 0xc3, 0x38, 0xc6, 0xde, 0xda, 0xf4, 0x09, 0xf4, 0x65, 0xc8, 0xf1,
-	0x14, 0x4e, 0x0a, 0xc7, 0xe8, 0x6b, 0x58, 0x63, 0x28, 0x4a, 0x20, 0xa2, 0x05, 0x95, 0x68, 0xae,
-	0x3c, 0xe5, 0xc8, 0x52, 0xbc, 0x26, 0x34, 0x46, 0xc1, 0x0c, 0x46, 0x11, 0xdb, 0xa8, 0x7f, 0x01,
-	0x48, 0xdf, 0x46, 0x03, 0x97, 0x8b, 0xf4, 0x6d, 0x34, 0x78, 0x9f, 0xd0, 0x27, 0xd0, 0xb7, 0x34,
-	0xa8, 0xa7, 0x25, 0x70, 0x51, 0x6a, 0x04, 0x34, 0x2c, 0x0b, 0xdd, 0x78, 0x66, 0x87, 0x54, 0xa1,
-	0x2c, 0xef, 0xc0, 0xac, 0x22, 0xcb, 0x87, 0x2e, 0xa4, 0xf1, 0x4b, 0x49, 0x50, 0x36, 0x9e, 0x1a,
-	0x9d, 0x20, 0x1c, 0x7b, 0x15, 0x72, 0x3c, 0x3b, 0x97, 0x62, 0x28, 0xd1, 0x64, 0x5f, 0x8a, 0xe9,
-	0xc5, 0x92, 0x7b, 0xfa, 0x04, 0xc2, 0x50, 0x89, 0xa6, 0xea, 0x52, 0x2c, 0x45, 0x91, 0xe5, 0x6b,
-	0x9c, 0x1e, 0x01, 0x33, 0x1c, 0xa6, 0x09, 0xd0, 0x4f, 0x95, 0xa5, 0x9c, 0x43, 0x03, 0xd9, 0xba,
-	0xc6, 0xa9, 0x6d, 0xf1, 0xa2, 0x47, 0x72, 0x24, 0xf9, 0x95, 0x72, 0x26, 0x0d, 0xa6, 0xc7, 0x46,
-	0xb8, 0x27, 0x0c, 0x26, 0x62, 0x52, 0xee, 0x09, 0xa9, 0x39, 0x9f, 0xc6, 0x85, 0x91, 0xf1, 0xc3,
-	0xf9, 0xbc, 0x0d, 0xb5, 0x64, 0xe2, 0x2a, 0xe5, 0xfe, 0x99, 0x92, 0x3e, 0x6b, 0x9c, 0x1b, 0x11,
-	0x3b, 0x7a, 0x56, 0x1d, 0x1e, 0x94, 0xe9, 0x2b, 0x36, 0xdd, 0xe4, 0x39, 0x93, 0x51, 0x66, 0x1d,
-	0x4d, 0xcf, 0x8c, 0x32, 0xeb, 0x58, 0x32, 0x46, 0x9f, 0x58, 0xea, 0x41, 0x65, 0xd5, 0xf7, 0x1e,
-	0x6c, 0x05, 0xb7, 0xf0, 0xff, 0x8d, 0x75, 0x5e, 0x7d, 0xe6, 0xab, 0x97, 0xda, 0x36, 0xdd, 0xec,
-	0xad, 0xb3, 0xf5, 0xbf, 0x20, 0x70, 0xcf, 0xd9, 0x9e, 0xfc, 0xba, 0x60, 0xbb, 0x14, 0xfb, 0xae,
-	0xe9, 0x5c, 0xe0, 0xbc, 0x24, 0xb4, 0xbb, 0xbe, 0x9e, 0xe7, 0xed, 0x4b, 0xff, 0x0d, 0x00, 0x00,
-	0xff, 0xff, 0x86, 0x01, 0x16, 0x1c, 0xed, 0x45, 0x00, 0x00,
+	// 4597 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3c, 0x5d, 0x8f, 0x1c, 0x57,
+	0x56, 0xae, 0xee, 0xe9, 0xaf, 0xd3, 0xdd, 0x33, 0x3d, 0x35, 0x1f, 0xee, 0x94, 0xed, 0x78, 0x5c,
+	0x89, 0x63, 0xc7, 0x4e, 0xec, 0xcd, 0x38, 0xc9, 0x86, 0x24, 0x90, 0xd8, 0x9e, 0xd8, 0x1e, 0xc5,
+	0xf6, 0xce, 0xd6, 0xc4, 0xbb, 0x5a, 0x16, 0xab, 0xa9, 0xe9, 0xba, 0xd3, 0x53, 0x4c, 0x75, 0x55,
+	0xa7, 0xee, 0xed, 0x19, 0x77, 0x1e, 0x10, 0x52, 0xd0, 0xae, 0xd0, 0x42, 0x56, 0x08, 0x04, 0x62,
+	0x25, 0x78, 0x60, 0xe1, 0x01, 0xa1, 0x00, 0xbb, 0x8b, 0x00, 0x21, 0x21, 0x84, 0xc4, 0x03, 0x0f,
+	0x48, 0x0b, 0xbc, 0xf0, 0xc0, 0x0b, 0x7f, 0x60, 0x25, 0x1e, 0x78, 0xe4, 0x01, 0xdd, 0x8f, 0xaa,
+	0xae, 0xaa, 0xbe, 0xd5, 0x5d, 0x33, 0x9d, 0xd9, 0x99, 0x91, 0xf6, 0xad, 0xee, 0xb9, 0xe7, 0xdc,
+	0x7b, 0xee, 0xb9, 0xe7, 0x9c, 0xfb, 0x71, 0xce, 0x2d, 0xa8, 0x75, 0x6d, 0x67, 0xaf, 0x8f, 0x6f,
+	0xf4, 0x7c, 0x8f, 0x78, 0xea, 0x42, 0xb4, 0x74, 0x83, 0x17, 0xb4, 0x5a, 0xdb, 0xeb, 0x76, 0x3d,
+	0x97, 0x03, 0xb5, 0x1a, 0x6e, 0xef, 0xa0, 0xae, 0xc9, 0x4b, 0xfa, 0x1f, 0x29, 0xa0, 0xde, 0xf5,
+	0x91, 0x49, 0xd0, 0x6d, 0xc7, 0x36, 0xb1, 0x81, 0x3e, 0xee, 0x23, 0x4c, 0xd4, 0x2f, 0xc1, 0xcc,
+	0x96, 0x89, 0x51, 0x53, 0x59, 0x51, 0xae, 0x56, 0x57, 0xcf, 0xdf, 0x88, 0x35, 0x2b, 0x9a, 0x7b,
+	0x84, 0x3b, 0x77, 0x4c, 0x8c, 0x0c, 0x86, 0xa9, 0x9e, 0x85, 0x92, 0xb5, 0xd5, 0x72, 0xcd, 0x2e,
+	0x6a, 0xe6, 0x56, 0x94, 0xab, 0x15, 0xa3, 0x68, 0x6d, 0x3d, 0x36, 0xbb, 0x48, 0xbd, 0x02, 0x73,
+	0x6d, 0xcf, 0x71, 0x50, 0x9b, 0xd8, 0x9e, 0xcb, 0x11, 0xf2, 0x0c, 0x61, 0x76, 0x08, 0x66, 0x88,
+	0x8b, 0x50, 0x30, 0x29, 0x0f, 0xcd, 0x19, 0x56, 0xcd, 0x0b, 0x3a, 0x86, 0xc6, 0x9a, 0xef, 0xf5,
+	0x8e, 0x8a, 0xbb, 0xb0, 0xd3, 0x7c, 0xb4, 0xd3, 0x3f, 0x54, 0x60, 0xfe, 0xb6, 0x43, 0x90, 0x7f,
+	0x42, 0x85, 0xf2, 0x3d, 0x05, 0xce, 0x1a, 0x88, 0x92, 0xdd, 0x0d, 0xd1, 0x8f, 0x80, 0xcb, 0xe7,
+	0xa0, 0xec, 0x39, 0x56, 0x94, 0xbd, 0x92, 0xe7, 0x58, 0x41, 0x95, 0x8b, 0xf6, 0x79, 0x15, 0x67,
+	0xad, 0xe4, 0xa2, 0x7d, 0x5a, 0xa5, 0xff, 0x58, 0x81, 0x65, 0x26, 0xbc, 0x23, 0xe5, 0x2d, 0xb3,
+	0x04, 0x6f, 0x03, 0xf4, 0x7c, 0xaf, 0x87, 0x7c, 0x62, 0x23, 0x2a, 0xc6, 0xfc, 0xd5, 0xea, 0xea,
+	0x25, 0x69, 0xcf, 0x1f, 0xa2, 0xc1, 0xd7, 0x4c, 0xa7, 0x8f, 0x36, 0x4c, 0xdb, 0x37, 0x22, 0x44,
+	0xfa, 0x3f, 0x28, 0x30, 0x77, 0xdb, 0xb2, 0xee, 0xd9, 0xc8, 0xb1, 0x8e, 0x73, 0x28, 0x6f, 0x42,
+	0x61, 0x9b, 0xf2, 0xc0, 0x24, 0x5e, 0x5d, 0x5d, 0x89, 0x77, 0x2a, 0xec, 0x9a, 0x71, 0xb9, 0xc9,
+	0xbe, 0x0d, 0x8e, 0xae, 0x6f, 0xc1, 0x12, 0xb7, 0xf1, 0x35, 0x93, 0x98, 0x94, 0x97, 0x2f, 0x7e,
+	0x10, 0xfa, 0x2f, 0xc3, 0x02, 0xb5, 0xd3, 0x23, 0xec, 0xe1, 0x01, 0x2c, 0x3e, 0xb4, 0x31, 0x09,
+	0x7a, 0x38, 0xbc, 0x59, 0xea, 0x9f, 0x2b, 0xb0, 0x94, 0x68, 0x0a, 0xf7, 0x3c, 0x17, 0x23, 0xf5,
+	0x16, 0x14, 0x31, 0x31, 0x49, 0x1f, 0x8b, 0xd6, 0xce, 0x49, 0x5b, 0xdb, 0x64, 0x28, 0x86, 0x40,
+	0xa5, 0xb6, 0x20, 0x38, 0xc6, 0xcd, 0xdc, 0x4a, 0x9e, 0xda, 0x02, 0x67, 0x19, 0xab, 0x4b, 0x50,
+	0xb4, 0xb6, 0x5a, 0xb6, 0x45, 0xfd, 0x4b, 0xfe, 0x6a, 0xde, 0x28, 0x58, 0x5b, 0xeb, 0x16, 0x56,
+	0x5f, 0x05, 0xb5, 0xcd, 0x26, 0xc4, 0x6a, 0x11, 0xbb, 0x8b, 0x30, 0x31, 0xbb, 0x3d, 0xae, 0x9b,
+	0x33, 0xc6, 0xbc, 0xa8, 0xf9, 0x28, 0xac, 0xd0, 0xff, 0x2b, 0x07, 0x67, 0xf9, 0x04, 0x9e, 0x0c,
+	0x93, 0x5a, 0x86, 0x22, 0x57, 0x36, 0xa6, 0x88, 0x35, 0x43, 0x94, 0xd4, 0x0b, 0x00, 0x78, 0xc7,
+	0xf4, 0x2d, 0xdc, 0x72, 0xfb, 0xdd, 0x66, 0x61, 0x45, 0xb9, 0x5a, 0x30, 0x2a, 0x1c, 0xf2, 0xb8,
+	0xdf, 0x55, 0x0d, 0x98, 0x6f, 0x7b, 0x2e, 0xb6, 0x31, 0x41, 0x6e, 0x7b, 0xd0, 0x72, 0xd0, 0x1e,
+	0x72, 0x9a, 0xc5, 0x15, 0xe5, 0xea, 0xec, 0xea, 0x65, 0x29, 0xdf, 0x77, 0x87, 0xd8, 0x0f, 0x29,
+	0xb2, 0xd1, 0x68, 0x27, 0x20, 0x09, 0xeb, 0x2e, 0x1d, 0xc6, 0xba, 0xbf, 0xa3, 0xc0, 0x12, 0x55,
+	0xdd, 0x13, 0x21, 0x5b, 0xfd, 0xcf, 0x14, 0x58, 0x7c, 0x60, 0xe2, 0x93, 0x31, 0xd1, 0x17, 0x00,
+	0xa8, 0x7e, 0xb6, 0x98, 0x1e, 0xb2, 0xc9, 0x9e, 0x31, 0x2a, 0x14, 0xb2, 0x49, 0x01, 0xfa, 0x37,
+	0xa0, 0x76, 0xc7, 0xf3, 0x9c, 0xe9, 0xac, 0x67, 0x11, 0x0a, 0x7b, 0x74, 0x5e, 0x18, 0x8f, 0x65,
+	0x83, 0x17, 0xf4, 0x6f, 0xc2, 0xec, 0x26, 0xf1, 0x6d, 0xb7, 0xf3, 0x05, 0x36, 0x5e, 0x09, 0x1a,
+	0xff, 0x0f, 0x05, 0x9e, 0x5b, 0x43, 0xb8, 0xed, 0xdb, 0x5b, 0x27, 0xc4, 0xa2, 0x74, 0xa8, 0x0d,
+	0x21, 0xeb, 0x6b, 0x4c, 0xd4, 0x79, 0x23, 0x06, 0x4b, 0x4c, 0x46, 0x21, 0x39, 0x19, 0x7f, 0x59,
+	0x00, 0x4d, 0x36, 0xa8, 0x69, 0xc4, 0xf7, 0xf3, 0xa1, 0xa1, 0xe7, 0x18, 0xd1, 0x65, 0xe9, 0x8a,
+	0x33, 0xec, 0x4d, 0x2c, 0x3b, 0x81, 0x3f, 0x48, 0x8e, 0x2a, 0x2f, 0x19, 0xd5, 0x2a, 0x2c, 0xed,
+	0xd9, 0x3e, 0xe9, 0x9b, 0x4e, 0xab, 0xbd, 0x63, 0xba, 0x2e, 0x72, 0x84, 0x27, 0x9d, 0x61, 0x9e,
+	0x74, 0x41, 0x54, 0xde, 0xe5, 0x75, 0xdc, 0xab, 0xbe, 0x0e, 0xcb, 0xbd, 0x9d, 0x01, 0xb6, 0xdb,
+	0x23, 0x44, 0x05, 0x46, 0xb4, 0x18, 0xd4, 0xc6, 0xa8, 0xae, 0xc3, 0xfc, 0x88, 0xd3, 0x65, 0xee,
+	0x67, 0xc6, 0x68, 0x24, 0x7d, 0x2e, 0x65, 0x2b, 0x40, 0xee, 0x93, 0x76, 0x84, 0xa0, 0xc4, 0x08,
+	0x16, 0x44, 0xe5, 0x13, 0xd2, 0x1e, 0xd2, 0xc4, 0xdd, 0x5f, 0x39, 0xe9, 0xfe, 0x9a, 0x50, 0x62,
+	0xbb, 0x37, 0x84, 0x9b, 0x15, 0xbe, 0x4a, 0x88, 0xa2, 0xba, 0x0e, 0x73, 0x98, 0x98, 0x3e, 0x69,
+	0xf5, 0x3c, 0x6c, 0x53, 0xb9, 0xe0, 0x26, 0xac, 0xe4, 0x47, 0x57, 0xf8, 0xa1, 0x27, 0xa3, 0x2b,
+	0x17, 0x73, 0x64, 0xb3, 0x8c, 0x70, 0x23, 0xa0, 0x93, 0xfb, 0xd8, 0xea, 0x74, 0x3e, 0x76, 0x01,
+	0x0a, 0x6c, 0x11, 0x6b, 0xd6, 0xd8, 0xfc, 0xcd, 0xd0, 0x35, 0x2c, 0xe1, 0x78, 0xeb, 0x87, 0x75,
+	0xbc, 0x0f, 0x3d, 0xd3, 0x3a, 0x19, 0x8e, 0xf7, 0x33, 0x05, 0x9a, 0x06, 0x72, 0x90, 0x89, 0x4f,
+	0x86, 0x4f, 0xd0, 0x7f, 0x57, 0x81, 0xe7, 0xef, 0x23, 0x12, 0xb1, 0x2e, 0x62, 0x12, 0x1b, 0x13,
+	0xbb, 0x7d, 0x9c, 0x27, 0x12, 0xfd, 0xbb, 0x0a, 0x5c, 0x4c, 0x65, 0x6b, 0x1a, 0x67, 0xf3, 0x65,
+	0x28, 0xd0, 0x2f, 0xbe, 0x87, 0xca, 0xa4, 0x4c, 0x1c, 0x5f, 0xff, 0x6f, 0x05, 0x96, 0x37, 0x77,
+	0xbc, 0xfd, 0x21, 0x4b, 0x47, 0x21, 0xa0, 0xb8, 0xfb, 0xcd, 0x27, 0xdc, 0xaf, 0xfa, 0x1a, 0xcc,
+	0x90, 0x41, 0x8f, 0x1f, 0x86, 0x66, 0x57, 0x2f, 0xdc, 0x90, 0x1c, 0xc4, 0x6f, 0x50, 0x26, 0x3f,
+	0x1a, 0xf4, 0x90, 0xc1, 0x50, 0xd5, 0x97, 0xa1, 0x91, 0x10, 0x79, 0xe0, 0xc0, 0xe6, 0xe2, 0x32,
+	0xc7, 0xfa, 0xdf, 0xe5, 0xe0, 0xec, 0xc8, 0x10, 0xa7, 0x11, 0xb6, 0xac, 0xef, 0x9c, 0xb4, 0x6f,
+	0xf5, 0x32, 0x44, 0x54, 0x20, 0xb2, 0x97, 0xad, 0x0f, 0xa1, 0x07, 0xdf, 0xd3, 0x52, 0x1f, 0x2e,
+	0x75, 0xb0, 0x5c, 0x04, 0x33, 0xc6, 0xa2, 0xc4, 0xc3, 0x62, 0xf5, 0x35, 0x58, 0xb4, 0xdd, 0x47,
+	0xa8, 0xeb, 0xf9, 0x83, 0x56, 0x0f, 0xf9, 0x6d, 0xe4, 0x12, 0xb3, 0x83, 0x70, 0xb3, 0xc8, 0x38,
+	0x5a, 0x08, 0xea, 0x36, 0x86, 0x55, 0xfa, 0x8f, 0x14, 0x58, 0xe6, 0x9b, 0xe7, 0x0d, 0xd3, 0x27,
+	0xf6, 0x71, 0xaf, 0xf4, 0x97, 0x61, 0xb6, 0x17, 0xf0, 0x11, 0x3d, 0x3e, 0xd7, 0x43, 0x28, 0xb3,
+	0xb2, 0x1f, 0x28, 0xb0, 0x48, 0x37, 0xa5, 0xa7, 0x89, 0xe7, 0xbf, 0x52, 0x60, 0xe1, 0x81, 0x89,
+	0x4f, 0x13, 0xcb, 0x7f, 0x2d, 0x96, 0xa0, 0x90, 0xe7, 0x63, 0xbd, 0xec, 0xb9, 0x02, 0x73, 0x71,
+	0xa6, 0x83, 0x5d, 0xd0, 0x6c, 0x8c, 0x6b, 0xac, 0xff, 0xed, 0x70, 0xad, 0x3a, 0x65, 0x9c, 0xff,
+	0xbd, 0x02, 0x17, 0xee, 0x23, 0x12, 0x72, 0x7d, 0x22, 0xd6, 0xb4, 0xac, 0xda, 0xf2, 0x19, 0x5f,
+	0x91, 0xa5, 0xcc, 0x1f, 0xcb, 0xca, 0xf7, 0x9d, 0x1c, 0x2c, 0xd1, 0x65, 0xe1, 0x64, 0x28, 0x41,
+	0x96, 0x43, 0x8c, 0x44, 0x51, 0x0a, 0x32, 0x45, 0x09, 0xd7, 0xd3, 0x62, 0xe6, 0xf5, 0x54, 0xff,
+	0x61, 0x0e, 0x96, 0x93, 0xd2, 0x98, 0x66, 0x5a, 0x24, 0xbc, 0xe6, 0xa4, 0xbc, 0xea, 0x50, 0x0b,
+	0x21, 0xeb, 0x6b, 0xc1, 0xfa, 0x18, 0x83, 0x9d, 0xd8, 0xe5, 0xf1, 0x37, 0x15, 0x58, 0x0e, 0x8e,
+	0x8d, 0x9b, 0xa8, 0xd3, 0x45, 0x2e, 0x39, 0xbc, 0x0e, 0x25, 0x35, 0x20, 0x27, 0xd1, 0x80, 0xf3,
+	0x50, 0xc1, 0xbc, 0x9f, 0xf0, 0x44, 0x38, 0x04, 0xe8, 0xff, 0xa8, 0xc0, 0xd9, 0x11, 0x76, 0xa6,
+	0x99, 0xc4, 0x26, 0x94, 0x6c, 0xd7, 0x42, 0xcf, 0x42, 0x6e, 0x82, 0x22, 0xad, 0xd9, 0xea, 0xdb,
+	0x8e, 0x15, 0xb2, 0x11, 0x14, 0xd5, 0x4b, 0x50, 0x43, 0xae, 0xb9, 0xe5, 0xa0, 0x16, 0xc3, 0x65,
+	0x8a, 0x5c, 0x36, 0xaa, 0x1c, 0xb6, 0x4e, 0x41, 0x94, 0x98, 0xdd, 0xad, 0xae, 0xaf, 0xb1, 0x93,
+	0x78, 0xde, 0x08, 0x8a, 0xfa, 0x6f, 0x29, 0xb0, 0x40, 0xb5, 0x50, 0x70, 0x8f, 0x8f, 0x56, 0x9a,
+	0x2b, 0x50, 0x8d, 0xa8, 0x99, 0x18, 0x48, 0x14, 0xa4, 0xef, 0xc2, 0x62, 0x9c, 0x9d, 0x69, 0xa4,
+	0xf9, 0x3c, 0x40, 0x38, 0x57, 0xdc, 0x1a, 0xf2, 0x46, 0x04, 0xa2, 0xff, 0x24, 0x0c, 0x27, 0x31,
+	0x31, 0x1d, 0xf3, 0xdd, 0x15, 0x9b, 0x92, 0xa8, 0x3f, 0xaf, 0x30, 0x08, 0xab, 0x5e, 0x83, 0x1a,
+	0x7a, 0x46, 0x7c, 0xb3, 0xd5, 0x33, 0x7d, 0xb3, 0xcb, 0xcd, 0x2a, 0x93, 0xeb, 0xad, 0x32, 0xb2,
+	0x0d, 0x46, 0xa5, 0xff, 0x0b, 0xdd, 0xa6, 0x09, 0x75, 0x3d, 0xe9, 0x23, 0xbe, 0x00, 0xc0, 0xd4,
+	0x99, 0x57, 0x17, 0x78, 0x35, 0x83, 0xb0, 0xc5, 0xed, 0x4f, 0x15, 0x68, 0xb0, 0x21, 0xf0, 0xf1,
+	0xf4, 0x68, 0xb3, 0x09, 0x1a, 0x25, 0x41, 0x33, 0xc6, 0xb8, 0x7e, 0x0e, 0x8a, 0x42, 0xb0, 0xf9,
+	0xac, 0x82, 0x15, 0x04, 0x13, 0x86, 0xa1, 0xff, 0x31, 0xbd, 0xae, 0x8d, 0x8b, 0x7c, 0x1a, 0x8d,
+	0xfe, 0x08, 0x54, 0x3e, 0x42, 0x6b, 0x38, 0xec, 0x60, 0x21, 0xbe, 0x2c, 0x5d, 0x75, 0x92, 0x42,
+	0x32, 0xe6, 0xed, 0x04, 0x04, 0xeb, 0xff, 0xa6, 0xc0, 0xf9, 0xfb, 0x88, 0x30, 0xd4, 0x3b, 0xd4,
+	0xab, 0x6c, 0xf8, 0x5e, 0xc7, 0x47, 0x18, 0x9f, 0x5e, 0xfd, 0xf8, 0x3d, 0xbe, 0x73, 0x93, 0x0d,
+	0x69, 0x1a, 0xf9, 0x5f, 0x82, 0x1a, 0xeb, 0x03, 0x59, 0x2d, 0xdf, 0xdb, 0xc7, 0x42, 0x8f, 0xaa,
+	0x02, 0x66, 0x78, 0xfb, 0x4c, 0x21, 0x88, 0x47, 0x4c, 0x87, 0x23, 0x88, 0x25, 0x83, 0x41, 0x68,
+	0x35, 0xb3, 0xc1, 0x80, 0x31, 0xda, 0x38, 0x3a, 0xbd, 0x32, 0xfe, 0x13, 0x05, 0x96, 0x12, 0x43,
+	0x99, 0x46, 0xb6, 0x6f, 0xf0, 0x7d, 0x25, 0x1f, 0xcc, 0xec, 0xea, 0x45, 0x29, 0x4d, 0xa4, 0x33,
+	0x8e, 0xad, 0x5e, 0x84, 0xea, 0xb6, 0x69, 0x3b, 0x2d, 0x1f, 0x99, 0xd8, 0x73, 0xc5, 0x40, 0x81,
+	0x82, 0x0c, 0x06, 0xd1, 0xff, 0x59, 0xe1, 0x41, 0xf9, 0x53, 0xee, 0xf1, 0xbe, 0x9f, 0x83, 0xfa,
+	0xba, 0x8b, 0x91, 0x4f, 0x4e, 0xfe, 0xd9, 0x43, 0x7d, 0x0f, 0xaa, 0x6c, 0x60, 0xb8, 0x65, 0x99,
+	0xc4, 0x14, 0xcb, 0xd5, 0xf3, 0xe9, 0x11, 0x60, 0x7a, 0x43, 0x6c, 0x70, 0xe9, 0x60, 0xfa, 0xad,
+	0x9e, 0x83, 0xca, 0x8e, 0x89, 0x77, 0x5a, 0xbb, 0x68, 0xc0, 0x37, 0x84, 0x75, 0xa3, 0x4c, 0x01,
+	0x1f, 0xa2, 0x01, 0x0b, 0x61, 0xba, 0xfd, 0x2e, 0x37, 0x30, 0x7a, 0xc3, 0x5d, 0x37, 0x4a, 0x6e,
+	0xbf, 0xcb, 0xcc, 0xeb, 0x5f, 0x73, 0x30, 0xfb, 0xa8, 0x4f, 0x4c, 0x11, 0x4d, 0xe8, 0x3b, 0xe4,
+	0x70, 0xca, 0x78, 0x0d, 0xf2, 0x7c, 0xcf, 0x40, 0x29, 0x9a, 0x52, 0xc6, 0xd7, 0xd7, 0xb0, 0x41,
+	0x91, 0xe8, 0xc4, 0xe1, 0x7e, 0xbb, 0x2d, 0xb6, 0x5f, 0x79, 0xc6, 0x6c, 0x85, 0x42, 0xf8, 0xe6,
+	0xeb, 0x1c, 0x54, 0x90, 0xef, 0x87, 0x9b, 0x33, 0x36, 0x14, 0xe4, 0xfb, 0xbc, 0x52, 0x87, 0x9a,
+	0xd9, 0xde, 0x75, 0xbd, 0x7d, 0x07, 0x59, 0x1d, 0x64, 0xb1, 0x69, 0x2f, 0x1b, 0x31, 0x18, 0x57,
+	0x0c, 0x3a, 0xf1, 0xad, 0xb6, 0x4b, 0xd8, 0x11, 0x23, 0x6f, 0x54, 0x38, 0xe4, 0xae, 0x4b, 0x68,
+	0xb5, 0x85, 0x1c, 0x44, 0x10, 0xab, 0x2e, 0xf1, 0x6a, 0x0e, 0x11, 0xd5, 0xfd, 0x5e, 0x48, 0x5d,
+	0xe6, 0xd5, 0x1c, 0x42, 0xab, 0xcf, 0x43, 0x65, 0x18, 0x2e, 0xa8, 0x0c, 0xef, 0x09, 0x19, 0x40,
+	0xff, 0x5f, 0x05, 0xea, 0x6b, 0xac, 0xa9, 0x53, 0xa0, 0x74, 0x2a, 0xcc, 0xa0, 0x67, 0x3d, 0x5f,
+	0x98, 0x0e, 0xfb, 0x1e, 0xaf, 0x47, 0x94, 0x33, 0x7f, 0xd0, 0xf2, 0xfb, 0x2e, 0x13, 0x5b, 0xd9,
+	0x28, 0x5a, 0xfe, 0xc0, 0xe8, 0xbb, 0xcc, 0xd6, 0x9e, 0xf4, 0x7e, 0x66, 0x6b, 0xe3, 0x6d, 0x6d,
+	0x0f, 0x1a, 0x1b, 0x8e, 0xd9, 0x46, 0x3b, 0x9e, 0x63, 0x21, 0x9f, 0x6d, 0x8d, 0xd4, 0x06, 0xe4,
+	0x89, 0xd9, 0x11, 0x7b, 0x2f, 0xfa, 0xa9, 0xbe, 0x25, 0x8e, 0xc6, 0xdc, 0xab, 0xbf, 0x28, 0xdd,
+	0xa4, 0x44, 0x9a, 0x89, 0xdc, 0x38, 0x2f, 0x43, 0x91, 0x45, 0x40, 0xf9, 0xae, 0xac, 0x66, 0x88,
+	0x92, 0xfe, 0x34, 0xd6, 0xef, 0x7d, 0xdf, 0xeb, 0xf7, 0xd4, 0x75, 0xa8, 0xf5, 0x86, 0x30, 0x6a,
+	0xea, 0xe9, 0x5b, 0xa2, 0x24, 0xd3, 0x46, 0x8c, 0x54, 0xff, 0x49, 0x1e, 0xea, 0x9b, 0xc8, 0xf4,
+	0xdb, 0x3b, 0xa7, 0xe1, 0x8e, 0x8a, 0x4a, 0xdc, 0xc2, 0x8e, 0x50, 0x7a, 0xfa, 0x49, 0x43, 0x87,
+	0x91, 0x01, 0xb5, 0x3a, 0x54, 0x40, 0xcc, 0x6d, 0xd4, 0x8c, 0x46, 0x2f, 0x29, 0xb8, 0x2f, 0x43,
+	0xd9, 0xc2, 0x4e, 0x8b, 0x4d, 0x51, 0x89, 0x4d, 0x91, 0x7c, 0x7c, 0x6b, 0xd8, 0x61, 0x53, 0x53,
+	0xb2, 0xf8, 0x87, 0xfa, 0x02, 0xd4, 0xbd, 0x3e, 0xe9, 0xf5, 0x49, 0x8b, 0xab, 0x52, 0xb3, 0xcc,
+	0xd8, 0xab, 0x71, 0x20, 0xd3, 0x34, 0xac, 0xde, 0x83, 0x3a, 0x66, 0xa2, 0x0c, 0x0e, 0x2e, 0x95,
+	0xac, 0xfb, 0xeb, 0x1a, 0xa7, 0xe3, 0x27, 0x17, 0x1a, 0x00, 0x20, 0xbe, 0xb9, 0x87, 0x9c, 0x48,
+	0x6c, 0x13, 0x98, 0xb3, 0x9a, 0xe3, 0xf0, 0x61, 0x5c, 0xf3, 0x26, 0x2c, 0x74, 0xfa, 0xa6, 0x6f,
+	0xba, 0x04, 0xa1, 0x08, 0x76, 0x95, 0x61, 0xab, 0x61, 0x55, 0x48, 0xa0, 0x7f, 0x08, 0x33, 0x0f,
+	0x6c, 0xc2, 0x04, 0xb9, 0xbe, 0xc6, 0x35, 0x27, 0xcf, 0x1d, 0xfb, 0x73, 0x50, 0xf6, 0xbd, 0x7d,
+	0x6e, 0x56, 0x39, 0xa6, 0x82, 0x25, 0xdf, 0xdb, 0x67, 0x36, 0xc3, 0x92, 0x4a, 0x3c, 0x5f, 0xe8,
+	0x66, 0xce, 0x10, 0x25, 0xfd, 0x2f, 0x94, 0xa1, 0xf2, 0xd0, 0xd5, 0x07, 0x1f, 0x6e, 0xf9, 0x79,
+	0x0f, 0x4a, 0x3e, 0xa7, 0x1f, 0x1b, 0xcb, 0x8e, 0xf6, 0xc4, 0xcc, 0x3a, 0xa0, 0xa2, 0xea, 0x63,
+	0x13, 0xe4, 0x9b, 0xc4, 0xf3, 0x5b, 0xed, 0xbe, 0x8f, 0x3d, 0x3f, 0xd0, 0xb3, 0x00, 0x7c, 0x97,
+	0x41, 0xf5, 0x5f, 0x57, 0xa0, 0x76, 0xcf, 0xe9, 0xe3, 0xa3, 0x50, 0x76, 0x59, 0xd8, 0x26, 0x2f,
+	0x0f, 0x19, 0xfd, 0x76, 0x0e, 0xea, 0x82, 0x8d, 0x69, 0xf6, 0x90, 0xa9, 0xac, 0x6c, 0x42, 0x95,
+	0x76, 0xd9, 0xc2, 0xa8, 0x13, 0xdc, 0x79, 0x55, 0x57, 0x57, 0xa5, 0xee, 0x21, 0xc6, 0x06, 0x4b,
+	0x17, 0xd8, 0x64, 0x44, 0x1f, 0xb8, 0xc4, 0x1f, 0x18, 0xd0, 0x0e, 0x01, 0xda, 0x53, 0x98, 0x4b,
+	0x54, 0x53, 0x25, 0xda, 0x45, 0x83, 0xc0, 0xff, 0xed, 0xa2, 0x81, 0xfa, 0x7a, 0x34, 0xa9, 0x23,
+	0xcd, 0x31, 0x3f, 0xf4, 0xdc, 0xce, 0x6d, 0xdf, 0x37, 0x07, 0x22, 0xe9, 0xe3, 0xed, 0xdc, 0x5b,
+	0x8a, 0xfe, 0xad, 0x3c, 0xd4, 0xbe, 0xda, 0x47, 0xfe, 0xe0, 0x38, 0xfd, 0x50, 0xb0, 0xa8, 0xce,
+	0x44, 0x16, 0xd5, 0x11, 0xd3, 0x2f, 0x48, 0x4c, 0x5f, 0xe2, 0xc0, 0x8a, 0x52, 0x07, 0x26, 0xb3,
+	0xed, 0xd2, 0x81, 0x6c, 0xbb, 0x9c, 0x66, 0xdb, 0xf4, 0xde, 0xe4, 0x63, 0x2a, 0xc1, 0x03, 0xbb,
+	0x9f, 0x2a, 0x23, 0x13, 0xf7, 0x26, 0x9f, 0x2b, 0xe1, 0x44, 0x4c, 0x65, 0xd3, 0xb1, 0x75, 0x3a,
+	0x77, 0xe0, 0x75, 0x3a, 0xb3, 0x4d, 0xff, 0x40, 0x81, 0xca, 0xd7, 0x50, 0x9b, 0x78, 0x3e, 0xf5,
+	0x62, 0x92, 0xa9, 0x56, 0x32, 0x9c, 0x4f, 0x72, 0xc9, 0xf3, 0xc9, 0x2d, 0x28, 0xdb, 0x56, 0xcb,
+	0xa4, 0x5a, 0xda, 0xcc, 0x4f, 0xd8, 0x17, 0x97, 0x6c, 0x8b, 0xa9, 0x73, 0xf6, 0x50, 0xcb, 0xef,
+	0x2b, 0x50, 0xe3, 0x3c, 0x63, 0x4e, 0xf9, 0x4e, 0xa4, 0x3b, 0x45, 0x66, 0x3a, 0xa2, 0x10, 0x0e,
+	0xf4, 0xc1, 0x99, 0x61, 0xb7, 0xb7, 0x01, 0xa8, 0x90, 0x05, 0x79, 0x6e, 0x4c, 0x02, 0x2a, 0x27,
+	0x67, 0x02, 0x7f, 0x70, 0xc6, 0xa8, 0x50, 0x2a, 0xd6, 0xc4, 0x9d, 0x12, 0x14, 0x18, 0xb5, 0xfe,
+	0x7f, 0x0a, 0x2c, 0xdc, 0x35, 0x9d, 0xf6, 0x9a, 0x8d, 0x89, 0xe9, 0xb6, 0xa7, 0xd8, 0x09, 0xbf,
+	0x0d, 0x25, 0xaf, 0xd7, 0x72, 0xd0, 0x36, 0x11, 0x2c, 0x5d, 0x1a, 0x33, 0x22, 0x2e, 0x06, 0xa3,
+	0xe8, 0xf5, 0x1e, 0xa2, 0x6d, 0xa2, 0xbe, 0x0b, 0x65, 0xaf, 0xd7, 0xf2, 0xed, 0xce, 0x0e, 0x69,
+	0xe6, 0xb3, 0x12, 0x97, 0xbc, 0x9e, 0x41, 0x29, 0x22, 0x17, 0x5c, 0x33, 0x07, 0xbc, 0xe0, 0xd2,
+	0xff, 0x7d, 0x64, 0xf8, 0x53, 0xd8, 0xc0, 0xdb, 0x50, 0xb6, 0x5d, 0xd2, 0xb2, 0x6c, 0x1c, 0x88,
+	0xe0, 0x82, 0x5c, 0x87, 0x5c, 0xc2, 0x46, 0xc0, 0xe6, 0xd4, 0x25, 0xb4, 0x6f, 0xf5, 0x7d, 0x80,
+	0x6d, 0xc7, 0x33, 0x05, 0x35, 0x97, 0xc1, 0x45, 0xb9, 0xf9, 0x50, 0xb4, 0x80, 0xbe, 0xc2, 0x88,
+	0x68, 0x0b, 0xc3, 0x29, 0xfd, 0xb1, 0x02, 0x4b, 0x1b, 0xc8, 0xe7, 0x89, 0x43, 0x44, 0x5c, 0x36,
+	0xaf, 0xbb, 0xdb, 0x5e, 0xfc, 0xbe, 0x5f, 0x49, 0xdc, 0xf7, 0x7f, 0x31, 0x77, 0xdc, 0xb1, 0x2d,
+	0x35, 0x8f, 0x3a, 0x05, 0x5b, 0xea, 0x20, 0xb6, 0xc6, 0x8f, 0xff, 0xb3, 0x29, 0xd3, 0x24, 0xf8,
+	0x8d, 0xde, 0x82, 0xe8, 0xbf, 0xc3, 0xf3, 0x5c, 0xa4, 0x83, 0x3a, 0xbc, 0xc2, 0x2e, 0x83, 0x58,
+	0x2f, 0x12, 0xab, 0xc7, 0x4b, 0x90, 0xf0, 0x1d, 0x29, 0xd9, 0x37, 0x7f, 0xa0, 0xc0, 0x4a, 0x3a,
+	0x57, 0xd3, 0x2c, 0xf4, 0xef, 0x43, 0xc1, 0x76, 0xb7, 0xbd, 0xe0, 0xee, 0xf3, 0x9a, 0x7c, 0xa3,
+	0x2f, 0xed, 0x97, 0x13, 0xea, 0x7f, 0x93, 0x83, 0x06, 0x73, 0xea, 0xc7, 0x30, 0xfd, 0x5d, 0xd4,
+	0x6d, 0x61, 0xfb, 0x13, 0x14, 0x4c, 0x7f, 0x17, 0x75, 0x37, 0xed, 0x4f, 0x50, 0x4c, 0x33, 0x0a,
+	0x71, 0xcd, 0x88, 0xdf, 0x0e, 0x15, 0xc7, 0xdc, 0x6d, 0x97, 0xe2, 0x77, 0xdb, 0xcb, 0x50, 0x74,
+	0x3d, 0x0b, 0xad, 0xaf, 0x89, 0xb3, 0xbf, 0x28, 0x0d, 0x55, 0xad, 0x72, 0x40, 0x55, 0xfb, 0x4c,
+	0x01, 0xed, 0x3e, 0x22, 0x49, 0xd9, 0x1d, 0x9f, 0x96, 0x7d, 0x57, 0x81, 0x73, 0x52, 0x86, 0xa6,
+	0x51, 0xb0, 0x77, 0xe2, 0x0a, 0x26, 0x3f, 0x49, 0x8e, 0x74, 0x29, 0x74, 0xeb, 0x35, 0xa8, 0xad,
+	0xf5, 0xbb, 0xdd, 0x70, 0xe3, 0x76, 0x09, 0x6a, 0x3e, 0xff, 0xe4, 0x07, 0x2d, 0xbe, 0xfe, 0x56,
+	0x05, 0x8c, 0x1e, 0xa7, 0xf4, 0xeb, 0x50, 0x17, 0x24, 0x82, 0x6b, 0x0d, 0xca, 0xbe, 0xf8, 0x16,
+	0xf8, 0x61, 0x59, 0x5f, 0x82, 0x05, 0x03, 0x75, 0xa8, 0x6a, 0xfb, 0x0f, 0x6d, 0x77, 0x57, 0x74,
+	0xa3, 0x7f, 0xaa, 0xc0, 0x62, 0x1c, 0x2e, 0xda, 0x7a, 0x13, 0x4a, 0xa6, 0x65, 0xf9, 0x08, 0xe3,
+	0xb1, 0xd3, 0x72, 0x9b, 0xe3, 0x18, 0x01, 0x72, 0x44, 0x72, 0xb9, 0xcc, 0x92, 0xd3, 0x5b, 0x30,
+	0x7f, 0x1f, 0x91, 0x47, 0x88, 0xf8, 0x53, 0xe5, 0x49, 0x34, 0xe9, 0x11, 0x88, 0x11, 0x0b, 0xb5,
+	0x08, 0x8a, 0x34, 0x08, 0xac, 0x46, 0x7b, 0x98, 0x66, 0x9a, 0xa3, 0x52, 0xce, 0xc5, 0xa5, 0xcc,
+	0x53, 0xc9, 0xba, 0x3d, 0xcf, 0x45, 0x2e, 0x89, 0x6e, 0x91, 0xeb, 0x21, 0x94, 0xa9, 0xdf, 0x8f,
+	0x14, 0x50, 0x69, 0x56, 0xce, 0x1d, 0xd3, 0x99, 0x6e, 0x7b, 0x40, 0xef, 0x11, 0xfd, 0x76, 0x4b,
+	0x58, 0x6b, 0x4e, 0x78, 0x1f, 0xbf, 0xfd, 0x98, 0x1b, 0xec, 0x45, 0xa8, 0x5a, 0x98, 0x88, 0xea,
+	0x20, 0x6c, 0x0f, 0x16, 0x26, 0xbc, 0x9e, 0xa5, 0x0c, 0x63, 0x64, 0x3a, 0xc8, 0x6a, 0x45, 0xa2,
+	0x9e, 0x33, 0x0c, 0xad, 0xc1, 0x2b, 0x36, 0x43, 0xb8, 0xfe, 0x14, 0xce, 0x3e, 0x32, 0x5d, 0x9a,
+	0xab, 0xec, 0x75, 0x7b, 0x66, 0x2c, 0x7d, 0x34, 0xe9, 0xe6, 0x14, 0x89, 0x9b, 0x7b, 0x9e, 0xe7,
+	0x17, 0xf2, 0x0d, 0x3a, 0xe3, 0x75, 0xc6, 0x88, 0x40, 0x74, 0x0c, 0xcd, 0xd1, 0xe6, 0xa7, 0x99,
+	0x28, 0xc6, 0x54, 0xd0, 0x54, 0xd4, 0xf7, 0x0e, 0x61, 0xfa, 0x7b, 0xf0, 0x1c, 0xcb, 0xf5, 0x0c,
+	0x40, 0xb1, 0xf8, 0x4a, 0xb2, 0x01, 0x45, 0xd2, 0xc0, 0xb7, 0x73, 0xa0, 0xc9, 0x5a, 0x98, 0x86,
+	0xf1, 0xb7, 0xe3, 0x61, 0x8d, 0x17, 0x53, 0xf2, 0x9a, 0xe3, 0x3d, 0x72, 0x12, 0xf5, 0x2a, 0xcc,
+	0xa1, 0x67, 0xa8, 0xdd, 0x27, 0xb6, 0xdb, 0xd9, 0x70, 0x4c, 0xf7, 0xb1, 0x27, 0x16, 0x94, 0x24,
+	0x58, 0x7d, 0x11, 0xea, 0x54, 0xfa, 0x5e, 0x9f, 0x08, 0x3c, 0xbe, 0xb2, 0xc4, 0x81, 0xb4, 0x3d,
+	0x3a, 0x5e, 0x07, 0x11, 0x64, 0x09, 0x3c, 0xbe, 0xcc, 0x24, 0xc1, 0x23, 0xa2, 0xa4, 0x60, 0x7c,
+	0x10, 0x51, 0xfe, 0xa7, 0x02, 0x9a, 0xac, 0x85, 0xe3, 0x12, 0xe5, 0x03, 0x80, 0x2e, 0xf2, 0x3b,
+	0x68, 0x9d, 0x39, 0x75, 0x7e, 0xfe, 0xbf, 0x2a, 0x75, 0xea, 0xc3, 0x06, 0x1e, 0x05, 0x04, 0x46,
+	0x84, 0x56, 0xbf, 0x0f, 0x0b, 0x12, 0x14, 0xea, 0xaf, 0xb0, 0xd7, 0xf7, 0xdb, 0x28, 0xb8, 0x42,
+	0x0a, 0x8a, 0x74, 0x7d, 0x23, 0xa6, 0xdf, 0x41, 0x44, 0x28, 0xad, 0x28, 0xe9, 0x6f, 0xb2, 0x48,
+	0x20, 0xbb, 0x6e, 0x88, 0x69, 0x6a, 0x3c, 0x6d, 0x41, 0x19, 0x49, 0x5b, 0xd8, 0x86, 0xa5, 0x04,
+	0xdd, 0x94, 0x29, 0x27, 0xdb, 0xb4, 0x29, 0x64, 0x89, 0x37, 0x2d, 0x41, 0x51, 0xff, 0x1e, 0x8d,
+	0x38, 0x75, 0x7b, 0xde, 0xa9, 0xb8, 0x05, 0x3f, 0x07, 0x15, 0x7a, 0x57, 0x47, 0x3b, 0x0d, 0xa2,
+	0x28, 0xf4, 0xf2, 0x8e, 0xb2, 0x62, 0xd1, 0x87, 0x35, 0xdb, 0xb6, 0x13, 0xde, 0x40, 0xf0, 0x82,
+	0xfa, 0x0e, 0x3d, 0x8e, 0xf1, 0x08, 0x7a, 0xe6, 0xa7, 0x58, 0x01, 0x85, 0xfe, 0x14, 0x66, 0x03,
+	0xd9, 0x4c, 0x23, 0x7d, 0xa6, 0x1b, 0x78, 0x37, 0x74, 0x68, 0xa2, 0xa4, 0x9b, 0x3c, 0xb4, 0xca,
+	0x7a, 0x98, 0x32, 0x4c, 0x9c, 0xd6, 0xc5, 0x0f, 0x15, 0x98, 0xe3, 0x1d, 0xdc, 0xb3, 0x1d, 0xc4,
+	0x3a, 0x19, 0x0a, 0x4a, 0x89, 0x0a, 0xea, 0xcd, 0xb8, 0xdd, 0xc9, 0xdf, 0x79, 0x44, 0x79, 0x15,
+	0x36, 0xb7, 0x0c, 0xc5, 0x58, 0x54, 0x56, 0x94, 0x82, 0xb9, 0x6a, 0x7b, 0x7d, 0x97, 0x08, 0x47,
+	0x45, 0xe7, 0xea, 0x2e, 0x2d, 0xc7, 0xb7, 0xe0, 0x85, 0x64, 0xc6, 0xd5, 0xe7, 0x39, 0x58, 0x4e,
+	0x0a, 0x66, 0x1a, 0xf9, 0x1f, 0x76, 0x68, 0xb1, 0x21, 0xe4, 0x13, 0x43, 0x88, 0x1b, 0xf0, 0x4c,
+	0xd2, 0x80, 0xd5, 0x0f, 0xe8, 0x4d, 0x90, 0xc3, 0x92, 0xf3, 0x09, 0x0a, 0x92, 0x79, 0xe4, 0x91,
+	0x91, 0xc4, 0x04, 0xd1, 0xfb, 0x20, 0xf1, 0x89, 0x47, 0xd6, 0xe9, 0xe2, 0xe8, 0x3a, 0x4d, 0xb7,
+	0x84, 0xc1, 0x63, 0x4c, 0x1f, 0x59, 0xc8, 0x25, 0xb6, 0xe9, 0x1c, 0x5e, 0x95, 0x34, 0x28, 0xf7,
+	0x31, 0xf2, 0x23, 0xe6, 0x1c, 0x96, 0x69, 0x5d, 0xcf, 0xc4, 0x78, 0xdf, 0xf3, 0x2d, 0x31, 0xdd,
+	0x61, 0x59, 0xff, 0x73, 0x05, 0xce, 0x3e, 0xe9, 0x59, 0x3f, 0x05, 0x2e, 0x56, 0xa0, 0xea, 0x39,
+	0xd6, 0x46, 0x9c, 0x91, 0x28, 0x88, 0x62, 0xb8, 0x68, 0x3f, 0xc4, 0xe0, 0xce, 0x24, 0x0a, 0xd2,
+	0x3b, 0x34, 0xa9, 0xcf, 0x41, 0x47, 0xce, 0xac, 0xbe, 0x06, 0x0d, 0xfa, 0xb0, 0xf7, 0x09, 0x46,
+	0xfe, 0x14, 0xef, 0x83, 0xb7, 0x61, 0x3e, 0xd2, 0xca, 0x34, 0xc6, 0x70, 0x1e, 0x2a, 0x01, 0x6f,
+	0x41, 0xf2, 0xe8, 0x10, 0xa0, 0x6f, 0xc1, 0x3c, 0xd7, 0x24, 0xc3, 0x73, 0xa6, 0x70, 0x47, 0xcc,
+	0x72, 0x1c, 0x14, 0x5d, 0x13, 0xca, 0x14, 0x20, 0xde, 0x65, 0xcf, 0xd1, 0x54, 0x8d, 0x23, 0xec,
+	0xe1, 0x9f, 0x14, 0x58, 0xfe, 0x4a, 0x0f, 0xf9, 0x26, 0x41, 0x54, 0x62, 0xd3, 0xf5, 0x34, 0x4e,
+	0x13, 0x63, 0x5c, 0xe4, 0xe3, 0x5c, 0xa8, 0xef, 0xc6, 0xde, 0xdf, 0xc8, 0xf7, 0x21, 0x09, 0x2e,
+	0x23, 0xa9, 0xc3, 0xdf, 0x57, 0xa0, 0x7a, 0xdf, 0x37, 0x5d, 0xf2, 0x81, 0x4b, 0x6c, 0x32, 0x88,
+	0x77, 0xa5, 0x24, 0xba, 0xba, 0x08, 0x55, 0x6f, 0xeb, 0x57, 0x50, 0x5b, 0x1c, 0x3d, 0x39, 0x9b,
+	0xc0, 0x41, 0xb4, 0xcd, 0x08, 0x42, 0x84, 0x55, 0x81, 0xc0, 0x5a, 0x38, 0x0f, 0x95, 0x9e, 0x6f,
+	0xef, 0xd9, 0x0e, 0xea, 0x84, 0x69, 0x2b, 0x21, 0x20, 0xba, 0xc2, 0x17, 0x62, 0x2f, 0xe0, 0x3f,
+	0xa5, 0xe9, 0x41, 0x94, 0xcb, 0x8d, 0x00, 0xf7, 0xf0, 0x82, 0x7e, 0x0b, 0x8a, 0x88, 0x8d, 0x55,
+	0x7e, 0x97, 0x2b, 0x0a, 0x11, 0x99, 0x18, 0x02, 0x9f, 0xc6, 0xb7, 0x96, 0x0d, 0xb4, 0xe7, 0xed,
+	0xa2, 0x63, 0x65, 0xe3, 0x57, 0xb9, 0x91, 0xb2, 0x2a, 0x7c, 0x34, 0xaa, 0x1d, 0xd3, 0xc6, 0x7c,
+	0xc2, 0xd5, 0x7c, 0x9b, 0x1e, 0x52, 0x23, 0x0c, 0x4c, 0xe3, 0x26, 0xde, 0x85, 0x32, 0x1b, 0x95,
+	0x8d, 0x82, 0xdb, 0x91, 0xc9, 0x72, 0x08, 0x29, 0xae, 0x5d, 0x82, 0x72, 0x90, 0x09, 0xaf, 0x96,
+	0x20, 0x7f, 0xdb, 0x71, 0x1a, 0x67, 0xd4, 0x1a, 0x94, 0xd7, 0x45, 0xba, 0x77, 0x43, 0xb9, 0xf6,
+	0x0b, 0x30, 0x97, 0xc8, 0x08, 0x50, 0xcb, 0x30, 0xf3, 0xd8, 0x73, 0x51, 0xe3, 0x8c, 0xda, 0x80,
+	0xda, 0x1d, 0xdb, 0x35, 0xfd, 0x01, 0xbf, 0xe9, 0x6e, 0x58, 0xea, 0x1c, 0x54, 0xd9, 0x8d, 0xaf,
+	0x00, 0xa0, 0x6b, 0xef, 0xc3, 0x82, 0xc4, 0x78, 0xd4, 0x79, 0xa8, 0xdf, 0xb6, 0x2c, 0x0a, 0xfa,
+	0xc8, 0xa3, 0xc0, 0xc6, 0x19, 0x75, 0x19, 0x54, 0x03, 0x75, 0xbd, 0x3d, 0x86, 0x78, 0xcf, 0xf7,
+	0xba, 0x0c, 0xae, 0xac, 0xfe, 0xcf, 0x2b, 0x50, 0x7f, 0xc4, 0x46, 0xb1, 0x89, 0xfc, 0x3d, 0xbb,
+	0x8d, 0xd4, 0x16, 0x34, 0x92, 0x3f, 0x35, 0x50, 0x5f, 0x91, 0x9f, 0x1f, 0xe4, 0xff, 0x3e, 0xd0,
+	0xc6, 0xc9, 0x56, 0x3f, 0xa3, 0x7e, 0x13, 0x66, 0xe3, 0xef, 0xfa, 0x55, 0xf9, 0xa5, 0xa6, 0xf4,
+	0xf1, 0xff, 0xa4, 0xc6, 0x5b, 0x50, 0x8f, 0x3d, 0xd3, 0x57, 0x5f, 0x96, 0xb6, 0x2d, 0x7b, 0xca,
+	0xaf, 0xc9, 0xe3, 0x0c, 0xd1, 0xa7, 0xf4, 0x9c, 0xfb, 0xf8, 0xe3, 0xd8, 0x14, 0xee, 0xa5, 0x2f,
+	0x68, 0x27, 0x71, 0x6f, 0xc2, 0xfc, 0xc8, 0x5b, 0x57, 0xf5, 0x55, 0x69, 0xfb, 0x69, 0x6f, 0x62,
+	0x27, 0x75, 0xb1, 0x0f, 0xea, 0xe8, 0x73, 0x74, 0xf5, 0x86, 0x7c, 0x06, 0xd2, 0x1e, 0xe3, 0x6b,
+	0x37, 0x33, 0xe3, 0x87, 0x82, 0xfb, 0x96, 0x02, 0x67, 0x53, 0x1e, 0xa8, 0xaa, 0xb7, 0xe4, 0x66,
+	0x35, 0xf6, 0x95, 0xad, 0xf6, 0xfa, 0xc1, 0x88, 0x42, 0x46, 0x5c, 0x98, 0x4b, 0xbc, 0xd9, 0x54,
+	0xaf, 0xa7, 0xbe, 0x63, 0x19, 0x7d, 0xbc, 0xaa, 0xbd, 0x92, 0x0d, 0x39, 0xec, 0x8f, 0x06, 0xcf,
+	0xe3, 0x0f, 0x1d, 0x53, 0xfa, 0x93, 0x3f, 0x87, 0x9c, 0x34, 0xa1, 0xdf, 0x80, 0x7a, 0xec, 0x45,
+	0x62, 0x8a, 0xc6, 0xcb, 0x5e, 0x2d, 0x4e, 0x6a, 0xfa, 0x29, 0xd4, 0xa2, 0x0f, 0x07, 0xd5, 0xab,
+	0x69, 0xb6, 0x34, 0xd2, 0xf0, 0x41, 0x4c, 0x29, 0x24, 0xc6, 0x63, 0x4c, 0x69, 0xe4, 0x29, 0x55,
+	0x76, 0x53, 0x8a, 0xb4, 0x3f, 0xd6, 0x94, 0x0e, 0xdc, 0xc5, 0xa7, 0x0a, 0x3b, 0xa2, 0x49, 0xde,
+	0x9d, 0xa9, 0xab, 0x69, 0xba, 0x99, 0xfe, 0xc2, 0x4e, 0xbb, 0x75, 0x20, 0x9a, 0x50, 0x8a, 0xbb,
+	0x30, 0x1b, 0x7f, 0x5d, 0x95, 0x22, 0x45, 0xe9, 0x83, 0x34, 0xed, 0x7a, 0x26, 0xdc, 0xb0, 0xb3,
+	0x27, 0x50, 0x8d, 0xfc, 0x96, 0x4c, 0xbd, 0x32, 0x46, 0x8f, 0xa3, 0xff, 0xe8, 0x9a, 0x24, 0xc9,
+	0xaf, 0x42, 0x25, 0xfc, 0x9b, 0x98, 0x7a, 0x39, 0x55, 0x7f, 0x0f, 0xd2, 0xe4, 0x26, 0xc0, 0xf0,
+	0x57, 0x61, 0xea, 0x4b, 0xd2, 0x36, 0x47, 0xfe, 0x25, 0x36, 0x79, 0x75, 0x69, 0x24, 0xff, 0xef,
+	0x95, 0xb2, 0x36, 0xa6, 0xfc, 0x06, 0x6c, 0xb2, 0xc5, 0xcd, 0x25, 0xfe, 0xd1, 0x95, 0xe2, 0x2b,
+	0xe4, 0x7f, 0xf2, 0x9a, 0xd4, 0xfc, 0x57, 0xa0, 0x1c, 0xfc, 0x30, 0x4b, 0x95, 0x1f, 0xc3, 0x13,
+	0xff, 0xd3, 0xca, 0xb0, 0x96, 0xc7, 0x7f, 0x61, 0x95, 0xa2, 0x7c, 0xd2, 0xff, 0x5c, 0x4d, 0x6a,
+	0xfc, 0xeb, 0x50, 0x8b, 0xfe, 0xbb, 0x2a, 0xc5, 0xfd, 0x48, 0x7e, 0x6f, 0x35, 0xa9, 0xe1, 0x1d,
+	0xa8, 0xc7, 0xfe, 0x33, 0x95, 0xe2, 0x32, 0x65, 0xbf, 0xb5, 0xd2, 0xae, 0x65, 0x41, 0x1d, 0xb5,
+	0x17, 0x9e, 0x04, 0x3d, 0xce, 0x5e, 0xa2, 0x59, 0xfb, 0x19, 0x06, 0x10, 0x7b, 0x6b, 0x93, 0xe6,
+	0xf3, 0x25, 0x4f, 0xa0, 0xb4, 0x6b, 0x59, 0x50, 0xc3, 0x01, 0xec, 0x40, 0x3d, 0xf6, 0xf2, 0x21,
+	0xa5, 0x27, 0xd9, 0x43, 0x0f, 0xed, 0x5a, 0x16, 0xd4, 0xb0, 0xa7, 0x5f, 0x8b, 0x3c, 0xb2, 0x88,
+	0x3d, 0x64, 0x51, 0x5f, 0x1b, 0xdb, 0x8e, 0xec, 0x1d, 0x8f, 0xb6, 0x7a, 0x10, 0x92, 0x90, 0x05,
+	0xe1, 0x86, 0xb8, 0x48, 0xd3, 0xdd, 0xd0, 0x41, 0x66, 0x6a, 0x13, 0x8a, 0xfc, 0x2d, 0x83, 0xaa,
+	0xa7, 0xbc, 0x5a, 0x8a, 0x24, 0x5f, 0x6b, 0x2f, 0x48, 0x71, 0xe2, 0x69, 0xfe, 0xbc, 0x51, 0x7e,
+	0x6f, 0x93, 0xd2, 0x68, 0x2c, 0x91, 0xfd, 0x00, 0x8d, 0xf2, 0x4c, 0xf0, 0x94, 0x46, 0x63, 0x69,
+	0xe2, 0x59, 0x1b, 0x35, 0xa0, 0xc8, 0x53, 0x37, 0x53, 0x1a, 0x8d, 0xa5, 0x1f, 0x6b, 0xe3, 0x71,
+	0x68, 0x93, 0x54, 0xa4, 0x1b, 0x50, 0x60, 0x21, 0x01, 0xf5, 0xd2, 0xb8, 0xac, 0xc6, 0x71, 0x2d,
+	0xc6, 0x12, 0x1f, 0x99, 0x5b, 0x2c, 0xb0, 0x00, 0x77, 0x4a, 0x8b, 0xd1, 0xd4, 0x44, 0x6d, 0x2c,
+	0x4a, 0xc0, 0xa2, 0x05, 0xb5, 0x68, 0x26, 0x51, 0x8a, 0xe7, 0x92, 0xe4, 0x5a, 0x69, 0x59, 0x30,
+	0x83, 0x5e, 0xb8, 0x6d, 0x0e, 0xc3, 0x23, 0xe9, 0xb6, 0x39, 0x12, 0x7a, 0xd1, 0xae, 0x65, 0x41,
+	0x0d, 0x05, 0xf4, 0x1b, 0x0a, 0x34, 0xd3, 0xd2, 0x5b, 0xd4, 0xd4, 0x7d, 0xf8, 0xb8, 0x1c, 0x1d,
+	0xed, 0x8d, 0x03, 0x52, 0x85, 0xbc, 0x7c, 0x02, 0x0b, 0x92, 0x1c, 0x08, 0xf5, 0x66, 0x5a, 0x7b,
+	0x29, 0xe9, 0x1b, 0xda, 0x97, 0xb2, 0x13, 0x84, 0x7d, 0x53, 0x6b, 0x66, 0xf7, 0xd4, 0x69, 0xd6,
+	0x1c, 0x0d, 0x22, 0x69, 0x2f, 0x8c, 0xc5, 0x89, 0x6e, 0xe0, 0xe2, 0x17, 0xfd, 0x6a, 0xba, 0xe3,
+	0x1c, 0x09, 0x93, 0x68, 0xd7, 0x33, 0xe1, 0x86, 0x9d, 0x6d, 0x40, 0x81, 0x65, 0x5f, 0xa4, 0xa8,
+	0x7a, 0x34, 0x99, 0x43, 0xd3, 0xc7, 0xa1, 0x84, 0x2d, 0x22, 0xa8, 0x45, 0x53, 0x31, 0x52, 0x74,
+	0x5d, 0x92, 0xc5, 0xa1, 0xbd, 0x9c, 0x01, 0x33, 0xec, 0xa6, 0x05, 0x30, 0x4c, 0x85, 0x48, 0xd9,
+	0xcf, 0x8d, 0x64, 0x63, 0x68, 0x57, 0x26, 0xe2, 0x45, 0x97, 0xea, 0x48, 0x72, 0x43, 0xca, 0x52,
+	0x3d, 0x9a, 0xfe, 0x90, 0xe1, 0xbc, 0x3d, 0x1a, 0x68, 0x4f, 0x39, 0x6f, 0xa7, 0xc6, 0xf4, 0xb5,
+	0x9b, 0x99, 0xf1, 0xc3, 0xf1, 0x7c, 0x0c, 0x8d, 0x64, 0x62, 0x42, 0xca, 0x5e, 0x35, 0x25, 0x3d,
+	0x42, 0x7b, 0x35, 0x23, 0x76, 0x74, 0x09, 0x3f, 0x37, 0xca, 0xd3, 0xd7, 0x6d, 0xb2, 0xc3, 0x62,
+	0xe2, 0x59, 0x46, 0x1d, 0x0d, 0xbf, 0x6b, 0x37, 0x33, 0xe3, 0x47, 0xd4, 0xa4, 0x91, 0x8c, 0x02,
+	0x8d, 0xbf, 0xbd, 0x4a, 0x46, 0x3e, 0x32, 0x1c, 0x01, 0x92, 0x01, 0x9e, 0x94, 0x0e, 0x52, 0xe2,
+	0x40, 0x19, 0x3a, 0x48, 0x06, 0x65, 0x52, 0x3a, 0x48, 0x89, 0xdd, 0x4c, 0xea, 0xe0, 0x97, 0xa0,
+	0x12, 0x86, 0x51, 0x52, 0x76, 0x39, 0xc9, 0x60, 0x8d, 0xf6, 0xd2, 0x24, 0xb4, 0x88, 0x8b, 0x84,
+	0x61, 0xf0, 0x24, 0xc5, 0x4e, 0x47, 0xa2, 0x2b, 0x19, 0xce, 0x2d, 0x41, 0xb4, 0x24, 0xe5, 0xdc,
+	0x92, 0x08, 0xa6, 0x64, 0x38, 0x67, 0x25, 0x2e, 0x4e, 0x53, 0xce, 0x59, 0xf2, 0x08, 0x4a, 0x86,
+	0x63, 0x51, 0x3c, 0x20, 0x90, 0xe6, 0xd2, 0x65, 0x51, 0x83, 0x0c, 0xbc, 0x27, 0xee, 0xf9, 0x53,
+	0x78, 0x97, 0x47, 0x03, 0x26, 0xeb, 0x1f, 0x0c, 0xef, 0xcf, 0xd5, 0xf4, 0x89, 0x8f, 0xdd, 0xf0,
+	0x6b, 0x57, 0x26, 0xe2, 0x05, 0x1a, 0xb2, 0xda, 0x87, 0xda, 0x86, 0xef, 0x3d, 0x1b, 0x04, 0x17,
+	0xce, 0x3f, 0x9d, 0x05, 0xe4, 0xce, 0x1b, 0xbf, 0x78, 0xab, 0x63, 0x93, 0x9d, 0xfe, 0x16, 0x1d,
+	0xf1, 0x4d, 0x8e, 0xfb, 0xaa, 0xed, 0x89, 0xaf, 0x9b, 0xb6, 0x4b, 0x90, 0xef, 0x9a, 0xce, 0x4d,
+	0xd6, 0x96, 0x80, 0xf6, 0xb6, 0xb6, 0x8a, 0xac, 0x7c, 0xeb, 0xff, 0x07, 0x00, 0x9e, 0xad, 0x38,
+	0xf8, 0xd1, 0x5d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -5263,6 +6986,12 @@ type MilvusServiceClient interface {
 	CreateAlias(ctx context.Context, in *CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error)
 	CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DescribeIndex(ctx context.Context, in *DescribeIndexRequest, opts ...grpc.CallOption) (*DescribeIndexResponse, error)
 	GetIndexState(ctx context.Context, in *GetIndexStateRequest, opts ...grpc.CallOption) (*GetIndexStateResponse, error)
@@ -5270,6 +6999,7 @@ type MilvusServiceClient interface {
 	DropIndex(ctx context.Context, in *DropIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	Insert(ctx context.Context, in *InsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*MutationResult, error)
+	Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error)
 	Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error)
 	Flush(ctx context.Context, in *FlushRequest, opts ...grpc.CallOption) (*FlushResponse, error)
 	Query(ctx context.Context, in *QueryRequest, opts ...grpc.CallOption) (*QueryResults, error)
@@ -5277,6 +7007,8 @@ type MilvusServiceClient interface {
 	GetFlushState(ctx context.Context, in *GetFlushStateRequest, opts ...grpc.CallOption) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(ctx context.Context, in *GetPersistentSegmentInfoRequest, opts ...grpc.CallOption) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(ctx context.Context, in *GetQuerySegmentInfoRequest, opts ...grpc.CallOption) (*GetQuerySegmentInfoResponse, error)
+	Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error)
+	GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error)
 	Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(ctx context.Context, in *RegisterLinkRequest, opts ...grpc.CallOption) (*RegisterLinkResponse, error)
@@ -5286,6 +7018,16 @@ type MilvusServiceClient interface {
 	GetCompactionState(ctx context.Context, in *GetCompactionStateRequest, opts ...grpc.CallOption) (*GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, in *ManualCompactionRequest, opts ...grpc.CallOption) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, in *GetCompactionPlansRequest, opts ...grpc.CallOption) (*GetCompactionPlansResponse, error)
+	CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GrantPrivilege(ctx context.Context, in *GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RevokePrivilege(ctx context.Context, in *RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error)
 }
 
 type milvusServiceClient struct {
@@ -5458,6 +7200,60 @@ func (c *milvusServiceClient) AlterAlias(ctx context.Context, in *AlterAliasRequ
 	return out, nil
 }
 
+func (c *milvusServiceClient) RenameCollection(ctx context.Context, in *RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AlterCollection(ctx context.Context, in *AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) AddField(ctx context.Context, in *AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateDatabase(ctx context.Context, in *CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropDatabase(ctx context.Context, in *DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListDatabases(ctx context.Context, in *ListDatabasesRequest, opts ...grpc.CallOption) (*ListDatabasesResponse, error) {
+	out := new(ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) CreateIndex(ctx context.Context, in *CreateIndexRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
 	out := new(commonpb.Status)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateIndex", in, out, opts...)
@@ -5521,6 +7317,15 @@ func (c *milvusServiceClient) Delete(ctx context.Context, in *DeleteRequest, opt
 	return out, nil
 }
 
+func (c *milvusServiceClient) Upsert(ctx context.Context, in *UpsertRequest, opts ...grpc.CallOption) (*MutationResult, error) {
+	out := new(MutationResult)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Upsert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Search(ctx context.Context, in *SearchRequest, opts ...grpc.CallOption) (*SearchResults, error) {
 	out := new(SearchResults)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Search", in, out, opts...)
@@ -5584,6 +7389,24 @@ func (c *milvusServiceClient) GetQuerySegmentInfo(ctx context.Context, in *GetQu
 	return out, nil
 }
 
+func (c *milvusServiceClient) Import(ctx context.Context, in *ImportRequest, opts ...grpc.CallOption) (*ImportResponse, error) {
+	out := new(ImportResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Import", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GetImportState(ctx context.Context, in *GetImportStateRequest, opts ...grpc.CallOption) (*GetImportStateResponse, error) {
+	out := new(GetImportStateResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GetImportState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *milvusServiceClient) Dummy(ctx context.Context, in *DummyRequest, opts ...grpc.CallOption) (*DummyResponse, error) {
 	out := new(DummyResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/Dummy", in, out, opts...)
@@ -5647,6 +7470,96 @@ func (c *milvusServiceClient) GetCompactionStateWithPlans(ctx context.Context, i
 	return out, nil
 }
 
+func (c *milvusServiceClient) CreateCredential(ctx context.Context, in *CreateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) UpdateCredential(ctx context.Context, in *UpdateCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DeleteCredential(ctx context.Context, in *DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) DropRole(ctx context.Context, in *DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) OperateUserRole(ctx context.Context, in *OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) GrantPrivilege(ctx context.Context, in *GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/GrantPrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) RevokePrivilege(ctx context.Context, in *RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/RevokePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *milvusServiceClient) ListGrants(ctx context.Context, in *ListGrantsRequest, opts ...grpc.CallOption) (*ListGrantsResponse, error) {
+	out := new(ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.milvus.MilvusService/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MilvusServiceServer is the server API for MilvusService service.
 type MilvusServiceServer interface {
 	CreateCollection(context.Context, *CreateCollectionRequest) (*commonpb.Status, error)
@@ -5667,6 +7580,12 @@ type MilvusServiceServer interface {
 	CreateAlias(context.Context, *CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *AlterAliasRequest) (*commonpb.Status, error)
+	RenameCollection(context.Context, *RenameCollectionRequest) (*commonpb.Status, error)
+	AlterCollection(context.Context, *AlterCollectionRequest) (*commonpb.Status, error)
+	AddField(context.Context, *AddFieldRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *ListDatabasesRequest) (*ListDatabasesResponse, error)
 	CreateIndex(context.Context, *CreateIndexRequest) (*commonpb.Status, error)
 	DescribeIndex(context.Context, *DescribeIndexRequest) (*DescribeIndexResponse, error)
 	GetIndexState(context.Context, *GetIndexStateRequest) (*GetIndexStateResponse, error)
@@ -5674,6 +7593,7 @@ type MilvusServiceServer interface {
 	DropIndex(context.Context, *DropIndexRequest) (*commonpb.Status, error)
 	Insert(context.Context, *InsertRequest) (*MutationResult, error)
 	Delete(context.Context, *DeleteRequest) (*MutationResult, error)
+	Upsert(context.Context, *UpsertRequest) (*MutationResult, error)
 	Search(context.Context, *SearchRequest) (*SearchResults, error)
 	Flush(context.Context, *FlushRequest) (*FlushResponse, error)
 	Query(context.Context, *QueryRequest) (*QueryResults, error)
@@ -5681,6 +7601,8 @@ type MilvusServiceServer interface {
 	GetFlushState(context.Context, *GetFlushStateRequest) (*GetFlushStateResponse, error)
 	GetPersistentSegmentInfo(context.Context, *GetPersistentSegmentInfoRequest) (*GetPersistentSegmentInfoResponse, error)
 	GetQuerySegmentInfo(context.Context, *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error)
+	Import(context.Context, *ImportRequest) (*ImportResponse, error)
+	GetImportState(context.Context, *GetImportStateRequest) (*GetImportStateResponse, error)
 	Dummy(context.Context, *DummyRequest) (*DummyResponse, error)
 	// TODO: remove
 	RegisterLink(context.Context, *RegisterLinkRequest) (*RegisterLinkResponse, error)
@@ -5690,6 +7612,16 @@ type MilvusServiceServer interface {
 	GetCompactionState(context.Context, *GetCompactionStateRequest) (*GetCompactionStateResponse, error)
 	ManualCompaction(context.Context, *ManualCompactionRequest) (*ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(context.Context, *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error)
+	CreateCredential(context.Context, *CreateCredentialRequest) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *UpdateCredentialRequest) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *DeleteCredentialRequest) (*commonpb.Status, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	CreateRole(context.Context, *CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *OperateUserRoleRequest) (*commonpb.Status, error)
+	GrantPrivilege(context.Context, *GrantPrivilegeRequest) (*commonpb.Status, error)
+	RevokePrivilege(context.Context, *RevokePrivilegeRequest) (*commonpb.Status, error)
+	ListGrants(context.Context, *ListGrantsRequest) (*ListGrantsResponse, error)
 }
 
 // UnimplementedMilvusServiceServer can be embedded to have forward compatible implementations.
@@ -5750,6 +7682,24 @@ func (*UnimplementedMilvusServiceServer) DropAlias(ctx context.Context, req *Dro
 func (*UnimplementedMilvusServiceServer) AlterAlias(ctx context.Context, req *AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedMilvusServiceServer) RenameCollection(ctx context.Context, req *RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AlterCollection(ctx context.Context, req *AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedMilvusServiceServer) AddField(ctx context.Context, req *AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateDatabase(ctx context.Context, req *CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropDatabase(ctx context.Context, req *DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListDatabases(ctx context.Context, req *ListDatabasesRequest) (*ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (*UnimplementedMilvusServiceServer) CreateIndex(ctx context.Context, req *CreateIndexRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateIndex not implemented")
 }
@@ -5771,6 +7721,9 @@ func (*UnimplementedMilvusServiceServer) Insert(ctx context.Context, req *Insert
 func (*UnimplementedMilvusServiceServer) Delete(ctx context.Context, req *DeleteRequest) (*MutationResult, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Upsert(ctx context.Context, req *UpsertRequest) (*MutationResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Upsert not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Search(ctx context.Context, req *SearchRequest) (*SearchResults, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Search not implemented")
 }
@@ -5792,6 +7745,12 @@ func (*UnimplementedMilvusServiceServer) GetPersistentSegmentInfo(ctx context.Co
 func (*UnimplementedMilvusServiceServer) GetQuerySegmentInfo(ctx context.Context, req *GetQuerySegmentInfoRequest) (*GetQuerySegmentInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetQuerySegmentInfo not implemented")
 }
+func (*UnimplementedMilvusServiceServer) Import(ctx context.Context, req *ImportRequest) (*ImportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Import not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GetImportState(ctx context.Context, req *GetImportStateRequest) (*GetImportStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetImportState not implemented")
+}
 func (*UnimplementedMilvusServiceServer) Dummy(ctx context.Context, req *DummyRequest) (*DummyResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Dummy not implemented")
 }
@@ -5813,6 +7772,36 @@ func (*UnimplementedMilvusServiceServer) ManualCompaction(ctx context.Context, r
 func (*UnimplementedMilvusServiceServer) GetCompactionStateWithPlans(ctx context.Context, req *GetCompactionPlansRequest) (*GetCompactionPlansResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetCompactionStateWithPlans not implemented")
 }
+func (*UnimplementedMilvusServiceServer) CreateCredential(ctx context.Context, req *CreateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) UpdateCredential(ctx context.Context, req *UpdateCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DeleteCredential(ctx context.Context, req *DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedMilvusServiceServer) CreateRole(ctx context.Context, req *CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) DropRole(ctx context.Context, req *DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) OperateUserRole(ctx context.Context, req *OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedMilvusServiceServer) GrantPrivilege(ctx context.Context, req *GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) RevokePrivilege(ctx context.Context, req *RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrivilege not implemented")
+}
+func (*UnimplementedMilvusServiceServer) ListGrants(ctx context.Context, req *ListGrantsRequest) (*ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
 
 func RegisterMilvusServiceServer(s *grpc.Server, srv MilvusServiceServer) {
 	s.RegisterService(&_MilvusService_serviceDesc, srv)
@@ -6004,140 +7993,248 @@ func _MilvusService_HasPartition_Handler(srv interface{}, ctx context.Context, d
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+		return srv.(MilvusServiceServer).HasPartition(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoadPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleasePartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPartitionStatisticsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShowPartitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropAliasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/HasPartition",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).HasPartition(ctx, req.(*HasPartitionRequest))
+		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_LoadPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoadPartitionsRequest)
+func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, in)
+		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/LoadPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).LoadPartitions(ctx, req.(*LoadPartitionsRequest))
+		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ReleasePartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ReleasePartitionsRequest)
+func _MilvusService_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenameCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, in)
+		return srv.(MilvusServiceServer).RenameCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ReleasePartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/RenameCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ReleasePartitions(ctx, req.(*ReleasePartitionsRequest))
+		return srv.(MilvusServiceServer).RenameCollection(ctx, req.(*RenameCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_GetPartitionStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPartitionStatisticsRequest)
+func _MilvusService_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AlterCollectionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, in)
+		return srv.(MilvusServiceServer).AlterCollection(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/GetPartitionStatistics",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AlterCollection",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).GetPartitionStatistics(ctx, req.(*GetPartitionStatisticsRequest))
+		return srv.(MilvusServiceServer).AlterCollection(ctx, req.(*AlterCollectionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_ShowPartitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ShowPartitionsRequest)
+func _MilvusService_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddFieldRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, in)
+		return srv.(MilvusServiceServer).AddField(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/ShowPartitions",
+		FullMethod: "/milvus.proto.milvus.MilvusService/AddField",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).ShowPartitions(ctx, req.(*ShowPartitionsRequest))
+		return srv.(MilvusServiceServer).AddField(ctx, req.(*AddFieldRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_CreateAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateAliasRequest)
+func _MilvusService_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, in)
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/CreateAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).CreateAlias(ctx, req.(*CreateAliasRequest))
+		return srv.(MilvusServiceServer).CreateDatabase(ctx, req.(*CreateDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_DropAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DropAliasRequest)
+func _MilvusService_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropDatabaseRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).DropAlias(ctx, in)
+		return srv.(MilvusServiceServer).DropDatabase(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/DropAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropDatabase",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).DropAlias(ctx, req.(*DropAliasRequest))
+		return srv.(MilvusServiceServer).DropDatabase(ctx, req.(*DropDatabaseRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MilvusService_AlterAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AlterAliasRequest)
+func _MilvusService_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDatabasesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, in)
+		return srv.(MilvusServiceServer).ListDatabases(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/milvus.proto.milvus.MilvusService/AlterAlias",
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListDatabases",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MilvusServiceServer).AlterAlias(ctx, req.(*AlterAliasRequest))
+		return srv.(MilvusServiceServer).ListDatabases(ctx, req.(*ListDatabasesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -6268,6 +8365,24 @@ func _MilvusService_Delete_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Upsert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Upsert(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Upsert",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Upsert(ctx, req.(*UpsertRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Search_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SearchRequest)
 	if err := dec(in); err != nil {
@@ -6394,6 +8509,42 @@ func _MilvusService_GetQuerySegmentInfo_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_Import_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).Import(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/Import",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).Import(ctx, req.(*ImportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GetImportState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetImportStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GetImportState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GetImportState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GetImportState(ctx, req.(*GetImportStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MilvusService_Dummy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DummyRequest)
 	if err := dec(in); err != nil {
@@ -6520,6 +8671,186 @@ func _MilvusService_GetCompactionStateWithPlans_Handler(srv interface{}, ctx con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MilvusService_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateCredential(ctx, req.(*CreateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).UpdateCredential(ctx, req.(*UpdateCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DeleteCredential(ctx, req.(*DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).CreateRole(ctx, req.(*CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).DropRole(ctx, req.(*DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).OperateUserRole(ctx, req.(*OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_GrantPrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GrantPrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).GrantPrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/GrantPrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).GrantPrivilege(ctx, req.(*GrantPrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_RevokePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).RevokePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/RevokePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).RevokePrivilege(ctx, req.(*RevokePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MilvusService_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MilvusServiceServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.milvus.MilvusService/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MilvusServiceServer).ListGrants(ctx, req.(*ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _MilvusService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.milvus.MilvusService",
 	HandlerType: (*MilvusServiceServer)(nil),
@@ -6596,6 +8927,30 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _MilvusService_AlterAlias_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _MilvusService_RenameCollection_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _MilvusService_AlterCollection_Handler,
+		},
+		{
+			MethodName: "AddField",
+			Handler:    _MilvusService_AddField_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _MilvusService_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _MilvusService_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _MilvusService_ListDatabases_Handler,
+		},
 		{
 			MethodName: "CreateIndex",
 			Handler:    _MilvusService_CreateIndex_Handler,
@@ -6624,6 +8979,10 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _MilvusService_Delete_Handler,
 		},
+		{
+			MethodName: "Upsert",
+			Handler:    _MilvusService_Upsert_Handler,
+		},
 		{
 			MethodName: "Search",
 			Handler:    _MilvusService_Search_Handler,
@@ -6652,6 +9011,14 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetQuerySegmentInfo",
 			Handler:    _MilvusService_GetQuerySegmentInfo_Handler,
 		},
+		{
+			MethodName: "Import",
+			Handler:    _MilvusService_Import_Handler,
+		},
+		{
+			MethodName: "GetImportState",
+			Handler:    _MilvusService_GetImportState_Handler,
+		},
 		{
 			MethodName: "Dummy",
 			Handler:    _MilvusService_Dummy_Handler,
@@ -6680,6 +9047,46 @@ var _MilvusService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompactionStateWithPlans",
 			Handler:    _MilvusService_GetCompactionStateWithPlans_Handler,
 		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _MilvusService_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _MilvusService_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _MilvusService_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _MilvusService_ListUsers_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _MilvusService_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _MilvusService_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _MilvusService_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "GrantPrivilege",
+			Handler:    _MilvusService_GrantPrivilege_Handler,
+		},
+		{
+			MethodName: "RevokePrivilege",
+			Handler:    _MilvusService_RevokePrivilege_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _MilvusService_ListGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "milvus.proto",
@@ -61,6 +61,65 @@ func (OpType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_2d655ab2f7683c23, []int{0}
 }
 
+type NullExpr_NullOp int32
+
+const (
+	NullExpr_Invalid   NullExpr_NullOp = 0
+	NullExpr_IsNull    NullExpr_NullOp = 1
+	NullExpr_IsNotNull NullExpr_NullOp = 2
+)
+
+var NullExpr_NullOp_name = map[int32]string{
+	0: "Invalid",
+	1: "IsNull",
+	2: "IsNotNull",
+}
+
+var NullExpr_NullOp_value = map[string]int32{
+	"Invalid":   0,
+	"IsNull":    1,
+	"IsNotNull": 2,
+}
+
+func (x NullExpr_NullOp) String() string {
+	return proto.EnumName(NullExpr_NullOp_name, int32(x))
+}
+
+func (NullExpr_NullOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+}
+
+type MatchExpr_MatchOp int32
+
+const (
+	MatchExpr_Invalid      MatchExpr_MatchOp = 0
+	MatchExpr_PrefixMatch  MatchExpr_MatchOp = 1
+	MatchExpr_PostfixMatch MatchExpr_MatchOp = 2
+	MatchExpr_InfixMatch   MatchExpr_MatchOp = 3
+)
+
+var MatchExpr_MatchOp_name = map[int32]string{
+	0: "Invalid",
+	1: "PrefixMatch",
+	2: "PostfixMatch",
+	3: "InfixMatch",
+}
+
+var MatchExpr_MatchOp_value = map[string]int32{
+	"Invalid":      0,
+	"PrefixMatch":  1,
+	"PostfixMatch": 2,
+	"InfixMatch":   3,
+}
+
+func (x MatchExpr_MatchOp) String() string {
+	return proto.EnumName(MatchExpr_MatchOp_name, int32(x))
+}
+
+func (MatchExpr_MatchOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+}
+
 type UnaryExpr_UnaryOp int32
 
 const (
@@ -83,7 +142,7 @@ func (x UnaryExpr_UnaryOp) String() string {
 }
 
 func (UnaryExpr_UnaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{9, 0}
 }
 
 type BinaryExpr_BinaryOp int32
@@ -111,7 +170,41 @@ func (x BinaryExpr_BinaryOp) String() string {
 }
 
 func (BinaryExpr_BinaryOp) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8, 0}
+	return fileDescriptor_2d655ab2f7683c23, []int{10, 0}
+}
+
+type Aggregate_AggregateOp int32
+
+const (
+	Aggregate_Invalid Aggregate_AggregateOp = 0
+	Aggregate_Count   Aggregate_AggregateOp = 1
+	Aggregate_Min     Aggregate_AggregateOp = 2
+	Aggregate_Max     Aggregate_AggregateOp = 3
+	Aggregate_Sum     Aggregate_AggregateOp = 4
+)
+
+var Aggregate_AggregateOp_name = map[int32]string{
+	0: "Invalid",
+	1: "Count",
+	2: "Min",
+	3: "Max",
+	4: "Sum",
+}
+
+var Aggregate_AggregateOp_value = map[string]int32{
+	"Invalid": 0,
+	"Count":   1,
+	"Min":     2,
+	"Max":     3,
+	"Sum":     4,
+}
+
+func (x Aggregate_AggregateOp) String() string {
+	return proto.EnumName(Aggregate_AggregateOp_name, int32(x))
+}
+
+func (Aggregate_AggregateOp) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{13, 0}
 }
 
 type GenericValue struct {
@@ -119,6 +212,7 @@ type GenericValue struct {
 	//	*GenericValue_BoolVal
 	//	*GenericValue_Int64Val
 	//	*GenericValue_FloatVal
+	//	*GenericValue_StringVal
 	Val                  isGenericValue_Val `protobuf_oneof:"val"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -166,12 +260,18 @@ type GenericValue_FloatVal struct {
 	FloatVal float64 `protobuf:"fixed64,3,opt,name=float_val,json=floatVal,proto3,oneof"`
 }
 
+type GenericValue_StringVal struct {
+	StringVal string `protobuf:"bytes,4,opt,name=string_val,json=stringVal,proto3,oneof"`
+}
+
 func (*GenericValue_BoolVal) isGenericValue_Val() {}
 
 func (*GenericValue_Int64Val) isGenericValue_Val() {}
 
 func (*GenericValue_FloatVal) isGenericValue_Val() {}
 
+func (*GenericValue_StringVal) isGenericValue_Val() {}
+
 func (m *GenericValue) GetVal() isGenericValue_Val {
 	if m != nil {
 		return m.Val
@@ -200,20 +300,33 @@ func (m *GenericValue) GetFloatVal() float64 {
 	return 0
 }
 
+func (m *GenericValue) GetStringVal() string {
+	if x, ok := m.GetVal().(*GenericValue_StringVal); ok {
+		return x.StringVal
+	}
+	return ""
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*GenericValue) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
 		(*GenericValue_BoolVal)(nil),
 		(*GenericValue_Int64Val)(nil),
 		(*GenericValue_FloatVal)(nil),
+		(*GenericValue_StringVal)(nil),
 	}
 }
 
 type QueryInfo struct {
-	Topk                 int64    `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
-	MetricType           string   `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
-	SearchParams         string   `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
-	RoundDecimal         int64    `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	Topk         int64  `protobuf:"varint,1,opt,name=topk,proto3" json:"topk,omitempty"`
+	MetricType   string `protobuf:"bytes,3,opt,name=metric_type,json=metricType,proto3" json:"metric_type,omitempty"`
+	SearchParams string `protobuf:"bytes,4,opt,name=search_params,json=searchParams,proto3" json:"search_params,omitempty"`
+	RoundDecimal int64  `protobuf:"varint,5,opt,name=round_decimal,json=roundDecimal,proto3" json:"round_decimal,omitempty"`
+	// range search returns the results within radius, topk limits the max number of results
+	// L2 and HAMMING: range_filter <= distance < radius, IP: radius < distance <= range_filter
+	IsRangeSearch        bool     `protobuf:"varint,6,opt,name=is_range_search,json=isRangeSearch,proto3" json:"is_range_search,omitempty"`
+	Radius               float32  `protobuf:"fixed32,7,opt,name=radius,proto3" json:"radius,omitempty"`
+	RangeFilter          float32  `protobuf:"fixed32,8,opt,name=range_filter,json=rangeFilter,proto3" json:"range_filter,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -272,14 +385,37 @@ func (m *QueryInfo) GetRoundDecimal() int64 {
 	return 0
 }
 
+func (m *QueryInfo) GetIsRangeSearch() bool {
+	if m != nil {
+		return m.IsRangeSearch
+	}
+	return false
+}
+
+func (m *QueryInfo) GetRadius() float32 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *QueryInfo) GetRangeFilter() float32 {
+	if m != nil {
+		return m.RangeFilter
+	}
+	return 0
+}
+
 type ColumnInfo struct {
-	FieldId              int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
-	DataType             schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
-	IsPrimaryKey         bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
-	IsAutoID             bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	FieldId      int64             `protobuf:"varint,1,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	DataType     schemapb.DataType `protobuf:"varint,2,opt,name=data_type,json=dataType,proto3,enum=milvus.proto.schema.DataType" json:"data_type,omitempty"`
+	IsPrimaryKey bool              `protobuf:"varint,3,opt,name=is_primary_key,json=isPrimaryKey,proto3" json:"is_primary_key,omitempty"`
+	IsAutoID     bool              `protobuf:"varint,4,opt,name=is_autoID,json=isAutoID,proto3" json:"is_autoID,omitempty"`
+	// the keys to the value filtered on in a json field, like ["a", "b"] of meta["a"]["b"]
+	NestedPath           []string `protobuf:"bytes,5,rep,name=nested_path,json=nestedPath,proto3" json:"nested_path,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *ColumnInfo) Reset()         { *m = ColumnInfo{} }
@@ -335,6 +471,13 @@ func (m *ColumnInfo) GetIsAutoID() bool {
 	return false
 }
 
+func (m *ColumnInfo) GetNestedPath() []string {
+	if m != nil {
+		return m.NestedPath
+	}
+	return nil
+}
+
 type UnaryRangeExpr struct {
 	ColumnInfo           *ColumnInfo   `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
 	Op                   OpType        `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.OpType" json:"op,omitempty"`
@@ -563,6 +706,109 @@ func (m *TermExpr) GetValues() []*GenericValue {
 	return nil
 }
 
+type NullExpr struct {
+	ColumnInfo           *ColumnInfo     `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   NullExpr_NullOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.NullExpr_NullOp" json:"op,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *NullExpr) Reset()         { *m = NullExpr{} }
+func (m *NullExpr) String() string { return proto.CompactTextString(m) }
+func (*NullExpr) ProtoMessage()    {}
+func (*NullExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{7}
+}
+
+func (m *NullExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NullExpr.Unmarshal(m, b)
+}
+func (m *NullExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NullExpr.Marshal(b, m, deterministic)
+}
+func (m *NullExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NullExpr.Merge(m, src)
+}
+func (m *NullExpr) XXX_Size() int {
+	return xxx_messageInfo_NullExpr.Size(m)
+}
+func (m *NullExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_NullExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NullExpr proto.InternalMessageInfo
+
+func (m *NullExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *NullExpr) GetOp() NullExpr_NullOp {
+	if m != nil {
+		return m.Op
+	}
+	return NullExpr_Invalid
+}
+
+// MatchExpr matches the string field against a pattern, which is translated from the `like` operator
+type MatchExpr struct {
+	ColumnInfo           *ColumnInfo       `protobuf:"bytes,1,opt,name=column_info,json=columnInfo,proto3" json:"column_info,omitempty"`
+	Op                   MatchExpr_MatchOp `protobuf:"varint,2,opt,name=op,proto3,enum=milvus.proto.plan.MatchExpr_MatchOp" json:"op,omitempty"`
+	Pattern              string            `protobuf:"bytes,3,opt,name=pattern,proto3" json:"pattern,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *MatchExpr) Reset()         { *m = MatchExpr{} }
+func (m *MatchExpr) String() string { return proto.CompactTextString(m) }
+func (*MatchExpr) ProtoMessage()    {}
+func (*MatchExpr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{8}
+}
+
+func (m *MatchExpr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MatchExpr.Unmarshal(m, b)
+}
+func (m *MatchExpr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MatchExpr.Marshal(b, m, deterministic)
+}
+func (m *MatchExpr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MatchExpr.Merge(m, src)
+}
+func (m *MatchExpr) XXX_Size() int {
+	return xxx_messageInfo_MatchExpr.Size(m)
+}
+func (m *MatchExpr) XXX_DiscardUnknown() {
+	xxx_messageInfo_MatchExpr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MatchExpr proto.InternalMessageInfo
+
+func (m *MatchExpr) GetColumnInfo() *ColumnInfo {
+	if m != nil {
+		return m.ColumnInfo
+	}
+	return nil
+}
+
+func (m *MatchExpr) GetOp() MatchExpr_MatchOp {
+	if m != nil {
+		return m.Op
+	}
+	return MatchExpr_Invalid
+}
+
+func (m *MatchExpr) GetPattern() string {
+	if m != nil {
+		return m.Pattern
+	}
+	return ""
+}
+
 type UnaryExpr struct {
 	Op                   UnaryExpr_UnaryOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.UnaryExpr_UnaryOp" json:"op,omitempty"`
 	Child                *Expr             `protobuf:"bytes,2,opt,name=child,proto3" json:"child,omitempty"`
@@ -575,7 +821,7 @@ func (m *UnaryExpr) Reset()         { *m = UnaryExpr{} }
 func (m *UnaryExpr) String() string { return proto.CompactTextString(m) }
 func (*UnaryExpr) ProtoMessage()    {}
 func (*UnaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{7}
+	return fileDescriptor_2d655ab2f7683c23, []int{9}
 }
 
 func (m *UnaryExpr) XXX_Unmarshal(b []byte) error {
@@ -623,7 +869,7 @@ func (m *BinaryExpr) Reset()         { *m = BinaryExpr{} }
 func (m *BinaryExpr) String() string { return proto.CompactTextString(m) }
 func (*BinaryExpr) ProtoMessage()    {}
 func (*BinaryExpr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{8}
+	return fileDescriptor_2d655ab2f7683c23, []int{10}
 }
 
 func (m *BinaryExpr) XXX_Unmarshal(b []byte) error {
@@ -673,6 +919,8 @@ type Expr struct {
 	//	*Expr_CompareExpr
 	//	*Expr_UnaryRangeExpr
 	//	*Expr_BinaryRangeExpr
+	//	*Expr_NullExpr
+	//	*Expr_MatchExpr
 	Expr                 isExpr_Expr `protobuf_oneof:"expr"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
 	XXX_unrecognized     []byte      `json:"-"`
@@ -683,7 +931,7 @@ func (m *Expr) Reset()         { *m = Expr{} }
 func (m *Expr) String() string { return proto.CompactTextString(m) }
 func (*Expr) ProtoMessage()    {}
 func (*Expr) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{9}
+	return fileDescriptor_2d655ab2f7683c23, []int{11}
 }
 
 func (m *Expr) XXX_Unmarshal(b []byte) error {
@@ -732,6 +980,14 @@ type Expr_BinaryRangeExpr struct {
 	BinaryRangeExpr *BinaryRangeExpr `protobuf:"bytes,6,opt,name=binary_range_expr,json=binaryRangeExpr,proto3,oneof"`
 }
 
+type Expr_NullExpr struct {
+	NullExpr *NullExpr `protobuf:"bytes,7,opt,name=null_expr,json=nullExpr,proto3,oneof"`
+}
+
+type Expr_MatchExpr struct {
+	MatchExpr *MatchExpr `protobuf:"bytes,8,opt,name=match_expr,json=matchExpr,proto3,oneof"`
+}
+
 func (*Expr_TermExpr) isExpr_Expr() {}
 
 func (*Expr_UnaryExpr) isExpr_Expr() {}
@@ -744,6 +1000,10 @@ func (*Expr_UnaryRangeExpr) isExpr_Expr() {}
 
 func (*Expr_BinaryRangeExpr) isExpr_Expr() {}
 
+func (*Expr_NullExpr) isExpr_Expr() {}
+
+func (*Expr_MatchExpr) isExpr_Expr() {}
+
 func (m *Expr) GetExpr() isExpr_Expr {
 	if m != nil {
 		return m.Expr
@@ -793,6 +1053,20 @@ func (m *Expr) GetBinaryRangeExpr() *BinaryRangeExpr {
 	return nil
 }
 
+func (m *Expr) GetNullExpr() *NullExpr {
+	if x, ok := m.GetExpr().(*Expr_NullExpr); ok {
+		return x.NullExpr
+	}
+	return nil
+}
+
+func (m *Expr) GetMatchExpr() *MatchExpr {
+	if x, ok := m.GetExpr().(*Expr_MatchExpr); ok {
+		return x.MatchExpr
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*Expr) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -802,6 +1076,8 @@ func (*Expr) XXX_OneofWrappers() []interface{} {
 		(*Expr_CompareExpr)(nil),
 		(*Expr_UnaryRangeExpr)(nil),
 		(*Expr_BinaryRangeExpr)(nil),
+		(*Expr_NullExpr)(nil),
+		(*Expr_MatchExpr)(nil),
 	}
 }
 
@@ -820,7 +1096,7 @@ func (m *VectorANNS) Reset()         { *m = VectorANNS{} }
 func (m *VectorANNS) String() string { return proto.CompactTextString(m) }
 func (*VectorANNS) ProtoMessage()    {}
 func (*VectorANNS) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{10}
+	return fileDescriptor_2d655ab2f7683c23, []int{12}
 }
 
 func (m *VectorANNS) XXX_Unmarshal(b []byte) error {
@@ -876,12 +1152,60 @@ func (m *VectorANNS) GetPlaceholderTag() string {
 	return ""
 }
 
+type Aggregate struct {
+	Op                   Aggregate_AggregateOp `protobuf:"varint,1,opt,name=op,proto3,enum=milvus.proto.plan.Aggregate_AggregateOp" json:"op,omitempty"`
+	FieldId              int64                 `protobuf:"varint,2,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *Aggregate) Reset()         { *m = Aggregate{} }
+func (m *Aggregate) String() string { return proto.CompactTextString(m) }
+func (*Aggregate) ProtoMessage()    {}
+func (*Aggregate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d655ab2f7683c23, []int{13}
+}
+
+func (m *Aggregate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Aggregate.Unmarshal(m, b)
+}
+func (m *Aggregate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Aggregate.Marshal(b, m, deterministic)
+}
+func (m *Aggregate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Aggregate.Merge(m, src)
+}
+func (m *Aggregate) XXX_Size() int {
+	return xxx_messageInfo_Aggregate.Size(m)
+}
+func (m *Aggregate) XXX_DiscardUnknown() {
+	xxx_messageInfo_Aggregate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Aggregate proto.InternalMessageInfo
+
+func (m *Aggregate) GetOp() Aggregate_AggregateOp {
+	if m != nil {
+		return m.Op
+	}
+	return Aggregate_Invalid
+}
+
+func (m *Aggregate) GetFieldId() int64 {
+	if m != nil {
+		return m.FieldId
+	}
+	return 0
+}
+
 type PlanNode struct {
 	// Types that are valid to be assigned to Node:
 	//	*PlanNode_VectorAnns
 	//	*PlanNode_Predicates
 	Node                 isPlanNode_Node `protobuf_oneof:"node"`
 	OutputFieldIds       []int64         `protobuf:"varint,3,rep,packed,name=output_field_ids,json=outputFieldIds,proto3" json:"output_field_ids,omitempty"`
+	Aggregates           []*Aggregate    `protobuf:"bytes,4,rep,name=aggregates,proto3" json:"aggregates,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
 	XXX_unrecognized     []byte          `json:"-"`
 	XXX_sizecache        int32           `json:"-"`
@@ -891,7 +1215,7 @@ func (m *PlanNode) Reset()         { *m = PlanNode{} }
 func (m *PlanNode) String() string { return proto.CompactTextString(m) }
 func (*PlanNode) ProtoMessage()    {}
 func (*PlanNode) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2d655ab2f7683c23, []int{11}
+	return fileDescriptor_2d655ab2f7683c23, []int{14}
 }
 
 func (m *PlanNode) XXX_Unmarshal(b []byte) error {
@@ -956,6 +1280,13 @@ func (m *PlanNode) GetOutputFieldIds() []int64 {
 	return nil
 }
 
+func (m *PlanNode) GetAggregates() []*Aggregate {
+	if m != nil {
+		return m.Aggregates
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*PlanNode) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -966,8 +1297,11 @@ func (*PlanNode) XXX_OneofWrappers() []interface{} {
 
 func init() {
 	proto.RegisterEnum("milvus.proto.plan.OpType", OpType_name, OpType_value)
+	proto.RegisterEnum("milvus.proto.plan.NullExpr_NullOp", NullExpr_NullOp_name, NullExpr_NullOp_value)
+	proto.RegisterEnum("milvus.proto.plan.MatchExpr_MatchOp", MatchExpr_MatchOp_name, MatchExpr_MatchOp_value)
 	proto.RegisterEnum("milvus.proto.plan.UnaryExpr_UnaryOp", UnaryExpr_UnaryOp_name, UnaryExpr_UnaryOp_value)
 	proto.RegisterEnum("milvus.proto.plan.BinaryExpr_BinaryOp", BinaryExpr_BinaryOp_name, BinaryExpr_BinaryOp_value)
+	proto.RegisterEnum("milvus.proto.plan.Aggregate_AggregateOp", Aggregate_AggregateOp_name, Aggregate_AggregateOp_value)
 	proto.RegisterType((*GenericValue)(nil), "milvus.proto.plan.GenericValue")
 	proto.RegisterType((*QueryInfo)(nil), "milvus.proto.plan.QueryInfo")
 	proto.RegisterType((*ColumnInfo)(nil), "milvus.proto.plan.ColumnInfo")
@@ -975,84 +1309,106 @@ func init() {
 	proto.RegisterType((*BinaryRangeExpr)(nil), "milvus.proto.plan.BinaryRangeExpr")
 	proto.RegisterType((*CompareExpr)(nil), "milvus.proto.plan.CompareExpr")
 	proto.RegisterType((*TermExpr)(nil), "milvus.proto.plan.TermExpr")
+	proto.RegisterType((*NullExpr)(nil), "milvus.proto.plan.NullExpr")
+	proto.RegisterType((*MatchExpr)(nil), "milvus.proto.plan.MatchExpr")
 	proto.RegisterType((*UnaryExpr)(nil), "milvus.proto.plan.UnaryExpr")
 	proto.RegisterType((*BinaryExpr)(nil), "milvus.proto.plan.BinaryExpr")
 	proto.RegisterType((*Expr)(nil), "milvus.proto.plan.Expr")
 	proto.RegisterType((*VectorANNS)(nil), "milvus.proto.plan.VectorANNS")
+	proto.RegisterType((*Aggregate)(nil), "milvus.proto.plan.Aggregate")
 	proto.RegisterType((*PlanNode)(nil), "milvus.proto.plan.PlanNode")
 }
 
 func init() { proto.RegisterFile("plan.proto", fileDescriptor_2d655ab2f7683c23) }
 
 var fileDescriptor_2d655ab2f7683c23 = []byte{
-	// 1091 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x72, 0x1b, 0xc5,
-	0x13, 0xd7, 0x6a, 0x25, 0x79, 0xb7, 0xa5, 0x48, 0xca, 0x5e, 0xfe, 0xce, 0x3f, 0x04, 0x9b, 0x25,
-	0x45, 0x0c, 0x54, 0xec, 0x22, 0x09, 0x4e, 0x11, 0x0a, 0xca, 0x5f, 0xc1, 0x52, 0x11, 0x6c, 0xb3,
-	0x18, 0x1f, 0xb8, 0x6c, 0x8d, 0x76, 0xc7, 0xd2, 0x54, 0x66, 0x77, 0xd6, 0xb3, 0xb3, 0x22, 0x3a,
-	0x73, 0xe3, 0xc6, 0x4b, 0xc0, 0x19, 0x6e, 0xbc, 0x03, 0x0f, 0xc0, 0x9d, 0x17, 0xa1, 0xa6, 0x67,
-	0xad, 0x8f, 0x94, 0xec, 0x98, 0xaa, 0xdc, 0x66, 0x7e, 0xd3, 0xdd, 0xd3, 0xbf, 0xfe, 0x9a, 0x01,
-	0xc8, 0x38, 0x49, 0x37, 0x33, 0x29, 0x94, 0xf0, 0x6e, 0x27, 0x8c, 0x8f, 0x8b, 0xdc, 0xec, 0x36,
-	0xf5, 0xc1, 0xff, 0x5b, 0x79, 0x34, 0xa2, 0x09, 0x31, 0x90, 0x9f, 0x41, 0xeb, 0x90, 0xa6, 0x54,
-	0xb2, 0xe8, 0x8c, 0xf0, 0x82, 0x7a, 0x77, 0xc1, 0x19, 0x08, 0xc1, 0xc3, 0x31, 0xe1, 0xab, 0xd6,
-	0xba, 0xb5, 0xe1, 0xf4, 0x2a, 0xc1, 0x8a, 0x46, 0xce, 0x08, 0xf7, 0xee, 0x81, 0xcb, 0x52, 0xb5,
-	0xfd, 0x04, 0x4f, 0xab, 0xeb, 0xd6, 0x86, 0xdd, 0xab, 0x04, 0x0e, 0x42, 0xe5, 0xf1, 0x39, 0x17,
-	0x44, 0xe1, 0xb1, 0xbd, 0x6e, 0x6d, 0x58, 0xfa, 0x18, 0xa1, 0x33, 0xc2, 0xf7, 0xea, 0x60, 0x8f,
-	0x09, 0xf7, 0x7f, 0xb6, 0xc0, 0xfd, 0xb6, 0xa0, 0x72, 0xd2, 0x4f, 0xcf, 0x85, 0xe7, 0x41, 0x4d,
-	0x89, 0xec, 0x25, 0xde, 0x65, 0x07, 0xb8, 0xf6, 0xd6, 0xa0, 0x99, 0x50, 0x25, 0x59, 0x14, 0xaa,
-	0x49, 0x46, 0xd1, 0x92, 0x1b, 0x80, 0x81, 0x4e, 0x27, 0x19, 0xf5, 0xde, 0x87, 0x5b, 0x39, 0x25,
-	0x32, 0x1a, 0x85, 0x19, 0x91, 0x24, 0xc9, 0x57, 0x6b, 0x28, 0xd2, 0x32, 0xe0, 0x09, 0x62, 0x5a,
-	0x48, 0x8a, 0x22, 0x8d, 0xc3, 0x98, 0x46, 0x2c, 0x21, 0x7c, 0xb5, 0x8e, 0x57, 0xb4, 0x10, 0x3c,
-	0x30, 0x98, 0xff, 0xab, 0x05, 0xb0, 0x2f, 0x78, 0x91, 0xa4, 0xe8, 0xcd, 0x1d, 0x70, 0xce, 0x19,
-	0xe5, 0x71, 0xc8, 0xe2, 0xd2, 0xa3, 0x15, 0xdc, 0xf7, 0x63, 0xef, 0x19, 0xb8, 0x31, 0x51, 0xc4,
-	0xb8, 0xa4, 0xb9, 0xb7, 0x1f, 0xdd, 0xdb, 0x5c, 0x88, 0x6e, 0x19, 0xd7, 0x03, 0xa2, 0x88, 0xf6,
-	0x32, 0x70, 0xe2, 0x72, 0xe5, 0xdd, 0x87, 0x36, 0xcb, 0xc3, 0x4c, 0xb2, 0x84, 0xc8, 0x49, 0xf8,
-	0x92, 0x4e, 0x90, 0x93, 0x13, 0xb4, 0x58, 0x7e, 0x62, 0xc0, 0xaf, 0xe9, 0xc4, 0xbb, 0x0b, 0x2e,
-	0xcb, 0x43, 0x52, 0x28, 0xd1, 0x3f, 0x40, 0x46, 0x4e, 0xe0, 0xb0, 0x7c, 0x17, 0xf7, 0xfe, 0x1f,
-	0x16, 0xb4, 0xbf, 0x4f, 0x89, 0x9c, 0x04, 0x24, 0x1d, 0xd2, 0xe7, 0xaf, 0x32, 0xe9, 0x7d, 0x09,
-	0xcd, 0x08, 0x5d, 0x0f, 0x59, 0x7a, 0x2e, 0xd0, 0xdf, 0xe6, 0xeb, 0x3e, 0x61, 0x29, 0xcc, 0x08,
-	0x06, 0x10, 0xcd, 0xc8, 0x7e, 0x08, 0x55, 0x91, 0x95, 0x54, 0xee, 0x2c, 0x51, 0x3b, 0xce, 0x90,
-	0x46, 0x55, 0x64, 0xde, 0xa7, 0x50, 0x1f, 0xeb, 0xf2, 0x40, 0xbf, 0x9b, 0x8f, 0xd6, 0x96, 0x48,
-	0xcf, 0x57, 0x51, 0x60, 0xa4, 0xfd, 0xdf, 0xaa, 0xd0, 0xd9, 0x63, 0x6f, 0xd7, 0xeb, 0x07, 0xd0,
-	0xe1, 0xe2, 0x47, 0x2a, 0x43, 0x96, 0x46, 0xbc, 0xc8, 0xd9, 0xd8, 0x64, 0xc3, 0x09, 0xda, 0x08,
-	0xf7, 0x2f, 0x51, 0x2d, 0x58, 0x64, 0xd9, 0x82, 0xa0, 0x89, 0x7a, 0x1b, 0xe1, 0x99, 0xe0, 0x0e,
-	0x34, 0x8d, 0x45, 0x43, 0xb1, 0x76, 0x33, 0x8a, 0x80, 0x3a, 0xa6, 0x69, 0x76, 0xa0, 0x69, 0xae,
-	0x32, 0x16, 0xea, 0x37, 0xb4, 0x80, 0x3a, 0xb8, 0xf6, 0xff, 0xb2, 0xa0, 0xb9, 0x2f, 0x92, 0x8c,
-	0x48, 0x13, 0xa5, 0x43, 0xe8, 0x72, 0x7a, 0xae, 0xc2, 0xff, 0x1c, 0xaa, 0xb6, 0x56, 0x9b, 0xab,
-	0xe8, 0x3e, 0xdc, 0x96, 0x6c, 0x38, 0x5a, 0xb4, 0x54, 0xbd, 0x89, 0xa5, 0x0e, 0xea, 0xed, 0xbf,
-	0x5e, 0x2f, 0xf6, 0x0d, 0xea, 0xc5, 0xff, 0xc9, 0x02, 0xe7, 0x94, 0xca, 0xe4, 0xad, 0x64, 0xfc,
-	0x29, 0x34, 0x30, 0xae, 0xf9, 0x6a, 0x75, 0xdd, 0xbe, 0x49, 0x60, 0x4b, 0x71, 0xff, 0x17, 0x0b,
-	0x5c, 0xec, 0x19, 0x74, 0xe3, 0x09, 0xba, 0x6f, 0xa1, 0xfb, 0xf7, 0x97, 0x98, 0x98, 0x4a, 0x9a,
-	0xd5, 0x71, 0x86, 0x95, 0xff, 0x10, 0xea, 0xd1, 0x88, 0xf1, 0xb8, 0x8c, 0xd9, 0xff, 0x96, 0x28,
-	0x6a, 0x9d, 0xc0, 0x48, 0xf9, 0x6b, 0xb0, 0x52, 0x6a, 0x7b, 0x4d, 0x58, 0xe9, 0xa7, 0x63, 0xc2,
-	0x59, 0xdc, 0xad, 0x78, 0x2b, 0x60, 0x1f, 0x09, 0xd5, 0xb5, 0xfc, 0xbf, 0x2d, 0x00, 0xd3, 0x12,
-	0xe8, 0xd4, 0xf6, 0x9c, 0x53, 0x1f, 0x2c, 0xb1, 0x3d, 0x13, 0x2d, 0x97, 0xa5, 0x5b, 0x1f, 0x43,
-	0x4d, 0x27, 0xfa, 0x4d, 0x5e, 0xa1, 0x90, 0xe6, 0x80, 0xb9, 0x2c, 0xbb, 0xf7, 0x6a, 0x0e, 0x28,
-	0xe5, 0x6f, 0x83, 0x73, 0x79, 0xd7, 0x22, 0x89, 0x36, 0xc0, 0x0b, 0x31, 0x64, 0x11, 0xe1, 0xbb,
-	0x69, 0xdc, 0xb5, 0xbc, 0x5b, 0xe0, 0x96, 0xfb, 0x63, 0xd9, 0xad, 0xfa, 0xbf, 0xdb, 0x50, 0x43,
-	0x52, 0xcf, 0xc0, 0x55, 0x54, 0x26, 0x21, 0x7d, 0x95, 0xc9, 0x32, 0xdd, 0x77, 0x97, 0xdc, 0x79,
-	0x59, 0x20, 0xfa, 0x91, 0x50, 0x97, 0xc5, 0xf2, 0x05, 0x40, 0xa1, 0xef, 0x36, 0xca, 0x86, 0xde,
-	0x3b, 0xd7, 0x65, 0xab, 0x57, 0x09, 0xdc, 0x62, 0x1a, 0xcf, 0x1d, 0x68, 0x0e, 0xd8, 0x4c, 0xdf,
-	0xbe, 0xb2, 0xd6, 0x66, 0x81, 0xed, 0x55, 0x02, 0x18, 0xcc, 0x32, 0xb2, 0x0f, 0xad, 0xc8, 0x34,
-	0xa2, 0x31, 0x61, 0xc6, 0xc1, 0xbb, 0x4b, 0xcb, 0x75, 0xda, 0xaf, 0xbd, 0x4a, 0xd0, 0x8c, 0xe6,
-	0xda, 0xf7, 0x1b, 0xe8, 0x1a, 0x16, 0x52, 0xcf, 0x3d, 0x63, 0xc8, 0x4c, 0x85, 0xf7, 0xae, 0xe2,
-	0x32, 0x9d, 0x90, 0xbd, 0x4a, 0xd0, 0x2e, 0x16, 0x67, 0xe6, 0x09, 0xdc, 0x2e, 0x59, 0xcd, 0xd9,
-	0x6b, 0xa0, 0x3d, 0xff, 0x4a, 0x6e, 0xf3, 0x06, 0x3b, 0x83, 0x45, 0x68, 0xaf, 0x01, 0x35, 0x6d,
-	0xc4, 0xff, 0xc7, 0x02, 0x38, 0xa3, 0x91, 0x12, 0x72, 0xf7, 0xe8, 0xe8, 0xbb, 0xf2, 0x09, 0x32,
-	0xc2, 0xe6, 0xf9, 0xd7, 0x4f, 0x90, 0xb1, 0xb7, 0xf0, 0x38, 0x56, 0x17, 0x1f, 0xc7, 0xa7, 0x00,
-	0x99, 0xa4, 0x31, 0x8b, 0x88, 0xa2, 0xf9, 0x9b, 0xca, 0x6c, 0x4e, 0xd4, 0xfb, 0x1c, 0xe0, 0x42,
-	0xff, 0x05, 0xcc, 0x68, 0xa8, 0x5d, 0x99, 0xee, 0xe9, 0x87, 0x21, 0x70, 0x2f, 0xa6, 0x7f, 0x87,
-	0x07, 0xd0, 0xc9, 0x38, 0x89, 0xe8, 0x48, 0xf0, 0x98, 0xca, 0x50, 0x91, 0x21, 0x06, 0xd9, 0x0d,
-	0xda, 0x73, 0xf0, 0x29, 0x19, 0xfa, 0x7f, 0x5a, 0xe0, 0x9c, 0x70, 0x92, 0x1e, 0x89, 0x18, 0x87,
-	0xf5, 0x18, 0x19, 0x87, 0x24, 0x4d, 0xf3, 0x6b, 0xc6, 0xd1, 0x2c, 0x2e, 0xba, 0x44, 0x8c, 0xce,
-	0x6e, 0x9a, 0xe6, 0xde, 0x67, 0x0b, 0x6c, 0xaf, 0x6f, 0x41, 0xad, 0x3a, 0xc7, 0x77, 0x03, 0xba,
-	0xa2, 0x50, 0x59, 0xa1, 0xc2, 0xcb, 0x50, 0xea, 0x70, 0xd9, 0x1b, 0x76, 0xd0, 0x36, 0xf8, 0x57,
-	0x26, 0xa2, 0xb9, 0xce, 0x50, 0x2a, 0x62, 0xfa, 0x51, 0x0a, 0x0d, 0x33, 0x58, 0x17, 0x7b, 0xb1,
-	0x03, 0xcd, 0x43, 0x49, 0x89, 0xa2, 0xf2, 0x74, 0x44, 0xd2, 0xae, 0xe5, 0x75, 0xa1, 0x55, 0x02,
-	0xcf, 0x2f, 0x0a, 0xc2, 0xbb, 0x55, 0xaf, 0x05, 0xce, 0x0b, 0x9a, 0xe7, 0x78, 0x6e, 0x63, 0xb3,
-	0xd2, 0x3c, 0x37, 0x87, 0x35, 0xcf, 0x85, 0xba, 0x59, 0xd6, 0xb5, 0xdc, 0x91, 0x50, 0x66, 0xd7,
-	0xd8, 0x7b, 0xfc, 0xc3, 0x27, 0x43, 0xa6, 0x46, 0xc5, 0x60, 0x33, 0x12, 0xc9, 0x96, 0x21, 0xf5,
-	0x90, 0x89, 0x72, 0xb5, 0xc5, 0x52, 0x45, 0x65, 0x4a, 0xf8, 0x16, 0xf2, 0xdc, 0xd2, 0x3c, 0xb3,
-	0xc1, 0xa0, 0x81, 0xbb, 0xc7, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x21, 0x9d, 0x5f, 0x11, 0x7b,
-	0x0a, 0x00, 0x00,
+	// 1393 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0xcd, 0x72, 0x1b, 0xc5,
+	0x13, 0xf7, 0x6a, 0xf5, 0xb1, 0xdb, 0x52, 0x64, 0x65, 0x0e, 0xff, 0xbf, 0x42, 0x08, 0x76, 0x96,
+	0x54, 0x10, 0x50, 0xb1, 0x21, 0x09, 0x09, 0x84, 0x8f, 0x8a, 0xed, 0x7c, 0x58, 0x45, 0xe2, 0x98,
+	0x8d, 0xf1, 0x81, 0xcb, 0xd6, 0x68, 0x77, 0x2c, 0x4d, 0x65, 0x34, 0xb3, 0x99, 0x9d, 0x35, 0xd6,
+	0x99, 0x27, 0xe0, 0x15, 0xb8, 0x70, 0xe1, 0x40, 0xf1, 0x12, 0x5c, 0x78, 0x00, 0xee, 0x54, 0x71,
+	0xe3, 0xcc, 0x95, 0x9a, 0x99, 0xd5, 0x57, 0x90, 0x1c, 0x53, 0xe5, 0x5b, 0xf7, 0x6f, 0xba, 0x7b,
+	0xfa, 0xd7, 0x3d, 0xd3, 0x3b, 0x0b, 0x90, 0x32, 0xcc, 0x37, 0x52, 0x29, 0x94, 0x40, 0x17, 0x87,
+	0x94, 0x1d, 0xe7, 0x99, 0xd5, 0x36, 0xf4, 0xc2, 0x1b, 0x8d, 0x2c, 0x1e, 0x90, 0x21, 0xb6, 0x50,
+	0xf0, 0xbd, 0x03, 0x8d, 0xc7, 0x84, 0x13, 0x49, 0xe3, 0x43, 0xcc, 0x72, 0x82, 0x2e, 0x83, 0xd7,
+	0x13, 0x82, 0x45, 0xc7, 0x98, 0xb5, 0x9d, 0x75, 0xa7, 0xe3, 0xed, 0xae, 0x84, 0x35, 0x8d, 0x1c,
+	0x62, 0x86, 0xae, 0x80, 0x4f, 0xb9, 0xba, 0x73, 0xdb, 0xac, 0x96, 0xd6, 0x9d, 0x8e, 0xbb, 0xbb,
+	0x12, 0x7a, 0x06, 0x2a, 0x96, 0x8f, 0x98, 0xc0, 0xca, 0x2c, 0xbb, 0xeb, 0x4e, 0xc7, 0xd1, 0xcb,
+	0x06, 0xd2, 0xcb, 0x6b, 0x00, 0x99, 0x92, 0x94, 0xf7, 0xcd, 0x7a, 0x79, 0xdd, 0xe9, 0xf8, 0xbb,
+	0x2b, 0xa1, 0x6f, 0xb1, 0x43, 0xcc, 0xb6, 0x2b, 0xe0, 0x1e, 0x63, 0x16, 0xfc, 0xe5, 0x80, 0xff,
+	0x55, 0x4e, 0xe4, 0xa8, 0xcb, 0x8f, 0x04, 0x42, 0x50, 0x56, 0x22, 0x7d, 0x61, 0x92, 0x71, 0x43,
+	0x23, 0xa3, 0x35, 0xa8, 0x0f, 0x89, 0x92, 0x34, 0x8e, 0xd4, 0x28, 0x25, 0x66, 0x2b, 0x3f, 0x04,
+	0x0b, 0x1d, 0x8c, 0x52, 0x82, 0xde, 0x86, 0x0b, 0x19, 0xc1, 0x32, 0x1e, 0x44, 0x29, 0x96, 0x78,
+	0x98, 0xd9, 0xdd, 0xc2, 0x86, 0x05, 0xf7, 0x0d, 0xa6, 0x8d, 0xa4, 0xc8, 0x79, 0x12, 0x25, 0x24,
+	0xa6, 0x43, 0xcc, 0xda, 0x15, 0xb3, 0x45, 0xc3, 0x80, 0x0f, 0x2c, 0x86, 0xae, 0xc3, 0x2a, 0xcd,
+	0x22, 0x89, 0x79, 0x9f, 0x44, 0xd6, 0xbb, 0x5d, 0xd5, 0x65, 0x09, 0x2f, 0xd0, 0x2c, 0xd4, 0xe8,
+	0x73, 0x03, 0xa2, 0xff, 0x41, 0x55, 0xe2, 0x84, 0xe6, 0x59, 0xbb, 0xb6, 0xee, 0x74, 0x4a, 0x61,
+	0xa1, 0xa1, 0xab, 0xd0, 0xb0, 0xce, 0x47, 0x94, 0x29, 0x22, 0xdb, 0x9e, 0x59, 0xad, 0x1b, 0xec,
+	0x91, 0x81, 0x82, 0x5f, 0x1d, 0x80, 0x1d, 0xc1, 0xf2, 0x21, 0x37, 0x84, 0x2f, 0x81, 0x77, 0x44,
+	0x09, 0x4b, 0x22, 0x9a, 0x14, 0xa4, 0x6b, 0x46, 0xef, 0x26, 0xe8, 0x1e, 0xf8, 0x09, 0x56, 0xd8,
+	0xb2, 0xd6, 0xf5, 0x6f, 0xde, 0xbc, 0xb2, 0x31, 0xd7, 0xe2, 0xa2, 0xb9, 0x0f, 0xb0, 0xc2, 0xba,
+	0x10, 0xa1, 0x97, 0x14, 0x12, 0xba, 0x06, 0x4d, 0x9a, 0x45, 0xa9, 0xa4, 0x43, 0x2c, 0x47, 0xd1,
+	0x0b, 0x32, 0x32, 0x65, 0xf3, 0xc2, 0x06, 0xcd, 0xf6, 0x2d, 0xf8, 0x25, 0x19, 0xa1, 0xcb, 0xe0,
+	0xd3, 0x2c, 0xc2, 0xb9, 0x12, 0xdd, 0x07, 0xa6, 0x68, 0x5e, 0xe8, 0xd1, 0x6c, 0xcb, 0xe8, 0xba,
+	0xec, 0x9c, 0x64, 0x8a, 0x24, 0x51, 0x8a, 0xd5, 0xa0, 0x5d, 0x59, 0x77, 0x75, 0xd9, 0x2d, 0xb4,
+	0x8f, 0xd5, 0x20, 0xf8, 0xc5, 0x81, 0xe6, 0xd7, 0x1c, 0xcb, 0x91, 0xa9, 0xcc, 0xc3, 0x93, 0x54,
+	0xa2, 0x2f, 0xa0, 0x1e, 0x1b, 0x6e, 0x11, 0xe5, 0x47, 0xc2, 0x10, 0xaa, 0xbf, 0x9a, 0xb4, 0x39,
+	0xb0, 0xd3, 0x0a, 0x84, 0x10, 0x4f, 0x64, 0xf4, 0x2e, 0x94, 0x44, 0x5a, 0x70, 0xbd, 0xb4, 0xc0,
+	0xed, 0x59, 0x6a, 0x78, 0x96, 0x44, 0x8a, 0x3e, 0x82, 0xca, 0xb1, 0x3e, 0xc3, 0x86, 0x58, 0xfd,
+	0xe6, 0xda, 0x02, 0xeb, 0xd9, 0xa3, 0x1e, 0x5a, 0xeb, 0xe0, 0xc7, 0x12, 0xac, 0x6e, 0xd3, 0xf3,
+	0xcd, 0xfa, 0x1d, 0x58, 0x65, 0xe2, 0x5b, 0x22, 0x23, 0xca, 0x63, 0x96, 0x67, 0xf4, 0xd8, 0xb6,
+	0xcb, 0x0b, 0x9b, 0x06, 0xee, 0x8e, 0x51, 0x6d, 0x98, 0xa7, 0xe9, 0x9c, 0xa1, 0x6d, 0x4b, 0xd3,
+	0xc0, 0x53, 0xc3, 0xfb, 0x50, 0xb7, 0x11, 0x2d, 0xc5, 0xf2, 0xd9, 0x28, 0x82, 0xf1, 0x31, 0xb2,
+	0x8e, 0x60, 0xb7, 0xb2, 0x11, 0x2a, 0x67, 0x8c, 0x60, 0x7c, 0x8c, 0x1c, 0xfc, 0xe6, 0x40, 0x7d,
+	0x47, 0x0c, 0x53, 0x2c, 0x6d, 0x95, 0x1e, 0x43, 0x8b, 0x91, 0x23, 0x15, 0xfd, 0xe7, 0x52, 0x35,
+	0xb5, 0xdb, 0x54, 0x47, 0x5d, 0xb8, 0x28, 0x69, 0x7f, 0x30, 0x1f, 0xa9, 0x74, 0x96, 0x48, 0xab,
+	0xc6, 0x6f, 0xe7, 0xd5, 0xf3, 0xe2, 0x9e, 0xe1, 0xbc, 0x04, 0xdf, 0x39, 0xe0, 0x1d, 0x10, 0x39,
+	0x3c, 0x97, 0x8e, 0xdf, 0x85, 0xaa, 0xa9, 0x6b, 0xd6, 0x2e, 0xad, 0xbb, 0x67, 0x29, 0x6c, 0x61,
+	0x1e, 0xfc, 0xec, 0x80, 0xb7, 0x97, 0x33, 0x76, 0x2e, 0x59, 0xdc, 0x9c, 0xb9, 0x2d, 0xc1, 0x02,
+	0xb7, 0xf1, 0x46, 0x46, 0x78, 0x96, 0x9a, 0x32, 0x7c, 0x00, 0x55, 0xab, 0xa1, 0x3a, 0xd4, 0xba,
+	0xfc, 0x18, 0x33, 0x9a, 0xb4, 0x56, 0x10, 0x40, 0xb5, 0x9b, 0xe9, 0x85, 0x96, 0x83, 0x2e, 0x80,
+	0xdf, 0xcd, 0xf6, 0x84, 0x32, 0x6a, 0x29, 0xf8, 0xd3, 0x01, 0xff, 0x29, 0x56, 0xf1, 0xe0, 0x5c,
+	0x72, 0xbe, 0x3d, 0x93, 0xf3, 0xb5, 0x05, 0x6e, 0x93, 0x9d, 0xac, 0x64, 0xb3, 0x46, 0x6d, 0xa8,
+	0xa5, 0x58, 0x29, 0x22, 0x79, 0x31, 0xfe, 0xc7, 0x6a, 0xd0, 0x85, 0x5a, 0x61, 0x38, 0x4f, 0x68,
+	0x15, 0xea, 0xfb, 0x92, 0x1c, 0xd1, 0x13, 0xb3, 0xda, 0x72, 0x50, 0x0b, 0x1a, 0xfb, 0x22, 0x53,
+	0x13, 0xa4, 0x84, 0x9a, 0x00, 0x5d, 0x3e, 0xd1, 0x5d, 0xfd, 0x75, 0xf4, 0xcd, 0x3c, 0x33, 0x44,
+	0x6d, 0xa2, 0xce, 0xd2, 0x44, 0x27, 0x96, 0x56, 0x2a, 0x12, 0xbd, 0x01, 0x95, 0x78, 0x40, 0x59,
+	0x52, 0x9c, 0xe7, 0xff, 0x2f, 0x70, 0xd4, 0x3e, 0xa1, 0xb5, 0x0a, 0xd6, 0xa0, 0x56, 0x78, 0xcf,
+	0x67, 0x5f, 0x03, 0x77, 0x4f, 0xa8, 0x96, 0x13, 0xfc, 0xee, 0x00, 0xd8, 0x71, 0x65, 0x92, 0xba,
+	0x33, 0x93, 0xd4, 0xf5, 0x05, 0xb1, 0xa7, 0xa6, 0x85, 0x58, 0xa4, 0xf5, 0x3e, 0x94, 0xf5, 0x25,
+	0x7c, 0x5d, 0x56, 0xc6, 0x48, 0x73, 0x30, 0xf7, 0xac, 0xed, 0x9e, 0x6e, 0x6d, 0xad, 0x82, 0x3b,
+	0xe0, 0x6d, 0xd3, 0x45, 0x24, 0x9a, 0x00, 0x4f, 0x44, 0x9f, 0xc6, 0x98, 0x6d, 0xf1, 0xc4, 0x9e,
+	0xab, 0x42, 0x7f, 0x26, 0x5b, 0xa5, 0xe0, 0xa7, 0x32, 0x94, 0x0d, 0xa9, 0x7b, 0xe0, 0x2b, 0x22,
+	0x87, 0x11, 0x39, 0x49, 0x65, 0x71, 0xa0, 0x2e, 0x2f, 0xd8, 0x73, 0x7c, 0x79, 0xf5, 0x2b, 0x43,
+	0x15, 0x32, 0xfa, 0x1c, 0x20, 0xd7, 0x7b, 0x5b, 0x67, 0x4b, 0xef, 0xcd, 0xd3, 0xba, 0xa5, 0xdf,
+	0x20, 0xf9, 0x58, 0xd1, 0x53, 0xb2, 0x47, 0xa7, 0xfe, 0xee, 0xd2, 0xd3, 0x3c, 0x2d, 0xec, 0xee,
+	0x4a, 0x08, 0xbd, 0x89, 0x86, 0x76, 0xa0, 0x11, 0xdb, 0x21, 0x69, 0x43, 0xd8, 0x51, 0xfd, 0xd6,
+	0xc2, 0x0b, 0x31, 0x99, 0xa5, 0xbb, 0x2b, 0x61, 0x3d, 0x9e, 0xaa, 0xe8, 0x29, 0xb4, 0x2c, 0x0b,
+	0xfb, 0x78, 0x30, 0x81, 0xec, 0xc4, 0xbe, 0xba, 0x8c, 0xcb, 0xe4, 0xeb, 0xb5, 0xbb, 0x12, 0x36,
+	0xf3, 0x39, 0x04, 0xed, 0xc3, 0xc5, 0x1e, 0x7d, 0x35, 0x5e, 0xd5, 0xc4, 0x0b, 0x96, 0x72, 0x9b,
+	0x0d, 0xb8, 0xda, 0x9b, 0x87, 0x74, 0x8b, 0x78, 0xce, 0x98, 0x8d, 0x54, 0x5b, 0xda, 0xa2, 0xf1,
+	0xc0, 0xd1, 0x2d, 0xe2, 0x85, 0xac, 0x5b, 0x34, 0xd4, 0x37, 0xcc, 0x3a, 0x7b, 0x4b, 0x5b, 0x34,
+	0xb9, 0xf9, 0xba, 0x45, 0xc3, 0xb1, 0xb2, 0x5d, 0x85, 0xb2, 0x76, 0x0c, 0xfe, 0x70, 0x00, 0x0e,
+	0x49, 0xac, 0x84, 0xdc, 0xda, 0xdb, 0x7b, 0x5e, 0x3c, 0x5d, 0x6c, 0x9e, 0x6d, 0x67, 0xfc, 0x74,
+	0xb1, 0x54, 0xe6, 0x1e, 0x55, 0xa5, 0xf9, 0x47, 0xd5, 0x5d, 0x80, 0x54, 0x92, 0x84, 0xc6, 0x58,
+	0x91, 0xec, 0x75, 0x27, 0x7c, 0xc6, 0x14, 0x7d, 0x0a, 0xf0, 0x52, 0x3f, 0x53, 0xed, 0xdc, 0x2b,
+	0x2f, 0xa5, 0x31, 0x79, 0xcb, 0x86, 0xfe, 0xcb, 0xb1, 0xa8, 0x3f, 0xfc, 0x29, 0xc3, 0x31, 0x19,
+	0x08, 0x96, 0x10, 0x19, 0x29, 0xdc, 0x37, 0xfd, 0xf5, 0xc3, 0xe6, 0x0c, 0x7c, 0x80, 0xfb, 0xc1,
+	0x0f, 0x0e, 0xf8, 0x5b, 0xfd, 0xbe, 0x24, 0x7d, 0xac, 0x08, 0xfa, 0x78, 0xe6, 0xba, 0x77, 0x16,
+	0xec, 0x35, 0xb1, 0x9c, 0x4a, 0xc5, 0x85, 0x5f, 0x5e, 0x81, 0xe0, 0x3e, 0xd4, 0x67, 0xac, 0xe7,
+	0xaf, 0xac, 0x0f, 0x95, 0x1d, 0x91, 0x73, 0xd5, 0x72, 0xf4, 0x08, 0x7a, 0x4a, 0x79, 0xab, 0x64,
+	0x04, 0x7c, 0xd2, 0x72, 0xb5, 0xf0, 0x3c, 0x1f, 0xb6, 0xca, 0xc1, 0xdf, 0x0e, 0x78, 0xfb, 0x0c,
+	0xf3, 0x3d, 0x91, 0x98, 0x87, 0xc6, 0xb1, 0x69, 0x4b, 0x84, 0x39, 0xcf, 0x4e, 0xf9, 0x20, 0x4c,
+	0x9b, 0xa7, 0xaf, 0x90, 0xf5, 0xd9, 0xe2, 0x3c, 0x43, 0x9f, 0xcc, 0xb5, 0xe4, 0xf4, 0x11, 0xa5,
+	0x5d, 0x67, 0x9a, 0xd2, 0x81, 0x96, 0xc8, 0x55, 0x9a, 0xab, 0x68, 0xcc, 0x56, 0xf7, 0xd4, 0xed,
+	0xb8, 0x61, 0xd3, 0xe2, 0x8f, 0x2c, 0xe9, 0x0c, 0x7d, 0x06, 0x80, 0xc7, 0xac, 0xf5, 0x0f, 0x82,
+	0xbb, 0xa4, 0x7d, 0x93, 0xd2, 0x84, 0x33, 0xf6, 0xfa, 0x10, 0x72, 0x91, 0x90, 0xf7, 0x38, 0x54,
+	0xed, 0x93, 0xe2, 0x5f, 0x1f, 0x9b, 0xc7, 0x92, 0x60, 0x45, 0xe4, 0xc1, 0x00, 0x73, 0xfb, 0xb1,
+	0x29, 0x80, 0x87, 0x2f, 0x73, 0xcc, 0x5a, 0x25, 0xd4, 0x00, 0xef, 0x09, 0xc9, 0x32, 0xb3, 0xee,
+	0x9a, 0x51, 0x48, 0xb2, 0xcc, 0x2e, 0x96, 0x75, 0xd9, 0xad, 0x58, 0xd1, 0x76, 0x7b, 0x42, 0x59,
+	0xad, 0xba, 0x7d, 0xeb, 0x9b, 0x0f, 0xfb, 0x54, 0x0d, 0xf2, 0xde, 0x46, 0x2c, 0x86, 0x9b, 0x36,
+	0xdb, 0x1b, 0x54, 0x14, 0xd2, 0x26, 0xe5, 0xfa, 0x1b, 0x88, 0xd9, 0xa6, 0x21, 0xb0, 0xa9, 0x09,
+	0xa4, 0xbd, 0x5e, 0xd5, 0x68, 0xb7, 0xfe, 0x19, 0x00, 0x4e, 0x06, 0x32, 0x22, 0x1b, 0x0e, 0x00,
+	0x00,
 }
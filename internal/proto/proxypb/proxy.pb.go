@@ -137,44 +137,198 @@ func (m *ReleaseDQLMessageStreamRequest) GetCollectionID() int64 {
 	return 0
 }
 
+type InvalidateCredCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *InvalidateCredCacheRequest) Reset()         { *m = InvalidateCredCacheRequest{} }
+func (m *InvalidateCredCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*InvalidateCredCacheRequest) ProtoMessage()    {}
+func (*InvalidateCredCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{2}
+}
+
+func (m *InvalidateCredCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Unmarshal(m, b)
+}
+func (m *InvalidateCredCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *InvalidateCredCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InvalidateCredCacheRequest.Merge(m, src)
+}
+func (m *InvalidateCredCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_InvalidateCredCacheRequest.Size(m)
+}
+func (m *InvalidateCredCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InvalidateCredCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InvalidateCredCacheRequest proto.InternalMessageInfo
+
+func (m *InvalidateCredCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *InvalidateCredCacheRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type RefreshPolicyInfoCacheRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *RefreshPolicyInfoCacheRequest) Reset()         { *m = RefreshPolicyInfoCacheRequest{} }
+func (m *RefreshPolicyInfoCacheRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshPolicyInfoCacheRequest) ProtoMessage()    {}
+func (*RefreshPolicyInfoCacheRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{3}
+}
+
+func (m *RefreshPolicyInfoCacheRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Unmarshal(m, b)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.Merge(m, src)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshPolicyInfoCacheRequest.Size(m)
+}
+func (m *RefreshPolicyInfoCacheRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshPolicyInfoCacheRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshPolicyInfoCacheRequest proto.InternalMessageInfo
+
+func (m *RefreshPolicyInfoCacheRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+type SetDMLQuotaRequest struct {
+	Base *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	// the factor applied to the insert rates of proxy, 1 means no backpressure and 0 means the DML requests are rejected
+	Factor float64 `protobuf:"fixed64,2,opt,name=factor,proto3" json:"factor,omitempty"`
+	// the reason why the DML requests are slowed down or rejected
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetDMLQuotaRequest) Reset()         { *m = SetDMLQuotaRequest{} }
+func (m *SetDMLQuotaRequest) String() string { return proto.CompactTextString(m) }
+func (*SetDMLQuotaRequest) ProtoMessage()    {}
+func (*SetDMLQuotaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_700b50b08ed8dbaf, []int{4}
+}
+
+func (m *SetDMLQuotaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetDMLQuotaRequest.Unmarshal(m, b)
+}
+func (m *SetDMLQuotaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetDMLQuotaRequest.Marshal(b, m, deterministic)
+}
+func (m *SetDMLQuotaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetDMLQuotaRequest.Merge(m, src)
+}
+func (m *SetDMLQuotaRequest) XXX_Size() int {
+	return xxx_messageInfo_SetDMLQuotaRequest.Size(m)
+}
+func (m *SetDMLQuotaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetDMLQuotaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetDMLQuotaRequest proto.InternalMessageInfo
+
+func (m *SetDMLQuotaRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *SetDMLQuotaRequest) GetFactor() float64 {
+	if m != nil {
+		return m.Factor
+	}
+	return 0
+}
+
+func (m *SetDMLQuotaRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*InvalidateCollMetaCacheRequest)(nil), "milvus.proto.proxy.InvalidateCollMetaCacheRequest")
 	proto.RegisterType((*ReleaseDQLMessageStreamRequest)(nil), "milvus.proto.proxy.ReleaseDQLMessageStreamRequest")
+	proto.RegisterType((*InvalidateCredCacheRequest)(nil), "milvus.proto.proxy.InvalidateCredCacheRequest")
+	proto.RegisterType((*RefreshPolicyInfoCacheRequest)(nil), "milvus.proto.proxy.RefreshPolicyInfoCacheRequest")
+	proto.RegisterType((*SetDMLQuotaRequest)(nil), "milvus.proto.proxy.SetDMLQuotaRequest")
 }
 
 func init() { proto.RegisterFile("proxy.proto", fileDescriptor_700b50b08ed8dbaf) }
 
 var fileDescriptor_700b50b08ed8dbaf = []byte{
-	// 460 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x61, 0x6b, 0x13, 0x41,
-	0x10, 0xed, 0x99, 0xb6, 0xe2, 0x36, 0x54, 0x59, 0x84, 0x96, 0xa8, 0xa5, 0x9c, 0xa2, 0x45, 0x30,
-	0x29, 0xd1, 0x5f, 0xd0, 0x04, 0x42, 0xc0, 0x88, 0xee, 0x7d, 0x10, 0xf4, 0x83, 0xcc, 0xdd, 0x0d,
-	0xc9, 0xc2, 0xde, 0xee, 0x75, 0x77, 0x2e, 0xe8, 0x5f, 0xf0, 0xb3, 0xff, 0xd1, 0xbf, 0x21, 0xb7,
-	0x77, 0x49, 0x7b, 0x6d, 0x2f, 0xc1, 0x7e, 0xbb, 0x99, 0x7d, 0x33, 0xef, 0xbd, 0xb9, 0xc7, 0x0e,
-	0x72, 0x6b, 0x7e, 0xfe, 0xea, 0xe7, 0xd6, 0x90, 0xe1, 0x3c, 0x93, 0x6a, 0x59, 0xb8, 0xaa, 0xea,
-	0xfb, 0x97, 0x5e, 0x37, 0x31, 0x59, 0x66, 0x74, 0xd5, 0xeb, 0x1d, 0x4a, 0x4d, 0x68, 0x35, 0xa8,
-	0xba, 0xee, 0x5e, 0x9f, 0x08, 0xff, 0x04, 0xec, 0x64, 0xaa, 0x97, 0xa0, 0x64, 0x0a, 0x84, 0x23,
-	0xa3, 0xd4, 0x0c, 0x09, 0x46, 0x90, 0x2c, 0x50, 0xe0, 0x65, 0x81, 0x8e, 0xf8, 0x39, 0xdb, 0x8d,
-	0xc1, 0xe1, 0x71, 0x70, 0x1a, 0x9c, 0x1d, 0x0c, 0x9f, 0xf7, 0x1b, 0x8c, 0x35, 0xd5, 0xcc, 0xcd,
-	0x2f, 0xc0, 0xa1, 0xf0, 0x48, 0x7e, 0xc4, 0x1e, 0xa6, 0xf1, 0x0f, 0x0d, 0x19, 0x1e, 0x3f, 0x38,
-	0x0d, 0xce, 0x1e, 0x89, 0xfd, 0x34, 0xfe, 0x04, 0x19, 0xf2, 0x37, 0xec, 0x71, 0x62, 0x94, 0xc2,
-	0x84, 0xa4, 0xd1, 0x15, 0xa0, 0xe3, 0x01, 0x87, 0x57, 0xed, 0x12, 0x18, 0xfe, 0x0e, 0xd8, 0x89,
-	0x40, 0x85, 0xe0, 0x70, 0xfc, 0xe5, 0xe3, 0x0c, 0x9d, 0x83, 0x39, 0x46, 0x64, 0x11, 0xb2, 0xfb,
-	0xcb, 0xe2, 0x6c, 0x37, 0x8d, 0xa7, 0x63, 0xaf, 0xa9, 0x23, 0xfc, 0x37, 0x0f, 0x59, 0xf7, 0x8a,
-	0x7a, 0x3a, 0xf6, 0x72, 0x3a, 0xa2, 0xd1, 0x1b, 0xfe, 0xdd, 0x63, 0x7b, 0x9f, 0xcb, 0xcb, 0xf2,
-	0x9c, 0xf1, 0x09, 0xd2, 0xc8, 0x64, 0xb9, 0xd1, 0xa8, 0x29, 0x22, 0x20, 0x74, 0xfc, 0xbc, 0xc9,
-	0xbd, 0xbe, 0xf7, 0x6d, 0x68, 0xad, 0xbd, 0xf7, 0xba, 0x65, 0xe2, 0x06, 0x3c, 0xdc, 0xe1, 0x97,
-	0xec, 0xe9, 0x04, 0x7d, 0x29, 0x1d, 0xc9, 0xc4, 0x8d, 0x16, 0xa0, 0x35, 0x2a, 0x3e, 0x6c, 0xe7,
-	0xbc, 0x05, 0x5e, 0xb1, 0xbe, 0x6c, 0xce, 0xd4, 0x45, 0x44, 0x56, 0xea, 0xb9, 0x40, 0x97, 0x1b,
-	0xed, 0x30, 0xdc, 0xe1, 0x96, 0xbd, 0x68, 0x26, 0xa2, 0x3a, 0xc4, 0x3a, 0x17, 0x37, 0xb9, 0xab,
-	0x38, 0x6e, 0x0e, 0x51, 0xef, 0xd9, 0x9d, 0xff, 0xa7, 0x94, 0x5a, 0x94, 0x36, 0x81, 0x75, 0x27,
-	0x48, 0xe3, 0x74, 0x65, 0xef, 0x6d, 0xbb, 0xbd, 0x35, 0xe8, 0x3f, 0x6d, 0x29, 0x76, 0xd4, 0x92,
-	0xa8, 0xbb, 0x0d, 0x6d, 0x8e, 0xdf, 0x36, 0x43, 0x5f, 0xd9, 0x93, 0x08, 0x75, 0x1a, 0x21, 0xd8,
-	0x64, 0x21, 0xd0, 0x15, 0x8a, 0xf8, 0xab, 0x16, 0x53, 0xd7, 0x41, 0x6e, 0xdb, 0xe2, 0xef, 0x8c,
-	0x97, 0x8b, 0x05, 0x92, 0x95, 0xb8, 0xc4, 0x7a, 0x75, 0x5b, 0xa0, 0x9a, 0xb0, 0x6d, 0xcb, 0x2f,
-	0x3e, 0x7c, 0x1b, 0xce, 0x25, 0x2d, 0x8a, 0xb8, 0x7c, 0x19, 0x54, 0xd0, 0x77, 0xd2, 0xd4, 0x5f,
-	0x83, 0xd5, 0xda, 0x81, 0x9f, 0x1e, 0xf8, 0x3b, 0xe5, 0x71, 0xbc, 0xef, 0xcb, 0xf7, 0xff, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0x48, 0x84, 0x3c, 0x39, 0x99, 0x04, 0x00, 0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x6d, 0x6f, 0xd3, 0x30,
+	0x10, 0x5e, 0xd8, 0x28, 0xc3, 0xad, 0x06, 0xb2, 0xd0, 0x36, 0x02, 0x9b, 0xa6, 0x80, 0xc6, 0x84,
+	0x44, 0x3b, 0x0a, 0xbf, 0x60, 0xad, 0x54, 0x55, 0x5a, 0xd1, 0x9a, 0x20, 0x21, 0xc1, 0x07, 0xe4,
+	0x24, 0xd7, 0xd6, 0x93, 0x63, 0x67, 0xb6, 0x53, 0xb1, 0xbf, 0xc0, 0x67, 0xf8, 0xbf, 0x28, 0x4e,
+	0xda, 0x35, 0x6d, 0xd3, 0x88, 0xed, 0x5b, 0x9e, 0xcb, 0x73, 0xf7, 0xdc, 0xf9, 0x5e, 0x50, 0x3d,
+	0x96, 0xe2, 0xd7, 0x6d, 0x33, 0x96, 0x42, 0x0b, 0x8c, 0x23, 0xca, 0xa6, 0x89, 0xca, 0x50, 0xd3,
+	0xfc, 0xb1, 0x1b, 0x81, 0x88, 0x22, 0xc1, 0x33, 0x9b, 0xbd, 0x47, 0xb9, 0x06, 0xc9, 0x09, 0xcb,
+	0x71, 0x63, 0xd1, 0xc3, 0xf9, 0x63, 0xa1, 0xe3, 0x3e, 0x9f, 0x12, 0x46, 0x43, 0xa2, 0xa1, 0x23,
+	0x18, 0x1b, 0x80, 0x26, 0x1d, 0x12, 0x4c, 0xc0, 0x85, 0x9b, 0x04, 0x94, 0xc6, 0xe7, 0x68, 0xc7,
+	0x27, 0x0a, 0x0e, 0xad, 0x13, 0xeb, 0xac, 0xde, 0x7e, 0xdd, 0x2c, 0x28, 0xe6, 0x52, 0x03, 0x35,
+	0xbe, 0x20, 0x0a, 0x5c, 0xc3, 0xc4, 0x07, 0xe8, 0x49, 0xe8, 0xff, 0xe4, 0x24, 0x82, 0xc3, 0x47,
+	0x27, 0xd6, 0xd9, 0x53, 0xb7, 0x16, 0xfa, 0x5f, 0x48, 0x04, 0xf8, 0x1d, 0x7a, 0x16, 0x08, 0xc6,
+	0x20, 0xd0, 0x54, 0xf0, 0x8c, 0xb0, 0x6d, 0x08, 0x7b, 0x77, 0xe6, 0x94, 0xe8, 0xfc, 0xb6, 0xd0,
+	0xb1, 0x0b, 0x0c, 0x88, 0x82, 0xee, 0xf0, 0x72, 0x00, 0x4a, 0x91, 0x31, 0x78, 0x5a, 0x02, 0x89,
+	0xee, 0x9f, 0x16, 0x46, 0x3b, 0xa1, 0xdf, 0xef, 0x9a, 0x9c, 0xb6, 0x5d, 0xf3, 0x8d, 0x1d, 0xd4,
+	0xb8, 0x93, 0xee, 0x77, 0x4d, 0x3a, 0xdb, 0x6e, 0xc1, 0xe6, 0x5c, 0x23, 0x7b, 0xe1, 0x89, 0x24,
+	0x84, 0x0f, 0x7c, 0x1e, 0x1b, 0xed, 0x26, 0x0a, 0xe4, 0xc2, 0xfb, 0xcc, 0xb1, 0x33, 0x44, 0x47,
+	0x2e, 0x8c, 0x24, 0xa8, 0xc9, 0x95, 0x60, 0x34, 0xb8, 0xed, 0xf3, 0x91, 0x78, 0x98, 0x9c, 0x33,
+	0x45, 0xd8, 0x03, 0xdd, 0x1d, 0x5c, 0x0e, 0x13, 0xa1, 0xc9, 0xfd, 0xd3, 0xde, 0x47, 0xb5, 0x11,
+	0x09, 0xb4, 0x90, 0x26, 0x69, 0xcb, 0xcd, 0x51, 0x6a, 0x97, 0x40, 0x94, 0xe0, 0x79, 0x2f, 0x73,
+	0xd4, 0xfe, 0xbb, 0x8b, 0x1e, 0x5f, 0xa5, 0x03, 0x89, 0x63, 0x84, 0x7b, 0xa0, 0x3b, 0x22, 0x8a,
+	0x05, 0x07, 0xae, 0x3d, 0x4d, 0x34, 0x28, 0x7c, 0x5e, 0xd4, 0x9c, 0x8f, 0xe9, 0x2a, 0x35, 0xcf,
+	0xd9, 0x3e, 0x2d, 0xf1, 0x58, 0xa2, 0x3b, 0x5b, 0xf8, 0x06, 0xbd, 0xe8, 0x81, 0x81, 0x54, 0x69,
+	0x1a, 0xa8, 0xce, 0x84, 0x70, 0x0e, 0x0c, 0xb7, 0xcb, 0x35, 0x57, 0xc8, 0x33, 0xd5, 0x37, 0x45,
+	0x9f, 0x1c, 0x78, 0x5a, 0x52, 0x3e, 0x76, 0x41, 0xc5, 0x82, 0x2b, 0x70, 0xb6, 0xb0, 0x44, 0x47,
+	0xc5, 0x45, 0xca, 0xe6, 0x67, 0xbe, 0x4e, 0xcb, 0xda, 0xd9, 0x16, 0x6f, 0xde, 0x3d, 0xfb, 0xd5,
+	0xda, 0xbe, 0xa4, 0xa9, 0x26, 0x69, 0x99, 0x04, 0x35, 0x7a, 0xa0, 0xbb, 0xe1, 0xac, 0xbc, 0xf7,
+	0xe5, 0xe5, 0xcd, 0x49, 0xff, 0x59, 0x16, 0x43, 0x07, 0x25, 0x8b, 0xb8, 0xbe, 0xa0, 0xcd, 0x5b,
+	0x5b, 0x55, 0xd0, 0x37, 0xf4, 0xdc, 0x03, 0x1e, 0x7a, 0x40, 0x64, 0x30, 0x71, 0x41, 0x25, 0x4c,
+	0xe3, 0xb7, 0x25, 0x45, 0x2d, 0x92, 0x54, 0x55, 0xe0, 0x1f, 0xe9, 0x12, 0xf0, 0xd0, 0x05, 0x2d,
+	0x29, 0x4c, 0x21, 0x0f, 0x5d, 0x36, 0x50, 0x45, 0x5a, 0x65, 0xf0, 0x6b, 0xf4, 0xb2, 0x78, 0x20,
+	0x80, 0x6b, 0x4a, 0x58, 0xd6, 0xf6, 0x66, 0x45, 0xdb, 0x97, 0xee, 0x49, 0xb5, 0xd6, 0xfe, 0xfa,
+	0x03, 0x81, 0x3f, 0xae, 0x6f, 0xc7, 0x86, 0x63, 0x52, 0xa5, 0xf5, 0x15, 0xd5, 0x17, 0x2e, 0x07,
+	0x3e, 0x5d, 0x27, 0xb0, 0x7a, 0x5a, 0x2a, 0xa2, 0x5e, 0x7c, 0xfe, 0xde, 0x1e, 0x53, 0x3d, 0x49,
+	0xfc, 0xf4, 0x4f, 0x2b, 0xa3, 0x7e, 0xa0, 0x22, 0xff, 0x6a, 0xcd, 0x9a, 0xd0, 0x32, 0xde, 0x2d,
+	0xa3, 0x12, 0xfb, 0x7e, 0xcd, 0xc0, 0x4f, 0xff, 0x06, 0x00, 0x46, 0xe8, 0x95, 0xec, 0xfe, 0x06,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -196,6 +350,9 @@ type ProxyClient interface {
 	ReleaseDQLMessageStream(ctx context.Context, in *ReleaseDQLMessageStreamRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SendSearchResult(ctx context.Context, in *internalpb.SearchResults, opts ...grpc.CallOption) (*commonpb.Status, error)
 	SendRetrieveResult(ctx context.Context, in *internalpb.RetrieveResults, opts ...grpc.CallOption) (*commonpb.Status, error)
+	InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	SetDMLQuota(ctx context.Context, in *SetDMLQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 }
 
 type proxyClient struct {
@@ -269,6 +426,33 @@ func (c *proxyClient) SendRetrieveResult(ctx context.Context, in *internalpb.Ret
 	return out, nil
 }
 
+func (c *proxyClient) InvalidateCredentialCache(ctx context.Context, in *InvalidateCredCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/InvalidateCredentialCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) RefreshPolicyInfoCache(ctx context.Context, in *RefreshPolicyInfoCacheRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *proxyClient) SetDMLQuota(ctx context.Context, in *SetDMLQuotaRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.proxy.Proxy/SetDMLQuota", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ProxyServer is the server API for Proxy service.
 type ProxyServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -278,6 +462,9 @@ type ProxyServer interface {
 	ReleaseDQLMessageStream(context.Context, *ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
 	SendSearchResult(context.Context, *internalpb.SearchResults) (*commonpb.Status, error)
 	SendRetrieveResult(context.Context, *internalpb.RetrieveResults) (*commonpb.Status, error)
+	InvalidateCredentialCache(context.Context, *InvalidateCredCacheRequest) (*commonpb.Status, error)
+	RefreshPolicyInfoCache(context.Context, *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
+	SetDMLQuota(context.Context, *SetDMLQuotaRequest) (*commonpb.Status, error)
 }
 
 // UnimplementedProxyServer can be embedded to have forward compatible implementations.
@@ -305,6 +492,15 @@ func (*UnimplementedProxyServer) SendSearchResult(ctx context.Context, req *inte
 func (*UnimplementedProxyServer) SendRetrieveResult(ctx context.Context, req *internalpb.RetrieveResults) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendRetrieveResult not implemented")
 }
+func (*UnimplementedProxyServer) InvalidateCredentialCache(ctx context.Context, req *InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InvalidateCredentialCache not implemented")
+}
+func (*UnimplementedProxyServer) RefreshPolicyInfoCache(ctx context.Context, req *RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RefreshPolicyInfoCache not implemented")
+}
+func (*UnimplementedProxyServer) SetDMLQuota(ctx context.Context, req *SetDMLQuotaRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDMLQuota not implemented")
+}
 
 func RegisterProxyServer(s *grpc.Server, srv ProxyServer) {
 	s.RegisterService(&_Proxy_serviceDesc, srv)
@@ -436,6 +632,60 @@ func _Proxy_SendRetrieveResult_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Proxy_InvalidateCredentialCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InvalidateCredCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCredentialCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).InvalidateCredentialCache(ctx, req.(*InvalidateCredCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_RefreshPolicyInfoCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshPolicyInfoCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/RefreshPolicyInfoCache",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).RefreshPolicyInfoCache(ctx, req.(*RefreshPolicyInfoCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Proxy_SetDMLQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDMLQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ProxyServer).SetDMLQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.proxy.Proxy/SetDMLQuota",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ProxyServer).SetDMLQuota(ctx, req.(*SetDMLQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Proxy_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.proxy.Proxy",
 	HandlerType: (*ProxyServer)(nil),
@@ -468,6 +718,18 @@ var _Proxy_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SendRetrieveResult",
 			Handler:    _Proxy_SendRetrieveResult_Handler,
 		},
+		{
+			MethodName: "InvalidateCredentialCache",
+			Handler:    _Proxy_InvalidateCredentialCache_Handler,
+		},
+		{
+			MethodName: "RefreshPolicyInfoCache",
+			Handler:    _Proxy_RefreshPolicyInfoCache_Handler,
+		},
+		{
+			MethodName: "SetDMLQuota",
+			Handler:    _Proxy_SetDMLQuota_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "proxy.proto",
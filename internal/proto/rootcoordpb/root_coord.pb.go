@@ -233,69 +233,193 @@ func (m *AllocIDResponse) GetCount() uint32 {
 	return 0
 }
 
+type GetCredentialRequest struct {
+	Base                 *commonpb.MsgBase `protobuf:"bytes,1,opt,name=base,proto3" json:"base,omitempty"`
+	Username             string            `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetCredentialRequest) Reset()         { *m = GetCredentialRequest{} }
+func (m *GetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialRequest) ProtoMessage()    {}
+func (*GetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{4}
+}
+
+func (m *GetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialRequest.Unmarshal(m, b)
+}
+func (m *GetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialRequest.Merge(m, src)
+}
+func (m *GetCredentialRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialRequest.Size(m)
+}
+func (m *GetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialRequest proto.InternalMessageInfo
+
+func (m *GetCredentialRequest) GetBase() *commonpb.MsgBase {
+	if m != nil {
+		return m.Base
+	}
+	return nil
+}
+
+func (m *GetCredentialRequest) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+type GetCredentialResponse struct {
+	Status   *commonpb.Status `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Username string           `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// hashed password
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCredentialResponse) Reset()         { *m = GetCredentialResponse{} }
+func (m *GetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCredentialResponse) ProtoMessage()    {}
+func (*GetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4513485a144f6b06, []int{5}
+}
+
+func (m *GetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCredentialResponse.Unmarshal(m, b)
+}
+func (m *GetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCredentialResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCredentialResponse.Merge(m, src)
+}
+func (m *GetCredentialResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCredentialResponse.Size(m)
+}
+func (m *GetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCredentialResponse proto.InternalMessageInfo
+
+func (m *GetCredentialResponse) GetStatus() *commonpb.Status {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *GetCredentialResponse) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
+}
+
+func (m *GetCredentialResponse) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*AllocTimestampRequest)(nil), "milvus.proto.rootcoord.AllocTimestampRequest")
 	proto.RegisterType((*AllocTimestampResponse)(nil), "milvus.proto.rootcoord.AllocTimestampResponse")
 	proto.RegisterType((*AllocIDRequest)(nil), "milvus.proto.rootcoord.AllocIDRequest")
 	proto.RegisterType((*AllocIDResponse)(nil), "milvus.proto.rootcoord.AllocIDResponse")
+	proto.RegisterType((*GetCredentialRequest)(nil), "milvus.proto.rootcoord.GetCredentialRequest")
+	proto.RegisterType((*GetCredentialResponse)(nil), "milvus.proto.rootcoord.GetCredentialResponse")
 }
 
 func init() { proto.RegisterFile("root_coord.proto", fileDescriptor_4513485a144f6b06) }
 
 var fileDescriptor_4513485a144f6b06 = []byte{
-	// 826 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xdb, 0x4f, 0xe3, 0x46,
-	0x14, 0xc6, 0x49, 0xa0, 0x54, 0x1c, 0x72, 0x41, 0x23, 0xa0, 0x28, 0xe5, 0x81, 0xa6, 0x2a, 0x24,
-	0x5c, 0x1c, 0x04, 0x52, 0xd5, 0x57, 0x48, 0x54, 0x88, 0xd4, 0x48, 0xc5, 0x01, 0x69, 0x6f, 0x28,
-	0x9a, 0x38, 0x47, 0x89, 0x85, 0xed, 0x31, 0x9e, 0xc9, 0xc2, 0x3e, 0xee, 0x5f, 0xb6, 0xff, 0xda,
-	0xca, 0x97, 0x71, 0x6c, 0xc7, 0x0e, 0x8e, 0x76, 0xdf, 0xe2, 0xf8, 0x37, 0xdf, 0x37, 0xe7, 0x3b,
-	0x1e, 0xfb, 0xc0, 0x96, 0xc3, 0x98, 0x18, 0x68, 0x8c, 0x39, 0x23, 0xc5, 0x76, 0x98, 0x60, 0x64,
-	0xd7, 0xd4, 0x8d, 0xcf, 0x53, 0xee, 0x5f, 0x29, 0xee, 0x6d, 0xef, 0x6e, 0xad, 0xa4, 0x31, 0xd3,
-	0x64, 0x96, 0xff, 0x7f, 0xad, 0x14, 0xa5, 0x6a, 0x15, 0xdd, 0x12, 0xe8, 0x58, 0xd4, 0x08, 0xae,
-	0x37, 0x6d, 0x87, 0xbd, 0x7e, 0x09, 0x2e, 0xb6, 0x46, 0x54, 0xd0, 0xa8, 0x45, 0x7d, 0x00, 0x3b,
-	0x57, 0x86, 0xc1, 0xb4, 0x7b, 0xdd, 0x44, 0x2e, 0xa8, 0x69, 0xab, 0xf8, 0x3c, 0x45, 0x2e, 0xc8,
-	0x39, 0xac, 0x0d, 0x29, 0xc7, 0xbd, 0xc2, 0x41, 0xa1, 0xb1, 0x79, 0xb1, 0xaf, 0xc4, 0xb6, 0x12,
-	0xf8, 0xf7, 0xf8, 0xf8, 0x9a, 0x72, 0x54, 0x3d, 0x92, 0x6c, 0xc3, 0x2f, 0x1a, 0x9b, 0x5a, 0x62,
-	0x6f, 0xf5, 0xa0, 0xd0, 0x28, 0xab, 0xfe, 0x45, 0xfd, 0x6b, 0x01, 0x76, 0x93, 0x0e, 0xdc, 0x66,
-	0x16, 0x47, 0x72, 0x09, 0xeb, 0x5c, 0x50, 0x31, 0xe5, 0x81, 0xc9, 0xef, 0xa9, 0x26, 0x7d, 0x0f,
-	0x51, 0x03, 0x94, 0xec, 0xc3, 0x86, 0x90, 0x4a, 0x7b, 0xc5, 0x83, 0x42, 0x63, 0x4d, 0x9d, 0xfd,
-	0x91, 0xb1, 0x87, 0x77, 0x50, 0xf1, 0xb6, 0xd0, 0xed, 0xfc, 0x84, 0xea, 0x8a, 0x51, 0x65, 0x03,
-	0xaa, 0xa1, 0xf2, 0x8f, 0x54, 0x55, 0x81, 0x62, 0xb7, 0xe3, 0x49, 0xaf, 0xaa, 0xc5, 0x6e, 0x27,
-	0xbd, 0x8e, 0x8b, 0x6f, 0xdb, 0xb0, 0xa1, 0x32, 0x26, 0xda, 0x6e, 0x03, 0x89, 0x0d, 0xe4, 0x06,
-	0x45, 0x9b, 0x99, 0x36, 0xb3, 0xd0, 0x12, 0xae, 0x22, 0x72, 0x72, 0x1e, 0xb7, 0x0b, 0x9f, 0x86,
-	0x79, 0x34, 0xc8, 0xa2, 0x76, 0x98, 0xb1, 0x22, 0x81, 0xd7, 0x57, 0x88, 0xe9, 0x39, 0xba, 0x8d,
-	0xbc, 0xd7, 0xb5, 0xa7, 0xf6, 0x84, 0x5a, 0x16, 0x1a, 0x8b, 0x1c, 0x13, 0xa8, 0x74, 0xfc, 0x33,
-	0xbe, 0x22, 0xb8, 0xe8, 0x0b, 0x47, 0xb7, 0xc6, 0x32, 0xc7, 0xfa, 0x0a, 0x79, 0x86, 0xed, 0x1b,
-	0xf4, 0xdc, 0x75, 0x2e, 0x74, 0x8d, 0x4b, 0xc3, 0x8b, 0x6c, 0xc3, 0x39, 0x78, 0x49, 0xcb, 0x01,
-	0x6c, 0xb5, 0x1d, 0xa4, 0x02, 0xdb, 0xcc, 0x30, 0x50, 0x13, 0x3a, 0xb3, 0xc8, 0x69, 0xea, 0xd2,
-	0x24, 0x26, 0x8d, 0x16, 0xb5, 0xbb, 0xbe, 0x42, 0x3e, 0x42, 0xa5, 0xe3, 0x30, 0x3b, 0x22, 0x7f,
-	0x9c, 0x2a, 0x1f, 0x87, 0x72, 0x8a, 0x0f, 0xa0, 0x7c, 0x4b, 0x79, 0x44, 0xbb, 0x99, 0xaa, 0x1d,
-	0x63, 0xa4, 0xf4, 0x1f, 0xa9, 0xe8, 0x35, 0x63, 0x46, 0x24, 0x9e, 0x17, 0x20, 0x1d, 0xe4, 0x9a,
-	0xa3, 0x0f, 0xa3, 0x01, 0x29, 0xe9, 0x15, 0xcc, 0x81, 0xd2, 0xaa, 0x95, 0x9b, 0x0f, 0x8d, 0x1f,
-	0x60, 0xd3, 0x0f, 0xfc, 0xca, 0xd0, 0x29, 0x27, 0x47, 0x0b, 0x5a, 0xe2, 0x11, 0x39, 0x03, 0xbb,
-	0x83, 0x0d, 0x37, 0x68, 0x5f, 0xf4, 0xaf, 0xcc, 0x46, 0x2c, 0x23, 0xd9, 0x07, 0xb8, 0x32, 0x04,
-	0x3a, 0xbe, 0xe6, 0x61, 0xaa, 0xe6, 0x0c, 0xc8, 0x29, 0x6a, 0x41, 0xb5, 0x3f, 0x61, 0x2f, 0xb3,
-	0x68, 0x38, 0x39, 0x49, 0x7f, 0xa0, 0xe3, 0x94, 0x94, 0x3f, 0xcd, 0x07, 0x87, 0x71, 0x3f, 0x42,
-	0xd5, 0x0f, 0xf3, 0x7f, 0xea, 0x08, 0xdd, 0x6b, 0xf2, 0xc9, 0x82, 0xc8, 0x43, 0x2a, 0x67, 0x39,
-	0xef, 0xa1, 0xec, 0xc6, 0x3a, 0x13, 0x6f, 0x66, 0x46, 0xbf, 0xac, 0xf4, 0x23, 0x94, 0x6e, 0x29,
-	0x9f, 0x29, 0x37, 0xb2, 0x4e, 0xc0, 0x9c, 0x70, 0xae, 0x03, 0xf0, 0x04, 0x15, 0x37, 0xb5, 0x70,
-	0x31, 0xcf, 0x38, 0xbe, 0x71, 0x48, 0x5a, 0x9c, 0xe4, 0x62, 0x43, 0x33, 0x0b, 0xaa, 0xf2, 0x50,
-	0xf4, 0x71, 0x6c, 0xa2, 0x25, 0x32, 0xba, 0x90, 0xa0, 0x16, 0x77, 0x7d, 0x0e, 0x0e, 0xfd, 0x10,
-	0x4a, 0xee, 0x5e, 0x82, 0x1b, 0x3c, 0x23, 0xbb, 0x28, 0x22, 0x9d, 0x9a, 0x39, 0xc8, 0xf9, 0xb3,
-	0xdc, 0xb5, 0x46, 0xf8, 0xba, 0xf0, 0x2c, 0x7b, 0x44, 0xce, 0xce, 0x4f, 0xa0, 0x2c, 0x4b, 0xf3,
-	0x85, 0x9b, 0x0b, 0xcb, 0x8f, 0x49, 0x1f, 0xe7, 0x41, 0xc3, 0x02, 0x82, 0xb7, 0x86, 0xef, 0x92,
-	0xfd, 0xd6, 0x58, 0x66, 0xf3, 0xcf, 0xc1, 0x84, 0x12, 0x0e, 0x49, 0xe4, 0x4c, 0x49, 0x1f, 0xfe,
-	0x94, 0xd4, 0x71, 0xad, 0xa6, 0xe4, 0xc5, 0xc3, 0x2a, 0x3e, 0xc1, 0xaf, 0xc1, 0xe8, 0x92, 0x7c,
-	0x4b, 0x25, 0x16, 0x87, 0x53, 0x53, 0xed, 0xe8, 0x4d, 0x2e, 0x54, 0xa7, 0xb0, 0xf3, 0x60, 0x8f,
-	0xdc, 0x2f, 0xa4, 0xff, 0x1d, 0x96, 0x93, 0x40, 0xb2, 0x2b, 0xb3, 0x69, 0x23, 0xce, 0xf5, 0xf8,
-	0xf8, 0xad, 0xcc, 0x0c, 0xf8, 0x4d, 0x45, 0x03, 0x29, 0xc7, 0xce, 0xdd, 0x7f, 0x3d, 0xe4, 0x9c,
-	0x8e, 0xb1, 0x2f, 0x1c, 0xa4, 0x66, 0x72, 0x42, 0xf0, 0x47, 0xe0, 0x0c, 0x38, 0x67, 0x87, 0x34,
-	0xd8, 0x09, 0x9e, 0xe5, 0x7f, 0x8d, 0x29, 0x9f, 0xb8, 0xc3, 0x91, 0x81, 0x02, 0x47, 0xc9, 0x23,
-	0xe9, 0x4e, 0xd8, 0x4a, 0x2a, 0x99, 0xa3, 0xa4, 0x01, 0xc0, 0x0d, 0x8a, 0x1e, 0x0a, 0x47, 0xd7,
-	0xb2, 0x3e, 0x1e, 0x33, 0x20, 0xa3, 0x2d, 0x29, 0x9c, 0x6c, 0xcb, 0xf5, 0x3f, 0x1f, 0xfe, 0x1e,
-	0xeb, 0x62, 0x32, 0x1d, 0xba, 0xd6, 0x2d, 0x9f, 0x3c, 0xd3, 0x59, 0xf0, 0xab, 0x25, 0xbb, 0xd1,
-	0xf2, 0x94, 0x5a, 0x61, 0x83, 0xed, 0xe1, 0x70, 0xdd, 0xfb, 0xeb, 0xf2, 0x7b, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x27, 0xad, 0x32, 0xbe, 0xa6, 0x0c, 0x00, 0x00,
+	// 1122 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x97, 0xdd, 0x6f, 0xdb, 0x36,
+	0x17, 0xc6, 0xe3, 0xb4, 0x6f, 0xdf, 0xfa, 0xe4, 0xcb, 0x20, 0x9a, 0x2e, 0xf0, 0x7a, 0x91, 0x79,
+	0x6d, 0x9a, 0x4f, 0xa7, 0x48, 0x81, 0x61, 0xb7, 0x49, 0x8c, 0xa6, 0x01, 0x1a, 0xb4, 0x95, 0x1b,
+	0x2c, 0xdb, 0x1a, 0x18, 0x8c, 0x75, 0x66, 0x0b, 0x91, 0x45, 0x85, 0xa4, 0x93, 0xee, 0x72, 0xc0,
+	0xfe, 0xe5, 0xdd, 0x0f, 0xd4, 0x07, 0x2d, 0xc9, 0xa2, 0x42, 0xaf, 0xbd, 0xb3, 0xac, 0x9f, 0x9e,
+	0x87, 0x3c, 0xe7, 0xe8, 0xe8, 0x10, 0x1a, 0x9c, 0x31, 0xd9, 0xeb, 0x33, 0xc6, 0xdd, 0x76, 0xc8,
+	0x99, 0x64, 0xe4, 0xe9, 0xc8, 0xf3, 0x6f, 0xc7, 0x22, 0xbe, 0x6a, 0xab, 0xdb, 0xd1, 0xdd, 0xe6,
+	0x62, 0x9f, 0x8d, 0x46, 0x2c, 0x88, 0xff, 0x6f, 0x2e, 0x66, 0xa9, 0xe6, 0xb2, 0x17, 0x48, 0xe4,
+	0x01, 0xf5, 0x93, 0xeb, 0x85, 0x90, 0xb3, 0x2f, 0x7f, 0x26, 0x17, 0x0d, 0x97, 0x4a, 0x9a, 0xb5,
+	0x68, 0xf5, 0x60, 0xf5, 0xd0, 0xf7, 0x59, 0xff, 0x93, 0x37, 0x42, 0x21, 0xe9, 0x28, 0x74, 0xf0,
+	0x66, 0x8c, 0x42, 0x92, 0x57, 0xf0, 0xf0, 0x8a, 0x0a, 0x5c, 0xab, 0xad, 0xd7, 0x36, 0x17, 0x0e,
+	0x9e, 0xb5, 0x73, 0x4b, 0x49, 0xfc, 0xcf, 0xc4, 0xe0, 0x88, 0x0a, 0x74, 0x22, 0x92, 0x3c, 0x81,
+	0xff, 0xf5, 0xd9, 0x38, 0x90, 0x6b, 0x0f, 0xd6, 0x6b, 0x9b, 0x4b, 0x4e, 0x7c, 0xd1, 0xfa, 0xab,
+	0x06, 0x4f, 0x8b, 0x0e, 0x22, 0x64, 0x81, 0x40, 0xf2, 0x1a, 0x1e, 0x09, 0x49, 0xe5, 0x58, 0x24,
+	0x26, 0xdf, 0x97, 0x9a, 0x74, 0x23, 0xc4, 0x49, 0x50, 0xf2, 0x0c, 0xea, 0x32, 0x55, 0x5a, 0x9b,
+	0x5f, 0xaf, 0x6d, 0x3e, 0x74, 0x26, 0x7f, 0x18, 0xd6, 0x70, 0x01, 0xcb, 0xd1, 0x12, 0x4e, 0x3b,
+	0xdf, 0x60, 0x77, 0xf3, 0x59, 0x65, 0x1f, 0x56, 0xb4, 0xf2, 0xd7, 0xec, 0x6a, 0x19, 0xe6, 0x4f,
+	0x3b, 0x91, 0xf4, 0x03, 0x67, 0xfe, 0xb4, 0x63, 0xd8, 0x87, 0x0b, 0x4f, 0x4e, 0x50, 0x1e, 0x73,
+	0x74, 0x31, 0x90, 0x1e, 0xf5, 0xff, 0xfb, 0x6e, 0x9a, 0xf0, 0x78, 0x2c, 0x54, 0x99, 0x8c, 0x30,
+	0x72, 0xad, 0x3b, 0xfa, 0xba, 0xf5, 0x77, 0x0d, 0x56, 0x0b, 0x36, 0x5f, 0xb3, 0xb5, 0x0a, 0x2b,
+	0x75, 0x2f, 0xa4, 0x42, 0xdc, 0x31, 0xee, 0x46, 0x3b, 0xad, 0x3b, 0xfa, 0xfa, 0xe0, 0x9f, 0x75,
+	0xa8, 0x3b, 0x8c, 0xc9, 0x63, 0x55, 0xad, 0x24, 0x04, 0xa2, 0xd6, 0xc4, 0x46, 0x21, 0x0b, 0x30,
+	0x90, 0xca, 0x03, 0x05, 0x79, 0x95, 0x5f, 0x80, 0x2e, 0xfd, 0x69, 0x34, 0x09, 0x55, 0x73, 0xc3,
+	0xf0, 0x44, 0x01, 0x6f, 0xcd, 0x91, 0x51, 0xe4, 0xa8, 0xaa, 0xf6, 0x93, 0xd7, 0xbf, 0x3e, 0x1e,
+	0xd2, 0x20, 0x40, 0xbf, 0xca, 0xb1, 0x80, 0xa6, 0x8e, 0x3f, 0xe6, 0x9f, 0x48, 0x2e, 0xba, 0x92,
+	0x7b, 0xc1, 0x20, 0x8d, 0x6c, 0x6b, 0x8e, 0xdc, 0x44, 0xb9, 0x55, 0xee, 0x9e, 0x90, 0x5e, 0x5f,
+	0xa4, 0x86, 0x07, 0x66, 0xc3, 0x29, 0x78, 0x46, 0xcb, 0x1e, 0x34, 0x8e, 0x39, 0x52, 0x89, 0xc7,
+	0xcc, 0xf7, 0xb1, 0x2f, 0x3d, 0x16, 0x90, 0xdd, 0xd2, 0x47, 0x8b, 0x58, 0x6a, 0x54, 0x55, 0x00,
+	0xad, 0x39, 0xf2, 0x3b, 0x2c, 0x77, 0x38, 0x0b, 0x33, 0xf2, 0xdb, 0xa5, 0xf2, 0x79, 0xc8, 0x52,
+	0xbc, 0x07, 0x4b, 0x6f, 0xa9, 0xc8, 0x68, 0x6f, 0x95, 0x6a, 0xe7, 0x98, 0x54, 0xfa, 0x87, 0x52,
+	0xf4, 0x88, 0x31, 0x3f, 0x13, 0x9e, 0x3b, 0x20, 0x1d, 0x14, 0x7d, 0xee, 0x5d, 0x65, 0x03, 0xd4,
+	0x2e, 0xdf, 0xc1, 0x14, 0x98, 0x5a, 0xed, 0x5b, 0xf3, 0xda, 0xf8, 0x1c, 0x16, 0xe2, 0x80, 0x1f,
+	0xfa, 0x1e, 0x15, 0xe4, 0x65, 0x45, 0x4a, 0x22, 0xc2, 0x32, 0x60, 0x1f, 0xa1, 0xae, 0x02, 0x1d,
+	0x8b, 0xbe, 0x30, 0x26, 0x62, 0x16, 0xc9, 0x2e, 0xc0, 0xa1, 0x2f, 0x91, 0xc7, 0x9a, 0x1b, 0xa5,
+	0x9a, 0x13, 0xc0, 0x3a, 0xb1, 0x0d, 0x07, 0x55, 0x7b, 0xb8, 0xb7, 0x2c, 0x8b, 0x98, 0xa5, 0xc1,
+	0xa5, 0x6a, 0xda, 0x12, 0x79, 0x46, 0x7f, 0xc7, 0xbc, 0xf4, 0x99, 0xe5, 0xdf, 0xc3, 0xe3, 0x43,
+	0xd7, 0x7d, 0xe3, 0xa1, 0xef, 0x92, 0xe7, 0xe5, 0xba, 0xc9, 0x6d, 0xfb, 0xd7, 0x28, 0xce, 0x76,
+	0x87, 0x4a, 0x1a, 0xb5, 0xef, 0xed, 0x8a, 0x92, 0x48, 0x21, 0x4b, 0xf1, 0x5f, 0x60, 0x51, 0x65,
+	0x5d, 0x4b, 0x6f, 0x1a, 0x0b, 0x63, 0x46, 0xe1, 0x21, 0x2c, 0xbd, 0xf3, 0x84, 0x4c, 0x9f, 0x12,
+	0x86, 0xf7, 0x33, 0xc7, 0xa4, 0xd2, 0xdb, 0x36, 0xa8, 0x7e, 0x5f, 0x02, 0x58, 0xe9, 0x0e, 0xd9,
+	0xdd, 0x24, 0x51, 0xc2, 0x90, 0xcf, 0x02, 0x95, 0xba, 0xed, 0xda, 0xc1, 0xda, 0xef, 0x12, 0x56,
+	0xe2, 0x50, 0x7f, 0xa0, 0x5c, 0x7a, 0x15, 0xf5, 0x53, 0xa0, 0x2c, 0x03, 0xf7, 0x2b, 0x2c, 0xa9,
+	0x70, 0x4f, 0xc4, 0xb7, 0x8c, 0x29, 0x99, 0x55, 0xfa, 0x12, 0x16, 0xdf, 0x52, 0x31, 0x51, 0xde,
+	0x34, 0xb5, 0xcc, 0x29, 0x61, 0xab, 0x8e, 0x79, 0x0d, 0xcb, 0x2a, 0x6a, 0xfa, 0x61, 0x61, 0x28,
+	0xd4, 0x3c, 0x94, 0x5a, 0xec, 0x58, 0xb1, 0xd9, 0xac, 0xa7, 0x5d, 0xb4, 0x8b, 0x83, 0x11, 0x06,
+	0xd2, 0x90, 0x85, 0x02, 0x55, 0x9d, 0xf5, 0x29, 0x58, 0xfb, 0x21, 0x2c, 0xaa, 0xb5, 0x24, 0x37,
+	0x84, 0x21, 0x76, 0x59, 0x24, 0x75, 0xda, 0xb2, 0x20, 0xa7, 0x9b, 0xff, 0x69, 0xe0, 0xe2, 0x97,
+	0xca, 0xe6, 0x1f, 0x11, 0xf6, 0x6f, 0x63, 0xba, 0xb5, 0x58, 0x78, 0xab, 0x72, 0xfb, 0x39, 0xe9,
+	0x6d, 0x1b, 0x54, 0x6f, 0x20, 0xf9, 0xcc, 0xc4, 0x2e, 0xe6, 0xcf, 0xcc, 0x2c, 0x8b, 0xbf, 0x49,
+	0xe6, 0x77, 0x7d, 0x84, 0x20, 0x7b, 0xed, 0xf2, 0xa3, 0x51, 0xbb, 0xf4, 0x30, 0xd3, 0x6c, 0xdb,
+	0xe2, 0x7a, 0x17, 0x9f, 0xe1, 0xff, 0xc9, 0x60, 0x4f, 0x36, 0x2a, 0x1f, 0xd6, 0x67, 0x8a, 0xe6,
+	0xcb, 0x7b, 0x39, 0xad, 0x4e, 0x61, 0xf5, 0x3c, 0x74, 0xd5, 0x48, 0x15, 0x0f, 0x6e, 0xe9, 0xe8,
+	0x48, 0xb6, 0x0c, 0xd3, 0x5e, 0x81, 0x3b, 0x13, 0x83, 0xfb, 0x62, 0xe6, 0xc3, 0x77, 0x0e, 0xfa,
+	0x48, 0x05, 0x76, 0x3e, 0xbe, 0x3b, 0x43, 0x21, 0xe8, 0x00, 0xbb, 0x92, 0x23, 0x1d, 0x15, 0x47,
+	0xca, 0xf8, 0x80, 0x68, 0x80, 0x2d, 0x33, 0xd4, 0x87, 0xd5, 0xa4, 0x96, 0xdf, 0xf8, 0x63, 0x31,
+	0x54, 0xd3, 0xb4, 0x8f, 0x12, 0xdd, 0xe2, 0x2b, 0xa9, 0xce, 0x9f, 0xed, 0x52, 0xd2, 0x62, 0x4b,
+	0x3d, 0x80, 0x13, 0x94, 0x67, 0x28, 0xb9, 0xd7, 0x37, 0x4d, 0x1b, 0x13, 0xc0, 0x90, 0x96, 0x12,
+	0x4e, 0xa7, 0xe5, 0x42, 0x0f, 0xc4, 0xfa, 0xec, 0x43, 0x5e, 0x98, 0x32, 0xa2, 0x91, 0xd3, 0xe0,
+	0x0f, 0x76, 0xdf, 0xd2, 0x2f, 0xa0, 0x91, 0x24, 0xfc, 0x5b, 0x2b, 0xf7, 0xa0, 0xd1, 0x41, 0x15,
+	0xc1, 0x8c, 0xb2, 0xa9, 0xb5, 0xe5, 0x31, 0xcb, 0xd4, 0x7e, 0x86, 0xba, 0xfa, 0xf0, 0x9e, 0x0b,
+	0xe4, 0xa6, 0xb1, 0x51, 0xdf, 0x37, 0x9c, 0xb2, 0xa6, 0xb1, 0x4c, 0x17, 0x5f, 0xca, 0x9d, 0x35,
+	0xc9, 0xae, 0xe9, 0x2d, 0x2a, 0x3b, 0xf9, 0x36, 0xf7, 0x2c, 0x69, 0xed, 0xd7, 0x05, 0x88, 0x53,
+	0xec, 0x30, 0x1f, 0x0d, 0x35, 0x34, 0x01, 0xec, 0x27, 0x3e, 0xd5, 0xd2, 0x22, 0xc9, 0xe7, 0xc6,
+	0x8e, 0x37, 0x83, 0xe0, 0x25, 0xac, 0xbc, 0x0f, 0x91, 0x53, 0x89, 0x2a, 0x5e, 0x91, 0x6e, 0xf9,
+	0xb7, 0xad, 0x40, 0xd9, 0x0f, 0x94, 0x27, 0x9c, 0x06, 0xf2, 0x03, 0xf7, 0x6e, 0x3d, 0x1f, 0x07,
+	0xa6, 0x81, 0x32, 0x0f, 0xd9, 0xaf, 0xdd, 0xc1, 0x5b, 0x76, 0x8d, 0x13, 0xf5, 0x1d, 0xc3, 0xf4,
+	0x9e, 0xa3, 0xac, 0x4f, 0x07, 0xa0, 0xea, 0x28, 0x5a, 0x9a, 0xa9, 0x09, 0x4c, 0x80, 0xea, 0x26,
+	0x90, 0xe5, 0xd2, 0x0a, 0x39, 0xfa, 0xf9, 0xb7, 0x9f, 0x06, 0x9e, 0x1c, 0x8e, 0xaf, 0x94, 0xf5,
+	0x7e, 0x4c, 0xee, 0x79, 0x2c, 0xf9, 0xb5, 0x9f, 0xbe, 0xa6, 0xfb, 0x91, 0xd2, 0xbe, 0xae, 0xb8,
+	0xf0, 0xea, 0xea, 0x51, 0xf4, 0xd7, 0xeb, 0x7f, 0x07, 0x00, 0xa4, 0x62, 0x09, 0xa1, 0xc9, 0x13,
+	0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -344,6 +468,12 @@ type RootCoordClient interface {
 	CreateAlias(ctx context.Context, in *milvuspb.CreateAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	DropAlias(ctx context.Context, in *milvuspb.DropAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
 	AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -383,6 +513,19 @@ type RootCoordClient interface {
 	SegmentFlushCompleted(ctx context.Context, in *datapb.SegmentFlushCompletedMsg, opts ...grpc.CallOption) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(ctx context.Context, in *milvuspb.GetMetricsRequest, opts ...grpc.CallOption) (*milvuspb.GetMetricsResponse, error)
+	// credential, the password in CredentialInfo is hashed by proxy
+	CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListUsersResponse, error)
+	GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error)
+	// role based access control
+	CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error)
+	ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error)
 }
 
 type rootCoordClient struct {
@@ -483,6 +626,60 @@ func (c *rootCoordClient) AlterAlias(ctx context.Context, in *milvuspb.AlterAlia
 	return out, nil
 }
 
+func (c *rootCoordClient) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RenameCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AlterCollection", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) AddField(ctx context.Context, in *milvuspb.AddFieldRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/AddField", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropDatabase", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest, opts ...grpc.CallOption) (*milvuspb.ListDatabasesResponse, error) {
+	out := new(milvuspb.ListDatabasesResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListDatabases", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rootCoordClient) ShowCollections(ctx context.Context, in *milvuspb.ShowCollectionsRequest, opts ...grpc.CallOption) (*milvuspb.ShowCollectionsResponse, error) {
 	out := new(milvuspb.ShowCollectionsResponse)
 	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ShowCollections", in, out, opts...)
@@ -627,6 +824,105 @@ func (c *rootCoordClient) GetMetrics(ctx context.Context, in *milvuspb.GetMetric
 	return out, nil
 }
 
+func (c *rootCoordClient) CreateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) UpdateCredential(ctx context.Context, in *internalpb.CredentialInfo, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/UpdateCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DeleteCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest, opts ...grpc.CallOption) (*milvuspb.ListUsersResponse, error) {
+	out := new(milvuspb.ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GetCredential(ctx context.Context, in *GetCredentialRequest, opts ...grpc.CallOption) (*GetCredentialResponse, error) {
+	out := new(GetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/CreateRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/DropRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/OperateUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/GrantPrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	out := new(commonpb.Status)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/RevokePrivilege", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rootCoordClient) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest, opts ...grpc.CallOption) (*milvuspb.ListGrantsResponse, error) {
+	out := new(milvuspb.ListGrantsResponse)
+	err := c.cc.Invoke(ctx, "/milvus.proto.rootcoord.RootCoord/ListGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // RootCoordServer is the server API for RootCoord service.
 type RootCoordServer interface {
 	GetComponentStates(context.Context, *internalpb.GetComponentStatesRequest) (*internalpb.ComponentStates, error)
@@ -663,6 +959,12 @@ type RootCoordServer interface {
 	CreateAlias(context.Context, *milvuspb.CreateAliasRequest) (*commonpb.Status, error)
 	DropAlias(context.Context, *milvuspb.DropAliasRequest) (*commonpb.Status, error)
 	AlterAlias(context.Context, *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+	RenameCollection(context.Context, *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+	AlterCollection(context.Context, *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+	AddField(context.Context, *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+	CreateDatabase(context.Context, *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+	DropDatabase(context.Context, *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+	ListDatabases(context.Context, *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
 	//*
 	// @brief This method is used to list all collections.
 	//
@@ -702,6 +1004,19 @@ type RootCoordServer interface {
 	SegmentFlushCompleted(context.Context, *datapb.SegmentFlushCompletedMsg) (*commonpb.Status, error)
 	// https://wiki.lfaidata.foundation/display/MIL/MEP+8+--+Add+metrics+for+proxy
 	GetMetrics(context.Context, *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+	// credential, the password in CredentialInfo is hashed by proxy
+	CreateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
+	UpdateCredential(context.Context, *internalpb.CredentialInfo) (*commonpb.Status, error)
+	DeleteCredential(context.Context, *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+	ListUsers(context.Context, *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error)
+	GetCredential(context.Context, *GetCredentialRequest) (*GetCredentialResponse, error)
+	// role based access control
+	CreateRole(context.Context, *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+	DropRole(context.Context, *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+	OperateUserRole(context.Context, *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+	GrantPrivilege(context.Context, *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error)
+	RevokePrivilege(context.Context, *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error)
+	ListGrants(context.Context, *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
 }
 
 // UnimplementedRootCoordServer can be embedded to have forward compatible implementations.
@@ -738,6 +1053,24 @@ func (*UnimplementedRootCoordServer) DropAlias(ctx context.Context, req *milvusp
 func (*UnimplementedRootCoordServer) AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AlterAlias not implemented")
 }
+func (*UnimplementedRootCoordServer) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenameCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AlterCollection not implemented")
+}
+func (*UnimplementedRootCoordServer) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddField not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropDatabase not implemented")
+}
+func (*UnimplementedRootCoordServer) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDatabases not implemented")
+}
 func (*UnimplementedRootCoordServer) ShowCollections(ctx context.Context, req *milvuspb.ShowCollectionsRequest) (*milvuspb.ShowCollectionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ShowCollections not implemented")
 }
@@ -786,6 +1119,39 @@ func (*UnimplementedRootCoordServer) SegmentFlushCompleted(ctx context.Context,
 func (*UnimplementedRootCoordServer) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMetrics not implemented")
 }
+func (*UnimplementedRootCoordServer) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (*UnimplementedRootCoordServer) GetCredential(ctx context.Context, req *GetCredentialRequest) (*GetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+func (*UnimplementedRootCoordServer) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRole not implemented")
+}
+func (*UnimplementedRootCoordServer) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DropRole not implemented")
+}
+func (*UnimplementedRootCoordServer) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OperateUserRole not implemented")
+}
+func (*UnimplementedRootCoordServer) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GrantPrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokePrivilege not implemented")
+}
+func (*UnimplementedRootCoordServer) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListGrants not implemented")
+}
 
 func RegisterRootCoordServer(s *grpc.Server, srv RootCoordServer) {
 	s.RegisterService(&_RootCoord_serviceDesc, srv)
@@ -971,6 +1337,114 @@ func _RootCoord_AlterAlias_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_RenameCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RenameCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RenameCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RenameCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RenameCollection(ctx, req.(*milvuspb.RenameCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AlterCollection_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AlterCollectionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AlterCollection(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AlterCollection",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AlterCollection(ctx, req.(*milvuspb.AlterCollectionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_AddField_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.AddFieldRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).AddField(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/AddField",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).AddField(ctx, req.(*milvuspb.AddFieldRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateDatabase(ctx, req.(*milvuspb.CreateDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropDatabase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropDatabaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropDatabase(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropDatabase",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropDatabase(ctx, req.(*milvuspb.DropDatabaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListDatabases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListDatabasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListDatabases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListDatabases",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListDatabases(ctx, req.(*milvuspb.ListDatabasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _RootCoord_ShowCollections_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(milvuspb.ShowCollectionsRequest)
 	if err := dec(in); err != nil {
@@ -1259,6 +1733,204 @@ func _RootCoord_GetMetrics_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _RootCoord_CreateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateCredential(ctx, req.(*internalpb.CredentialInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_UpdateCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(internalpb.CredentialInfo)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).UpdateCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/UpdateCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).UpdateCredential(ctx, req.(*internalpb.CredentialInfo))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DeleteCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DeleteCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DeleteCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DeleteCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DeleteCredential(ctx, req.(*milvuspb.DeleteCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListUsers(ctx, req.(*milvuspb.ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GetCredential(ctx, req.(*GetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_CreateRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.CreateRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).CreateRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/CreateRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).CreateRole(ctx, req.(*milvuspb.CreateRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_DropRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.DropRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).DropRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/DropRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).DropRole(ctx, req.(*milvuspb.DropRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_OperateUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.OperateUserRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).OperateUserRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/OperateUserRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).OperateUserRole(ctx, req.(*milvuspb.OperateUserRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_GrantPrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.GrantPrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).GrantPrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/GrantPrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).GrantPrivilege(ctx, req.(*milvuspb.GrantPrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_RevokePrivilege_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.RevokePrivilegeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).RevokePrivilege(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/RevokePrivilege",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).RevokePrivilege(ctx, req.(*milvuspb.RevokePrivilegeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RootCoord_ListGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(milvuspb.ListGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RootCoordServer).ListGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/milvus.proto.rootcoord.RootCoord/ListGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RootCoordServer).ListGrants(ctx, req.(*milvuspb.ListGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _RootCoord_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "milvus.proto.rootcoord.RootCoord",
 	HandlerType: (*RootCoordServer)(nil),
@@ -1303,6 +1975,30 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AlterAlias",
 			Handler:    _RootCoord_AlterAlias_Handler,
 		},
+		{
+			MethodName: "RenameCollection",
+			Handler:    _RootCoord_RenameCollection_Handler,
+		},
+		{
+			MethodName: "AlterCollection",
+			Handler:    _RootCoord_AlterCollection_Handler,
+		},
+		{
+			MethodName: "AddField",
+			Handler:    _RootCoord_AddField_Handler,
+		},
+		{
+			MethodName: "CreateDatabase",
+			Handler:    _RootCoord_CreateDatabase_Handler,
+		},
+		{
+			MethodName: "DropDatabase",
+			Handler:    _RootCoord_DropDatabase_Handler,
+		},
+		{
+			MethodName: "ListDatabases",
+			Handler:    _RootCoord_ListDatabases_Handler,
+		},
 		{
 			MethodName: "ShowCollections",
 			Handler:    _RootCoord_ShowCollections_Handler,
@@ -1367,6 +2063,50 @@ var _RootCoord_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetMetrics",
 			Handler:    _RootCoord_GetMetrics_Handler,
 		},
+		{
+			MethodName: "CreateCredential",
+			Handler:    _RootCoord_CreateCredential_Handler,
+		},
+		{
+			MethodName: "UpdateCredential",
+			Handler:    _RootCoord_UpdateCredential_Handler,
+		},
+		{
+			MethodName: "DeleteCredential",
+			Handler:    _RootCoord_DeleteCredential_Handler,
+		},
+		{
+			MethodName: "ListUsers",
+			Handler:    _RootCoord_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _RootCoord_GetCredential_Handler,
+		},
+		{
+			MethodName: "CreateRole",
+			Handler:    _RootCoord_CreateRole_Handler,
+		},
+		{
+			MethodName: "DropRole",
+			Handler:    _RootCoord_DropRole_Handler,
+		},
+		{
+			MethodName: "OperateUserRole",
+			Handler:    _RootCoord_OperateUserRole_Handler,
+		},
+		{
+			MethodName: "GrantPrivilege",
+			Handler:    _RootCoord_GrantPrivilege_Handler,
+		},
+		{
+			MethodName: "RevokePrivilege",
+			Handler:    _RootCoord_RevokePrivilege_Handler,
+		},
+		{
+			MethodName: "ListGrants",
+			Handler:    _RootCoord_ListGrants_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "root_coord.proto",
@@ -35,6 +35,8 @@ const (
 	DataType_Float        DataType = 10
 	DataType_Double       DataType = 11
 	DataType_String       DataType = 20
+	DataType_VarChar      DataType = 21
+	DataType_JSON         DataType = 23
 	DataType_BinaryVector DataType = 100
 	DataType_FloatVector  DataType = 101
 )
@@ -49,6 +51,8 @@ var DataType_name = map[int32]string{
 	10:  "Float",
 	11:  "Double",
 	20:  "String",
+	21:  "VarChar",
+	23:  "JSON",
 	100: "BinaryVector",
 	101: "FloatVector",
 }
@@ -63,6 +67,8 @@ var DataType_value = map[string]int32{
 	"Float":        10,
 	"Double":       11,
 	"String":       20,
+	"VarChar":      21,
+	"JSON":         23,
 	"BinaryVector": 100,
 	"FloatVector":  101,
 }
@@ -86,6 +92,9 @@ type FieldSchema struct {
 	TypeParams           []*commonpb.KeyValuePair `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
 	IndexParams          []*commonpb.KeyValuePair `protobuf:"bytes,7,rep,name=index_params,json=indexParams,proto3" json:"index_params,omitempty"`
 	AutoID               bool                     `protobuf:"varint,8,opt,name=autoID,proto3" json:"autoID,omitempty"`
+	DefaultValue         *ValueField              `protobuf:"bytes,9,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	Nullable             bool                     `protobuf:"varint,10,opt,name=nullable,proto3" json:"nullable,omitempty"`
+	IsDynamic            bool                     `protobuf:"varint,11,opt,name=is_dynamic,json=isDynamic,proto3" json:"is_dynamic,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -172,6 +181,27 @@ func (m *FieldSchema) GetAutoID() bool {
 	return false
 }
 
+func (m *FieldSchema) GetDefaultValue() *ValueField {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return nil
+}
+
+func (m *FieldSchema) GetNullable() bool {
+	if m != nil {
+		return m.Nullable
+	}
+	return false
+}
+
+func (m *FieldSchema) GetIsDynamic() bool {
+	if m != nil {
+		return m.IsDynamic
+	}
+	return false
+}
+
 //*
 // @brief Collection schema
 type CollectionSchema struct {
@@ -179,6 +209,8 @@ type CollectionSchema struct {
 	Description          string         `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
 	AutoID               bool           `protobuf:"varint,3,opt,name=autoID,proto3" json:"autoID,omitempty"`
 	Fields               []*FieldSchema `protobuf:"bytes,4,rep,name=fields,proto3" json:"fields,omitempty"`
+	Version              int32          `protobuf:"varint,5,opt,name=version,proto3" json:"version,omitempty"`
+	EnableDynamicField   bool           `protobuf:"varint,6,opt,name=enable_dynamic_field,json=enableDynamicField,proto3" json:"enable_dynamic_field,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
 	XXX_unrecognized     []byte         `json:"-"`
 	XXX_sizecache        int32          `json:"-"`
@@ -237,6 +269,20 @@ func (m *CollectionSchema) GetFields() []*FieldSchema {
 	return nil
 }
 
+func (m *CollectionSchema) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
+func (m *CollectionSchema) GetEnableDynamicField() bool {
+	if m != nil {
+		return m.EnableDynamicField
+	}
+	return false
+}
+
 type BoolArray struct {
 	Data                 []bool   `protobuf:"varint,1,rep,packed,name=data,proto3" json:"data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -511,6 +557,188 @@ func (m *StringArray) GetData() []string {
 	return nil
 }
 
+//*
+// @brief Single value of a scalar field
+type ValueField struct {
+	// Types that are valid to be assigned to Data:
+	//	*ValueField_BoolData
+	//	*ValueField_IntData
+	//	*ValueField_LongData
+	//	*ValueField_FloatData
+	//	*ValueField_DoubleData
+	//	*ValueField_StringData
+	Data                 isValueField_Data `protobuf_oneof:"data"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *ValueField) Reset()         { *m = ValueField{} }
+func (m *ValueField) String() string { return proto.CompactTextString(m) }
+func (*ValueField) ProtoMessage()    {}
+func (*ValueField) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+}
+
+func (m *ValueField) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValueField.Unmarshal(m, b)
+}
+func (m *ValueField) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValueField.Marshal(b, m, deterministic)
+}
+func (m *ValueField) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValueField.Merge(m, src)
+}
+func (m *ValueField) XXX_Size() int {
+	return xxx_messageInfo_ValueField.Size(m)
+}
+func (m *ValueField) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValueField.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValueField proto.InternalMessageInfo
+
+type isValueField_Data interface {
+	isValueField_Data()
+}
+
+type ValueField_BoolData struct {
+	BoolData bool `protobuf:"varint,1,opt,name=bool_data,json=boolData,proto3,oneof"`
+}
+
+type ValueField_IntData struct {
+	IntData int32 `protobuf:"varint,2,opt,name=int_data,json=intData,proto3,oneof"`
+}
+
+type ValueField_LongData struct {
+	LongData int64 `protobuf:"varint,3,opt,name=long_data,json=longData,proto3,oneof"`
+}
+
+type ValueField_FloatData struct {
+	FloatData float32 `protobuf:"fixed32,4,opt,name=float_data,json=floatData,proto3,oneof"`
+}
+
+type ValueField_DoubleData struct {
+	DoubleData float64 `protobuf:"fixed64,5,opt,name=double_data,json=doubleData,proto3,oneof"`
+}
+
+type ValueField_StringData struct {
+	StringData string `protobuf:"bytes,6,opt,name=string_data,json=stringData,proto3,oneof"`
+}
+
+func (*ValueField_BoolData) isValueField_Data() {}
+
+func (*ValueField_IntData) isValueField_Data() {}
+
+func (*ValueField_LongData) isValueField_Data() {}
+
+func (*ValueField_FloatData) isValueField_Data() {}
+
+func (*ValueField_DoubleData) isValueField_Data() {}
+
+func (*ValueField_StringData) isValueField_Data() {}
+
+func (m *ValueField) GetData() isValueField_Data {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ValueField) GetBoolData() bool {
+	if x, ok := m.GetData().(*ValueField_BoolData); ok {
+		return x.BoolData
+	}
+	return false
+}
+
+func (m *ValueField) GetIntData() int32 {
+	if x, ok := m.GetData().(*ValueField_IntData); ok {
+		return x.IntData
+	}
+	return 0
+}
+
+func (m *ValueField) GetLongData() int64 {
+	if x, ok := m.GetData().(*ValueField_LongData); ok {
+		return x.LongData
+	}
+	return 0
+}
+
+func (m *ValueField) GetFloatData() float32 {
+	if x, ok := m.GetData().(*ValueField_FloatData); ok {
+		return x.FloatData
+	}
+	return 0
+}
+
+func (m *ValueField) GetDoubleData() float64 {
+	if x, ok := m.GetData().(*ValueField_DoubleData); ok {
+		return x.DoubleData
+	}
+	return 0
+}
+
+func (m *ValueField) GetStringData() string {
+	if x, ok := m.GetData().(*ValueField_StringData); ok {
+		return x.StringData
+	}
+	return ""
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*ValueField) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*ValueField_BoolData)(nil),
+		(*ValueField_IntData)(nil),
+		(*ValueField_LongData)(nil),
+		(*ValueField_FloatData)(nil),
+		(*ValueField_DoubleData)(nil),
+		(*ValueField_StringData)(nil),
+	}
+}
+
+// JSONArray holds the serialized json documents
+type JSONArray struct {
+	Data                 [][]byte `protobuf:"bytes,1,rep,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JSONArray) Reset()         { *m = JSONArray{} }
+func (m *JSONArray) String() string { return proto.CompactTextString(m) }
+func (*JSONArray) ProtoMessage()    {}
+func (*JSONArray) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+}
+
+func (m *JSONArray) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JSONArray.Unmarshal(m, b)
+}
+func (m *JSONArray) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JSONArray.Marshal(b, m, deterministic)
+}
+func (m *JSONArray) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JSONArray.Merge(m, src)
+}
+func (m *JSONArray) XXX_Size() int {
+	return xxx_messageInfo_JSONArray.Size(m)
+}
+func (m *JSONArray) XXX_DiscardUnknown() {
+	xxx_messageInfo_JSONArray.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JSONArray proto.InternalMessageInfo
+
+func (m *JSONArray) GetData() [][]byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 type ScalarField struct {
 	// Types that are valid to be assigned to Data:
 	//	*ScalarField_BoolData
@@ -520,6 +748,7 @@ type ScalarField struct {
 	//	*ScalarField_DoubleData
 	//	*ScalarField_StringData
 	//	*ScalarField_BytesData
+	//	*ScalarField_JsonData
 	Data                 isScalarField_Data `protobuf_oneof:"data"`
 	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
 	XXX_unrecognized     []byte             `json:"-"`
@@ -530,7 +759,7 @@ func (m *ScalarField) Reset()         { *m = ScalarField{} }
 func (m *ScalarField) String() string { return proto.CompactTextString(m) }
 func (*ScalarField) ProtoMessage()    {}
 func (*ScalarField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{9}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
 }
 
 func (m *ScalarField) XXX_Unmarshal(b []byte) error {
@@ -583,6 +812,10 @@ type ScalarField_BytesData struct {
 	BytesData *BytesArray `protobuf:"bytes,7,opt,name=bytes_data,json=bytesData,proto3,oneof"`
 }
 
+type ScalarField_JsonData struct {
+	JsonData *JSONArray `protobuf:"bytes,8,opt,name=json_data,json=jsonData,proto3,oneof"`
+}
+
 func (*ScalarField_BoolData) isScalarField_Data() {}
 
 func (*ScalarField_IntData) isScalarField_Data() {}
@@ -597,6 +830,8 @@ func (*ScalarField_StringData) isScalarField_Data() {}
 
 func (*ScalarField_BytesData) isScalarField_Data() {}
 
+func (*ScalarField_JsonData) isScalarField_Data() {}
+
 func (m *ScalarField) GetData() isScalarField_Data {
 	if m != nil {
 		return m.Data
@@ -653,6 +888,13 @@ func (m *ScalarField) GetBytesData() *BytesArray {
 	return nil
 }
 
+func (m *ScalarField) GetJsonData() *JSONArray {
+	if x, ok := m.GetData().(*ScalarField_JsonData); ok {
+		return x.JsonData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*ScalarField) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -663,6 +905,7 @@ func (*ScalarField) XXX_OneofWrappers() []interface{} {
 		(*ScalarField_DoubleData)(nil),
 		(*ScalarField_StringData)(nil),
 		(*ScalarField_BytesData)(nil),
+		(*ScalarField_JsonData)(nil),
 	}
 }
 
@@ -681,7 +924,7 @@ func (m *VectorField) Reset()         { *m = VectorField{} }
 func (m *VectorField) String() string { return proto.CompactTextString(m) }
 func (*VectorField) ProtoMessage()    {}
 func (*VectorField) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{10}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
 }
 
 func (m *VectorField) XXX_Unmarshal(b []byte) error {
@@ -762,6 +1005,7 @@ type FieldData struct {
 	//	*FieldData_Vectors
 	Field                isFieldData_Field `protobuf_oneof:"field"`
 	FieldId              int64             `protobuf:"varint,5,opt,name=field_id,json=fieldId,proto3" json:"field_id,omitempty"`
+	ValidData            []bool            `protobuf:"varint,6,rep,packed,name=valid_data,json=validData,proto3" json:"valid_data,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
 	XXX_unrecognized     []byte            `json:"-"`
 	XXX_sizecache        int32             `json:"-"`
@@ -771,7 +1015,7 @@ func (m *FieldData) Reset()         { *m = FieldData{} }
 func (m *FieldData) String() string { return proto.CompactTextString(m) }
 func (*FieldData) ProtoMessage()    {}
 func (*FieldData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{11}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
 }
 
 func (m *FieldData) XXX_Unmarshal(b []byte) error {
@@ -850,6 +1094,13 @@ func (m *FieldData) GetFieldId() int64 {
 	return 0
 }
 
+func (m *FieldData) GetValidData() []bool {
+	if m != nil {
+		return m.ValidData
+	}
+	return nil
+}
+
 // XXX_OneofWrappers is for the internal use of the proto package.
 func (*FieldData) XXX_OneofWrappers() []interface{} {
 	return []interface{}{
@@ -872,7 +1123,7 @@ func (m *IDs) Reset()         { *m = IDs{} }
 func (m *IDs) String() string { return proto.CompactTextString(m) }
 func (*IDs) ProtoMessage()    {}
 func (*IDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{12}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{14}
 }
 
 func (m *IDs) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1205,7 @@ func (m *SearchResultData) Reset()         { *m = SearchResultData{} }
 func (m *SearchResultData) String() string { return proto.CompactTextString(m) }
 func (*SearchResultData) ProtoMessage()    {}
 func (*SearchResultData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c5fb4d8cc22d66a, []int{13}
+	return fileDescriptor_1c5fb4d8cc22d66a, []int{15}
 }
 
 func (m *SearchResultData) XXX_Unmarshal(b []byte) error {
@@ -1028,6 +1279,8 @@ func init() {
 	proto.RegisterType((*DoubleArray)(nil), "milvus.proto.schema.DoubleArray")
 	proto.RegisterType((*BytesArray)(nil), "milvus.proto.schema.BytesArray")
 	proto.RegisterType((*StringArray)(nil), "milvus.proto.schema.StringArray")
+	proto.RegisterType((*ValueField)(nil), "milvus.proto.schema.ValueField")
+	proto.RegisterType((*JSONArray)(nil), "milvus.proto.schema.JSONArray")
 	proto.RegisterType((*ScalarField)(nil), "milvus.proto.schema.ScalarField")
 	proto.RegisterType((*VectorField)(nil), "milvus.proto.schema.VectorField")
 	proto.RegisterType((*FieldData)(nil), "milvus.proto.schema.FieldData")
@@ -1038,66 +1291,78 @@ func init() {
 func init() { proto.RegisterFile("schema.proto", fileDescriptor_1c5fb4d8cc22d66a) }
 
 var fileDescriptor_1c5fb4d8cc22d66a = []byte{
-	// 963 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xdd, 0x8e, 0xdb, 0x44,
-	0x14, 0x8e, 0xe3, 0xfc, 0xd8, 0xc7, 0xa1, 0x58, 0xd3, 0x0a, 0x19, 0xa4, 0x76, 0xd3, 0x08, 0xa4,
-	0xa8, 0x12, 0xbb, 0xea, 0x2e, 0x94, 0x52, 0x51, 0x01, 0x69, 0xb4, 0x4a, 0xb4, 0xa8, 0x5a, 0xbc,
-	0xa8, 0x17, 0xdc, 0x44, 0x93, 0x78, 0xba, 0x3b, 0x5a, 0x7b, 0x26, 0x78, 0x26, 0x15, 0x79, 0x00,
-	0xae, 0xb9, 0xe1, 0x8a, 0x77, 0xe3, 0x02, 0xf1, 0x1c, 0x48, 0x68, 0xce, 0x4c, 0x12, 0xd3, 0x78,
-	0x57, 0x7b, 0x77, 0x66, 0x7c, 0xbe, 0x6f, 0xce, 0xf9, 0xce, 0x8f, 0xa1, 0xa7, 0x16, 0x57, 0xac,
-	0xa0, 0x87, 0xcb, 0x52, 0x6a, 0x49, 0xee, 0x17, 0x3c, 0x7f, 0xb7, 0x52, 0xf6, 0x74, 0x68, 0x3f,
-	0x7d, 0xd2, 0x5b, 0xc8, 0xa2, 0x90, 0xc2, 0x5e, 0x0e, 0xfe, 0x6e, 0x42, 0x74, 0xca, 0x59, 0x9e,
-	0x5d, 0xe0, 0x57, 0x92, 0x40, 0xf7, 0xad, 0x39, 0x4e, 0xc7, 0x89, 0xd7, 0xf7, 0x86, 0x7e, 0xba,
-	0x39, 0x12, 0x02, 0x2d, 0x41, 0x0b, 0x96, 0x34, 0xfb, 0xde, 0x30, 0x4c, 0xd1, 0x26, 0x9f, 0xc2,
-	0x3d, 0xae, 0x66, 0xcb, 0x92, 0x17, 0xb4, 0x5c, 0xcf, 0xae, 0xd9, 0x3a, 0xf1, 0xfb, 0xde, 0x30,
-	0x48, 0x7b, 0x5c, 0x9d, 0xdb, 0xcb, 0x33, 0xb6, 0x26, 0x7d, 0x88, 0x32, 0xa6, 0x16, 0x25, 0x5f,
-	0x6a, 0x2e, 0x45, 0xd2, 0x42, 0x82, 0xea, 0x15, 0x79, 0x01, 0x61, 0x46, 0x35, 0x9d, 0xe9, 0xf5,
-	0x92, 0x25, 0xed, 0xbe, 0x37, 0xbc, 0x77, 0xfc, 0xf0, 0xb0, 0x26, 0xf8, 0xc3, 0x31, 0xd5, 0xf4,
-	0xa7, 0xf5, 0x92, 0xa5, 0x41, 0xe6, 0x2c, 0x32, 0x82, 0xc8, 0xc0, 0x66, 0x4b, 0x5a, 0xd2, 0x42,
-	0x25, 0x9d, 0xbe, 0x3f, 0x8c, 0x8e, 0x1f, 0xff, 0x1f, 0xed, 0x52, 0x3e, 0x63, 0xeb, 0x37, 0x34,
-	0x5f, 0xb1, 0x73, 0xca, 0xcb, 0x14, 0x0c, 0xea, 0x1c, 0x41, 0x64, 0x0c, 0x3d, 0x2e, 0x32, 0xf6,
-	0xeb, 0x86, 0xa4, 0x7b, 0x57, 0x92, 0x08, 0x61, 0x8e, 0xe5, 0x23, 0xe8, 0xd0, 0x95, 0x96, 0xd3,
-	0x71, 0x12, 0xa0, 0x0a, 0xee, 0x34, 0xf8, 0xd3, 0x83, 0xf8, 0x95, 0xcc, 0x73, 0xb6, 0x30, 0xc9,
-	0x3a, 0xa1, 0x37, 0x72, 0x7a, 0x15, 0x39, 0xdf, 0x13, 0xaa, 0xb9, 0x2f, 0xd4, 0xee, 0x09, 0xbf,
-	0xfa, 0x04, 0x79, 0x0e, 0x1d, 0xac, 0x93, 0x4a, 0x5a, 0x18, 0x7a, 0xbf, 0x56, 0xbd, 0x4a, 0xa1,
-	0x53, 0xe7, 0x3f, 0x38, 0x80, 0x70, 0x24, 0x65, 0xfe, 0x7d, 0x59, 0xd2, 0xb5, 0x09, 0xca, 0xe8,
-	0x9a, 0x78, 0x7d, 0x7f, 0x18, 0xa4, 0x68, 0x0f, 0x1e, 0x41, 0x30, 0x15, 0x7a, 0xff, 0x7b, 0xdb,
-	0x7d, 0x3f, 0x80, 0xf0, 0x07, 0x29, 0x2e, 0xf7, 0x1d, 0x7c, 0xe7, 0xd0, 0x07, 0x38, 0xcd, 0x25,
-	0xad, 0xa1, 0x68, 0x3a, 0x8f, 0xc7, 0x10, 0x8d, 0xe5, 0x6a, 0x9e, 0xb3, 0x7d, 0x17, 0x6f, 0x47,
-	0x32, 0x5a, 0x6b, 0xa6, 0xf6, 0x3d, 0x7a, 0x3b, 0x92, 0x0b, 0x5d, 0xf2, 0xba, 0x48, 0x42, 0xe7,
-	0xf2, 0x97, 0x0f, 0xd1, 0xc5, 0x82, 0xe6, 0xb4, 0x44, 0x25, 0xc8, 0x4b, 0x08, 0xe7, 0x52, 0xe6,
-	0x33, 0xe7, 0xe8, 0x0d, 0xa3, 0xe3, 0x47, 0xb5, 0xc2, 0x6d, 0x15, 0x9a, 0x34, 0xd2, 0xc0, 0x40,
-	0x4c, 0x1f, 0x92, 0x17, 0x10, 0x70, 0xa1, 0x2d, 0xba, 0x89, 0xe8, 0xfa, 0xa6, 0xdd, 0xc8, 0x37,
-	0x69, 0xa4, 0x5d, 0x2e, 0x34, 0x62, 0x5f, 0x42, 0x98, 0x4b, 0x71, 0x69, 0xc1, 0xfe, 0x2d, 0x4f,
-	0x6f, 0xb5, 0x35, 0x4f, 0x1b, 0x08, 0xc2, 0xbf, 0x03, 0x78, 0x6b, 0x34, 0xb5, 0xf8, 0x16, 0xe2,
-	0x0f, 0xea, 0x6b, 0xbe, 0x95, 0x7e, 0xd2, 0x48, 0x43, 0x04, 0x21, 0xc3, 0x2b, 0x88, 0x32, 0xd4,
-	0xdc, 0x52, 0xb4, 0x91, 0xa2, 0xbe, 0x6d, 0x2a, 0xb5, 0x99, 0x34, 0x52, 0xb0, 0xb0, 0x0d, 0x89,
-	0x42, 0xcd, 0x2d, 0x49, 0xe7, 0x16, 0x92, 0x4a, 0x6d, 0x0c, 0x89, 0x85, 0x6d, 0x72, 0x99, 0x9b,
-	0xd2, 0x5a, 0x8e, 0xee, 0x2d, 0xb9, 0xec, 0x3a, 0xc0, 0xe4, 0x82, 0x20, 0xc3, 0x30, 0xea, 0xd8,
-	0x5a, 0x0f, 0xfe, 0xf0, 0x20, 0x7a, 0xc3, 0x16, 0x5a, 0xba, 0xfa, 0xc6, 0xe0, 0x67, 0xbc, 0x70,
-	0x8b, 0xcc, 0x98, 0x66, 0xd0, 0xad, 0x6e, 0xef, 0xd0, 0xcd, 0x95, 0xed, 0x0e, 0xca, 0x45, 0x08,
-	0xb3, 0xe4, 0xe4, 0x33, 0xf8, 0x60, 0xce, 0x85, 0x59, 0x79, 0x8e, 0xc6, 0x14, 0xb0, 0x37, 0x69,
-	0xa4, 0x3d, 0x7b, 0x6d, 0xdd, 0xb6, 0x61, 0xfd, 0xeb, 0x41, 0x88, 0x01, 0x61, 0xba, 0x4f, 0xa1,
-	0x85, 0x6b, 0xce, 0xbb, 0xcb, 0x9a, 0x43, 0x57, 0xf2, 0x10, 0x00, 0xa7, 0x75, 0x56, 0x59, 0xc0,
-	0x21, 0xde, 0xbc, 0x36, 0x6b, 0xe3, 0x1b, 0xe8, 0x2a, 0xec, 0x6a, 0xe5, 0x3a, 0xe9, 0x86, 0x0a,
-	0xec, 0x3a, 0xdf, 0x74, 0xa2, 0x83, 0x18, 0xb4, 0xcd, 0x42, 0xb9, 0x3e, 0xaa, 0x47, 0x57, 0x74,
-	0x35, 0x68, 0x07, 0x21, 0x1f, 0x43, 0x60, 0x43, 0xe3, 0x19, 0xf6, 0xd0, 0xf6, 0x87, 0x91, 0x8d,
-	0xba, 0xd0, 0x46, 0x73, 0xf0, 0x9b, 0x07, 0xfe, 0x74, 0xac, 0xc8, 0x57, 0xd0, 0x31, 0xf3, 0xc2,
-	0xb3, 0x5b, 0x67, 0xad, 0xda, 0xf0, 0x6d, 0x2e, 0xf4, 0x34, 0x23, 0x5f, 0x43, 0x47, 0xe9, 0xd2,
-	0x00, 0x9b, 0x77, 0xee, 0xb0, 0xb6, 0xd2, 0xe5, 0x34, 0x1b, 0x01, 0x04, 0x3c, 0x9b, 0xd9, 0x38,
-	0xfe, 0xf1, 0x20, 0xbe, 0x60, 0xb4, 0x5c, 0x5c, 0xa5, 0x4c, 0xad, 0x72, 0x3b, 0x07, 0x07, 0x10,
-	0x89, 0x55, 0x31, 0xfb, 0x65, 0xc5, 0x4a, 0xce, 0x94, 0xeb, 0x15, 0x10, 0xab, 0xe2, 0x47, 0x7b,
-	0x43, 0xee, 0x43, 0x5b, 0xcb, 0xe5, 0xec, 0x1a, 0xdf, 0xf6, 0xd3, 0x96, 0x96, 0xcb, 0x33, 0xf2,
-	0x2d, 0x44, 0x76, 0x7f, 0x6e, 0x06, 0xd8, 0xbf, 0x31, 0x9f, 0x6d, 0xe5, 0x53, 0x5b, 0x44, 0x6c,
-	0x59, 0xb3, 0xc8, 0xd5, 0x42, 0x96, 0xcc, 0x2e, 0xec, 0x66, 0xea, 0x4e, 0xe4, 0x09, 0xf8, 0x3c,
-	0x53, 0x6e, 0x1c, 0x93, 0xfa, 0x75, 0x32, 0x56, 0xa9, 0x71, 0x22, 0x0f, 0x30, 0xb2, 0x6b, 0xfb,
-	0xcf, 0xf3, 0x53, 0x7b, 0x78, 0xf2, 0xbb, 0x07, 0xc1, 0xa6, 0x7f, 0x48, 0x00, 0xad, 0xd7, 0x52,
-	0xb0, 0xb8, 0x61, 0x2c, 0xb3, 0xc5, 0x62, 0xcf, 0x58, 0x53, 0xa1, 0x9f, 0xc7, 0x4d, 0x12, 0x42,
-	0x7b, 0x2a, 0xf4, 0xd3, 0x67, 0xb1, 0xef, 0xcc, 0x93, 0xe3, 0xb8, 0xe5, 0xcc, 0x67, 0x5f, 0xc4,
-	0x6d, 0x63, 0xe2, 0x14, 0xc4, 0x40, 0x00, 0x3a, 0x76, 0x0f, 0xc4, 0x91, 0xb1, 0xad, 0xd8, 0xf1,
-	0x03, 0x12, 0x43, 0x6f, 0x54, 0x69, 0xfa, 0x38, 0x23, 0x1f, 0x42, 0x74, 0xba, 0x1b, 0x96, 0x98,
-	0x8d, 0xbe, 0xfc, 0xf9, 0xe4, 0x92, 0xeb, 0xab, 0xd5, 0xdc, 0xfc, 0x42, 0x8f, 0x6c, 0x4a, 0x9f,
-	0x73, 0xe9, 0xac, 0x23, 0x2e, 0x34, 0x2b, 0x05, 0xcd, 0x8f, 0x30, 0xcb, 0x23, 0x9b, 0xe5, 0x72,
-	0x3e, 0xef, 0xe0, 0xf9, 0xe4, 0xbf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x88, 0x3c, 0xbb, 0x94, 0xd4,
-	0x08, 0x00, 0x00,
+	// 1158 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xdd, 0x6e, 0x1b, 0x45,
+	0x14, 0xf6, 0x7a, 0xfd, 0xb3, 0x7b, 0xd6, 0x2d, 0xab, 0x69, 0x29, 0x4b, 0x51, 0x1a, 0xd7, 0x02,
+	0xc9, 0xaa, 0x44, 0x42, 0x13, 0x28, 0xa5, 0xa2, 0x02, 0x1c, 0x2b, 0x8a, 0x09, 0x0a, 0x61, 0x83,
+	0x72, 0xc1, 0x8d, 0x35, 0xf6, 0x4e, 0x92, 0x21, 0xeb, 0x1d, 0xb3, 0x33, 0x8e, 0xf0, 0x03, 0xf0,
+	0x06, 0x5c, 0xf6, 0x9a, 0x47, 0xe2, 0x01, 0x40, 0xe2, 0x39, 0xd0, 0x99, 0x99, 0xf5, 0x4f, 0x6c,
+	0x47, 0xb9, 0x3b, 0x73, 0xfe, 0x76, 0xbe, 0x73, 0xbe, 0x73, 0x66, 0xa1, 0x21, 0x87, 0x57, 0x6c,
+	0x44, 0x77, 0xc6, 0xb9, 0x50, 0x82, 0x3c, 0x1a, 0xf1, 0xf4, 0x66, 0x22, 0xcd, 0x69, 0xc7, 0x98,
+	0x9e, 0x36, 0x86, 0x62, 0x34, 0x12, 0x99, 0x51, 0xb6, 0xfe, 0x71, 0x21, 0x38, 0xe4, 0x2c, 0x4d,
+	0xce, 0xb4, 0x95, 0x44, 0x50, 0xbf, 0xc0, 0x63, 0xaf, 0x1b, 0x39, 0x4d, 0xa7, 0xed, 0xc6, 0xc5,
+	0x91, 0x10, 0xa8, 0x64, 0x74, 0xc4, 0xa2, 0x72, 0xd3, 0x69, 0xfb, 0xb1, 0x96, 0xc9, 0xc7, 0xf0,
+	0x90, 0xcb, 0xfe, 0x38, 0xe7, 0x23, 0x9a, 0x4f, 0xfb, 0xd7, 0x6c, 0x1a, 0xb9, 0x4d, 0xa7, 0xed,
+	0xc5, 0x0d, 0x2e, 0x4f, 0x8d, 0xf2, 0x98, 0x4d, 0x49, 0x13, 0x82, 0x84, 0xc9, 0x61, 0xce, 0xc7,
+	0x8a, 0x8b, 0x2c, 0xaa, 0xe8, 0x04, 0x8b, 0x2a, 0xf2, 0x06, 0xfc, 0x84, 0x2a, 0xda, 0x57, 0xd3,
+	0x31, 0x8b, 0xaa, 0x4d, 0xa7, 0xfd, 0x70, 0x6f, 0x6b, 0x67, 0xcd, 0xe5, 0x77, 0xba, 0x54, 0xd1,
+	0x9f, 0xa7, 0x63, 0x16, 0x7b, 0x89, 0x95, 0x48, 0x07, 0x02, 0x0c, 0xeb, 0x8f, 0x69, 0x4e, 0x47,
+	0x32, 0xaa, 0x35, 0xdd, 0x76, 0xb0, 0xf7, 0x7c, 0x39, 0xda, 0x42, 0x3e, 0x66, 0xd3, 0x73, 0x9a,
+	0x4e, 0xd8, 0x29, 0xe5, 0x79, 0x0c, 0x18, 0x75, 0xaa, 0x83, 0x48, 0x17, 0x1a, 0x3c, 0x4b, 0xd8,
+	0xef, 0x45, 0x92, 0xfa, 0x7d, 0x93, 0x04, 0x3a, 0xcc, 0x66, 0x79, 0x02, 0x35, 0x3a, 0x51, 0xa2,
+	0xd7, 0x8d, 0x3c, 0x5d, 0x05, 0x7b, 0x22, 0x5d, 0x78, 0x90, 0xb0, 0x0b, 0x3a, 0x49, 0x55, 0xff,
+	0x06, 0x23, 0x23, 0xbf, 0xe9, 0xb4, 0x83, 0xbd, 0xed, 0xb5, 0x08, 0x75, 0x6e, 0xdd, 0x91, 0xb8,
+	0x61, 0xa3, 0xb4, 0x8a, 0x3c, 0x05, 0x2f, 0x9b, 0xa4, 0x29, 0x1d, 0xa4, 0x2c, 0x02, 0x9d, 0x7f,
+	0x76, 0x26, 0x5b, 0x00, 0x5c, 0xf6, 0x93, 0x69, 0x46, 0x47, 0x7c, 0x18, 0x05, 0xda, 0xea, 0x73,
+	0xd9, 0x35, 0x8a, 0xd6, 0x7f, 0x0e, 0x84, 0x07, 0x22, 0x4d, 0xd9, 0x10, 0xab, 0x6d, 0x3b, 0x5d,
+	0xf4, 0xd3, 0x59, 0xe8, 0xe7, 0xad, 0x4e, 0x95, 0x57, 0x3b, 0x35, 0xc7, 0xe8, 0x2e, 0x61, 0x7c,
+	0x0d, 0x35, 0x4d, 0x14, 0x19, 0x55, 0x74, 0xed, 0x9a, 0x6b, 0xc1, 0x2d, 0x30, 0x2d, 0xb6, 0xfe,
+	0xc8, 0xb8, 0x1b, 0x96, 0x4b, 0xfc, 0x1e, 0x76, 0xbe, 0x1a, 0x17, 0x47, 0xf2, 0x19, 0x3c, 0x66,
+	0x19, 0xe2, 0x2b, 0x90, 0xf5, 0x75, 0x48, 0x54, 0xd3, 0x5f, 0x26, 0xc6, 0x66, 0x31, 0xea, 0xcc,
+	0xad, 0x6d, 0xf0, 0x3b, 0x42, 0xa4, 0xdf, 0xe5, 0x39, 0x9d, 0x22, 0x40, 0x24, 0x49, 0xe4, 0x34,
+	0xdd, 0xb6, 0x17, 0x6b, 0xb9, 0xf5, 0x0c, 0xbc, 0x5e, 0xa6, 0x56, 0xed, 0x55, 0x6b, 0xdf, 0x06,
+	0xff, 0x07, 0x91, 0x5d, 0xae, 0x3a, 0xb8, 0xd6, 0xa1, 0x09, 0x70, 0x98, 0x0a, 0xba, 0x26, 0x45,
+	0xd9, 0x7a, 0x3c, 0x87, 0xa0, 0x2b, 0x26, 0x83, 0x94, 0xad, 0xba, 0x38, 0xf3, 0x24, 0x9d, 0xa9,
+	0x62, 0x72, 0xd5, 0xa3, 0x31, 0x4f, 0x72, 0xa6, 0x72, 0xbe, 0xee, 0x26, 0xbe, 0x75, 0xf9, 0xdb,
+	0x01, 0x98, 0x93, 0x85, 0x6c, 0x81, 0x3f, 0x10, 0x22, 0xed, 0x5b, 0x3f, 0xa7, 0xed, 0x1d, 0x95,
+	0x62, 0x0f, 0x55, 0x38, 0x33, 0xe4, 0x23, 0xf0, 0x78, 0xa6, 0x8c, 0x15, 0xdb, 0x5a, 0x3d, 0x2a,
+	0xc5, 0x75, 0x9e, 0x29, 0x6d, 0xdc, 0x02, 0x3f, 0x15, 0xd9, 0xa5, 0xb1, 0x62, 0x5f, 0x5d, 0x8c,
+	0x45, 0x95, 0x36, 0x6f, 0x03, 0x5c, 0x20, 0x66, 0x63, 0xc7, 0xf1, 0x2d, 0x1f, 0x95, 0x62, 0x5f,
+	0xeb, 0xb4, 0xc3, 0x73, 0x08, 0x12, 0x0d, 0xd9, 0x78, 0x60, 0x1b, 0x9d, 0xa3, 0x52, 0x0c, 0x46,
+	0x59, 0xb8, 0x48, 0x0d, 0xc8, 0xb8, 0x60, 0x0b, 0x7d, 0x74, 0x31, 0x4a, 0x74, 0xe9, 0xd4, 0x60,
+	0xd6, 0x83, 0xef, 0xcf, 0x7e, 0x3c, 0xd9, 0x5c, 0x9c, 0x77, 0x15, 0x08, 0xce, 0x86, 0x34, 0xa5,
+	0xb9, 0x81, 0xfe, 0xf6, 0x36, 0xf4, 0x60, 0xef, 0xd9, 0x5a, 0xfa, 0xcd, 0xb8, 0xb1, 0x54, 0x9a,
+	0x37, 0xb7, 0x4a, 0x13, 0x6c, 0xd8, 0x3d, 0x05, 0x71, 0x16, 0x2b, 0xf7, 0xf6, 0x76, 0xe5, 0x36,
+	0x7d, 0x7a, 0xc6, 0xaa, 0xa5, 0xca, 0x7e, 0xbb, 0x52, 0xd9, 0x4d, 0x6b, 0x61, 0x4e, 0xba, 0xe5,
+	0xd2, 0x1f, 0xac, 0x96, 0x7e, 0xd3, 0xf0, 0x2d, 0xb0, 0xf2, 0x56, 0x73, 0x0e, 0x56, 0x9b, 0xb3,
+	0x29, 0xc9, 0x02, 0x2b, 0x97, 0xdb, 0x87, 0x58, 0x06, 0x48, 0x6a, 0x93, 0xa3, 0x7e, 0x07, 0x96,
+	0x39, 0xf7, 0x11, 0x8b, 0x0e, 0x2a, 0x8a, 0xf9, 0xab, 0x14, 0x99, 0x49, 0xe0, 0xdd, 0x51, 0xcc,
+	0x19, 0x3d, 0xb0, 0x98, 0x18, 0xb2, 0xc4, 0x9f, 0x3f, 0x1d, 0x08, 0xce, 0xd9, 0x50, 0x09, 0x4b,
+	0x8f, 0x10, 0xdc, 0x84, 0x8f, 0xec, 0x73, 0x86, 0x22, 0xae, 0x7b, 0x53, 0xf6, 0x1b, 0xed, 0x16,
+	0x95, 0xef, 0xb8, 0xec, 0x52, 0xe1, 0x03, 0x1d, 0x66, 0x92, 0x93, 0x4f, 0xe0, 0xc1, 0x80, 0x67,
+	0xf8, 0xf0, 0xd9, 0x34, 0xd8, 0xff, 0xc6, 0x51, 0x29, 0x6e, 0x18, 0xb5, 0x71, 0x9b, 0x5d, 0xeb,
+	0x5d, 0x19, 0x7c, 0x7d, 0x21, 0x8d, 0xf5, 0x25, 0x54, 0xf4, 0x63, 0xe7, 0xdc, 0xe7, 0xb1, 0xd3,
+	0xae, 0xb8, 0xe4, 0xf5, 0xfe, 0xeb, 0x2f, 0x3c, 0xc3, 0xbe, 0xd6, 0x9c, 0xe0, 0xee, 0xfe, 0x1a,
+	0xea, 0x52, 0x0f, 0x85, 0x8c, 0xdc, 0xbb, 0x1a, 0x38, 0x1f, 0x1c, 0x24, 0xb2, 0x0d, 0xc1, 0x68,
+	0x83, 0x42, 0x46, 0x95, 0x3b, 0xa2, 0x17, 0xea, 0x8a, 0xd1, 0x36, 0x84, 0x7c, 0x08, 0x9e, 0xb9,
+	0x1a, 0x4f, 0xa2, 0xea, 0xe2, 0x6f, 0x03, 0xee, 0x25, 0xb8, 0xa1, 0x29, 0x4f, 0x0a, 0x6a, 0xe1,
+	0x2e, 0xf6, 0xb5, 0x46, 0x37, 0xad, 0x0e, 0x55, 0xed, 0xd9, 0xfa, 0xc3, 0x01, 0xb7, 0xd7, 0x95,
+	0xe4, 0x4b, 0xa8, 0xe1, 0x34, 0xf2, 0x24, 0x72, 0xee, 0x39, 0x4e, 0x55, 0x9e, 0xa9, 0x5e, 0x42,
+	0xbe, 0x82, 0x9a, 0x54, 0x39, 0x06, 0x96, 0xef, 0xcd, 0xdf, 0xaa, 0x54, 0x79, 0x2f, 0xe9, 0x00,
+	0x78, 0x3c, 0x31, 0x8f, 0x4b, 0xeb, 0x5f, 0x07, 0xc2, 0x33, 0x46, 0xf3, 0xe1, 0x55, 0xcc, 0xe4,
+	0x24, 0x55, 0x76, 0x03, 0x06, 0xd9, 0x64, 0xd4, 0xff, 0x6d, 0xc2, 0x72, 0xce, 0xa4, 0xa5, 0x12,
+	0x64, 0x93, 0xd1, 0x4f, 0x46, 0x43, 0x1e, 0x41, 0x55, 0x89, 0x71, 0xff, 0x5a, 0x7f, 0xdb, 0x8d,
+	0x2b, 0x4a, 0x8c, 0x8f, 0xc9, 0x37, 0x10, 0x98, 0x37, 0xae, 0x58, 0x0f, 0xee, 0x46, 0x3c, 0x33,
+	0x62, 0xc4, 0xa6, 0xc7, 0x66, 0x20, 0x9e, 0x40, 0x4d, 0x0e, 0x45, 0xce, 0xcc, 0xa3, 0x5a, 0x8e,
+	0xed, 0x89, 0xbc, 0x00, 0x97, 0x27, 0xd2, 0x0e, 0x7b, 0xb4, 0x7e, 0x59, 0x75, 0x65, 0x8c, 0x4e,
+	0xe4, 0xb1, 0xbe, 0xd9, 0xb5, 0xf9, 0x31, 0x72, 0x63, 0x73, 0x78, 0xf1, 0x97, 0x03, 0x5e, 0x41,
+	0x2f, 0xe2, 0x41, 0xe5, 0x44, 0x64, 0x2c, 0x2c, 0xa1, 0x84, 0x3b, 0x32, 0x74, 0x50, 0xea, 0x65,
+	0xea, 0x75, 0x58, 0x26, 0x3e, 0x54, 0x7b, 0x99, 0x7a, 0xf9, 0x2a, 0x74, 0xad, 0xb8, 0xbf, 0x17,
+	0x56, 0xac, 0xf8, 0xea, 0xf3, 0xb0, 0x8a, 0xa2, 0x1e, 0x92, 0x10, 0x08, 0x40, 0xcd, 0x6c, 0x99,
+	0x30, 0x40, 0xd9, 0x14, 0x3b, 0x7c, 0x4c, 0x02, 0xa8, 0x9f, 0xd3, 0xfc, 0xe0, 0x8a, 0xe6, 0xe1,
+	0xfb, 0x98, 0x1a, 0x07, 0x38, 0xfc, 0x80, 0x84, 0xd0, 0xe8, 0x2c, 0x8c, 0x4a, 0x98, 0x90, 0xf7,
+	0x20, 0x38, 0x9c, 0x8f, 0x58, 0xc8, 0x3a, 0x5f, 0xfc, 0xb2, 0x7f, 0xc9, 0xd5, 0xd5, 0x64, 0x80,
+	0xbf, 0x5f, 0xbb, 0x06, 0xe9, 0xa7, 0x5c, 0x58, 0x69, 0x97, 0x67, 0x8a, 0xe5, 0x19, 0x4d, 0x77,
+	0x35, 0xf8, 0x5d, 0x03, 0x7e, 0x3c, 0x18, 0xd4, 0xf4, 0x79, 0xff, 0xff, 0x01, 0x00, 0x06, 0xbe,
+	0xb1, 0xba, 0x10, 0x0b, 0x00, 0x00,
 }
@@ -0,0 +1,153 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"go.uber.org/zap"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+// internalMethodPrefix is the prefix of methods called by other milvus components, which are authenticated by the node token
+const internalMethodPrefix = "/milvus.proto.proxy.Proxy/"
+
+// basicAuthPrefix is the optional scheme prefix of the authorization header
+const basicAuthPrefix = "Basic "
+
+// parseAuthorization decodes the base64 encoded `username:password` carried by the authorization header
+func parseAuthorization(authorization string) (string, string, bool) {
+	authorization = strings.TrimPrefix(authorization, basicAuthPrefix)
+	rawToken, err := crypto.Base64Decode(authorization)
+	if err != nil {
+		return "", "", false
+	}
+	secrets := strings.SplitN(rawToken, util.CredentialSeparator, 2)
+	if len(secrets) < 2 {
+		return "", "", false
+	}
+	return secrets[0], secrets[1], true
+}
+
+// validAuth checks whether the authorization header matches the credential cached in proxy
+func validAuth(ctx context.Context, authorization []string) bool {
+	if len(authorization) < 1 {
+		return false
+	}
+	username, password, ok := parseAuthorization(authorization[0])
+	if !ok {
+		return false
+	}
+	if globalMetaCache == nil {
+		return false
+	}
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, username)
+	if err != nil {
+		log.Debug("failed to get credential info", zap.String("username", username), zap.Error(err))
+		return false
+	}
+	return passwordVerify(password, credInfo)
+}
+
+// passwordVerify checks the raw password against the credential, the verified password is cached as sha256
+// so that bcrypt, which is costly by design, is only run once for every credential
+func passwordVerify(rawPwd string, credInfo *internalpb.CredentialInfo) bool {
+	sha256Pwd := crypto.SHA256(rawPwd, credInfo.Username)
+	if credInfo.Sha256Password != "" {
+		return subtle.ConstantTimeCompare([]byte(sha256Pwd), []byte(credInfo.Sha256Password)) == 1
+	}
+	if !crypto.PasswordVerify(rawPwd, credInfo.EncryptedPassword) {
+		return false
+	}
+	globalMetaCache.CacheVerifiedCredential(credInfo, sha256Pwd)
+	return true
+}
+
+// validNodeToken checks whether the node token carried by the grpc metadata matches the one of milvus components
+func validNodeToken(ctx context.Context, nodeToken string) bool {
+	if nodeToken == "" {
+		return false
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return false
+	}
+	tokens := md[util.HeaderNodeToken]
+	return len(tokens) > 0 && subtle.ConstantTimeCompare([]byte(tokens[0]), []byte(nodeToken)) == 1
+}
+
+// getCurUserFromContext returns the username carried by the authorization header of the incoming grpc metadata
+func getCurUserFromContext(ctx context.Context) (string, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", fmt.Errorf("fail to get md from the context")
+	}
+	authorization := md[strings.ToLower(util.HeaderAuthorize)]
+	if len(authorization) < 1 {
+		return "", fmt.Errorf("fail to get authorization from the md, authorize:[%s]", util.HeaderAuthorize)
+	}
+	username, _, ok := parseAuthorization(authorization[0])
+	if !ok {
+		return "", fmt.Errorf("fail to decode the authorization")
+	}
+	return username, nil
+}
+
+// AuthenticationInterceptor verifies the username and password carried by the grpc metadata,
+// the request is rejected with `PermissionDenied` if the credential is invalid
+func AuthenticationInterceptor(ctx context.Context) (context.Context, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil, status.Error(codes.PermissionDenied, "missing metadata, auth check failure")
+	}
+	if !validAuth(ctx, md[strings.ToLower(util.HeaderAuthorize)]) {
+		return nil, status.Error(codes.PermissionDenied, "auth check failure, please check username and password are correct")
+	}
+	return ctx, nil
+}
+
+// UnaryServerAuthInterceptor returns a grpc unary interceptor which authenticates the requests sent by clients
+// when `common.security.authorizationEnabled` is set, the requests sent by milvus components must carry nodeToken
+func UnaryServerAuthInterceptor(nodeToken string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if !Params.CommonCfg.AuthorizationEnabled {
+			return handler(ctx, req)
+		}
+		if strings.HasPrefix(info.FullMethod, internalMethodPrefix) {
+			if !validNodeToken(ctx, nodeToken) {
+				return nil, status.Error(codes.PermissionDenied, "node token check failure")
+			}
+			return handler(ctx, req)
+		}
+		newCtx, err := AuthenticationInterceptor(ctx)
+		if err != nil {
+			return nil, err
+		}
+		return handler(newCtx, req)
+	}
+}
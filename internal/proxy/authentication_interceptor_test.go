@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func TestValidAuth(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	encryptedPassword, err := crypto.PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: encryptedPassword})
+
+	assert.False(t, validAuth(ctx, nil))
+	assert.False(t, validAuth(ctx, []string{"not base64"}))
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("user1")}))
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("user1:654321")}))
+	assert.False(t, validAuth(ctx, []string{crypto.Base64Encode("user2:123456")}))
+	assert.True(t, validAuth(ctx, []string{crypto.Base64Encode("user1:123456")}))
+	assert.True(t, validAuth(ctx, []string{"Basic " + crypto.Base64Encode("user1:123456")}))
+}
+
+func TestPasswordVerify(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	encryptedPassword, err := crypto.PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: encryptedPassword})
+
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.False(t, passwordVerify("654321", credInfo))
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", credInfo.Sha256Password)
+
+	// the verified password is cached
+	assert.True(t, passwordVerify("123456", credInfo))
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, crypto.SHA256("123456", "user1"), credInfo.Sha256Password)
+	assert.True(t, passwordVerify("123456", credInfo))
+	assert.False(t, passwordVerify("654321", credInfo))
+
+	// the credential updated meanwhile is not overwritten
+	stale := credInfo
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: encryptedPassword})
+	globalMetaCache.CacheVerifiedCredential(stale, "stale")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "", credInfo.Sha256Password)
+}
+
+func TestUnaryServerAuthInterceptor(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	encryptedPassword, err := crypto.PasswordEncrypt("123456")
+	assert.Nil(t, err)
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: encryptedPassword})
+
+	Params.Init()
+	authEnabled := Params.CommonCfg.AuthorizationEnabled
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = authEnabled
+	}()
+
+	interceptor := UnaryServerAuthInterceptor("node-token")
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return "ok", nil
+	}
+	externalInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.milvus.MilvusService/HasCollection"}
+	internalInfo := &grpc.UnaryServerInfo{FullMethod: "/milvus.proto.proxy.Proxy/InvalidateCollectionMetaCache"}
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		Params.CommonCfg.AuthorizationEnabled = false
+		resp, err := interceptor(ctx, nil, externalInfo, handler)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+
+	Params.CommonCfg.AuthorizationEnabled = true
+
+	t.Run("internal method", func(t *testing.T) {
+		_, err := interceptor(ctx, nil, internalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		md := metadata.Pairs(util.HeaderNodeToken, "wrong-token")
+		_, err = interceptor(metadata.NewIncomingContext(ctx, md), nil, internalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		// the credential of users doesn't grant the access to internal methods
+		md = metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("user1:123456"))
+		_, err = interceptor(metadata.NewIncomingContext(ctx, md), nil, internalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+		md = metadata.Pairs(util.HeaderNodeToken, "node-token")
+		resp, err := interceptor(metadata.NewIncomingContext(ctx, md), nil, internalInfo, handler)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", resp)
+
+		// no internal method is allowed if the node token is not set
+		md = metadata.Pairs(util.HeaderNodeToken, "")
+		_, err = UnaryServerAuthInterceptor("")(metadata.NewIncomingContext(ctx, md), nil, internalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("missing metadata", func(t *testing.T) {
+		_, err := interceptor(ctx, nil, externalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("wrong password", func(t *testing.T) {
+		md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("user1:654321"))
+		_, err := interceptor(metadata.NewIncomingContext(ctx, md), nil, externalInfo, handler)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	t.Run("valid credential", func(t *testing.T) {
+		md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode("user1:123456"))
+		resp, err := interceptor(metadata.NewIncomingContext(ctx, md), nil, externalInfo, handler)
+		assert.Nil(t, err)
+		assert.Equal(t, "ok", resp)
+	})
+}
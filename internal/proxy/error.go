@@ -20,6 +20,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
@@ -71,3 +72,20 @@ func msgProxyIsUnhealthy(id UniqueID) string {
 func errProxyIsUnhealthy(id UniqueID) error {
 	return errors.New(msgProxyIsUnhealthy(id))
 }
+
+// errPermissionDenied is returned when the current user has no privilege to perform the request
+var errPermissionDenied = errors.New("permission denied")
+
+// errRateLimited is returned when the request is rejected by the rate limiter
+var errRateLimited = errors.New("rate limit exceeded")
+
+// errorCodeOf returns the ErrorCode which should be set in the response status for the error
+func errorCodeOf(err error) commonpb.ErrorCode {
+	if errors.Is(err, errPermissionDenied) {
+		return commonpb.ErrorCode_PermissionDenied
+	}
+	if errors.Is(err, errRateLimited) {
+		return commonpb.ErrorCode_RateLimit
+	}
+	return commonpb.ErrorCode_UnexpectedError
+}
@@ -43,7 +43,10 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/proxypb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/importutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -105,7 +108,7 @@ func (node *Proxy) InvalidateCollectionMetaCache(ctx context.Context, request *p
 
 	collectionName := request.CollectionName
 	if globalMetaCache != nil {
-		globalMetaCache.RemoveCollection(ctx, collectionName) // no need to return error, though collection may be not cached
+		globalMetaCache.RemoveCollection(ctx, request.DbName, collectionName) // no need to return error, though collection may be not cached
 	}
 	logutil.Logger(ctx).Debug("complete to invalidate collection meta cache",
 		zap.String("role", typeutil.ProxyRole),
@@ -143,6 +146,67 @@ func (node *Proxy) ReleaseDQLMessageStream(ctx context.Context, request *proxypb
 	}, nil
 }
 
+// InvalidateCredentialCache invalidate the credential cache of specified username.
+func (node *Proxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	ctx = logutil.WithModule(ctx, moduleName)
+	logutil.Logger(ctx).Debug("received request to invalidate credential cache",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", request.Username))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RemoveCredential(request.Username) // no need to return error, though credential may be not cached
+	}
+	logutil.Logger(ctx).Debug("complete to invalidate credential cache",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("username", request.Username))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// RefreshPolicyInfoCache clears the cached privileges of users, the privileges will be fetched from RootCoord on demand.
+func (node *Proxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	ctx = logutil.WithModule(ctx, moduleName)
+	logutil.Logger(ctx).Debug("received request to refresh policy info cache",
+		zap.String("role", typeutil.ProxyRole))
+
+	if globalMetaCache != nil {
+		globalMetaCache.RefreshPolicyInfo()
+	}
+	logutil.Logger(ctx).Debug("complete to refresh policy info cache",
+		zap.String("role", typeutil.ProxyRole))
+
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+// SetDMLQuota slows down or rejects the DML requests, it is called by RootCoord according to the load of the cluster.
+func (node *Proxy) SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+	if node.rateLimiter == nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "quota is not enabled on proxy",
+		}, nil
+	}
+	factor, _ := node.rateLimiter.getDMLQuota()
+	if factor != request.GetFactor() {
+		log.Info("DML quota of proxy changed", zap.String("role", typeutil.ProxyRole),
+			zap.Float64("factor", request.GetFactor()), zap.String("reason", request.GetReason()))
+	}
+	node.rateLimiter.setDMLQuota(request.GetFactor(), request.GetReason())
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 // TODO(dragondriver): add more detailed ut for ConsistencyLevel, should we support multiple consistency level in Proxy?
 // CreateCollection create a collection by the schema.
 func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
@@ -188,7 +252,7 @@ func (node *Proxy) CreateCollection(ctx context.Context, request *milvuspb.Creat
 			zap.String("consistency_level", request.ConsistencyLevel.String()))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -278,7 +342,7 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 			zap.String("collection", request.CollectionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -318,6 +382,9 @@ func (node *Proxy) DropCollection(ctx context.Context, request *milvuspb.DropCol
 		zap.String("db", request.DbName),
 		zap.String("collection", request.CollectionName))
 
+	if node.rateLimiter != nil && dct.result.GetErrorCode() == commonpb.ErrorCode_Success {
+		node.rateLimiter.removeCollection(request.DbName, request.CollectionName)
+	}
 	return dct.result, nil
 }
 
@@ -356,7 +423,7 @@ func (node *Proxy) HasCollection(ctx context.Context, request *milvuspb.HasColle
 
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -434,7 +501,7 @@ func (node *Proxy) LoadCollection(ctx context.Context, request *milvuspb.LoadCol
 			zap.String("collection", request.CollectionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -514,7 +581,7 @@ func (node *Proxy) ReleaseCollection(ctx context.Context, request *milvuspb.Rele
 			zap.String("collection", request.CollectionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -595,7 +662,7 @@ func (node *Proxy) DescribeCollection(ctx context.Context, request *milvuspb.Des
 
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -676,7 +743,7 @@ func (node *Proxy) GetCollectionStatistics(ctx context.Context, request *milvusp
 
 		return &milvuspb.GetCollectionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -758,7 +825,7 @@ func (node *Proxy) ShowCollections(ctx context.Context, request *milvuspb.ShowCo
 
 		return &milvuspb.ShowCollectionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -843,7 +910,7 @@ func (node *Proxy) CreatePartition(ctx context.Context, request *milvuspb.Create
 			zap.String("partition", request.PartitionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -931,7 +998,7 @@ func (node *Proxy) DropPartition(ctx context.Context, request *milvuspb.DropPart
 			zap.String("partition", request.PartitionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1022,7 +1089,7 @@ func (node *Proxy) HasPartition(ctx context.Context, request *milvuspb.HasPartit
 
 		return &milvuspb.BoolResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 			Value: false,
@@ -1114,7 +1181,7 @@ func (node *Proxy) LoadPartitions(ctx context.Context, request *milvuspb.LoadPar
 			zap.Any("partitions", request.PartitionNames))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1201,7 +1268,7 @@ func (node *Proxy) ReleasePartitions(ctx context.Context, request *milvuspb.Rele
 			zap.Any("partitions", request.PartitionNames))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1291,7 +1358,7 @@ func (node *Proxy) GetPartitionStatistics(ctx context.Context, request *milvuspb
 
 		return &milvuspb.GetPartitionStatisticsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1382,7 +1449,7 @@ func (node *Proxy) ShowPartitions(ctx context.Context, request *milvuspb.ShowPar
 
 		return &milvuspb.ShowPartitionsResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1474,7 +1541,7 @@ func (node *Proxy) CreateIndex(ctx context.Context, request *milvuspb.CreateInde
 			zap.Any("extra_params", request.ExtraParams))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1571,7 +1638,7 @@ func (node *Proxy) DescribeIndex(ctx context.Context, request *milvuspb.Describe
 
 		return &milvuspb.DescribeIndexResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1670,7 +1737,7 @@ func (node *Proxy) DropIndex(ctx context.Context, request *milvuspb.DropIndexReq
 			zap.String("index name", request.IndexName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -1768,7 +1835,7 @@ func (node *Proxy) GetIndexBuildProgress(ctx context.Context, request *milvuspb.
 
 		return &milvuspb.GetIndexBuildProgressResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1868,7 +1935,7 @@ func (node *Proxy) GetIndexState(ctx context.Context, request *milvuspb.GetIndex
 
 		return &milvuspb.GetIndexStateResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -1950,6 +2017,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 					MsgType: commonpb.MsgType_Insert,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -1973,7 +2041,7 @@ func (node *Proxy) Insert(ctx context.Context, request *milvuspb.InsertRequest)
 		}
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 			ErrIndex: errIndex,
@@ -2064,6 +2132,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 					MsgType: commonpb.MsgType_Delete,
 					MsgID:   0,
 				},
+				DbName:         request.DbName,
 				CollectionName: request.CollectionName,
 				PartitionName:  request.PartitionName,
 				// RowData: transfer column based request to this
@@ -2073,6 +2142,46 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 		chTicker: node.chTicker,
 	}
 
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}
+	}
+
+	if request.DryRun {
+		count, err := node.countEntitiesByExpr(ctx, request)
+		if err != nil {
+			log.Error("Failed to count entities by expr: "+err.Error(), zap.String("traceID", traceID))
+			return constructFailedResponse(err), nil
+		}
+		return &milvuspb.MutationResult{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DeleteCnt: count,
+		}, nil
+	}
+	primaryKeys, queried, err := node.queryPrimaryKeysByExpr(ctx, request)
+	if err != nil {
+		log.Error("Failed to get primary keys from expr: "+err.Error(), zap.String("traceID", traceID))
+		return constructFailedResponse(err), nil
+	}
+	if queried {
+		if typeutil.GetSizeOfIDs(primaryKeys) == 0 {
+			log.Debug("No entity matches the delete expr", zap.String("expr", request.Expr), zap.String("traceID", traceID))
+			return &milvuspb.MutationResult{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_Success,
+				},
+				IDs: &schemapb.IDs{},
+			}, nil
+		}
+		dt.queriedPrimaryKeys = primaryKeys
+	}
+
 	log.Debug("Enqueue delete request in Proxy",
 		zap.String("role", typeutil.ProxyRole),
 		zap.String("db", request.DbName),
@@ -2083,12 +2192,7 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 	// MsgID will be set by Enqueue()
 	if err := node.sched.dmQueue.Enqueue(dt); err != nil {
 		log.Error("Failed to enqueue delete task: "+err.Error(), zap.String("traceID", traceID))
-		return &milvuspb.MutationResult{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
-		}, nil
+		return constructFailedResponse(err), nil
 	}
 
 	log.Debug("Detail of delete request in Proxy",
@@ -2103,15 +2207,239 @@ func (node *Proxy) Delete(ctx context.Context, request *milvuspb.DeleteRequest)
 
 	if err := dt.WaitToFinish(); err != nil {
 		log.Error("Failed to execute delete task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		return constructFailedResponse(err), nil
+	}
+
+	return dt.result, nil
+}
+
+// queryPrimaryKeysByExpr resolves the primary keys of the entities to delete. The keys are taken from the expr
+// directly if it is "pk in [a, b]", otherwise the expr is sent to query nodes and queried is true.
+// At most MaxDeleteCount+1 entities are queried, the request is rejected if the limit is exceeded.
+// It must be called before the delete task is enqueued, since the time tick of the dml channels
+// will not move forward while the delete task is pending.
+func (node *Proxy) queryPrimaryKeysByExpr(ctx context.Context, request *milvuspb.DeleteRequest) (primaryKeys *schemapb.IDs, queried bool, err error) {
+	if err := validateCollectionName(request.CollectionName); err != nil {
+		return nil, false, err
+	}
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, request.DbName, request.CollectionName)
+	if err != nil {
+		return nil, false, err
+	}
+	primaryKeys, ok, err := getPrimaryKeysFromExpr(schema, request.Expr)
+	if err != nil || ok {
+		return primaryKeys, false, err
+	}
+
+	schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, false, err
+	}
+	pkField, err := schemaHelper.GetPrimaryKeyField()
+	if err != nil {
+		return nil, false, err
+	}
+	resp, err := node.queryForDelete(ctx, request, []string{pkField.Name}, []*commonpb.KeyValuePair{
+		{Key: LimitKey, Value: strconv.FormatInt(Params.ProxyCfg.MaxDeleteCount+1, 10)},
+	})
+	if err != nil {
+		return nil, true, err
+	}
+	if resp.Status.ErrorCode == commonpb.ErrorCode_EmptyCollection {
+		return &schemapb.IDs{}, true, nil
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, true, errors.New(resp.Status.Reason)
+	}
+
+	primaryKeys = &schemapb.IDs{}
+	for _, fieldData := range resp.FieldsData {
+		if fieldData.FieldId != pkField.FieldID {
+			continue
+		}
+		switch pkField.DataType {
+		case schemapb.DataType_Int64:
+			primaryKeys.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: fieldData.GetScalars().GetLongData().GetData()}}
+		case schemapb.DataType_VarChar:
+			primaryKeys.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: fieldData.GetScalars().GetStringData().GetData()}}
+		}
+	}
+	rowNum := typeutil.GetSizeOfIDs(primaryKeys)
+	log.Debug("query primary keys by expr", zap.String("expr", request.Expr), zap.Int("len of primary keys", rowNum))
+	if int64(rowNum) > Params.ProxyCfg.MaxDeleteCount {
+		return nil, true, fmt.Errorf("the number of entities to delete exceeds the limit (%d)", Params.ProxyCfg.MaxDeleteCount)
+	}
+	return primaryKeys, true, nil
+}
+
+// countEntitiesByExpr counts the entities matching the expr of the delete request by count(*) on query nodes,
+// which is used by dry run
+func (node *Proxy) countEntitiesByExpr(ctx context.Context, request *milvuspb.DeleteRequest) (int64, error) {
+	if err := validateCollectionName(request.CollectionName); err != nil {
+		return 0, err
+	}
+	resp, err := node.queryForDelete(ctx, request, []string{"count(*)"}, nil)
+	if err != nil {
+		return 0, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return 0, errors.New(resp.Status.Reason)
+	}
+	if len(resp.FieldsData) != 1 || len(resp.FieldsData[0].GetScalars().GetLongData().GetData()) != 1 {
+		return 0, errors.New("invalid result of count(*)")
+	}
+	return resp.FieldsData[0].GetScalars().GetLongData().GetData()[0], nil
+}
+
+// queryForDelete queries the entities matching the expr of the delete request. The query is issued by proxy on behalf
+// of the delete request, so the privilege and the rate are checked against delete instead of query.
+func (node *Proxy) queryForDelete(ctx context.Context, request *milvuspb.DeleteRequest, outputFields []string,
+	queryParams []*commonpb.KeyValuePair) (*milvuspb.QueryResults, error) {
+	var partitionNames []string
+	if len(request.PartitionName) > 0 {
+		partitionNames = []string{request.PartitionName}
+	}
+	qt := &queryTask{
+		ctx:       ctx,
+		Condition: NewTaskCondition(ctx),
+		RetrieveRequest: &internalpb.RetrieveRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_Retrieve,
+				SourceID: Params.ProxyCfg.ProxyID,
+			},
+			ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
+		},
+		resultBuf: make(chan []*internalpb.RetrieveResults),
+		query: &milvuspb.QueryRequest{
+			DbName:         request.DbName,
+			CollectionName: request.CollectionName,
+			Expr:           request.Expr,
+			OutputFields:   outputFields,
+			PartitionNames: partitionNames,
+			QueryParams:    queryParams,
+		},
+		chMgr:     node.chMgr,
+		qc:        node.queryCoord,
+		forDelete: true,
+	}
+	if err := node.sched.dqQueue.Enqueue(qt); err != nil {
+		return nil, err
+	}
+	if err := qt.WaitToFinish(); err != nil {
+		return nil, err
+	}
+	return qt.result, nil
+}
+
+// Upsert insert records into collection, the existing records with the same primary keys are replaced.
+func (node *Proxy) Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error) {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-Upsert")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+	log.Info("Start processing upsert request in Proxy", zap.String("traceID", traceID))
+	defer log.Info("Finish processing upsert request in Proxy", zap.String("traceID", traceID))
+
+	if !node.checkHealthy() {
+		return &milvuspb.MutationResult{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	ut := &upsertTask{
+		insertTask: insertTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			req: &milvuspb.InsertRequest{
+				Base:           request.Base,
+				DbName:         request.DbName,
+				CollectionName: request.CollectionName,
+				PartitionName:  request.PartitionName,
+				FieldsData:     request.FieldsData,
+				HashKeys:       request.HashKeys,
+				NumRows:        request.NumRows,
+			},
+			BaseInsertTask: BaseInsertTask{
+				BaseMsg: msgstream.BaseMsg{
+					HashValues: request.HashKeys,
+				},
+				InsertRequest: internalpb.InsertRequest{
+					Base: &commonpb.MsgBase{
+						MsgType: commonpb.MsgType_Insert,
+						MsgID:   0,
+					},
+					DbName:         request.DbName,
+					CollectionName: request.CollectionName,
+					PartitionName:  request.PartitionName,
+				},
+			},
+			rowIDAllocator: node.idAllocator,
+			segIDAssigner:  node.segAssigner,
+			chMgr:          node.chMgr,
+			chTicker:       node.chTicker,
+		},
+		upsertReq: request,
+	}
+
+	if len(ut.PartitionName) <= 0 {
+		ut.PartitionName = Params.CommonCfg.DefaultPartitionName
+	}
+
+	constructFailedResponse := func(err error) *milvuspb.MutationResult {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
 		return &milvuspb.MutationResult{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
-		}, nil
+			ErrIndex: errIndex,
+		}
 	}
 
-	return dt.result, nil
+	log.Debug("Enqueue upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Int("len(FieldsData)", len(request.FieldsData)),
+		zap.Int("len(HashKeys)", len(request.HashKeys)),
+		zap.Uint32("NumRows", request.NumRows),
+		zap.String("traceID", traceID))
+
+	if err := node.sched.dmQueue.Enqueue(ut); err != nil {
+		log.Debug("Failed to enqueue upsert task: " + err.Error())
+		return constructFailedResponse(err), nil
+	}
+
+	log.Debug("Detail of upsert request in Proxy",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("msgID", ut.Base.MsgID),
+		zap.Uint64("BeginTS", ut.BeginTs()),
+		zap.Uint64("EndTS", ut.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.String("partition", request.PartitionName),
+		zap.Uint32("NumRows", request.NumRows),
+		zap.String("traceID", traceID))
+
+	if err := ut.WaitToFinish(); err != nil {
+		log.Debug("Failed to execute upsert task in task scheduler: "+err.Error(), zap.String("traceID", traceID))
+		return constructFailedResponse(err), nil
+	}
+
+	if ut.result.Status.ErrorCode != commonpb.ErrorCode_Success {
+		numRows := request.NumRows
+		errIndex := make([]uint32, numRows)
+		for i := uint32(0); i < numRows; i++ {
+			errIndex[i] = i
+		}
+		ut.result.ErrIndex = errIndex
+	}
+
+	return ut.result, nil
 }
 
 // Search search the most similar records of requests.
@@ -2178,7 +2506,7 @@ func (node *Proxy) Search(ctx context.Context, request *milvuspb.SearchRequest)
 
 		return &milvuspb.SearchResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -2285,6 +2613,7 @@ func (node *Proxy) Flush(ctx context.Context, request *milvuspb.FlushRequest) (*
 			zap.String("db", request.DbName),
 			zap.Any("collections", request.CollectionNames))
 
+		resp.Status.ErrorCode = errorCodeOf(err)
 		resp.Status.Reason = err.Error()
 		return resp, nil
 	}
@@ -2379,7 +2708,7 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 
 		return &milvuspb.QueryResults{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				ErrorCode: errorCodeOf(err),
 				Reason:    err.Error(),
 			},
 		}, nil
@@ -2429,8 +2758,9 @@ func (node *Proxy) Query(ctx context.Context, request *milvuspb.QueryRequest) (*
 		zap.Any("partitions", request.PartitionNames))
 
 	return &milvuspb.QueryResults{
-		Status:     qt.result.Status,
-		FieldsData: qt.result.FieldsData,
+		Status:         qt.result.Status,
+		FieldsData:     qt.result.FieldsData,
+		IteratorCursor: qt.result.IteratorCursor,
 	}, nil
 }
 
@@ -2472,7 +2802,7 @@ func (node *Proxy) CreateAlias(ctx context.Context, request *milvuspb.CreateAlia
 			zap.String("collection", request.CollectionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -2557,7 +2887,7 @@ func (node *Proxy) DropAlias(ctx context.Context, request *milvuspb.DropAliasReq
 			zap.String("alias", request.Alias))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -2641,7 +2971,7 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 			zap.String("collection", request.CollectionName))
 
 		return &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			ErrorCode: errorCodeOf(err),
 			Reason:    err.Error(),
 		}, nil
 	}
@@ -2690,887 +3020,1847 @@ func (node *Proxy) AlterAlias(ctx context.Context, request *milvuspb.AlterAliasR
 	return aat.result, nil
 }
 
-// CalcDistance calculates the distances between vectors.
-func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+// RenameCollection renames a collection, aliases of the collection are kept.
+func (node *Proxy) RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
 	if !node.checkHealthy() {
-		return &milvuspb.CalcDistanceResults{
-			Status: unhealthyStatus(),
-		}, nil
-	}
-
-	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
-	metric, err := distance.ValidateMetricType(param)
-	if err != nil {
-		return &milvuspb.CalcDistanceResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    err.Error(),
-			},
-		}, nil
+		return unhealthyStatus(), nil
 	}
 
-	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-RenameCollection")
 	defer sp.Finish()
 	traceID, _, _ := trace.InfoFromSpan(sp)
 
-	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
-		outputFields := []string{ids.FieldName}
+	rct := &RenameCollectionTask{
+		ctx:                     ctx,
+		Condition:               NewTaskCondition(ctx),
+		RenameCollectionRequest: request,
+		rootCoord:               node.rootCoord,
+	}
 
-		queryRequest := &milvuspb.QueryRequest{
-			DbName:         "",
-			CollectionName: ids.CollectionName,
-			PartitionNames: ids.PartitionNames,
-			OutputFields:   outputFields,
-		}
+	method := "RenameCollection"
 
-		qt := &queryTask{
-			ctx:       ctx,
-			Condition: NewTaskCondition(ctx),
-			RetrieveRequest: &internalpb.RetrieveRequest{
-				Base: &commonpb.MsgBase{
-					MsgType:  commonpb.MsgType_Retrieve,
-					SourceID: Params.ProxyCfg.ProxyID,
-				},
-				ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
-			},
-			resultBuf: make(chan []*internalpb.RetrieveResults),
-			query:     queryRequest,
-			chMgr:     node.chMgr,
-			qc:        node.queryCoord,
-			ids:       ids.IdArray,
-		}
-
-		err := node.sched.dqQueue.Enqueue(qt)
-		if err != nil {
-			log.Debug("CalcDistance queryTask failed to enqueue",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole),
-				zap.String("db", queryRequest.DbName),
-				zap.String("collection", queryRequest.CollectionName),
-				zap.Any("partitions", queryRequest.PartitionNames))
-
-			return &milvuspb.QueryResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, err
-		}
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
 
-		log.Debug("CalcDistance queryTask enqueued",
+	if err := node.sched.ddQueue.Enqueue(rct); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole),
-			zap.Int64("msgID", qt.Base.MsgID),
-			zap.Uint64("timestamp", qt.Base.Timestamp),
-			zap.String("db", queryRequest.DbName),
-			zap.String("collection", queryRequest.CollectionName),
-			zap.Any("partitions", queryRequest.PartitionNames),
-			zap.Any("OutputFields", queryRequest.OutputFields))
+			zap.String("db", request.DbName),
+			zap.String("oldName", request.OldName),
+			zap.String("newName", request.NewName))
 
-		err = qt.WaitToFinish()
-		if err != nil {
-			log.Debug("CalcDistance queryTask failed to WaitToFinish",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole),
-				zap.Int64("msgID", qt.Base.MsgID),
-				zap.Uint64("timestamp", qt.Base.Timestamp),
-				zap.String("db", queryRequest.DbName),
-				zap.String("collection", queryRequest.CollectionName),
-				zap.Any("partitions", queryRequest.PartitionNames),
-				zap.Any("OutputFields", queryRequest.OutputFields))
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
 
-			return &milvuspb.QueryResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, err
-		}
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", rct.ID()),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
 
-		log.Debug("CalcDistance queryTask Done",
+	if err := rct.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole),
-			zap.Int64("msgID", qt.Base.MsgID),
-			zap.Uint64("timestamp", qt.Base.Timestamp),
-			zap.String("db", queryRequest.DbName),
-			zap.String("collection", queryRequest.CollectionName),
-			zap.Any("partitions", queryRequest.PartitionNames),
-			zap.Any("OutputFields", queryRequest.OutputFields))
+			zap.Int64("MsgID", rct.ID()),
+			zap.Uint64("BeginTs", rct.BeginTs()),
+			zap.Uint64("EndTs", rct.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("oldName", request.OldName),
+			zap.String("newName", request.NewName))
 
-		return &milvuspb.QueryResults{
-			Status:     qt.result.Status,
-			FieldsData: qt.result.FieldsData,
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
 		}, nil
 	}
 
-	// the vectors retrieved are random order, we need re-arrange the vectors by the order of input ids
-	arrangeFunc := func(ids *milvuspb.VectorIDs, retrievedFields []*schemapb.FieldData) (*schemapb.VectorField, error) {
-		var retrievedIds *schemapb.ScalarField
-		var retrievedVectors *schemapb.VectorField
-		for _, fieldData := range retrievedFields {
-			if fieldData.FieldName == ids.FieldName {
-				retrievedVectors = fieldData.GetVectors()
-			}
-			if fieldData.Type == schemapb.DataType_Int64 {
-				retrievedIds = fieldData.GetScalars()
-			}
-		}
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", rct.ID()),
+		zap.Uint64("BeginTs", rct.BeginTs()),
+		zap.Uint64("EndTs", rct.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("oldName", request.OldName),
+		zap.String("newName", request.NewName))
 
-		if retrievedIds == nil || retrievedVectors == nil {
-			return nil, errors.New("failed to fetch vectors")
-		}
+	return rct.result, nil
+}
 
-		dict := make(map[int64]int)
-		for index, id := range retrievedIds.GetLongData().Data {
-			dict[id] = index
-		}
+// AlterCollection alters the properties of a collection, the change is visible in DescribeCollection.
+func (node *Proxy) AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-		inputIds := ids.IdArray.GetIntId().Data
-		if retrievedVectors.GetFloatVector() != nil {
-			floatArr := retrievedVectors.GetFloatVector().Data
-			element := retrievedVectors.GetDim()
-			result := make([]float32, 0, int64(len(inputIds))*element)
-			for _, id := range inputIds {
-				index, ok := dict[id]
-				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
-					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
-				}
-				result = append(result, floatArr[int64(index)*element:int64(index+1)*element]...)
-			}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AlterCollection")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
 
-			return &schemapb.VectorField{
-				Dim: element,
-				Data: &schemapb.VectorField_FloatVector{
-					FloatVector: &schemapb.FloatArray{
-						Data: result,
-					},
-				},
-			}, nil
-		}
+	act := &AlterCollectionTask{
+		ctx:                    ctx,
+		Condition:              NewTaskCondition(ctx),
+		AlterCollectionRequest: request,
+		rootCoord:              node.rootCoord,
+	}
 
-		if retrievedVectors.GetBinaryVector() != nil {
-			binaryArr := retrievedVectors.GetBinaryVector()
-			element := retrievedVectors.GetDim()
-			if element%8 != 0 {
-				element = element + 8 - element%8
-			}
+	method := "AlterCollection"
 
-			result := make([]byte, 0, int64(len(inputIds))*element)
-			for _, id := range inputIds {
-				index, ok := dict[id]
-				if !ok {
-					log.Error("id not found in CalcDistance", zap.Int64("id", id))
-					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
-				}
-				result = append(result, binaryArr[int64(index)*element:int64(index+1)*element]...)
-			}
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.Properties))
 
-			return &schemapb.VectorField{
-				Dim: element * 8,
-				Data: &schemapb.VectorField_BinaryVector{
-					BinaryVector: result,
-				},
-			}, nil
-		}
+	if err := node.sched.ddQueue.Enqueue(act); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("properties", request.Properties))
 
-		return nil, errors.New("failed to fetch vectors")
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
 	}
 
-	log.Debug("CalcDistance received",
+	log.Debug(
+		rpcEnqueued(method),
 		zap.String("traceID", traceID),
 		zap.String("role", typeutil.ProxyRole),
-		zap.String("metric", metric))
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.Properties))
 
-	vectorsLeft := request.GetOpLeft().GetDataArray()
-	opLeft := request.GetOpLeft().GetIdArray()
-	if opLeft != nil {
-		log.Debug("OpLeft IdArray not empty, Get vectors by id",
+	if err := act.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", act.ID()),
+			zap.Uint64("BeginTs", act.BeginTs()),
+			zap.Uint64("EndTs", act.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("properties", request.Properties))
 
-		result, err := query(opLeft)
-		if err != nil {
-			log.Debug("Failed to get left vectors by id",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", act.ID()),
+		zap.Uint64("BeginTs", act.BeginTs()),
+		zap.Uint64("EndTs", act.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("properties", request.Properties))
 
-		log.Debug("OpLeft IdArray not empty, Get vectors by id done",
-			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+	return act.result, nil
+}
 
-		vectorsLeft, err = arrangeFunc(opLeft, result.FieldsData)
-		if err != nil {
-			log.Debug("Failed to re-arrange left vectors",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+// AddField adds a scalar field to a collection, the rows inserted before take the default value of the field.
+func (node *Proxy) AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-AddField")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
 
-		log.Debug("Re-arrange left vectors done",
-			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+	aft := &AddFieldTask{
+		ctx:             ctx,
+		Condition:       NewTaskCondition(ctx),
+		AddFieldRequest: request,
+		rootCoord:       node.rootCoord,
 	}
 
-	if vectorsLeft == nil {
-		msg := "Left vectors array is empty"
-		log.Debug(msg,
-			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+	method := "AddField"
 
-		return &milvuspb.CalcDistanceResults{
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    msg,
-			},
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("field", request.Field))
+
+	if err := node.sched.ddQueue.Enqueue(aft); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("field", request.Field))
+
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
 		}, nil
 	}
 
-	vectorsRight := request.GetOpRight().GetDataArray()
-	opRight := request.GetOpRight().GetIdArray()
-	if opRight != nil {
-		log.Debug("OpRight IdArray not empty, Get vectors by id",
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("field", request.Field))
+
+	if err := aft.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", aft.ID()),
+			zap.Uint64("BeginTs", aft.BeginTs()),
+			zap.Uint64("EndTs", aft.EndTs()),
+			zap.String("db", request.DbName),
+			zap.String("collection", request.CollectionName),
+			zap.Any("field", request.Field))
 
-		result, err := query(opRight)
-		if err != nil {
-			log.Debug("Failed to get right vectors by id",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", aft.ID()),
+		zap.Uint64("BeginTs", aft.BeginTs()),
+		zap.Uint64("EndTs", aft.EndTs()),
+		zap.String("db", request.DbName),
+		zap.String("collection", request.CollectionName),
+		zap.Any("field", request.Field))
 
-		log.Debug("OpRight IdArray not empty, Get vectors by id done",
+	return aft.result, nil
+}
+
+// CreateDatabase creates a database namespace for collections.
+func (node *Proxy) CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CreateDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	cdt := &CreateDatabaseTask{
+		ctx:                   ctx,
+		Condition:             NewTaskCondition(ctx),
+		CreateDatabaseRequest: request,
+		rootCoord:             node.rootCoord,
+	}
+
+	method := "CreateDatabase"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	if err := node.sched.ddQueue.Enqueue(cdt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName))
 
-		vectorsRight, err = arrangeFunc(opRight, result.FieldsData)
-		if err != nil {
-			log.Debug("Failed to re-arrange right vectors",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName))
 
-		log.Debug("Re-arrange right vectors done",
+	if err := cdt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", cdt.ID()),
+			zap.Uint64("BeginTs", cdt.BeginTs()),
+			zap.Uint64("EndTs", cdt.EndTs()),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
 	}
 
-	if vectorsRight == nil {
-		msg := "Right vectors array is empty"
-		log.Debug(msg,
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", cdt.ID()),
+		zap.Uint64("BeginTs", cdt.BeginTs()),
+		zap.Uint64("EndTs", cdt.EndTs()),
+		zap.String("db", request.DbName))
+
+	return cdt.result, nil
+}
+
+// DropDatabase drops an empty database.
+func (node *Proxy) DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-DropDatabase")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	ddt := &DropDatabaseTask{
+		ctx:                 ctx,
+		Condition:           NewTaskCondition(ctx),
+		DropDatabaseRequest: request,
+		rootCoord:           node.rootCoord,
+	}
+
+	method := "DropDatabase"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", request.DbName))
+
+	if err := node.sched.ddQueue.Enqueue(ddt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	if err := ddt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ddt.ID()),
+			zap.Uint64("BeginTs", ddt.BeginTs()),
+			zap.Uint64("EndTs", ddt.EndTs()),
+			zap.String("db", request.DbName))
+
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ddt.ID()),
+		zap.Uint64("BeginTs", ddt.BeginTs()),
+		zap.Uint64("EndTs", ddt.EndTs()),
+		zap.String("db", request.DbName))
+
+	return ddt.result, nil
+}
+
+// ListDatabases lists all databases, including the default one.
+func (node *Proxy) ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.ListDatabasesResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-ListDatabases")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	ldt := &ListDatabasesTask{
+		ctx:                  ctx,
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: request,
+		rootCoord:            node.rootCoord,
+	}
+
+	method := "ListDatabases"
+
+	log.Debug(
+		rpcReceived(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole))
+
+	if err := node.sched.ddQueue.Enqueue(ldt); err != nil {
+		log.Warn(
+			rpcFailedToEnqueue(method),
+			zap.Error(err),
 			zap.String("traceID", traceID),
 			zap.String("role", typeutil.ProxyRole))
 
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcEnqueued(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()))
+
+	if err := ldt.WaitToFinish(); err != nil {
+		log.Warn(
+			rpcFailedToWaitToFinish(method),
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("MsgID", ldt.ID()),
+			zap.Uint64("BeginTs", ldt.BeginTs()),
+			zap.Uint64("EndTs", ldt.EndTs()))
+
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	log.Debug(
+		rpcDone(method),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.Int64("MsgID", ldt.ID()),
+		zap.Uint64("BeginTs", ldt.BeginTs()),
+		zap.Uint64("EndTs", ldt.EndTs()),
+		zap.Strings("databases", ldt.result.GetDbNames()))
+
+	return ldt.result, nil
+}
+
+// CalcDistance calculates the distances between vectors.
+func (node *Proxy) CalcDistance(ctx context.Context, request *milvuspb.CalcDistanceRequest) (*milvuspb.CalcDistanceResults, error) {
+	if !node.checkHealthy() {
+		return &milvuspb.CalcDistanceResults{
+			Status: unhealthyStatus(),
+		}, nil
+	}
+
+	param, _ := funcutil.GetAttrByKeyFromRepeatedKV("metric", request.GetParams())
+	metric, err := distance.ValidateMetricType(param)
+	if err != nil {
 		return &milvuspb.CalcDistanceResults{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    msg,
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
+
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ctx, "Proxy-CalcDistance")
+	defer sp.Finish()
+	traceID, _, _ := trace.InfoFromSpan(sp)
+
+	query := func(ids *milvuspb.VectorIDs) (*milvuspb.QueryResults, error) {
+		outputFields := []string{ids.FieldName}
+
+		queryRequest := &milvuspb.QueryRequest{
+			DbName:         "",
+			CollectionName: ids.CollectionName,
+			PartitionNames: ids.PartitionNames,
+			OutputFields:   outputFields,
+		}
+
+		qt := &queryTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  commonpb.MsgType_Retrieve,
+					SourceID: Params.ProxyCfg.ProxyID,
+				},
+				ResultChannelID: strconv.FormatInt(Params.ProxyCfg.ProxyID, 10),
+			},
+			resultBuf: make(chan []*internalpb.RetrieveResults),
+			query:     queryRequest,
+			chMgr:     node.chMgr,
+			qc:        node.queryCoord,
+			ids:       ids.IdArray,
+		}
+
+		err := node.sched.dqQueue.Enqueue(qt)
+		if err != nil {
+			log.Debug("CalcDistance queryTask failed to enqueue",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole),
+				zap.String("db", queryRequest.DbName),
+				zap.String("collection", queryRequest.CollectionName),
+				zap.Any("partitions", queryRequest.PartitionNames))
+
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: errorCodeOf(err),
+					Reason:    err.Error(),
+				},
+			}, err
+		}
+
+		log.Debug("CalcDistance queryTask enqueued",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("msgID", qt.Base.MsgID),
+			zap.Uint64("timestamp", qt.Base.Timestamp),
+			zap.String("db", queryRequest.DbName),
+			zap.String("collection", queryRequest.CollectionName),
+			zap.Any("partitions", queryRequest.PartitionNames),
+			zap.Any("OutputFields", queryRequest.OutputFields))
+
+		err = qt.WaitToFinish()
+		if err != nil {
+			log.Debug("CalcDistance queryTask failed to WaitToFinish",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole),
+				zap.Int64("msgID", qt.Base.MsgID),
+				zap.Uint64("timestamp", qt.Base.Timestamp),
+				zap.String("db", queryRequest.DbName),
+				zap.String("collection", queryRequest.CollectionName),
+				zap.Any("partitions", queryRequest.PartitionNames),
+				zap.Any("OutputFields", queryRequest.OutputFields))
+
+			return &milvuspb.QueryResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, err
+		}
+
+		log.Debug("CalcDistance queryTask Done",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole),
+			zap.Int64("msgID", qt.Base.MsgID),
+			zap.Uint64("timestamp", qt.Base.Timestamp),
+			zap.String("db", queryRequest.DbName),
+			zap.String("collection", queryRequest.CollectionName),
+			zap.Any("partitions", queryRequest.PartitionNames),
+			zap.Any("OutputFields", queryRequest.OutputFields))
+
+		return &milvuspb.QueryResults{
+			Status:     qt.result.Status,
+			FieldsData: qt.result.FieldsData,
+		}, nil
+	}
+
+	// the vectors retrieved are random order, we need re-arrange the vectors by the order of input ids
+	arrangeFunc := func(ids *milvuspb.VectorIDs, retrievedFields []*schemapb.FieldData) (*schemapb.VectorField, error) {
+		var retrievedIds *schemapb.ScalarField
+		var retrievedVectors *schemapb.VectorField
+		for _, fieldData := range retrievedFields {
+			if fieldData.FieldName == ids.FieldName {
+				retrievedVectors = fieldData.GetVectors()
+			}
+			if fieldData.Type == schemapb.DataType_Int64 || fieldData.Type == schemapb.DataType_VarChar {
+				retrievedIds = fieldData.GetScalars()
+			}
+		}
+
+		if retrievedIds == nil || retrievedVectors == nil {
+			return nil, errors.New("failed to fetch vectors")
+		}
+
+		dict := make(map[interface{}]int)
+		for index, id := range retrievedIds.GetLongData().GetData() {
+			dict[id] = index
+		}
+		for index, id := range retrievedIds.GetStringData().GetData() {
+			dict[id] = index
+		}
+
+		inputIds := make([]interface{}, 0, typeutil.GetSizeOfIDs(ids.IdArray))
+		for i := 0; i < typeutil.GetSizeOfIDs(ids.IdArray); i++ {
+			inputIds = append(inputIds, typeutil.GetPK(ids.IdArray, int64(i)))
+		}
+		if retrievedVectors.GetFloatVector() != nil {
+			floatArr := retrievedVectors.GetFloatVector().Data
+			element := retrievedVectors.GetDim()
+			result := make([]float32, 0, int64(len(inputIds))*element)
+			for _, id := range inputIds {
+				index, ok := dict[id]
+				if !ok {
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
+					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+				}
+				result = append(result, floatArr[int64(index)*element:int64(index+1)*element]...)
+			}
+
+			return &schemapb.VectorField{
+				Dim: element,
+				Data: &schemapb.VectorField_FloatVector{
+					FloatVector: &schemapb.FloatArray{
+						Data: result,
+					},
+				},
+			}, nil
+		}
+
+		if retrievedVectors.GetBinaryVector() != nil {
+			binaryArr := retrievedVectors.GetBinaryVector()
+			element := retrievedVectors.GetDim()
+			if element%8 != 0 {
+				element = element + 8 - element%8
+			}
+
+			result := make([]byte, 0, int64(len(inputIds))*element)
+			for _, id := range inputIds {
+				index, ok := dict[id]
+				if !ok {
+					log.Error("id not found in CalcDistance", zap.Any("id", id))
+					return nil, errors.New("failed to fetch vectors by id: " + fmt.Sprintln(id))
+				}
+				result = append(result, binaryArr[int64(index)*element:int64(index+1)*element]...)
+			}
+
+			return &schemapb.VectorField{
+				Dim: element * 8,
+				Data: &schemapb.VectorField_BinaryVector{
+					BinaryVector: result,
+				},
+			}, nil
+		}
+
+		return nil, errors.New("failed to fetch vectors")
+	}
+
+	log.Debug("CalcDistance received",
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("metric", metric))
+
+	vectorsLeft := request.GetOpLeft().GetDataArray()
+	opLeft := request.GetOpLeft().GetIdArray()
+	if opLeft != nil {
+		log.Debug("OpLeft IdArray not empty, Get vectors by id",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		result, err := query(opLeft)
+		if err != nil {
+			log.Debug("Failed to get left vectors by id",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("OpLeft IdArray not empty, Get vectors by id done",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		vectorsLeft, err = arrangeFunc(opLeft, result.FieldsData)
+		if err != nil {
+			log.Debug("Failed to re-arrange left vectors",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("Re-arrange left vectors done",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+	}
+
+	if vectorsLeft == nil {
+		msg := "Left vectors array is empty"
+		log.Debug(msg,
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msg,
+			},
+		}, nil
+	}
+
+	vectorsRight := request.GetOpRight().GetDataArray()
+	opRight := request.GetOpRight().GetIdArray()
+	if opRight != nil {
+		log.Debug("OpRight IdArray not empty, Get vectors by id",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		result, err := query(opRight)
+		if err != nil {
+			log.Debug("Failed to get right vectors by id",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("OpRight IdArray not empty, Get vectors by id done",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		vectorsRight, err = arrangeFunc(opRight, result.FieldsData)
+		if err != nil {
+			log.Debug("Failed to re-arrange right vectors",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("Re-arrange right vectors done",
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+	}
+
+	if vectorsRight == nil {
+		msg := "Right vectors array is empty"
+		log.Debug(msg,
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msg,
+			},
+		}, nil
+	}
+
+	if vectorsLeft.Dim != vectorsRight.Dim {
+		msg := "Vectors dimension is not equal"
+		log.Debug(msg,
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msg,
+			},
+		}, nil
+	}
+
+	if vectorsLeft.GetFloatVector() != nil && vectorsRight.GetFloatVector() != nil {
+		distances, err := distance.CalcFloatDistance(vectorsLeft.Dim, vectorsLeft.GetFloatVector().Data, vectorsRight.GetFloatVector().Data, metric)
+		if err != nil {
+			log.Debug("Failed to CalcFloatDistance",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		log.Debug("CalcFloatDistance done",
+			zap.Error(err),
+			zap.String("traceID", traceID),
+			zap.String("role", typeutil.ProxyRole))
+
+		return &milvuspb.CalcDistanceResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+			Array: &milvuspb.CalcDistanceResults_FloatDist{
+				FloatDist: &schemapb.FloatArray{
+					Data: distances,
+				},
+			},
+		}, nil
+	}
+
+	if vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetBinaryVector() != nil {
+		hamming, err := distance.CalcHammingDistance(vectorsLeft.Dim, vectorsLeft.GetBinaryVector(), vectorsRight.GetBinaryVector())
+		if err != nil {
+			log.Debug("Failed to CalcHammingDistance",
+				zap.Error(err),
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{
+					ErrorCode: commonpb.ErrorCode_UnexpectedError,
+					Reason:    err.Error(),
+				},
+			}, nil
+		}
+
+		if metric == distance.HAMMING {
+			log.Debug("CalcHammingDistance done",
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+				Array: &milvuspb.CalcDistanceResults_IntDist{
+					IntDist: &schemapb.IntArray{
+						Data: hamming,
+					},
+				},
+			}, nil
+		}
+
+		if metric == distance.TANIMOTO {
+			tanimoto, err := distance.CalcTanimotoCoefficient(vectorsLeft.Dim, hamming)
+			if err != nil {
+				log.Debug("Failed to CalcTanimotoCoefficient",
+					zap.Error(err),
+					zap.String("traceID", traceID),
+					zap.String("role", typeutil.ProxyRole))
+
+				return &milvuspb.CalcDistanceResults{
+					Status: &commonpb.Status{
+						ErrorCode: commonpb.ErrorCode_UnexpectedError,
+						Reason:    err.Error(),
+					},
+				}, nil
+			}
+
+			log.Debug("CalcTanimotoCoefficient done",
+				zap.String("traceID", traceID),
+				zap.String("role", typeutil.ProxyRole))
+
+			return &milvuspb.CalcDistanceResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
+				Array: &milvuspb.CalcDistanceResults_FloatDist{
+					FloatDist: &schemapb.FloatArray{
+						Data: tanimoto,
+					},
+				},
+			}, nil
+		}
+	}
+
+	err = errors.New("unexpected error")
+	if (vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetFloatVector() != nil) || (vectorsLeft.GetFloatVector() != nil && vectorsRight.GetBinaryVector() != nil) {
+		err = errors.New("cannot calculate distance between binary vectors and float vectors")
+	}
+
+	log.Debug("Failed to CalcDistance",
+		zap.Error(err),
+		zap.String("traceID", traceID),
+		zap.String("role", typeutil.ProxyRole))
+
+	return &milvuspb.CalcDistanceResults{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		},
+	}, nil
+}
+
+// GetDdChannel returns the used channel for dd operations.
+func (node *Proxy) GetDdChannel(ctx context.Context, request *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error) {
+	panic("implement me")
+}
+
+// GetPersistentSegmentInfo get the information of sealed segment.
+func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error) {
+	log.Debug("GetPersistentSegmentInfo",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.DbName),
+		zap.Any("collection", req.CollectionName))
+
+	resp := &milvuspb.GetPersistentSegmentInfoResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
+		return resp, nil
+	}
+	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_SegmentInfo,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyCfg.ProxyID,
+		},
+		SegmentIDs: segments,
+	})
+	if err != nil {
+		log.Debug("GetPersistentSegmentInfo fail", zap.Error(err))
+		resp.Status.Reason = fmt.Errorf("dataCoord:GetSegmentInfo, err:%w", err).Error()
+		return resp, nil
+	}
+	log.Debug("GetPersistentSegmentInfo ", zap.Int("len(infos)", len(infoResp.Infos)), zap.Any("status", infoResp.Status))
+	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		resp.Status.Reason = infoResp.Status.Reason
+		return resp, nil
+	}
+	persistentInfos := make([]*milvuspb.PersistentSegmentInfo, len(infoResp.Infos))
+	for i, info := range infoResp.Infos {
+		persistentInfos[i] = &milvuspb.PersistentSegmentInfo{
+			SegmentID:    info.ID,
+			CollectionID: info.CollectionID,
+			PartitionID:  info.PartitionID,
+			NumRows:      info.NumOfRows,
+			State:        info.State,
+		}
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Infos = persistentInfos
+	return resp, nil
+}
+
+// GetQuerySegmentInfo gets segment information from QueryCoord.
+func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
+	log.Debug("GetQuerySegmentInfo",
+		zap.String("role", typeutil.ProxyRole),
+		zap.String("db", req.DbName),
+		zap.Any("collection", req.CollectionName))
+
+	resp := &milvuspb.GetQuerySegmentInfoResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+		},
+	}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	collID, err := globalMetaCache.GetCollectionID(ctx, req.DbName, req.CollectionName)
+	if err != nil {
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	infoResp, err := node.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_SegmentInfo,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyCfg.ProxyID,
+		},
+		CollectionID: collID,
+		SegmentIDs:   segments,
+	})
+	if err != nil {
+		log.Error("Failed to get segment info from QueryCoord",
+			zap.Int64s("segmentIDs", segments), zap.Error(err))
+		resp.Status.Reason = err.Error()
+		return resp, nil
+	}
+	log.Debug("GetQuerySegmentInfo ", zap.Any("infos", infoResp.Infos), zap.Any("status", infoResp.Status))
+	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("Failed to get segment info from QueryCoord", zap.String("errMsg", infoResp.Status.Reason))
+		resp.Status.Reason = infoResp.Status.Reason
+		return resp, nil
+	}
+	queryInfos := make([]*milvuspb.QuerySegmentInfo, len(infoResp.Infos))
+	for i, info := range infoResp.Infos {
+		queryInfos[i] = &milvuspb.QuerySegmentInfo{
+			SegmentID:    info.SegmentID,
+			CollectionID: info.CollectionID,
+			PartitionID:  info.PartitionID,
+			NumRows:      info.NumRows,
+			MemSize:      info.MemSize,
+			IndexName:    info.IndexName,
+			IndexID:      info.IndexID,
+			NodeID:       info.NodeID,
+			State:        info.SegmentState,
+		}
+	}
+	resp.Status.ErrorCode = commonpb.ErrorCode_Success
+	resp.Infos = queryInfos
+	return resp, nil
+}
+
+func (node *Proxy) getSegmentsOfCollection(ctx context.Context, dbName string, collectionName string) ([]UniqueID, error) {
+	describeCollectionResponse, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_DescribeCollection,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyCfg.ProxyID,
+		},
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if describeCollectionResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(describeCollectionResponse.Status.Reason)
+	}
+	collectionID := describeCollectionResponse.CollectionID
+	showPartitionsResp, err := node.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_ShowPartitions,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyCfg.ProxyID,
+		},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		CollectionID:   collectionID,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if showPartitionsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(showPartitionsResp.Status.Reason)
+	}
+
+	ret := make([]UniqueID, 0)
+	for _, partitionID := range showPartitionsResp.PartitionIDs {
+		showSegmentResponse, err := node.rootCoord.ShowSegments(ctx, &milvuspb.ShowSegmentsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_ShowSegments,
+				MsgID:     0,
+				Timestamp: 0,
+				SourceID:  Params.ProxyCfg.ProxyID,
+			},
+			CollectionID: collectionID,
+			PartitionID:  partitionID,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if showSegmentResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, errors.New(showSegmentResponse.Status.Reason)
+		}
+		ret = append(ret, showSegmentResponse.SegmentIDs...)
+	}
+	return ret, nil
+}
+
+// Dummy handles dummy request
+func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
+	failedResponse := &milvuspb.DummyResponse{
+		Response: `{"status": "fail"}`,
+	}
+
+	// TODO(wxyu): change name RequestType to Request
+	drt, err := parseDummyRequestType(req.RequestType)
+	if err != nil {
+		log.Debug("Failed to parse dummy request type")
+		return failedResponse, nil
+	}
+
+	if drt.RequestType == "query" {
+		drr, err := parseDummyQueryRequest(req.RequestType)
+		if err != nil {
+			log.Debug("Failed to parse dummy query request")
+			return failedResponse, nil
+		}
+
+		request := &milvuspb.QueryRequest{
+			DbName:         drr.DbName,
+			CollectionName: drr.CollectionName,
+			PartitionNames: drr.PartitionNames,
+			OutputFields:   drr.OutputFields,
+		}
+
+		_, err = node.Query(ctx, request)
+		if err != nil {
+			log.Debug("Failed to execute dummy query")
+			return failedResponse, err
+		}
+
+		return &milvuspb.DummyResponse{
+			Response: `{"status": "success"}`,
+		}, nil
+	}
+
+	log.Debug("cannot find specify dummy request type")
+	return failedResponse, nil
+}
+
+// RegisterLink registers a link
+func (node *Proxy) RegisterLink(ctx context.Context, req *milvuspb.RegisterLinkRequest) (*milvuspb.RegisterLinkResponse, error) {
+	code := node.stateCode.Load().(internalpb.StateCode)
+	log.Debug("RegisterLink",
+		zap.String("role", typeutil.ProxyRole),
+		zap.Any("state code of proxy", code))
+
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.RegisterLinkResponse{
+			Address: nil,
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    "proxy not healthy",
+			},
+		}, nil
+	}
+	return &milvuspb.RegisterLinkResponse{
+		Address: nil,
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    os.Getenv(metricsinfo.DeployModeEnvKey),
+		},
+	}, nil
+}
+
+// TODO(dragondriver): cache the Metrics and set a retention to the cache
+func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
+	log.Debug("Proxy.GetMetrics",
+		zap.Int64("node_id", Params.ProxyCfg.ProxyID),
+		zap.String("req", req.Request))
+
+	if !node.checkHealthy() {
+		log.Warn("Proxy.GetMetrics failed",
+			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
+			zap.String("req", req.Request),
+			zap.Error(errProxyIsUnhealthy(Params.ProxyCfg.ProxyID)))
+
+		return &milvuspb.GetMetricsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    msgProxyIsUnhealthy(Params.ProxyCfg.ProxyID),
 			},
+			Response: "",
 		}, nil
 	}
 
-	if vectorsLeft.Dim != vectorsRight.Dim {
-		msg := "Vectors dimension is not equal"
-		log.Debug(msg,
-			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+	metricType, err := metricsinfo.ParseMetricType(req.Request)
+	if err != nil {
+		log.Warn("Proxy.GetMetrics failed to parse metric type",
+			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
+			zap.String("req", req.Request),
+			zap.Error(err))
 
-		return &milvuspb.CalcDistanceResults{
+		return &milvuspb.GetMetricsResponse{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    msg,
+				Reason:    err.Error(),
 			},
+			Response: "",
 		}, nil
 	}
 
-	if vectorsLeft.GetFloatVector() != nil && vectorsRight.GetFloatVector() != nil {
-		distances, err := distance.CalcFloatDistance(vectorsLeft.Dim, vectorsLeft.GetFloatVector().Data, vectorsRight.GetFloatVector().Data, metric)
-		if err != nil {
-			log.Debug("Failed to CalcFloatDistance",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+	log.Debug("Proxy.GetMetrics",
+		zap.String("metric_type", metricType))
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+	msgID := UniqueID(0)
+	msgID, err = node.idAllocator.AllocOne()
+	if err != nil {
+		log.Warn("Proxy.GetMetrics failed to allocate id",
+			zap.Error(err))
+	}
+	req.Base = &commonpb.MsgBase{
+		MsgType:   commonpb.MsgType_SystemInfo,
+		MsgID:     msgID,
+		Timestamp: 0,
+		SourceID:  Params.ProxyCfg.ProxyID,
+	}
 
-		log.Debug("CalcFloatDistance done",
-			zap.Error(err),
-			zap.String("traceID", traceID),
-			zap.String("role", typeutil.ProxyRole))
+	if metricType == metricsinfo.SystemInfoMetrics {
+		ret, err := node.metricsCacheManager.GetSystemInfoMetrics()
+		if err == nil && ret != nil {
+			return ret, nil
+		}
+		log.Debug("failed to get system info metrics from cache, recompute instead",
+			zap.Error(err))
 
-		return &milvuspb.CalcDistanceResults{
-			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
-			Array: &milvuspb.CalcDistanceResults_FloatDist{
-				FloatDist: &schemapb.FloatArray{
-					Data: distances,
-				},
-			},
-		}, nil
-	}
+		metrics, err := getSystemInfoMetrics(ctx, req, node)
 
-	if vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetBinaryVector() != nil {
-		hamming, err := distance.CalcHammingDistance(vectorsLeft.Dim, vectorsLeft.GetBinaryVector(), vectorsRight.GetBinaryVector())
-		if err != nil {
-			log.Debug("Failed to CalcHammingDistance",
-				zap.Error(err),
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+		log.Debug("Proxy.GetMetrics",
+			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
+			zap.String("req", req.Request),
+			zap.String("metric_type", metricType),
+			zap.Any("metrics", metrics), // TODO(dragondriver): necessary? may be very large
+			zap.Error(err))
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{
-					ErrorCode: commonpb.ErrorCode_UnexpectedError,
-					Reason:    err.Error(),
-				},
-			}, nil
-		}
+		node.metricsCacheManager.UpdateSystemInfoMetrics(metrics)
 
-		if metric == distance.HAMMING {
-			log.Debug("CalcHammingDistance done",
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+		return metrics, nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
-				Array: &milvuspb.CalcDistanceResults_IntDist{
-					IntDist: &schemapb.IntArray{
-						Data: hamming,
-					},
-				},
-			}, nil
-		}
+	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
+		zap.Int64("node_id", Params.ProxyCfg.ProxyID),
+		zap.String("req", req.Request),
+		zap.String("metric_type", metricType))
 
-		if metric == distance.TANIMOTO {
-			tanimoto, err := distance.CalcTanimotoCoefficient(vectorsLeft.Dim, hamming)
-			if err != nil {
-				log.Debug("Failed to CalcTanimotoCoefficient",
-					zap.Error(err),
-					zap.String("traceID", traceID),
-					zap.String("role", typeutil.ProxyRole))
+	return &milvuspb.GetMetricsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    metricsinfo.MsgUnimplementedMetric,
+		},
+		Response: "",
+	}, nil
+}
 
-				return &milvuspb.CalcDistanceResults{
-					Status: &commonpb.Status{
-						ErrorCode: commonpb.ErrorCode_UnexpectedError,
-						Reason:    err.Error(),
-					},
-				}, nil
-			}
+// LoadBalance would do a load balancing operation between query nodes
+func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
+	log.Debug("Proxy.LoadBalance",
+		zap.Int64("proxy_id", Params.ProxyCfg.ProxyID),
+		zap.Any("req", req))
 
-			log.Debug("CalcTanimotoCoefficient done",
-				zap.String("traceID", traceID),
-				zap.String("role", typeutil.ProxyRole))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-			return &milvuspb.CalcDistanceResults{
-				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success, Reason: ""},
-				Array: &milvuspb.CalcDistanceResults_FloatDist{
-					FloatDist: &schemapb.FloatArray{
-						Data: tanimoto,
-					},
-				},
-			}, nil
-		}
+	status := &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	}
+	infoResp, err := node.queryCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:   commonpb.MsgType_LoadBalanceSegments,
+			MsgID:     0,
+			Timestamp: 0,
+			SourceID:  Params.ProxyCfg.ProxyID,
+		},
+		SourceNodeIDs:    []int64{req.SrcNodeID},
+		DstNodeIDs:       req.DstNodeIDs,
+		BalanceReason:    querypb.TriggerCondition_GrpcRequest,
+		SealedSegmentIDs: req.SealedSegmentIDs,
+	})
+	if err != nil {
+		log.Error("Failed to LoadBalance from Query Coordinator",
+			zap.Any("req", req), zap.Error(err))
+		status.Reason = err.Error()
+		return status, nil
+	}
+	if infoResp.ErrorCode != commonpb.ErrorCode_Success {
+		log.Error("Failed to LoadBalance from Query Coordinator", zap.String("errMsg", infoResp.Reason))
+		status.Reason = infoResp.Reason
+		return status, nil
 	}
+	log.Debug("LoadBalance Done", zap.Any("req", req), zap.Any("status", infoResp))
+	status.ErrorCode = commonpb.ErrorCode_Success
+	return status, nil
+}
 
-	err = errors.New("unexpected error")
-	if (vectorsLeft.GetBinaryVector() != nil && vectorsRight.GetFloatVector() != nil) || (vectorsLeft.GetFloatVector() != nil && vectorsRight.GetBinaryVector() != nil) {
-		err = errors.New("cannot calculate distance between binary vectors and float vectors")
+//GetCompactionState gets the compaction state of multiple segments
+func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
+	log.Info("received GetCompactionState request", zap.Int64("compactionID", req.GetCompactionID()))
+	resp := &milvuspb.GetCompactionStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
 	}
 
-	log.Debug("Failed to CalcDistance",
-		zap.Error(err),
-		zap.String("traceID", traceID),
-		zap.String("role", typeutil.ProxyRole))
+	resp, err := node.dataCoord.GetCompactionState(ctx, req)
+	log.Info("received GetCompactionState response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
+}
 
-	return &milvuspb.CalcDistanceResults{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			Reason:    err.Error(),
-		},
-	}, nil
+// ManualCompaction invokes compaction on specified collection
+func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
+	log.Info("received ManualCompaction request", zap.Int64("collectionID", req.GetCollectionID()))
+	resp := &milvuspb.ManualCompactionResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.ManualCompaction(ctx, req)
+	log.Info("received ManualCompaction response", zap.Int64("collectionID", req.GetCollectionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
 }
 
-// GetDdChannel returns the used channel for dd operations.
-func (node *Proxy) GetDdChannel(ctx context.Context, request *internalpb.GetDdChannelRequest) (*milvuspb.StringResponse, error) {
-	panic("implement me")
+func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
+	log.Info("received GetCompactionStateWithPlans request", zap.Int64("compactionID", req.GetCompactionID()))
+	resp := &milvuspb.GetCompactionPlansResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		return resp, nil
+	}
+
+	resp, err := node.dataCoord.GetCompactionStateWithPlans(ctx, req)
+	log.Info("received GetCompactionStateWithPlans response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
+	return resp, err
 }
 
-// GetPersistentSegmentInfo get the information of sealed segment.
-func (node *Proxy) GetPersistentSegmentInfo(ctx context.Context, req *milvuspb.GetPersistentSegmentInfoRequest) (*milvuspb.GetPersistentSegmentInfoResponse, error) {
-	log.Debug("GetPersistentSegmentInfo",
-		zap.String("role", typeutil.ProxyRole),
-		zap.String("db", req.DbName),
-		zap.Any("collection", req.CollectionName))
+// GetFlushState gets the flush state of multiple segments
+func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
+	log.Info("received get flush state request", zap.Any("request", req))
+	var err error
+	resp := &milvuspb.GetFlushStateResponse{}
+	if !node.checkHealthy() {
+		resp.Status = unhealthyStatus()
+		log.Info("unable to get flush state because of closed server")
+		return resp, nil
+	}
 
-	resp := &milvuspb.GetPersistentSegmentInfoResponse{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		},
+	resp, err = node.dataCoord.GetFlushState(ctx, req)
+	if err != nil {
+		log.Info("failed to get flush state response", zap.Error(err))
+		return nil, err
 	}
+	log.Info("received get flush state response", zap.Any("response", resp))
+	return resp, err
+}
+
+// Import imports the files in the object storage into a collection, the files are imported asynchronously
+// and the returned task ID is used to get the state of the import
+func (node *Proxy) Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error) {
+	log.Info("received import request", zap.String("collection", req.GetCollectionName()),
+		zap.String("partition", req.GetPartitionName()), zap.Bool("rowBased", req.GetRowBased()), zap.Strings("files", req.GetFiles()))
+	resp := &milvuspb.ImportResponse{}
 	if !node.checkHealthy() {
 		resp.Status = unhealthyStatus()
+		log.Info("unable to import because of closed server")
 		return resp, nil
 	}
-	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
-	if err != nil {
-		resp.Status.Reason = fmt.Errorf("getSegmentsOfCollection, err:%w", err).Error()
+
+	if err := checkPrivilege(ctx, commonpb.MsgType_Insert, req.GetDbName(), req.GetCollectionName()); err != nil {
+		resp.Status = &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}
 		return resp, nil
 	}
-	infoResp, err := node.dataCoord.GetSegmentInfo(ctx, &datapb.GetSegmentInfoRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_SegmentInfo,
-			MsgID:     0,
-			Timestamp: 0,
-			SourceID:  Params.ProxyCfg.ProxyID,
-		},
-		SegmentIDs: segments,
-	})
-	if err != nil {
-		log.Debug("GetPersistentSegmentInfo fail", zap.Error(err))
-		resp.Status.Reason = fmt.Errorf("dataCoord:GetSegmentInfo, err:%w", err).Error()
+
+	if err := validateCollectionName(req.GetCollectionName()); err != nil {
+		resp.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}
 		return resp, nil
 	}
-	log.Debug("GetPersistentSegmentInfo ", zap.Int("len(infos)", len(infoResp.Infos)), zap.Any("status", infoResp.Status))
-	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		resp.Status.Reason = infoResp.Status.Reason
-		return resp, nil
+	if req.GetPartitionName() != "" {
+		if err := validatePartitionTag(req.GetPartitionName(), true); err != nil {
+			resp.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    err.Error(),
+			}
+			return resp, nil
+		}
 	}
-	persistentInfos := make([]*milvuspb.PersistentSegmentInfo, len(infoResp.Infos))
-	for i, info := range infoResp.Infos {
-		persistentInfos[i] = &milvuspb.PersistentSegmentInfo{
-			SegmentID:    info.ID,
-			CollectionID: info.CollectionID,
-			PartitionID:  info.PartitionID,
-			NumRows:      info.NumOfRows,
-			State:        info.State,
+	if err := importutil.ValidateFiles(req.GetRowBased(), req.GetFiles()); err != nil {
+		resp.Status = &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
 		}
+		return resp, nil
 	}
-	resp.Status.ErrorCode = commonpb.ErrorCode_Success
-	resp.Infos = persistentInfos
+
+	resp, err := node.dataCoord.Import(ctx, req)
+	if err != nil {
+		log.Info("failed to import", zap.Error(err))
+		return nil, err
+	}
+	log.Info("received import response", zap.Any("response", resp))
 	return resp, nil
 }
 
-// GetQuerySegmentInfo gets segment information from QueryCoord.
-func (node *Proxy) GetQuerySegmentInfo(ctx context.Context, req *milvuspb.GetQuerySegmentInfoRequest) (*milvuspb.GetQuerySegmentInfoResponse, error) {
-	log.Debug("GetQuerySegmentInfo",
-		zap.String("role", typeutil.ProxyRole),
-		zap.String("db", req.DbName),
-		zap.Any("collection", req.CollectionName))
-
-	resp := &milvuspb.GetQuerySegmentInfoResponse{
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-		},
-	}
+// GetImportState gets the state of an import task
+func (node *Proxy) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error) {
+	log.Info("received get import state request", zap.Int64("taskID", req.GetTaskID()))
+	resp := &milvuspb.GetImportStateResponse{}
 	if !node.checkHealthy() {
 		resp.Status = unhealthyStatus()
+		log.Info("unable to get import state because of closed server")
 		return resp, nil
 	}
-	segments, err := node.getSegmentsOfCollection(ctx, req.DbName, req.CollectionName)
+
+	resp, err := node.dataCoord.GetImportState(ctx, req)
 	if err != nil {
-		resp.Status.Reason = err.Error()
-		return resp, nil
+		log.Info("failed to get import state", zap.Error(err))
+		return nil, err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, req.CollectionName)
-	if err != nil {
-		resp.Status.Reason = err.Error()
-		return resp, nil
+	log.Info("received get import state response", zap.Int64("taskID", req.GetTaskID()), zap.Any("state", resp.GetState()))
+	return resp, nil
+}
+
+// CreateCredential create a new user, the password is encrypted before being sent to RootCoord
+func (node *Proxy) CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("CreateCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
 	}
-	infoResp, err := node.queryCoord.GetSegmentInfo(ctx, &querypb.GetSegmentInfoRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_SegmentInfo,
-			MsgID:     0,
-			Timestamp: 0,
-			SourceID:  Params.ProxyCfg.ProxyID,
-		},
-		CollectionID: collID,
-		SegmentIDs:   segments,
-	})
-	if err != nil {
-		log.Error("Failed to get segment info from QueryCoord",
-			zap.Int64s("segmentIDs", segments), zap.Error(err))
-		resp.Status.Reason = err.Error()
-		return resp, nil
+
+	if err := checkPrivilege(ctx, commonpb.MsgType_CreateCredential, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
 	}
-	log.Debug("GetQuerySegmentInfo ", zap.Any("infos", infoResp.Infos), zap.Any("status", infoResp.Status))
-	if infoResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		log.Error("Failed to get segment info from QueryCoord", zap.String("errMsg", infoResp.Status.Reason))
-		resp.Status.Reason = infoResp.Status.Reason
-		return resp, nil
+
+	if err := validateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
 	}
-	queryInfos := make([]*milvuspb.QuerySegmentInfo, len(infoResp.Infos))
-	for i, info := range infoResp.Infos {
-		queryInfos[i] = &milvuspb.QuerySegmentInfo{
-			SegmentID:    info.SegmentID,
-			CollectionID: info.CollectionID,
-			PartitionID:  info.PartitionID,
-			NumRows:      info.NumRows,
-			MemSize:      info.MemSize,
-			IndexName:    info.IndexName,
-			IndexID:      info.IndexID,
-			NodeID:       info.NodeID,
-			State:        info.SegmentState,
-		}
+	if err := validatePassword(req.Password); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
 	}
-	resp.Status.ErrorCode = commonpb.ErrorCode_Success
-	resp.Infos = queryInfos
-	return resp, nil
-}
 
-func (node *Proxy) getSegmentsOfCollection(ctx context.Context, dbName string, collectionName string) ([]UniqueID, error) {
-	describeCollectionResponse, err := node.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_DescribeCollection,
-			MsgID:     0,
-			Timestamp: 0,
-			SourceID:  Params.ProxyCfg.ProxyID,
-		},
-		DbName:         dbName,
-		CollectionName: collectionName,
-	})
+	encryptedPassword, err := crypto.PasswordEncrypt(req.Password)
 	if err != nil {
-		return nil, err
+		log.Error("failed to encrypt password", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "failed to encrypt password: " + err.Error(),
+		}, nil
 	}
-	if describeCollectionResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, errors.New(describeCollectionResponse.Status.Reason)
+	credInfo := &internalpb.CredentialInfo{
+		Username:          req.Username,
+		EncryptedPassword: encryptedPassword,
 	}
-	collectionID := describeCollectionResponse.CollectionID
-	showPartitionsResp, err := node.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_ShowPartitions,
-			MsgID:     0,
-			Timestamp: 0,
-			SourceID:  Params.ProxyCfg.ProxyID,
-		},
-		DbName:         dbName,
-		CollectionName: collectionName,
-		CollectionID:   collectionID,
-	})
+	result, err := node.rootCoord.CreateCredential(ctx, credInfo)
 	if err != nil {
-		return nil, err
+		log.Error("failed to create credential", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
 	}
-	if showPartitionsResp.Status.ErrorCode != commonpb.ErrorCode_Success {
-		return nil, errors.New(showPartitionsResp.Status.Reason)
+	if result.ErrorCode == commonpb.ErrorCode_Success {
+		globalMetaCache.UpdateCredential(credInfo)
 	}
+	return result, nil
+}
 
-	ret := make([]UniqueID, 0)
-	for _, partitionID := range showPartitionsResp.PartitionIDs {
-		showSegmentResponse, err := node.rootCoord.ShowSegments(ctx, &milvuspb.ShowSegmentsRequest{
-			Base: &commonpb.MsgBase{
-				MsgType:   commonpb.MsgType_ShowSegments,
-				MsgID:     0,
-				Timestamp: 0,
-				SourceID:  Params.ProxyCfg.ProxyID,
-			},
-			CollectionID: collectionID,
-			PartitionID:  partitionID,
-		})
-		if err != nil {
-			return nil, err
-		}
-		if showSegmentResponse.Status.ErrorCode != commonpb.ErrorCode_Success {
-			return nil, errors.New(showSegmentResponse.Status.Reason)
-		}
-		ret = append(ret, showSegmentResponse.SegmentIDs...)
+// UpdateCredential update the password of an existing user, the old password must be verified first
+func (node *Proxy) UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("UpdateCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
 	}
-	return ret, nil
-}
 
-// Dummy handles dummy request
-func (node *Proxy) Dummy(ctx context.Context, req *milvuspb.DummyRequest) (*milvuspb.DummyResponse, error) {
-	failedResponse := &milvuspb.DummyResponse{
-		Response: `{"status": "fail"}`,
+	if err := validateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := validatePassword(req.NewPassword); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
 	}
 
-	// TODO(wxyu): change name RequestType to Request
-	drt, err := parseDummyRequestType(req.RequestType)
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, req.Username)
 	if err != nil {
-		log.Debug("Failed to parse dummy request type")
-		return failedResponse, nil
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
 	}
-
-	if drt.RequestType == "query" {
-		drr, err := parseDummyQueryRequest(req.RequestType)
-		if err != nil {
-			log.Debug("Failed to parse dummy query request")
-			return failedResponse, nil
-		}
-
-		request := &milvuspb.QueryRequest{
-			DbName:         drr.DbName,
-			CollectionName: drr.CollectionName,
-			PartitionNames: drr.PartitionNames,
-			OutputFields:   drr.OutputFields,
-		}
-
-		_, err = node.Query(ctx, request)
-		if err != nil {
-			log.Debug("Failed to execute dummy query")
-			return failedResponse, err
-		}
-
-		return &milvuspb.DummyResponse{
-			Response: `{"status": "success"}`,
+	if !crypto.PasswordVerify(req.OldPassword, credInfo.EncryptedPassword) {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_PermissionDenied,
+			Reason:    "old password is not correct: " + req.Username,
 		}, nil
 	}
 
-	log.Debug("cannot find specify dummy request type")
-	return failedResponse, nil
+	encryptedPassword, err := crypto.PasswordEncrypt(req.NewPassword)
+	if err != nil {
+		log.Error("failed to encrypt password", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "failed to encrypt password: " + err.Error(),
+		}, nil
+	}
+	newCredInfo := &internalpb.CredentialInfo{
+		Username:          req.Username,
+		EncryptedPassword: encryptedPassword,
+	}
+	result, err := node.rootCoord.UpdateCredential(ctx, newCredInfo)
+	if err != nil {
+		log.Error("failed to update credential", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if result.ErrorCode == commonpb.ErrorCode_Success {
+		globalMetaCache.UpdateCredential(newCredInfo)
+	}
+	return result, nil
 }
 
-// RegisterLink registers a link
-func (node *Proxy) RegisterLink(ctx context.Context, req *milvuspb.RegisterLinkRequest) (*milvuspb.RegisterLinkResponse, error) {
-	code := node.stateCode.Load().(internalpb.StateCode)
-	log.Debug("RegisterLink",
-		zap.String("role", typeutil.ProxyRole),
-		zap.Any("state code of proxy", code))
+// DeleteCredential delete a user, the root user can not be deleted
+func (node *Proxy) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	log.Debug("DeleteCredential", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-	if code != internalpb.StateCode_Healthy {
-		return &milvuspb.RegisterLinkResponse{
-			Address: nil,
-			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    "proxy not healthy",
-			},
+	if err := checkPrivilege(ctx, commonpb.MsgType_DeleteCredential, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	if req.Username == util.UserRoot {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    "user root cannot be deleted",
 		}, nil
 	}
-	return &milvuspb.RegisterLinkResponse{
-		Address: nil,
-		Status: &commonpb.Status{
-			ErrorCode: commonpb.ErrorCode_Success,
-			Reason:    os.Getenv(metricsinfo.DeployModeEnvKey),
-		},
-	}, nil
-}
 
-// TODO(dragondriver): cache the Metrics and set a retention to the cache
-func (node *Proxy) GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error) {
-	log.Debug("Proxy.GetMetrics",
-		zap.Int64("node_id", Params.ProxyCfg.ProxyID),
-		zap.String("req", req.Request))
+	result, err := node.rootCoord.DeleteCredential(ctx, req)
+	if err != nil {
+		log.Error("failed to delete credential", zap.String("username", req.Username), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if result.ErrorCode == commonpb.ErrorCode_Success {
+		globalMetaCache.RemoveCredential(req.Username)
+	}
+	return result, nil
+}
 
+// ListUsers list the usernames of all users
+func (node *Proxy) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	log.Debug("ListUsers", zap.String("role", typeutil.ProxyRole))
 	if !node.checkHealthy() {
-		log.Warn("Proxy.GetMetrics failed",
-			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
-			zap.String("req", req.Request),
-			zap.Error(errProxyIsUnhealthy(Params.ProxyCfg.ProxyID)))
+		return &milvuspb.ListUsersResponse{
+			Status: unhealthyStatus(),
+		}, nil
+	}
 
-		return &milvuspb.GetMetricsResponse{
+	if err := checkPrivilege(ctx, commonpb.MsgType_ListUsers, "", ""); err != nil {
+		return &milvuspb.ListUsersResponse{
 			Status: &commonpb.Status{
-				ErrorCode: commonpb.ErrorCode_UnexpectedError,
-				Reason:    msgProxyIsUnhealthy(Params.ProxyCfg.ProxyID),
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
 			},
-			Response: "",
 		}, nil
 	}
 
-	metricType, err := metricsinfo.ParseMetricType(req.Request)
+	resp, err := node.rootCoord.ListUsers(ctx, req)
 	if err != nil {
-		log.Warn("Proxy.GetMetrics failed to parse metric type",
-			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
-			zap.String("req", req.Request),
-			zap.Error(err))
-
-		return &milvuspb.GetMetricsResponse{
+		log.Error("failed to list users", zap.Error(err))
+		return &milvuspb.ListUsersResponse{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_UnexpectedError,
 				Reason:    err.Error(),
 			},
-			Response: "",
 		}, nil
 	}
+	return resp, nil
+}
 
-	log.Debug("Proxy.GetMetrics",
-		zap.String("metric_type", metricType))
+// CreateRole create a role which privileges can be granted to
+func (node *Proxy) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	log.Debug("CreateRole", zap.String("role", typeutil.ProxyRole), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-	msgID := UniqueID(0)
-	msgID, err = node.idAllocator.AllocOne()
+	if err := checkPrivilege(ctx, commonpb.MsgType_CreateRole, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	if err := validateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+
+	result, err := node.rootCoord.CreateRole(ctx, req)
 	if err != nil {
-		log.Warn("Proxy.GetMetrics failed to allocate id",
-			zap.Error(err))
+		log.Error("failed to create role", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
 	}
-	req.Base = &commonpb.MsgBase{
-		MsgType:   commonpb.MsgType_SystemInfo,
-		MsgID:     msgID,
-		Timestamp: 0,
-		SourceID:  Params.ProxyCfg.ProxyID,
+	return result, nil
+}
+
+// DropRole drop a role, the privileges granted to the role are revoked as well
+func (node *Proxy) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	log.Debug("DropRole", zap.String("role", typeutil.ProxyRole), zap.String("roleName", req.RoleName))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
 	}
 
-	if metricType == metricsinfo.SystemInfoMetrics {
-		ret, err := node.metricsCacheManager.GetSystemInfoMetrics()
-		if err == nil && ret != nil {
-			return ret, nil
-		}
-		log.Debug("failed to get system info metrics from cache, recompute instead",
-			zap.Error(err))
+	if err := checkPrivilege(ctx, commonpb.MsgType_DropRole, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
 
-		metrics, err := getSystemInfoMetrics(ctx, req, node)
+	if err := validateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-		log.Debug("Proxy.GetMetrics",
-			zap.Int64("node_id", Params.ProxyCfg.ProxyID),
-			zap.String("req", req.Request),
-			zap.String("metric_type", metricType),
-			zap.Any("metrics", metrics), // TODO(dragondriver): necessary? may be very large
-			zap.Error(err))
+	result, err := node.rootCoord.DropRole(ctx, req)
+	if err != nil {
+		log.Error("failed to drop role", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
+}
 
-		node.metricsCacheManager.UpdateSystemInfoMetrics(metrics)
+// OperateUserRole add a user to a role or remove a user from a role
+func (node *Proxy) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	log.Debug("OperateUserRole", zap.String("role", typeutil.ProxyRole), zap.String("username", req.Username),
+		zap.String("roleName", req.RoleName), zap.String("type", req.Type.String()))
+	if !node.checkHealthy() {
+		return unhealthyStatus(), nil
+	}
 
-		return metrics, nil
+	if err := checkPrivilege(ctx, commonpb.MsgType_OperateUserRole, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
 	}
 
-	log.Debug("Proxy.GetMetrics failed, request metric type is not implemented yet",
-		zap.Int64("node_id", Params.ProxyCfg.ProxyID),
-		zap.String("req", req.Request),
-		zap.String("metric_type", metricType))
+	if err := validateUsername(req.Username); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
+	if err := validateRoleName(req.RoleName); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
+	}
 
-	return &milvuspb.GetMetricsResponse{
-		Status: &commonpb.Status{
+	result, err := node.rootCoord.OperateUserRole(ctx, req)
+	if err != nil {
+		log.Error("failed to operate user role", zap.String("username", req.Username),
+			zap.String("roleName", req.RoleName), zap.Error(err))
+		return &commonpb.Status{
 			ErrorCode: commonpb.ErrorCode_UnexpectedError,
-			Reason:    metricsinfo.MsgUnimplementedMetric,
-		},
-		Response: "",
-	}, nil
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
 }
 
-// LoadBalance would do a load balancing operation between query nodes
-func (node *Proxy) LoadBalance(ctx context.Context, req *milvuspb.LoadBalanceRequest) (*commonpb.Status, error) {
-	log.Debug("Proxy.LoadBalance",
-		zap.Int64("proxy_id", Params.ProxyCfg.ProxyID),
-		zap.Any("req", req))
-
+// GrantPrivilege grant a privilege on an object to a role
+func (node *Proxy) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("GrantPrivilege", zap.String("role", typeutil.ProxyRole), zap.Any("entity", req.Entity))
 	if !node.checkHealthy() {
 		return unhealthyStatus(), nil
 	}
 
-	status := &commonpb.Status{
-		ErrorCode: commonpb.ErrorCode_UnexpectedError,
+	if err := checkPrivilege(ctx, commonpb.MsgType_GrantPrivilege, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
 	}
-	infoResp, err := node.queryCoord.LoadBalance(ctx, &querypb.LoadBalanceRequest{
-		Base: &commonpb.MsgBase{
-			MsgType:   commonpb.MsgType_LoadBalanceSegments,
-			MsgID:     0,
-			Timestamp: 0,
-			SourceID:  Params.ProxyCfg.ProxyID,
-		},
-		SourceNodeIDs:    []int64{req.SrcNodeID},
-		DstNodeIDs:       req.DstNodeIDs,
-		BalanceReason:    querypb.TriggerCondition_GrpcRequest,
-		SealedSegmentIDs: req.SealedSegmentIDs,
-	})
-	if err != nil {
-		log.Error("Failed to LoadBalance from Query Coordinator",
-			zap.Any("req", req), zap.Error(err))
-		status.Reason = err.Error()
-		return status, nil
+
+	if err := validateGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
 	}
-	if infoResp.ErrorCode != commonpb.ErrorCode_Success {
-		log.Error("Failed to LoadBalance from Query Coordinator", zap.String("errMsg", infoResp.Reason))
-		status.Reason = infoResp.Reason
-		return status, nil
+
+	result, err := node.rootCoord.GrantPrivilege(ctx, req)
+	if err != nil {
+		log.Error("failed to grant privilege", zap.Any("entity", req.Entity), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
 	}
-	log.Debug("LoadBalance Done", zap.Any("req", req), zap.Any("status", infoResp))
-	status.ErrorCode = commonpb.ErrorCode_Success
-	return status, nil
+	return result, nil
 }
 
-//GetCompactionState gets the compaction state of multiple segments
-func (node *Proxy) GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error) {
-	log.Info("received GetCompactionState request", zap.Int64("compactionID", req.GetCompactionID()))
-	resp := &milvuspb.GetCompactionStateResponse{}
+// RevokePrivilege revoke a privilege on an object from a role
+func (node *Proxy) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	log.Debug("RevokePrivilege", zap.String("role", typeutil.ProxyRole), zap.Any("entity", req.Entity))
 	if !node.checkHealthy() {
-		resp.Status = unhealthyStatus()
-		return resp, nil
+		return unhealthyStatus(), nil
 	}
 
-	resp, err := node.dataCoord.GetCompactionState(ctx, req)
-	log.Info("received GetCompactionState response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
-	return resp, err
-}
+	if err := checkPrivilege(ctx, commonpb.MsgType_RevokePrivilege, "", ""); err != nil {
+		return &commonpb.Status{
+			ErrorCode: errorCodeOf(err),
+			Reason:    err.Error(),
+		}, nil
+	}
 
-// ManualCompaction invokes compaction on specified collection
-func (node *Proxy) ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error) {
-	log.Info("received ManualCompaction request", zap.Int64("collectionID", req.GetCollectionID()))
-	resp := &milvuspb.ManualCompactionResponse{}
-	if !node.checkHealthy() {
-		resp.Status = unhealthyStatus()
-		return resp, nil
+	if err := validateGrantEntity(req.Entity); err != nil {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_IllegalArgument,
+			Reason:    err.Error(),
+		}, nil
 	}
 
-	resp, err := node.dataCoord.ManualCompaction(ctx, req)
-	log.Info("received ManualCompaction response", zap.Int64("collectionID", req.GetCollectionID()), zap.Any("resp", resp), zap.Error(err))
-	return resp, err
+	result, err := node.rootCoord.RevokePrivilege(ctx, req)
+	if err != nil {
+		log.Error("failed to revoke privilege", zap.Any("entity", req.Entity), zap.Error(err))
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    err.Error(),
+		}, nil
+	}
+	return result, nil
 }
 
-func (node *Proxy) GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error) {
-	log.Info("received GetCompactionStateWithPlans request", zap.Int64("compactionID", req.GetCompactionID()))
-	resp := &milvuspb.GetCompactionPlansResponse{}
+// ListGrants list the privileges granted to a role, or to all the roles of a user if the role name is empty
+func (node *Proxy) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	log.Debug("ListGrants", zap.String("role", typeutil.ProxyRole), zap.String("roleName", req.RoleName),
+		zap.String("username", req.Username))
 	if !node.checkHealthy() {
-		resp.Status = unhealthyStatus()
-		return resp, nil
+		return &milvuspb.ListGrantsResponse{
+			Status: unhealthyStatus(),
+		}, nil
 	}
 
-	resp, err := node.dataCoord.GetCompactionStateWithPlans(ctx, req)
-	log.Info("received GetCompactionStateWithPlans response", zap.Int64("compactionID", req.GetCompactionID()), zap.Any("resp", resp), zap.Error(err))
-	return resp, err
-}
+	if err := checkPrivilege(ctx, commonpb.MsgType_ListGrants, "", ""); err != nil {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: errorCodeOf(err),
+				Reason:    err.Error(),
+			},
+		}, nil
+	}
 
-// GetFlushState gets the flush state of multiple segments
-func (node *Proxy) GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error) {
-	log.Info("received get flush state request", zap.Any("request", req))
-	var err error
-	resp := &milvuspb.GetFlushStateResponse{}
-	if !node.checkHealthy() {
-		resp.Status = unhealthyStatus()
-		log.Info("unable to get flush state because of closed server")
-		return resp, nil
+	if req.RoleName == "" && req.Username == "" {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_IllegalArgument,
+				Reason:    "either role name or username should be specified",
+			},
+		}, nil
 	}
 
-	resp, err = node.dataCoord.GetFlushState(ctx, req)
+	resp, err := node.rootCoord.ListGrants(ctx, req)
 	if err != nil {
-		log.Info("failed to get flush state response", zap.Error(err))
-		return nil, err
+		log.Error("failed to list grants", zap.String("roleName", req.RoleName), zap.Error(err))
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    err.Error(),
+			},
+		}, nil
 	}
-	log.Info("received get flush state response", zap.Any("response", resp))
-	return resp, err
+	return resp, nil
 }
 
 // checkHealthy checks proxy state is Healthy
@@ -29,36 +29,56 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
-// Cache is the interface for system meta data cache
+// Cache is the interface for system meta data cache, collections are keyed by (database name, collection name)
 type Cache interface {
 	// GetCollectionID get collection's id by name.
-	GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error)
+	GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error)
 	// GetCollectionInfo get collection's information by name, such as collection id, schema, and etc.
-	GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error)
+	GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error)
 	// GetPartitionID get partition's identifier of specific collection.
-	GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error)
+	GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error)
 	// GetPartitions get all partitions' id of specific collection.
-	GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error)
+	GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error)
 	// GetPartitionInfo get partition's info.
-	GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error)
+	GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error)
 	// GetCollectionSchema get collection's schema.
-	GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error)
-	RemoveCollection(ctx context.Context, collectionName string)
-	RemovePartition(ctx context.Context, collectionName string, partitionName string)
+	GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error)
+	RemoveCollection(ctx context.Context, dbName string, collectionName string)
+	RemovePartition(ctx context.Context, dbName string, collectionName string, partitionName string)
+
+	// GetCredentialInfo get the encrypted credential of a user, fetch it from RootCoord if not cached.
+	GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error)
+	// UpdateCredential update the cached credential of a user.
+	UpdateCredential(credInfo *internalpb.CredentialInfo)
+	// RemoveCredential remove the cached credential of a user.
+	RemoveCredential(username string)
+	// CacheVerifiedCredential caches the sha256 of the raw password verified against the credential of a user.
+	CacheVerifiedCredential(credInfo *internalpb.CredentialInfo, sha256Password string)
+
+	// GetUserGrants get the privileges granted to the roles of a user, fetch them from RootCoord if not cached.
+	GetUserGrants(ctx context.Context, username string) ([]*milvuspb.GrantEntity, error)
+	// RefreshPolicyInfo drop all the cached privileges.
+	RefreshPolicyInfo()
 }
 
 type collectionInfo struct {
+	dbID                typeutil.UniqueID
 	collID              typeutil.UniqueID
 	schema              *schemapb.CollectionSchema
 	partInfo            map[string]*partitionInfo
 	createdTimestamp    uint64
 	createdUtcTimestamp uint64
+	// the entities older than ttl are expired, zero means never expire
+	ttl time.Duration
 }
 
 type partitionInfo struct {
@@ -74,8 +94,14 @@ var _ Cache = (*MetaCache)(nil)
 type MetaCache struct {
 	client types.RootCoord
 
-	collInfo map[string]*collectionInfo
+	collInfo map[string]map[string]*collectionInfo // database name -> collection name -> collection info
 	mu       sync.RWMutex
+
+	credMap map[string]*internalpb.CredentialInfo // username -> credential info
+	credMut sync.RWMutex
+
+	grantMap map[string][]*milvuspb.GrantEntity // username -> privileges granted to the roles of the user
+	grantMut sync.RWMutex
 }
 
 // globalMetaCache is singleton instance of Cache
@@ -95,25 +121,40 @@ func InitMetaCache(client types.RootCoord) error {
 func NewMetaCache(client types.RootCoord) (*MetaCache, error) {
 	return &MetaCache{
 		client:   client,
-		collInfo: map[string]*collectionInfo{},
+		collInfo: map[string]map[string]*collectionInfo{},
+		credMap:  map[string]*internalpb.CredentialInfo{},
+		grantMap: map[string][]*milvuspb.GrantEntity{},
 	}, nil
 }
 
+// getDatabaseName returns the database name used as cache key, empty name stands for the default database
+func getDatabaseName(dbName string) string {
+	if dbName == "" {
+		return Params.CommonCfg.DefaultDatabaseName
+	}
+	return dbName
+}
+
+// getCollInfo returns the cached collection information, caller should hold the lock
+func (m *MetaCache) getCollInfo(dbName string, collectionName string) (*collectionInfo, bool) {
+	collInfo, ok := m.collInfo[getDatabaseName(dbName)][collectionName]
+	return collInfo, ok
+}
+
 // GetCollectionID returns the corresponding collection id for provided collection name
-func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string) (typeutil.UniqueID, error) {
+func (m *MetaCache) GetCollectionID(ctx context.Context, dbName string, collectionName string) (typeutil.UniqueID, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return 0, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		return collInfo.collID, nil
 	}
 	defer m.mu.RUnlock()
@@ -123,51 +164,53 @@ func (m *MetaCache) GetCollectionID(ctx context.Context, collectionName string)
 
 // GetCollectionInfo returns the collection information related to provided collection name
 // If the information is not found, proxy will try to fetch information for other source (RootCoord for now)
-func (m *MetaCache) GetCollectionInfo(ctx context.Context, collectionName string) (*collectionInfo, error) {
+func (m *MetaCache) GetCollectionInfo(ctx context.Context, dbName string, collectionName string) (*collectionInfo, error) {
 	m.mu.RLock()
 	var collInfo *collectionInfo
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	m.mu.RUnlock()
 
 	if !ok {
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 	}
 
 	return &collectionInfo{
+		dbID:                collInfo.dbID,
 		collID:              collInfo.collID,
 		schema:              collInfo.schema,
 		partInfo:            collInfo.partInfo,
 		createdTimestamp:    collInfo.createdTimestamp,
 		createdUtcTimestamp: collInfo.createdUtcTimestamp,
+		ttl:                 collInfo.ttl,
 	}, nil
 }
 
-func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName string) (*schemapb.CollectionSchema, error) {
+func (m *MetaCache) GetCollectionSchema(ctx context.Context, dbName string, collectionName string) (*schemapb.CollectionSchema, error) {
 	m.mu.RLock()
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 
 	if !ok {
 		t0 := time.Now()
 		m.mu.RUnlock()
-		coll, err := m.describeCollection(ctx, collectionName)
+		coll, err := m.describeCollection(ctx, dbName, collectionName)
 		if err != nil {
 			log.Warn("Failed to load collection from rootcoord ",
+				zap.String("database name ", dbName),
 				zap.String("collection name ", collectionName),
 				zap.Error(err))
 			return nil, err
 		}
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		m.updateCollection(coll, collectionName)
-		collInfo = m.collInfo[collectionName]
+		collInfo = m.updateCollection(coll, dbName, collectionName)
 		log.Debug("Reload collection from rootcoord ",
+			zap.String("database name ", dbName),
 			zap.String("collection name ", collectionName),
 			zap.Any("time take ", time.Since(t0)))
 		return collInfo.schema, nil
@@ -177,34 +220,43 @@ func (m *MetaCache) GetCollectionSchema(ctx context.Context, collectionName stri
 	return collInfo.schema, nil
 }
 
-func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, collectionName string) {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updateCollection(coll *milvuspb.DescribeCollectionResponse, dbName string, collectionName string) *collectionInfo {
+	dbName = getDatabaseName(dbName)
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{}
+		collInfo = &collectionInfo{}
+		m.collInfo[dbName][collectionName] = collInfo
 	}
-	m.collInfo[collectionName].schema = coll.Schema
-	m.collInfo[collectionName].collID = coll.CollectionID
-	m.collInfo[collectionName].createdTimestamp = coll.CreatedTimestamp
-	m.collInfo[collectionName].createdUtcTimestamp = coll.CreatedUtcTimestamp
+	collInfo.schema = coll.Schema
+	collInfo.dbID = coll.DbId
+	collInfo.collID = coll.CollectionID
+	collInfo.createdTimestamp = coll.CreatedTimestamp
+	collInfo.createdUtcTimestamp = coll.CreatedUtcTimestamp
+	// the properties are validated by rootcoord on creation
+	collInfo.ttl, _ = funcutil.GetCollectionTTL(coll.Properties)
+	return collInfo
 }
 
-func (m *MetaCache) GetPartitionID(ctx context.Context, collectionName string, partitionName string) (typeutil.UniqueID, error) {
-	partInfo, err := m.GetPartitionInfo(ctx, collectionName, partitionName)
+func (m *MetaCache) GetPartitionID(ctx context.Context, dbName string, collectionName string, partitionName string) (typeutil.UniqueID, error) {
+	partInfo, err := m.GetPartitionInfo(ctx, dbName, collectionName, partitionName)
 	if err != nil {
 		return 0, err
 	}
 	return partInfo.partitionID, nil
 }
 
-func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (map[string]typeutil.UniqueID, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitions(ctx context.Context, dbName string, collectionName string) (map[string]typeutil.UniqueID, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -213,7 +265,7 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	if collInfo.partInfo == nil || len(collInfo.partInfo) == 0 {
 		m.mu.RUnlock()
 
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
@@ -221,14 +273,14 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 		m.mu.Lock()
 		defer m.mu.Unlock()
 
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitions:partitions after update", partitions), zap.Any("collectionName", collectionName))
 		ret := make(map[string]typeutil.UniqueID)
-		partInfo := m.collInfo[collectionName].partInfo
-		for k, v := range partInfo {
+		collInfo, _ = m.getCollInfo(dbName, collectionName)
+		for k, v := range collInfo.partInfo {
 			ret[k] = v.partitionID
 		}
 		return ret, nil
@@ -237,23 +289,22 @@ func (m *MetaCache) GetPartitions(ctx context.Context, collectionName string) (m
 	defer m.mu.RUnlock()
 
 	ret := make(map[string]typeutil.UniqueID)
-	partInfo := m.collInfo[collectionName].partInfo
-	for k, v := range partInfo {
+	for k, v := range collInfo.partInfo {
 		ret[k] = v.partitionID
 	}
 
 	return ret, nil
 }
 
-func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string, partitionName string) (*partitionInfo, error) {
-	_, err := m.GetCollectionID(ctx, collectionName)
+func (m *MetaCache) GetPartitionInfo(ctx context.Context, dbName string, collectionName string, partitionName string) (*partitionInfo, error) {
+	_, err := m.GetCollectionID(ctx, dbName, collectionName)
 	if err != nil {
 		return nil, err
 	}
 
 	m.mu.RLock()
 
-	collInfo, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		m.mu.RUnlock()
 		return nil, fmt.Errorf("can't find collection name:%s", collectionName)
@@ -264,20 +315,21 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 	m.mu.RUnlock()
 
 	if !ok {
-		partitions, err := m.showPartitions(ctx, collectionName)
+		partitions, err := m.showPartitions(ctx, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 
 		m.mu.Lock()
 		defer m.mu.Unlock()
-		err = m.updatePartitions(partitions, collectionName)
+		err = m.updatePartitions(partitions, dbName, collectionName)
 		if err != nil {
 			return nil, err
 		}
 		log.Debug("proxy", zap.Any("GetPartitionID:partitions after update", partitions), zap.Any("collectionName", collectionName))
 
-		partInfo, ok = m.collInfo[collectionName].partInfo[partitionName]
+		collInfo, _ = m.getCollInfo(dbName, collectionName)
+		partInfo, ok = collInfo.partInfo[partitionName]
 		if !ok {
 			return nil, fmt.Errorf("partitionID of partitionName:%s can not be find", partitionName)
 		}
@@ -290,11 +342,12 @@ func (m *MetaCache) GetPartitionInfo(ctx context.Context, collectionName string,
 }
 
 // Get the collection information from rootcoord.
-func (m *MetaCache) describeCollection(ctx context.Context, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+func (m *MetaCache) describeCollection(ctx context.Context, dbName string, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
 	req := &milvuspb.DescribeCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_DescribeCollection,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 	coll, err := m.client.DescribeCollection(ctx, req)
@@ -311,12 +364,15 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 			Description: coll.Schema.Description,
 			AutoID:      coll.Schema.AutoID,
 			Fields:      make([]*schemapb.FieldSchema, 0),
+			Version:     coll.Schema.Version,
 		},
 		CollectionID:         coll.CollectionID,
 		VirtualChannelNames:  coll.VirtualChannelNames,
 		PhysicalChannelNames: coll.PhysicalChannelNames,
 		CreatedTimestamp:     coll.CreatedTimestamp,
 		CreatedUtcTimestamp:  coll.CreatedUtcTimestamp,
+		DbId:                 coll.DbId,
+		Properties:           coll.Properties,
 	}
 	for _, field := range coll.Schema.Fields {
 		if field.FieldID >= common.StartOfUserFieldID {
@@ -326,11 +382,12 @@ func (m *MetaCache) describeCollection(ctx context.Context, collectionName strin
 	return resp, nil
 }
 
-func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
+func (m *MetaCache) showPartitions(ctx context.Context, dbName string, collectionName string) (*milvuspb.ShowPartitionsResponse, error) {
 	req := &milvuspb.ShowPartitionsRequest{
 		Base: &commonpb.MsgBase{
 			MsgType: commonpb.MsgType_ShowPartitions,
 		},
+		DbName:         dbName,
 		CollectionName: collectionName,
 	}
 
@@ -350,14 +407,19 @@ func (m *MetaCache) showPartitions(ctx context.Context, collectionName string) (
 	return partitions, nil
 }
 
-func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, collectionName string) error {
-	_, ok := m.collInfo[collectionName]
+func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse, dbName string, collectionName string) error {
+	dbName = getDatabaseName(dbName)
+	if _, ok := m.collInfo[dbName]; !ok {
+		m.collInfo[dbName] = map[string]*collectionInfo{}
+	}
+	collInfo, ok := m.collInfo[dbName][collectionName]
 	if !ok {
-		m.collInfo[collectionName] = &collectionInfo{
+		collInfo = &collectionInfo{
 			partInfo: map[string]*partitionInfo{},
 		}
+		m.collInfo[dbName][collectionName] = collInfo
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		partInfo = map[string]*partitionInfo{}
 	}
@@ -376,26 +438,124 @@ func (m *MetaCache) updatePartitions(partitions *milvuspb.ShowPartitionsResponse
 			}
 		}
 	}
-	m.collInfo[collectionName].partInfo = partInfo
+	collInfo.partInfo = partInfo
 	return nil
 }
 
-func (m *MetaCache) RemoveCollection(ctx context.Context, collectionName string) {
+func (m *MetaCache) RemoveCollection(ctx context.Context, dbName string, collectionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	delete(m.collInfo, collectionName)
+	delete(m.collInfo[getDatabaseName(dbName)], collectionName)
 }
 
-func (m *MetaCache) RemovePartition(ctx context.Context, collectionName, partitionName string) {
+func (m *MetaCache) RemovePartition(ctx context.Context, dbName string, collectionName, partitionName string) {
 	m.mu.Lock()
 	defer m.mu.Unlock()
-	_, ok := m.collInfo[collectionName]
+	collInfo, ok := m.getCollInfo(dbName, collectionName)
 	if !ok {
 		return
 	}
-	partInfo := m.collInfo[collectionName].partInfo
+	partInfo := collInfo.partInfo
 	if partInfo == nil {
 		return
 	}
 	delete(partInfo, partitionName)
 }
+
+// GetCredentialInfo returns the encrypted credential of provided username
+// If the credential is not cached, proxy will fetch it from RootCoord
+func (m *MetaCache) GetCredentialInfo(ctx context.Context, username string) (*internalpb.CredentialInfo, error) {
+	m.credMut.RLock()
+	credInfo, ok := m.credMap[username]
+	m.credMut.RUnlock()
+	if ok {
+		return credInfo, nil
+	}
+
+	req := &rootcoordpb.GetCredentialRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_GetCredential,
+		},
+		Username: username,
+	}
+	resp, err := m.client.GetCredential(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+	credInfo = &internalpb.CredentialInfo{
+		Username:          resp.Username,
+		EncryptedPassword: resp.Password,
+	}
+	m.UpdateCredential(credInfo)
+	return credInfo, nil
+}
+
+func (m *MetaCache) UpdateCredential(credInfo *internalpb.CredentialInfo) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	m.credMap[credInfo.Username] = credInfo
+}
+
+// CacheVerifiedCredential caches the sha256 of the raw password verified against the credential, the following requests
+// are verified by the sha256 instead of the costly bcrypt. Nothing is done if the credential has been updated meanwhile.
+func (m *MetaCache) CacheVerifiedCredential(credInfo *internalpb.CredentialInfo, sha256Password string) {
+	m.credMut.Lock()
+	defer m.credMut.Unlock()
+	if m.credMap[credInfo.Username] != credInfo {
+		return
+	}
+	m.credMap[credInfo.Username] = &internalpb.CredentialInfo{
+		Username:          credInfo.Username,
+		EncryptedPassword: credInfo.EncryptedPassword,
+		Sha256Password:    sha256Password,
+	}
+}
+
+func (m *MetaCache) RemoveCredential(username string) {
+	m.credMut.Lock()
+	delete(m.credMap, username)
+	m.credMut.Unlock()
+
+	m.grantMut.Lock()
+	defer m.grantMut.Unlock()
+	delete(m.grantMap, username)
+}
+
+// GetUserGrants returns the privileges granted to all the roles bound to the user
+// If the privileges are not cached, proxy will fetch them from RootCoord
+func (m *MetaCache) GetUserGrants(ctx context.Context, username string) ([]*milvuspb.GrantEntity, error) {
+	m.grantMut.RLock()
+	grants, ok := m.grantMap[username]
+	m.grantMut.RUnlock()
+	if ok {
+		return grants, nil
+	}
+
+	req := &milvuspb.ListGrantsRequest{
+		Base: &commonpb.MsgBase{
+			MsgType: commonpb.MsgType_ListGrants,
+		},
+		Username: username,
+	}
+	resp, err := m.client.ListGrants(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.Status.ErrorCode != commonpb.ErrorCode_Success {
+		return nil, errors.New(resp.Status.Reason)
+	}
+
+	m.grantMut.Lock()
+	defer m.grantMut.Unlock()
+	m.grantMap[username] = resp.Entities
+	return resp.Entities, nil
+}
+
+func (m *MetaCache) RefreshPolicyInfo() {
+	m.grantMut.Lock()
+	defer m.grantMut.Unlock()
+	m.grantMap = map[string][]*milvuspb.GrantEntity{}
+}
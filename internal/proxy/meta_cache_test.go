@@ -24,9 +24,12 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
 )
@@ -90,7 +93,19 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 		return nil, errors.New("mocked error")
 	}
 	m.AccessCount++
-	if in.CollectionName == "collection1" {
+	if in.DbName == "db1" && in.CollectionName == "collection1" {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			DbId:         typeutil.UniqueID(1),
+			CollectionID: typeutil.UniqueID(11),
+			Schema: &schemapb.CollectionSchema{
+				AutoID: true,
+			},
+		}, nil
+	}
+	if in.CollectionName == "collection1" || in.CollectionName == "collection1Alias" {
 		return &milvuspb.DescribeCollectionResponse{
 			Status: &commonpb.Status{
 				ErrorCode: commonpb.ErrorCode_Success,
@@ -134,6 +149,58 @@ func (m *MockRootCoordClientInterface) DescribeCollection(ctx context.Context, i
 	}, nil
 }
 
+func (m *MockRootCoordClientInterface) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if req.Username == "mockUser" {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Username: "mockUser",
+			Password: "mockEncryptedPassword",
+		}, nil
+	}
+
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "user not found: " + req.Username,
+		},
+	}, nil
+}
+
+func (m *MockRootCoordClientInterface) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	if m.Error {
+		return nil, errors.New("mocked error")
+	}
+	m.AccessCount++
+	if req.Username == "mockUser" {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			},
+			Entities: []*milvuspb.GrantEntity{
+				{
+					RoleName:   "mockRole",
+					ObjectType: util.ObjectTypeCollection,
+					ObjectName: "collection1",
+					Privilege:  "Search",
+				},
+			},
+		}, nil
+	}
+
+	return &milvuspb.ListGrantsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    "user not found: " + req.Username,
+		},
+	}, nil
+}
+
 //Simulate the cache path and the
 func TestMetaCache_GetCollection(t *testing.T) {
 	ctx := context.Background()
@@ -141,24 +208,24 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
 	assert.Equal(t, client.AccessCount, 1)
 
 	// should'nt be accessed to remote root coord.
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 1)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
 		Fields: []*schemapb.FieldSchema{},
 	})
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection2")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection2")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection2")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -167,11 +234,11 @@ func TestMetaCache_GetCollection(t *testing.T) {
 	})
 
 	// test to get from cache, this should trigger root request
-	id, err = globalMetaCache.GetCollectionID(ctx, "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Equal(t, client.AccessCount, 2)
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
@@ -181,6 +248,46 @@ func TestMetaCache_GetCollection(t *testing.T) {
 
 }
 
+func TestMetaCache_GetCollectionInDatabase(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	id, err := globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), id)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// same collection name in the default database is cached separately
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 2, client.AccessCount)
+
+	// empty database name and the default database name share the cache entry
+	info, err := globalMetaCache.GetCollectionInfo(ctx, Params.CommonCfg.DefaultDatabaseName, "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), info.collID)
+	assert.Equal(t, 2, client.AccessCount)
+
+	info, err = globalMetaCache.GetCollectionInfo(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), info.dbID)
+	assert.Equal(t, 2, client.AccessCount)
+
+	globalMetaCache.RemoveCollection(ctx, "db1", "collection1")
+	id, err = globalMetaCache.GetCollectionID(ctx, "", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), id)
+	assert.Equal(t, 2, client.AccessCount)
+
+	id, err = globalMetaCache.GetCollectionID(ctx, "db1", "collection1")
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(11), id)
+	assert.Equal(t, 3, client.AccessCount)
+}
+
 func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	ctx := context.Background()
 	client := &MockRootCoordClientInterface{}
@@ -188,13 +295,13 @@ func TestMetaCache_GetCollectionFailure(t *testing.T) {
 	assert.Nil(t, err)
 	client.Error = true
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 
 	client.Error = false
 
-	schema, err = globalMetaCache.GetCollectionSchema(ctx, "collection1")
+	schema, err = globalMetaCache.GetCollectionSchema(ctx, "", "collection1")
 	assert.Nil(t, err)
 	assert.Equal(t, schema, &schemapb.CollectionSchema{
 		AutoID: true,
@@ -216,10 +323,10 @@ func TestMetaCache_GetNonExistCollection(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetCollectionID(ctx, "collection3")
+	id, err := globalMetaCache.GetCollectionID(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Equal(t, id, int64(0))
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, "collection3")
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, "", "collection3")
 	assert.NotNil(t, err)
 	assert.Nil(t, schema)
 }
@@ -230,16 +337,16 @@ func TestMetaCache_GetPartitionID(t *testing.T) {
 	err := InitMetaCache(client)
 	assert.Nil(t, err)
 
-	id, err := globalMetaCache.GetPartitionID(ctx, "collection1", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "collection1", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(1))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(2))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par1")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(3))
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection2", "par2")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection2", "par2")
 	assert.Nil(t, err)
 	assert.Equal(t, id, typeutil.UniqueID(4))
 }
@@ -251,25 +358,97 @@ func TestMetaCache_GetPartitionError(t *testing.T) {
 	assert.Nil(t, err)
 
 	// Test the case where ShowPartitionsResponse is not aligned
-	id, err := globalMetaCache.GetPartitionID(ctx, "errorCollection", "par1")
+	id, err := globalMetaCache.GetPartitionID(ctx, "", "errorCollection", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
-	partitions, err2 := globalMetaCache.GetPartitions(ctx, "errorCollection")
+	partitions, err2 := globalMetaCache.GetPartitions(ctx, "", "errorCollection")
 	assert.NotNil(t, err2)
 	log.Debug(err.Error())
 	assert.Equal(t, len(partitions), 0)
 
 	// Test non existed tables
-	id, err = globalMetaCache.GetPartitionID(ctx, "nonExisted", "par1")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "nonExisted", "par1")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 
 	// Test non existed partition
-	id, err = globalMetaCache.GetPartitionID(ctx, "collection1", "par3")
+	id, err = globalMetaCache.GetPartitionID(ctx, "", "collection1", "par3")
 	assert.NotNil(t, err)
 	log.Debug(err.Error())
 	assert.Equal(t, id, typeutil.UniqueID(0))
 }
+
+func TestMetaCache_GetCredentialInfo(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	credInfo, err := globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "mockEncryptedPassword", credInfo.EncryptedPassword)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// hit the cache
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "mockEncryptedPassword", credInfo.EncryptedPassword)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.UpdateCredential(&internalpb.CredentialInfo{Username: "mockUser", EncryptedPassword: "newPassword"})
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "newPassword", credInfo.EncryptedPassword)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.RemoveCredential("mockUser")
+	credInfo, err = globalMetaCache.GetCredentialInfo(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, "mockEncryptedPassword", credInfo.EncryptedPassword)
+	assert.Equal(t, 2, client.AccessCount)
+
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "notExistUser")
+	assert.NotNil(t, err)
+
+	client.Error = true
+	_, err = globalMetaCache.GetCredentialInfo(ctx, "anotherUser")
+	assert.NotNil(t, err)
+}
+
+func TestMetaCache_GetUserGrants(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	grants, err := globalMetaCache.GetUserGrants(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(grants))
+	assert.Equal(t, "mockRole", grants[0].RoleName)
+	assert.Equal(t, 1, client.AccessCount)
+
+	// hit the cache
+	_, err = globalMetaCache.GetUserGrants(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, client.AccessCount)
+
+	globalMetaCache.RefreshPolicyInfo()
+	_, err = globalMetaCache.GetUserGrants(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, client.AccessCount)
+
+	globalMetaCache.RemoveCredential("mockUser")
+	_, err = globalMetaCache.GetUserGrants(ctx, "mockUser")
+	assert.Nil(t, err)
+	assert.Equal(t, 3, client.AccessCount)
+
+	_, err = globalMetaCache.GetUserGrants(ctx, "notExistUser")
+	assert.NotNil(t, err)
+
+	client.Error = true
+	_, err = globalMetaCache.GetUserGrants(ctx, "anotherUser")
+	assert.NotNil(t, err)
+}
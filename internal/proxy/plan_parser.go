@@ -19,10 +19,14 @@ package proxy
 import (
 	"fmt"
 	"math"
+	"regexp"
+	"strconv"
 	"strings"
 
 	ant_ast "github.com/antonmedv/expr/ast"
+	ant_file "github.com/antonmedv/expr/file"
 	ant_parser "github.com/antonmedv/expr/parser"
+	ant_lexer "github.com/antonmedv/expr/parser/lexer"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -169,10 +173,101 @@ func (optimizer *optimizer) Exit(node *ant_ast.Node) {
 	}
 }
 
+// isNullOp returns the comparison with nil that the null predicate starting at tokens[i] stands for,
+// and the number of the tokens of the predicate, the predicates are `is null` and `is not null`
+func isNullOp(tokens []ant_lexer.Token, i int) (string, int) {
+	isWord := func(j int, word string) bool {
+		return j < len(tokens) && (tokens[j].Kind == ant_lexer.Identifier || tokens[j].Kind == ant_lexer.Operator) &&
+			strings.EqualFold(tokens[j].Value, word)
+	}
+	if !isWord(i, "is") {
+		return "", 0
+	}
+	if isWord(i+1, "null") {
+		return "==", 2
+	}
+	if isWord(i+1, "not") && isWord(i+2, "null") {
+		return "!=", 3
+	}
+	return "", 0
+}
+
+// rewriteNullExpr lexes the expression and replaces the null predicates with the comparisons with nil,
+// which are parsed as the BinaryNode of the identifier and the NilNode and turned into the NullExpr
+func rewriteNullExpr(exprStr string) (string, error) {
+	tokens, err := ant_lexer.Lex(ant_file.NewSource(exprStr))
+	if err != nil {
+		return "", err
+	}
+	found := false
+	for i := range tokens {
+		if _, n := isNullOp(tokens, i); n > 0 {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return exprStr, nil
+	}
+
+	words := make([]string, 0, len(tokens))
+	for i := 0; i < len(tokens); i++ {
+		token := tokens[i]
+		if op, n := isNullOp(tokens, i); n > 0 {
+			words = append(words, op, "nil")
+			i += n - 1
+			continue
+		}
+		switch token.Kind {
+		case ant_lexer.EOF:
+		case ant_lexer.String:
+			words = append(words, strconv.Quote(token.Value))
+		default:
+			words = append(words, token.Value)
+		}
+	}
+	return strings.Join(words, " "), nil
+}
+
+// likeExprPattern matches the quoted strings, the `contains` identifiers and the like operators followed by
+// their pattern. A quoted string is matched only to be skipped, so that a `like` or `contains` inside it is kept.
+var likeExprPattern = regexp.MustCompile(`(?i:\blike\b)(\s*(?:"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'))|"(?:[^"\\]|\\.)*"|'(?:[^'\\]|\\.)*'|\bcontains\b`)
+
+// rewriteLikeExpr rewrites `like` to `contains`, which is lexed as a binary operator by the expr parser.
+// Only a `like` followed by a string literal is rewritten, so a field named `like` can still be referenced,
+// and a `contains` written by the user is rejected since it would silently get the like semantics.
+func rewriteLikeExpr(exprStr string) (string, error) {
+	var err error
+	rewritten := likeExprPattern.ReplaceAllStringFunc(exprStr, func(s string) string {
+		if strings.HasPrefix(s, "\"") || strings.HasPrefix(s, "'") {
+			return s
+		}
+		if s == "contains" {
+			if err == nil {
+				err = fmt.Errorf("invalid expression: %s, unsupported operator contains", exprStr)
+			}
+			return s
+		}
+		return "contains" + s[len("like"):]
+	})
+	if err != nil {
+		return "", err
+	}
+	return rewritten, nil
+}
+
 func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, error) {
 	if exprStr == "" {
 		return nil, nil
 	}
+	exprStr, err := rewriteNullExpr(exprStr)
+	if err != nil {
+		return nil, err
+	}
+	exprStr, err = rewriteLikeExpr(exprStr)
+	if err != nil {
+		return nil, err
+	}
 	ast, err := ant_parser.Parse(exprStr)
 	if err != nil {
 		return nil, err
@@ -193,11 +288,51 @@ func parseExpr(schema *typeutil.SchemaHelper, exprStr string) (*planpb.Expr, err
 	return expr, nil
 }
 
-func createColumnInfo(field *schemapb.FieldSchema) *planpb.ColumnInfo {
+func createColumnInfo(field *schemapb.FieldSchema, nestedPath []string) *planpb.ColumnInfo {
 	return &planpb.ColumnInfo{
 		FieldId:      field.FieldID,
 		DataType:     field.DataType,
 		IsPrimaryKey: field.IsPrimaryKey,
+		NestedPath:   nestedPath,
+	}
+}
+
+func isSameColumn(a, b *planpb.ColumnInfo) bool {
+	if a.FieldId != b.FieldId || len(a.NestedPath) != len(b.NestedPath) {
+		return false
+	}
+	for i := range a.NestedPath {
+		if a.NestedPath[i] != b.NestedPath[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// getValueKind returns the kind of the value compared with a json field, the integers and the floats
+// are both numbers, which are compared as double
+func getValueKind(value *planpb.GenericValue) string {
+	switch value.GetVal().(type) {
+	case *planpb.GenericValue_BoolVal:
+		return "bool"
+	case *planpb.GenericValue_Int64Val, *planpb.GenericValue_FloatVal:
+		return "number"
+	case *planpb.GenericValue_StringVal:
+		return "string"
+	default:
+		return ""
+	}
+}
+
+// isColumnNode returns whether the node refers to a field, or to a value inside a json field, e.g. meta["color"]
+func isColumnNode(node ant_ast.Node) bool {
+	switch node := node.(type) {
+	case *ant_ast.IdentifierNode:
+		return true
+	case *ant_ast.IndexNode:
+		return isColumnNode(node.Node)
+	default:
+		return false
 	}
 }
 
@@ -282,18 +417,26 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	if boolNode := parseBoolNode(&right); boolNode != nil {
 		right = boolNode
 	}
-	idNodeLeft, okLeft := left.(*ant_ast.IdentifierNode)
-	idNodeRight, okRight := right.(*ant_ast.IdentifierNode)
+	okLeft := isColumnNode(left)
+	okRight := isColumnNode(right)
 
 	if okLeft && okRight {
-		leftField, err := pc.handleIdentifier(idNodeLeft)
+		leftField, leftPath, err := pc.handleColumn(left)
 		if err != nil {
 			return nil, err
 		}
-		rightField, err := pc.handleIdentifier(idNodeRight)
+		rightField, rightPath, err := pc.handleColumn(right)
 		if err != nil {
 			return nil, err
 		}
+		if typeutil.IsJSONType(leftField.DataType) || typeutil.IsJSONType(rightField.DataType) {
+			return nil, fmt.Errorf("cannot compare field %s with field %s, compare expr is not supported on json field",
+				leftField.Name, rightField.Name)
+		}
+		if typeutil.IsStringType(leftField.DataType) != typeutil.IsStringType(rightField.DataType) {
+			return nil, fmt.Errorf("cannot compare field %s of type %s with field %s of type %s",
+				leftField.Name, leftField.DataType.String(), rightField.Name, rightField.DataType.String())
+		}
 		op := getCompareOpType(operator, false)
 		if op == planpb.OpType_Invalid {
 			return nil, fmt.Errorf("invalid binary operator(%s)", operator)
@@ -301,8 +444,8 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		expr := &planpb.Expr{
 			Expr: &planpb.Expr_CompareExpr{
 				CompareExpr: &planpb.CompareExpr{
-					LeftColumnInfo:  createColumnInfo(leftField),
-					RightColumnInfo: createColumnInfo(rightField),
+					LeftColumnInfo:  createColumnInfo(leftField, leftPath),
+					RightColumnInfo: createColumnInfo(rightField, rightPath),
 					Op:              op,
 				},
 			},
@@ -310,22 +453,22 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 		return expr, nil
 	}
 
-	var idNode *ant_ast.IdentifierNode
+	var columnNode ant_ast.Node
 	var reverse bool
 	var valueNode *ant_ast.Node
 	if okLeft {
-		idNode = idNodeLeft
+		columnNode = left
 		reverse = false
 		valueNode = &right
 	} else if okRight {
-		idNode = idNodeRight
+		columnNode = right
 		reverse = true
 		valueNode = &left
 	} else {
 		return nil, fmt.Errorf("compare expr has no identifier")
 	}
 
-	field, err := pc.handleIdentifier(idNode)
+	field, nestedPath, err := pc.handleColumn(columnNode)
 	if err != nil {
 		return nil, err
 	}
@@ -343,7 +486,7 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_UnaryRangeExpr{
 			UnaryRangeExpr: &planpb.UnaryRangeExpr{
-				ColumnInfo: createColumnInfo(field),
+				ColumnInfo: createColumnInfo(field, nestedPath),
 				Op:         op,
 				Value:      val,
 			},
@@ -352,7 +495,49 @@ func (pc *parserContext) createCmpExpr(left, right ant_ast.Node, operator string
 	return expr, nil
 }
 
+func (pc *parserContext) createNullExpr(idNode *ant_ast.IdentifierNode, operator string) (*planpb.Expr, error) {
+	field, err := pc.handleIdentifier(idNode)
+	if err != nil {
+		return nil, err
+	}
+	if !field.GetNullable() {
+		return nil, fmt.Errorf("field %s is not nullable", field.GetName())
+	}
+
+	var op planpb.NullExpr_NullOp
+	switch operator {
+	case "==":
+		op = planpb.NullExpr_IsNull
+	case "!=":
+		op = planpb.NullExpr_IsNotNull
+	default:
+		return nil, fmt.Errorf("invalid null operator(%s)", operator)
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_NullExpr{
+			NullExpr: &planpb.NullExpr{
+				ColumnInfo: createColumnInfo(field, nil),
+				Op:         op,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *parserContext) handleCmpExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	if _, ok := node.Right.(*ant_ast.NilNode); ok {
+		if idNode, ok := node.Left.(*ant_ast.IdentifierNode); ok {
+			return pc.createNullExpr(idNode, node.Operator)
+		}
+		return nil, fmt.Errorf("null expr has no identifier")
+	}
+	if _, ok := node.Left.(*ant_ast.NilNode); ok {
+		if idNode, ok := node.Right.(*ant_ast.IdentifierNode); ok {
+			return pc.createNullExpr(idNode, node.Operator)
+		}
+		return nil, fmt.Errorf("null expr has no identifier")
+	}
 	return pc.createCmpExpr(node.Left, node.Right, node.Operator)
 }
 
@@ -406,11 +591,10 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	if node.Operator != "in" && node.Operator != "not in" {
 		return nil, fmt.Errorf("invalid operator(%s)", node.Operator)
 	}
-	idNode, ok := node.Left.(*ant_ast.IdentifierNode)
-	if !ok {
+	if !isColumnNode(node.Left) {
 		return nil, fmt.Errorf("left operand of the InExpr must be identifier")
 	}
-	field, err := pc.handleIdentifier(idNode)
+	field, nestedPath, err := pc.handleColumn(node.Left)
 	if err != nil {
 		return nil, err
 	}
@@ -418,11 +602,17 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	if err != nil {
 		return nil, err
 	}
+	// the values in a json field are looked up by the kind of the first value
+	for _, value := range arrayData {
+		if getValueKind(value) != getValueKind(arrayData[0]) {
+			return nil, fmt.Errorf("values of the InExpr on field %s should be of the same kind", field.Name)
+		}
+	}
 
 	expr := &planpb.Expr{
 		Expr: &planpb.Expr_TermExpr{
 			TermExpr: &planpb.TermExpr{
-				ColumnInfo: createColumnInfo(field),
+				ColumnInfo: createColumnInfo(field, nestedPath),
 				Values:     arrayData,
 			},
 		},
@@ -434,6 +624,97 @@ func (pc *parserContext) handleInExpr(node *ant_ast.BinaryNode) (*planpb.Expr, e
 	return expr, nil
 }
 
+// parseLikePattern translates the pattern of the like expr into the match op and the string to match,
+// only the leading and the trailing `%` are supported as wildcards, `\` escapes `%`, `_` and itself,
+// the op is invalid if the pattern has no wildcard, which means an exact match
+func parseLikePattern(pattern string) (planpb.MatchExpr_MatchOp, string, error) {
+	var buf strings.Builder
+	prefixWildcard, postfixWildcard := false, false
+	runes := []rune(pattern)
+	for i := 0; i < len(runes); i++ {
+		switch c := runes[i]; c {
+		case '\\':
+			if i == len(runes)-1 {
+				return planpb.MatchExpr_Invalid, "", fmt.Errorf("like pattern %s ends with escape character", pattern)
+			}
+			i++
+			buf.WriteRune(runes[i])
+		case '%':
+			if i == 0 {
+				prefixWildcard = true
+			} else if i == len(runes)-1 {
+				postfixWildcard = true
+			} else {
+				return planpb.MatchExpr_Invalid, "", fmt.Errorf("unsupported like pattern %s, %% is only allowed at the beginning or the end", pattern)
+			}
+		case '_':
+			return planpb.MatchExpr_Invalid, "", fmt.Errorf("unsupported like pattern %s, _ should be escaped", pattern)
+		default:
+			buf.WriteRune(c)
+		}
+	}
+
+	switch {
+	case prefixWildcard && postfixWildcard:
+		return planpb.MatchExpr_InfixMatch, buf.String(), nil
+	case prefixWildcard:
+		return planpb.MatchExpr_PostfixMatch, buf.String(), nil
+	case postfixWildcard:
+		return planpb.MatchExpr_PrefixMatch, buf.String(), nil
+	default:
+		return planpb.MatchExpr_Invalid, buf.String(), nil
+	}
+}
+
+func (pc *parserContext) handleLikeExpr(node *ant_ast.BinaryNode) (*planpb.Expr, error) {
+	if !isColumnNode(node.Left) {
+		return nil, fmt.Errorf("left operand of the like expr must be identifier")
+	}
+	patternNode, ok := node.Right.(*ant_ast.StringNode)
+	if !ok {
+		return nil, fmt.Errorf("right operand of the like expr must be string")
+	}
+	field, nestedPath, err := pc.handleColumn(node.Left)
+	if err != nil {
+		return nil, err
+	}
+	if !typeutil.IsStringType(field.DataType) && !typeutil.IsJSONType(field.DataType) {
+		return nil, fmt.Errorf("like expr is not supported on field %s of type %s", field.Name, field.DataType.String())
+	}
+
+	op, pattern, err := parseLikePattern(patternNode.Value)
+	if err != nil {
+		return nil, err
+	}
+	if op == planpb.MatchExpr_Invalid {
+		expr := &planpb.Expr{
+			Expr: &planpb.Expr_UnaryRangeExpr{
+				UnaryRangeExpr: &planpb.UnaryRangeExpr{
+					ColumnInfo: createColumnInfo(field, nestedPath),
+					Op:         planpb.OpType_Equal,
+					Value: &planpb.GenericValue{
+						Val: &planpb.GenericValue_StringVal{
+							StringVal: pattern,
+						},
+					},
+				},
+			},
+		}
+		return expr, nil
+	}
+
+	expr := &planpb.Expr{
+		Expr: &planpb.Expr_MatchExpr{
+			MatchExpr: &planpb.MatchExpr{
+				ColumnInfo: createColumnInfo(field, nestedPath),
+				Op:         op,
+				Pattern:    pattern,
+			},
+		},
+	}
+	return expr, nil
+}
+
 func (pc *parserContext) combineUnaryRangeExpr(a, b *planpb.UnaryRangeExpr) *planpb.Expr {
 	if a.Op == planpb.OpType_LessEqual || a.Op == planpb.OpType_LessThan {
 		a, b = b, a
@@ -487,7 +768,8 @@ func (pc *parserContext) handleMultiCmpExpr(node *ant_ast.BinaryNode) (*planpb.E
 	var lastExpr *planpb.UnaryRangeExpr
 	for i := len(exprs) - 1; i >= 0; i-- {
 		if expr, ok := exprs[i].Expr.(*planpb.Expr_UnaryRangeExpr); ok {
-			if lastExpr != nil && expr.UnaryRangeExpr.ColumnInfo.FieldId == lastExpr.ColumnInfo.FieldId {
+			if lastExpr != nil && isSameColumn(expr.UnaryRangeExpr.ColumnInfo, lastExpr.ColumnInfo) &&
+				getValueKind(expr.UnaryRangeExpr.Value) == getValueKind(lastExpr.Value) {
 				binaryRangeExpr := pc.combineUnaryRangeExpr(expr.UnaryRangeExpr, lastExpr)
 				exprs = append(exprs[0:i], append([]*planpb.Expr{binaryRangeExpr}, exprs[i+2:]...)...)
 				lastExpr = nil
@@ -526,6 +808,8 @@ func (pc *parserContext) handleBinaryExpr(node *ant_ast.BinaryNode) (*planpb.Exp
 		return pc.handleLogicalExpr(node)
 	case "in", "not in":
 		return pc.handleInExpr(node)
+	case "contains":
+		return pc.handleLikeExpr(node)
 	}
 	return nil, fmt.Errorf("unsupported binary operator %s", node.Operator)
 }
@@ -545,7 +829,7 @@ func (pc *parserContext) createNotExpr(childExpr *planpb.Expr) (*planpb.Expr, er
 func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemapb.DataType) (gv *planpb.GenericValue, err error) {
 	switch node := (*nodeRaw).(type) {
 	case *ant_ast.FloatNode:
-		if typeutil.IsFloatingType(dataType) {
+		if typeutil.IsFloatingType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_FloatVal{
 					FloatVal: node.Value,
@@ -561,7 +845,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 					FloatVal: float64(node.Value),
 				},
 			}
-		} else if typeutil.IsIntegerType(dataType) {
+		} else if typeutil.IsIntegerType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_Int64Val{
 					Int64Val: int64(node.Value),
@@ -571,7 +855,7 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 			return nil, fmt.Errorf("type mismatch")
 		}
 	case *ant_ast.BoolNode:
-		if typeutil.IsBoolType(dataType) {
+		if typeutil.IsBoolType(dataType) || typeutil.IsJSONType(dataType) {
 			gv = &planpb.GenericValue{
 				Val: &planpb.GenericValue_BoolVal{
 					BoolVal: node.Value,
@@ -580,6 +864,16 @@ func (pc *parserContext) handleLeafValue(nodeRaw *ant_ast.Node, dataType schemap
 		} else {
 			return nil, fmt.Errorf("type mismatch")
 		}
+	case *ant_ast.StringNode:
+		if typeutil.IsStringType(dataType) || typeutil.IsJSONType(dataType) {
+			gv = &planpb.GenericValue{
+				Val: &planpb.GenericValue_StringVal{
+					StringVal: node.Value,
+				},
+			}
+		} else {
+			return nil, fmt.Errorf("type mismatch")
+		}
 	default:
 		return nil, fmt.Errorf("unsupported leaf node")
 	}
@@ -593,6 +887,44 @@ func (pc *parserContext) handleIdentifier(node *ant_ast.IdentifierNode) (*schema
 	return field, err
 }
 
+// handleColumn resolves the field the column node refers to, and the path of keys if the node
+// refers to a value inside a json field, e.g. meta["color"]["name"] has the path [color, name]
+func (pc *parserContext) handleColumn(node ant_ast.Node) (*schemapb.FieldSchema, []string, error) {
+	var nestedPath []string
+	for {
+		indexNode, ok := node.(*ant_ast.IndexNode)
+		if !ok {
+			break
+		}
+		keyNode, ok := indexNode.Index.(*ant_ast.StringNode)
+		if !ok {
+			return nil, nil, fmt.Errorf("key of json field should be string")
+		}
+		nestedPath = append([]string{keyNode.Value}, nestedPath...)
+		node = indexNode.Node
+	}
+	idNode, ok := node.(*ant_ast.IdentifierNode)
+	if !ok {
+		return nil, nil, fmt.Errorf("column should be identifier")
+	}
+	// the names not declared in the schema are the keys of the dynamic field, e.g. color["name"] is
+	// $meta["color"]["name"]
+	field, err := pc.schema.GetFieldFromNameDefaultJSON(idNode.Value)
+	if err != nil {
+		return nil, nil, err
+	}
+	if field.IsDynamic && field.Name != idNode.Value {
+		nestedPath = append([]string{idNode.Value}, nestedPath...)
+	}
+	if typeutil.IsJSONType(field.DataType) && len(nestedPath) == 0 {
+		return nil, nil, fmt.Errorf("json field %s should be accessed by keys, e.g. %s[\"key\"]", field.Name, field.Name)
+	}
+	if !typeutil.IsJSONType(field.DataType) && len(nestedPath) > 0 {
+		return nil, nil, fmt.Errorf("field %s of type %s can't be accessed by keys", field.Name, field.DataType.String())
+	}
+	return field, nestedPath, nil
+}
+
 func (pc *parserContext) handleUnaryExpr(node *ant_ast.UnaryNode) (*planpb.Expr, error) {
 	switch node.Operator {
 	case "!", "not":
@@ -611,7 +943,8 @@ func (pc *parserContext) handleExpr(nodeRaw *ant_ast.Node) (*planpb.Expr, error)
 	case *ant_ast.IdentifierNode,
 		*ant_ast.FloatNode,
 		*ant_ast.IntegerNode,
-		*ant_ast.BoolNode:
+		*ant_ast.BoolNode,
+		*ant_ast.StringNode:
 		return nil, fmt.Errorf("scalar expr is not supported yet")
 	case *ant_ast.UnaryNode:
 		expr, err := pc.handleUnaryExpr(node)
@@ -26,6 +26,7 @@ import (
 	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
@@ -330,6 +331,256 @@ func TestExprFieldCompare_Str(t *testing.T) {
 	}
 }
 
+func TestExprNull_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true},
+		{FieldID: 102, Name: "score", DataType: schemapb.DataType_Int64},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields})
+	assert.Nil(t, err)
+
+	expr, err := parseExpr(schema, "age is null")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), expr.GetNullExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.NullExpr_IsNull, expr.GetNullExpr().GetOp())
+
+	expr, err = parseExpr(schema, "age IS NOT NULL && score > 1")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNotNull, expr.GetBinaryExpr().GetLeft().GetNullExpr().GetOp())
+
+	expr, err = parseExpr(schema, "nil == age")
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.NullExpr_IsNull, expr.GetNullExpr().GetOp())
+
+	_, err = parseExpr(schema, "score is null")
+	assert.Error(t, err)
+
+	_, err = parseExpr(schema, "age > nil")
+	assert.Error(t, err)
+
+	exprStr, err := rewriteNullExpr(`age is null and name == "is null" and name != 'a\'b'`)
+	assert.Nil(t, err)
+	assert.Equal(t, `age == nil and name == "is null" and name != "a'b"`, exprStr)
+
+	exprStr, err = rewriteNullExpr(`this_is_null > 1`)
+	assert.Nil(t, err)
+	assert.Equal(t, `this_is_null > 1`, exprStr)
+}
+
+func TestExprVarChar_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "name", DataType: schemapb.DataType_VarChar},
+		{FieldID: 102, Name: "alias", DataType: schemapb.DataType_VarChar},
+		{FieldID: 103, Name: "age", DataType: schemapb.DataType_Int64},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields})
+	assert.Nil(t, err)
+
+	expr, err := parseExpr(schema, `name == "milvus"`)
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_Equal, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, "milvus", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	expr, err = parseExpr(schema, `'a' < name`)
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetUnaryRangeExpr().GetOp())
+
+	expr, err = parseExpr(schema, `"a" <= name < "b"`)
+	assert.Nil(t, err)
+	assert.Equal(t, "a", expr.GetBinaryRangeExpr().GetLowerValue().GetStringVal())
+	assert.Equal(t, "b", expr.GetBinaryRangeExpr().GetUpperValue().GetStringVal())
+
+	expr, err = parseExpr(schema, `name not in ["a", "b"]`)
+	assert.Nil(t, err)
+	values := expr.GetUnaryExpr().GetChild().GetTermExpr().GetValues()
+	assert.Equal(t, 2, len(values))
+	assert.Equal(t, "b", values[1].GetStringVal())
+
+	expr, err = parseExpr(schema, `name != alias`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(102), expr.GetCompareExpr().GetRightColumnInfo().GetFieldId())
+
+	invalidExprs := []string{
+		`name == 1`,
+		`age == "1"`,
+		`name in ["a", 1]`,
+		`name < age`,
+		`"a"`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestExprLike_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "title", DataType: schemapb.DataType_VarChar},
+		{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields})
+	assert.Nil(t, err)
+
+	cases := []struct {
+		exprStr string
+		op      planpb.MatchExpr_MatchOp
+		pattern string
+	}{
+		{`title like "abc%"`, planpb.MatchExpr_PrefixMatch, "abc"},
+		{`title LIKE "%abc"`, planpb.MatchExpr_PostfixMatch, "abc"},
+		{`title like "%abc%"`, planpb.MatchExpr_InfixMatch, "abc"},
+		{`title like "a\\%b\\_c%"`, planpb.MatchExpr_PrefixMatch, "a%b_c"},
+	}
+	for _, c := range cases {
+		expr, err := parseExpr(schema, c.exprStr)
+		assert.Nil(t, err, c.exprStr)
+		assert.Equal(t, int64(101), expr.GetMatchExpr().GetColumnInfo().GetFieldId())
+		assert.Equal(t, c.op, expr.GetMatchExpr().GetOp(), c.exprStr)
+		assert.Equal(t, c.pattern, expr.GetMatchExpr().GetPattern(), c.exprStr)
+	}
+
+	expr, err := parseExpr(schema, `title like "abc" && title != "like"`)
+	assert.Nil(t, err)
+	assert.Equal(t, "abc", expr.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetValue().GetStringVal())
+	assert.Equal(t, "like", expr.GetBinaryExpr().GetRight().GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	expr, err = parseExpr(schema, `not (title like "abc%")`)
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.MatchExpr_PrefixMatch, expr.GetUnaryExpr().GetChild().GetMatchExpr().GetOp())
+
+	expr, err = parseExpr(schema, `title == "contains" && title like 'a like b%'`)
+	assert.Nil(t, err)
+	assert.Equal(t, "contains", expr.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetValue().GetStringVal())
+	assert.Equal(t, "a like b", expr.GetBinaryExpr().GetRight().GetMatchExpr().GetPattern())
+
+	invalidExprs := []string{
+		`title like "a%c"`,
+		`title like "a_c"`,
+		`title like 1`,
+		`age like "1%"`,
+		`"abc%" like title`,
+		`title contains "abc%"`,
+		`title like "abc%" || title contains "a"`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+
+	// a field named like can still be referenced
+	fields = append(fields, &schemapb.FieldSchema{FieldID: 103, Name: "like", DataType: schemapb.DataType_VarChar})
+	schema, err = typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields})
+	assert.Nil(t, err)
+	expr, err = parseExpr(schema, `like like "abc%"`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(103), expr.GetMatchExpr().GetColumnInfo().GetFieldId())
+	expr, err = parseExpr(schema, `like == "abc"`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(103), expr.GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+}
+
+func TestExprJSON_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "meta", DataType: schemapb.DataType_JSON},
+		{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int64},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields})
+	assert.Nil(t, err)
+
+	expr, err := parseExpr(schema, `meta["color"] == "red"`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), expr.GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"color"}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, "red", expr.GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	expr, err = parseExpr(schema, `meta["price"] > 10`)
+	assert.Nil(t, err)
+	assert.Equal(t, planpb.OpType_GreaterThan, expr.GetUnaryRangeExpr().GetOp())
+	assert.Equal(t, int64(10), expr.GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	expr, err = parseExpr(schema, `meta["size"]["width"] <= 1.5`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"size", "width"}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, 1.5, expr.GetUnaryRangeExpr().GetValue().GetFloatVal())
+
+	expr, err = parseExpr(schema, `meta["sold"] == true`)
+	assert.Nil(t, err)
+	assert.True(t, expr.GetUnaryRangeExpr().GetValue().GetBoolVal())
+
+	expr, err = parseExpr(schema, `1 < meta["price"] <= 2.5`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"price"}, expr.GetBinaryRangeExpr().GetColumnInfo().GetNestedPath())
+
+	// the bounds of different paths or kinds are not combined into a range
+	expr, err = parseExpr(schema, `"a" < meta["price"] < 2`)
+	assert.Nil(t, err)
+	assert.NotNil(t, expr.GetBinaryExpr())
+
+	expr, err = parseExpr(schema, `meta["color"] in ["red", "blue"]`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"color"}, expr.GetTermExpr().GetColumnInfo().GetNestedPath())
+	assert.Len(t, expr.GetTermExpr().GetValues(), 2)
+
+	expr, err = parseExpr(schema, `meta["price"] in [1, 2.5]`)
+	assert.Nil(t, err)
+	assert.Len(t, expr.GetTermExpr().GetValues(), 2)
+
+	expr, err = parseExpr(schema, `meta["color"] like "re%"`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"color"}, expr.GetMatchExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, planpb.MatchExpr_PrefixMatch, expr.GetMatchExpr().GetOp())
+
+	invalidExprs := []string{
+		`meta == "red"`,
+		`meta["color"] == age`,
+		`age["color"] == 1`,
+		`meta[1] == 1`,
+		`meta["color"] in ["red", 1]`,
+	}
+	for _, exprStr := range invalidExprs {
+		_, err = parseExpr(schema, exprStr)
+		assert.Error(t, err, exprStr)
+	}
+}
+
+func TestExprDynamicField_Str(t *testing.T) {
+	fields := []*schemapb.FieldSchema{
+		{FieldID: 100, Name: "fakevec", DataType: schemapb.DataType_FloatVector},
+		{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+		{FieldID: 102, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+	}
+	schema, err := typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields, EnableDynamicField: true})
+	assert.Nil(t, err)
+
+	expr, err := parseExpr(schema, `color == "red"`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(102), expr.GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Equal(t, []string{"color"}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+
+	expr, err = parseExpr(schema, `size["width"] > 1`)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"size", "width"}, expr.GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+
+	expr, err = parseExpr(schema, `age > 1 && color in ["red", "blue"]`)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(101), expr.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetFieldId())
+	assert.Empty(t, expr.GetBinaryExpr().GetLeft().GetUnaryRangeExpr().GetColumnInfo().GetNestedPath())
+	assert.Equal(t, []string{"color"}, expr.GetBinaryExpr().GetRight().GetTermExpr().GetColumnInfo().GetNestedPath())
+
+	_, err = parseExpr(schema, `age["color"] == 1`)
+	assert.Error(t, err)
+
+	// the undeclared names are not found without the dynamic field
+	schema, err = typeutil.CreateSchemaHelper(&schemapb.CollectionSchema{Name: "default-collection", Fields: fields[:2]})
+	assert.Nil(t, err)
+	_, err = parseExpr(schema, `color == "red"`)
+	assert.Error(t, err)
+}
+
 func TestPlanParseAPIs(t *testing.T) {
 	t.Run("get compare op type", func(t *testing.T) {
 		var op planpb.OpType
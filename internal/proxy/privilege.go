@@ -0,0 +1,202 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+)
+
+// privilegeInfo describes the privilege required by a request
+type privilegeInfo struct {
+	objectType string
+	privilege  string
+}
+
+// msgTypePrivileges maps the type of request to the privilege which is checked before the request is executed,
+// requests whose type is not listed here are allowed for all authenticated users
+var msgTypePrivileges = map[commonpb.MsgType]privilegeInfo{
+	commonpb.MsgType_CreateCollection:        {util.ObjectTypeGlobal, "CreateCollection"},
+	commonpb.MsgType_DropCollection:          {util.ObjectTypeCollection, "DropCollection"},
+	commonpb.MsgType_HasCollection:           {util.ObjectTypeCollection, "DescribeCollection"},
+	commonpb.MsgType_DescribeCollection:      {util.ObjectTypeCollection, "DescribeCollection"},
+	commonpb.MsgType_ShowCollections:         {util.ObjectTypeGlobal, "ShowCollections"},
+	commonpb.MsgType_RenameCollection:        {util.ObjectTypeGlobal, "RenameCollection"},
+	commonpb.MsgType_AlterCollection:         {util.ObjectTypeCollection, "AlterCollection"},
+	commonpb.MsgType_AddField:                {util.ObjectTypeCollection, "AlterCollection"},
+	commonpb.MsgType_GetCollectionStatistics: {util.ObjectTypeCollection, "GetStatistics"},
+	commonpb.MsgType_GetPartitionStatistics:  {util.ObjectTypeCollection, "GetStatistics"},
+	commonpb.MsgType_CreatePartition:         {util.ObjectTypeCollection, "CreatePartition"},
+	commonpb.MsgType_DropPartition:           {util.ObjectTypeCollection, "DropPartition"},
+	commonpb.MsgType_HasPartition:            {util.ObjectTypeCollection, "ShowPartitions"},
+	commonpb.MsgType_ShowPartitions:          {util.ObjectTypeCollection, "ShowPartitions"},
+	commonpb.MsgType_LoadCollection:          {util.ObjectTypeCollection, "Load"},
+	commonpb.MsgType_LoadPartitions:          {util.ObjectTypeCollection, "Load"},
+	commonpb.MsgType_ReleaseCollection:       {util.ObjectTypeCollection, "Release"},
+	commonpb.MsgType_ReleasePartitions:       {util.ObjectTypeCollection, "Release"},
+	commonpb.MsgType_CreateIndex:             {util.ObjectTypeCollection, "CreateIndex"},
+	commonpb.MsgType_DropIndex:               {util.ObjectTypeCollection, "DropIndex"},
+	commonpb.MsgType_DescribeIndex:           {util.ObjectTypeCollection, "IndexDetail"},
+	commonpb.MsgType_GetIndexState:           {util.ObjectTypeCollection, "IndexDetail"},
+	commonpb.MsgType_GetIndexBuildProgress:   {util.ObjectTypeCollection, "IndexDetail"},
+	commonpb.MsgType_Insert:                  {util.ObjectTypeCollection, "Insert"},
+	commonpb.MsgType_Delete:                  {util.ObjectTypeCollection, "Delete"},
+	commonpb.MsgType_Upsert:                  {util.ObjectTypeCollection, "Upsert"},
+	commonpb.MsgType_Search:                  {util.ObjectTypeCollection, "Search"},
+	commonpb.MsgType_Retrieve:                {util.ObjectTypeCollection, "Query"},
+	commonpb.MsgType_Flush:                   {util.ObjectTypeGlobal, "Flush"},
+	commonpb.MsgType_CreateAlias:             {util.ObjectTypeGlobal, "CreateAlias"},
+	commonpb.MsgType_DropAlias:               {util.ObjectTypeGlobal, "DropAlias"},
+	commonpb.MsgType_AlterAlias:              {util.ObjectTypeGlobal, "AlterAlias"},
+	commonpb.MsgType_CreateDatabase:          {util.ObjectTypeGlobal, "CreateDatabase"},
+	commonpb.MsgType_DropDatabase:            {util.ObjectTypeGlobal, "DropDatabase"},
+	commonpb.MsgType_ListDatabases:           {util.ObjectTypeGlobal, "ListDatabases"},
+	commonpb.MsgType_CreateCredential:        {util.ObjectTypeGlobal, "CreateOwnership"},
+	commonpb.MsgType_DeleteCredential:        {util.ObjectTypeGlobal, "DropOwnership"},
+	commonpb.MsgType_ListUsers:               {util.ObjectTypeGlobal, "SelectOwnership"},
+	commonpb.MsgType_CreateRole:              {util.ObjectTypeGlobal, "CreateOwnership"},
+	commonpb.MsgType_DropRole:                {util.ObjectTypeGlobal, "DropOwnership"},
+	commonpb.MsgType_OperateUserRole:         {util.ObjectTypeGlobal, "ManageOwnership"},
+	commonpb.MsgType_GrantPrivilege:          {util.ObjectTypeGlobal, "ManageOwnership"},
+	commonpb.MsgType_RevokePrivilege:         {util.ObjectTypeGlobal, "ManageOwnership"},
+	commonpb.MsgType_ListGrants:              {util.ObjectTypeGlobal, "SelectOwnership"},
+}
+
+// validateObjectType checks whether the object type is one of the supported object types
+func validateObjectType(objectType string) error {
+	if objectType != util.ObjectTypeCollection && objectType != util.ObjectTypeGlobal {
+		return fmt.Errorf("invalid object type %s, it should be %s or %s", objectType, util.ObjectTypeCollection, util.ObjectTypeGlobal)
+	}
+	return nil
+}
+
+// validatePrivilege checks whether the privilege can be granted on the object type
+func validatePrivilege(objectType string, privilege string) error {
+	if privilege == util.PrivilegeAll {
+		return nil
+	}
+	for _, info := range msgTypePrivileges {
+		if info.objectType == objectType && info.privilege == privilege {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid privilege %s for object type %s", privilege, objectType)
+}
+
+// validateGrantEntity checks the role, object and privilege of the grant
+func validateGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return errors.New("the grant entity is nil")
+	}
+	if err := validateRoleName(entity.RoleName); err != nil {
+		return err
+	}
+	if err := validateObjectType(entity.ObjectType); err != nil {
+		return err
+	}
+	if entity.ObjectType == util.ObjectTypeGlobal {
+		if entity.ObjectName != util.AnyWord {
+			return fmt.Errorf("the object name of %s privileges must be %s", util.ObjectTypeGlobal, util.AnyWord)
+		}
+	} else if entity.ObjectName != util.AnyWord {
+		if err := validateCollectionName(entity.ObjectName); err != nil {
+			return err
+		}
+	}
+	return validatePrivilege(entity.ObjectType, entity.Privilege)
+}
+
+// checkPrivilege checks whether the user carried by the context has the privilege required by the request on the collection
+// of the database, the check is skipped if authorization is disabled, and the root user is allowed to do everything
+func checkPrivilege(ctx context.Context, msgType commonpb.MsgType, dbName string, collectionName string) error {
+	if !Params.CommonCfg.AuthorizationEnabled {
+		return nil
+	}
+	info, ok := msgTypePrivileges[msgType]
+	if !ok {
+		return nil
+	}
+	username, err := getCurUserFromContext(ctx)
+	if err != nil {
+		return fmt.Errorf("%w: %s", errPermissionDenied, err.Error())
+	}
+	if username == util.UserRoot {
+		return nil
+	}
+	objectName := util.AnyWord
+	if info.objectType == util.ObjectTypeCollection {
+		objectName = collectionName
+	}
+	grants, err := globalMetaCache.GetUserGrants(ctx, username)
+	if err != nil {
+		log.Warn("failed to get user grants", zap.String("username", username), zap.Error(err))
+		return fmt.Errorf("%w: %s", errPermissionDenied, err.Error())
+	}
+	for _, grant := range grants {
+		if grant.GetObjectType() != info.objectType {
+			continue
+		}
+		if info.objectType == util.ObjectTypeCollection && getDatabaseName(grant.GetDbName()) != getDatabaseName(dbName) {
+			continue
+		}
+		if grant.GetObjectName() != util.AnyWord && grant.GetObjectName() != objectName &&
+			!isSameCollection(ctx, dbName, grant.GetObjectName(), objectName) {
+			continue
+		}
+		if grant.GetPrivilege() == util.PrivilegeAll || grant.GetPrivilege() == info.privilege {
+			return nil
+		}
+	}
+	log.Debug("privilege check failed", zap.String("username", username), zap.String("objectType", info.objectType),
+		zap.String("objectName", objectName), zap.String("privilege", info.privilege))
+	return fmt.Errorf("%w: user %s has no privilege %s on %s %s of database %s", errPermissionDenied, username, info.privilege, info.objectType, objectName, getDatabaseName(dbName))
+}
+
+// isSameCollection checks whether the granted collection and the requested one, which may be an alias, resolve to
+// the same collection id, since rootcoord keys the grants by collection id
+func isSameCollection(ctx context.Context, dbName string, grantedName string, collectionName string) bool {
+	collID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
+	if err != nil {
+		return false
+	}
+	grantedID, err := globalMetaCache.GetCollectionID(ctx, dbName, grantedName)
+	if err != nil {
+		return false
+	}
+	return grantedID == collID
+}
+
+// checkTaskPrivilege checks the privilege of the task before it is enqueued,
+// the database and collection names are taken from the request carried by the task if there is one
+func checkTaskPrivilege(t task) error {
+	dbName, collectionName := "", ""
+	if req, ok := t.(interface{ GetDbName() string }); ok {
+		dbName = req.GetDbName()
+	}
+	if req, ok := t.(interface{ GetCollectionName() string }); ok {
+		collectionName = req.GetCollectionName()
+	}
+	return checkPrivilege(t.TraceCtx(), t.Type(), dbName, collectionName)
+}
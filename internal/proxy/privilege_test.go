@@ -0,0 +1,160 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+)
+
+func getContextWithAuthorization(ctx context.Context, username string, password string) context.Context {
+	md := metadata.Pairs(util.HeaderAuthorize, crypto.Base64Encode(username+util.CredentialSeparator+password))
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+func TestCheckPrivilege(t *testing.T) {
+	ctx := context.Background()
+	client := &MockRootCoordClientInterface{}
+	err := InitMetaCache(client)
+	assert.Nil(t, err)
+
+	Params.Init()
+	authEnabled := Params.CommonCfg.AuthorizationEnabled
+	defer func() {
+		Params.CommonCfg.AuthorizationEnabled = authEnabled
+	}()
+
+	t.Run("authorization disabled", func(t *testing.T) {
+		Params.CommonCfg.AuthorizationEnabled = false
+		assert.Nil(t, checkPrivilege(ctx, commonpb.MsgType_DropCollection, "", "collection1"))
+	})
+
+	Params.CommonCfg.AuthorizationEnabled = true
+
+	t.Run("no privilege required", func(t *testing.T) {
+		assert.Nil(t, checkPrivilege(ctx, commonpb.MsgType_UpdateCredential, "", ""))
+	})
+
+	t.Run("missing metadata", func(t *testing.T) {
+		err := checkPrivilege(ctx, commonpb.MsgType_Search, "", "collection1")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, errorCodeOf(err))
+	})
+
+	t.Run("root", func(t *testing.T) {
+		rootCtx := getContextWithAuthorization(ctx, util.UserRoot, util.DefaultRootPassword)
+		assert.Nil(t, checkPrivilege(rootCtx, commonpb.MsgType_DropCollection, "", "collection1"))
+		assert.Nil(t, checkPrivilege(rootCtx, commonpb.MsgType_CreateRole, "", ""))
+	})
+
+	t.Run("granted user", func(t *testing.T) {
+		userCtx := getContextWithAuthorization(ctx, "mockUser", "123456")
+		assert.Nil(t, checkPrivilege(userCtx, commonpb.MsgType_Search, "", "collection1"))
+
+		err := checkPrivilege(userCtx, commonpb.MsgType_Search, "", "collection2")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+		err = checkPrivilege(userCtx, commonpb.MsgType_Insert, "", "collection1")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+		err = checkPrivilege(userCtx, commonpb.MsgType_CreateRole, "", "")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+
+		// the collection of the same name in another database is not granted
+		err = checkPrivilege(userCtx, commonpb.MsgType_Search, "db1", "collection1")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+		assert.Nil(t, checkPrivilege(userCtx, commonpb.MsgType_Search, Params.CommonCfg.DefaultDatabaseName, "collection1"))
+
+		// the alias is resolved to the granted collection
+		assert.Nil(t, checkPrivilege(userCtx, commonpb.MsgType_Search, "", "collection1Alias"))
+		err = checkPrivilege(userCtx, commonpb.MsgType_Insert, "", "collection1Alias")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+	})
+
+	t.Run("fail to get grants", func(t *testing.T) {
+		userCtx := getContextWithAuthorization(ctx, "notExistUser", "123456")
+		err := checkPrivilege(userCtx, commonpb.MsgType_Search, "", "collection1")
+		assert.True(t, errors.Is(err, errPermissionDenied))
+	})
+}
+
+func TestValidateGrantEntity(t *testing.T) {
+	Params.Init()
+
+	assert.NotNil(t, validateGrantEntity(nil))
+
+	assert.Nil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "collection1",
+		Privilege:  "Search",
+	}))
+	assert.Nil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: util.AnyWord,
+		Privilege:  util.PrivilegeAll,
+	}))
+	assert.Nil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeGlobal,
+		ObjectName: util.AnyWord,
+		Privilege:  "CreateCollection",
+	}))
+
+	// invalid role name
+	assert.NotNil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "1role",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "collection1",
+		Privilege:  "Search",
+	}))
+	// invalid object type
+	assert.NotNil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: "Partition",
+		ObjectName: "collection1",
+		Privilege:  "Search",
+	}))
+	// global privileges must be granted on all objects
+	assert.NotNil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeGlobal,
+		ObjectName: "collection1",
+		Privilege:  "CreateCollection",
+	}))
+	// privilege does not match the object type
+	assert.NotNil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "collection1",
+		Privilege:  "CreateCollection",
+	}))
+	assert.NotNil(t, validateGrantEntity(&milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "collection1",
+		Privilege:  "NotExistPrivilege",
+	}))
+}
@@ -77,6 +77,8 @@ type Proxy struct {
 	chMgr channelsMgr
 
 	sched *taskScheduler
+	// rateLimiter is nil if neither the rate limit nor the quota is enabled
+	rateLimiter *rateLimiter
 
 	chTicker channelsTimeTicker
 
@@ -237,9 +239,15 @@ func (node *Proxy) Init() error {
 	log.Debug("create channels manager done", zap.String("role", typeutil.ProxyRole))
 
 	log.Debug("create task scheduler", zap.String("role", typeutil.ProxyRole))
-	node.sched, err = newTaskScheduler(node.ctx, node.idAllocator, node.tsoAllocator, node.msFactory,
+	opts := []schedOpt{
 		schedOptWithSearchResultCh(node.searchResultCh),
-		schedOptWithRetrieveResultCh(node.retrieveResultCh))
+		schedOptWithRetrieveResultCh(node.retrieveResultCh),
+	}
+	if Params.ProxyCfg.RateLimitEnabled || Params.QuotaCfg.Enabled {
+		node.rateLimiter = newRateLimiter()
+		opts = append(opts, schedOptWithRateLimiter(node.rateLimiter))
+	}
+	node.sched, err = newTaskScheduler(node.ctx, node.idAllocator, node.tsoAllocator, node.msFactory, opts...)
 	if err != nil {
 		log.Warn("failed to create task scheduler", zap.Error(err), zap.String("role", typeutil.ProxyRole))
 		return err
@@ -68,6 +68,8 @@ import (
 
 	grpcdatacoordclient2 "github.com/milvus-io/milvus/internal/distributed/datacoord/client"
 
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 
@@ -870,6 +872,295 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("rename collection", func(t *testing.T) {
+		defer wg.Done()
+		renamedCollection := collectionName + "_renamed"
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			OldName: collectionName,
+			NewName: renamedCollection,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// same name -> fail
+		resp, err = proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			OldName: renamedCollection,
+			NewName: renamedCollection,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			OldName: renamedCollection,
+			NewName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("alter collection", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: "foo", Value: "bar"}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		descResp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			CollectionName: collectionName,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, descResp.Status.ErrorCode)
+		assert.Contains(t, descResp.Properties, &commonpb.KeyValuePair{Key: "foo", Value: "bar"})
+
+		// invalid ttl -> fail
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "invalid"}},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// remove the property for the following cases
+		resp, err = proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: "foo", Value: ""}},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("add field", func(t *testing.T) {
+		defer wg.Done()
+		// the collection is shared by the other cases, so only the failures are checked here
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{
+			CollectionName: collectionName,
+			Field:          &schemapb.FieldSchema{Name: "no_default", DataType: schemapb.DataType_Int64},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.AddField(ctx, &milvuspb.AddFieldRequest{
+			CollectionName: "not_exist_" + funcutil.GenRandomStr(),
+			Field: &schemapb.FieldSchema{
+				Name:         "added",
+				DataType:     schemapb.DataType_Int64,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("database", func(t *testing.T) {
+		defer wg.Done()
+		testDbName := "db_" + funcutil.GenRandomStr()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: testDbName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// recreate -> fail
+		resp, err = proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: testDbName})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		listResp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Contains(t, listResp.DbNames, testDbName)
+		assert.Contains(t, listResp.DbNames, Params.CommonCfg.DefaultDatabaseName)
+
+		resp, err = proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{DbName: testDbName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		listResp, err = proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotContains(t, listResp.DbNames, testDbName)
+
+		// invalid name -> fail
+		resp, err = proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{DbName: "$db"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("credential", func(t *testing.T) {
+		defer wg.Done()
+		username := "user_" + funcutil.GenRandomStr()
+		resp, err := proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Username: username,
+			Password: "123456",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// recreate -> fail
+		resp, err = proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Username: username,
+			Password: "123456",
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// invalid password -> fail
+		resp, err = proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Username: "another_" + username,
+			Password: "123",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+
+		listResp, err := proxy.ListUsers(ctx, &milvuspb.ListUsersRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Contains(t, listResp.Usernames, username)
+		assert.Contains(t, listResp.Usernames, util.UserRoot)
+
+		// wrong old password -> fail
+		resp, err = proxy.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+			Username:    username,
+			OldPassword: "654321",
+			NewPassword: "1234567",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_PermissionDenied, resp.ErrorCode)
+
+		resp, err = proxy.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{
+			Username:    username,
+			OldPassword: "123456",
+			NewPassword: "1234567",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		authorization := []string{crypto.Base64Encode(username + util.CredentialSeparator + "1234567")}
+		assert.True(t, validAuth(ctx, authorization))
+
+		resp, err = proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: username})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+		assert.False(t, validAuth(ctx, authorization))
+
+		// root user can not be deleted
+		resp, err = proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: util.UserRoot})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		_, err = proxy.InvalidateCredentialCache(ctx, &proxypb.InvalidateCredCacheRequest{Username: util.UserRoot})
+		assert.NoError(t, err)
+	})
+
+	wg.Add(1)
+	t.Run("rbac", func(t *testing.T) {
+		defer wg.Done()
+		username := "user_" + funcutil.GenRandomStr()
+		roleName := "role_" + funcutil.GenRandomStr()
+		resp, err := proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{
+			Username: username,
+			Password: "123456",
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// invalid role name -> fail
+		resp, err = proxy.CreateRole(ctx, &milvuspb.CreateRoleRequest{RoleName: "1" + roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+
+		resp, err = proxy.CreateRole(ctx, &milvuspb.CreateRoleRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: username,
+			RoleName: roleName,
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			ObjectType: util.ObjectTypeCollection,
+			ObjectName: collectionName,
+			Privilege:  "Search",
+		}
+		resp, err = proxy.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{Entity: entity})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		// invalid privilege -> fail
+		resp, err = proxy.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+			Entity: &milvuspb.GrantEntity{
+				RoleName:   roleName,
+				ObjectType: util.ObjectTypeCollection,
+				ObjectName: collectionName,
+				Privilege:  "NotExistPrivilege",
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.ErrorCode)
+
+		listResp, err := proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Equal(t, 1, len(listResp.Entities))
+
+		listResp, err = proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{Username: username})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Equal(t, 1, len(listResp.Entities))
+
+		// neither role name nor username -> fail
+		listResp, err = proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_IllegalArgument, listResp.Status.ErrorCode)
+
+		resp, err = proxy.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{Entity: entity})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		listResp, err = proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, listResp.Status.ErrorCode)
+		assert.Equal(t, 0, len(listResp.Entities))
+
+		resp, err = proxy.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Username: username,
+			RoleName: roleName,
+			Type:     milvuspb.OperateUserRoleType_RemoveUserFromRole,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DropRole(ctx, &milvuspb.DropRoleRequest{RoleName: roleName})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		resp, err = proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{Username: username})
+		assert.NoError(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+
+		_, err = proxy.RefreshPolicyInfoCache(ctx, &proxypb.RefreshPolicyInfoCacheRequest{})
+		assert.NoError(t, err)
+	})
+
+	wg.Add(1)
+	t.Run("set dml quota", func(t *testing.T) {
+		defer wg.Done()
+		// neither the rate limit nor the quota is enabled
+		assert.Nil(t, proxy.rateLimiter)
+		resp, err := proxy.SetDMLQuota(ctx, &proxypb.SetDMLQuotaRequest{Factor: 0, Reason: "test"})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("drop alias", func(t *testing.T) {
 		defer wg.Done()
@@ -931,7 +1222,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
@@ -1100,7 +1391,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1142,6 +1433,24 @@ func TestProxy(t *testing.T) {
 		assert.Equal(t, int64(rowNum), resp.InsertCnt)
 	})
 
+	wg.Add(1)
+	t.Run("upsert fail, autoID primary field", func(t *testing.T) {
+		defer wg.Done()
+		req := constructInsertRequest()
+
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			FieldsData:     req.FieldsData,
+			HashKeys:       req.HashKeys,
+			NumRows:        req.NumRows,
+		})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+		assert.Equal(t, rowNum, len(resp.ErrIndex))
+	})
+
 	// TODO(dragondriver): proxy.Delete()
 
 	flushed := true // fortunately, no task depends on this state, maybe CreateIndex?
@@ -1442,6 +1751,22 @@ func TestProxy(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Equal(t, commonpb.ErrorCode_EmptyCollection, res.Status.ErrorCode)
 		})
+
+		wg.Add(1)
+		t.Run("delete dry run", func(t *testing.T) {
+			defer wg.Done()
+			resp, err := proxy.Delete(ctx, &milvuspb.DeleteRequest{
+				Base:           nil,
+				DbName:         dbName,
+				CollectionName: collectionName,
+				Expr:           expr,
+				DryRun:         true,
+			})
+			assert.NoError(t, err)
+			assert.Equal(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+			// dry run only counts the entities
+			assert.Nil(t, resp.IDs)
+		})
 	}
 
 	wg.Add(1)
@@ -1576,7 +1901,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("release collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ReleaseCollection(ctx, &milvuspb.ReleaseCollectionRequest{
@@ -1648,7 +1973,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1705,7 +2030,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show in-memory partitions after release partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1801,7 +2126,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partitions after drop partition", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
@@ -1848,7 +2173,7 @@ func TestProxy(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop collection", func(t *testing.T) {
 		defer wg.Done()
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 		assert.NoError(t, err)
 
 		resp, err := proxy.DropCollection(ctx, &milvuspb.DropCollectionRequest{
@@ -1918,6 +2243,14 @@ func TestProxy(t *testing.T) {
 
 	proxy.UpdateStateCode(internalpb.StateCode_Abnormal)
 
+	wg.Add(1)
+	t.Run("SetDMLQuota fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.SetDMLQuota(ctx, &proxypb.SetDMLQuotaRequest{Factor: 1})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("ReleaseDQLMessageStream fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2102,6 +2435,14 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("Upsert fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.Upsert(ctx, &milvuspb.UpsertRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("Search fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2150,6 +2491,134 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("RenameCollection fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AlterCollection fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("AddField fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.AddField(ctx, &milvuspb.AddFieldRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateCredential fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateCredential(ctx, &milvuspb.CreateCredentialRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("UpdateCredential fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.UpdateCredential(ctx, &milvuspb.UpdateCredentialRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DeleteCredential fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListUsers fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListUsers(ctx, &milvuspb.ListUsersRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateRole fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateRole(ctx, &milvuspb.CreateRoleRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropRole fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropRole(ctx, &milvuspb.DropRoleRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("OperateUserRole fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("GrantPrivilege fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("RevokePrivilege fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListGrants fail, unhealthy", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListGrants(ctx, &milvuspb.ListGrantsRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	wg.Add(1)
 	t.Run("GetPersistentSegmentInfo fail, unhealthy", func(t *testing.T) {
 		defer wg.Done()
@@ -2389,6 +2858,38 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("RenameCollection fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, dd queue full", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	proxy.sched.ddQueue.setMaxTaskNum(ddParallel)
 
 	dmParallelism := proxy.sched.dmQueue.getMaxTaskNum()
@@ -2665,6 +3166,38 @@ func TestProxy(t *testing.T) {
 		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("RenameCollection fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.RenameCollection(shortCtx, &milvuspb.RenameCollectionRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("CreateDatabase fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.CreateDatabase(shortCtx, &milvuspb.CreateDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("DropDatabase fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.DropDatabase(shortCtx, &milvuspb.DropDatabaseRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("ListDatabases fail, timeout", func(t *testing.T) {
+		defer wg.Done()
+		resp, err := proxy.ListDatabases(shortCtx, &milvuspb.ListDatabasesRequest{})
+		assert.NoError(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, resp.Status.ErrorCode)
+	})
+
 	testServer.gracefulStop()
 
 	wg.Wait()
@@ -2749,6 +3282,67 @@ func Test_GetFlushState(t *testing.T) {
 	})
 }
 
+func Test_Import(t *testing.T) {
+	t.Run("normal test", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.Import(context.TODO(), &milvuspb.ImportRequest{
+			CollectionName: "test",
+			RowBased:       true,
+			Files:          []string{"a.json"},
+		})
+		assert.EqualValues(t, &milvuspb.ImportResponse{}, resp)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test import with invalid request", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		requests := []*milvuspb.ImportRequest{
+			{CollectionName: "", RowBased: true, Files: []string{"a.json"}},
+			{CollectionName: "test", PartitionName: "$invalid", RowBased: true, Files: []string{"a.json"}},
+			{CollectionName: "test", RowBased: true},
+			{CollectionName: "test", RowBased: false, Files: []string{"a.json"}},
+		}
+		for _, req := range requests {
+			resp, err := proxy.Import(context.TODO(), req)
+			assert.Nil(t, err)
+			assert.Equal(t, commonpb.ErrorCode_IllegalArgument, resp.GetStatus().GetErrorCode())
+		}
+	})
+
+	t.Run("test import with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.Import(context.TODO(), &milvuspb.ImportRequest{})
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
+func Test_GetImportState(t *testing.T) {
+	t.Run("normal test", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Healthy)
+		resp, err := proxy.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: 1})
+		assert.EqualValues(t, &milvuspb.GetImportStateResponse{}, resp)
+		assert.Nil(t, err)
+	})
+
+	t.Run("test get import state with unhealthy proxy", func(t *testing.T) {
+		datacoord := &DataCoordMock{}
+		proxy := &Proxy{dataCoord: datacoord}
+		proxy.stateCode.Store(internalpb.StateCode_Abnormal)
+		resp, err := proxy.GetImportState(context.TODO(), &milvuspb.GetImportStateRequest{TaskID: 1})
+		assert.EqualValues(t, unhealthyStatus(), resp.Status)
+		assert.Nil(t, err)
+	})
+}
+
 func TestProxy_GetComponentStates(t *testing.T) {
 	n := &Proxy{}
 	n.stateCode.Store(internalpb.StateCode_Healthy)
@@ -0,0 +1,353 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+// rateType is the kind of resource whose consumption rate is limited
+type rateType int32
+
+const (
+	ddlRate rateType = iota
+	insertRowRate
+	insertByteRate
+	searchRate
+	queryRate
+)
+
+func (rt rateType) String() string {
+	switch rt {
+	case ddlRate:
+		return "ddl"
+	case insertRowRate:
+		return "insert rows"
+	case insertByteRate:
+		return "insert bytes"
+	case searchRate:
+		return "search"
+	case queryRate:
+		return "query"
+	default:
+		return fmt.Sprintf("rateType(%d)", int32(rt))
+	}
+}
+
+// ddlMsgTypes are the types of data definition requests which are limited by ddlRate
+var ddlMsgTypes = map[commonpb.MsgType]struct{}{
+	commonpb.MsgType_CreateCollection:  {},
+	commonpb.MsgType_DropCollection:    {},
+	commonpb.MsgType_RenameCollection:  {},
+	commonpb.MsgType_AlterCollection:   {},
+	commonpb.MsgType_AddField:          {},
+	commonpb.MsgType_CreatePartition:   {},
+	commonpb.MsgType_DropPartition:     {},
+	commonpb.MsgType_LoadCollection:    {},
+	commonpb.MsgType_ReleaseCollection: {},
+	commonpb.MsgType_LoadPartitions:    {},
+	commonpb.MsgType_ReleasePartitions: {},
+	commonpb.MsgType_CreateIndex:       {},
+	commonpb.MsgType_DropIndex:         {},
+	commonpb.MsgType_CreateAlias:       {},
+	commonpb.MsgType_DropAlias:         {},
+	commonpb.MsgType_AlterAlias:        {},
+	commonpb.MsgType_CreateDatabase:    {},
+	commonpb.MsgType_DropDatabase:      {},
+	commonpb.MsgType_Flush:             {},
+}
+
+// dmlMsgTypes are the types of requests which are rejected when RootCoord applies the backpressure of DML
+var dmlMsgTypes = map[commonpb.MsgType]struct{}{
+	commonpb.MsgType_Insert: {},
+	commonpb.MsgType_Upsert: {},
+	commonpb.MsgType_Delete: {},
+}
+
+// dmlQuotaTTLIntervals is the number of quota collect intervals after which the backpressure of DML is released
+// if RootCoord doesn't update it any more, e.g. RootCoord is down or the quota is disabled
+const dmlQuotaTTLIntervals = 3
+
+// rateLimiter limits the rates of the requests before they are enqueued into the task scheduler,
+// the requests are limited by the rates of the whole proxy and then by the rates of the collection
+type rateLimiter struct {
+	globalRates     map[rateType]float64
+	globalLimiters  map[rateType]*ratelimitutil.Limiter
+	collectionRates map[rateType]float64
+
+	mu                 sync.Mutex
+	collectionLimiters map[string]map[rateType]*ratelimitutil.Limiter
+
+	// dmlFactor is set by RootCoord to slow down or reject the DML requests when the cluster is overloaded
+	dmlMu        sync.RWMutex
+	dmlFactor    float64
+	dmlReason    string
+	dmlUpdatedAt time.Time
+	// dmlBaseRates are the insert rates observed when the backpressure starts, which are slowed down by the factor
+	// instead of the configured rates if the insert rates are not limited by config
+	dmlBaseRates map[rateType]float64
+	// insertCounts are the rows and bytes inserted since observedSince, which are used to observe the insert rates
+	insertCounts  map[rateType]float64
+	observedSince time.Time
+}
+
+// newLimiter returns a limiter which permits bursts of the tokens generated in one second,
+// a non-positive rate means no limit
+func newLimiter(rate float64) *ratelimitutil.Limiter {
+	if rate <= 0 {
+		return ratelimitutil.NewLimiter(ratelimitutil.Inf, 0)
+	}
+	return ratelimitutil.NewLimiter(ratelimitutil.Limit(rate), rate)
+}
+
+// newRateLimiter creates the rateLimiter with the rates in the proxy config,
+// all the rates are unlimited if the rate limit is disabled
+func newRateLimiter() *rateLimiter {
+	globalRates := map[rateType]float64{
+		ddlRate:        -1,
+		insertRowRate:  -1,
+		insertByteRate: -1,
+		searchRate:     -1,
+		queryRate:      -1,
+	}
+	collectionRates := map[rateType]float64{
+		insertRowRate:  -1,
+		insertByteRate: -1,
+		searchRate:     -1,
+		queryRate:      -1,
+	}
+	if Params.ProxyCfg.RateLimitEnabled {
+		globalRates[ddlRate] = Params.ProxyCfg.DDLRate
+		globalRates[insertRowRate] = Params.ProxyCfg.InsertRowRate
+		globalRates[insertByteRate] = Params.ProxyCfg.InsertByteRate
+		globalRates[searchRate] = Params.ProxyCfg.SearchRate
+		globalRates[queryRate] = Params.ProxyCfg.QueryRate
+		collectionRates[insertRowRate] = Params.ProxyCfg.CollectionInsertRowRate
+		collectionRates[insertByteRate] = Params.ProxyCfg.CollectionInsertByteRate
+		collectionRates[searchRate] = Params.ProxyCfg.CollectionSearchRate
+		collectionRates[queryRate] = Params.ProxyCfg.CollectionQueryRate
+	}
+	globalLimiters := make(map[rateType]*ratelimitutil.Limiter, len(globalRates))
+	for rt, rate := range globalRates {
+		globalLimiters[rt] = newLimiter(rate)
+	}
+	return &rateLimiter{
+		globalRates:        globalRates,
+		globalLimiters:     globalLimiters,
+		collectionRates:    collectionRates,
+		collectionLimiters: make(map[string]map[rateType]*ratelimitutil.Limiter),
+		dmlFactor:          1,
+		dmlUpdatedAt:       time.Now(),
+		dmlBaseRates:       make(map[rateType]float64),
+		insertCounts:       make(map[rateType]float64),
+		observedSince:      time.Now(),
+	}
+}
+
+// setDMLQuota applies the backpressure factor to the insert rates of proxy,
+// the DML requests are rejected if the factor is not positive.
+// The configured insert rates are slowed down, or the insert rates observed when the backpressure starts
+// if the insert rates are not limited by config.
+func (rl *rateLimiter) setDMLQuota(factor float64, reason string) {
+	if factor > 1 {
+		factor = 1
+	}
+	rl.dmlMu.Lock()
+	defer rl.dmlMu.Unlock()
+	rl.applyDMLQuota(time.Now(), factor, reason)
+}
+
+// applyDMLQuota applies the backpressure factor, caller should hold dmlMu
+func (rl *rateLimiter) applyDMLQuota(now time.Time, factor float64, reason string) {
+	observed := rl.observeInsertRates(now)
+	rl.dmlFactor = factor
+	rl.dmlReason = reason
+	rl.dmlUpdatedAt = now
+	for _, rt := range []rateType{insertRowRate, insertByteRate} {
+		base := rl.globalRates[rt]
+		if base <= 0 {
+			if factor >= 1 {
+				// the observed rate is only the base while the backpressure lasts
+				delete(rl.dmlBaseRates, rt)
+				rl.globalLimiters[rt].SetLimit(now, ratelimitutil.Inf)
+				continue
+			}
+			if _, ok := rl.dmlBaseRates[rt]; !ok {
+				if observed[rt] <= 0 {
+					continue
+				}
+				rl.dmlBaseRates[rt] = observed[rt]
+			}
+			base = rl.dmlBaseRates[rt]
+		}
+		// the DML requests are rejected before the limiters if the factor is not positive
+		if factor > 0 {
+			rl.globalLimiters[rt].SetLimit(now, ratelimitutil.Limit(base*factor))
+		}
+	}
+}
+
+// observeInsertRates returns the insert rates since the last observation, caller should hold dmlMu
+func (rl *rateLimiter) observeInsertRates(now time.Time) map[rateType]float64 {
+	rates := make(map[rateType]float64, len(rl.insertCounts))
+	if elapsed := now.Sub(rl.observedSince).Seconds(); elapsed > 0 {
+		for rt, count := range rl.insertCounts {
+			rates[rt] = count / elapsed
+		}
+	}
+	rl.insertCounts = make(map[rateType]float64, len(rl.insertCounts))
+	rl.observedSince = now
+	return rates
+}
+
+// getDMLQuota returns the backpressure factor of DML and the reason. The backpressure is released
+// if RootCoord has not updated it for dmlQuotaTTLIntervals collect intervals.
+func (rl *rateLimiter) getDMLQuota() (float64, string) {
+	ttl := dmlQuotaTTLIntervals * Params.QuotaCfg.CollectInterval
+	rl.dmlMu.RLock()
+	factor, reason, updatedAt := rl.dmlFactor, rl.dmlReason, rl.dmlUpdatedAt
+	rl.dmlMu.RUnlock()
+	if factor >= 1 || time.Since(updatedAt) <= ttl {
+		return factor, reason
+	}
+
+	rl.dmlMu.Lock()
+	defer rl.dmlMu.Unlock()
+	// the quota may have been updated before the lock is acquired
+	now := time.Now()
+	if rl.dmlFactor < 1 && now.Sub(rl.dmlUpdatedAt) > ttl {
+		log.Warn("DML quota is not updated by RootCoord, release the backpressure", zap.Float64("factor", rl.dmlFactor),
+			zap.String("reason", rl.dmlReason), zap.Duration("ttl", ttl))
+		rl.applyDMLQuota(now, 1, "")
+	}
+	return rl.dmlFactor, rl.dmlReason
+}
+
+// getCollectionLimiters returns the limiters of the collection, they are created on first use
+func (rl *rateLimiter) getCollectionLimiters(dbName, collectionName string) map[rateType]*ratelimitutil.Limiter {
+	key := getDatabaseName(dbName) + "." + collectionName
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	limiters, ok := rl.collectionLimiters[key]
+	if !ok {
+		limiters = make(map[rateType]*ratelimitutil.Limiter, len(rl.collectionRates))
+		for rt, rate := range rl.collectionRates {
+			limiters[rt] = newLimiter(rate)
+		}
+		rl.collectionLimiters[key] = limiters
+	}
+	return limiters
+}
+
+// removeCollection removes the limiters of the collection, which is called when the collection is dropped
+func (rl *rateLimiter) removeCollection(dbName, collectionName string) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	delete(rl.collectionLimiters, getDatabaseName(dbName)+"."+collectionName)
+}
+
+// costsOf returns the tokens consumed by the task for each kind of limited rate
+func costsOf(t task) map[rateType]float64 {
+	msgType := t.Type()
+	if _, ok := ddlMsgTypes[msgType]; ok {
+		return map[rateType]float64{ddlRate: 1}
+	}
+	switch task := t.(type) {
+	case *insertTask:
+		return map[rateType]float64{
+			insertRowRate:  float64(task.req.GetNumRows()),
+			insertByteRate: float64(proto.Size(task.req)),
+		}
+	case *upsertTask:
+		return map[rateType]float64{
+			insertRowRate:  float64(task.req.GetNumRows()),
+			insertByteRate: float64(proto.Size(task.req)),
+		}
+	case *searchTask:
+		return map[rateType]float64{searchRate: 1}
+	case *queryTask:
+		// the query issued by delete doesn't consume the tokens of query
+		if task.forDelete {
+			return nil
+		}
+		return map[rateType]float64{queryRate: 1}
+	}
+	return nil
+}
+
+// check consumes the tokens of the task, an error wrapping errRateLimited is returned if any rate is exceeded
+func (rl *rateLimiter) check(t task) error {
+	if _, ok := dmlMsgTypes[t.Type()]; ok {
+		if factor, reason := rl.getDMLQuota(); factor <= 0 {
+			return fmt.Errorf("%w: the DML requests are rejected because %s", errRateLimited, reason)
+		}
+	}
+
+	costs := costsOf(t)
+	if len(costs) == 0 {
+		return nil
+	}
+	now := time.Now()
+	for rt, cost := range costs {
+		if !rl.globalLimiters[rt].AllowN(now, cost) {
+			return fmt.Errorf("%w: the %s rate exceeds the limit of proxy", errRateLimited, rt)
+		}
+	}
+
+	req, ok := t.(interface {
+		GetDbName() string
+		GetCollectionName() string
+	})
+	if ok && req.GetCollectionName() != "" {
+		limiters := rl.getCollectionLimiters(req.GetDbName(), req.GetCollectionName())
+		for rt, cost := range costs {
+			limiter, ok := limiters[rt]
+			if !ok {
+				continue
+			}
+			if !limiter.AllowN(now, cost) {
+				return fmt.Errorf("%w: the %s rate exceeds the limit of collection %s", errRateLimited, rt, req.GetCollectionName())
+			}
+		}
+	}
+
+	rl.countInserts(costs)
+	return nil
+}
+
+// countInserts counts the inserted rows and bytes to observe the insert rates
+func (rl *rateLimiter) countInserts(costs map[rateType]float64) {
+	if _, ok := costs[insertRowRate]; !ok {
+		return
+	}
+	rl.dmlMu.Lock()
+	defer rl.dmlMu.Unlock()
+	for _, rt := range []rateType{insertRowRate, insertByteRate} {
+		if cost, ok := costs[rt]; ok {
+			rl.insertCounts[rt] += cost
+		}
+	}
+}
@@ -0,0 +1,286 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package proxy
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/util/ratelimitutil"
+)
+
+func newRateLimitTestInsertTask(collectionName string, numRows uint32) *insertTask {
+	return &insertTask{
+		BaseInsertTask: BaseInsertTask{
+			BaseMsg: msgstream.BaseMsg{},
+			InsertRequest: internalpb.InsertRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_Insert},
+				CollectionName: collectionName,
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			CollectionName: collectionName,
+			NumRows:        numRows,
+		},
+	}
+}
+
+func newRateLimitTestSearchTask(collectionName string) *searchTask {
+	return &searchTask{
+		SearchRequest: &internalpb.SearchRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Search},
+		},
+		query: &milvuspb.SearchRequest{CollectionName: collectionName},
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	Params.Init()
+	saved := Params.ProxyCfg
+	defer func() {
+		Params.ProxyCfg = saved
+	}()
+
+	t.Run("disabled", func(t *testing.T) {
+		Params.ProxyCfg.RateLimitEnabled = false
+		Params.ProxyCfg.DDLRate = 1
+		rl := newRateLimiter()
+		for i := 0; i < 100; i++ {
+			assert.Nil(t, rl.check(&dropCollectionTask{
+				DropCollectionRequest: &milvuspb.DropCollectionRequest{
+					Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+				},
+			}))
+		}
+	})
+
+	Params.ProxyCfg.RateLimitEnabled = true
+
+	t.Run("no limit", func(t *testing.T) {
+		Params.ProxyCfg.DDLRate = -1
+		Params.ProxyCfg.InsertRowRate = -1
+		Params.ProxyCfg.InsertByteRate = -1
+		Params.ProxyCfg.SearchRate = -1
+		Params.ProxyCfg.QueryRate = -1
+		Params.ProxyCfg.CollectionInsertRowRate = -1
+		Params.ProxyCfg.CollectionInsertByteRate = -1
+		Params.ProxyCfg.CollectionSearchRate = -1
+		Params.ProxyCfg.CollectionQueryRate = -1
+		rl := newRateLimiter()
+		for i := 0; i < 100; i++ {
+			assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 10000)))
+			assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+		}
+	})
+
+	t.Run("ddl rate", func(t *testing.T) {
+		Params.ProxyCfg.DDLRate = 1
+		rl := newRateLimiter()
+		cct := &createCollectionTask{
+			CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+				CollectionName: "collection1",
+			},
+		}
+		assert.Nil(t, rl.check(cct))
+		assert.Nil(t, rl.check(cct))
+		err := rl.check(cct)
+		assert.True(t, errors.Is(err, errRateLimited))
+		assert.Equal(t, commonpb.ErrorCode_RateLimit, errorCodeOf(err))
+
+		// the other requests are not limited by the ddl rate
+		assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+		Params.ProxyCfg.DDLRate = -1
+	})
+
+	t.Run("insert rows of proxy", func(t *testing.T) {
+		Params.ProxyCfg.InsertRowRate = 100
+		rl := newRateLimiter()
+		assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 100)))
+		assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection2", 1)))
+		err := rl.check(newRateLimitTestInsertTask("collection3", 1))
+		assert.True(t, errors.Is(err, errRateLimited))
+		Params.ProxyCfg.InsertRowRate = -1
+	})
+
+	t.Run("query issued by delete", func(t *testing.T) {
+		Params.ProxyCfg.QueryRate = 1
+		rl := newRateLimiter()
+		qt := &queryTask{
+			RetrieveRequest: &internalpb.RetrieveRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Retrieve},
+			},
+			query: &milvuspb.QueryRequest{CollectionName: "collection1"},
+		}
+		assert.Nil(t, rl.check(qt))
+		err := rl.check(qt)
+		assert.True(t, errors.Is(err, errRateLimited))
+
+		// the query issued by delete doesn't consume the tokens of query
+		qt.forDelete = true
+		assert.Equal(t, commonpb.MsgType_Delete, qt.Type())
+		assert.Nil(t, rl.check(qt))
+		rl.setDMLQuota(0, "memory exhausted")
+		err = rl.check(qt)
+		assert.True(t, errors.Is(err, errRateLimited))
+		Params.ProxyCfg.QueryRate = -1
+	})
+
+	t.Run("search rate of collection", func(t *testing.T) {
+		Params.ProxyCfg.CollectionSearchRate = 1
+		rl := newRateLimiter()
+		assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+		assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+		err := rl.check(newRateLimitTestSearchTask("collection1"))
+		assert.True(t, errors.Is(err, errRateLimited))
+
+		// the limits of collections are independent
+		assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection2")))
+
+		// the limiters are recreated after the collection is dropped
+		rl.removeCollection("", "collection1")
+		assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+		Params.ProxyCfg.CollectionSearchRate = -1
+	})
+}
+
+func TestRateLimiter_DMLQuota(t *testing.T) {
+	Params.Init()
+	saved := Params.ProxyCfg
+	defer func() {
+		Params.ProxyCfg = saved
+	}()
+	Params.ProxyCfg.RateLimitEnabled = true
+	Params.ProxyCfg.InsertRowRate = 100
+	Params.ProxyCfg.InsertByteRate = -1
+
+	rl := newRateLimiter()
+	factor, _ := rl.getDMLQuota()
+	assert.Equal(t, float64(1), factor)
+
+	// the insert rate is slowed down
+	rl.setDMLQuota(0.5, "slow down")
+	assert.Equal(t, ratelimitutil.Limit(50), rl.globalLimiters[insertRowRate].Limit())
+	assert.Equal(t, ratelimitutil.Inf, rl.globalLimiters[insertByteRate].Limit())
+	assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 1)))
+
+	// the DML requests are rejected
+	rl.setDMLQuota(0, "memory exhausted")
+	err := rl.check(newRateLimitTestInsertTask("collection1", 1))
+	assert.True(t, errors.Is(err, errRateLimited))
+	assert.Contains(t, err.Error(), "memory exhausted")
+	err = rl.check(&deleteTask{
+		BaseDeleteTask: BaseDeleteTask{
+			DeleteRequest: internalpb.DeleteRequest{
+				Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			},
+		},
+	})
+	assert.True(t, errors.Is(err, errRateLimited))
+	// the other requests are not affected
+	assert.Nil(t, rl.check(newRateLimitTestSearchTask("collection1")))
+
+	// the insert rate is restored
+	rl.setDMLQuota(1, "")
+	assert.Equal(t, ratelimitutil.Limit(100), rl.globalLimiters[insertRowRate].Limit())
+	assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 1)))
+}
+
+func TestRateLimiter_DMLQuotaWithoutRateLimit(t *testing.T) {
+	Params.Init()
+	saved := Params.ProxyCfg
+	savedQuota := Params.QuotaCfg
+	defer func() {
+		Params.ProxyCfg = saved
+		Params.QuotaCfg = savedQuota
+	}()
+	Params.ProxyCfg.RateLimitEnabled = false
+	Params.QuotaCfg.Enabled = true
+
+	rl := newRateLimiter()
+	assert.Equal(t, ratelimitutil.Inf, rl.globalLimiters[insertRowRate].Limit())
+	for i := 0; i < 10; i++ {
+		assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 10)))
+	}
+	// 100 rows are inserted in the last second
+	rl.observedSince = time.Now().Add(-time.Second)
+
+	// the observed insert rate is slowed down
+	rl.setDMLQuota(0.5, "slow down")
+	limit := float64(rl.globalLimiters[insertRowRate].Limit())
+	assert.InDelta(t, 50, limit, 5)
+	assert.NotEqual(t, ratelimitutil.Inf, rl.globalLimiters[insertByteRate].Limit())
+
+	// the base rate is kept while the backpressure lasts
+	rl.setDMLQuota(0.2, "slow down")
+	assert.InDelta(t, limit*0.4, float64(rl.globalLimiters[insertRowRate].Limit()), 0.001)
+
+	// the backpressure is released if it is not updated within the ttl
+	rl.dmlUpdatedAt = time.Now().Add(-dmlQuotaTTLIntervals*Params.QuotaCfg.CollectInterval - time.Second)
+	factor, reason := rl.getDMLQuota()
+	assert.Equal(t, float64(1), factor)
+	assert.Equal(t, "", reason)
+	assert.Equal(t, ratelimitutil.Inf, rl.globalLimiters[insertRowRate].Limit())
+	assert.Equal(t, ratelimitutil.Inf, rl.globalLimiters[insertByteRate].Limit())
+	assert.Empty(t, rl.dmlBaseRates)
+
+	// the rejection expires as well
+	rl.setDMLQuota(0, "memory exhausted")
+	assert.True(t, errors.Is(rl.check(newRateLimitTestInsertTask("collection1", 1)), errRateLimited))
+	rl.dmlUpdatedAt = time.Now().Add(-dmlQuotaTTLIntervals*Params.QuotaCfg.CollectInterval - time.Second)
+	assert.Nil(t, rl.check(newRateLimitTestInsertTask("collection1", 1)))
+}
+
+func TestBaseTaskQueue_EnqueueRateLimited(t *testing.T) {
+	Params.Init()
+	saved := Params.ProxyCfg
+	defer func() {
+		Params.ProxyCfg = saved
+	}()
+	Params.ProxyCfg.RateLimitEnabled = true
+	Params.ProxyCfg.DDLRate = 1
+
+	ctx := context.Background()
+	tsoAllocatorIns := newMockTsoAllocator()
+	idAllocatorIns := newMockIDAllocatorInterface()
+	sched, err := newTaskScheduler(ctx, idAllocatorIns, tsoAllocatorIns, newSimpleMockMsgStreamFactory(),
+		schedOptWithRateLimiter(newRateLimiter()))
+	assert.Nil(t, err)
+
+	newTask := func() task {
+		return &dropCollectionTask{
+			ctx:       ctx,
+			Condition: NewTaskCondition(ctx),
+			DropCollectionRequest: &milvuspb.DropCollectionRequest{
+				Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+				CollectionName: "collection1",
+			},
+		}
+	}
+	assert.Nil(t, sched.ddQueue.Enqueue(newTask()))
+	assert.Nil(t, sched.ddQueue.Enqueue(newTask()))
+	err = sched.ddQueue.Enqueue(newTask())
+	assert.True(t, errors.Is(err, errRateLimited))
+}
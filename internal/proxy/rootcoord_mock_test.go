@@ -54,6 +54,7 @@ type collectionMeta struct {
 	physicalChannelNames []string
 	createdTimestamp     uint64
 	createdUtcTimestamp  uint64
+	properties           []*commonpb.KeyValuePair
 }
 
 type partitionMeta struct {
@@ -90,6 +91,14 @@ type RootCoordMock struct {
 	collAlias2ID map[string]typeutil.UniqueID
 	collMtx      sync.RWMutex
 
+	dbName2ID map[string]typeutil.UniqueID
+	dbMtx     sync.RWMutex
+
+	credentials map[string]*internalpb.CredentialInfo
+	roles       map[string][]*milvuspb.GrantEntity
+	userRoles   map[string]map[string]struct{}
+	credMtx     sync.RWMutex
+
 	// TODO(dragondriver): need default partition?
 	collID2Partitions map[typeutil.UniqueID]partitionMap
 	partitionMtx      sync.RWMutex
@@ -200,6 +209,484 @@ func (coord *RootCoordMock) AlterAlias(ctx context.Context, req *milvuspb.AlterA
 	}, nil
 }
 
+func (coord *RootCoordMock) RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.OldName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.OldName),
+		}, nil
+	}
+	_, nameExist := coord.collName2ID[req.NewName]
+	_, aliasExist := coord.collAlias2ID[req.NewName]
+	if nameExist || aliasExist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("duplicate collection name, name = %s", req.NewName),
+		}, nil
+	}
+
+	meta := coord.collID2Meta[collID]
+	meta.name = req.NewName
+	coord.collID2Meta[collID] = meta
+	delete(coord.collName2ID, req.OldName)
+	coord.collName2ID[req.NewName] = collID
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.CollectionName),
+		}, nil
+	}
+
+	meta := coord.collID2Meta[collID]
+	values := make(map[string]string)
+	for _, kv := range meta.properties {
+		values[kv.Key] = kv.Value
+	}
+	for _, kv := range req.Properties {
+		values[kv.Key] = kv.Value
+	}
+	meta.properties = make([]*commonpb.KeyValuePair, 0, len(values))
+	for k, v := range values {
+		if v != "" {
+			meta.properties = append(meta.properties, &commonpb.KeyValuePair{Key: k, Value: v})
+		}
+	}
+	coord.collID2Meta[collID] = meta
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.collMtx.Lock()
+	defer coord.collMtx.Unlock()
+
+	collID, exist := coord.collName2ID[req.CollectionName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_CollectionNotExists,
+			Reason:    fmt.Sprintf("collection does not exist, name = %s", req.CollectionName),
+		}, nil
+	}
+
+	meta := coord.collID2Meta[collID]
+	schema := proto.Clone(meta.schema).(*schemapb.CollectionSchema)
+	field := proto.Clone(req.Field).(*schemapb.FieldSchema)
+	field.FieldID = common.StartOfUserFieldID + int64(len(schema.Fields))
+	schema.Fields = append(schema.Fields, field)
+	schema.Version++
+	meta.schema = schema
+	coord.collID2Meta[collID] = meta
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.dbMtx.Lock()
+	defer coord.dbMtx.Unlock()
+
+	_, exist := coord.dbName2ID[req.DbName]
+	if exist || req.DbName == Params.CommonCfg.DefaultDatabaseName {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database already exists, name = %s", req.DbName),
+		}, nil
+	}
+
+	coord.dbName2ID[req.DbName] = typeutil.UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.dbMtx.Lock()
+	defer coord.dbMtx.Unlock()
+
+	_, exist := coord.dbName2ID[req.DbName]
+	if !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("database does not exist, name = %s", req.DbName),
+		}, nil
+	}
+
+	delete(coord.dbName2ID, req.DbName)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListDatabasesResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.dbMtx.RLock()
+	defer coord.dbMtx.RUnlock()
+
+	names := []string{Params.CommonCfg.DefaultDatabaseName}
+	ids := []typeutil.UniqueID{0}
+	for name, id := range coord.dbName2ID {
+		names = append(names, name)
+		ids = append(ids, id)
+	}
+	return &milvuspb.ListDatabasesResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		DbNames: names,
+		DbIds:   ids,
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	if _, exist := coord.credentials[req.Username]; exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("user %s already exists", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	if _, exist := coord.credentials[req.Username]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("user %s not found", req.Username),
+		}, nil
+	}
+	coord.credentials[req.Username] = req
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	delete(coord.credentials, req.Username)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListUsersResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+
+	usernames := make([]string, 0, len(coord.credentials))
+	for username := range coord.credentials {
+		usernames = append(usernames, username)
+	}
+	return &milvuspb.ListUsersResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Usernames: usernames,
+	}, nil
+}
+
+func (coord *RootCoordMock) GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+
+	credInfo, exist := coord.credentials[req.Username]
+	if !exist {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("user %s not found", req.Username),
+			},
+		}, nil
+	}
+	return &rootcoordpb.GetCredentialResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+func (coord *RootCoordMock) CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	if _, exist := coord.roles[req.RoleName]; exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("role %s already exists", req.RoleName),
+		}, nil
+	}
+	coord.roles[req.RoleName] = make([]*milvuspb.GrantEntity, 0)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	delete(coord.roles, req.RoleName)
+	for _, roles := range coord.userRoles {
+		delete(roles, req.RoleName)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	if _, exist := coord.credentials[req.Username]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("user %s not found", req.Username),
+		}, nil
+	}
+	if _, exist := coord.roles[req.RoleName]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("role %s not found", req.RoleName),
+		}, nil
+	}
+	if req.Type == milvuspb.OperateUserRoleType_AddUserToRole {
+		if _, ok := coord.userRoles[req.Username]; !ok {
+			coord.userRoles[req.Username] = make(map[string]struct{})
+		}
+		coord.userRoles[req.Username][req.RoleName] = struct{}{}
+	} else {
+		delete(coord.userRoles[req.Username], req.RoleName)
+	}
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	if _, exist := coord.roles[req.Entity.RoleName]; !exist {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("role %s not found", req.Entity.RoleName),
+		}, nil
+	}
+	coord.roles[req.Entity.RoleName] = append(coord.roles[req.Entity.RoleName], req.Entity)
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_UnexpectedError,
+			Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+	coord.credMtx.Lock()
+	defer coord.credMtx.Unlock()
+
+	grants := make([]*milvuspb.GrantEntity, 0)
+	for _, grant := range coord.roles[req.Entity.RoleName] {
+		if grant.ObjectType == req.Entity.ObjectType && grant.ObjectName == req.Entity.ObjectName &&
+			grant.Privilege == req.Entity.Privilege {
+			continue
+		}
+		grants = append(grants, grant)
+	}
+	coord.roles[req.Entity.RoleName] = grants
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (coord *RootCoordMock) ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	code := coord.state.Load().(internalpb.StateCode)
+	if code != internalpb.StateCode_Healthy {
+		return &milvuspb.ListGrantsResponse{
+			Status: &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_UnexpectedError,
+				Reason:    fmt.Sprintf("state code = %s", internalpb.StateCode_name[int32(code)]),
+			},
+		}, nil
+	}
+	coord.credMtx.RLock()
+	defer coord.credMtx.RUnlock()
+
+	entities := make([]*milvuspb.GrantEntity, 0)
+	if req.RoleName != "" {
+		entities = append(entities, coord.roles[req.RoleName]...)
+	} else {
+		for roleName := range coord.userRoles[req.Username] {
+			entities = append(entities, coord.roles[roleName]...)
+		}
+	}
+	return &milvuspb.ListGrantsResponse{
+		Status: &commonpb.Status{
+			ErrorCode: commonpb.ErrorCode_Success,
+			Reason:    "",
+		},
+		Entities: entities,
+	}, nil
+}
+
 func (coord *RootCoordMock) updateState(state internalpb.StateCode) {
 	coord.state.Store(state)
 }
@@ -346,6 +833,7 @@ func (coord *RootCoordMock) CreateCollection(ctx context.Context, req *milvuspb.
 		physicalChannelNames: physicalChannelNames,
 		createdTimestamp:     ts,
 		createdUtcTimestamp:  ts,
+		properties:           req.Properties,
 	}
 
 	coord.partitionMtx.Lock()
@@ -488,6 +976,7 @@ func (coord *RootCoordMock) DescribeCollection(ctx context.Context, req *milvusp
 		PhysicalChannelNames: meta.physicalChannelNames,
 		CreatedTimestamp:     meta.createdUtcTimestamp,
 		CreatedUtcTimestamp:  meta.createdUtcTimestamp,
+		Properties:           meta.properties,
 	}, nil
 }
 
@@ -935,6 +1424,10 @@ func NewRootCoordMock(opts ...RootCoordMockOption) *RootCoordMock {
 		collName2ID:       make(map[string]typeutil.UniqueID),
 		collID2Meta:       make(map[typeutil.UniqueID]collectionMeta),
 		collID2Partitions: make(map[typeutil.UniqueID]partitionMap),
+		dbName2ID:         make(map[string]typeutil.UniqueID),
+		credentials:       make(map[string]*internalpb.CredentialInfo),
+		roles:             make(map[string][]*milvuspb.GrantEntity),
+		userRoles:         make(map[string]map[string]struct{}),
 		lastTs:            typeutil.Timestamp(time.Now().UnixNano()),
 	}
 
@@ -19,7 +19,9 @@ package proxy
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/binary"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
@@ -68,6 +70,12 @@ const (
 	MetricTypeKey                   = "metric_type"
 	SearchParamsKey                 = "params"
 	RoundDecimalKey                 = "round_decimal"
+	OffsetKey                       = "offset"
+	LimitKey                        = "limit"
+	RadiusKey                       = "radius"
+	RangeFilterKey                  = "range_filter"
+	IteratorKey                     = "iterator"
+	IteratorCursorKey               = "iterator_cursor"
 	HasCollectionTaskName           = "HasCollectionTask"
 	DescribeCollectionTaskName      = "DescribeCollectionTask"
 	GetCollectionStatisticsTaskName = "GetCollectionStatisticsTask"
@@ -88,12 +96,26 @@ const (
 	LoadPartitionTaskName           = "LoadPartitionsTask"
 	ReleasePartitionTaskName        = "ReleasePartitionsTask"
 	deleteTaskName                  = "DeleteTask"
+	UpsertTaskName                  = "UpsertTask"
 	CreateAliasTaskName             = "CreateAliasTask"
 	DropAliasTaskName               = "DropAliasTask"
 	AlterAliasTaskName              = "AlterAliasTask"
+	RenameCollectionTaskName        = "RenameCollectionTask"
+	AlterCollectionTaskName         = "AlterCollectionTask"
+	AddFieldTaskName                = "AddFieldTask"
+	CreateDatabaseTaskName          = "CreateDatabaseTask"
+	DropDatabaseTaskName            = "DropDatabaseTask"
+	ListDatabasesTaskName           = "ListDatabasesTask"
 
 	// minFloat32 minimum float.
 	minFloat32 = -1 * float32(math.MaxFloat32)
+
+	// maxTopK is the max topk query nodes accept, including the results skipped by offset.
+	maxTopK = 16384
+	// maxRangeSearchTopK is the max number of results of range search per query.
+	maxRangeSearchTopK = maxTopK
+	// maxSearchIteratorDepth is the max number of results a search iterator returns, limited by the topk of query nodes.
+	maxSearchIteratorDepth = maxTopK
 )
 
 type task interface {
@@ -192,7 +214,7 @@ func (it *insertTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (it *insertTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(it.ctx, it.DbName, it.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -210,6 +232,7 @@ func (it *insertTask) getChannels() ([]pChan, error) {
 
 func (it *insertTask) OnEnqueue() error {
 	it.BaseInsertTask.InsertRequest.Base = &commonpb.MsgBase{}
+	it.BaseInsertTask.InsertRequest.Base.MsgType = commonpb.MsgType_Insert
 	return nil
 }
 
@@ -258,6 +281,174 @@ func (it *insertTask) checkLengthOfFieldsData() error {
 	return nil
 }
 
+// fillFieldsData puts the fields data in the order of the schema, the missing fields take their default values,
+// or null if they are nullable, and the validity of the nullable fields is collected. If the dynamic field is
+// enabled, the fields not in the schema are packed into it
+func (it *insertTask) fillFieldsData() error {
+	fieldsData := make(map[string]*schemapb.FieldData, len(it.req.FieldsData))
+	for _, fieldData := range it.req.FieldsData {
+		fieldsData[fieldData.GetFieldName()] = fieldData
+	}
+
+	filledFieldsData := make([]*schemapb.FieldData, 0, len(it.req.FieldsData))
+	var dynamicField *schemapb.FieldSchema
+	dynamicFieldOffset := -1
+	for _, field := range it.schema.Fields {
+		if field.GetAutoID() {
+			continue
+		}
+		if field.GetIsDynamic() {
+			// filled after all the declared fields are taken
+			dynamicField = field
+			dynamicFieldOffset = len(filledFieldsData)
+			filledFieldsData = append(filledFieldsData, nil)
+			continue
+		}
+		fieldData, ok := fieldsData[field.GetName()]
+		if ok {
+			delete(fieldsData, field.GetName())
+		} else if field.GetNullable() || field.GetDefaultValue() != nil {
+			var err error
+			fieldData, err = typeutil.GenDefaultFieldData(field, int(it.req.NumRows))
+			if err != nil {
+				return err
+			}
+		} else {
+			// the missing field is reported by checkLengthOfFieldsData
+			continue
+		}
+
+		validData := fieldData.GetValidData()
+		if len(validData) > 0 {
+			if !field.GetNullable() {
+				return fmt.Errorf("field %s is not nullable", field.GetName())
+			}
+			if int64(len(validData)) != int64(it.req.NumRows) {
+				return fmt.Errorf("the number of valid data of field %s is %d, but the number of rows is %d", field.GetName(), len(validData), it.req.NumRows)
+			}
+			it.ValidData = append(it.ValidData, &internalpb.FieldValidData{
+				FieldID:   field.GetFieldID(),
+				ValidData: validData,
+			})
+		}
+		filledFieldsData = append(filledFieldsData, fieldData)
+	}
+	undeclaredFieldsData := make([]*schemapb.FieldData, 0, len(fieldsData))
+	for _, fieldData := range it.req.FieldsData {
+		if _, ok := fieldsData[fieldData.GetFieldName()]; ok {
+			undeclaredFieldsData = append(undeclaredFieldsData, fieldData)
+		}
+	}
+	if dynamicField != nil {
+		fieldData, err := packDynamicFieldData(dynamicField, undeclaredFieldsData, int(it.req.NumRows))
+		if err != nil {
+			return err
+		}
+		filledFieldsData[dynamicFieldOffset] = fieldData
+	} else {
+		// the fields not in the schema are kept as they are
+		filledFieldsData = append(filledFieldsData, undeclaredFieldsData...)
+	}
+	it.req.FieldsData = filledFieldsData
+
+	return nil
+}
+
+// packDynamicFieldData packs the fields not declared in the schema into the json documents of the dynamic field,
+// one document per row, e.g. {"color": "red", "size": 3}. The null values of a field are left out of the documents
+func packDynamicFieldData(field *schemapb.FieldSchema, fieldsData []*schemapb.FieldData, numRows int) (*schemapb.FieldData, error) {
+	rows := make([]map[string]interface{}, numRows)
+	for i := range rows {
+		rows[i] = make(map[string]interface{}, len(fieldsData))
+	}
+	for _, fieldData := range fieldsData {
+		name := fieldData.GetFieldName()
+		if name == field.GetName() {
+			return nil, fmt.Errorf("field name %s is reserved for the dynamic field", name)
+		}
+		values, err := getDynamicFieldValues(fieldData)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) != numRows {
+			return nil, fmt.Errorf("the number of rows of dynamic field %s is %d, but the number of rows is %d", name, len(values), numRows)
+		}
+		validData := fieldData.GetValidData()
+		if len(validData) > 0 && len(validData) != numRows {
+			return nil, fmt.Errorf("the number of valid data of dynamic field %s is %d, but the number of rows is %d", name, len(validData), numRows)
+		}
+		for i, value := range values {
+			if len(validData) > 0 && !validData[i] {
+				continue
+			}
+			rows[i][name] = value
+		}
+	}
+
+	docs := make([][]byte, numRows)
+	for i, row := range rows {
+		doc, err := json.Marshal(row)
+		if err != nil {
+			return nil, err
+		}
+		docs[i] = doc
+	}
+	return &schemapb.FieldData{
+		Type:      schemapb.DataType_JSON,
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{
+					JsonData: &schemapb.JSONArray{Data: docs},
+				},
+			},
+		},
+	}, nil
+}
+
+// getDynamicFieldValues returns the values of a field not declared in the schema, one value per row
+func getDynamicFieldValues(fieldData *schemapb.FieldData) ([]interface{}, error) {
+	var data interface{}
+	switch scalarData := fieldData.GetScalars().GetData().(type) {
+	case *schemapb.ScalarField_BoolData:
+		data = scalarData.BoolData.GetData()
+	case *schemapb.ScalarField_IntData:
+		data = scalarData.IntData.GetData()
+	case *schemapb.ScalarField_LongData:
+		data = scalarData.LongData.GetData()
+	case *schemapb.ScalarField_FloatData:
+		data = scalarData.FloatData.GetData()
+	case *schemapb.ScalarField_DoubleData:
+		data = scalarData.DoubleData.GetData()
+	case *schemapb.ScalarField_StringData:
+		data = scalarData.StringData.GetData()
+	case *schemapb.ScalarField_JsonData:
+		docs := scalarData.JsonData.GetData()
+		values := make([]interface{}, len(docs))
+		for i, doc := range docs {
+			// an empty document is null, like the null rows of a json field
+			if len(doc) == 0 {
+				continue
+			}
+			if !json.Valid(doc) {
+				return nil, fmt.Errorf("invalid json document of dynamic field %s: %s", fieldData.GetFieldName(), string(doc))
+			}
+			values[i] = json.RawMessage(doc)
+		}
+		return values, nil
+	default:
+		return nil, fmt.Errorf("dynamic field %s should be bool, number, string or json", fieldData.GetFieldName())
+	}
+
+	fieldValues := reflect.ValueOf(data)
+	values := make([]interface{}, fieldValues.Len())
+	for i := range values {
+		values[i] = fieldValues.Index(i).Interface()
+	}
+	return values, nil
+}
+
 func (it *insertTask) checkRowNums() error {
 	if it.req.NumRows <= 0 {
 		return errNumRowsLessThanOrEqualToZero(it.req.NumRows)
@@ -302,7 +493,15 @@ func (it *insertTask) checkRowNums() error {
 			case *schemapb.ScalarField_BytesData:
 				return errUnsupportedDType("bytes")
 			case *schemapb.ScalarField_StringData:
-				return errUnsupportedDType("string")
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetStringData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
+			case *schemapb.ScalarField_JsonData:
+				fieldNumRows := getNumRowsOfScalarField(scalarField.GetJsonData().Data)
+				if fieldNumRows != rowNums {
+					return errNumRowsOfFieldDataMismatchPassed(i, fieldNumRows, rowNums)
+				}
 			case nil:
 				continue
 			default:
@@ -343,9 +542,15 @@ func (it *insertTask) checkRowNums() error {
 // TODO(dragondriver): ignore the order of fields in request, use the order of CollectionSchema to reorganize data
 func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 	dTypes := make([]schemapb.DataType, 0, len(it.req.FieldsData))
+	maxLengths := make([]int, 0, len(it.req.FieldsData))
 	datas := make([][]interface{}, 0, len(it.req.FieldsData))
 	rowNum := 0
 
+	schemaHelper, err := typeutil.CreateSchemaHelper(it.schema)
+	if err != nil {
+		return err
+	}
+
 	appendScalarField := func(getDataFunc func() interface{}) error {
 		fieldDatas := reflect.ValueOf(getDataFunc())
 		if rowNum != 0 && rowNum != fieldDatas.Len() {
@@ -455,7 +660,19 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 			case *schemapb.ScalarField_BytesData:
 				return errors.New("bytes field is not supported now")
 			case *schemapb.ScalarField_StringData:
-				return errors.New("string field is not supported now")
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetStringData().Data
+				})
+				if err != nil {
+					return err
+				}
+			case *schemapb.ScalarField_JsonData:
+				err := appendScalarField(func() interface{} {
+					return scalarField.GetJsonData().Data
+				})
+				if err != nil {
+					return err
+				}
 			case nil:
 				continue
 			default:
@@ -489,7 +706,20 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 			continue
 		}
 
+		// a varchar value or a serialized json document takes its length and the bytes, which are limited by the max length
+		maxLength := 0
+		if field.Type == schemapb.DataType_VarChar || field.Type == schemapb.DataType_JSON {
+			fieldSchema, err := schemaHelper.GetFieldFromName(field.FieldName)
+			if err != nil {
+				return err
+			}
+			maxLength, err = typeutil.GetMaxLength(fieldSchema)
+			if err != nil {
+				return err
+			}
+		}
 		dTypes = append(dTypes, field.Type)
+		maxLengths = append(maxLengths, maxLength)
 	}
 
 	it.RowData = make([]*commonpb.Blob, 0, rowNum)
@@ -554,6 +784,26 @@ func (it *insertTask) transferColumnBasedRequestToRowBasedData() error {
 					log.Warn("ConvertData", zap.Error(err))
 				}
 				blob.Value = append(blob.Value, buffer.Bytes()...)
+			case schemapb.DataType_VarChar:
+				d, err := typeutil.EncodeVarChar(datas[j][i].(string), maxLengths[j])
+				if err != nil {
+					return err
+				}
+				blob.Value = append(blob.Value, d...)
+			case schemapb.DataType_JSON:
+				doc := datas[j][i].([]byte)
+				if len(doc) == 0 {
+					// the null rows of a nullable json field are left empty
+					doc = []byte("null")
+				}
+				if !json.Valid(doc) {
+					return fmt.Errorf("the value of row %d is not a valid json document", i)
+				}
+				d, err := typeutil.EncodeVarChar(string(doc), maxLengths[j])
+				if err != nil {
+					return err
+				}
+				blob.Value = append(blob.Value, d...)
 			case schemapb.DataType_FloatVector:
 				d := datas[j][i].([]float32)
 				err := binary.Write(&buffer, endian, d)
@@ -620,7 +870,6 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	}
 
 	var primaryField *schemapb.FieldData
-	var primaryData []int64
 	for _, field := range it.req.FieldsData {
 		if field.FieldName == autoIDFieldName {
 			return fmt.Errorf("autoID field (%v) does not require data", autoIDFieldName)
@@ -631,25 +880,35 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	}
 
 	if primaryField != nil {
-		if primaryField.Type != schemapb.DataType_Int64 {
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-		}
-		switch primaryField.Field.(type) {
-		case *schemapb.FieldData_Scalars:
-			scalarField := primaryField.GetScalars()
-			switch scalarField.Data.(type) {
-			case *schemapb.ScalarField_LongData:
-				primaryData = scalarField.GetLongData().Data
-			default:
-				return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
+		switch primaryField.Type {
+		case schemapb.DataType_Int64:
+			longData := primaryField.GetScalars().GetLongData()
+			if longData == nil {
+				return fmt.Errorf("the data of int64 primary field %s should be long data", primaryFieldName)
+			}
+			it.result.IDs.IdField = &schemapb.IDs_IntId{
+				IntId: &schemapb.LongArray{
+					Data: longData.Data,
+				},
+			}
+		case schemapb.DataType_VarChar:
+			stringData := primaryField.GetScalars().GetStringData()
+			if stringData == nil {
+				return fmt.Errorf("the data of varchar primary field %s should be string data", primaryFieldName)
+			}
+			// the empty string marks an invalid primary key
+			for i, pk := range stringData.Data {
+				if pk == "" {
+					return fmt.Errorf("the primary key of row %d is empty", i)
+				}
+			}
+			it.result.IDs.IdField = &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: stringData.Data,
+				},
 			}
 		default:
-			return fmt.Errorf("currently only support DataType Int64 as PrimaryField and Enable autoID")
-		}
-		it.result.IDs.IdField = &schemapb.IDs_IntId{
-			IntId: &schemapb.LongArray{
-				Data: primaryData,
-			},
+			return fmt.Errorf("currently only support DataType Int64 or VarChar as PrimaryField")
 		}
 	}
 
@@ -691,10 +950,8 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 				Data: it.BaseInsertTask.RowIDs,
 			},
 		}
-		it.HashPK(it.BaseInsertTask.RowIDs)
-	} else {
-		it.HashPK(primaryData)
 	}
+	it.HashPK(it.result.IDs)
 
 	sliceIndex := make([]uint32, rowNums)
 	for i := uint32(0); i < rowNums; i++ {
@@ -705,15 +962,29 @@ func (it *insertTask) checkFieldAutoIDAndHashPK() error {
 	return nil
 }
 
-func (it *insertTask) HashPK(pks []int64) {
+func (it *insertTask) HashPK(pks *schemapb.IDs) {
 	if len(it.HashValues) != 0 {
 		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
 	}
-	it.HashValues = make([]uint32, 0, len(pks))
-	for _, pk := range pks {
-		hash, _ := typeutil.Hash32Int64(pk)
-		it.HashValues = append(it.HashValues, hash)
+	it.HashValues = hashPKs(pks)
+}
+
+// hashPKs hashes the primary keys of int64 or varchar to the hash values used to dispatch the rows to channels
+func hashPKs(pks *schemapb.IDs) []uint32 {
+	hashValues := make([]uint32, 0, typeutil.GetSizeOfIDs(pks))
+	switch pks.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		for _, pk := range pks.GetIntId().GetData() {
+			hash, _ := typeutil.Hash32Int64(pk)
+			hashValues = append(hashValues, hash)
+		}
+	case *schemapb.IDs_StrId:
+		for _, pk := range pks.GetStrId().GetData() {
+			hash, _ := typeutil.Hash32String(pk)
+			hashValues = append(hashValues, uint32(hash))
+		}
 	}
+	return hashValues
 }
 
 func (it *insertTask) PreExecute(ctx context.Context) error {
@@ -742,12 +1013,24 @@ func (it *insertTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, it.DbName, collectionName)
 	log.Debug("Proxy Insert PreExecute", zap.Any("collSchema", collSchema))
 	if err != nil {
 		return err
 	}
 	it.schema = collSchema
+	it.SchemaVersion = collSchema.Version
+	it.FieldIDs = make([]int64, 0, len(collSchema.Fields))
+	for _, field := range collSchema.Fields {
+		if field.FieldID >= common.StartOfUserFieldID {
+			it.FieldIDs = append(it.FieldIDs, field.FieldID)
+		}
+	}
+
+	err = it.fillFieldsData()
+	if err != nil {
+		return err
+	}
 
 	err = it.checkRowNums()
 	if err != nil {
@@ -945,6 +1228,13 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 					PartitionName:  partitionName,
 					SegmentID:      segmentID,
 					ShardName:      channelNames[key],
+					SchemaVersion:  insertRequest.SchemaVersion,
+					FieldIDs:       insertRequest.FieldIDs,
+				}
+				for _, fieldValidData := range insertRequest.ValidData {
+					sliceRequest.ValidData = append(sliceRequest.ValidData, &internalpb.FieldValidData{
+						FieldID: fieldValidData.FieldID,
+					})
 				}
 				insertMsg := &msgstream.InsertMsg{
 					BaseMsg: msgstream.BaseMsg{
@@ -961,9 +1251,12 @@ func (it *insertTask) _assignSegmentID(stream msgstream.MsgStream, pack *msgstre
 			curMsg.Timestamps = append(curMsg.Timestamps, ts)
 			curMsg.RowIDs = append(curMsg.RowIDs, rowID)
 			curMsg.RowData = append(curMsg.RowData, row)
+			for j, fieldValidData := range insertRequest.ValidData {
+				curMsg.ValidData[j].ValidData = append(curMsg.ValidData[j].ValidData, fieldValidData.ValidData[index])
+			}
 			/* #nosec G103 */
 			curMsgSize += 4 + 8 + int(unsafe.Sizeof(row.Value))
-			curMsgSize += len(row.Value)
+			curMsgSize += len(row.Value) + len(insertRequest.ValidData)
 
 			if curMsgSize >= threshold {
 				newPack.Msgs = append(newPack.Msgs, curMsg)
@@ -991,19 +1284,19 @@ func (it *insertTask) Execute(ctx context.Context) error {
 	defer tr.Elapse("done")
 
 	collectionName := it.BaseInsertTask.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, it.DbName, collectionName)
 	if err != nil {
 		return err
 	}
 	it.CollectionID = collID
 	var partitionID UniqueID
 	if len(it.PartitionName) > 0 {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, it.PartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, it.PartitionName)
 		if err != nil {
 			return err
 		}
 	} else {
-		partitionID, err = globalMetaCache.GetPartitionID(ctx, collectionName, Params.CommonCfg.DefaultPartitionName)
+		partitionID, err = globalMetaCache.GetPartitionID(ctx, it.DbName, collectionName, Params.CommonCfg.DefaultPartitionName)
 		if err != nil {
 			return err
 		}
@@ -1137,6 +1430,10 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	if _, err := funcutil.GetCollectionTTL(cct.Properties); err != nil {
+		return err
+	}
+
 	if err := validateDuplicatedFieldName(cct.schema.Fields); err != nil {
 		return err
 	}
@@ -1154,6 +1451,18 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		if err := validateFieldName(field.Name); err != nil {
 			return err
 		}
+		if field.IsDynamic {
+			return fmt.Errorf("field %s can't be dynamic, the dynamic field is added by enable_dynamic_field", field.Name)
+		}
+		if err := validateNullableAndDefault(field); err != nil {
+			return err
+		}
+		// the length of a json document is limited by max_length, like a varchar field
+		if field.DataType == schemapb.DataType_JSON {
+			if err := validateMaxLength(field); err != nil {
+				return err
+			}
+		}
 		if field.DataType == schemapb.DataType_FloatVector || field.DataType == schemapb.DataType_BinaryVector {
 			exist := false
 			var dim int64
@@ -1187,6 +1496,26 @@ func (cct *createCollectionTask) PreExecute(ctx context.Context) error {
 		return err
 	}
 
+	// the keys not declared in the schema are packed into a hidden json field on insert
+	if cct.schema.EnableDynamicField {
+		cct.schema.Fields = append(cct.schema.Fields, &schemapb.FieldSchema{
+			Name:        common.MetaFieldName,
+			Description: "dynamic field",
+			DataType:    schemapb.DataType_JSON,
+			TypeParams: []*commonpb.KeyValuePair{
+				{Key: "max_length", Value: strconv.FormatInt(Params.ProxyCfg.DynamicFieldMaxLength, 10)},
+			},
+			IsDynamic: true,
+		})
+		if err := validateMaxLength(cct.schema.Fields[len(cct.schema.Fields)-1]); err != nil {
+			return err
+		}
+		cct.CreateCollectionRequest.Schema, err = proto.Marshal(cct.schema)
+		if err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -1244,6 +1573,7 @@ func (dct *dropCollectionTask) SetTs(ts Timestamp) {
 
 func (dct *dropCollectionTask) OnEnqueue() error {
 	dct.Base = &commonpb.MsgBase{}
+	dct.Base.MsgType = commonpb.MsgType_DropCollection
 	return nil
 }
 
@@ -1258,7 +1588,7 @@ func (dct *dropCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, dct.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dct.DbName, dct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -1275,7 +1605,7 @@ func (dct *dropCollectionTask) Execute(ctx context.Context) error {
 }
 
 func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
-	globalMetaCache.RemoveCollection(ctx, dct.CollectionName)
+	globalMetaCache.RemoveCollection(ctx, dct.DbName, dct.CollectionName)
 	return nil
 }
 
@@ -1290,6 +1620,7 @@ func (dct *dropCollectionTask) PostExecute(ctx context.Context) error {
 //   output_fields=["*",C]   ==> [A,B,C]
 func translateOutputFields(outputFields []string, schema *schemapb.CollectionSchema, addPrimary bool) ([]string, error) {
 	var primaryFieldName string
+	var dynamicFieldName string
 	scalarFieldNameMap := make(map[string]bool)
 	vectorFieldNameMap := make(map[string]bool)
 	resultFieldNameMap := make(map[string]bool)
@@ -1299,6 +1630,9 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 		if field.IsPrimaryKey {
 			primaryFieldName = field.Name
 		}
+		if field.IsDynamic {
+			dynamicFieldName = field.Name
+		}
 		if field.DataType == schemapb.DataType_BinaryVector || field.DataType == schemapb.DataType_FloatVector {
 			vectorFieldNameMap[field.Name] = true
 		} else {
@@ -1316,6 +1650,9 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 			for fieldName := range vectorFieldNameMap {
 				resultFieldNameMap[fieldName] = true
 			}
+		} else if !scalarFieldNameMap[outputFieldName] && !vectorFieldNameMap[outputFieldName] && dynamicFieldName != "" {
+			// the keys not declared in the schema are read from the dynamic field
+			resultFieldNameMap[dynamicFieldName] = true
 		} else {
 			resultFieldNameMap[outputFieldName] = true
 		}
@@ -1331,6 +1668,71 @@ func translateOutputFields(outputFields []string, schema *schemapb.CollectionSch
 	return resultFieldNames, nil
 }
 
+// getDynamicOutputKeys returns the output fields not declared in the schema, which are the keys of the dynamic
+// field. It returns nil if the dynamic field is not enabled, or the whole dynamic field is requested by `*`
+func getDynamicOutputKeys(outputFields []string, schema *schemapb.CollectionSchema) []string {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil
+	}
+	dynamicField, err := helper.GetDynamicField()
+	if err != nil {
+		return nil
+	}
+	var keys []string
+	for _, outputFieldName := range outputFields {
+		outputFieldName = strings.TrimSpace(outputFieldName)
+		if outputFieldName == "*" || outputFieldName == dynamicField.Name {
+			return nil
+		}
+		if _, err := helper.GetFieldFromName(outputFieldName); err != nil && outputFieldName != "%" {
+			keys = append(keys, outputFieldName)
+		}
+	}
+	return keys
+}
+
+// filterDynamicFieldData keeps only the given keys in the json documents of the dynamic field
+func filterDynamicFieldData(fieldsData []*schemapb.FieldData, dynamicFieldName string, keys []string) error {
+	if len(keys) == 0 {
+		return nil
+	}
+	for _, fieldData := range fieldsData {
+		if fieldData.GetFieldName() != dynamicFieldName {
+			continue
+		}
+		docs := fieldData.GetScalars().GetJsonData().GetData()
+		for i, doc := range docs {
+			row := make(map[string]json.RawMessage)
+			if err := json.Unmarshal(doc, &row); err != nil {
+				return err
+			}
+			filteredRow := make(map[string]json.RawMessage, len(keys))
+			for _, key := range keys {
+				if value, ok := row[key]; ok {
+					filteredRow[key] = value
+				}
+			}
+			filteredDoc, err := json.Marshal(filteredRow)
+			if err != nil {
+				return err
+			}
+			docs[i] = filteredDoc
+		}
+	}
+	return nil
+}
+
+// hasVarCharPrimaryKey returns whether the primary key of the collection is a varchar field
+func hasVarCharPrimaryKey(schema *schemapb.CollectionSchema) bool {
+	for _, field := range schema.GetFields() {
+		if field.GetIsPrimaryKey() {
+			return field.GetDataType() == schemapb.DataType_VarChar
+		}
+	}
+	return false
+}
+
 type searchTask struct {
 	Condition
 	*internalpb.SearchRequest
@@ -1340,6 +1742,15 @@ type searchTask struct {
 	query     *milvuspb.SearchRequest
 	chMgr     channelsMgr
 	qc        types.QueryCoord
+	offset    int64
+	topk      int64
+	iterator  bool
+	cursor    *internalpb.IteratorCursor
+
+	// range search without topk, which returns all the results within radius
+	rangeSearchAll bool
+	// the output keys of the dynamic field
+	dynamicKeys []string
 }
 
 func (st *searchTask) TraceCtx() context.Context {
@@ -1381,8 +1792,18 @@ func (st *searchTask) OnEnqueue() error {
 	return nil
 }
 
+// GetCollectionName returns the name of the collection to search
+func (st *searchTask) GetCollectionName() string {
+	return st.query.GetCollectionName()
+}
+
+// GetDbName returns the name of the database which the collection to search belongs to
+func (st *searchTask) GetDbName() string {
+	return st.query.GetDbName()
+}
+
 func (st *searchTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1401,7 +1822,7 @@ func (st *searchTask) getChannels() ([]pChan, error) {
 }
 
 func (st *searchTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(st.ctx, st.query.DbName, st.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -1419,6 +1840,19 @@ func (st *searchTask) getVChannels() ([]vChan, error) {
 	return channels, nil
 }
 
+// getExpireTimestamp returns the timestamp before which the entities are expired by the collection ttl,
+// zero means no entity is expired
+func getExpireTimestamp(ctx context.Context, dbName string, collectionName string, ts Timestamp) (Timestamp, error) {
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, dbName, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	if collInfo.ttl <= 0 {
+		return 0, nil
+	}
+	return tsoutil.AddPhysicalTimeOnTs(-collInfo.ttl.Milliseconds(), ts), nil
+}
+
 func (st *searchTask) PreExecute(ctx context.Context) error {
 	sp, ctx := trace.StartSpanFromContextWithOperationName(st.TraceCtx(), "Proxy-Search-PreExecute")
 	defer sp.Finish()
@@ -1426,10 +1860,11 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	st.Base.SourceID = Params.ProxyCfg.ProxyID
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
+	collID := collInfo.collID
 
 	if err := validateCollectionName(st.query.CollectionName); err != nil {
 		return err
@@ -1449,7 +1884,7 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			Timestamp: st.Base.Timestamp,
 			SourceID:  Params.ProxyCfg.ProxyID,
 		},
-		DbID: 0, // TODO(dragondriver)
+		DbID: collInfo.dbID,
 	})
 	if err != nil {
 		return err
@@ -1477,9 +1912,11 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 
 	st.Base.MsgType = commonpb.MsgType_Search
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, collectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, collectionName)
 
-	outputFields, err := translateOutputFields(st.query.OutputFields, schema, false)
+	// query nodes take the ids of a varchar primary key from the primary field data
+	st.dynamicKeys = getDynamicOutputKeys(st.query.OutputFields, schema)
+	outputFields, err := translateOutputFields(st.query.OutputFields, schema, hasVarCharPrimaryKey(schema))
 	if err != nil {
 		return err
 	}
@@ -1492,18 +1929,28 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(AnnsFieldKey + " not found in search_params")
 		}
 
-		topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams)
+		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
 		if err != nil {
-			return errors.New(TopKKey + " not found in search_params")
+			return errors.New(MetricTypeKey + " not found in search_params")
 		}
-		topK, err := strconv.Atoi(topKStr)
+
+		isRangeSearch, radius, rangeFilter, err := parseRangeSearchParams(metricType, st.query.SearchParams)
 		if err != nil {
-			return errors.New(TopKKey + " " + topKStr + " is not invalid")
+			return err
 		}
 
-		metricType, err := funcutil.GetAttrByKeyFromRepeatedKV(MetricTypeKey, st.query.SearchParams)
+		topKStr, err := funcutil.GetAttrByKeyFromRepeatedKV(TopKKey, st.query.SearchParams)
+		topKSet := err == nil
+		if !topKSet {
+			if !isRangeSearch {
+				return errors.New(TopKKey + " not found in search_params")
+			}
+			// range search returns all the results within radius if topk is not set
+			topKStr = strconv.Itoa(maxRangeSearchTopK)
+		}
+		topK, err := strconv.Atoi(topKStr)
 		if err != nil {
-			return errors.New(MetricTypeKey + " not found in search_params")
+			return errors.New(TopKKey + " " + topKStr + " is not invalid")
 		}
 
 		searchParams, err := funcutil.GetAttrByKeyFromRepeatedKV(SearchParamsKey, st.query.SearchParams)
@@ -1523,11 +1970,69 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 			return errors.New(RoundDecimalKey + " " + roundDecimalStr + " is not invalid")
 		}
 
+		offset, err := getPaginationParam(OffsetKey, st.query.SearchParams)
+		if err != nil {
+			return err
+		}
+		// range search without topk returns all the results within radius, an extra result is asked from
+		// query nodes to tell whether there are more results than the topk of query nodes allows
+		st.rangeSearchAll = isRangeSearch && !topKSet
+		if st.rangeSearchAll {
+			// the results skipped by offset take up the topk of query nodes
+			topK -= int(offset) + 1
+		}
+		st.topk = int64(topK)
+		// query nodes return topk + offset results, the first offset ones are skipped in reduce
+		queryTopK := st.topk + offset
+		if st.rangeSearchAll {
+			queryTopK++
+		}
+
+		st.iterator, st.cursor, err = parseIteratorParams(st.query.SearchParams)
+		if err != nil {
+			return err
+		}
+		if st.iterator {
+			if offset > 0 {
+				return errors.New("search iterator doesn't support " + OffsetKey)
+			}
+			nq, err := getNumOfQuery(st.query.PlaceholderGroup)
+			if err != nil {
+				return err
+			}
+			if nq != 1 {
+				return fmt.Errorf("search iterator only supports one query vector, got %d", nq)
+			}
+			if st.cursor != nil {
+				if st.cursor.ReturnedCount+st.topk > maxSearchIteratorDepth {
+					return fmt.Errorf("search iterator can't return more than %d results", maxSearchIteratorDepth)
+				}
+				st.query.TravelTimestamp = st.cursor.TravelTimestamp
+				// query nodes search as deep as the results returned, the nearer ones are removed by the range of distance,
+				// only the results at the last distance already returned need to be skipped.
+				var guarded bool
+				isRangeSearch, radius, rangeFilter, guarded = continueFromLastDistance(metricType, isRangeSearch, radius, rangeFilter, st.cursor.LastDistance)
+				if guarded {
+					offset = st.cursor.TieCount
+				} else {
+					offset = st.cursor.ReturnedCount
+				}
+				queryTopK = st.cursor.ReturnedCount + st.topk
+			}
+		}
+		st.offset = offset
+		if queryTopK <= 0 || queryTopK > maxTopK {
+			return fmt.Errorf("%s+%s (%d) should be in range [1, %d]", TopKKey, OffsetKey, queryTopK, maxTopK)
+		}
+
 		queryInfo := &planpb.QueryInfo{
-			Topk:         int64(topK),
-			MetricType:   metricType,
-			SearchParams: searchParams,
-			RoundDecimal: int64(roundDecimal),
+			Topk:          queryTopK,
+			MetricType:    metricType,
+			SearchParams:  searchParams,
+			RoundDecimal:  int64(roundDecimal),
+			IsRangeSearch: isRangeSearch,
+			Radius:        radius,
+			RangeFilter:   rangeFilter,
 		}
 
 		log.Debug("create query plan",
@@ -1591,17 +2096,21 @@ func (st *searchTask) PreExecute(ctx context.Context) error {
 	}
 	st.SearchRequest.TravelTimestamp = travelTimestamp
 	st.SearchRequest.GuaranteeTimestamp = guaranteeTimestamp
+	st.SearchRequest.ExpireTimestamp, err = getExpireTimestamp(ctx, st.query.DbName, collectionName, st.BeginTs())
+	if err != nil {
+		return err
+	}
 	deadline, ok := st.TraceCtx().Deadline()
 	if ok {
 		st.SearchRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
 	}
 
 	st.SearchRequest.ResultChannelID = Params.ProxyCfg.SearchResultChannelNames[0]
-	st.SearchRequest.DbID = 0 // todo
+	st.SearchRequest.DbID = collInfo.dbID
 	st.SearchRequest.CollectionID = collID
 	st.SearchRequest.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, st.query.DbName, collectionName)
 	if err != nil {
 		return err
 	}
@@ -1659,7 +2168,7 @@ func (st *searchTask) Execute(ctx context.Context) error {
 	msgPack.Msgs[0] = tsMsg
 
 	collectionName := st.query.CollectionName
-	collID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, st.query.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -1737,25 +2246,65 @@ func checkSearchResultData(data *schemapb.SearchResultData, nq int64, topk int64
 	if data.TopK != topk {
 		return fmt.Errorf("search result's topk(%d) mis-match with %d", data.TopK, topk)
 	}
-	if len(data.Ids.GetIntId().Data) != (int)(nq*topk) {
-		return fmt.Errorf("search result's id length %d invalid", len(data.Ids.GetIntId().Data))
+	size := nq * topk
+	if isVariableLengthSearchResult(data, nq) {
+		size = int64(typeutil.GetSizeOfIDs(data.Ids))
+		for _, k := range data.Topks {
+			if k < 0 || k > topk {
+				return fmt.Errorf("search result's topks %v invalid", data.Topks)
+			}
+		}
 	}
-	if len(data.Scores) != (int)(nq*topk) {
+	if typeutil.GetSizeOfIDs(data.Ids) != (int)(size) {
+		return fmt.Errorf("search result's id length %d invalid", typeutil.GetSizeOfIDs(data.Ids))
+	}
+	if len(data.Scores) != (int)(size) {
 		return fmt.Errorf("search result's score length %d invalid", len(data.Scores))
 	}
 	return nil
 }
 
-func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []int64, topk int64, qi int64) int {
+// isVariableLengthSearchResult returns whether the number of results of each query is given by Topks,
+// e.g. the results of range search. Otherwise every query has topk results.
+func isVariableLengthSearchResult(data *schemapb.SearchResultData, nq int64) bool {
+	if int64(len(data.Topks)) != nq {
+		return false
+	}
+	var total int64
+	for _, k := range data.Topks {
+		total += k
+	}
+	return total == int64(typeutil.GetSizeOfIDs(data.Ids))
+}
+
+// getSearchResultQueryRanges returns the start position and the number of results of each query
+func getSearchResultQueryRanges(data *schemapb.SearchResultData, nq int64, topk int64) ([]int64, []int64) {
+	starts := make([]int64, nq)
+	sizes := make([]int64, nq)
+	variableLength := isVariableLengthSearchResult(data, nq)
+	var start int64
+	for i := int64(0); i < nq; i++ {
+		starts[i] = start
+		if variableLength {
+			sizes[i] = data.Topks[i]
+		} else {
+			sizes[i] = topk
+		}
+		start += sizes[i]
+	}
+	return starts, sizes
+}
+
+func selectSearchResultData(dataArray []*schemapb.SearchResultData, starts [][]int64, sizes [][]int64, offsets []int64, qi int64) int {
 	sel := -1
 	maxDistance := minFloat32
 	for i, offset := range offsets { // query num, the number of ways to merge
-		if offset >= topk {
+		if offset >= sizes[i][qi] {
 			continue
 		}
-		idx := qi*topk + offset
-		id := dataArray[i].Ids.GetIntId().Data[idx]
-		if id != -1 {
+		idx := starts[i][qi] + offset
+		id := typeutil.GetPK(dataArray[i].Ids, idx)
+		if !typeutil.IsInvalidPK(id) {
 			distance := dataArray[i].Scores[idx]
 			if distance > maxDistance {
 				sel = i
@@ -1778,7 +2327,9 @@ func selectSearchResultData(dataArray []*schemapb.SearchResultData, offsets []in
 //	}
 //}
 
-func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string) (*milvuspb.SearchResults, error) {
+// reduceSearchResultData merges the topk results of query nodes,
+// the first offset results of each query are skipped and at most limit results are kept.
+func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq int64, topk int64, metricType string, offset int64, limit int64) (*milvuspb.SearchResults, error) {
 
 	tr := timerecord.NewTimeRecorder("reduceSearchResultData")
 	defer func() {
@@ -1786,7 +2337,8 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 	}()
 
 	log.Debug("reduceSearchResultData", zap.Int("len(searchResultData)", len(searchResultData)),
-		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType))
+		zap.Int64("nq", nq), zap.Int64("topk", topk), zap.String("metricType", metricType),
+		zap.Int64("offset", offset), zap.Int64("limit", limit))
 
 	ret := &milvuspb.SearchResults{
 		Status: &commonpb.Status{
@@ -1797,17 +2349,27 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			TopK:       topk,
 			FieldsData: make([]*schemapb.FieldData, len(searchResultData[0].FieldsData)),
 			Scores:     make([]float32, 0),
-			Ids: &schemapb.IDs{
-				IdField: &schemapb.IDs_IntId{
-					IntId: &schemapb.LongArray{
-						Data: make([]int64, 0),
-					},
-				},
-			},
-			Topks: make([]int64, 0),
+			Ids:        &schemapb.IDs{},
+			Topks:      make([]int64, 0),
 		},
 	}
+	// the ids of the varchar primary key are strings
+	if searchResultData[0].GetIds().GetStrId() != nil {
+		ret.Results.Ids.IdField = &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{
+				Data: make([]string, 0),
+			},
+		}
+	} else {
+		ret.Results.Ids.IdField = &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: make([]int64, 0),
+			},
+		}
+	}
 
+	starts := make([][]int64, len(searchResultData))
+	sizes := make([][]int64, len(searchResultData))
 	for i, sData := range searchResultData {
 		log.Debug("reduceSearchResultData",
 			zap.Int("i", i),
@@ -1817,35 +2379,40 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 		if err := checkSearchResultData(sData, nq, topk); err != nil {
 			return ret, err
 		}
+		starts[i], sizes[i] = getSearchResultQueryRanges(sData, nq, topk)
 		//printSearchResultData(sData, strconv.FormatInt(int64(i), 10))
 	}
 
 	var skipDupCnt int64
 	var realTopK int64 = -1
+	var maxTopK int64
 	for i := int64(0); i < nq; i++ {
 		offsets := make([]int64, len(searchResultData))
 
-		var idSet = make(map[int64]struct{})
+		var idSet = make(map[interface{}]struct{})
 		var j int64
-		for j = 0; j < topk; {
-			sel := selectSearchResultData(searchResultData, offsets, topk, i)
+		for j = 0; j < offset+limit; {
+			sel := selectSearchResultData(searchResultData, starts, sizes, offsets, i)
 			if sel == -1 {
 				break
 			}
-			idx := i*topk + offsets[sel]
+			idx := starts[sel][i] + offsets[sel]
 
-			id := searchResultData[sel].Ids.GetIntId().Data[idx]
+			id := typeutil.GetPK(searchResultData[sel].Ids, idx)
 			score := searchResultData[sel].Scores[idx]
 			// ignore invalid search result
-			if id == -1 {
+			if typeutil.IsInvalidPK(id) {
 				continue
 			}
 
 			// remove duplicates
 			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
-				ret.Results.Ids.GetIntId().Data = append(ret.Results.Ids.GetIntId().Data, id)
-				ret.Results.Scores = append(ret.Results.Scores, score)
+				// skip the results before offset
+				if j >= offset {
+					typeutil.AppendFieldData(ret.Results.FieldsData, searchResultData[sel].FieldsData, idx)
+					typeutil.AppendPKs(ret.Results.Ids, id)
+					ret.Results.Scores = append(ret.Results.Scores, score)
+				}
 				idSet[id] = struct{}{}
 				j++
 			} else {
@@ -1854,15 +2421,26 @@ func reduceSearchResultData(searchResultData []*schemapb.SearchResultData, nq in
 			}
 			offsets[sel]++
 		}
-		if realTopK != -1 && realTopK != j {
-			log.Warn("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
+		if j < offset {
+			j = offset
+		}
+		if realTopK != -1 && realTopK != j-offset {
+			log.Debug("Proxy Reduce Search Result", zap.Error(errors.New("the length (topk) between all result of query is different")))
 			// return nil, errors.New("the length (topk) between all result of query is different")
 		}
-		realTopK = j
+		realTopK = j - offset
+		if realTopK > maxTopK {
+			maxTopK = realTopK
+		}
 		ret.Results.Topks = append(ret.Results.Topks, realTopK)
 	}
 	log.Debug("skip duplicated search result", zap.Int64("count", skipDupCnt))
-	ret.Results.TopK = realTopK
+	// the number of results of each query may differ, Topks tells the number of results of each query
+	ret.Results.TopK = maxTopK
+	if typeutil.GetSizeOfIDs(ret.Results.Ids) == 0 {
+		// no field data is appended, nil field data can't be marshaled
+		ret.Results.FieldsData = []*schemapb.FieldData{}
+	}
 
 	if !distance.PositivelyRelated(metricType) {
 		for k := range ret.Results.Scores {
@@ -1947,17 +2525,32 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 				return nil
 			}
 
-			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType)
-			if err != nil {
-				return err
+			limit := searchResults[0].TopK - st.offset
+			if st.iterator {
+				limit = st.topk
 			}
-
-			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.CollectionName)
+			st.result, err = reduceSearchResultData(validSearchResults, searchResults[0].NumQueries, searchResults[0].TopK, searchResults[0].MetricType, st.offset, limit)
 			if err != nil {
 				return err
 			}
-			if len(st.query.OutputFields) != 0 && len(st.result.Results.FieldsData) != 0 {
-				for k, fieldName := range st.query.OutputFields {
+			if st.iterator {
+				st.result.IteratorCursor, err = st.nextIteratorCursor()
+				if err != nil {
+					return err
+				}
+			}
+			if st.rangeSearchAll {
+				if err := checkRangeSearchTruncated(st.result.GetResults(), st.topk); err != nil {
+					return err
+				}
+			}
+
+			schema, err := globalMetaCache.GetCollectionSchema(ctx, st.query.DbName, st.query.CollectionName)
+			if err != nil {
+				return err
+			}
+			if len(st.query.OutputFields) != 0 && len(st.result.Results.FieldsData) != 0 {
+				for k, fieldName := range st.query.OutputFields {
 					for _, field := range schema.Fields {
 						if st.result.Results.FieldsData[k] != nil && field.Name == fieldName {
 							st.result.Results.FieldsData[k].FieldName = field.Name
@@ -1966,12 +2559,53 @@ func (st *searchTask) PostExecute(ctx context.Context) error {
 						}
 					}
 				}
+				if err := filterDynamicFieldData(st.result.Results.FieldsData, common.MetaFieldName, st.dynamicKeys); err != nil {
+					return err
+				}
 			}
 			return nil
 		}
 	}
 }
 
+// checkRangeSearchTruncated returns an error if any query of the range search without topk has more results
+// than topk, which are truncated by the topk of query nodes
+func checkRangeSearchTruncated(results *schemapb.SearchResultData, topk int64) error {
+	for i, queryTopK := range results.GetTopks() {
+		if queryTopK > topk {
+			return fmt.Errorf("range search of query %d has more than %d results within radius, set %s or narrow the radius", i, topk, TopKKey)
+		}
+	}
+	return nil
+}
+
+// nextIteratorCursor returns the cursor of the next page of search iterator, empty if the iteration is finished
+func (st *searchTask) nextIteratorCursor() (string, error) {
+	results := st.result.GetResults()
+	if len(results.GetTopks()) == 0 || results.Topks[0] < st.topk {
+		return "", nil
+	}
+	scores := results.Scores[:results.Topks[0]]
+	lastDistance := scores[len(scores)-1]
+	var tieCount int64
+	for k := len(scores) - 1; k >= 0 && scores[k] == lastDistance; k-- {
+		tieCount++
+	}
+	returnedCount := st.topk
+	if st.cursor != nil {
+		returnedCount += st.cursor.ReturnedCount
+		if tieCount == int64(len(scores)) && st.cursor.LastDistance == lastDistance {
+			tieCount += st.cursor.TieCount
+		}
+	}
+	return encodeIteratorCursor(&internalpb.IteratorCursor{
+		TravelTimestamp: st.SearchRequest.TravelTimestamp,
+		LastDistance:    lastDistance,
+		ReturnedCount:   returnedCount,
+		TieCount:        tieCount,
+	})
+}
+
 type queryTask struct {
 	Condition
 	*internalpb.RetrieveRequest
@@ -1982,6 +2616,20 @@ type queryTask struct {
 	chMgr     channelsMgr
 	qc        types.QueryCoord
 	ids       *schemapb.IDs
+	offset    int64
+	limit     int64
+	iterator  bool
+	cursor    *internalpb.IteratorCursor
+
+	aggregates     []*planpb.Aggregate
+	aggregateNames []string
+
+	// the output keys of the dynamic field
+	dynamicKeys []string
+
+	// forDelete is set if the query is issued by proxy to resolve the entities of a delete request,
+	// the privilege and the rate of the task are checked as a delete
+	forDelete bool
 }
 
 func (qt *queryTask) TraceCtx() context.Context {
@@ -2001,6 +2649,9 @@ func (qt *queryTask) Name() string {
 }
 
 func (qt *queryTask) Type() commonpb.MsgType {
+	if qt.forDelete {
+		return commonpb.MsgType_Delete
+	}
 	return qt.Base.MsgType
 }
 
@@ -2021,8 +2672,18 @@ func (qt *queryTask) OnEnqueue() error {
 	return nil
 }
 
+// GetCollectionName returns the name of the collection to query
+func (qt *queryTask) GetCollectionName() string {
+	return qt.query.GetCollectionName()
+}
+
+// GetDbName returns the name of the database which the collection to query belongs to
+func (qt *queryTask) GetDbName() string {
+	return qt.query.GetDbName()
+}
+
 func (qt *queryTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2041,7 +2702,7 @@ func (qt *queryTask) getChannels() ([]pChan, error) {
 }
 
 func (qt *queryTask) getVChannels() ([]vChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(qt.ctx, qt.query.DbName, qt.query.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -2059,12 +2720,207 @@ func (qt *queryTask) getVChannels() ([]vChan, error) {
 	return channels, nil
 }
 
-// IDs2Expr converts ids slices to bool expresion with specified field name
-func IDs2Expr(fieldName string, ids []int64) string {
-	idsStr := strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids)), ", "), "[]")
+// IDs2Expr converts ids to bool expresion with specified field name, the string ids are quoted
+func IDs2Expr(fieldName string, ids *schemapb.IDs) string {
+	var idsStr string
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		idsStr = strings.Trim(strings.Join(strings.Fields(fmt.Sprint(ids.GetIntId().GetData())), ", "), "[]")
+	case *schemapb.IDs_StrId:
+		strs := make([]string, 0, len(ids.GetStrId().GetData()))
+		for _, id := range ids.GetStrId().GetData() {
+			strs = append(strs, strconv.Quote(id))
+		}
+		idsStr = strings.Join(strs, ", ")
+	}
 	return fieldName + " in [ " + idsStr + " ]"
 }
 
+// parseRangeSearchParams parses the optional radius and range_filter of range search.
+// If range_filter is not set, the results are only bounded by radius.
+func parseRangeSearchParams(metricType string, kvs []*commonpb.KeyValuePair) (bool, float32, float32, error) {
+	radiusStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RadiusKey, kvs)
+	if err != nil {
+		if _, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, kvs); err == nil {
+			return false, 0, 0, errors.New(RangeFilterKey + " must be used together with " + RadiusKey)
+		}
+		return false, 0, 0, nil
+	}
+	radius, err := strconv.ParseFloat(radiusStr, 32)
+	if err != nil {
+		return false, 0, 0, errors.New(RadiusKey + " " + radiusStr + " is invalid")
+	}
+
+	metricType = strings.ToUpper(metricType)
+	if metricType != distance.L2 && metricType != distance.IP && metricType != distance.HAMMING {
+		return false, 0, 0, fmt.Errorf("range search is not supported for metric type %s", metricType)
+	}
+	positivelyRelated := distance.PositivelyRelated(metricType)
+
+	var rangeFilter float64
+	if positivelyRelated {
+		rangeFilter = math.Inf(1)
+	}
+	rangeFilterStr, err := funcutil.GetAttrByKeyFromRepeatedKV(RangeFilterKey, kvs)
+	if err == nil {
+		rangeFilter, err = strconv.ParseFloat(rangeFilterStr, 32)
+		if err != nil {
+			return false, 0, 0, errors.New(RangeFilterKey + " " + rangeFilterStr + " is invalid")
+		}
+	}
+
+	if positivelyRelated && rangeFilter <= radius {
+		return false, 0, 0, fmt.Errorf("%s must be greater than %s for metric type %s", RangeFilterKey, RadiusKey, metricType)
+	}
+	if !positivelyRelated && (rangeFilter >= radius || rangeFilter < 0) {
+		return false, 0, 0, fmt.Errorf("%s must be non-negative and less than %s for metric type %s", RangeFilterKey, RadiusKey, metricType)
+	}
+	return true, float32(radius), float32(rangeFilter), nil
+}
+
+// parseIteratorParams parses whether the request is an iterator and the cursor of the next page,
+// the cursor is nil for the first page.
+func parseIteratorParams(kvs []*commonpb.KeyValuePair) (bool, *internalpb.IteratorCursor, error) {
+	iterator := false
+	iteratorStr, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorKey, kvs)
+	if err == nil {
+		iterator, err = strconv.ParseBool(iteratorStr)
+		if err != nil {
+			return false, nil, errors.New(IteratorKey + " " + iteratorStr + " is invalid")
+		}
+	}
+	token, err := funcutil.GetAttrByKeyFromRepeatedKV(IteratorCursorKey, kvs)
+	if err != nil || token == "" {
+		return iterator, nil, nil
+	}
+	cursor, err := decodeIteratorCursor(token)
+	if err != nil {
+		return false, nil, err
+	}
+	return true, cursor, nil
+}
+
+func encodeIteratorCursor(cursor *internalpb.IteratorCursor) (string, error) {
+	bs, err := proto.Marshal(cursor)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(bs), nil
+}
+
+func decodeIteratorCursor(token string) (*internalpb.IteratorCursor, error) {
+	bs, err := base64.StdEncoding.DecodeString(token)
+	if err != nil {
+		return nil, errors.New(IteratorCursorKey + " " + token + " is invalid")
+	}
+	cursor := &internalpb.IteratorCursor{}
+	if err := proto.Unmarshal(bs, cursor); err != nil {
+		return nil, errors.New(IteratorCursorKey + " " + token + " is invalid")
+	}
+	return cursor, nil
+}
+
+// getNumOfQuery returns the number of query vectors in the placeholder group
+func getNumOfQuery(placeholderGroup []byte) (int64, error) {
+	var phg milvuspb.PlaceholderGroup
+	if err := proto.Unmarshal(placeholderGroup, &phg); err != nil {
+		return 0, err
+	}
+	var nq int64
+	for _, ph := range phg.Placeholders {
+		nq += int64(len(ph.Values))
+	}
+	return nq, nil
+}
+
+// continueFromLastDistance restricts the range of search to the results not nearer than the last distance,
+// false is returned if range search is not supported by the metric type.
+func continueFromLastDistance(metricType string, isRangeSearch bool, radius float32, rangeFilter float32, lastDistance float32) (bool, float32, float32, bool) {
+	switch strings.ToUpper(metricType) {
+	case distance.L2, distance.HAMMING:
+		if !isRangeSearch {
+			radius = float32(math.Inf(1))
+			rangeFilter = lastDistance
+		} else if lastDistance > rangeFilter {
+			rangeFilter = lastDistance
+		}
+		return true, radius, rangeFilter, true
+	case distance.IP:
+		if !isRangeSearch {
+			radius = float32(math.Inf(-1))
+			rangeFilter = lastDistance
+		} else if lastDistance < rangeFilter {
+			rangeFilter = lastDistance
+		}
+		return true, radius, rangeFilter, true
+	default:
+		return isRangeSearch, radius, rangeFilter, false
+	}
+}
+
+// addPrimaryKeyLowerBound restricts the predicates of plan to the entities whose primary keys are greater than
+// the last primary key of the cursor
+func addPrimaryKeyLowerBound(plan *planpb.PlanNode, pkField *schemapb.FieldSchema, cursor *internalpb.IteratorCursor) error {
+	value := &planpb.GenericValue{}
+	switch pk := cursor.GetLastPk().(type) {
+	case *internalpb.IteratorCursor_LastIntPk:
+		if pkField.DataType != schemapb.DataType_Int64 {
+			return errors.New(IteratorCursorKey + " doesn't match the type of primary key")
+		}
+		value.Val = &planpb.GenericValue_Int64Val{Int64Val: pk.LastIntPk}
+	case *internalpb.IteratorCursor_LastStrPk:
+		if pkField.DataType != schemapb.DataType_VarChar {
+			return errors.New(IteratorCursorKey + " doesn't match the type of primary key")
+		}
+		value.Val = &planpb.GenericValue_StringVal{StringVal: pk.LastStrPk}
+	default:
+		return errors.New(IteratorCursorKey + " has no primary key")
+	}
+	pkExpr := &planpb.Expr{
+		Expr: &planpb.Expr_UnaryRangeExpr{
+			UnaryRangeExpr: &planpb.UnaryRangeExpr{
+				ColumnInfo: &planpb.ColumnInfo{
+					FieldId:      pkField.FieldID,
+					DataType:     pkField.DataType,
+					IsPrimaryKey: true,
+				},
+				Op:    planpb.OpType_GreaterThan,
+				Value: value,
+			},
+		},
+	}
+	predicates := plan.GetPredicates()
+	if predicates == nil {
+		plan.Node = &planpb.PlanNode_Predicates{Predicates: pkExpr}
+		return nil
+	}
+	plan.Node = &planpb.PlanNode_Predicates{
+		Predicates: &planpb.Expr{
+			Expr: &planpb.Expr_BinaryExpr{
+				BinaryExpr: &planpb.BinaryExpr{
+					Op:    planpb.BinaryExpr_LogicalAnd,
+					Left:  predicates,
+					Right: pkExpr,
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// getPaginationParam parses the optional offset or limit from kv pairs, 0 is returned if not set
+func getPaginationParam(key string, kvs []*commonpb.KeyValuePair) (int64, error) {
+	valueStr, err := funcutil.GetAttrByKeyFromRepeatedKV(key, kvs)
+	if err != nil {
+		return 0, nil
+	}
+	value, err := strconv.ParseInt(valueStr, 10, 64)
+	if err != nil || value < 0 {
+		return 0, errors.New(key + " " + valueStr + " is invalid")
+	}
+	return value, nil
+}
+
 func (qt *queryTask) PreExecute(ctx context.Context) error {
 	qt.Base.MsgType = commonpb.MsgType_Retrieve
 	qt.Base.SourceID = Params.ProxyCfg.ProxyID
@@ -2079,12 +2935,13 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	log.Info("Validate collection name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 		return err
 	}
+	collectionID := collInfo.collID
 	log.Info("Get collection id by name.", zap.Any("collectionName", collectionName),
 		zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 
@@ -2106,7 +2963,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 			Timestamp: qt.Base.Timestamp,
 			SourceID:  Params.ProxyCfg.ProxyID,
 		},
-		DbID: 0, // TODO(dragondriver)
+		DbID: collInfo.dbID,
 	})
 	if err != nil {
 		return err
@@ -2129,7 +2986,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 		return fmt.Errorf("collection %v was not loaded into memory", collectionName)
 	}
 
-	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+	schema, _ := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 
 	if qt.ids != nil {
 		pkField := ""
@@ -2138,7 +2995,7 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 				pkField = field.Name
 			}
 		}
-		qt.query.Expr = IDs2Expr(pkField, qt.ids.GetIntId().Data)
+		qt.query.Expr = IDs2Expr(pkField, qt.ids)
 	}
 
 	if qt.query.Expr == "" {
@@ -2150,6 +3007,61 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
+
+	qt.offset, err = getPaginationParam(OffsetKey, qt.query.QueryParams)
+	if err != nil {
+		return err
+	}
+	qt.limit, err = getPaginationParam(LimitKey, qt.query.QueryParams)
+	if err != nil {
+		return err
+	}
+	if qt.limit > 0 {
+		// every query node returns at most offset+limit entities with the smallest primary keys
+		qt.RetrieveRequest.Limit = qt.offset + qt.limit
+	}
+
+	qt.iterator, qt.cursor, err = parseIteratorParams(qt.query.QueryParams)
+	if err != nil {
+		return err
+	}
+	if qt.iterator {
+		if qt.limit <= 0 || qt.offset > 0 {
+			return errors.New("query iterator requires " + LimitKey + " as the batch size and doesn't support " + OffsetKey)
+		}
+		helper, err := typeutil.CreateSchemaHelper(schema)
+		if err != nil {
+			return err
+		}
+		pkField, err := helper.GetPrimaryKeyField()
+		if err != nil {
+			return err
+		}
+		if qt.cursor != nil {
+			// the next page continues after the last primary key in the same snapshot
+			if err := addPrimaryKeyLowerBound(plan, pkField, qt.cursor); err != nil {
+				return err
+			}
+			qt.query.TravelTimestamp = qt.cursor.TravelTimestamp
+		}
+	}
+
+	var aggregateFieldNames []string
+	qt.aggregates, aggregateFieldNames, err = parseAggregates(qt.query.OutputFields, schema)
+	if err != nil {
+		return err
+	}
+	if len(qt.aggregates) > 0 {
+		if qt.offset > 0 || qt.limit > 0 || qt.iterator {
+			return errors.New("aggregation doesn't support pagination or iterator")
+		}
+		plan.Aggregates = qt.aggregates
+		qt.aggregateNames = qt.query.OutputFields
+		// query nodes retrieve the aggregated fields and return the partial aggregates
+		qt.query.OutputFields = aggregateFieldNames
+	}
+
+	qt.dynamicKeys = getDynamicOutputKeys(qt.query.OutputFields, schema)
 	qt.query.OutputFields, err = translateOutputFields(qt.query.OutputFields, schema, true)
 	if err != nil {
 		return err
@@ -2209,18 +3121,22 @@ func (qt *queryTask) PreExecute(ctx context.Context) error {
 	}
 	qt.TravelTimestamp = travelTimestamp
 	qt.GuaranteeTimestamp = guaranteeTimestamp
+	qt.ExpireTimestamp, err = getExpireTimestamp(ctx, qt.query.DbName, qt.query.CollectionName, qt.BeginTs())
+	if err != nil {
+		return err
+	}
 	deadline, ok := qt.TraceCtx().Deadline()
 	if ok {
 		qt.RetrieveRequest.TimeoutTimestamp = tsoutil.ComposeTSByTime(deadline, 0)
 	}
 
 	qt.ResultChannelID = Params.ProxyCfg.RetrieveResultChannelNames[0]
-	qt.DbID = 0 // todo(yukun)
+	qt.DbID = collInfo.dbID
 
 	qt.CollectionID = collectionID
 	qt.PartitionIDs = make([]UniqueID, 0)
 
-	partitionsMap, err := globalMetaCache.GetPartitions(ctx, collectionName)
+	partitionsMap, err := globalMetaCache.GetPartitions(ctx, qt.query.DbName, collectionName)
 	if err != nil {
 		log.Debug("Failed to get partitions in collection.", zap.Any("collectionName", collectionName),
 			zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
@@ -2314,31 +3230,39 @@ func (qt *queryTask) Execute(ctx context.Context) error {
 	return err
 }
 
-func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvuspb.QueryResults, error) {
-	var ret *milvuspb.QueryResults
+// mergeRetrieveResults merges the results of query nodes and removes duplicates.
+// If offset or limit is set, the entities are ordered by primary key and paginated.
+func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults, offset int64, limit int64) (*milvuspb.QueryResults, error) {
+	type retrieveRow struct {
+		pk  interface{}
+		rr  *internalpb.RetrieveResults
+		idx int64
+	}
+
+	var rows []retrieveRow
+	var fieldNum = -1
 	var skipDupCnt int64
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[interface{}]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
 		// skip empty result, it will break merge result
-		if rr == nil || rr.Ids == nil || rr.Ids.GetIntId() == nil || len(rr.Ids.GetIntId().Data) == 0 {
+		if rr == nil || typeutil.GetSizeOfIDs(rr.Ids) == 0 {
 			continue
 		}
 
-		if ret == nil {
-			ret = &milvuspb.QueryResults{
-				FieldsData: make([]*schemapb.FieldData, len(rr.FieldsData)),
-			}
+		if fieldNum == -1 {
+			fieldNum = len(rr.FieldsData)
 		}
 
-		if len(ret.FieldsData) != len(rr.FieldsData) {
-			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", len(ret.FieldsData), len(rr.FieldsData))
+		if fieldNum != len(rr.FieldsData) {
+			return nil, fmt.Errorf("mismatch FieldData in proxy RetrieveResults, expect %d get %d", fieldNum, len(rr.FieldsData))
 		}
 
-		for i, id := range rr.Ids.GetIntId().GetData() {
+		for i := 0; i < typeutil.GetSizeOfIDs(rr.Ids); i++ {
+			id := typeutil.GetPK(rr.Ids, int64(i))
 			if _, ok := idSet[id]; !ok {
-				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
+				rows = append(rows, retrieveRow{pk: id, rr: rr, idx: int64(i)})
 				idSet[id] = struct{}{}
 			} else {
 				// primary keys duplicate
@@ -2348,10 +3272,38 @@ func mergeRetrieveResults(retrieveResults []*internalpb.RetrieveResults) (*milvu
 	}
 	log.Debug("skip duplicated query result", zap.Int64("count", skipDupCnt))
 
-	if ret == nil {
-		ret = &milvuspb.QueryResults{
-			FieldsData: []*schemapb.FieldData{},
+	if offset > 0 || limit > 0 {
+		sort.Slice(rows, func(i, j int) bool {
+			switch pk := rows[i].pk.(type) {
+			case int64:
+				return pk < rows[j].pk.(int64)
+			case string:
+				return pk < rows[j].pk.(string)
+			default:
+				return false
+			}
+		})
+		if offset >= int64(len(rows)) {
+			rows = nil
+		} else {
+			rows = rows[offset:]
 		}
+		if limit > 0 && limit < int64(len(rows)) {
+			rows = rows[:limit]
+		}
+	}
+
+	if len(rows) == 0 {
+		return &milvuspb.QueryResults{
+			FieldsData: []*schemapb.FieldData{},
+		}, nil
+	}
+
+	ret := &milvuspb.QueryResults{
+		FieldsData: make([]*schemapb.FieldData, fieldNum),
+	}
+	for _, row := range rows {
+		typeutil.AppendFieldData(ret.FieldsData, row.rr.FieldsData, row.idx)
 	}
 
 	return ret, nil
@@ -2390,7 +3342,19 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 		}
 
 		var err error
-		qt.result, err = mergeRetrieveResults(filterRetrieveResults)
+		if len(qt.aggregates) > 0 {
+			qt.result, err = mergeAggregateResults(filterRetrieveResults, qt.aggregates, qt.aggregateNames)
+			if err != nil {
+				return err
+			}
+			qt.result.Status = &commonpb.Status{
+				ErrorCode: commonpb.ErrorCode_Success,
+			}
+			log.Info("Query PostExecute done", zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
+			return nil
+		}
+
+		qt.result, err = mergeRetrieveResults(filterRetrieveResults, qt.offset, qt.limit)
 		if err != nil {
 			return err
 		}
@@ -2408,7 +3372,7 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 			return nil
 		}
 
-		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.CollectionName)
+		schema, err := globalMetaCache.GetCollectionSchema(ctx, qt.query.DbName, qt.query.CollectionName)
 		if err != nil {
 			return err
 		}
@@ -2421,12 +3385,171 @@ func (qt *queryTask) PostExecute(ctx context.Context) error {
 				}
 			}
 		}
+		if err := filterDynamicFieldData(qt.result.FieldsData, common.MetaFieldName, qt.dynamicKeys); err != nil {
+			return err
+		}
+
+		if qt.iterator {
+			qt.result.IteratorCursor, err = qt.nextIteratorCursor(schema)
+			if err != nil {
+				return err
+			}
+		}
 	}
 
 	log.Info("Query PostExecute done", zap.Any("requestID", qt.Base.MsgID), zap.Any("requestType", "query"))
 	return nil
 }
 
+var aggregatePattern = regexp.MustCompile(`(?i)^(count|min|max|sum)\s*\(\s*([^()\s]*)\s*\)$`)
+
+var aggregateOps = map[string]planpb.Aggregate_AggregateOp{
+	"count": planpb.Aggregate_Count,
+	"min":   planpb.Aggregate_Min,
+	"max":   planpb.Aggregate_Max,
+	"sum":   planpb.Aggregate_Sum,
+}
+
+// parseAggregates parses the aggregates in output fields, e.g. count(*), min(age).
+// The names of the primary key and the aggregated fields are returned to be retrieved.
+// Aggregates can't be mixed with plain output fields.
+func parseAggregates(outputFields []string, schema *schemapb.CollectionSchema) ([]*planpb.Aggregate, []string, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var aggregates []*planpb.Aggregate
+	fieldNames := []string{pkField.Name}
+	for _, outputField := range outputFields {
+		matches := aggregatePattern.FindStringSubmatch(strings.TrimSpace(outputField))
+		if matches == nil {
+			continue
+		}
+		op := aggregateOps[strings.ToLower(matches[1])]
+		if op == planpb.Aggregate_Count {
+			if matches[2] != "*" {
+				return nil, nil, fmt.Errorf("only count(*) is supported, got %s", outputField)
+			}
+			aggregates = append(aggregates, &planpb.Aggregate{Op: op})
+			continue
+		}
+		field, err := helper.GetFieldFromName(matches[2])
+		if err != nil {
+			return nil, nil, err
+		}
+		switch field.DataType {
+		case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32, schemapb.DataType_Int64,
+			schemapb.DataType_Float, schemapb.DataType_Double:
+		default:
+			return nil, nil, fmt.Errorf("%s is not supported on field %s of type %s", matches[1], field.Name, field.DataType.String())
+		}
+		aggregates = append(aggregates, &planpb.Aggregate{Op: op, FieldId: field.FieldID})
+		if !funcutil.SliceContain(fieldNames, field.Name) {
+			fieldNames = append(fieldNames, field.Name)
+		}
+	}
+	if len(aggregates) > 0 && len(aggregates) != len(outputFields) {
+		return nil, nil, errors.New("aggregates can't be mixed with other output fields")
+	}
+	return aggregates, fieldNames, nil
+}
+
+// mergeAggregateResults merges the partial aggregates of query nodes, every result has a field data with
+// a single value for each aggregate. Counts and sums are added up, mins and maxes are compared.
+func mergeAggregateResults(retrieveResults []*internalpb.RetrieveResults, aggregates []*planpb.Aggregate, names []string) (*milvuspb.QueryResults, error) {
+	ret := &milvuspb.QueryResults{
+		FieldsData: make([]*schemapb.FieldData, 0, len(aggregates)),
+	}
+	for i, aggregate := range aggregates {
+		var longs []int64
+		var doubles []float64
+		dataType := schemapb.DataType_Int64
+		for _, rr := range retrieveResults {
+			if len(rr.GetFieldsData()) != len(aggregates) {
+				return nil, fmt.Errorf("mismatch aggregates in proxy RetrieveResults, expect %d get %d", len(aggregates), len(rr.GetFieldsData()))
+			}
+			partial := rr.FieldsData[i]
+			if partial.GetType() == schemapb.DataType_Double {
+				dataType = schemapb.DataType_Double
+			}
+			longs = append(longs, partial.GetScalars().GetLongData().GetData()...)
+			doubles = append(doubles, partial.GetScalars().GetDoubleData().GetData()...)
+		}
+
+		op := aggregate.Op
+		if op == planpb.Aggregate_Count {
+			// the sum of the counts
+			op = planpb.Aggregate_Sum
+		}
+		fieldData := &schemapb.FieldData{
+			Type:      dataType,
+			FieldName: names[i],
+			FieldId:   aggregate.FieldId,
+		}
+		if dataType == schemapb.DataType_Double {
+			fieldData.Field = &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: typeutil.AggregateFloat64(op, doubles)}},
+				},
+			}
+		} else {
+			fieldData.Field = &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: typeutil.AggregateInt64(op, longs)}},
+				},
+			}
+		}
+		ret.FieldsData = append(ret.FieldsData, fieldData)
+	}
+	return ret, nil
+}
+
+// nextIteratorCursor returns the cursor of the next page of query iterator, empty if the iteration is finished
+func (qt *queryTask) nextIteratorCursor(schema *schemapb.CollectionSchema) (string, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return "", err
+	}
+	pkField, err := helper.GetPrimaryKeyField()
+	if err != nil {
+		return "", err
+	}
+	for _, fieldData := range qt.result.FieldsData {
+		if fieldData.FieldId != pkField.FieldID {
+			continue
+		}
+		// the entities are ordered by primary key
+		cursor := &internalpb.IteratorCursor{TravelTimestamp: qt.TravelTimestamp}
+		var rowNum int
+		switch pkField.DataType {
+		case schemapb.DataType_Int64:
+			pks := fieldData.GetScalars().GetLongData().GetData()
+			rowNum = len(pks)
+			if rowNum > 0 {
+				cursor.LastPk = &internalpb.IteratorCursor_LastIntPk{LastIntPk: pks[rowNum-1]}
+			}
+		case schemapb.DataType_VarChar:
+			pks := fieldData.GetScalars().GetStringData().GetData()
+			rowNum = len(pks)
+			if rowNum > 0 {
+				cursor.LastPk = &internalpb.IteratorCursor_LastStrPk{LastStrPk: pks[rowNum-1]}
+			}
+		default:
+			return "", fmt.Errorf("unsupported primary key type %s", pkField.DataType.String())
+		}
+		if int64(rowNum) < qt.limit {
+			return "", nil
+		}
+		return encodeIteratorCursor(cursor)
+	}
+	return "", errors.New("primary key is not in the query result")
+}
+
 type hasCollectionTask struct {
 	Condition
 	*milvuspb.HasCollectionRequest
@@ -2469,6 +3592,7 @@ func (hct *hasCollectionTask) SetTs(ts Timestamp) {
 
 func (hct *hasCollectionTask) OnEnqueue() error {
 	hct.Base = &commonpb.MsgBase{}
+	hct.Base.MsgType = commonpb.MsgType_HasCollection
 	return nil
 }
 
@@ -2543,6 +3667,7 @@ func (dct *describeCollectionTask) SetTs(ts Timestamp) {
 
 func (dct *describeCollectionTask) OnEnqueue() error {
 	dct.Base = &commonpb.MsgBase{}
+	dct.Base.MsgType = commonpb.MsgType_DescribeCollection
 	return nil
 }
 
@@ -2586,6 +3711,8 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.Schema.Name = result.Schema.Name
 		dct.result.Schema.Description = result.Schema.Description
 		dct.result.Schema.AutoID = result.Schema.AutoID
+		dct.result.Schema.Version = result.Schema.Version
+		dct.result.Schema.EnableDynamicField = result.Schema.EnableDynamicField
 		dct.result.CollectionID = result.CollectionID
 		dct.result.VirtualChannelNames = result.VirtualChannelNames
 		dct.result.PhysicalChannelNames = result.PhysicalChannelNames
@@ -2593,8 +3720,10 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 		dct.result.CreatedUtcTimestamp = result.CreatedUtcTimestamp
 		dct.result.ShardsNum = result.ShardsNum
 		dct.result.ConsistencyLevel = result.ConsistencyLevel
+		dct.result.Properties = result.Properties
 		for _, field := range result.Schema.Fields {
-			if field.FieldID >= common.StartOfUserFieldID {
+			// the dynamic field is hidden from users
+			if field.FieldID >= common.StartOfUserFieldID && !field.IsDynamic {
 				dct.result.Schema.Fields = append(dct.result.Schema.Fields, &schemapb.FieldSchema{
 					FieldID:      field.FieldID,
 					Name:         field.Name,
@@ -2604,6 +3733,8 @@ func (dct *describeCollectionTask) Execute(ctx context.Context) error {
 					DataType:     field.DataType,
 					TypeParams:   field.TypeParams,
 					IndexParams:  field.IndexParams,
+					DefaultValue: field.DefaultValue,
+					Nullable:     field.Nullable,
 				})
 			}
 		}
@@ -2657,6 +3788,7 @@ func (g *getCollectionStatisticsTask) SetTs(ts Timestamp) {
 
 func (g *getCollectionStatisticsTask) OnEnqueue() error {
 	g.Base = &commonpb.MsgBase{}
+	g.Base.MsgType = commonpb.MsgType_GetCollectionStatistics
 	return nil
 }
 
@@ -2667,7 +3799,7 @@ func (g *getCollectionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getCollectionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -2744,6 +3876,7 @@ func (g *getPartitionStatisticsTask) SetTs(ts Timestamp) {
 
 func (g *getPartitionStatisticsTask) OnEnqueue() error {
 	g.Base = &commonpb.MsgBase{}
+	g.Base.MsgType = commonpb.MsgType_GetPartitionStatistics
 	return nil
 }
 
@@ -2754,11 +3887,11 @@ func (g *getPartitionStatisticsTask) PreExecute(ctx context.Context) error {
 }
 
 func (g *getPartitionStatisticsTask) Execute(ctx context.Context) error {
-	collID, err := globalMetaCache.GetCollectionID(ctx, g.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, g.DbName, g.CollectionName)
 	if err != nil {
 		return err
 	}
-	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.CollectionName, g.PartitionName)
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, g.DbName, g.CollectionName, g.PartitionName)
 	if err != nil {
 		return err
 	}
@@ -2837,6 +3970,7 @@ func (sct *showCollectionsTask) SetTs(ts Timestamp) {
 
 func (sct *showCollectionsTask) OnEnqueue() error {
 	sct.Base = &commonpb.MsgBase{}
+	sct.Base.MsgType = commonpb.MsgType_ShowCollections
 	return nil
 }
 
@@ -2877,7 +4011,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 		}
 		collectionIDs := make([]UniqueID, 0)
 		for _, collectionName := range sct.CollectionNames {
-			collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+			collectionID, err := globalMetaCache.GetCollectionID(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2926,7 +4060,7 @@ func (sct *showCollectionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
 				return errors.New("failed to show collections")
 			}
-			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, collectionName)
+			collectionInfo, err := globalMetaCache.GetCollectionInfo(ctx, sct.DbName, collectionName)
 			if err != nil {
 				log.Debug("Failed to get collection info.", zap.Any("collectionName", collectionName),
 					zap.Any("requestID", sct.Base.MsgID), zap.Any("requestType", "showCollections"))
@@ -2991,6 +4125,7 @@ func (cpt *createPartitionTask) SetTs(ts Timestamp) {
 
 func (cpt *createPartitionTask) OnEnqueue() error {
 	cpt.Base = &commonpb.MsgBase{}
+	cpt.Base.MsgType = commonpb.MsgType_CreatePartition
 	return nil
 }
 
@@ -3068,6 +4203,7 @@ func (dpt *dropPartitionTask) SetTs(ts Timestamp) {
 
 func (dpt *dropPartitionTask) OnEnqueue() error {
 	dpt.Base = &commonpb.MsgBase{}
+	dpt.Base.MsgType = commonpb.MsgType_DropPartition
 	return nil
 }
 
@@ -3145,6 +4281,7 @@ func (hpt *hasPartitionTask) SetTs(ts Timestamp) {
 
 func (hpt *hasPartitionTask) OnEnqueue() error {
 	hpt.Base = &commonpb.MsgBase{}
+	hpt.Base.MsgType = commonpb.MsgType_HasPartition
 	return nil
 }
 
@@ -3222,6 +4359,7 @@ func (spt *showPartitionsTask) SetTs(ts Timestamp) {
 
 func (spt *showPartitionsTask) OnEnqueue() error {
 	spt.Base = &commonpb.MsgBase{}
+	spt.Base.MsgType = commonpb.MsgType_ShowPartitions
 	return nil
 }
 
@@ -3260,7 +4398,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 
 	if spt.GetType() == milvuspb.ShowType_InMemory {
 		collectionName := spt.CollectionName
-		collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+		collectionID, err := globalMetaCache.GetCollectionID(ctx, spt.DbName, collectionName)
 		if err != nil {
 			log.Debug("Failed to get collection id.", zap.Any("collectionName", collectionName),
 				zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3273,7 +4411,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 		}
 		partitionIDs := make([]UniqueID, 0)
 		for _, partitionName := range spt.PartitionNames {
-			partitionID, err := globalMetaCache.GetPartitionID(ctx, collectionName, partitionName)
+			partitionID, err := globalMetaCache.GetPartitionID(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3321,7 +4459,7 @@ func (spt *showPartitionsTask) Execute(ctx context.Context) error {
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
 				return errors.New("failed to show partitions")
 			}
-			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, collectionName, partitionName)
+			partitionInfo, err := globalMetaCache.GetPartitionInfo(ctx, spt.DbName, collectionName, partitionName)
 			if err != nil {
 				log.Debug("Failed to get partition id.", zap.Any("partitionName", partitionName),
 					zap.Any("requestID", spt.Base.MsgID), zap.Any("requestType", "showPartitions"))
@@ -3386,6 +4524,7 @@ func (cit *createIndexTask) SetTs(ts Timestamp) {
 
 func (cit *createIndexTask) OnEnqueue() error {
 	cit.Base = &commonpb.MsgBase{}
+	cit.Base.MsgType = commonpb.MsgType_CreateIndex
 	return nil
 }
 
@@ -3500,6 +4639,7 @@ func (dit *describeIndexTask) SetTs(ts Timestamp) {
 
 func (dit *describeIndexTask) OnEnqueue() error {
 	dit.Base = &commonpb.MsgBase{}
+	dit.Base.MsgType = commonpb.MsgType_DescribeIndex
 	return nil
 }
 
@@ -3577,6 +4717,7 @@ func (dit *dropIndexTask) SetTs(ts Timestamp) {
 
 func (dit *dropIndexTask) OnEnqueue() error {
 	dit.Base = &commonpb.MsgBase{}
+	dit.Base.MsgType = commonpb.MsgType_DropIndex
 	return nil
 }
 
@@ -3661,6 +4802,7 @@ func (gibpt *getIndexBuildProgressTask) SetTs(ts Timestamp) {
 
 func (gibpt *getIndexBuildProgressTask) OnEnqueue() error {
 	gibpt.Base = &commonpb.MsgBase{}
+	gibpt.Base.MsgType = commonpb.MsgType_GetIndexBuildProgress
 	return nil
 }
 
@@ -3677,7 +4819,7 @@ func (gibpt *getIndexBuildProgressTask) PreExecute(ctx context.Context) error {
 
 func (gibpt *getIndexBuildProgressTask) Execute(ctx context.Context) error {
 	collectionName := gibpt.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gibpt.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -3882,6 +5024,7 @@ func (gist *getIndexStateTask) SetTs(ts Timestamp) {
 
 func (gist *getIndexStateTask) OnEnqueue() error {
 	gist.Base = &commonpb.MsgBase{}
+	gist.Base.MsgType = commonpb.MsgType_GetIndexState
 	return nil
 }
 
@@ -3898,7 +5041,7 @@ func (gist *getIndexStateTask) PreExecute(ctx context.Context) error {
 
 func (gist *getIndexStateTask) Execute(ctx context.Context) error {
 	collectionName := gist.CollectionName
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, gist.DbName, collectionName)
 	if err != nil { // err is not nil if collection not exists
 		return err
 	}
@@ -4088,6 +5231,7 @@ func (ft *flushTask) SetTs(ts Timestamp) {
 
 func (ft *flushTask) OnEnqueue() error {
 	ft.Base = &commonpb.MsgBase{}
+	ft.Base.MsgType = commonpb.MsgType_Flush
 	return nil
 }
 
@@ -4100,10 +5244,11 @@ func (ft *flushTask) PreExecute(ctx context.Context) error {
 func (ft *flushTask) Execute(ctx context.Context) error {
 	coll2Segments := make(map[string]*schemapb.LongArray)
 	for _, collName := range ft.CollectionNames {
-		collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+		collInfo, err := globalMetaCache.GetCollectionInfo(ctx, ft.DbName, collName)
 		if err != nil {
 			return err
 		}
+		collID := collInfo.collID
 		flushReq := &datapb.FlushRequest{
 			Base: &commonpb.MsgBase{
 				MsgType:   commonpb.MsgType_Flush,
@@ -4111,7 +5256,7 @@ func (ft *flushTask) Execute(ctx context.Context) error {
 				Timestamp: ft.Base.Timestamp,
 				SourceID:  ft.Base.SourceID,
 			},
-			DbID:         0,
+			DbID:         collInfo.dbID,
 			CollectionID: collID,
 		}
 		resp, err := ft.dataCoord.Flush(ctx, flushReq)
@@ -4180,6 +5325,7 @@ func (lct *loadCollectionTask) SetTs(ts Timestamp) {
 
 func (lct *loadCollectionTask) OnEnqueue() error {
 	lct.Base = &commonpb.MsgBase{}
+	lct.Base.MsgType = commonpb.MsgType_LoadCollection
 	return nil
 }
 
@@ -4199,11 +5345,12 @@ func (lct *loadCollectionTask) PreExecute(ctx context.Context) error {
 
 func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 	log.Debug("loadCollectionTask Execute", zap.String("role", typeutil.ProxyRole), zap.Int64("msgID", lct.Base.MsgID))
-	collID, err := globalMetaCache.GetCollectionID(ctx, lct.CollectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.CollectionName)
+	collID := collInfo.collID
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lct.DbName, lct.CollectionName)
 	if err != nil {
 		return err
 	}
@@ -4215,7 +5362,7 @@ func (lct *loadCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: lct.Base.Timestamp,
 			SourceID:  lct.Base.SourceID,
 		},
-		DbID:         0,
+		DbID:         collInfo.dbID,
 		CollectionID: collID,
 		Schema:       collSchema,
 	}
@@ -4278,6 +5425,7 @@ func (rct *releaseCollectionTask) SetTs(ts Timestamp) {
 
 func (rct *releaseCollectionTask) OnEnqueue() error {
 	rct.Base = &commonpb.MsgBase{}
+	rct.Base.MsgType = commonpb.MsgType_ReleaseCollection
 	return nil
 }
 
@@ -4295,10 +5443,11 @@ func (rct *releaseCollectionTask) PreExecute(ctx context.Context) error {
 }
 
 func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
-	collID, err := globalMetaCache.GetCollectionID(ctx, rct.CollectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, rct.DbName, rct.CollectionName)
 	if err != nil {
 		return err
 	}
+	collID := collInfo.collID
 	request := &querypb.ReleaseCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_ReleaseCollection,
@@ -4306,7 +5455,7 @@ func (rct *releaseCollectionTask) Execute(ctx context.Context) (err error) {
 			Timestamp: rct.Base.Timestamp,
 			SourceID:  rct.Base.SourceID,
 		},
-		DbID:         0,
+		DbID:         collInfo.dbID,
 		CollectionID: collID,
 	}
 
@@ -4363,6 +5512,7 @@ func (lpt *loadPartitionsTask) SetTs(ts Timestamp) {
 
 func (lpt *loadPartitionsTask) OnEnqueue() error {
 	lpt.Base = &commonpb.MsgBase{}
+	lpt.Base.MsgType = commonpb.MsgType_LoadPartitions
 	return nil
 }
 
@@ -4381,16 +5531,17 @@ func (lpt *loadPartitionsTask) PreExecute(ctx context.Context) error {
 
 func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, lpt.CollectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
-	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.CollectionName)
+	collID := collInfo.collID
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, lpt.DbName, lpt.CollectionName)
 	if err != nil {
 		return err
 	}
 	for _, partitionName := range lpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, lpt.DbName, lpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4403,7 +5554,7 @@ func (lpt *loadPartitionsTask) Execute(ctx context.Context) error {
 			Timestamp: lpt.Base.Timestamp,
 			SourceID:  lpt.Base.SourceID,
 		},
-		DbID:         0,
+		DbID:         collInfo.dbID,
 		CollectionID: collID,
 		PartitionIDs: partitionIDs,
 		Schema:       collSchema,
@@ -4458,6 +5609,7 @@ func (rpt *releasePartitionsTask) SetTs(ts Timestamp) {
 
 func (rpt *releasePartitionsTask) OnEnqueue() error {
 	rpt.Base = &commonpb.MsgBase{}
+	rpt.Base.MsgType = commonpb.MsgType_ReleasePartitions
 	return nil
 }
 
@@ -4476,12 +5628,13 @@ func (rpt *releasePartitionsTask) PreExecute(ctx context.Context) error {
 
 func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 	var partitionIDs []int64
-	collID, err := globalMetaCache.GetCollectionID(ctx, rpt.CollectionName)
+	collInfo, err := globalMetaCache.GetCollectionInfo(ctx, rpt.DbName, rpt.CollectionName)
 	if err != nil {
 		return err
 	}
+	collID := collInfo.collID
 	for _, partitionName := range rpt.PartitionNames {
-		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.CollectionName, partitionName)
+		partitionID, err := globalMetaCache.GetPartitionID(ctx, rpt.DbName, rpt.CollectionName, partitionName)
 		if err != nil {
 			return err
 		}
@@ -4494,7 +5647,7 @@ func (rpt *releasePartitionsTask) Execute(ctx context.Context) (err error) {
 			Timestamp: rpt.Base.Timestamp,
 			SourceID:  rpt.Base.SourceID,
 		},
-		DbID:         0,
+		DbID:         collInfo.dbID,
 		CollectionID: collID,
 		PartitionIDs: partitionIDs,
 	}
@@ -4518,6 +5671,9 @@ type deleteTask struct {
 	chTicker  channelsTimeTicker
 	vChannels []vChan
 	pChannels []pChan
+
+	// primary keys resolved by query if expr is a general filter expression
+	queriedPrimaryKeys *schemapb.IDs
 }
 
 func (dt *deleteTask) TraceCtx() context.Context {
@@ -4554,6 +5710,7 @@ func (dt *deleteTask) SetTs(ts Timestamp) {
 
 func (dt *deleteTask) OnEnqueue() error {
 	dt.DeleteRequest.Base = &commonpb.MsgBase{}
+	dt.DeleteRequest.Base.MsgType = commonpb.MsgType_Delete
 	return nil
 }
 
@@ -4578,7 +5735,7 @@ func (dt *deleteTask) getPChanStats() (map[pChan]pChanStatistics, error) {
 }
 
 func (dt *deleteTask) getChannels() ([]pChan, error) {
-	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.CollectionName)
+	collID, err := globalMetaCache.GetCollectionID(dt.ctx, dt.req.DbName, dt.CollectionName)
 	if err != nil {
 		return nil, err
 	}
@@ -4594,28 +5751,37 @@ func (dt *deleteTask) getChannels() ([]pChan, error) {
 	return channels, err
 }
 
-func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res []int64, err error) {
+// getPrimaryKeysFromExpr returns the primary keys listed by expr "pk in [a, b]",
+// ok is false if expr is a general filter expression which has to be resolved by query.
+func getPrimaryKeysFromExpr(schema *schemapb.CollectionSchema, expr string) (res *schemapb.IDs, ok bool, err error) {
+	res = &schemapb.IDs{}
 	if len(expr) == 0 {
 		log.Warn("empty expr")
-		return
+		return res, true, nil
 	}
 
 	plan, err := createExprPlan(schema, expr)
 	if err != nil {
-		return res, fmt.Errorf("failed to create expr plan, expr = %s", expr)
+		return res, false, fmt.Errorf("failed to create expr plan, expr = %s", expr)
 	}
 
-	// delete request only support expr "id in [a, b]"
 	termExpr, ok := plan.Node.(*planpb.PlanNode_Predicates).Predicates.Expr.(*planpb.Expr_TermExpr)
-	if !ok {
-		return res, fmt.Errorf("invalid plan node type")
+	if !ok || !termExpr.TermExpr.GetColumnInfo().GetIsPrimaryKey() {
+		return res, false, nil
 	}
 
 	for _, v := range termExpr.TermExpr.Values {
-		res = append(res, v.GetInt64Val())
+		switch val := v.GetVal().(type) {
+		case *planpb.GenericValue_Int64Val:
+			typeutil.AppendPKs(res, val.Int64Val)
+		case *planpb.GenericValue_StringVal:
+			typeutil.AppendPKs(res, val.StringVal)
+		default:
+			return res, false, fmt.Errorf("unsupported primary key value %v in expr %s", v, expr)
+		}
 	}
 
-	return res, nil
+	return res, true, nil
 }
 
 func (dt *deleteTask) PreExecute(ctx context.Context) error {
@@ -4637,7 +5803,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		log.Error("Invalid collection name", zap.String("collectionName", collName))
 		return err
 	}
-	collID, err := globalMetaCache.GetCollectionID(ctx, collName)
+	collID, err := globalMetaCache.GetCollectionID(ctx, dt.req.DbName, collName)
 	if err != nil {
 		log.Debug("Failed to get collection id", zap.String("collectionName", collName))
 		return err
@@ -4651,7 +5817,7 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 			log.Error("Invalid partition name", zap.String("partitionName", partName))
 			return err
 		}
-		partID, err := globalMetaCache.GetPartitionID(ctx, collName, partName)
+		partID, err := globalMetaCache.GetPartitionID(ctx, dt.req.DbName, collName, partName)
 		if err != nil {
 			log.Debug("Failed to get partition id", zap.String("collectionName", collName), zap.String("partitionName", partName))
 			return err
@@ -4661,31 +5827,37 @@ func (dt *deleteTask) PreExecute(ctx context.Context) error {
 		dt.DeleteRequest.PartitionID = common.InvalidPartitionID
 	}
 
-	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.CollectionName)
+	schema, err := globalMetaCache.GetCollectionSchema(ctx, dt.req.DbName, dt.req.CollectionName)
 	if err != nil {
 		log.Error("Failed to get collection schema", zap.String("collectionName", dt.req.CollectionName))
 		return err
 	}
 
-	primaryKeys, err := getPrimaryKeysFromExpr(schema, dt.req.Expr)
-	if err != nil {
-		log.Error("Failed to get primary keys from expr", zap.Error(err))
-		return err
+	primaryKeys := dt.queriedPrimaryKeys
+	if primaryKeys == nil {
+		var ok bool
+		primaryKeys, ok, err = getPrimaryKeysFromExpr(schema, dt.req.Expr)
+		if err != nil {
+			log.Error("Failed to get primary keys from expr", zap.Error(err))
+			return err
+		}
+		if !ok {
+			return fmt.Errorf("the primary keys of expr (%s) should be resolved by query", dt.req.Expr)
+		}
+	}
+	rowNum := typeutil.GetSizeOfIDs(primaryKeys)
+	log.Debug("get primary keys from expr", zap.Int("len of primary keys", rowNum))
+	if int64(rowNum) > Params.ProxyCfg.MaxDeleteCount {
+		return fmt.Errorf("the number of entities to delete (%d) exceeds the limit (%d)", rowNum, Params.ProxyCfg.MaxDeleteCount)
 	}
-	log.Debug("get primary keys from expr", zap.Int("len of primary keys", len(primaryKeys)))
 	dt.DeleteRequest.PrimaryKeys = primaryKeys
 
 	// set result
-	dt.result.IDs.IdField = &schemapb.IDs_IntId{
-		IntId: &schemapb.LongArray{
-			Data: primaryKeys,
-		},
-	}
-	dt.result.DeleteCnt = int64(len(primaryKeys))
+	dt.result.IDs = primaryKeys
+	dt.result.DeleteCnt = int64(rowNum)
 
 	dt.HashPK(primaryKeys)
 
-	rowNum := len(primaryKeys)
 	dt.Timestamps = make([]uint64, rowNum)
 	for index := range dt.Timestamps {
 		dt.Timestamps[index] = dt.BeginTs()
@@ -4722,10 +5894,27 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			return err
 		}
 	}
+	newPack := &msgstream.MsgPack{
+		BeginTs:        msgPack.BeginTs,
+		EndTs:          msgPack.EndTs,
+		StartPositions: msgPack.StartPositions,
+		EndPositions:   msgPack.EndPositions,
+		Msgs:           repackDeleteMsgByHash(ctx, dt.Base.MsgID, msgPack.Msgs, stream.ComputeProduceChannelIndexes(msgPack.Msgs)),
+	}
+
+	err = stream.Produce(newPack)
+	if err != nil {
+		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		dt.result.Status.Reason = err.Error()
+		return err
+	}
+	return nil
+}
+
+// repackDeleteMsgByHash assigns the primary keys of delete msgs to different message buckets by hash value of PK.
+func repackDeleteMsgByHash(ctx context.Context, reqID UniqueID, tsMsgs []msgstream.TsMsg, hashKeys [][]int32) []msgstream.TsMsg {
 	result := make(map[int32]msgstream.TsMsg)
-	hashKeys := stream.ComputeProduceChannelIndexes(msgPack.Msgs)
-	// For each msg, assign PK to different message buckets by hash value of PK.
-	for i, request := range msgPack.Msgs {
+	for i, request := range tsMsgs {
 		deleteRequest := request.(*msgstream.DeleteMsg)
 		keys := hashKeys[i]
 		collectionName := deleteRequest.CollectionName
@@ -4735,13 +5924,13 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 		proxyID := deleteRequest.Base.SourceID
 		for index, key := range keys {
 			ts := deleteRequest.Timestamps[index]
-			pks := deleteRequest.PrimaryKeys[index]
+			pk := typeutil.GetPK(deleteRequest.PrimaryKeys, int64(index))
 			_, ok := result[key]
 			if !ok {
 				sliceRequest := internalpb.DeleteRequest{
 					Base: &commonpb.MsgBase{
 						MsgType:   commonpb.MsgType_Delete,
-						MsgID:     dt.Base.MsgID,
+						MsgID:     reqID,
 						Timestamp: ts,
 						SourceID:  proxyID,
 					},
@@ -4749,6 +5938,7 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 					PartitionID:    partitionID,
 					CollectionName: collectionName,
 					PartitionName:  partitionName,
+					PrimaryKeys:    &schemapb.IDs{},
 				}
 				deleteMsg := &msgstream.DeleteMsg{
 					BaseMsg: msgstream.BaseMsg{
@@ -4761,46 +5951,162 @@ func (dt *deleteTask) Execute(ctx context.Context) (err error) {
 			curMsg := result[key].(*msgstream.DeleteMsg)
 			curMsg.HashValues = append(curMsg.HashValues, deleteRequest.HashValues[index])
 			curMsg.Timestamps = append(curMsg.Timestamps, ts)
-			curMsg.PrimaryKeys = append(curMsg.PrimaryKeys, pks)
+			typeutil.AppendPKs(curMsg.PrimaryKeys, pk)
 		}
 	}
 
-	newPack := &msgstream.MsgPack{
-		BeginTs:        msgPack.BeginTs,
-		EndTs:          msgPack.EndTs,
-		StartPositions: msgPack.StartPositions,
-		EndPositions:   msgPack.EndPositions,
-		Msgs:           make([]msgstream.TsMsg, 0),
-	}
-
+	msgs := make([]msgstream.TsMsg, 0, len(result))
 	for _, msg := range result {
 		if msg != nil {
-			newPack.Msgs = append(newPack.Msgs, msg)
+			msgs = append(msgs, msg)
 		}
 	}
-
-	err = stream.Produce(newPack)
-	if err != nil {
-		dt.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
-		dt.result.Status.Reason = err.Error()
-		return err
-	}
-	return nil
+	return msgs
 }
 
 func (dt *deleteTask) PostExecute(ctx context.Context) error {
 	return nil
 }
 
-func (dt *deleteTask) HashPK(pks []int64) {
+func (dt *deleteTask) HashPK(pks *schemapb.IDs) {
 	if len(dt.HashValues) != 0 {
 		log.Warn("the hashvalues passed through client is not supported now, and will be overwritten")
 	}
-	dt.HashValues = make([]uint32, 0, len(pks))
-	for _, pk := range pks {
-		hash, _ := typeutil.Hash32Int64(pk)
-		dt.HashValues = append(dt.HashValues, hash)
+	dt.HashValues = hashPKs(pks)
+}
+
+// upsertTask inserts the rows and deletes the existing rows which have the same primary keys,
+// the delete and insert messages are produced under the same timestamp.
+type upsertTask struct {
+	insertTask
+	upsertReq *milvuspb.UpsertRequest
+	deleteMsg *msgstream.DeleteMsg
+}
+
+func (ut *upsertTask) Name() string {
+	return UpsertTaskName
+}
+
+func (ut *upsertTask) Type() commonpb.MsgType {
+	return commonpb.MsgType_Upsert
+}
+
+func (ut *upsertTask) PreExecute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-PreExecute")
+	defer sp.Finish()
+
+	collSchema, err := globalMetaCache.GetCollectionSchema(ctx, ut.upsertReq.DbName, ut.upsertReq.CollectionName)
+	if err != nil {
+		return err
+	}
+	for _, field := range collSchema.Fields {
+		if field.IsPrimaryKey && field.AutoID {
+			return fmt.Errorf("upsert is not supported on collection %s with autoID primary field", ut.upsertReq.CollectionName)
+		}
+	}
+
+	if err := ut.insertTask.PreExecute(ctx); err != nil {
+		return err
+	}
+
+	primaryKeys := ut.result.IDs
+	ut.deleteMsg = &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{
+			Ctx:        ctx,
+			HashValues: ut.HashValues,
+		},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_Delete,
+				MsgID:     ut.Base.MsgID,
+				Timestamp: ut.BeginTs(),
+				SourceID:  Params.ProxyCfg.ProxyID,
+			},
+			CollectionName: ut.upsertReq.CollectionName,
+			PartitionName:  ut.upsertReq.PartitionName,
+			PartitionID:    common.InvalidPartitionID,
+			PrimaryKeys:    primaryKeys,
+			Timestamps:     ut.Timestamps,
+		},
+	}
+
+	ut.result.UpsertCnt = int64(typeutil.GetSizeOfIDs(primaryKeys))
+
+	return nil
+}
+
+func (ut *upsertTask) Execute(ctx context.Context) error {
+	sp, ctx := trace.StartSpanFromContextWithOperationName(ut.ctx, "Proxy-Upsert-Execute")
+	defer sp.Finish()
+
+	tr := timerecord.NewTimeRecorder(fmt.Sprintf("proxy execute upsert %d", ut.ID()))
+	defer tr.Elapse("done")
+
+	collectionName := ut.BaseInsertTask.CollectionName
+	collID, err := globalMetaCache.GetCollectionID(ctx, ut.upsertReq.DbName, collectionName)
+	if err != nil {
+		return err
+	}
+	ut.CollectionID = collID
+	partitionName := ut.PartitionName
+	if len(partitionName) <= 0 {
+		partitionName = Params.CommonCfg.DefaultPartitionName
+	}
+	partitionID, err := globalMetaCache.GetPartitionID(ctx, ut.upsertReq.DbName, collectionName, partitionName)
+	if err != nil {
+		return err
 	}
+	ut.PartitionID = partitionID
+	ut.deleteMsg.CollectionID = collID
+	tr.Record("get collection id & partition id from cache")
+
+	stream, err := ut.chMgr.getDMLStream(collID)
+	if err != nil {
+		err = ut.chMgr.createDMLMsgStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+		stream, err = ut.chMgr.getDMLStream(collID)
+		if err != nil {
+			ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+			ut.result.Status.Reason = err.Error()
+			return err
+		}
+	}
+	tr.Record("get used message stream")
+
+	ut.BaseMsg.Ctx = ctx
+	insertPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    []msgstream.TsMsg{&ut.BaseInsertTask},
+	}
+	insertPack, err = ut._assignSegmentID(stream, insertPack)
+	if err != nil {
+		return err
+	}
+	tr.Record("assign segment id")
+
+	deleteMsgs := []msgstream.TsMsg{ut.deleteMsg}
+	// deletes go first in the pack, the rows inserted under the same timestamp are not affected by them
+	msgPack := &msgstream.MsgPack{
+		BeginTs: ut.BeginTs(),
+		EndTs:   ut.EndTs(),
+		Msgs:    repackDeleteMsgByHash(ctx, ut.Base.MsgID, deleteMsgs, stream.ComputeProduceChannelIndexes(deleteMsgs)),
+	}
+	msgPack.Msgs = append(msgPack.Msgs, insertPack.Msgs...)
+
+	err = stream.Produce(msgPack)
+	if err != nil {
+		ut.result.Status.ErrorCode = commonpb.ErrorCode_UnexpectedError
+		ut.result.Status.Reason = err.Error()
+		return err
+	}
+	tr.Record("send upsert request to message stream")
+
+	return nil
 }
 
 // CreateAliasTask contains task information of CreateAlias
@@ -4855,6 +6161,7 @@ func (c *CreateAliasTask) SetTs(ts Timestamp) {
 // OnEnqueue defines the behavior task enqueued
 func (c *CreateAliasTask) OnEnqueue() error {
 	c.Base = &commonpb.MsgBase{}
+	c.Base.MsgType = commonpb.MsgType_CreateAlias
 	return nil
 }
 
@@ -4935,6 +6242,7 @@ func (d *DropAliasTask) SetTs(ts Timestamp) {
 
 func (d *DropAliasTask) OnEnqueue() error {
 	d.Base = &commonpb.MsgBase{}
+	d.Base.MsgType = commonpb.MsgType_DropAlias
 	return nil
 }
 
@@ -5001,6 +6309,7 @@ func (a *AlterAliasTask) SetTs(ts Timestamp) {
 
 func (a *AlterAliasTask) OnEnqueue() error {
 	a.Base = &commonpb.MsgBase{}
+	a.Base.MsgType = commonpb.MsgType_AlterAlias
 	return nil
 }
 
@@ -5031,3 +6340,426 @@ func (a *AlterAliasTask) Execute(ctx context.Context) error {
 func (a *AlterAliasTask) PostExecute(ctx context.Context) error {
 	return nil
 }
+
+// RenameCollectionTask is the task to rename collection
+type RenameCollectionTask struct {
+	Condition
+	*milvuspb.RenameCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (r *RenameCollectionTask) TraceCtx() context.Context {
+	return r.ctx
+}
+
+func (r *RenameCollectionTask) ID() UniqueID {
+	return r.Base.MsgID
+}
+
+func (r *RenameCollectionTask) SetID(uid UniqueID) {
+	r.Base.MsgID = uid
+}
+
+func (r *RenameCollectionTask) Name() string {
+	return RenameCollectionTaskName
+}
+
+func (r *RenameCollectionTask) Type() commonpb.MsgType {
+	return r.Base.MsgType
+}
+
+func (r *RenameCollectionTask) BeginTs() Timestamp {
+	return r.Base.Timestamp
+}
+
+func (r *RenameCollectionTask) EndTs() Timestamp {
+	return r.Base.Timestamp
+}
+
+func (r *RenameCollectionTask) SetTs(ts Timestamp) {
+	r.Base.Timestamp = ts
+}
+
+func (r *RenameCollectionTask) OnEnqueue() error {
+	r.Base = &commonpb.MsgBase{}
+	r.Base.MsgType = commonpb.MsgType_RenameCollection
+	return nil
+}
+
+func (r *RenameCollectionTask) PreExecute(ctx context.Context) error {
+	r.Base.MsgType = commonpb.MsgType_RenameCollection
+	r.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateCollectionName(r.OldName); err != nil {
+		return err
+	}
+	if err := validateCollectionName(r.NewName); err != nil {
+		return err
+	}
+	if r.OldName == r.NewName {
+		return fmt.Errorf("new collection name %s is the same as the old one", r.NewName)
+	}
+	return nil
+}
+
+func (r *RenameCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	r.result, err = r.rootCoord.RenameCollection(ctx, r.RenameCollectionRequest)
+	return err
+}
+
+func (r *RenameCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// AlterCollectionTask is the task to alter the properties of a collection
+type AlterCollectionTask struct {
+	Condition
+	*milvuspb.AlterCollectionRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (a *AlterCollectionTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AlterCollectionTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AlterCollectionTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AlterCollectionTask) Name() string {
+	return AlterCollectionTaskName
+}
+
+func (a *AlterCollectionTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AlterCollectionTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterCollectionTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AlterCollectionTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AlterCollectionTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AlterCollectionTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AlterCollection
+	a.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+	if len(a.Properties) == 0 {
+		return fmt.Errorf("no properties to alter for collection %s", a.CollectionName)
+	}
+	if _, err := funcutil.GetCollectionTTL(a.Properties); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (a *AlterCollectionTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AlterCollection(ctx, a.AlterCollectionRequest)
+	return err
+}
+
+func (a *AlterCollectionTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// AddFieldTask is the task to add a field to a collection
+type AddFieldTask struct {
+	Condition
+	*milvuspb.AddFieldRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (a *AddFieldTask) TraceCtx() context.Context {
+	return a.ctx
+}
+
+func (a *AddFieldTask) ID() UniqueID {
+	return a.Base.MsgID
+}
+
+func (a *AddFieldTask) SetID(uid UniqueID) {
+	a.Base.MsgID = uid
+}
+
+func (a *AddFieldTask) Name() string {
+	return AddFieldTaskName
+}
+
+func (a *AddFieldTask) Type() commonpb.MsgType {
+	return a.Base.MsgType
+}
+
+func (a *AddFieldTask) BeginTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddFieldTask) EndTs() Timestamp {
+	return a.Base.Timestamp
+}
+
+func (a *AddFieldTask) SetTs(ts Timestamp) {
+	a.Base.Timestamp = ts
+}
+
+func (a *AddFieldTask) OnEnqueue() error {
+	a.Base = &commonpb.MsgBase{}
+	return nil
+}
+
+func (a *AddFieldTask) PreExecute(ctx context.Context) error {
+	a.Base.MsgType = commonpb.MsgType_AddField
+	a.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateCollectionName(a.CollectionName); err != nil {
+		return err
+	}
+	if a.Field == nil {
+		return fmt.Errorf("no field to add to collection %s", a.CollectionName)
+	}
+	if err := validateFieldName(a.Field.Name); err != nil {
+		return err
+	}
+	if typeutil.IsVectorType(a.Field.DataType) {
+		return fmt.Errorf("vector field %s can't be added to an existing collection", a.Field.Name)
+	}
+	if a.Field.DefaultValue == nil {
+		return fmt.Errorf("default value of field %s is required", a.Field.Name)
+	}
+	return validateNullableAndDefault(a.Field)
+}
+
+func (a *AddFieldTask) Execute(ctx context.Context) error {
+	var err error
+	a.result, err = a.rootCoord.AddField(ctx, a.AddFieldRequest)
+	return err
+}
+
+func (a *AddFieldTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// CreateDatabaseTask is the task to create database
+type CreateDatabaseTask struct {
+	Condition
+	*milvuspb.CreateDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (c *CreateDatabaseTask) TraceCtx() context.Context {
+	return c.ctx
+}
+
+func (c *CreateDatabaseTask) ID() UniqueID {
+	return c.Base.MsgID
+}
+
+func (c *CreateDatabaseTask) SetID(uid UniqueID) {
+	c.Base.MsgID = uid
+}
+
+func (c *CreateDatabaseTask) Name() string {
+	return CreateDatabaseTaskName
+}
+
+func (c *CreateDatabaseTask) Type() commonpb.MsgType {
+	return c.Base.MsgType
+}
+
+func (c *CreateDatabaseTask) BeginTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) EndTs() Timestamp {
+	return c.Base.Timestamp
+}
+
+func (c *CreateDatabaseTask) SetTs(ts Timestamp) {
+	c.Base.Timestamp = ts
+}
+
+func (c *CreateDatabaseTask) OnEnqueue() error {
+	c.Base = &commonpb.MsgBase{}
+	c.Base.MsgType = commonpb.MsgType_CreateDatabase
+	return nil
+}
+
+func (c *CreateDatabaseTask) PreExecute(ctx context.Context) error {
+	c.Base.MsgType = commonpb.MsgType_CreateDatabase
+	c.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateDatabaseName(c.DbName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (c *CreateDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	c.result, err = c.rootCoord.CreateDatabase(ctx, c.CreateDatabaseRequest)
+	return err
+}
+
+func (c *CreateDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// DropDatabaseTask is the task to drop database
+type DropDatabaseTask struct {
+	Condition
+	*milvuspb.DropDatabaseRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *commonpb.Status
+}
+
+func (d *DropDatabaseTask) TraceCtx() context.Context {
+	return d.ctx
+}
+
+func (d *DropDatabaseTask) ID() UniqueID {
+	return d.Base.MsgID
+}
+
+func (d *DropDatabaseTask) SetID(uid UniqueID) {
+	d.Base.MsgID = uid
+}
+
+func (d *DropDatabaseTask) Name() string {
+	return DropDatabaseTaskName
+}
+
+func (d *DropDatabaseTask) Type() commonpb.MsgType {
+	return d.Base.MsgType
+}
+
+func (d *DropDatabaseTask) BeginTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) EndTs() Timestamp {
+	return d.Base.Timestamp
+}
+
+func (d *DropDatabaseTask) SetTs(ts Timestamp) {
+	d.Base.Timestamp = ts
+}
+
+func (d *DropDatabaseTask) OnEnqueue() error {
+	d.Base = &commonpb.MsgBase{}
+	d.Base.MsgType = commonpb.MsgType_DropDatabase
+	return nil
+}
+
+func (d *DropDatabaseTask) PreExecute(ctx context.Context) error {
+	d.Base.MsgType = commonpb.MsgType_DropDatabase
+	d.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	if err := validateDatabaseName(d.DbName); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (d *DropDatabaseTask) Execute(ctx context.Context) error {
+	var err error
+	d.result, err = d.rootCoord.DropDatabase(ctx, d.DropDatabaseRequest)
+	return err
+}
+
+func (d *DropDatabaseTask) PostExecute(ctx context.Context) error {
+	return nil
+}
+
+// ListDatabasesTask is the task to list databases
+type ListDatabasesTask struct {
+	Condition
+	*milvuspb.ListDatabasesRequest
+	ctx       context.Context
+	rootCoord types.RootCoord
+	result    *milvuspb.ListDatabasesResponse
+}
+
+func (l *ListDatabasesTask) TraceCtx() context.Context {
+	return l.ctx
+}
+
+func (l *ListDatabasesTask) ID() UniqueID {
+	return l.Base.MsgID
+}
+
+func (l *ListDatabasesTask) SetID(uid UniqueID) {
+	l.Base.MsgID = uid
+}
+
+func (l *ListDatabasesTask) Name() string {
+	return ListDatabasesTaskName
+}
+
+func (l *ListDatabasesTask) Type() commonpb.MsgType {
+	return l.Base.MsgType
+}
+
+func (l *ListDatabasesTask) BeginTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) EndTs() Timestamp {
+	return l.Base.Timestamp
+}
+
+func (l *ListDatabasesTask) SetTs(ts Timestamp) {
+	l.Base.Timestamp = ts
+}
+
+func (l *ListDatabasesTask) OnEnqueue() error {
+	l.Base = &commonpb.MsgBase{}
+	l.Base.MsgType = commonpb.MsgType_ListDatabases
+	return nil
+}
+
+func (l *ListDatabasesTask) PreExecute(ctx context.Context) error {
+	l.Base.MsgType = commonpb.MsgType_ListDatabases
+	l.Base.SourceID = Params.ProxyCfg.ProxyID
+
+	return nil
+}
+
+func (l *ListDatabasesTask) Execute(ctx context.Context) error {
+	var err error
+	l.result, err = l.rootCoord.ListDatabases(ctx, l.ListDatabasesRequest)
+	return err
+}
+
+func (l *ListDatabasesTask) PostExecute(ctx context.Context) error {
+	return nil
+}
@@ -73,6 +73,9 @@ type baseTaskQueue struct {
 
 	tsoAllocatorIns tsoAllocator
 	idAllocatorIns  idAllocatorInterface
+
+	// rateLimiter rejects the tasks over the rate limits before they are enqueued, nil means no limit
+	rateLimiter *rateLimiter
 }
 
 func (queue *baseTaskQueue) utChan() <-chan int {
@@ -178,6 +181,18 @@ func (queue *baseTaskQueue) Enqueue(t task) error {
 		return err
 	}
 
+	err = checkTaskPrivilege(t)
+	if err != nil {
+		return err
+	}
+
+	if queue.rateLimiter != nil {
+		err = queue.rateLimiter.check(t)
+		if err != nil {
+			return err
+		}
+	}
+
 	ts, err := queue.tsoAllocatorIns.AllocOne()
 	if err != nil {
 		return err
@@ -406,6 +421,14 @@ func schedOptWithRetrieveResultCh(ch chan *internalpb.RetrieveResults) schedOpt
 	}
 }
 
+func schedOptWithRateLimiter(limiter *rateLimiter) schedOpt {
+	return func(sched *taskScheduler) {
+		sched.ddQueue.rateLimiter = limiter
+		sched.dmQueue.rateLimiter = limiter
+		sched.dqQueue.rateLimiter = limiter
+	}
+}
+
 func newTaskScheduler(ctx context.Context,
 	idAllocatorIns idAllocatorInterface,
 	tsoAllocatorIns tsoAllocator,
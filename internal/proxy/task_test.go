@@ -23,6 +23,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"strconv"
 	"sync"
@@ -39,6 +40,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/distance"
@@ -212,6 +214,114 @@ func constructCollectionSchemaWithAllType(
 	}
 }
 
+// generateFieldsDataWithAllType generates the fields data of the collection constructed by constructCollectionSchemaWithAllType
+func generateFieldsDataWithAllType(
+	boolField, int32Field, int64Field, floatField, doubleField string,
+	floatVecField, binaryVecField string,
+	dim, nb int,
+) []*schemapb.FieldData {
+	return []*schemapb.FieldData{
+		{
+			Type:      schemapb.DataType_Bool,
+			FieldName: boolField,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_BoolData{
+						BoolData: &schemapb.BoolArray{
+							Data: generateBoolArray(nb),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 0,
+		},
+		{
+			Type:      schemapb.DataType_Int32,
+			FieldName: int32Field,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_IntData{
+						IntData: &schemapb.IntArray{
+							Data: generateInt32Array(nb),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 1,
+		},
+		{
+			Type:      schemapb.DataType_Int64,
+			FieldName: int64Field,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{
+						LongData: &schemapb.LongArray{
+							Data: generateInt64Array(nb),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 2,
+		},
+		{
+			Type:      schemapb.DataType_Float,
+			FieldName: floatField,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_FloatData{
+						FloatData: &schemapb.FloatArray{
+							Data: generateFloat32Array(nb),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 3,
+		},
+		{
+			Type:      schemapb.DataType_Double,
+			FieldName: doubleField,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_DoubleData{
+						DoubleData: &schemapb.DoubleArray{
+							Data: generateFloat64Array(nb),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 4,
+		},
+		{
+			Type:      schemapb.DataType_FloatVector,
+			FieldName: floatVecField,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: int64(dim),
+					Data: &schemapb.VectorField_FloatVector{
+						FloatVector: &schemapb.FloatArray{
+							Data: generateFloatVectors(nb, dim),
+						},
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 5,
+		},
+		{
+			Type:      schemapb.DataType_BinaryVector,
+			FieldName: binaryVecField,
+			Field: &schemapb.FieldData_Vectors{
+				Vectors: &schemapb.VectorField{
+					Dim: int64(dim),
+					Data: &schemapb.VectorField_BinaryVector{
+						BinaryVector: generateBinaryVectors(nb, dim),
+					},
+				},
+			},
+			FieldId: common.StartOfUserFieldID + 6,
+		},
+	}
+}
+
 func constructPlaceholderGroup(
 	nq, dim int,
 ) *milvuspb.PlaceholderGroup {
@@ -501,6 +611,133 @@ func TestInsertTask_checkLengthOfFieldsData(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestInsertTask_fillFieldsData(t *testing.T) {
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name: "TestInsertTask_fillFieldsData",
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64, Nullable: true},
+				{
+					FieldID:      102,
+					Name:         "score",
+					DataType:     schemapb.DataType_Int32,
+					DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 5}},
+				},
+				{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Bool, "flag", 2),
+				{
+					Type:      schemapb.DataType_Int64,
+					FieldName: "age",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{1, 0}}},
+						},
+					},
+					ValidData: []bool{true, false},
+				},
+			},
+		},
+	}
+	assert.NoError(t, it.fillFieldsData())
+	assert.Equal(t, 3, len(it.req.FieldsData))
+	assert.Equal(t, "age", it.req.FieldsData[0].GetFieldName())
+	assert.Equal(t, []int32{5, 5}, it.req.FieldsData[1].GetScalars().GetIntData().GetData())
+	assert.Equal(t, "flag", it.req.FieldsData[2].GetFieldName())
+	assert.Equal(t, 1, len(it.ValidData))
+	assert.Equal(t, int64(101), it.ValidData[0].GetFieldID())
+	assert.Equal(t, []bool{true, false}, it.ValidData[0].GetValidData())
+
+	// the field is not nullable
+	it.ValidData = nil
+	it.req.FieldsData[2].ValidData = []bool{true, false}
+	assert.Error(t, it.fillFieldsData())
+
+	// the number of valid data mismatches
+	it.ValidData = nil
+	it.req.FieldsData[2].ValidData = nil
+	it.req.FieldsData[0].ValidData = []bool{true}
+	assert.Error(t, it.fillFieldsData())
+}
+
+func TestInsertTask_fillFieldsData_dynamicField(t *testing.T) {
+	it := insertTask{
+		schema: &schemapb.CollectionSchema{
+			Name:               "TestInsertTask_fillFieldsData_dynamicField",
+			EnableDynamicField: true,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: true, DataType: schemapb.DataType_Int64},
+				{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int64},
+				{FieldID: 102, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+			},
+		},
+		req: &milvuspb.InsertRequest{
+			NumRows: 2,
+			FieldsData: []*schemapb.FieldData{
+				newScalarFieldData(schemapb.DataType_Int64, "age", 2),
+				{
+					Type:      schemapb.DataType_VarChar,
+					FieldName: "color",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: []string{"red", ""}}},
+						},
+					},
+					ValidData: []bool{true, false},
+				},
+				{
+					Type:      schemapb.DataType_JSON,
+					FieldName: "size",
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{"width":1}`), []byte(`2`)}}},
+						},
+					},
+				},
+			},
+		},
+	}
+	assert.NoError(t, it.fillFieldsData())
+	assert.Equal(t, 2, len(it.req.FieldsData))
+	assert.Equal(t, "age", it.req.FieldsData[0].GetFieldName())
+	assert.Equal(t, common.MetaFieldName, it.req.FieldsData[1].GetFieldName())
+	assert.Equal(t, int64(102), it.req.FieldsData[1].GetFieldId())
+	docs := it.req.FieldsData[1].GetScalars().GetJsonData().GetData()
+	assert.Equal(t, `{"color":"red","size":{"width":1}}`, string(docs[0]))
+	assert.Equal(t, `{"size":2}`, string(docs[1]))
+
+	// the rows without undeclared fields get empty documents
+	it.req.FieldsData = []*schemapb.FieldData{newScalarFieldData(schemapb.DataType_Int64, "age", 2)}
+	assert.NoError(t, it.fillFieldsData())
+	assert.Equal(t, [][]byte{[]byte(`{}`), []byte(`{}`)}, it.req.FieldsData[1].GetScalars().GetJsonData().GetData())
+
+	// vector fields can't be dynamic
+	it.req.FieldsData = []*schemapb.FieldData{
+		newScalarFieldData(schemapb.DataType_Int64, "age", 2),
+		newFloatVectorFieldData("vec", 2, 8),
+	}
+	assert.Error(t, it.fillFieldsData())
+
+	// the name of the dynamic field is reserved
+	it.req.FieldsData = []*schemapb.FieldData{
+		newScalarFieldData(schemapb.DataType_Int64, "age", 2),
+		newScalarFieldData(schemapb.DataType_Int64, common.MetaFieldName, 2),
+	}
+	assert.Error(t, it.fillFieldsData())
+
+	// the number of rows mismatches
+	it.req.FieldsData = []*schemapb.FieldData{
+		newScalarFieldData(schemapb.DataType_Int64, "age", 2),
+		newScalarFieldData(schemapb.DataType_Int64, "score", 3),
+	}
+	assert.Error(t, it.fillFieldsData())
+}
+
 func TestInsertTask_checkRowNums(t *testing.T) {
 	var err error
 
@@ -792,6 +1029,54 @@ func TestTranslateOutputFields(t *testing.T) {
 	assert.ElementsMatch(t, []string{idFieldName, floatVectorFieldName, binaryVectorFieldName}, outputFields)
 }
 
+func TestTranslateOutputFields_dynamicField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:               "TestTranslateOutputFields_dynamicField",
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "id", DataType: schemapb.DataType_Int64, IsPrimaryKey: true},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+			{FieldID: 102, Name: common.MetaFieldName, DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+
+	outputFields, err := translateOutputFields([]string{"color", "size"}, schema, true)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"id", common.MetaFieldName}, outputFields)
+	assert.Equal(t, []string{"color", "size"}, getDynamicOutputKeys([]string{"id", "color", "size"}, schema))
+
+	outputFields, err = translateOutputFields([]string{"*"}, schema, false)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"id", common.MetaFieldName}, outputFields)
+	assert.Nil(t, getDynamicOutputKeys([]string{"*", "color"}, schema))
+	assert.Nil(t, getDynamicOutputKeys([]string{common.MetaFieldName, "color"}, schema))
+
+	fieldsData := []*schemapb.FieldData{
+		{
+			FieldName: common.MetaFieldName,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: [][]byte{
+						[]byte(`{"color":"red","size":1,"weight":2}`),
+						[]byte(`{"weight":3}`),
+					}}},
+				},
+			},
+		},
+	}
+	assert.Nil(t, filterDynamicFieldData(fieldsData, common.MetaFieldName, []string{"color", "size"}))
+	docs := fieldsData[0].GetScalars().GetJsonData().GetData()
+	assert.Equal(t, `{"color":"red","size":1}`, string(docs[0]))
+	assert.Equal(t, `{}`, string(docs[1]))
+
+	// the undeclared names are kept as they are without the dynamic field
+	schema.Fields = schema.Fields[:2]
+	outputFields, err = translateOutputFields([]string{"color"}, schema, false)
+	assert.Nil(t, err)
+	assert.ElementsMatch(t, []string{"color"}, outputFields)
+	assert.Nil(t, getDynamicOutputKeys([]string{"color"}, schema))
+}
+
 func TestSearchTask(t *testing.T) {
 	ctx := context.Background()
 	ctxCancel, cancel := context.WithCancel(ctx)
@@ -995,6 +1280,14 @@ func TestCreateCollectionTask(t *testing.T) {
 		assert.Error(t, err)
 		task.ShardsNum = shardsNum
 
+		task.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "-1"}}
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
+		task.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "604800"}}
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
+		task.Properties = nil
+
 		reqBackup := proto.Clone(task.CreateCollectionRequest).(*milvuspb.CreateCollectionRequest)
 		schemaBackup := proto.Clone(schema).(*schemapb.CollectionSchema)
 
@@ -1158,6 +1451,31 @@ func TestCreateCollectionTask(t *testing.T) {
 		} else {
 			assert.Error(t, err)
 		}
+
+		// the dynamic field is added by enable_dynamic_field
+		schema = proto.Clone(schemaBackup).(*schemapb.CollectionSchema)
+		schema.EnableDynamicField = true
+		dynamicSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		task.CreateCollectionRequest.Schema = dynamicSchema
+		err = task.PreExecute(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, len(schemaBackup.Fields)+1, len(task.schema.Fields))
+		dynamicField := task.schema.Fields[len(task.schema.Fields)-1]
+		assert.Equal(t, common.MetaFieldName, dynamicField.Name)
+		assert.Equal(t, schemapb.DataType_JSON, dynamicField.DataType)
+		assert.True(t, dynamicField.IsDynamic)
+		createdSchema := &schemapb.CollectionSchema{}
+		assert.NoError(t, proto.Unmarshal(task.CreateCollectionRequest.Schema, createdSchema))
+		assert.True(t, proto.Equal(task.schema, createdSchema))
+
+		schema.EnableDynamicField = false
+		schema.Fields[0].IsDynamic = true
+		userDynamicSchema, err := proto.Marshal(schema)
+		assert.NoError(t, err)
+		task.CreateCollectionRequest.Schema = userDynamicSchema
+		err = task.PreExecute(ctx)
+		assert.Error(t, err)
 	})
 }
 
@@ -1229,7 +1547,7 @@ func TestDropCollectionTask(t *testing.T) {
 	assert.NotNil(t, err)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1307,7 +1625,7 @@ func TestHasCollectionTask(t *testing.T) {
 	assert.Equal(t, false, task.result.Value)
 	// createCollection in RootCood and fill GlobalMetaCache
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// success to drop collection
 	err = task.Execute(ctx)
@@ -1420,7 +1738,7 @@ func TestDescribeCollectionTask_ShardsNum1(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1479,7 +1797,7 @@ func TestDescribeCollectionTask_ShardsNum2(t *testing.T) {
 	}
 
 	rc.CreateCollection(ctx, createColReq)
-	globalMetaCache.GetCollectionID(ctx, collectionName)
+	globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	//CreateCollection
 	task := &describeCollectionTask{
@@ -1774,7 +2092,7 @@ func TestSearchTask_all(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2120,7 +2438,7 @@ func TestSearchTaskWithInvalidRoundDecimal(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2460,7 +2778,7 @@ func TestSearchTask_7803_reduce(t *testing.T) {
 	defer chMgr.removeAllDMLStream()
 	defer chMgr.removeAllDQLStream()
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -2824,7 +3142,7 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	assert.NoError(t, createColT.Execute(ctx))
 	assert.NoError(t, createColT.PostExecute(ctx))
 
-	collectionID, _ := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, _ := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 
 	// validateCollectionName
 	task.query.CollectionName = "$"
@@ -3021,6 +3339,14 @@ func TestSearchTask_PreExecute(t *testing.T) {
 	// after preExecute
 	assert.Greater(t, task.TimeoutTimestamp, typeutil.ZeroTimestamp)
 
+	// topk + offset exceeds the max topk
+	searchParams := task.query.SearchParams
+	task.query.SearchParams = append(searchParams, &commonpb.KeyValuePair{Key: OffsetKey, Value: strconv.Itoa(maxTopK)})
+	assert.Error(t, task.PreExecute(ctx))
+	task.query.SearchParams = append(searchParams, &commonpb.KeyValuePair{Key: OffsetKey, Value: strconv.Itoa(maxTopK - 10)})
+	assert.NoError(t, task.PreExecute(ctx))
+	task.query.SearchParams = searchParams
+
 	// field not exist
 	task.query.OutputFields = []string{int64Field + funcutil.GenRandomStr()}
 	assert.Error(t, task.PreExecute(ctx))
@@ -3163,7 +3489,7 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, metricType, 0, topk)
 		assert.Nil(t, err)
 		assert.Equal(t, ids, res.Results.Ids.GetIntId().Data)
 		assert.Equal(t, []float32{1.0, 2.0, 3.0, 4.0}, res.Results.Scores)
@@ -3178,77 +3504,628 @@ func TestSearchTask_Reduce(t *testing.T) {
 		dataArray := make([]*schemapb.SearchResultData, 0)
 		dataArray = append(dataArray, data1)
 		dataArray = append(dataArray, data2)
-		res, err := reduceSearchResultData(dataArray, nq, topk, metricType)
+		res, err := reduceSearchResultData(dataArray, nq, topk, metricType, 0, topk)
 		assert.Nil(t, err)
 		assert.ElementsMatch(t, []int64{1, 5, 2, 3}, res.Results.Ids.GetIntId().Data)
 	})
+	t.Run("with offset", func(t *testing.T) {
+		ids := []int64{1, 2, 3, 4}
+		scores := []float32{-1.0, -2.0, -3.0, -4.0}
+		data1 := genSearchResultData(nq, topk, ids, scores)
+		data2 := genSearchResultData(nq, topk, ids, scores)
+		dataArray := []*schemapb.SearchResultData{data1, data2}
+		res, err := reduceSearchResultData(dataArray, nq, topk, metricType, 2, topk-2)
+		assert.Nil(t, err)
+		assert.Equal(t, []int64{3, 4}, res.Results.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{3.0, 4.0}, res.Results.Scores)
+		assert.Equal(t, []int64{2}, res.Results.Topks)
+
+		res, err = reduceSearchResultData(dataArray, nq, topk, metricType, 5, topk-5)
+		assert.Nil(t, err)
+		assert.Equal(t, 0, len(res.Results.Ids.GetIntId().Data))
+		assert.Equal(t, []int64{0}, res.Results.Topks)
+	})
+	t.Run("varchar primary key", func(t *testing.T) {
+		genStrSearchResultData := func(ids []string, scores []float32) *schemapb.SearchResultData {
+			data := genSearchResultData(nq, topk, nil, scores)
+			data.Ids = &schemapb.IDs{
+				IdField: &schemapb.IDs_StrId{
+					StrId: &schemapb.StringArray{
+						Data: ids,
+					},
+				},
+			}
+			return data
+		}
+		data1 := genStrSearchResultData([]string{"a", "b", "c", ""}, []float32{-1.0, -2.0, -3.0, -4.0})
+		data2 := genStrSearchResultData([]string{"e", "a", "c", "d"}, []float32{-1.0, -1.0, -3.0, -4.0})
+		res, err := reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, nq, topk, metricType, 0, topk)
+		assert.Nil(t, err)
+		assert.ElementsMatch(t, []string{"a", "e", "b", "c"}, res.Results.Ids.GetStrId().Data)
+	})
 }
 
-func TestQueryTask_all(t *testing.T) {
-	var err error
+func TestSearchTask_ReduceRangeSearch(t *testing.T) {
+	const (
+		nq         = 2
+		topk       = 4
+		metricType = "L2"
+	)
+	genRangeSearchResultData := func(ids []int64, scores []float32, topks []int64) *schemapb.SearchResultData {
+		data := genSearchResultData(nq, topk, ids, scores)
+		data.Topks = topks
+		return data
+	}
 
-	Params.Init()
-	Params.ProxyCfg.RetrieveResultChannelNames = []string{funcutil.GenRandomStr()}
+	data1 := genRangeSearchResultData([]int64{1, 2, 3}, []float32{-1.0, -3.0, -2.0}, []int64{2, 1})
+	data2 := genRangeSearchResultData([]int64{4, 5}, []float32{-2.0, -1.5}, []int64{1, 1})
+	res, err := reduceSearchResultData([]*schemapb.SearchResultData{data1, data2}, nq, topk, metricType, 0, topk)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{3, 2}, res.Results.Topks)
+	assert.Equal(t, int64(3), res.Results.TopK)
+	assert.Equal(t, []int64{1, 4, 2, 5, 3}, res.Results.Ids.GetIntId().Data)
+	assert.Equal(t, []float32{1.0, 2.0, 3.0, 1.5, 2.0}, res.Results.Scores)
+
+	// topks exceed topk
+	data3 := genRangeSearchResultData([]int64{1, 2, 3, 4, 5}, []float32{-1.0, -2.0, -3.0, -4.0, -5.0}, []int64{5, 0})
+	_, err = reduceSearchResultData([]*schemapb.SearchResultData{data3}, nq, topk, metricType, 0, topk)
+	assert.Error(t, err)
 
-	rc := NewRootCoordMock()
-	rc.Start()
-	defer rc.Stop()
+	// range search without topk asks for an extra result to detect the truncation
+	assert.NoError(t, checkRangeSearchTruncated(res.Results, 3))
+	assert.Error(t, checkRangeSearchTruncated(res.Results, 2))
+}
 
-	ctx := context.Background()
+func TestParseRangeSearchParams(t *testing.T) {
+	genKVs := func(kvs map[string]string) []*commonpb.KeyValuePair {
+		ret := make([]*commonpb.KeyValuePair, 0)
+		for k, v := range kvs {
+			ret = append(ret, &commonpb.KeyValuePair{Key: k, Value: v})
+		}
+		return ret
+	}
 
-	err = InitMetaCache(rc)
+	isRangeSearch, _, _, err := parseRangeSearchParams("L2", genKVs(map[string]string{TopKKey: "10"}))
 	assert.NoError(t, err)
+	assert.False(t, isRangeSearch)
 
-	shardsNum := int32(2)
-	prefix := "TestQueryTask_all"
-	dbName := ""
-	collectionName := prefix + funcutil.GenRandomStr()
-	boolField := "bool"
-	int32Field := "int32"
-	int64Field := "int64"
-	floatField := "float"
-	doubleField := "double"
-	floatVecField := "fvec"
-	binaryVecField := "bvec"
-	fieldsLen := len([]string{boolField, int32Field, int64Field, floatField, doubleField, floatVecField, binaryVecField})
-	dim := 128
-	expr := fmt.Sprintf("%s > 0", int64Field)
-	hitNum := 10
+	isRangeSearch, radius, rangeFilter, err := parseRangeSearchParams("L2", genKVs(map[string]string{RadiusKey: "2.5"}))
+	assert.NoError(t, err)
+	assert.True(t, isRangeSearch)
+	assert.Equal(t, float32(2.5), radius)
+	assert.Equal(t, float32(0), rangeFilter)
 
-	schema := constructCollectionSchemaWithAllType(
-		boolField, int32Field, int64Field, floatField, doubleField,
-		floatVecField, binaryVecField, dim, collectionName)
-	marshaledSchema, err := proto.Marshal(schema)
+	isRangeSearch, radius, rangeFilter, err = parseRangeSearchParams("IP", genKVs(map[string]string{RadiusKey: "0.5", RangeFilterKey: "0.9"}))
 	assert.NoError(t, err)
+	assert.True(t, isRangeSearch)
+	assert.Equal(t, float32(0.5), radius)
+	assert.Equal(t, float32(0.9), rangeFilter)
 
-	createColT := &createCollectionTask{
-		Condition: NewTaskCondition(ctx),
-		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
-			Base:           nil,
-			DbName:         dbName,
-			CollectionName: collectionName,
-			Schema:         marshaledSchema,
-			ShardsNum:      shardsNum,
-		},
-		ctx:       ctx,
-		rootCoord: rc,
-		result:    nil,
-		schema:    nil,
-	}
+	_, _, rangeFilter, err = parseRangeSearchParams("IP", genKVs(map[string]string{RadiusKey: "0.5"}))
+	assert.NoError(t, err)
+	assert.True(t, math.IsInf(float64(rangeFilter), 1))
 
-	assert.NoError(t, createColT.OnEnqueue())
-	assert.NoError(t, createColT.PreExecute(ctx))
-	assert.NoError(t, createColT.Execute(ctx))
-	assert.NoError(t, createColT.PostExecute(ctx))
+	_, _, _, err = parseRangeSearchParams("L2", genKVs(map[string]string{RangeFilterKey: "1"}))
+	assert.Error(t, err)
 
-	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
-	query := newMockGetChannelsService()
-	factory := newSimpleMockMsgStreamFactory()
-	chMgr := newChannelsMgrImpl(dmlChannelsFunc, nil, query.GetChannels, nil, factory)
-	defer chMgr.removeAllDMLStream()
-	defer chMgr.removeAllDQLStream()
+	_, _, _, err = parseRangeSearchParams("L2", genKVs(map[string]string{RadiusKey: "abc"}))
+	assert.Error(t, err)
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	_, _, _, err = parseRangeSearchParams("L2", genKVs(map[string]string{RadiusKey: "1", RangeFilterKey: "abc"}))
+	assert.Error(t, err)
+
+	_, _, _, err = parseRangeSearchParams("L2", genKVs(map[string]string{RadiusKey: "1", RangeFilterKey: "2"}))
+	assert.Error(t, err)
+
+	_, _, _, err = parseRangeSearchParams("IP", genKVs(map[string]string{RadiusKey: "1", RangeFilterKey: "0.5"}))
+	assert.Error(t, err)
+
+	_, _, _, err = parseRangeSearchParams("JACCARD", genKVs(map[string]string{RadiusKey: "1"}))
+	assert.Error(t, err)
+}
+
+func TestParseIteratorParams(t *testing.T) {
+	iterator, cursor, err := parseIteratorParams(nil)
+	assert.NoError(t, err)
+	assert.False(t, iterator)
+	assert.Nil(t, cursor)
+
+	iterator, cursor, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "true"}})
+	assert.NoError(t, err)
+	assert.True(t, iterator)
+	assert.Nil(t, cursor)
+
+	_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorKey, Value: "abc"}})
+	assert.Error(t, err)
+
+	token, err := encodeIteratorCursor(&internalpb.IteratorCursor{
+		TravelTimestamp: 100,
+		LastPk:          &internalpb.IteratorCursor_LastIntPk{LastIntPk: 10},
+	})
+	assert.NoError(t, err)
+	iterator, cursor, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorCursorKey, Value: token}})
+	assert.NoError(t, err)
+	assert.True(t, iterator)
+	assert.Equal(t, uint64(100), cursor.TravelTimestamp)
+	assert.Equal(t, int64(10), cursor.GetLastIntPk())
+
+	token, err = encodeIteratorCursor(&internalpb.IteratorCursor{
+		TravelTimestamp: 100,
+		LastPk:          &internalpb.IteratorCursor_LastStrPk{LastStrPk: "abc"},
+	})
+	assert.NoError(t, err)
+	_, cursor, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorCursorKey, Value: token}})
+	assert.NoError(t, err)
+	assert.Equal(t, "abc", cursor.GetLastStrPk())
+
+	_, _, err = parseIteratorParams([]*commonpb.KeyValuePair{{Key: IteratorCursorKey, Value: "!@#"}})
+	assert.Error(t, err)
+}
+
+func TestContinueFromLastDistance(t *testing.T) {
+	isRangeSearch, radius, rangeFilter, guarded := continueFromLastDistance("L2", false, 0, 0, 1.5)
+	assert.True(t, isRangeSearch)
+	assert.True(t, guarded)
+	assert.True(t, math.IsInf(float64(radius), 1))
+	assert.Equal(t, float32(1.5), rangeFilter)
+
+	_, radius, rangeFilter, _ = continueFromLastDistance("L2", true, 10, 2, 1.5)
+	assert.Equal(t, float32(10), radius)
+	assert.Equal(t, float32(2), rangeFilter)
+
+	_, radius, rangeFilter, _ = continueFromLastDistance("IP", false, 0, 0, 0.8)
+	assert.True(t, math.IsInf(float64(radius), -1))
+	assert.Equal(t, float32(0.8), rangeFilter)
+
+	_, _, rangeFilter, _ = continueFromLastDistance("IP", true, 0.1, float32(math.Inf(1)), 0.8)
+	assert.Equal(t, float32(0.8), rangeFilter)
+
+	isRangeSearch, _, _, guarded = continueFromLastDistance("JACCARD", false, 0, 0, 0.8)
+	assert.False(t, isRangeSearch)
+	assert.False(t, guarded)
+}
+
+func TestAddPrimaryKeyLowerBound(t *testing.T) {
+	pkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64}
+
+	plan := &planpb.PlanNode{}
+	err := addPrimaryKeyLowerBound(plan, pkField, &internalpb.IteratorCursor{LastPk: &internalpb.IteratorCursor_LastIntPk{LastIntPk: 10}})
+	assert.NoError(t, err)
+	unaryRangeExpr := plan.GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, int64(100), unaryRangeExpr.GetColumnInfo().GetFieldId())
+	assert.Equal(t, planpb.OpType_GreaterThan, unaryRangeExpr.GetOp())
+	assert.Equal(t, int64(10), unaryRangeExpr.GetValue().GetInt64Val())
+
+	err = addPrimaryKeyLowerBound(plan, pkField, &internalpb.IteratorCursor{LastPk: &internalpb.IteratorCursor_LastIntPk{LastIntPk: 20}})
+	assert.NoError(t, err)
+	binaryExpr := plan.GetPredicates().GetBinaryExpr()
+	assert.Equal(t, planpb.BinaryExpr_LogicalAnd, binaryExpr.GetOp())
+	assert.Equal(t, int64(10), binaryExpr.GetLeft().GetUnaryRangeExpr().GetValue().GetInt64Val())
+	assert.Equal(t, int64(20), binaryExpr.GetRight().GetUnaryRangeExpr().GetValue().GetInt64Val())
+
+	// the cursor of a varchar primary key
+	err = addPrimaryKeyLowerBound(plan, pkField, &internalpb.IteratorCursor{LastPk: &internalpb.IteratorCursor_LastStrPk{LastStrPk: "abc"}})
+	assert.Error(t, err)
+	err = addPrimaryKeyLowerBound(plan, pkField, &internalpb.IteratorCursor{})
+	assert.Error(t, err)
+
+	varCharPkField := &schemapb.FieldSchema{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar}
+	plan = &planpb.PlanNode{}
+	err = addPrimaryKeyLowerBound(plan, varCharPkField, &internalpb.IteratorCursor{LastPk: &internalpb.IteratorCursor_LastStrPk{LastStrPk: "abc"}})
+	assert.NoError(t, err)
+	unaryRangeExpr = plan.GetPredicates().GetUnaryRangeExpr()
+	assert.Equal(t, schemapb.DataType_VarChar, unaryRangeExpr.GetColumnInfo().GetDataType())
+	assert.Equal(t, planpb.OpType_GreaterThan, unaryRangeExpr.GetOp())
+	assert.Equal(t, "abc", unaryRangeExpr.GetValue().GetStringVal())
+	err = addPrimaryKeyLowerBound(plan, varCharPkField, &internalpb.IteratorCursor{LastPk: &internalpb.IteratorCursor_LastIntPk{LastIntPk: 10}})
+	assert.Error(t, err)
+}
+
+func TestSearchTask_nextIteratorCursor(t *testing.T) {
+	st := &searchTask{
+		SearchRequest: &internalpb.SearchRequest{TravelTimestamp: 100},
+		topk:          3,
+		result: &milvuspb.SearchResults{
+			Results: &schemapb.SearchResultData{
+				Scores: []float32{0.1, 0.2, 0.2},
+				Topks:  []int64{3},
+			},
+		},
+	}
+	token, err := st.nextIteratorCursor()
+	assert.NoError(t, err)
+	cursor, err := decodeIteratorCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), cursor.TravelTimestamp)
+	assert.Equal(t, float32(0.2), cursor.LastDistance)
+	assert.Equal(t, int64(3), cursor.ReturnedCount)
+	assert.Equal(t, int64(2), cursor.TieCount)
+
+	// all the results are at the last distance of the previous page
+	st.cursor = cursor
+	st.result.Results.Scores = []float32{0.2, 0.2, 0.2}
+	token, err = st.nextIteratorCursor()
+	assert.NoError(t, err)
+	cursor, err = decodeIteratorCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(6), cursor.ReturnedCount)
+	assert.Equal(t, int64(5), cursor.TieCount)
+
+	// the last page
+	st.result.Results.Topks = []int64{2}
+	st.result.Results.Scores = []float32{0.3, 0.4}
+	token, err = st.nextIteratorCursor()
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+}
+
+func TestQueryTask_nextIteratorCursor(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_query_iterator",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	qt := &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{TravelTimestamp: 100},
+		limit:           2,
+		result: &milvuspb.QueryResults{
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_Int64,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{Data: []int64{3, 5}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	token, err := qt.nextIteratorCursor(schema)
+	assert.NoError(t, err)
+	cursor, err := decodeIteratorCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), cursor.TravelTimestamp)
+	assert.Equal(t, int64(5), cursor.GetLastIntPk())
+
+	qt.limit = 3
+	token, err = qt.nextIteratorCursor(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+
+	qt.result.FieldsData[0].FieldId = 101
+	_, err = qt.nextIteratorCursor(schema)
+	assert.Error(t, err)
+}
+
+func TestQueryTask_nextIteratorCursorVarCharPk(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_query_iterator",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_VarChar},
+			{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+		},
+	}
+	qt := &queryTask{
+		RetrieveRequest: &internalpb.RetrieveRequest{TravelTimestamp: 100},
+		limit:           2,
+		result: &milvuspb.QueryResults{
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type:    schemapb.DataType_VarChar,
+					FieldId: 100,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_StringData{
+								StringData: &schemapb.StringArray{Data: []string{"a", "b"}},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	token, err := qt.nextIteratorCursor(schema)
+	assert.NoError(t, err)
+	cursor, err := decodeIteratorCursor(token)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(100), cursor.TravelTimestamp)
+	assert.Equal(t, "b", cursor.GetLastStrPk())
+
+	// the next page continues after the last primary key
+	plan := &planpb.PlanNode{}
+	err = addPrimaryKeyLowerBound(plan, schema.Fields[0], cursor)
+	assert.NoError(t, err)
+	assert.Equal(t, "b", plan.GetPredicates().GetUnaryRangeExpr().GetValue().GetStringVal())
+
+	qt.limit = 3
+	token, err = qt.nextIteratorCursor(schema)
+	assert.NoError(t, err)
+	assert.Equal(t, "", token)
+}
+
+func TestParseAggregates(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_aggregate",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool},
+		},
+	}
+
+	aggregates, fieldNames, err := parseAggregates([]string{"pk", "age"}, schema)
+	assert.NoError(t, err)
+	assert.Nil(t, aggregates)
+
+	aggregates, fieldNames, err = parseAggregates([]string{"count(*)", "MIN(age)", "max( score )", "sum(age)"}, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(aggregates))
+	assert.Equal(t, planpb.Aggregate_Count, aggregates[0].Op)
+	assert.Equal(t, planpb.Aggregate_Min, aggregates[1].Op)
+	assert.Equal(t, int64(101), aggregates[1].FieldId)
+	assert.Equal(t, planpb.Aggregate_Max, aggregates[2].Op)
+	assert.Equal(t, int64(102), aggregates[2].FieldId)
+	assert.Equal(t, planpb.Aggregate_Sum, aggregates[3].Op)
+	assert.Equal(t, []string{"pk", "age", "score"}, fieldNames)
+
+	_, _, err = parseAggregates([]string{"count(pk)"}, schema)
+	assert.Error(t, err)
+
+	_, _, err = parseAggregates([]string{"sum(flag)"}, schema)
+	assert.Error(t, err)
+
+	_, _, err = parseAggregates([]string{"sum(not_exist)"}, schema)
+	assert.Error(t, err)
+
+	_, _, err = parseAggregates([]string{"count(*)", "age"}, schema)
+	assert.Error(t, err)
+}
+
+func TestMergeAggregateResults(t *testing.T) {
+	genLongData := func(data []int64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}},
+			},
+		}
+	}
+	genDoubleData := func(data []float64) *schemapb.FieldData {
+		return &schemapb.FieldData{
+			Type: schemapb.DataType_Double,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}},
+			},
+		}
+	}
+	aggregates := []*planpb.Aggregate{
+		{Op: planpb.Aggregate_Count},
+		{Op: planpb.Aggregate_Min, FieldId: 101},
+		{Op: planpb.Aggregate_Max, FieldId: 102},
+		{Op: planpb.Aggregate_Sum, FieldId: 101},
+	}
+	names := []string{"count(*)", "min(age)", "max(score)", "sum(age)"}
+	// the partial aggregates of query nodes, the second one retrieves nothing
+	results := []*internalpb.RetrieveResults{
+		{FieldsData: []*schemapb.FieldData{genLongData([]int64{3}), genLongData([]int64{10}), genDoubleData([]float64{1.5}), genLongData([]int64{60})}},
+		{FieldsData: []*schemapb.FieldData{genLongData([]int64{0}), genLongData([]int64{}), genDoubleData([]float64{}), genLongData([]int64{0})}},
+		{FieldsData: []*schemapb.FieldData{genLongData([]int64{2}), genLongData([]int64{5}), genDoubleData([]float64{0.5}), genLongData([]int64{25})}},
+	}
+
+	res, err := mergeAggregateResults(results, aggregates, names)
+	assert.NoError(t, err)
+	assert.Equal(t, 4, len(res.FieldsData))
+	assert.Equal(t, "count(*)", res.FieldsData[0].FieldName)
+	assert.Equal(t, []int64{5}, res.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{5}, res.FieldsData[1].GetScalars().GetLongData().Data)
+	assert.Equal(t, []float64{1.5}, res.FieldsData[2].GetScalars().GetDoubleData().Data)
+	assert.Equal(t, schemapb.DataType_Double, res.FieldsData[2].Type)
+	assert.Equal(t, []int64{85}, res.FieldsData[3].GetScalars().GetLongData().Data)
+
+	// nothing matched
+	res, err = mergeAggregateResults(results[1:2], aggregates, names)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, res.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, 0, len(res.FieldsData[1].GetScalars().GetLongData().Data))
+	assert.Equal(t, 0, len(res.FieldsData[2].GetScalars().GetDoubleData().Data))
+	assert.Equal(t, []int64{0}, res.FieldsData[3].GetScalars().GetLongData().Data)
+
+	// a query node doesn't return the partial aggregates
+	results = append(results, &internalpb.RetrieveResults{})
+	_, err = mergeAggregateResults(results, aggregates, names)
+	assert.Error(t, err)
+}
+
+func TestGetPaginationParam(t *testing.T) {
+	kvs := []*commonpb.KeyValuePair{
+		{Key: OffsetKey, Value: "10"},
+		{Key: LimitKey, Value: "-1"},
+	}
+	offset, err := getPaginationParam(OffsetKey, kvs)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(10), offset)
+
+	_, err = getPaginationParam(LimitKey, kvs)
+	assert.Error(t, err)
+
+	offset, err = getPaginationParam(OffsetKey, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(0), offset)
+
+	_, err = getPaginationParam(OffsetKey, []*commonpb.KeyValuePair{{Key: OffsetKey, Value: "abc"}})
+	assert.Error(t, err)
+}
+
+func TestQueryTask_mergeRetrieveResults(t *testing.T) {
+	genRetrieveResults := func(ids []int64) *internalpb.RetrieveResults {
+		return &internalpb.RetrieveResults{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{Data: ids},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				{
+					Type: schemapb.DataType_Int64,
+					Field: &schemapb.FieldData_Scalars{
+						Scalars: &schemapb.ScalarField{
+							Data: &schemapb.ScalarField_LongData{
+								LongData: &schemapb.LongArray{Data: ids},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	results := []*internalpb.RetrieveResults{
+		genRetrieveResults([]int64{5, 1, 3}),
+		genRetrieveResults([]int64{4, 3, 2}),
+	}
+
+	res, err := mergeRetrieveResults(results, 0, 0)
+	assert.NoError(t, err)
+	assert.ElementsMatch(t, []int64{1, 2, 3, 4, 5}, res.FieldsData[0].GetScalars().GetLongData().Data)
+
+	res, err = mergeRetrieveResults(results, 1, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{2, 3}, res.FieldsData[0].GetScalars().GetLongData().Data)
+
+	res, err = mergeRetrieveResults(results, 3, 0)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{4, 5}, res.FieldsData[0].GetScalars().GetLongData().Data)
+
+	res, err = mergeRetrieveResults(results, 10, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(res.FieldsData))
+
+	t.Run("varchar primary key", func(t *testing.T) {
+		genStrRetrieveResults := func(ids []string) *internalpb.RetrieveResults {
+			return &internalpb.RetrieveResults{
+				Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success},
+				Ids: &schemapb.IDs{
+					IdField: &schemapb.IDs_StrId{
+						StrId: &schemapb.StringArray{Data: ids},
+					},
+				},
+				FieldsData: []*schemapb.FieldData{
+					{
+						Type: schemapb.DataType_VarChar,
+						Field: &schemapb.FieldData_Scalars{
+							Scalars: &schemapb.ScalarField{
+								Data: &schemapb.ScalarField_StringData{
+									StringData: &schemapb.StringArray{Data: ids},
+								},
+							},
+						},
+					},
+				},
+			}
+		}
+		results := []*internalpb.RetrieveResults{
+			genStrRetrieveResults([]string{"e", "a", "c"}),
+			genStrRetrieveResults([]string{"d", "c", "b"}),
+		}
+
+		res, err := mergeRetrieveResults(results, 0, 0)
+		assert.NoError(t, err)
+		assert.ElementsMatch(t, []string{"a", "b", "c", "d", "e"}, res.FieldsData[0].GetScalars().GetStringData().Data)
+
+		res, err = mergeRetrieveResults(results, 1, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"b", "c"}, res.FieldsData[0].GetScalars().GetStringData().Data)
+	})
+}
+
+func TestIDs2Expr(t *testing.T) {
+	intIds := &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{Data: []int64{1, 2, 3}},
+		},
+	}
+	assert.Equal(t, "pk in [ 1, 2, 3 ]", IDs2Expr("pk", intIds))
+
+	strIds := &schemapb.IDs{
+		IdField: &schemapb.IDs_StrId{
+			StrId: &schemapb.StringArray{Data: []string{"a", `b"c`}},
+		},
+	}
+	assert.Equal(t, `pk in [ "a", "b\"c" ]`, IDs2Expr("pk", strIds))
+}
+
+func TestQueryTask_all(t *testing.T) {
+	var err error
+
+	Params.Init()
+	Params.ProxyCfg.RetrieveResultChannelNames = []string{funcutil.GenRandomStr()}
+
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+
+	ctx := context.Background()
+
+	err = InitMetaCache(rc)
+	assert.NoError(t, err)
+
+	shardsNum := int32(2)
+	prefix := "TestQueryTask_all"
+	dbName := ""
+	collectionName := prefix + funcutil.GenRandomStr()
+	boolField := "bool"
+	int32Field := "int32"
+	int64Field := "int64"
+	floatField := "float"
+	doubleField := "double"
+	floatVecField := "fvec"
+	binaryVecField := "bvec"
+	fieldsLen := len([]string{boolField, int32Field, int64Field, floatField, doubleField, floatVecField, binaryVecField})
+	dim := 128
+	expr := fmt.Sprintf("%s > 0", int64Field)
+	hitNum := 10
+
+	schema := constructCollectionSchemaWithAllType(
+		boolField, int32Field, int64Field, floatField, doubleField,
+		floatVecField, binaryVecField, dim, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	createColT := &createCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		CreateCollectionRequest: &milvuspb.CreateCollectionRequest{
+			Base:           nil,
+			DbName:         dbName,
+			CollectionName: collectionName,
+			Schema:         marshaledSchema,
+			ShardsNum:      shardsNum,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+		result:    nil,
+		schema:    nil,
+	}
+
+	assert.NoError(t, createColT.OnEnqueue())
+	assert.NoError(t, createColT.PreExecute(ctx))
+	assert.NoError(t, createColT.Execute(ctx))
+	assert.NoError(t, createColT.PostExecute(ctx))
+
+	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
+	query := newMockGetChannelsService()
+	factory := newSimpleMockMsgStreamFactory()
+	chMgr := newChannelsMgrImpl(dmlChannelsFunc, nil, query.GetChannels, nil, factory)
+	defer chMgr.removeAllDMLStream()
+	defer chMgr.removeAllDQLStream()
+
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	qc := NewQueryCoordMock()
@@ -3524,7 +4401,6 @@ func TestTask_all(t *testing.T) {
 	doubleField := "double"
 	floatVecField := "fvec"
 	binaryVecField := "bvec"
-	fieldsLen := len([]string{boolField, int32Field, int64Field, floatField, doubleField, floatVecField, binaryVecField})
 	dim := 128
 	nb := 10
 
@@ -3568,7 +4444,7 @@ func TestTask_all(t *testing.T) {
 		})
 	})
 
-	collectionID, err := globalMetaCache.GetCollectionID(ctx, collectionName)
+	collectionID, err := globalMetaCache.GetCollectionID(ctx, dbName, collectionName)
 	assert.NoError(t, err)
 
 	dmlChannelsFunc := getDmlChannelsFunc(ctx, rc)
@@ -3627,7 +4503,7 @@ func TestTask_all(t *testing.T) {
 				DbName:         dbName,
 				CollectionName: collectionName,
 				PartitionName:  partitionName,
-				FieldsData:     make([]*schemapb.FieldData, fieldsLen),
+				FieldsData:     generateFieldsDataWithAllType(boolField, int32Field, int64Field, floatField, doubleField, floatVecField, binaryVecField, dim, nb),
 				HashKeys:       hash,
 				NumRows:        uint32(nb),
 			},
@@ -3656,111 +4532,6 @@ func TestTask_all(t *testing.T) {
 			schema:         nil,
 		}
 
-		task.req.FieldsData[0] = &schemapb.FieldData{
-			Type:      schemapb.DataType_Bool,
-			FieldName: boolField,
-			Field: &schemapb.FieldData_Scalars{
-				Scalars: &schemapb.ScalarField{
-					Data: &schemapb.ScalarField_BoolData{
-						BoolData: &schemapb.BoolArray{
-							Data: generateBoolArray(nb),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 0,
-		}
-
-		task.req.FieldsData[1] = &schemapb.FieldData{
-			Type:      schemapb.DataType_Int32,
-			FieldName: int32Field,
-			Field: &schemapb.FieldData_Scalars{
-				Scalars: &schemapb.ScalarField{
-					Data: &schemapb.ScalarField_IntData{
-						IntData: &schemapb.IntArray{
-							Data: generateInt32Array(nb),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 1,
-		}
-
-		task.req.FieldsData[2] = &schemapb.FieldData{
-			Type:      schemapb.DataType_Int64,
-			FieldName: int64Field,
-			Field: &schemapb.FieldData_Scalars{
-				Scalars: &schemapb.ScalarField{
-					Data: &schemapb.ScalarField_LongData{
-						LongData: &schemapb.LongArray{
-							Data: generateInt64Array(nb),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 2,
-		}
-
-		task.req.FieldsData[3] = &schemapb.FieldData{
-			Type:      schemapb.DataType_Float,
-			FieldName: floatField,
-			Field: &schemapb.FieldData_Scalars{
-				Scalars: &schemapb.ScalarField{
-					Data: &schemapb.ScalarField_FloatData{
-						FloatData: &schemapb.FloatArray{
-							Data: generateFloat32Array(nb),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 3,
-		}
-
-		task.req.FieldsData[4] = &schemapb.FieldData{
-			Type:      schemapb.DataType_Double,
-			FieldName: doubleField,
-			Field: &schemapb.FieldData_Scalars{
-				Scalars: &schemapb.ScalarField{
-					Data: &schemapb.ScalarField_DoubleData{
-						DoubleData: &schemapb.DoubleArray{
-							Data: generateFloat64Array(nb),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 4,
-		}
-
-		task.req.FieldsData[5] = &schemapb.FieldData{
-			Type:      schemapb.DataType_FloatVector,
-			FieldName: doubleField,
-			Field: &schemapb.FieldData_Vectors{
-				Vectors: &schemapb.VectorField{
-					Dim: int64(dim),
-					Data: &schemapb.VectorField_FloatVector{
-						FloatVector: &schemapb.FloatArray{
-							Data: generateFloatVectors(nb, dim),
-						},
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 5,
-		}
-
-		task.req.FieldsData[6] = &schemapb.FieldData{
-			Type:      schemapb.DataType_BinaryVector,
-			FieldName: doubleField,
-			Field: &schemapb.FieldData_Vectors{
-				Vectors: &schemapb.VectorField{
-					Dim: int64(dim),
-					Data: &schemapb.VectorField_BinaryVector{
-						BinaryVector: generateBinaryVectors(nb, dim),
-					},
-				},
-			},
-			FieldId: common.StartOfUserFieldID + 6,
-		}
-
 		assert.NoError(t, task.OnEnqueue())
 		assert.NoError(t, task.PreExecute(ctx))
 		assert.NoError(t, task.Execute(ctx))
@@ -3833,6 +4604,101 @@ func TestTask_all(t *testing.T) {
 		assert.NoError(t, task.Execute(ctx))
 		assert.NoError(t, task.PostExecute(ctx))
 	})
+
+	t.Run("upsert", func(t *testing.T) {
+		hash := generateHashKeys(nb)
+		fieldsData := generateFieldsDataWithAllType(boolField, int32Field, int64Field, floatField, doubleField, floatVecField, binaryVecField, dim, nb)
+		req := &milvuspb.UpsertRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:   commonpb.MsgType_Upsert,
+				MsgID:     0,
+				Timestamp: 0,
+				SourceID:  Params.ProxyCfg.ProxyID,
+			},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partitionName,
+			FieldsData:     fieldsData,
+			HashKeys:       hash,
+			NumRows:        uint32(nb),
+		}
+		task := &upsertTask{
+			insertTask: insertTask{
+				BaseInsertTask: BaseInsertTask{
+					BaseMsg: msgstream.BaseMsg{
+						HashValues: hash,
+					},
+					InsertRequest: internalpb.InsertRequest{
+						Base: &commonpb.MsgBase{
+							MsgType: commonpb.MsgType_Insert,
+							MsgID:   0,
+						},
+						CollectionName: collectionName,
+						PartitionName:  partitionName,
+					},
+				},
+				req: &milvuspb.InsertRequest{
+					Base:           req.Base,
+					DbName:         dbName,
+					CollectionName: collectionName,
+					PartitionName:  partitionName,
+					FieldsData:     fieldsData,
+					HashKeys:       hash,
+					NumRows:        uint32(nb),
+				},
+				Condition:      NewTaskCondition(ctx),
+				ctx:            ctx,
+				rowIDAllocator: idAllocator,
+				segIDAssigner:  segAllocator,
+				chMgr:          chMgr,
+				chTicker:       ticker,
+			},
+			upsertReq: req,
+		}
+
+		assert.NoError(t, task.OnEnqueue())
+		assert.Equal(t, UpsertTaskName, task.Name())
+		assert.Equal(t, commonpb.MsgType_Upsert, task.Type())
+
+		ts := Timestamp(time.Now().UnixNano())
+		task.SetTs(ts)
+		assert.Equal(t, ts, task.BeginTs())
+
+		assert.NoError(t, task.PreExecute(ctx))
+		assert.Equal(t, int64(nb), task.result.UpsertCnt)
+		assert.Equal(t, nb, typeutil.GetSizeOfIDs(task.deleteMsg.PrimaryKeys))
+		for _, deleteTs := range task.deleteMsg.Timestamps {
+			assert.Equal(t, ts, deleteTs)
+		}
+		for _, insertTs := range task.Timestamps {
+			assert.Equal(t, ts, insertTs)
+		}
+		assert.NoError(t, task.Execute(ctx))
+		assert.NoError(t, task.PostExecute(ctx))
+	})
+}
+
+func TestUpsertTask_varCharPK(t *testing.T) {
+	pks := &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: []string{"a", "b", "c"}}}}
+	hashValues := hashPKs(pks)
+	assert.Equal(t, 3, len(hashValues))
+
+	deleteMsg := &msgstream.DeleteMsg{
+		BaseMsg: msgstream.BaseMsg{HashValues: hashValues},
+		DeleteRequest: internalpb.DeleteRequest{
+			Base:        &commonpb.MsgBase{MsgType: commonpb.MsgType_Delete},
+			PrimaryKeys: pks,
+			Timestamps:  []Timestamp{1, 1, 1},
+		},
+	}
+	deleteMsgs := []msgstream.TsMsg{deleteMsg}
+	msgs := repackDeleteMsgByHash(context.Background(), 0, deleteMsgs, [][]int32{{0, 1, 0}})
+	assert.Equal(t, 2, len(msgs))
+	var repackedPKs []string
+	for _, msg := range msgs {
+		repackedPKs = append(repackedPKs, msg.(*msgstream.DeleteMsg).PrimaryKeys.GetStrId().GetData()...)
+	}
+	assert.ElementsMatch(t, []string{"a", "b", "c"}, repackedPKs)
 }
 
 func TestCreateAlias_all(t *testing.T) {
@@ -3957,3 +4823,301 @@ func TestAlterAlias_all(t *testing.T) {
 	assert.NoError(t, task.Execute(ctx))
 	assert.NoError(t, task.PostExecute(ctx))
 }
+
+func TestRenameCollectionTask_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestRenameCollectionTask_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &RenameCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		RenameCollectionRequest: &milvuspb.RenameCollectionRequest{
+			Base:    nil,
+			OldName: collectionName,
+			NewName: collectionName + "_new",
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, RenameCollectionTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_RenameCollection, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	// collection does not exist in root coord
+	assert.NotEqual(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+
+	task.NewName = task.OldName
+	assert.Error(t, task.PreExecute(ctx))
+	task.NewName = "$invalid"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestAlterCollectionTask_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAlterCollectionTask_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	task := &AlterCollectionTask{
+		Condition: NewTaskCondition(ctx),
+		AlterCollectionRequest: &milvuspb.AlterCollectionRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "60"}},
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, AlterCollectionTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AlterCollection, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	// collection does not exist in root coord
+	assert.NotEqual(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+
+	task.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "-1"}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Properties = nil
+	assert.Error(t, task.PreExecute(ctx))
+	task.CollectionName = "$invalid"
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestAddFieldTask_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	prefix := "TestAddFieldTask_all"
+	collectionName := prefix + funcutil.GenRandomStr()
+	schema := constructCollectionSchema("int64", "fvec", 128, collectionName)
+	marshaledSchema, err := proto.Marshal(schema)
+	assert.NoError(t, err)
+
+	task := &AddFieldTask{
+		Condition: NewTaskCondition(ctx),
+		AddFieldRequest: &milvuspb.AddFieldRequest{
+			Base:           nil,
+			CollectionName: collectionName,
+			Field: &schemapb.FieldSchema{
+				Name:         "age",
+				DataType:     schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+			},
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, task.OnEnqueue())
+	assert.NotNil(t, task.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	task.SetID(id)
+	assert.Equal(t, id, task.ID())
+	assert.Equal(t, AddFieldTaskName, task.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	task.SetTs(ts)
+	assert.Equal(t, ts, task.BeginTs())
+	assert.Equal(t, ts, task.EndTs())
+
+	assert.NoError(t, task.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_AddField, task.Type())
+	assert.NoError(t, task.Execute(ctx))
+	assert.NoError(t, task.PostExecute(ctx))
+	// collection does not exist in root coord
+	assert.NotEqual(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+
+	status, err := rc.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		CollectionName: collectionName,
+		Schema:         marshaledSchema,
+		ShardsNum:      1,
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	assert.NoError(t, task.Execute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, task.result.ErrorCode)
+
+	resp, err := rc.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{CollectionName: collectionName})
+	assert.NoError(t, err)
+	assert.Equal(t, int32(1), resp.Schema.Version)
+	assert.Equal(t, "age", resp.Schema.Fields[len(resp.Schema.Fields)-1].Name)
+
+	task.Field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 18}}
+	assert.Error(t, task.PreExecute(ctx))
+	task.Field.DefaultValue = nil
+	assert.Error(t, task.PreExecute(ctx))
+	task.Field.DataType = schemapb.DataType_FloatVector
+	assert.Error(t, task.PreExecute(ctx))
+	task.Field.Name = "$invalid"
+	assert.Error(t, task.PreExecute(ctx))
+	task.Field = nil
+	assert.Error(t, task.PreExecute(ctx))
+}
+
+func TestDatabaseTasks_all(t *testing.T) {
+	Params.Init()
+	rc := NewRootCoordMock()
+	rc.Start()
+	defer rc.Stop()
+	ctx := context.Background()
+	dbName := "TestDatabaseTasks_all" + funcutil.GenRandomStr()
+
+	cdt := &CreateDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		CreateDatabaseRequest: &milvuspb.CreateDatabaseRequest{
+			Base:   nil,
+			DbName: dbName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+
+	assert.NoError(t, cdt.OnEnqueue())
+	assert.NotNil(t, cdt.TraceCtx())
+
+	id := UniqueID(uniquegenerator.GetUniqueIntGeneratorIns().GetInt())
+	cdt.SetID(id)
+	assert.Equal(t, id, cdt.ID())
+	assert.Equal(t, CreateDatabaseTaskName, cdt.Name())
+
+	ts := Timestamp(time.Now().UnixNano())
+	cdt.SetTs(ts)
+	assert.Equal(t, ts, cdt.BeginTs())
+	assert.Equal(t, ts, cdt.EndTs())
+
+	assert.NoError(t, cdt.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_CreateDatabase, cdt.Type())
+	assert.NoError(t, cdt.Execute(ctx))
+	assert.NoError(t, cdt.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, cdt.result.ErrorCode)
+
+	ldt := &ListDatabasesTask{
+		Condition:            NewTaskCondition(ctx),
+		ListDatabasesRequest: &milvuspb.ListDatabasesRequest{},
+		ctx:                  ctx,
+		rootCoord:            rc,
+	}
+	assert.NoError(t, ldt.OnEnqueue())
+	assert.Equal(t, ListDatabasesTaskName, ldt.Name())
+	assert.NoError(t, ldt.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_ListDatabases, ldt.Type())
+	assert.NoError(t, ldt.Execute(ctx))
+	assert.NoError(t, ldt.PostExecute(ctx))
+	assert.Contains(t, ldt.result.DbNames, dbName)
+
+	ddt := &DropDatabaseTask{
+		Condition: NewTaskCondition(ctx),
+		DropDatabaseRequest: &milvuspb.DropDatabaseRequest{
+			Base:   nil,
+			DbName: dbName,
+		},
+		ctx:       ctx,
+		rootCoord: rc,
+	}
+	assert.NoError(t, ddt.OnEnqueue())
+	assert.Equal(t, DropDatabaseTaskName, ddt.Name())
+	assert.NoError(t, ddt.PreExecute(ctx))
+	assert.Equal(t, commonpb.MsgType_DropDatabase, ddt.Type())
+	assert.NoError(t, ddt.Execute(ctx))
+	assert.NoError(t, ddt.PostExecute(ctx))
+	assert.Equal(t, commonpb.ErrorCode_Success, ddt.result.ErrorCode)
+
+	// invalid database name
+	ddt.DbName = ""
+	assert.Error(t, ddt.PreExecute(ctx))
+}
+
+func TestGetPrimaryKeysFromExpr(t *testing.T) {
+	int64Field := "int64"
+	ageField := "age"
+	schema := &schemapb.CollectionSchema{
+		Name: "TestGetPrimaryKeysFromExpr",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: int64Field, IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: ageField, DataType: schemapb.DataType_Int64},
+		},
+	}
+
+	t.Run("term expr on primary key", func(t *testing.T) {
+		pks, ok, err := getPrimaryKeysFromExpr(schema, int64Field+" in [1, 2, 3]")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.ElementsMatch(t, []int64{1, 2, 3}, pks.GetIntId().GetData())
+	})
+
+	t.Run("empty expr", func(t *testing.T) {
+		pks, ok, err := getPrimaryKeysFromExpr(schema, "")
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, 0, typeutil.GetSizeOfIDs(pks))
+	})
+
+	t.Run("general filter expr", func(t *testing.T) {
+		_, ok, err := getPrimaryKeysFromExpr(schema, int64Field+" > 0 && "+int64Field+" < 100")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+
+		_, ok, err = getPrimaryKeysFromExpr(schema, ageField+" in [1, 2]")
+		assert.NoError(t, err)
+		assert.False(t, ok)
+	})
+
+	t.Run("invalid expr", func(t *testing.T) {
+		_, _, err := getPrimaryKeysFromExpr(schema, "not_exist_field in [1]")
+		assert.Error(t, err)
+	})
+	t.Run("term expr on varchar primary key", func(t *testing.T) {
+		varCharSchema := &schemapb.CollectionSchema{
+			Name: "TestGetPrimaryKeysFromExpr",
+			Fields: []*schemapb.FieldSchema{
+				{
+					FieldID:      100,
+					Name:         "pk",
+					IsPrimaryKey: true,
+					DataType:     schemapb.DataType_VarChar,
+					TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}},
+				},
+			},
+		}
+		pks, ok, err := getPrimaryKeysFromExpr(varCharSchema, `pk in ["a", "b"]`)
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.ElementsMatch(t, []string{"a", "b"}, pks.GetStrId().GetData())
+	})
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // enableMultipleVectorFields indicates whether to enable multiple vector fields.
@@ -84,6 +85,113 @@ func validateCollectionName(collName string) error {
 	return validateCollectionNameOrAlias(collName, "name")
 }
 
+func validateDatabaseName(dbName string) error {
+	dbName = strings.TrimSpace(dbName)
+
+	invalidMsg := "Invalid database name: " + dbName + ". "
+	if dbName == "" {
+		msg := invalidMsg + "Database name should not be empty."
+		return errors.New(msg)
+	}
+
+	if int64(len(dbName)) > Params.ProxyCfg.MaxNameLength {
+		msg := invalidMsg + "The length of a database name must be less than " +
+			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := dbName[0]
+	if firstChar != '_' && !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of a database name must be an underscore or letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(dbName); i++ {
+		c := dbName[i]
+		if c != '_' && c != '$' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Database name can only contain numbers, letters, dollars and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+const (
+	minPasswordLength = 6
+	maxPasswordLength = 256
+)
+
+func validateUsername(username string) error {
+	username = strings.TrimSpace(username)
+
+	invalidMsg := "Invalid username: " + username + ". "
+	if username == "" {
+		msg := invalidMsg + "Username should not be empty."
+		return errors.New(msg)
+	}
+
+	if int64(len(username)) > Params.ProxyCfg.MaxNameLength {
+		msg := invalidMsg + "The length of username must be less than " +
+			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := username[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of username must be a letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(username); i++ {
+		c := username[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Username should only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func validateRoleName(roleName string) error {
+	roleName = strings.TrimSpace(roleName)
+
+	invalidMsg := "Invalid role name: " + roleName + ". "
+	if roleName == "" {
+		msg := invalidMsg + "Role name should not be empty."
+		return errors.New(msg)
+	}
+
+	if int64(len(roleName)) > Params.ProxyCfg.MaxNameLength {
+		msg := invalidMsg + "The length of role name must be less than " +
+			strconv.FormatInt(Params.ProxyCfg.MaxNameLength, 10) + " characters."
+		return errors.New(msg)
+	}
+
+	firstChar := roleName[0]
+	if !isAlpha(firstChar) {
+		msg := invalidMsg + "The first character of role name must be a letter."
+		return errors.New(msg)
+	}
+
+	for i := 1; i < len(roleName); i++ {
+		c := roleName[i]
+		if c != '_' && !isAlpha(c) && !isNumber(c) {
+			msg := invalidMsg + "Role name should only contain numbers, letters, and underscores."
+			return errors.New(msg)
+		}
+	}
+	return nil
+}
+
+func validatePassword(password string) error {
+	if len(password) < minPasswordLength || len(password) > maxPasswordLength {
+		msg := "The length of password must be between " + strconv.Itoa(minPasswordLength) +
+			" and " + strconv.Itoa(maxPasswordLength) + " characters."
+		return errors.New(msg)
+	}
+	return nil
+}
+
 func validatePartitionTag(partitionTag string, strictCheck bool) error {
 	partitionTag = strings.TrimSpace(partitionTag)
 
@@ -160,6 +268,18 @@ func validateDimension(dim int64, isBinary bool) error {
 	return nil
 }
 
+// validateMaxLength checks the max_length of the varchar or json field is in range 1 ~ maxVarCharLength
+func validateMaxLength(field *schemapb.FieldSchema) error {
+	maxLength, err := typeutil.GetMaxLength(field)
+	if err != nil {
+		return err
+	}
+	if maxLength <= 0 || int64(maxLength) > Params.ProxyCfg.MaxVarCharLength {
+		return fmt.Errorf("invalid max_length %d of field %s, should be in range 1 ~ %d", maxLength, field.Name, Params.ProxyCfg.MaxVarCharLength)
+	}
+	return nil
+}
+
 func validateVectorFieldMetricType(field *schemapb.FieldSchema) error {
 	if (field.DataType != schemapb.DataType_FloatVector) && (field.DataType != schemapb.DataType_BinaryVector) {
 		return nil
@@ -208,8 +328,17 @@ func validatePrimaryKey(coll *schemapb.CollectionSchema) error {
 			if idx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[idx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return errors.New("the data type of primary key should be int64")
+			switch field.DataType {
+			case schemapb.DataType_Int64:
+			case schemapb.DataType_VarChar:
+				if field.AutoID {
+					return fmt.Errorf("the varchar primary key %s can't be auto generated", field.Name)
+				}
+				if err := validateMaxLength(field); err != nil {
+					return err
+				}
+			default:
+				return errors.New("the data type of primary key should be int64 or varchar")
 			}
 			idx = i
 		}
@@ -239,7 +368,8 @@ func isVector(dataType schemapb.DataType) (bool, error) {
 	case schemapb.DataType_Bool, schemapb.DataType_Int8,
 		schemapb.DataType_Int16, schemapb.DataType_Int32,
 		schemapb.DataType_Int64,
-		schemapb.DataType_Float, schemapb.DataType_Double:
+		schemapb.DataType_Float, schemapb.DataType_Double,
+		schemapb.DataType_VarChar, schemapb.DataType_JSON:
 		return false, nil
 
 	case schemapb.DataType_FloatVector, schemapb.DataType_BinaryVector:
@@ -283,8 +413,11 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			} else if primaryIdx != -1 {
 				return fmt.Errorf("there are more than one primary key, field name = %s, %s", coll.Fields[primaryIdx].Name, field.Name)
 			}
-			if field.DataType != schemapb.DataType_Int64 {
-				return fmt.Errorf("type of primary key shoule be int64")
+			if field.DataType != schemapb.DataType_Int64 && field.DataType != schemapb.DataType_VarChar {
+				return fmt.Errorf("type of primary key shoule be int64 or varchar")
+			}
+			if field.DataType == schemapb.DataType_VarChar && field.AutoID {
+				return fmt.Errorf("the varchar primary key %s can't be auto generated", field.Name)
 			}
 			primaryIdx = idx
 		}
@@ -337,7 +470,11 @@ func validateSchema(coll *schemapb.CollectionSchema) error {
 			if len(field.IndexParams) != 0 {
 				return fmt.Errorf("index params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
-			if len(field.TypeParams) != 0 {
+			if field.DataType == schemapb.DataType_VarChar || field.DataType == schemapb.DataType_JSON {
+				if err := validateMaxLength(field); err != nil {
+					return err
+				}
+			} else if len(field.TypeParams) != 0 {
 				return fmt.Errorf("type params is not empty for scalar field: %s(%d)", field.Name, field.FieldID)
 			}
 		}
@@ -373,3 +510,18 @@ func validateMultipleVectorFields(schema *schemapb.CollectionSchema) error {
 
 	return nil
 }
+
+// validateNullableAndDefault checks only the scalar fields except the primary key can be nullable or have a default value,
+// and the default value matches the data type of the field
+func validateNullableAndDefault(field *schemapb.FieldSchema) error {
+	if !field.GetNullable() && field.GetDefaultValue() == nil {
+		return nil
+	}
+	if typeutil.IsVectorType(field.GetDataType()) {
+		return fmt.Errorf("vector field %s can't be nullable or have a default value", field.GetName())
+	}
+	if field.GetIsPrimaryKey() {
+		return fmt.Errorf("primary key field %s can't be nullable or have a default value", field.GetName())
+	}
+	return typeutil.CheckDefaultValue(field)
+}
@@ -50,6 +50,89 @@ func TestValidateCollectionName(t *testing.T) {
 	}
 }
 
+func TestValidateDatabaseName(t *testing.T) {
+	assert.Nil(t, validateDatabaseName("abc"))
+	assert.Nil(t, validateDatabaseName("_123abc"))
+	assert.Nil(t, validateDatabaseName("abc123_$"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"123abc",
+		"$abc",
+		"_12 ac",
+		" ",
+		"",
+		string(longName),
+		"中文",
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateDatabaseName(name))
+	}
+}
+
+func TestValidateUsername(t *testing.T) {
+	assert.Nil(t, validateUsername("root"))
+	assert.Nil(t, validateUsername("user_123"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"",
+		" ",
+		"123user",
+		"_user",
+		"user$",
+		"user name",
+		string(longName),
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateUsername(name))
+	}
+}
+
+func TestValidateRoleName(t *testing.T) {
+	assert.Nil(t, validateRoleName("admin"))
+	assert.Nil(t, validateRoleName("role_123"))
+
+	longName := make([]byte, 256)
+	for i := 0; i < len(longName); i++ {
+		longName[i] = 'a'
+	}
+	invalidNames := []string{
+		"",
+		" ",
+		"123role",
+		"_role",
+		"role$",
+		"role name",
+		string(longName),
+	}
+
+	for _, name := range invalidNames {
+		assert.NotNil(t, validateRoleName(name))
+	}
+}
+
+func TestValidatePassword(t *testing.T) {
+	assert.Nil(t, validatePassword("123456"))
+	assert.Nil(t, validatePassword("Milvus"))
+
+	longPassword := make([]byte, 257)
+	for i := 0; i < len(longPassword); i++ {
+		longPassword[i] = 'a'
+	}
+	assert.NotNil(t, validatePassword(""))
+	assert.NotNil(t, validatePassword("12345"))
+	assert.NotNil(t, validatePassword(string(longPassword)))
+}
+
 func TestValidatePartitionTag(t *testing.T) {
 	assert.Nil(t, validatePartitionTag("abc", true))
 	assert.Nil(t, validatePartitionTag("123abc", true))
@@ -151,6 +234,7 @@ func TestValidateDuplicatedFieldName(t *testing.T) {
 }
 
 func TestValidatePrimaryKey(t *testing.T) {
+	Params.Init()
 	coll := schemapb.CollectionSchema{
 		Name:        "coll1",
 		Description: "",
@@ -182,6 +266,14 @@ func TestValidatePrimaryKey(t *testing.T) {
 	assert.NotNil(t, validateSchema(&coll))
 	pf.DataType = schemapb.DataType_Bool
 	assert.NotNil(t, validateSchema(&coll))
+	pf.DataType = schemapb.DataType_VarChar
+	assert.NotNil(t, validateSchema(&coll))
+	pf.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "36"}}
+	assert.Nil(t, validateSchema(&coll))
+	pf.AutoID = true
+	assert.NotNil(t, validateSchema(&coll))
+	pf.AutoID = false
+	pf.TypeParams = nil
 	pf.DataType = schemapb.DataType_Int64
 	assert.Nil(t, validateSchema(&coll))
 	coll.Fields = append(coll.Fields, &schemapb.FieldSchema{
@@ -258,7 +350,14 @@ func TestValidateSchema(t *testing.T) {
 	pf2.DataType = schemapb.DataType_FloatVector
 	assert.NotNil(t, validateSchema(coll))
 
+	pf2.DataType = schemapb.DataType_JSON
+	assert.NotNil(t, validateSchema(coll))
+
+	pf2.TypeParams = []*commonpb.KeyValuePair{{Key: "max_length", Value: "256"}}
+	assert.Nil(t, validateSchema(coll))
+
 	pf2.DataType = schemapb.DataType_Int64
+	pf2.TypeParams = nil
 	assert.Nil(t, validateSchema(coll))
 
 	tp3Good := []*commonpb.KeyValuePair{
@@ -408,3 +507,25 @@ func TestValidateMultipleVectorFields(t *testing.T) {
 		assert.Error(t, validateMultipleVectorFields(schema3))
 	}
 }
+
+func TestValidateNullableAndDefault(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "field", DataType: schemapb.DataType_Int64}
+	assert.NoError(t, validateNullableAndDefault(field))
+
+	field.Nullable = true
+	assert.NoError(t, validateNullableAndDefault(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+	assert.NoError(t, validateNullableAndDefault(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_FloatData{FloatData: 1}}
+	assert.Error(t, validateNullableAndDefault(field))
+
+	field.DefaultValue = nil
+	field.IsPrimaryKey = true
+	assert.Error(t, validateNullableAndDefault(field))
+
+	field.IsPrimaryKey = false
+	field.DataType = schemapb.DataType_FloatVector
+	assert.Error(t, validateNullableAndDefault(field))
+}
@@ -28,6 +28,7 @@ package querynode
 */
 import "C"
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -39,7 +40,10 @@ import (
 	"github.com/golang/protobuf/proto"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/types"
 )
 
 // Collection is a wrapper of the underlying C-structure C.CCollection
@@ -47,7 +51,9 @@ type Collection struct {
 	collectionPtr C.CCollection
 	id            UniqueID
 	partitionIDs  []UniqueID
-	schema        *schemapb.CollectionSchema
+
+	schemaMu sync.RWMutex // guards schema
+	schema   *schemapb.CollectionSchema
 
 	channelMu      sync.RWMutex
 	vChannels      []Channel
@@ -69,9 +75,35 @@ func (c *Collection) ID() UniqueID {
 
 // Schema returns the schema of collection
 func (c *Collection) Schema() *schemapb.CollectionSchema {
+	c.schemaMu.RLock()
+	defer c.schemaMu.RUnlock()
 	return c.schema
 }
 
+// updateSchema replaces the schema of collection with the one altered by AddField, which appends fields to it,
+// the segments created later take the new schema
+func (c *Collection) updateSchema(schema *schemapb.CollectionSchema) error {
+	/*
+		CStatus
+		UpdateCollectionSchema(CCollection collection, const char* schema_proto_blob);
+	*/
+	c.schemaMu.Lock()
+	defer c.schemaMu.Unlock()
+	if schema.GetVersion() <= c.schema.GetVersion() {
+		return nil
+	}
+
+	cSchemaBlob := C.CString(proto.MarshalTextString(schema))
+	defer C.free(unsafe.Pointer(cSchemaBlob))
+	status := C.UpdateCollectionSchema(c.collectionPtr, cSchemaBlob)
+	if err := HandleCStatus(&status, "UpdateCollectionSchema failed"); err != nil {
+		return err
+	}
+	c.schema = schema
+	log.Debug("update collection schema", zap.Int64("collectionID", c.id), zap.Int32("version", schema.GetVersion()))
+	return nil
+}
+
 // addPartitionID would add a partition id to partition id list of collection
 func (c *Collection) addPartitionID(partitionID UniqueID) {
 	c.releaseMu.Lock()
@@ -363,3 +395,34 @@ func deleteCollection(collection *Collection) {
 
 	collection = nil
 }
+
+// refreshCollectionSchema gets the schema of the collection at ts from rootcoord and updates the replicas with it,
+// it's called when the schema is altered by AddField after the collection is loaded
+func refreshCollectionSchema(ctx context.Context, rootCoord types.RootCoord, collectionID UniqueID, ts Timestamp, replicas ...ReplicaInterface) (*schemapb.CollectionSchema, error) {
+	if rootCoord == nil {
+		return nil, errors.New("null root coordinator when refresh collection schema")
+	}
+	resp, err := rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_DescribeCollection,
+			SourceID: Params.QueryNodeCfg.QueryNodeID,
+		},
+		CollectionID: collectionID,
+		TimeStamp:    ts,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("grpc error when describe collection %d from rootcoord: %w", collectionID, err)
+	}
+	if resp.GetStatus().GetErrorCode() != commonpb.ErrorCode_Success {
+		return nil, fmt.Errorf("describe collection %d from rootcoord wrong: %s", collectionID, resp.GetStatus().GetReason())
+	}
+	for _, replica := range replicas {
+		if !replica.hasCollection(collectionID) {
+			continue
+		}
+		if err := replica.updateCollectionSchema(collectionID, resp.GetSchema()); err != nil {
+			return nil, err
+		}
+	}
+	return resp.GetSchema(), nil
+}
@@ -62,6 +62,8 @@ type ReplicaInterface interface {
 	hasCollection(collectionID UniqueID) bool
 	// getCollectionNum returns num of collections in collectionReplica
 	getCollectionNum() int
+	// updateCollectionSchema replaces the schema of the collection and its segments with the one altered by AddField
+	updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error
 	// getPartitionIDs returns partition ids of collection
 	getPartitionIDs(collectionID UniqueID) ([]UniqueID, error)
 	// getVecFieldIDsByCollectionID returns vector field ids of collection
@@ -278,6 +280,29 @@ func (colReplica *collectionReplica) getCollectionNum() int {
 	return len(colReplica.collections)
 }
 
+// updateCollectionSchema replaces the schema of the collection and its segments with the one altered by AddField,
+// the rows in the segments take the default values of the fields appended to the schema
+func (colReplica *collectionReplica) updateCollectionSchema(collectionID UniqueID, schema *schemapb.CollectionSchema) error {
+	colReplica.mu.Lock()
+	defer colReplica.mu.Unlock()
+	collection, err := colReplica.getCollectionByIDPrivate(collectionID)
+	if err != nil {
+		return err
+	}
+	if err := collection.updateSchema(schema); err != nil {
+		return err
+	}
+	for _, segment := range colReplica.segments {
+		if segment.collectionID != collectionID {
+			continue
+		}
+		if err := segment.updateSchema(collection); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // getPartitionIDs returns partition ids of collection
 func (colReplica *collectionReplica) getPartitionIDs(collectionID UniqueID) ([]UniqueID, error) {
 	colReplica.mu.RLock()
@@ -536,10 +561,14 @@ func (colReplica *collectionReplica) addSegmentPrivate(segmentID UniqueID, parti
 func (colReplica *collectionReplica) setSegment(segment *Segment) error {
 	colReplica.mu.Lock()
 	defer colReplica.mu.Unlock()
-	_, err := colReplica.getCollectionByIDPrivate(segment.collectionID)
+	collection, err := colReplica.getCollectionByIDPrivate(segment.collectionID)
 	if err != nil {
 		return err
 	}
+	// the schema may be altered while the segment is being loaded
+	if err := segment.updateSchema(collection); err != nil {
+		return err
+	}
 	return colReplica.addSegmentPrivate(segment.segmentID, segment.partitionID, segment)
 }
 
@@ -19,7 +19,10 @@ package querynode
 import (
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 //----------------------------------------------------------------------------------------------------- collection
@@ -76,6 +79,43 @@ func TestCollectionReplica_hasCollection(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestCollectionReplica_updateCollectionSchema(t *testing.T) {
+	node := newQueryNodeMock()
+	collectionID := UniqueID(0)
+	initTestMeta(t, node, collectionID, 0)
+
+	collection, err := node.historical.replica.getCollectionByID(collectionID)
+	assert.NoError(t, err)
+	schema := proto.Clone(collection.Schema()).(*schemapb.CollectionSchema)
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{
+		FieldID:      UniqueID(102),
+		Name:         "score",
+		DataType:     schemapb.DataType_Int64,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 7}},
+	})
+	schema.Version++
+
+	err = node.historical.replica.updateCollectionSchema(collectionID, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Version, collection.Schema().Version)
+	segment, err := node.historical.replica.getSegmentByID(0)
+	assert.NoError(t, err)
+	assert.Equal(t, schema.Version, segment.schema.Version)
+
+	// the fields can't be removed from the schema
+	removed := proto.Clone(schema).(*schemapb.CollectionSchema)
+	removed.Fields = removed.Fields[:1]
+	removed.Version++
+	err = node.historical.replica.updateCollectionSchema(collectionID, removed)
+	assert.Error(t, err)
+
+	err = node.historical.replica.updateCollectionSchema(UniqueID(1), schema)
+	assert.Error(t, err)
+
+	err = node.Stop()
+	assert.NoError(t, err)
+}
+
 //----------------------------------------------------------------------------------------------------- partition
 func TestCollectionReplica_getPartitionNum(t *testing.T) {
 	node := newQueryNodeMock()
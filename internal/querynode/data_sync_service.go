@@ -25,6 +25,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/types"
 )
 
 // dataSyncService manages a lot of flow graphs
@@ -39,6 +40,7 @@ type dataSyncService struct {
 	historicalReplica ReplicaInterface
 	tSafeReplica      TSafeReplicaInterface
 	msFactory         msgstream.Factory
+	rootCoord         types.RootCoord
 }
 
 // addFlowGraphsForDMLChannels add flowGraphs to dmlChannel2FlowGraph
@@ -57,9 +59,11 @@ func (dsService *dataSyncService) addFlowGraphsForDMLChannels(collectionID Uniqu
 		newFlowGraph := newQueryNodeFlowGraph(dsService.ctx,
 			collectionID,
 			dsService.streamingReplica,
+			dsService.historicalReplica,
 			dsService.tSafeReplica,
 			channel,
-			dsService.msFactory)
+			dsService.msFactory,
+			dsService.rootCoord)
 		dsService.dmlChannel2FlowGraph[channel] = newFlowGraph
 		log.Debug("add DML flow graph",
 			zap.Any("collectionID", collectionID),
@@ -184,7 +188,8 @@ func newDataSyncService(ctx context.Context,
 	streamingReplica ReplicaInterface,
 	historicalReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
-	factory msgstream.Factory) *dataSyncService {
+	factory msgstream.Factory,
+	rootCoord types.RootCoord) *dataSyncService {
 
 	return &dataSyncService{
 		ctx:                    ctx,
@@ -194,6 +199,7 @@ func newDataSyncService(ctx context.Context,
 		historicalReplica:      historicalReplica,
 		tSafeReplica:           tSafeReplica,
 		msFactory:              factory,
+		rootCoord:              rootCoord,
 	}
 }
 
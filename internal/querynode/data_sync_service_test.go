@@ -37,7 +37,7 @@ func TestDataSyncService_DMLFlowGraphs(t *testing.T) {
 	assert.NoError(t, err)
 
 	tSafe := newTSafeReplica()
-	dataSyncService := newDataSyncService(ctx, streamingReplica, historicalReplica, tSafe, fac)
+	dataSyncService := newDataSyncService(ctx, streamingReplica, historicalReplica, tSafe, fac, nil)
 	assert.NotNil(t, dataSyncService)
 
 	dataSyncService.addFlowGraphsForDMLChannels(defaultCollectionID, []Channel{defaultDMLChannel})
@@ -88,7 +88,7 @@ func TestDataSyncService_DeltaFlowGraphs(t *testing.T) {
 	assert.NoError(t, err)
 
 	tSafe := newTSafeReplica()
-	dataSyncService := newDataSyncService(ctx, streamingReplica, historicalReplica, tSafe, fac)
+	dataSyncService := newDataSyncService(ctx, streamingReplica, historicalReplica, tSafe, fac, nil)
 	assert.NotNil(t, dataSyncService)
 
 	dataSyncService.addFlowGraphsForDeltaChannels(defaultCollectionID, []Channel{defaultDeltaChannel})
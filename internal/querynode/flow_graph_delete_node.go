@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
 )
@@ -52,7 +53,7 @@ func (dNode *deleteNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 	}
 
 	delData := &deleteData{
-		deleteIDs:        map[UniqueID][]int64{},
+		deleteIDs:        map[UniqueID][]storage.PrimaryKey{},
 		deleteTimestamps: map[UniqueID][]Timestamp{},
 		deleteOffset:     map[UniqueID]int64{},
 	}
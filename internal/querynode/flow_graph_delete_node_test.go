@@ -24,6 +24,7 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -123,9 +124,9 @@ func TestFlowGraphDeleteNode_operate(t *testing.T) {
 		msg := []flowgraph.Msg{&dMsg}
 		deleteNode.Operate(msg)
 		s, err := historical.getSegmentByID(defaultSegmentID)
-		pks := make([]int64, defaultMsgLength)
+		pks := make([]storage.PrimaryKey, defaultMsgLength)
 		for i := 0; i < defaultMsgLength; i++ {
-			pks[i] = int64(i)
+			pks[i] = storage.NewInt64PrimaryKey(int64(i))
 		}
 		s.updateBloomFilter(pks)
 		assert.Nil(t, err)
@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // filterDeleteNode is one of the nodes in delta flow graph
@@ -101,7 +102,7 @@ func (fddNode *filterDeleteNode) filterInvalidDeleteMessage(msg *msgstream.Delet
 		return nil
 	}
 
-	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+	if typeutil.GetSizeOfIDs(msg.PrimaryKeys) != len(msg.Timestamps) {
 		log.Warn("Error, misaligned messages detected")
 		return nil
 	}
@@ -23,6 +23,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/milvus-io/milvus/internal/msgstream"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -83,7 +84,7 @@ func TestFlowGraphFilterDeleteNode_filterInvalidDeleteMessage(t *testing.T) {
 		fg, err := getFilterDeleteNode(ctx)
 		assert.NoError(t, err)
 		msg.Timestamps = make([]Timestamp, 0)
-		msg.PrimaryKeys = make([]IntPrimaryKey, 0)
+		msg.PrimaryKeys = &schemapb.IDs{}
 		res := fg.filterInvalidDeleteMessage(msg)
 		assert.Nil(t, res)
 	})
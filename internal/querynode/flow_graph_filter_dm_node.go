@@ -27,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // filterDmNode is one of the nodes in query node flow graph
@@ -142,7 +143,7 @@ func (fdmNode *filterDmNode) filterInvalidDeleteMessage(msg *msgstream.DeleteMsg
 		}
 	}
 
-	if len(msg.PrimaryKeys) != len(msg.Timestamps) {
+	if typeutil.GetSizeOfIDs(msg.PrimaryKeys) != len(msg.Timestamps) {
 		log.Warn("Error, misaligned messages detected")
 		return nil
 	}
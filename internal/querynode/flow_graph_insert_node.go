@@ -18,11 +18,10 @@ package querynode
 
 import (
 	"bytes"
+	"context"
 	"encoding/binary"
 	"errors"
 	"fmt"
-	"io"
-	"strconv"
 	"sync"
 
 	"github.com/opentracing/opentracing-go"
@@ -32,15 +31,21 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/trace"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // insertNode is one of the nodes in query flow graph
 type insertNode struct {
 	baseNode
-	streamingReplica ReplicaInterface
+	streamingReplica  ReplicaInterface
+	historicalReplica ReplicaInterface
+	rootCoord         types.RootCoord
 }
 
 // insertData stores the valid insert data
@@ -49,12 +54,13 @@ type insertData struct {
 	insertTimestamps map[UniqueID][]Timestamp
 	insertRecords    map[UniqueID][]*commonpb.Blob
 	insertOffset     map[UniqueID]int64
-	insertPKs        map[UniqueID][]int64
+	insertPKs        map[UniqueID][]storage.PrimaryKey
+	insertValidData  map[UniqueID]map[FieldID][]bool
 }
 
 // deleteData stores the valid delete data
 type deleteData struct {
-	deleteIDs        map[UniqueID][]int64
+	deleteIDs        map[UniqueID][]storage.PrimaryKey
 	deleteTimestamps map[UniqueID][]Timestamp
 	deleteOffset     map[UniqueID]int64
 }
@@ -84,7 +90,8 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 		insertTimestamps: make(map[UniqueID][]Timestamp),
 		insertRecords:    make(map[UniqueID][]*commonpb.Blob),
 		insertOffset:     make(map[UniqueID]int64),
-		insertPKs:        make(map[UniqueID][]int64),
+		insertPKs:        make(map[UniqueID][]storage.PrimaryKey),
+		insertValidData:  make(map[UniqueID]map[FieldID][]bool),
 	}
 
 	if iMsg == nil {
@@ -114,6 +121,21 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
+		// the schema is altered by AddField after the collection is loaded, the rows can't be dropped,
+		// so the query node fails if the schema can't be refreshed or the rows can't be aligned
+		if task.SchemaVersion > col.Schema().GetVersion() {
+			_, err = refreshCollectionSchema(context.Background(), iNode.rootCoord, task.CollectionID, task.EndTs(), iNode.streamingReplica, iNode.historicalReplica)
+			if err != nil {
+				log.Error("failed to refresh collection schema", zap.Int64("collectionID", task.CollectionID), zap.Error(err))
+				panic(err)
+			}
+		}
+		err = typeutil.AlignRowData(col.Schema(), task.FieldIDs, task.RowData)
+		if err != nil {
+			log.Error("failed to align row data", zap.Int64("collectionID", task.CollectionID), zap.Int64("segmentID", task.SegmentID), zap.Error(err))
+			panic(err)
+		}
+
 		// check if segment exists, if not, create this segment
 		if !iNode.streamingReplica.hasSegment(task.SegmentID) {
 			err := iNode.streamingReplica.addSegment(task.SegmentID, task.PartitionID, task.CollectionID, task.ShardName, segmentTypeGrowing, true)
@@ -123,6 +145,7 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 			}
 		}
 
+		appendValidData(&iData, task.SegmentID, len(iData.insertIDs[task.SegmentID]), task.ValidData)
 		iData.insertIDs[task.SegmentID] = append(iData.insertIDs[task.SegmentID], task.RowIDs...)
 		iData.insertTimestamps[task.SegmentID] = append(iData.insertTimestamps[task.SegmentID], task.Timestamps...)
 		iData.insertRecords[task.SegmentID] = append(iData.insertRecords[task.SegmentID], task.RowData...)
@@ -157,14 +180,26 @@ func (iNode *insertNode) Operate(in []flowgraph.Msg) []flowgraph.Msg {
 
 	// 3. do insert
 	wg := sync.WaitGroup{}
+	insertErrs := make(chan error, len(iData.insertRecords))
 	for segmentID := range iData.insertRecords {
 		wg.Add(1)
-		go iNode.insert(&iData, segmentID, &wg)
+		go func(segmentID UniqueID) {
+			if err := iNode.insert(&iData, segmentID, &wg); err != nil {
+				insertErrs <- err
+			}
+		}(segmentID)
 	}
 	wg.Wait()
+	close(insertErrs)
+	// the offsets of the rows are reserved by preInsert already, the segment can't be consistent
+	// if the rows or their validity are not inserted, so the query node fails
+	for err := range insertErrs {
+		log.Error("failed to insert", zap.Error(err))
+		panic(err)
+	}
 
 	delData := &deleteData{
-		deleteIDs:        make(map[UniqueID][]int64),
+		deleteIDs:        make(map[UniqueID][]storage.PrimaryKey),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
 		deleteOffset:     make(map[UniqueID]int64),
 	}
@@ -236,7 +271,7 @@ func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, d
 			log.Warn(err.Error())
 			continue
 		}
-		pks, err := filterSegmentsByPKs(msg.PrimaryKeys, segment)
+		pks, err := filterSegmentsByPKs(storage.ParseIDs2PrimaryKeys(msg.PrimaryKeys), segment)
 		if err != nil {
 			log.Warn(err.Error())
 			continue
@@ -250,18 +285,16 @@ func processDeleteMessages(replica ReplicaInterface, msg *msgstream.DeleteMsg, d
 }
 
 // filterSegmentsByPKs would filter segments by primary keys
-func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
+func filterSegmentsByPKs(pks []storage.PrimaryKey, segment *Segment) ([]storage.PrimaryKey, error) {
 	if pks == nil {
 		return nil, fmt.Errorf("pks is nil when getSegmentsByPKs")
 	}
 	if segment == nil {
 		return nil, fmt.Errorf("segments is nil when getSegmentsByPKs")
 	}
-	buf := make([]byte, 8)
-	res := make([]int64, 0)
+	res := make([]storage.PrimaryKey, 0)
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		exist := segment.pkFilter.Test(buf)
+		exist := segment.pkFilter.Test(pk.Bytes())
 		if exist {
 			res = append(res, pk)
 		}
@@ -270,20 +303,38 @@ func filterSegmentsByPKs(pks []int64, segment *Segment) ([]int64, error) {
 	return res, nil
 }
 
-// insert would execute insert operations for specific growing segment
-func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID, wg *sync.WaitGroup) {
+// appendValidData appends the validity of the nullable fields of an insert message to the segment,
+// the rows before rowOffset without validity are valid
+func appendValidData(iData *insertData, segmentID UniqueID, rowOffset int, fieldsValidData []*internalpb.FieldValidData) {
+	if len(fieldsValidData) == 0 {
+		return
+	}
+	if iData.insertValidData[segmentID] == nil {
+		iData.insertValidData[segmentID] = make(map[FieldID][]bool)
+	}
+	for _, fieldValidData := range fieldsValidData {
+		validData := iData.insertValidData[segmentID][fieldValidData.GetFieldID()]
+		for len(validData) < rowOffset {
+			validData = append(validData, true)
+		}
+		iData.insertValidData[segmentID][fieldValidData.GetFieldID()] = append(validData, fieldValidData.GetValidData()...)
+	}
+}
+
+// insert would execute insert operations for specific growing segment,
+// it returns the error if the rows or the validity of the rows can't be inserted
+func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID, wg *sync.WaitGroup) error {
+	defer wg.Done()
 	log.Debug("QueryNode::iNode::insert", zap.Any("SegmentID", segmentID))
 	var targetSegment, err = iNode.streamingReplica.getSegmentByID(segmentID)
 	if err != nil {
 		log.Warn("cannot find segment:", zap.Int64("segmentID", segmentID))
 		// TODO: add error handling
-		wg.Done()
-		return
+		return nil
 	}
 
 	if targetSegment.segmentType != segmentTypeGrowing {
-		wg.Done()
-		return
+		return nil
 	}
 
 	ids := iData.insertIDs[segmentID]
@@ -294,13 +345,19 @@ func (iNode *insertNode) insert(iData *insertData, segmentID UniqueID, wg *sync.
 	err = targetSegment.segmentInsert(offsets, &ids, &timestamps, &records)
 	if err != nil {
 		log.Debug("QueryNode: targetSegmentInsert failed", zap.Error(err))
-		// TODO: add error handling
-		wg.Done()
-		return
+		return fmt.Errorf("failed to insert into segment %d, %w", segmentID, err)
+	}
+
+	for fieldID, validData := range iData.insertValidData[segmentID] {
+		err = targetSegment.segmentLoadValidData(fieldID, offsets, validData)
+		if err != nil {
+			log.Warn("QueryNode: load valid data failed", zap.Int64("fieldID", fieldID), zap.Error(err))
+			return fmt.Errorf("failed to load valid data of field %d into segment %d, %w", fieldID, segmentID, err)
+		}
 	}
 
 	log.Debug("Do insert done", zap.Int("len", len(iData.insertIDs[segmentID])), zap.Int64("collectionID", targetSegment.collectionID), zap.Int64("segmentID", segmentID))
-	wg.Done()
+	return nil
 }
 
 // delete would execute delete operations for specific growing segment
@@ -332,7 +389,7 @@ func (iNode *insertNode) delete(deleteData *deleteData, segmentID UniqueID, wg *
 
 // TODO: remove this function to proper file
 // getPrimaryKeys would get primary keys by insert messages
-func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface) ([]int64, error) {
+func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface) ([]storage.PrimaryKey, error) {
 	if len(msg.RowIDs) != len(msg.Timestamps) || len(msg.RowIDs) != len(msg.RowData) {
 		log.Warn("misaligned messages detected")
 		return nil, errors.New("misaligned messages detected")
@@ -344,72 +401,53 @@ func getPrimaryKeys(msg *msgstream.InsertMsg, streamingReplica ReplicaInterface)
 		log.Warn(err.Error())
 		return nil, err
 	}
-	offset := 0
-	for _, field := range collection.schema.Fields {
+	fields := typeutil.GetUserFields(collection.schema)
+	pkIndex := -1
+	for i, field := range fields {
 		if field.IsPrimaryKey {
+			pkIndex = i
 			break
 		}
-		switch field.DataType {
-		case schemapb.DataType_Bool:
-			offset++
-		case schemapb.DataType_Int8:
-			offset++
-		case schemapb.DataType_Int16:
-			offset += 2
-		case schemapb.DataType_Int32:
-			offset += 4
-		case schemapb.DataType_Int64:
-			offset += 8
-		case schemapb.DataType_Float:
-			offset += 4
-		case schemapb.DataType_Double:
-			offset += 8
-		case schemapb.DataType_FloatVector:
-			for _, t := range field.TypeParams {
-				if t.Key == "dim" {
-					dim, err := strconv.Atoi(t.Value)
-					if err != nil {
-						log.Error("strconv wrong on get dim", zap.Error(err))
-						break
-					}
-					offset += dim * 4
-					break
-				}
-			}
-		case schemapb.DataType_BinaryVector:
-			for _, t := range field.TypeParams {
-				if t.Key == "dim" {
-					dim, err := strconv.Atoi(t.Value)
-					if err != nil {
-						log.Error("strconv wrong on get dim", zap.Error(err))
-						return nil, err
-					}
-					offset += dim / 8
-					break
-				}
-			}
-		}
 	}
-
-	blobReaders := make([]io.Reader, len(msg.RowData))
-	for i, blob := range msg.RowData {
-		blobReaders[i] = bytes.NewReader(blob.GetValue()[offset : offset+8])
+	if pkIndex < 0 {
+		return nil, fmt.Errorf("no primary key field in collection %d", collectionID)
+	}
+	pkField := fields[pkIndex]
+	if pkField.DataType != schemapb.DataType_Int64 && pkField.DataType != schemapb.DataType_VarChar {
+		return nil, fmt.Errorf("unsupported primary key type %s", pkField.DataType.String())
 	}
-	pks := make([]int64, len(blobReaders))
 
-	for i, reader := range blobReaders {
-		err := binary.Read(reader, common.Endian, &pks[i])
+	pks := make([]storage.PrimaryKey, 0, len(msg.RowData))
+	for _, blob := range msg.RowData {
+		values, err := typeutil.SplitRowData(fields[:pkIndex+1], blob.GetValue())
 		if err != nil {
-			log.Warn("binary read blob value failed", zap.Error(err))
+			log.Warn("split row data failed", zap.Error(err))
 			return nil, err
 		}
+		switch pkField.DataType {
+		case schemapb.DataType_Int64:
+			var pk int64
+			err := binary.Read(bytes.NewReader(values[pkIndex]), common.Endian, &pk)
+			if err != nil {
+				log.Warn("binary read blob value failed", zap.Error(err))
+				return nil, err
+			}
+			pks = append(pks, storage.NewInt64PrimaryKey(pk))
+		case schemapb.DataType_VarChar:
+			pk, _, err := typeutil.DecodeVarChar(values[pkIndex])
+			if err != nil {
+				log.Warn("decode varchar primary key failed", zap.Error(err))
+				return nil, err
+			}
+			pks = append(pks, storage.NewVarCharPrimaryKey(pk))
+		}
 	}
 
 	return pks, nil
 }
 
 // newInsertNode returns a new insertNode
-func newInsertNode(streamingReplica ReplicaInterface) *insertNode {
+func newInsertNode(streamingReplica ReplicaInterface, historicalReplica ReplicaInterface, rootCoord types.RootCoord) *insertNode {
 	maxQueueLength := Params.QueryNodeCfg.FlowGraphMaxQueueLength
 	maxParallelism := Params.QueryNodeCfg.FlowGraphMaxParallelism
 
@@ -418,7 +456,9 @@ func newInsertNode(streamingReplica ReplicaInterface) *insertNode {
 	baseNode.SetMaxParallelism(maxParallelism)
 
 	return &insertNode{
-		baseNode:         baseNode,
-		streamingReplica: streamingReplica,
+		baseNode:          baseNode,
+		streamingReplica:  streamingReplica,
+		historicalReplica: historicalReplica,
+		rootCoord:         rootCoord,
 	}
 }
@@ -26,6 +26,7 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 )
 
@@ -58,8 +59,8 @@ func genFlowGraphDeleteData() (*deleteData, error) {
 		return nil, err
 	}
 	dData := &deleteData{
-		deleteIDs: map[UniqueID][]UniqueID{
-			defaultSegmentID: deleteMsg.PrimaryKeys,
+		deleteIDs: map[UniqueID][]storage.PrimaryKey{
+			defaultSegmentID: storage.ParseIDs2PrimaryKeys(deleteMsg.PrimaryKeys),
 		},
 		deleteTimestamps: map[UniqueID][]Timestamp{
 			defaultSegmentID: deleteMsg.Timestamps,
@@ -75,7 +76,7 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 	t.Run("test insert", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -90,13 +91,14 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		insertNode.insert(insertData, defaultSegmentID, wg)
+		err = insertNode.insert(insertData, defaultSegmentID, wg)
+		assert.NoError(t, err)
 	})
 
 	t.Run("test segment insert error", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -112,22 +114,24 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
 		insertData.insertRecords[defaultSegmentID][0].Value = insertData.insertRecords[defaultSegmentID][0].Value[:len(insertData.insertRecords[defaultSegmentID][0].Value)/2]
-		insertNode.insert(insertData, defaultSegmentID, wg)
+		err = insertNode.insert(insertData, defaultSegmentID, wg)
+		assert.Error(t, err)
 	})
 
 	t.Run("test no target segment", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		insertNode.insert(nil, defaultSegmentID, wg)
+		err = insertNode.insert(nil, defaultSegmentID, wg)
+		assert.NoError(t, err)
 	})
 
 	t.Run("test invalid segmentType", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -139,7 +143,8 @@ func TestFlowGraphInsertNode_insert(t *testing.T) {
 
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
-		insertNode.insert(nil, defaultSegmentID, wg)
+		err = insertNode.insert(nil, defaultSegmentID, wg)
+		assert.NoError(t, err)
 	})
 }
 
@@ -147,7 +152,7 @@ func TestFlowGraphInsertNode_delete(t *testing.T) {
 	t.Run("test insert and delete", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -173,7 +178,7 @@ func TestFlowGraphInsertNode_delete(t *testing.T) {
 	t.Run("test only delete", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -193,7 +198,7 @@ func TestFlowGraphInsertNode_delete(t *testing.T) {
 	t.Run("test segment delete error", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -214,7 +219,7 @@ func TestFlowGraphInsertNode_delete(t *testing.T) {
 	t.Run("test no target segment", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 		wg := &sync.WaitGroup{}
 		wg.Add(1)
 		insertNode.delete(nil, defaultSegmentID, wg)
@@ -225,7 +230,7 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test operate", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -262,7 +267,7 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test invalid partitionID", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -288,7 +293,7 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test collection partition not exist", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -314,7 +319,7 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test partition not exist", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -339,7 +344,7 @@ func TestFlowGraphInsertNode_operate(t *testing.T) {
 	t.Run("test invalid input length", func(t *testing.T) {
 		streaming, err := genSimpleReplica()
 		assert.NoError(t, err)
-		insertNode := newInsertNode(streaming)
+		insertNode := newInsertNode(streaming, nil, nil)
 
 		err = streaming.addSegment(defaultSegmentID,
 			defaultPartitionID,
@@ -377,15 +382,15 @@ func TestGetSegmentsByPKs(t *testing.T) {
 		segmentID: 1,
 		pkFilter:  filter,
 	}
-	pks, err := filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, segment)
+	pks, err := filterSegmentsByPKs(newInt64PrimaryKeys(0, 1, 2, 3, 4), segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 3)
 
-	pks, err = filterSegmentsByPKs([]int64{}, segment)
+	pks, err = filterSegmentsByPKs([]storage.PrimaryKey{}, segment)
 	assert.Nil(t, err)
 	assert.Equal(t, len(pks), 0)
 	_, err = filterSegmentsByPKs(nil, segment)
 	assert.NotNil(t, err)
-	_, err = filterSegmentsByPKs([]int64{0, 1, 2, 3, 4}, nil)
+	_, err = filterSegmentsByPKs(newInt64PrimaryKeys(0, 1, 2, 3, 4), nil)
 	assert.NotNil(t, err)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/flowgraph"
 	"github.com/milvus-io/milvus/internal/util/mqclient"
 )
@@ -43,9 +44,11 @@ type queryNodeFlowGraph struct {
 func newQueryNodeFlowGraph(ctx context.Context,
 	collectionID UniqueID,
 	streamingReplica ReplicaInterface,
+	historicalReplica ReplicaInterface,
 	tSafeReplica TSafeReplicaInterface,
 	channel Channel,
-	factory msgstream.Factory) *queryNodeFlowGraph {
+	factory msgstream.Factory,
+	rootCoord types.RootCoord) *queryNodeFlowGraph {
 
 	ctx1, cancel := context.WithCancel(ctx)
 
@@ -59,7 +62,7 @@ func newQueryNodeFlowGraph(ctx context.Context,
 
 	var dmStreamNode node = q.newDmInputNode(ctx1, factory)
 	var filterDmNode node = newFilteredDmNode(streamingReplica, collectionID)
-	var insertNode node = newInsertNode(streamingReplica)
+	var insertNode node = newInsertNode(streamingReplica, historicalReplica, rootCoord)
 	var serviceTimeNode node = newServiceTimeNode(ctx1, tSafeReplica, collectionID, channel, factory)
 
 	q.flowGraph.AddNode(dmStreamNode)
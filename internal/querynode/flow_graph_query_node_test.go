@@ -40,9 +40,11 @@ func TestQueryNodeFlowGraph_consumerFlowGraph(t *testing.T) {
 	fg := newQueryNodeFlowGraph(ctx,
 		defaultCollectionID,
 		streamingReplica,
+		nil,
 		tSafe,
 		defaultDMLChannel,
-		fac)
+		fac,
+		nil)
 
 	err = fg.consumerFlowGraph(defaultDMLChannel, defaultSubName)
 	assert.NoError(t, err)
@@ -65,9 +67,11 @@ func TestQueryNodeFlowGraph_seekQueryNodeFlowGraph(t *testing.T) {
 	fg := newQueryNodeFlowGraph(ctx,
 		defaultCollectionID,
 		streamingReplica,
+		nil,
 		tSafe,
 		defaultDMLChannel,
-		fac)
+		fac,
+		nil)
 
 	position := &internalpb.MsgPosition{
 		ChannelName: defaultDMLChannel,
@@ -18,13 +18,30 @@ package querynode
 
 import (
 	"context"
+	"time"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
+// getQuotaMetrics returns the metrics used by RootCoord to apply the backpressure of DML
+func getQuotaMetrics(node *QueryNode) metricsinfo.QueryNodeQuotaMetrics {
+	ret := metricsinfo.QueryNodeQuotaMetrics{}
+	if node.tSafeReplica != nil {
+		if minTSafe := node.tSafeReplica.getMinTSafe(); minTSafe != typeutil.ZeroTimestamp {
+			physical, _ := tsoutil.ParseTS(minTSafe)
+			ret.MaxTimeTickDelay = time.Since(physical)
+		}
+	}
+	if node.streaming != nil {
+		ret.GrowingSegmentsSize = node.streaming.replica.getSegmentsMemSize()
+	}
+	return ret
+}
+
 // getSystemInfoMetrics returns metrics info of QueryNode
 func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest, node *QueryNode) (*milvuspb.GetMetricsResponse, error) {
 	usedMem := metricsinfo.GetUsedMemoryCount()
@@ -57,6 +74,7 @@ func getSystemInfoMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest,
 
 			SimdType: Params.KnowhereCfg.SimdType,
 		},
+		QuotaMetrics: getQuotaMetrics(node),
 	}
 	metricsinfo.FillDeployMetricsWithEnv(&nodeInfos.SystemInfo)
 
@@ -19,6 +19,7 @@ package querynode
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -26,6 +27,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
 )
 
 func TestGetSystemInfoMetrics(t *testing.T) {
@@ -47,3 +49,20 @@ func TestGetSystemInfoMetrics(t *testing.T) {
 	assert.NoError(t, err)
 	resp.Status.ErrorCode = commonpb.ErrorCode_Success
 }
+
+func TestGetQuotaMetrics(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	node, err := genSimpleQueryNode(ctx)
+	assert.NoError(t, err)
+
+	metrics := getQuotaMetrics(node)
+	assert.True(t, metrics.GrowingSegmentsSize >= 0)
+
+	node.tSafeReplica.addTSafe(defaultDMLChannel)
+	err = node.tSafeReplica.setTSafe(defaultDMLChannel, tsoutil.ComposeTSByTime(time.Now().Add(-time.Minute), 0))
+	assert.NoError(t, err)
+	metrics = getQuotaMetrics(node)
+	assert.True(t, metrics.MaxTimeTickDelay >= time.Minute)
+}
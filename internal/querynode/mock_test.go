@@ -721,12 +721,18 @@ func genSimpleRowIDField() []IntPrimaryKey {
 	return ids
 }
 
-func genSimpleDeleteID() []IntPrimaryKey {
+func genSimpleDeleteID() *schemapb.IDs {
 	ids := make([]IntPrimaryKey, defaultDelLength)
 	for i := 0; i < defaultDelLength; i++ {
 		ids[0] = IntPrimaryKey(i)
 	}
-	return ids
+	return &schemapb.IDs{
+		IdField: &schemapb.IDs_IntId{
+			IntId: &schemapb.LongArray{
+				Data: ids,
+			},
+		},
+	}
 }
 
 func genMsgStreamBaseMsg() msgstream.BaseMsg {
@@ -1311,7 +1317,7 @@ func genSimpleQueryNode(ctx context.Context) (*QueryNode, error) {
 	if err != nil {
 		return nil, err
 	}
-	node.dataSyncService = newDataSyncService(node.queryNodeLoopCtx, streaming.replica, historical.replica, node.tSafeReplica, node.msFactory)
+	node.dataSyncService = newDataSyncService(node.queryNodeLoopCtx, streaming.replica, historical.replica, node.tSafeReplica, node.msFactory, nil)
 
 	node.streaming = streaming
 	node.historical = historical
@@ -1448,3 +1454,11 @@ func genFieldData(fieldName string, fieldID int64, fieldType schemapb.DataType,
 
 	return fieldData
 }
+
+func newInt64PrimaryKeys(pks ...int64) []storage.PrimaryKey {
+	primaryKeys := make([]storage.PrimaryKey, 0, len(pks))
+	for _, pk := range pks {
+		primaryKeys = append(primaryKeys, storage.NewInt64PrimaryKey(pk))
+	}
+	return primaryKeys
+}
@@ -29,11 +29,17 @@ import (
 	"errors"
 	"fmt"
 	"unsafe"
+
+	"github.com/golang/protobuf/proto"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 )
 
 // SearchPlan is a wrapper of the underlying C-structure C.CSearchPlan
 type SearchPlan struct {
 	cSearchPlan C.CSearchPlan
+	// rangeSearchInfo is not nil if the plan is a range search
+	rangeSearchInfo *planpb.QueryInfo
 }
 
 // createSearchPlan returns a new SearchPlan and error
@@ -70,6 +76,15 @@ func createSearchPlanByExpr(col *Collection, expr []byte) (*SearchPlan, error) {
 	}
 
 	var newPlan = &SearchPlan{cSearchPlan: cPlan}
+
+	var planNode planpb.PlanNode
+	if err := proto.Unmarshal(expr, &planNode); err != nil {
+		newPlan.delete()
+		return nil, err
+	}
+	if queryInfo := planNode.GetVectorAnns().GetQueryInfo(); queryInfo.GetIsRangeSearch() {
+		newPlan.rangeSearchInfo = queryInfo
+	}
 	return newPlan, nil
 }
 
@@ -85,6 +100,11 @@ func (plan *SearchPlan) getMetricType() string {
 	return metricType
 }
 
+// setExpireTimestamp makes the plan filter out the entities inserted before the expire timestamp, zero means no entity is expired
+func (plan *SearchPlan) setExpireTimestamp(expireTs Timestamp) {
+	C.SetSearchPlanExpireTimestamp(plan.cSearchPlan, C.uint64_t(expireTs))
+}
+
 func (plan *SearchPlan) delete() {
 	C.DeleteSearchPlan(plan.cSearchPlan)
 }
@@ -157,6 +177,11 @@ func createRetrievePlanByExpr(col *Collection, expr []byte, timestamp Timestamp)
 	return newPlan, nil
 }
 
+// setExpireTimestamp makes the plan filter out the entities inserted before the expire timestamp, zero means no entity is expired
+func (plan *RetrievePlan) setExpireTimestamp(expireTs Timestamp) {
+	C.SetRetrievePlanExpireTimestamp(plan.cRetrievePlan, C.uint64_t(expireTs))
+}
+
 func (plan *RetrievePlan) delete() {
 	C.DeleteRetrievePlan(plan.cRetrievePlan)
 }
@@ -45,6 +45,18 @@ func (m *mockProxy) ReleaseDQLMessageStream(ctx context.Context, in *proxypb.Rel
 	return nil, nil
 }
 
+func (m *mockProxy) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *mockProxy) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
+func (m *mockProxy) SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	return nil, nil
+}
+
 func (m *mockProxy) SendSearchResult(ctx context.Context, req *internalpb.SearchResults) (*commonpb.Status, error) {
 	return &commonpb.Status{
 		ErrorCode: commonpb.ErrorCode_Success,
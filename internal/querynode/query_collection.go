@@ -21,6 +21,7 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"sort"
 	"sync"
 	"unsafe"
 
@@ -36,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
 	"github.com/milvus-io/milvus/internal/storage"
@@ -709,8 +711,6 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 		hits = append(hits, &hit)
 	}
 
-	blobOffset := 0
-	// skip id
 	numQueries := len(rawHits)
 	pbHits := &milvuspb.Hits{}
 	err := proto.Unmarshal(rawHits[0], pbHits)
@@ -719,12 +719,18 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 	}
 	topK := len(pbHits.IDs)
 
-	blobOffset += 8
 	var ids []int64
 	var scores []float32
+	var rows [][]byte
 	for _, hit := range hits {
 		ids = append(ids, hit.IDs...)
 		scores = append(scores, hit.Scores...)
+		rows = append(rows, hit.RowData...)
+	}
+	// the rows vary in size with the varchar fields, skip id
+	offsets := make([]int, len(rows))
+	for i := range offsets {
+		offsets[i] = 8
 	}
 
 	finalResult := &schemapb.SearchResultData{
@@ -749,12 +755,11 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 		case schemapb.DataType_Bool:
 			blobLen := 1
 			var colData []bool
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := dataBlob[0]
-					colData = append(colData, data != 0)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := dataBlob[0]
+				colData = append(colData, data != 0)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -768,16 +773,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Int8:
 			blobLen := 1
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(dataBlob[0])
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := int32(dataBlob[0])
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -791,16 +794,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Int16:
 			blobLen := 2
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(int16(common.Endian.Uint16(dataBlob)))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := int32(int16(common.Endian.Uint16(dataBlob)))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -814,16 +815,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Int32:
 			blobLen := 4
 			var colData []int32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int32(common.Endian.Uint32(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := int32(common.Endian.Uint32(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -837,16 +836,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Int64:
 			blobLen := 8
 			var colData []int64
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := int64(common.Endian.Uint64(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := int64(common.Endian.Uint64(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -860,16 +857,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Float:
 			blobLen := 4
 			var colData []float32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := math.Float32frombits(common.Endian.Uint32(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := math.Float32frombits(common.Endian.Uint32(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -883,16 +878,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_Double:
 			blobLen := 8
 			var colData []float64
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					data := math.Float64frombits(common.Endian.Uint64(dataBlob))
-					colData = append(colData, data)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				data := math.Float64frombits(common.Endian.Uint64(dataBlob))
+				colData = append(colData, data)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Scalars{
@@ -906,7 +899,28 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
+		case schemapb.DataType_VarChar:
+			var colData []string
+			for i, row := range rows {
+				data, blobLen, err := typeutil.DecodeVarChar(row[offsets[i]:])
+				if err != nil {
+					return nil, err
+				}
+				offsets[i] += blobLen
+				colData = append(colData, data)
+			}
+			newCol := &schemapb.FieldData{
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_StringData{
+							StringData: &schemapb.StringArray{
+								Data: colData,
+							},
+						},
+					},
+				},
+			}
+			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
 		case schemapb.DataType_FloatVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -914,15 +928,14 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			blobLen := dim * 4
 			var colData []float32
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					//ref https://github.com/golang/go/wiki/cgo#turning-c-arrays-into-go-slices
-					/* #nosec G103 */
-					ptr := unsafe.Pointer(&dataBlob[0])
-					farray := (*[1 << 28]float32)(ptr)
-					colData = append(colData, farray[:dim:dim]...)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				//ref https://github.com/golang/go/wiki/cgo#turning-c-arrays-into-go-slices
+				/* #nosec G103 */
+				ptr := unsafe.Pointer(&dataBlob[0])
+				farray := (*[1 << 28]float32)(ptr)
+				colData = append(colData, farray[:dim:dim]...)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Vectors{
@@ -937,7 +950,6 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		case schemapb.DataType_BinaryVector:
 			dim, err := schema.GetVectorDimFromID(fieldID)
 			if err != nil {
@@ -945,11 +957,10 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 			}
 			blobLen := dim / 8
 			var colData []byte
-			for _, hit := range hits {
-				for _, row := range hit.RowData {
-					dataBlob := row[blobOffset : blobOffset+blobLen]
-					colData = append(colData, dataBlob...)
-				}
+			for i, row := range rows {
+				dataBlob := row[offsets[i] : offsets[i]+blobLen]
+				offsets[i] += blobLen
+				colData = append(colData, dataBlob...)
 			}
 			newCol := &schemapb.FieldData{
 				Field: &schemapb.FieldData_Vectors{
@@ -962,10 +973,49 @@ func translateHits(schema *typeutil.SchemaHelper, fieldIDs []int64, rawHits [][]
 				},
 			}
 			finalResult.FieldsData = append(finalResult.FieldsData, newCol)
-			blobOffset += blobLen
 		default:
 			return nil, fmt.Errorf("unsupported data type %s", schemapb.DataType_name[int32(fieldMeta.DataType)])
 		}
+
+		// a nullable field is followed by a byte of the validity of the row
+		if fieldMeta.GetNullable() {
+			var validData []bool
+			for i, row := range rows {
+				validData = append(validData, row[offsets[i]] != 0)
+				offsets[i]++
+			}
+			finalResult.FieldsData[len(finalResult.FieldsData)-1].ValidData = validData
+		}
+	}
+
+	// the ids of hits are the row ids for a varchar primary key, which are replaced by the primary keys in the output fields
+	pkField, err := schema.GetPrimaryKeyField()
+	if err == nil && pkField.DataType == schemapb.DataType_VarChar {
+		pkIdx := -1
+		for i, fieldID := range fieldIDs {
+			if fieldID == pkField.FieldID {
+				pkIdx = i
+				break
+			}
+		}
+		if pkIdx < 0 {
+			return nil, fmt.Errorf("varchar primary key field %s is not in the output fields", pkField.Name)
+		}
+		pks := finalResult.FieldsData[pkIdx].GetScalars().GetStringData().GetData()
+		strIds := make([]string, len(ids))
+		for i, id := range ids {
+			// keep the empty string for the invalid id
+			if id != -1 {
+				strIds[i] = pks[i]
+			}
+		}
+		finalResult.Ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: strIds,
+				},
+			},
+		}
 	}
 
 	return finalResult, nil
@@ -1011,6 +1061,7 @@ func (q *queryCollection) search(msg queryMsg) error {
 			return err
 		}
 	}
+	plan.setExpireTimestamp(searchMsg.ExpireTimestamp)
 	topK := plan.getTopK()
 	if topK == 0 {
 		return fmt.Errorf("limit must be greater than 0, msgID = %d", searchMsg.ID())
@@ -1164,10 +1215,17 @@ func (q *queryCollection) search(msg queryMsg) error {
 		if err != nil {
 			return err
 		}
+		if plan.rangeSearchInfo != nil {
+			transformed = filterRangeSearchResultData(transformed, plan.rangeSearchInfo)
+		}
 		byteBlobs, err := proto.Marshal(transformed)
 		if err != nil {
 			return err
 		}
+		// no result within the range, the nil blob is skipped by proxy
+		if plan.rangeSearchInfo != nil && typeutil.GetSizeOfIDs(transformed.Ids) == 0 {
+			byteBlobs = nil
+		}
 
 		resultChannelInt := 0
 		searchResultMsg := &msgstream.SearchResultMsg{
@@ -1252,6 +1310,7 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 		return err
 	}
 	defer plan.delete()
+	plan.setExpireTimestamp(retrieveMsg.ExpireTimestamp)
 
 	tr := timerecord.NewTimeRecorder(fmt.Sprintf("retrieve %d", retrieveMsg.CollectionID))
 
@@ -1298,12 +1357,25 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	log.Debug("streaming retrieve", zap.Int64("msgID", retrieveMsg.ID()), zap.Int64("collectionID", collectionID), zap.Int64s("retrieve partitionIDs", streamingPartitionRetrived), zap.Int64s("retrieve segmentIDs", streamingSegmentRetrived))
 	tr.Record(fmt.Sprintf("streaming retrieve done, msgID = %d", retrieveMsg.ID()))
 
-	result, err := mergeRetrieveResults(mergeList)
+	result, err := mergeRetrieveResults(mergeList, retrieveMsg.Limit)
 	if err != nil {
 		return err
 	}
 	tr.Record(fmt.Sprintf("merge result done, msgID = %d", retrieveMsg.ID()))
 
+	var planNode planpb.PlanNode
+	if err := proto.Unmarshal(expr, &planNode); err != nil {
+		return err
+	}
+	if len(planNode.Aggregates) > 0 {
+		// the entities of segments are deduplicated by the merge, only the partial aggregates are sent to proxy
+		result, err = aggregateRetrieveResults(result, &planNode, collection.schema)
+		if err != nil {
+			return err
+		}
+		tr.Record(fmt.Sprintf("aggregate result done, msgID = %d", retrieveMsg.ID()))
+	}
+
 	resultChannelInt := 0
 	retrieveResultMsg := &msgstream.RetrieveResultMsg{
 		BaseMsg: msgstream.BaseMsg{Ctx: retrieveMsg.Ctx, HashValues: []uint32{uint32(resultChannelInt)}},
@@ -1337,10 +1409,19 @@ func (q *queryCollection) retrieve(msg queryMsg) error {
 	return nil
 }
 
-func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcorepb.RetrieveResults, error) {
+// mergeRetrieveResults merges the results of segments and removes duplicates.
+// If limit is set, only the limit entities with the smallest primary keys are kept.
+func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults, limit int64) (*segcorepb.RetrieveResults, error) {
+	type retrieveRow struct {
+		pk  storage.PrimaryKey
+		rr  *segcorepb.RetrieveResults
+		idx int64
+	}
+
 	var ret *segcorepb.RetrieveResults
+	var rows []retrieveRow
 	var skipDupCnt int64
-	var idSet = make(map[int64]struct{})
+	var idSet = make(map[storage.PrimaryKey]struct{})
 
 	// merge results and remove duplicates
 	for _, rr := range retrieveResults {
@@ -1351,13 +1432,7 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 
 		if ret == nil {
 			ret = &segcorepb.RetrieveResults{
-				Ids: &schemapb.IDs{
-					IdField: &schemapb.IDs_IntId{
-						IntId: &schemapb.LongArray{
-							Data: []int64{},
-						},
-					},
-				},
+				Ids:        &schemapb.IDs{},
 				FieldsData: make([]*schemapb.FieldData, len(rr.FieldsData)),
 			}
 		}
@@ -1366,11 +1441,9 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 			return nil, fmt.Errorf("mismatch FieldData in RetrieveResults")
 		}
 
-		dstIds := ret.Ids.GetIntId()
-		for i, id := range rr.Ids.GetIntId().GetData() {
+		for i, id := range storage.ParseIDs2PrimaryKeys(rr.Ids) {
 			if _, ok := idSet[id]; !ok {
-				dstIds.Data = append(dstIds.Data, id)
-				typeutil.AppendFieldData(ret.FieldsData, rr.FieldsData, int64(i))
+				rows = append(rows, retrieveRow{pk: id, rr: rr, idx: int64(i)})
 				idSet[id] = struct{}{}
 			} else {
 				// primary keys duplicate
@@ -1380,6 +1453,20 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 	}
 	log.Debug("skip duplicated query result", zap.Int64("count", skipDupCnt))
 
+	if limit > 0 && int64(len(rows)) > limit {
+		sort.Slice(rows, func(i, j int) bool {
+			return rows[i].pk.LT(rows[j].pk)
+		})
+		rows = rows[:limit]
+	}
+
+	if ret != nil {
+		for _, row := range rows {
+			typeutil.AppendPKs(ret.Ids, row.pk.GetValue())
+			typeutil.AppendFieldData(ret.FieldsData, row.rr.FieldsData, row.idx)
+		}
+	}
+
 	// not found, return default values indicating not result found
 	if ret == nil {
 		ret = &segcorepb.RetrieveResults{
@@ -1391,6 +1478,55 @@ func mergeRetrieveResults(retrieveResults []*segcorepb.RetrieveResults) (*segcor
 	return ret, nil
 }
 
+// aggregateRetrieveResults evaluates the partial aggregates of plan on the merged entities of segments.
+// The result has no ids and a field data with a single value for each aggregate, which proxy merges with
+// the partial aggregates of other query nodes: counts and sums are added up, mins and maxes are compared.
+func aggregateRetrieveResults(result *segcorepb.RetrieveResults, plan *planpb.PlanNode, schema *schemapb.CollectionSchema) (*segcorepb.RetrieveResults, error) {
+	helper, err := typeutil.CreateSchemaHelper(schema)
+	if err != nil {
+		return nil, err
+	}
+	getFieldData := func(fieldID int64) *schemapb.FieldData {
+		for i, id := range plan.OutputFieldIds {
+			if id == fieldID && i < len(result.FieldsData) {
+				return result.FieldsData[i]
+			}
+		}
+		return nil
+	}
+
+	ret := &segcorepb.RetrieveResults{
+		Ids:        &schemapb.IDs{},
+		FieldsData: make([]*schemapb.FieldData, 0, len(plan.Aggregates)),
+	}
+	for _, aggregate := range plan.Aggregates {
+		var fieldData *schemapb.FieldData
+		if aggregate.Op == planpb.Aggregate_Count {
+			count := int64(typeutil.GetSizeOfIDs(result.GetIds()))
+			fieldData = &schemapb.FieldData{
+				Type: schemapb.DataType_Int64,
+				Field: &schemapb.FieldData_Scalars{
+					Scalars: &schemapb.ScalarField{
+						Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: []int64{count}}},
+					},
+				},
+			}
+		} else {
+			field, err := helper.GetFieldFromID(aggregate.FieldId)
+			if err != nil {
+				return nil, err
+			}
+			fieldData, err = typeutil.AggregateFieldData(aggregate.Op, field.DataType, getFieldData(aggregate.FieldId))
+			if err != nil {
+				return nil, err
+			}
+		}
+		fieldData.FieldId = aggregate.FieldId
+		ret.FieldsData = append(ret.FieldsData, fieldData)
+	}
+	return ret, nil
+}
+
 func (q *queryCollection) publishSearchResultWithCtx(ctx context.Context, result *internalpb.SearchResults, nodeID UniqueID) error {
 	return q.sessionManager.SendSearchResult(ctx, nodeID, result)
 }
@@ -37,6 +37,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/proto/segcorepb"
@@ -471,6 +472,36 @@ func TestQueryCollection_TranslateHits(t *testing.T) {
 		assert.NoError(t, err)
 	})
 
+	t.Run("test nullable field", func(t *testing.T) {
+		schema := &schemapb.CollectionSchema{
+			Name:   defaultCollectionName,
+			AutoID: true,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: fieldID, Name: "nullable", DataType: schemapb.DataType_Int64, Nullable: true},
+			},
+		}
+		schemaHelper, err := typeutil.CreateSchemaHelper(schema)
+		assert.NoError(t, err)
+
+		// each row is the id, the value and the validity
+		hit := &milvuspb.Hits{}
+		for i := 0; i < 2; i++ {
+			var buf bytes.Buffer
+			assert.NoError(t, binary.Write(&buf, common.Endian, int64(i)))
+			assert.NoError(t, binary.Write(&buf, common.Endian, int64(i*10)))
+			assert.NoError(t, binary.Write(&buf, common.Endian, i == 0))
+			hit.IDs = append(hit.IDs, int64(i))
+			hit.RowData = append(hit.RowData, buf.Bytes())
+		}
+		rawHit, err := proto.Marshal(hit)
+		assert.NoError(t, err)
+
+		result, err := translateHits(schemaHelper, fieldIDs, [][]byte{rawHit})
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{0, 10}, result.FieldsData[0].GetScalars().GetLongData().GetData())
+		assert.Equal(t, []bool{true, false}, result.FieldsData[0].GetValidData())
+	})
+
 	t.Run("test field with error type", func(t *testing.T) {
 		dataType := schemapb.DataType_FloatVector
 		_, err := translateHits(genSchema(dataType), fieldIDs, genRawHits(dataType))
@@ -571,13 +602,101 @@ func TestQueryCollection_mergeRetrieveResults(t *testing.T) {
 		FieldsData: fieldDataArray2,
 	}
 
-	result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2})
+	result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2}, 0)
 	assert.NoError(t, err)
 	assert.Equal(t, 2, len(result.FieldsData[0].GetScalars().GetLongData().Data))
 	assert.Equal(t, 2*Dim, len(result.FieldsData[1].GetVectors().GetFloatVector().Data))
 
-	_, err = mergeRetrieveResults(nil)
+	result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2}, 1)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, result.Ids.GetIntId().Data)
+	assert.Equal(t, []int64{11}, result.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, Dim, len(result.FieldsData[1].GetVectors().GetFloatVector().Data))
+
+	_, err = mergeRetrieveResults(nil, 0)
+	assert.NoError(t, err)
+
+	// varchar primary keys
+	genStrIds := func(pks ...string) *schemapb.IDs {
+		return &schemapb.IDs{IdField: &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: pks}}}
+	}
+	result1.Ids = genStrIds("b", "c")
+	result2.Ids = genStrIds("c", "a")
+	result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2}, 0)
 	assert.NoError(t, err)
+	assert.Equal(t, []string{"b", "c", "a"}, result.Ids.GetStrId().Data)
+	assert.Equal(t, []int64{11, 22, 22}, result.FieldsData[0].GetScalars().GetLongData().Data)
+
+	result, err = mergeRetrieveResults([]*segcorepb.RetrieveResults{result1, result2}, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b"}, result.Ids.GetStrId().Data)
+}
+
+func TestQueryCollection_aggregateRetrieveResults(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name: "test_aggregate",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "age", DataType: schemapb.DataType_Int32},
+			{FieldID: 102, Name: "score", DataType: schemapb.DataType_Float},
+			{FieldID: 103, Name: "flag", DataType: schemapb.DataType_Bool},
+		},
+	}
+	genResult := func(pks []int64, ages []int32, scores []float32) *segcorepb.RetrieveResults {
+		return &segcorepb.RetrieveResults{
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: pks,
+					},
+				},
+			},
+			Offset: pks,
+			FieldsData: []*schemapb.FieldData{
+				genFieldData("pk", 100, schemapb.DataType_Int64, pks, 1),
+				genFieldData("age", 101, schemapb.DataType_Int32, ages, 1),
+				genFieldData("score", 102, schemapb.DataType_Float, scores, 1),
+			},
+		}
+	}
+	plan := &planpb.PlanNode{
+		OutputFieldIds: []int64{100, 101, 102},
+		Aggregates: []*planpb.Aggregate{
+			{Op: planpb.Aggregate_Count},
+			{Op: planpb.Aggregate_Min, FieldId: 101},
+			{Op: planpb.Aggregate_Max, FieldId: 102},
+			{Op: planpb.Aggregate_Sum, FieldId: 101},
+		},
+	}
+
+	// the entities 2 and 3 are retrieved from both a sealed and a growing segment
+	result, err := mergeRetrieveResults([]*segcorepb.RetrieveResults{
+		genResult([]int64{1, 2, 3}, []int32{30, 10, 20}, []float32{0.5, 1.5, 1.0}),
+		genResult([]int64{2, 3, 4}, []int32{10, 20, 5}, []float32{1.5, 1.0, 0.5}),
+	}, 0)
+	assert.NoError(t, err)
+	ret, err := aggregateRetrieveResults(result, plan, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, typeutil.GetSizeOfIDs(ret.Ids))
+	assert.Equal(t, 4, len(ret.FieldsData))
+	assert.Equal(t, []int64{4}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, []int64{5}, ret.FieldsData[1].GetScalars().GetLongData().Data)
+	assert.Equal(t, []float64{1.5}, ret.FieldsData[2].GetScalars().GetDoubleData().Data)
+	assert.Equal(t, []int64{65}, ret.FieldsData[3].GetScalars().GetLongData().Data)
+
+	// nothing retrieved
+	result, err = mergeRetrieveResults(nil, 0)
+	assert.NoError(t, err)
+	ret, err = aggregateRetrieveResults(result, plan, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+	assert.Equal(t, 0, len(ret.FieldsData[1].GetScalars().GetLongData().Data))
+	assert.Equal(t, 0, len(ret.FieldsData[2].GetScalars().GetDoubleData().Data))
+	assert.Equal(t, []int64{0}, ret.FieldsData[3].GetScalars().GetLongData().Data)
+
+	plan.Aggregates = []*planpb.Aggregate{{Op: planpb.Aggregate_Sum, FieldId: 103}}
+	_, err = aggregateRetrieveResults(result, plan, schema)
+	assert.Error(t, err)
 }
 
 func TestQueryCollection_doUnsolvedQueryMsg(t *testing.T) {
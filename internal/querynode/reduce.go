@@ -28,6 +28,11 @@ import "C"
 import (
 	"errors"
 	"unsafe"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/distance"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // SearchResult contains a pointer to the search result in C++ memory
@@ -64,6 +69,50 @@ func reduceSearchResultsAndFillData(plan *SearchPlan, searchResults []*SearchRes
 	return nil
 }
 
+// inRange returns whether the score is within the range of range search,
+// the scores of L2 and HAMMING are negated distances.
+func inRange(score float32, rangeInfo *planpb.QueryInfo) bool {
+	if distance.PositivelyRelated(rangeInfo.GetMetricType()) {
+		return score > rangeInfo.GetRadius() && score <= rangeInfo.GetRangeFilter()
+	}
+	dis := -score
+	return dis < rangeInfo.GetRadius() && dis >= rangeInfo.GetRangeFilter()
+}
+
+// filterRangeSearchResultData removes the results out of the range of range search,
+// the number of results of each query is recorded in Topks.
+func filterRangeSearchResultData(data *schemapb.SearchResultData, rangeInfo *planpb.QueryInfo) *schemapb.SearchResultData {
+	ret := &schemapb.SearchResultData{
+		NumQueries: data.NumQueries,
+		TopK:       data.TopK,
+		FieldsData: make([]*schemapb.FieldData, len(data.FieldsData)),
+		Scores:     make([]float32, 0),
+		Ids:        &schemapb.IDs{},
+		Topks:      make([]int64, 0, data.NumQueries),
+	}
+
+	for i := int64(0); i < data.NumQueries; i++ {
+		var count int64
+		for j := i * data.TopK; j < (i+1)*data.TopK; j++ {
+			pk := typeutil.GetPK(data.Ids, j)
+			if typeutil.IsInvalidPK(pk) || !inRange(data.Scores[j], rangeInfo) {
+				continue
+			}
+			typeutil.AppendPKs(ret.Ids, pk)
+			ret.Scores = append(ret.Scores, data.Scores[j])
+			typeutil.AppendFieldData(ret.FieldsData, data.FieldsData, j)
+			count++
+		}
+		ret.Topks = append(ret.Topks, count)
+	}
+
+	// no field data is appended, nil field data can't be marshaled
+	if typeutil.GetSizeOfIDs(ret.Ids) == 0 {
+		ret.FieldsData = nil
+	}
+	return ret
+}
+
 func reorganizeSearchResults(searchResults []*SearchResult, numSegments int64) (*MarshaledHits, error) {
 	cSearchResults := make([]C.CSearchResult, 0)
 	for _, res := range searchResults {
@@ -26,6 +26,8 @@ import (
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 )
 
 func TestReduce_AllFunc(t *testing.T) {
@@ -117,3 +119,66 @@ func TestReduce_nilPlan(t *testing.T) {
 	err := reduceSearchResultsAndFillData(plan, nil, 1)
 	assert.Error(t, err)
 }
+
+func TestReduce_filterRangeSearchResultData(t *testing.T) {
+	genData := func(ids []int64, scores []float32) *schemapb.SearchResultData {
+		return &schemapb.SearchResultData{
+			NumQueries: 2,
+			TopK:       3,
+			Scores:     scores,
+			Ids: &schemapb.IDs{
+				IdField: &schemapb.IDs_IntId{
+					IntId: &schemapb.LongArray{
+						Data: ids,
+					},
+				},
+			},
+			FieldsData: []*schemapb.FieldData{
+				genFieldData("Int64Field", common.StartOfUserFieldID, schemapb.DataType_Int64, ids, 1),
+			},
+		}
+	}
+
+	t.Run("L2", func(t *testing.T) {
+		// scores of L2 are negated distances
+		data := genData([]int64{1, 2, 3, 4, 5, -1}, []float32{-0.5, -1.5, -2.5, -1.0, -3.0, -4.0})
+		rangeInfo := &planpb.QueryInfo{MetricType: "L2", IsRangeSearch: true, Radius: 2.0, RangeFilter: 1.0}
+		ret := filterRangeSearchResultData(data, rangeInfo)
+		assert.Equal(t, []int64{1, 1}, ret.Topks)
+		assert.Equal(t, []int64{2, 4}, ret.Ids.GetIntId().Data)
+		assert.Equal(t, []float32{-1.5, -1.0}, ret.Scores)
+		assert.Equal(t, []int64{2, 4}, ret.FieldsData[0].GetScalars().GetLongData().Data)
+	})
+
+	t.Run("IP", func(t *testing.T) {
+		data := genData([]int64{1, 2, 3, 4, 5, 6}, []float32{3.0, 2.0, 1.0, 0.9, 0.8, 0.7})
+		rangeInfo := &planpb.QueryInfo{MetricType: "IP", IsRangeSearch: true, Radius: 1.0, RangeFilter: float32(math.Inf(1))}
+		ret := filterRangeSearchResultData(data, rangeInfo)
+		assert.Equal(t, []int64{2, 0}, ret.Topks)
+		assert.Equal(t, []int64{1, 2}, ret.Ids.GetIntId().Data)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		data := genData([]int64{1, 2, 3, 4, 5, 6}, []float32{-3.0, -3.0, -3.0, -3.0, -3.0, -3.0})
+		rangeInfo := &planpb.QueryInfo{MetricType: "L2", IsRangeSearch: true, Radius: 2.0}
+		ret := filterRangeSearchResultData(data, rangeInfo)
+		assert.Equal(t, []int64{0, 0}, ret.Topks)
+		assert.Equal(t, 0, len(ret.Ids.GetIntId().GetData()))
+		assert.Nil(t, ret.FieldsData)
+	})
+
+	t.Run("varchar", func(t *testing.T) {
+		data := genData([]int64{1, 2, 3, 4, 5, 6}, []float32{3.0, 2.0, 1.0, 0.9, 0.8, 0.7})
+		data.Ids = &schemapb.IDs{
+			IdField: &schemapb.IDs_StrId{
+				StrId: &schemapb.StringArray{
+					Data: []string{"a", "", "c", "d", "e", "f"},
+				},
+			},
+		}
+		rangeInfo := &planpb.QueryInfo{MetricType: "IP", IsRangeSearch: true, Radius: 0.85, RangeFilter: float32(math.Inf(1))}
+		ret := filterRangeSearchResultData(data, rangeInfo)
+		assert.Equal(t, []int64{2, 1}, ret.Topks)
+		assert.Equal(t, []string{"a", "c", "d"}, ret.Ids.GetStrId().GetData())
+	})
+}
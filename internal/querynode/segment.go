@@ -30,6 +30,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"fmt"
+	"math"
 	"strconv"
 	"sync"
 	"unsafe"
@@ -78,6 +79,7 @@ func newVectorFieldInfo(fieldBinlog *datapb.FieldBinlog) *VectorFieldInfo {
 type Segment struct {
 	segPtrMu   sync.RWMutex // guards segmentPtr
 	segmentPtr C.CSegmentInterface
+	schema     *schemapb.CollectionSchema // the schema segmentPtr takes, guarded by segPtrMu
 
 	segmentID    UniqueID
 	partitionID  UniqueID
@@ -186,6 +188,8 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 		CSegmentInterface
 		NewSegment(CCollection collection, uint64_t segment_id, SegmentType seg_type);
 	*/
+	collection.schemaMu.RLock()
+	defer collection.schemaMu.RUnlock()
 	var segmentPtr C.CSegmentInterface
 	switch segType {
 	case segmentTypeInvalid:
@@ -204,6 +208,7 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 
 	var segment = &Segment{
 		segmentPtr:       segmentPtr,
+		schema:           collection.schema,
 		segmentType:      segType,
 		segmentID:        segmentID,
 		partitionID:      partitionID,
@@ -219,6 +224,51 @@ func newSegment(collection *Collection, segmentID UniqueID, partitionID UniqueID
 	return segment
 }
 
+// updateSchema replaces the schema of segment with the schema of collection altered by AddField,
+// the rows in the segment take the default values of the fields appended to the schema
+func (s *Segment) updateSchema(collection *Collection) error {
+	/*
+		CStatus
+		UpdateSegmentSchema(CSegmentInterface c_segment, CCollection c_collection, const void* default_row);
+	*/
+	collection.schemaMu.RLock()
+	defer collection.schemaMu.RUnlock()
+	s.segPtrMu.Lock()
+	defer s.segPtrMu.Unlock()
+	if collection.schema.GetVersion() <= s.schema.GetVersion() {
+		return nil
+	}
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+
+	fieldIDs := make(map[FieldID]struct{}, len(s.schema.GetFields()))
+	for _, field := range s.schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	var appendedFields []*schemapb.FieldSchema
+	for _, field := range collection.schema.GetFields() {
+		if _, ok := fieldIDs[field.GetFieldID()]; !ok {
+			appendedFields = append(appendedFields, field)
+		}
+	}
+	defaultRow, err := typeutil.EncodeDefaultRow(appendedFields)
+	if err != nil {
+		return err
+	}
+	var cDefaultRow unsafe.Pointer
+	if len(defaultRow) > 0 {
+		cDefaultRow = unsafe.Pointer(&defaultRow[0])
+	}
+	status := C.UpdateSegmentSchema(s.segmentPtr, collection.collectionPtr, cDefaultRow)
+	if err := HandleCStatus(&status, "UpdateSegmentSchema failed"); err != nil {
+		return err
+	}
+	s.schema = collection.schema
+	log.Debug("update segment schema", zap.Int64("segmentID", s.segmentID), zap.Int32("version", s.schema.GetVersion()))
+	return nil
+}
+
 func deleteSegment(segment *Segment) {
 	/*
 		void
@@ -551,12 +601,33 @@ func (s *Segment) checkIndexReady(fieldID int64) bool {
 	return s.indexInfos[fieldID].getReadyLoad()
 }
 
-func (s *Segment) updateBloomFilter(pks []int64) {
-	buf := make([]byte, 8)
+func (s *Segment) updateBloomFilter(pks []storage.PrimaryKey) {
 	for _, pk := range pks {
-		common.Endian.PutUint64(buf, uint64(pk))
-		s.pkFilter.Add(buf)
+		s.pkFilter.Add(pk.Bytes())
+	}
+}
+
+// encodePrimaryKeys encodes the primary keys as segcore takes them, an int64 array,
+// or the varchar primary keys encoded by typeutil.EncodeVarChar one after another
+func encodePrimaryKeys(pks []storage.PrimaryKey) ([]byte, error) {
+	data := make([]byte, 0, len(pks)*8)
+	for _, pk := range pks {
+		switch v := pk.(type) {
+		case storage.Int64PrimaryKey:
+			var b [8]byte
+			common.Endian.PutUint64(b[:], uint64(v.Value))
+			data = append(data, b[:]...)
+		case storage.VarCharPrimaryKey:
+			b, err := typeutil.EncodeVarChar(v.Value, math.MaxUint32)
+			if err != nil {
+				return nil, err
+			}
+			data = append(data, b...)
+		default:
+			return nil, fmt.Errorf("unsupported primary key type %T", pk)
+		}
 	}
+	return data, nil
 }
 
 //-------------------------------------------------------------------------------------- interfaces for growing segment
@@ -601,7 +672,7 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		           const long* primary_keys,
 		           const unsigned long* timestamps,
 		           void* raw_data,
-		           int sizeof_per_row,
+		           signed long int raw_data_size,
 		           signed long int count);
 	*/
 	s.segPtrMu.RLock()
@@ -614,27 +685,28 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("null seg core pointer")
 	}
 
-	// Blobs to one big blob
+	// Blobs to one big blob, the rows vary in size with the varchar and json values
 	var numOfRow = len(*entityIDs)
-	var sizeofPerRow = len((*records)[0].Value)
 
 	assert.Equal(nil, numOfRow, len(*records))
 	if numOfRow != len(*records) {
 		return errors.New("entityIDs row num not equal to length of records")
 	}
 
-	var rawData = make([]byte, numOfRow*sizeofPerRow)
-	var copyOffset = 0
+	var rawDataSize = 0
 	for i := 0; i < len(*records); i++ {
-		copy(rawData[copyOffset:], (*records)[i].Value)
-		copyOffset += sizeofPerRow
+		rawDataSize += len((*records)[i].Value)
+	}
+	var rawData = make([]byte, 0, rawDataSize)
+	for i := 0; i < len(*records); i++ {
+		rawData = append(rawData, (*records)[i].Value...)
 	}
 
 	var cOffset = C.long(offset)
 	var cNumOfRows = C.long(numOfRow)
 	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
 	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
-	var cSizeofPerRow = C.int(sizeofPerRow)
+	var cRawDataSize = C.int64_t(rawDataSize)
 	var cRawDataVoidPtr = unsafe.Pointer(&rawData[0])
 	log.Debug("QueryNode::Segment::InsertBegin", zap.Any("cNumOfRows", cNumOfRows))
 	status := C.Insert(s.segmentPtr,
@@ -643,7 +715,7 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 		cEntityIdsPtr,
 		cTimestampsPtr,
 		cRawDataVoidPtr,
-		cSizeofPerRow,
+		cRawDataSize,
 		cNumOfRows)
 	if err := HandleCStatus(&status, "Insert failed"); err != nil {
 		return err
@@ -653,13 +725,13 @@ func (s *Segment) segmentInsert(offset int64, entityIDs *[]UniqueID, timestamps
 	return nil
 }
 
-func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps *[]Timestamp) error {
+func (s *Segment) segmentDelete(offset int64, entityIDs *[]storage.PrimaryKey, timestamps *[]Timestamp) error {
 	/*
 		CStatus
 		Delete(CSegmentInterface c_segment,
 		           long int reserved_offset,
 		           long size,
-		           const long* primary_keys,
+		           const void* primary_keys,
 		           const unsigned long* timestamps);
 	*/
 	s.segPtrMu.RLock()
@@ -672,12 +744,16 @@ func (s *Segment) segmentDelete(offset int64, entityIDs *[]UniqueID, timestamps
 		return errors.New("length of entityIDs not equal to length of timestamps")
 	}
 
+	pks, err := encodePrimaryKeys(*entityIDs)
+	if err != nil {
+		return err
+	}
 	var cOffset = C.long(offset)
 	var cSize = C.long(len(*entityIDs))
-	var cEntityIdsPtr = (*C.long)(&(*entityIDs)[0])
+	var cPrimaryKeysPtr = unsafe.Pointer(&pks[0])
 	var cTimestampsPtr = (*C.ulong)(&(*timestamps)[0])
 
-	status := C.Delete(s.segmentPtr, cOffset, cSize, cEntityIdsPtr, cTimestampsPtr)
+	status := C.Delete(s.segmentPtr, cOffset, cSize, cPrimaryKeysPtr, cTimestampsPtr)
 	if err := HandleCStatus(&status, "Delete failed"); err != nil {
 		return err
 	}
@@ -778,7 +854,34 @@ func (s *Segment) segmentLoadFieldData(fieldID int64, rowCount int, data interfa
 	return nil
 }
 
-func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestamps []Timestamp, rowCount int64) error {
+// segmentLoadValidData sets the validity of the rows of a nullable field from the offset, false means the row is null
+func (s *Segment) segmentLoadValidData(fieldID int64, offset int64, validData []bool) error {
+	/*
+		CStatus
+		LoadValidData(CSegmentInterface c_segment, int64_t field_id, int64_t offset, int64_t count, const bool* valid_data);
+	*/
+	s.segPtrMu.RLock()
+	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
+	if s.segmentPtr == nil {
+		return errors.New("null seg core pointer")
+	}
+	if len(validData) == 0 {
+		return nil
+	}
+
+	status := C.LoadValidData(s.segmentPtr,
+		C.int64_t(fieldID),
+		C.int64_t(offset),
+		C.int64_t(len(validData)),
+		(*C.bool)(unsafe.Pointer(&validData[0])))
+	if err := HandleCStatus(&status, "LoadValidData failed"); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (s *Segment) segmentLoadDeletedRecord(primaryKeys []storage.PrimaryKey, timestamps []Timestamp, rowCount int64) error {
 	s.segPtrMu.RLock()
 	defer s.segPtrMu.RUnlock() // thread safe guaranteed by segCore, use RLock
 	if s.segmentPtr == nil {
@@ -788,9 +891,13 @@ func (s *Segment) segmentLoadDeletedRecord(primaryKeys []IntPrimaryKey, timestam
 		errMsg := fmt.Sprintln("segmentLoadFieldData failed, illegal segment type ", s.segmentType, "segmentID = ", s.ID())
 		return errors.New(errMsg)
 	}
+	pks, err := encodePrimaryKeys(primaryKeys)
+	if err != nil {
+		return err
+	}
 	loadInfo := C.CLoadDeletedRecordInfo{
 		timestamps:   unsafe.Pointer(&timestamps[0]),
-		primary_keys: unsafe.Pointer(&primaryKeys[0]),
+		primary_keys: unsafe.Pointer(&pks[0]),
 		row_count:    C.int64_t(rowCount),
 	}
 	/*
@@ -35,13 +35,16 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/rootcoord"
 	"github.com/milvus-io/milvus/internal/storage"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 const timeoutForEachRead = 10 * time.Second
@@ -52,6 +55,7 @@ type segmentLoader struct {
 	streamingReplica  ReplicaInterface
 
 	dataCoord types.DataCoord
+	rootCoord types.RootCoord
 
 	minioKV kv.DataKV // minio minioKV
 	etcdKV  *etcdkv.EtcdKV
@@ -95,6 +99,14 @@ func (loader *segmentLoader) loadSegment(req *querypb.LoadSegmentsRequest, segme
 			segmentGC()
 			return err
 		}
+		// the segment is written after the fields are added by AddField
+		if hasUnknownFields(collection.Schema(), info.BinlogPaths) {
+			_, err = refreshCollectionSchema(context.Background(), loader.rootCoord, collectionID, 0, loader.historicalReplica, loader.streamingReplica)
+			if err != nil {
+				segmentGC()
+				return err
+			}
+		}
 		segment := newSegment(collection, segmentID, partitionID, collectionID, "", segmentType, true)
 		newSegments[segmentID] = segment
 		fieldBinlog, indexedFieldID, err := loader.getFieldAndIndexInfo(segment, info)
@@ -212,6 +224,20 @@ func (loader *segmentLoader) loadSegmentInternal(segment *Segment,
 	return nil
 }
 
+// hasUnknownFields checks if any of the binlogs is of the field unknown to the schema, which is added by AddField after the schema is cached
+func hasUnknownFields(schema *schemapb.CollectionSchema, fieldBinlogs []*datapb.FieldBinlog) bool {
+	fieldIDs := make(map[int64]struct{}, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		fieldIDs[field.GetFieldID()] = struct{}{}
+	}
+	for _, fieldBinlog := range fieldBinlogs {
+		if _, ok := fieldIDs[fieldBinlog.GetFieldID()]; !ok && fieldBinlog.GetFieldID() >= common.StartOfUserFieldID {
+			return true
+		}
+	}
+	return false
+}
+
 func (loader *segmentLoader) filterPKStatsBinlogs(fieldBinlogs []*datapb.FieldBinlog, pkFieldID int64) []string {
 	result := make([]string, 0)
 	for _, fieldBinlog := range fieldBinlogs {
@@ -235,9 +261,16 @@ func (loader *segmentLoader) filterFieldBinlogs(fieldBinlogs []*datapb.FieldBinl
 }
 
 func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlogs []*datapb.FieldBinlog, segmentType segmentType) error {
-	iCodec := storage.InsertCodec{}
+	// with the schema the segment takes, the fields added after the segment is written are filled with default values
+	segment.segPtrMu.RLock()
+	schema := segment.schema
+	segment.segPtrMu.RUnlock()
+	iCodec := storage.InsertCodec{Schema: &etcdpb.CollectionMeta{ID: segment.collectionID, Schema: schema}}
 	blobs := make([]*storage.Blob, 0)
 	for _, fb := range fieldBinlogs {
+		if hasUnknownFields(schema, []*datapb.FieldBinlog{fb}) {
+			return fmt.Errorf("field %d of segment %d is unknown to the schema of version %d", fb.FieldID, segment.segmentID, schema.GetVersion())
+		}
 		log.Debug("load segment fields data",
 			zap.Int64("segmentID", segment.segmentID),
 			zap.Any("fieldID", fb.FieldID),
@@ -273,11 +306,17 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 
 	switch segmentType {
 	case segmentTypeGrowing:
-		timestamps, ids, rowData, err := storage.TransferColumnBasedInsertDataToRowBased(insertData)
+		timestamps, ids, rowData, err := storage.TransferColumnBasedInsertDataToRowBased(insertData, iCodec.Schema.GetSchema())
 		if err != nil {
 			return err
 		}
-		return loader.loadGrowingSegments(segment, ids, timestamps, rowData)
+		validData := make(map[FieldID][]bool)
+		for fieldID, fieldData := range insertData.Data {
+			if fieldValidData := storage.GetValidData(fieldData); len(fieldValidData) > 0 {
+				validData[fieldID] = fieldValidData
+			}
+		}
+		return loader.loadGrowingSegments(segment, ids, timestamps, rowData, validData)
 	case segmentTypeSealed:
 		return loader.loadSealedSegments(segment, insertData)
 	default:
@@ -289,7 +328,8 @@ func (loader *segmentLoader) loadSegmentFieldsData(segment *Segment, fieldBinlog
 func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
 	ids []UniqueID,
 	timestamps []Timestamp,
-	records []*commonpb.Blob) error {
+	records []*commonpb.Blob,
+	validData map[FieldID][]bool) error {
 	if len(ids) != len(timestamps) || len(timestamps) != len(records) {
 		return errors.New(fmt.Sprintln("illegal insert data when load segment, collectionID = ", segment.collectionID))
 	}
@@ -328,6 +368,12 @@ func (loader *segmentLoader) loadGrowingSegments(segment *Segment,
 	if err != nil {
 		return err
 	}
+	for fieldID, fieldValidData := range validData {
+		err = segment.segmentLoadValidData(fieldID, offset, fieldValidData)
+		if err != nil {
+			return err
+		}
+	}
 	log.Debug("Do insert done in segment loader", zap.Int("len", numOfRecords), zap.Int64("segmentID", segment.ID()), zap.Int64("collectionID", segment.collectionID))
 
 	return nil
@@ -366,7 +412,24 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			data = fieldData.Data
 		case *storage.StringFieldData:
 			numRows = fieldData.NumRows
-			data = fieldData.Data
+			// segcore takes the varchar values each of which is prefixed by its length
+			blob, err := loader.encodeVarCharFieldData(segment.collectionID, fieldID, fieldData.Data)
+			if err != nil {
+				return err
+			}
+			data = blob
+		case *storage.JSONFieldData:
+			numRows = fieldData.NumRows
+			// the serialized json documents are encoded as the varchar values
+			docs := make([]string, 0, len(fieldData.Data))
+			for _, doc := range fieldData.Data {
+				docs = append(docs, string(doc))
+			}
+			blob, err := loader.encodeVarCharFieldData(segment.collectionID, fieldID, docs)
+			if err != nil {
+				return err
+			}
+			data = blob
 		case *storage.FloatVectorFieldData:
 			numRows = fieldData.NumRows
 			data = fieldData.Data
@@ -388,10 +451,43 @@ func (loader *segmentLoader) loadSealedSegments(segment *Segment, insertData *st
 			// TODO: return or continue?
 			return err
 		}
+		err = segment.segmentLoadValidData(fieldID, 0, storage.GetValidData(value))
+		if err != nil {
+			return err
+		}
 	}
 	return nil
 }
 
+// encodeVarCharFieldData encodes the values of the varchar or json field one after another into a blob
+func (loader *segmentLoader) encodeVarCharFieldData(collectionID UniqueID, fieldID FieldID, values []string) ([]byte, error) {
+	collection, err := loader.historicalReplica.getCollectionByID(collectionID)
+	if err != nil {
+		return nil, err
+	}
+	helper, err := typeutil.CreateSchemaHelper(collection.Schema())
+	if err != nil {
+		return nil, err
+	}
+	field, err := helper.GetFieldFromID(fieldID)
+	if err != nil {
+		return nil, err
+	}
+	maxLength, err := typeutil.GetMaxLength(field)
+	if err != nil {
+		return nil, err
+	}
+	var blob []byte
+	for _, value := range values {
+		b, err := typeutil.EncodeVarChar(value, maxLength)
+		if err != nil {
+			return nil, err
+		}
+		blob = append(blob, b...)
+	}
+	return blob, nil
+}
+
 func (loader *segmentLoader) loadSegmentBloomFilter(segment *Segment, binlogPaths []string) error {
 	if len(binlogPaths) == 0 {
 		log.Info("there are no stats logs saved with segment", zap.Any("segmentID", segment.segmentID))
@@ -472,7 +568,7 @@ func (loader *segmentLoader) FromDmlCPLoadDelete(ctx context.Context, collection
 	}
 
 	delData := &deleteData{
-		deleteIDs:        make(map[UniqueID][]int64),
+		deleteIDs:        make(map[UniqueID][]storage.PrimaryKey),
 		deleteTimestamps: make(map[UniqueID][]Timestamp),
 		deleteOffset:     make(map[UniqueID]int64),
 	}
@@ -689,6 +785,7 @@ func newSegmentLoader(ctx context.Context,
 	return &segmentLoader{
 		historicalReplica: historicalReplica,
 		streamingReplica:  streamingReplica,
+		rootCoord:         rootCoord,
 
 		minioKV: client,
 		etcdKV:  etcdKV,
@@ -23,6 +23,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
 	"github.com/milvus-io/milvus/internal/proto/querypb"
@@ -375,7 +376,7 @@ func TestSegmentLoader_testLoadGrowing(t *testing.T) {
 		insertMsg, err := genSimpleInsertMsg()
 		assert.NoError(t, err)
 
-		err = loader.loadGrowingSegments(segment, insertMsg.RowIDs, insertMsg.Timestamps, insertMsg.RowData)
+		err = loader.loadGrowingSegments(segment, insertMsg.RowIDs, insertMsg.Timestamps, insertMsg.RowData, nil)
 		assert.NoError(t, err)
 	})
 
@@ -395,7 +396,7 @@ func TestSegmentLoader_testLoadGrowing(t *testing.T) {
 
 		insertMsg.RowData = nil
 
-		err = loader.loadGrowingSegments(segment, insertMsg.RowIDs, insertMsg.Timestamps, insertMsg.RowData)
+		err = loader.loadGrowingSegments(segment, insertMsg.RowIDs, insertMsg.Timestamps, insertMsg.RowData, nil)
 		assert.Error(t, err)
 	})
 }
@@ -475,3 +476,17 @@ func TestSegmentLoader_testLoadGrowingAndSealed(t *testing.T) {
 		assert.Equal(t, segment1.getRowCount(), segment2.getRowCount())
 	})
 }
+
+func TestSegmentLoader_hasUnknownFields(t *testing.T) {
+	schema := genSimpleInsertDataSchema()
+	var fieldBinlogs []*datapb.FieldBinlog
+	for _, field := range schema.Fields {
+		fieldBinlogs = append(fieldBinlogs, &datapb.FieldBinlog{FieldID: field.FieldID})
+	}
+	assert.False(t, hasUnknownFields(schema, fieldBinlogs))
+	// the system fields are always known
+	assert.False(t, hasUnknownFields(schema, []*datapb.FieldBinlog{{FieldID: common.RowIDField}}))
+
+	fieldBinlogs = append(fieldBinlogs, &datapb.FieldBinlog{FieldID: 1000})
+	assert.True(t, hasUnknownFields(schema, fieldBinlogs))
+}
@@ -234,6 +234,12 @@ func TestSegment_retrieve(t *testing.T) {
 	assert.NoError(t, err)
 
 	assert.Equal(t, res.GetFieldsData()[0].GetScalars().Data.(*schemapb.ScalarField_IntData).IntData.Data, []int32{1, 2, 3})
+
+	// all the entities are inserted at timestamp 0, so they are expired
+	plan.setExpireTimestamp(1)
+	res, err = segment.retrieve(plan)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(res.GetOffset()))
 }
 
 func TestSegment_getDeletedCount(t *testing.T) {
@@ -280,7 +286,8 @@ func TestSegment_getDeletedCount(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	pks := newInt64PrimaryKeys(ids...)
+	err = segment.segmentDelete(offsetDelete, &pks, &timestamps)
 	assert.NoError(t, err)
 
 	var deletedCount = segment.getDeletedCount()
@@ -450,7 +457,8 @@ func TestSegment_segmentDelete(t *testing.T) {
 	var offsetDelete = segment.segmentPreDelete(10)
 	assert.GreaterOrEqual(t, offsetDelete, int64(0))
 
-	err = segment.segmentDelete(offsetDelete, &ids, &timestamps)
+	pks := newInt64PrimaryKeys(ids...)
+	err = segment.segmentDelete(offsetDelete, &pks, &timestamps)
 	assert.NoError(t, err)
 
 	deleteCollection(collection)
@@ -670,7 +678,7 @@ func TestSegment_segmentLoadDeletedRecord(t *testing.T) {
 		defaultDMLChannel,
 		segmentTypeSealed,
 		true)
-	pks := []IntPrimaryKey{1, 2, 3}
+	pks := newInt64PrimaryKeys(1, 2, 3)
 	timestamps := []Timestamp{10, 10, 10}
 	var rowCount int64 = 3
 	error := seg.segmentLoadDeletedRecord(pks, timestamps, rowCount)
@@ -23,6 +23,7 @@ import (
 	"go.uber.org/zap"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // TSafeReplicaInterface is the interface wrapper of tSafeReplica
@@ -32,6 +33,7 @@ type TSafeReplicaInterface interface {
 	addTSafe(vChannel Channel)
 	removeTSafe(vChannel Channel)
 	registerTSafeWatcher(vChannel Channel, watcher *tSafeWatcher) error
+	getMinTSafe() Timestamp
 }
 
 // tSafeReplica implements `TSafeReplicaInterface` interface.
@@ -109,6 +111,24 @@ func (t *tSafeReplica) registerTSafeWatcher(vChannel Channel, watcher *tSafeWatc
 	return ts.registerTSafeWatcher(watcher)
 }
 
+// getMinTSafe returns the min tSafe of all the channels, the channels whose tSafe has not been set are ignored,
+// ZeroTimestamp is returned if there is no such channel
+func (t *tSafeReplica) getMinTSafe() Timestamp {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	minTSafe := typeutil.ZeroTimestamp
+	for _, ts := range t.tSafes {
+		tSafe := ts.get()
+		if tSafe == typeutil.ZeroTimestamp {
+			continue
+		}
+		if minTSafe == typeutil.ZeroTimestamp || tSafe < minTSafe {
+			minTSafe = tSafe
+		}
+	}
+	return minTSafe
+}
+
 func newTSafeReplica() TSafeReplicaInterface {
 	var replica TSafeReplicaInterface = &tSafeReplica{
 		tSafes: make(map[string]*tSafe),
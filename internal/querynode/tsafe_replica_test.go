@@ -57,4 +57,22 @@ func TestTSafeReplica(t *testing.T) {
 		err = replica.setTSafe(defaultDMLChannel, Timestamp(1000))
 		assert.Error(t, err)
 	})
+
+	t.Run("test min tSafe", func(t *testing.T) {
+		replica := newTSafeReplica()
+		assert.Equal(t, Timestamp(0), replica.getMinTSafe())
+
+		replica.addTSafe(defaultDMLChannel)
+		replica.addTSafe(defaultDeltaChannel)
+		// the tSafe of channels has not been set
+		assert.Equal(t, Timestamp(0), replica.getMinTSafe())
+
+		err := replica.setTSafe(defaultDMLChannel, Timestamp(1000))
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(1000), replica.getMinTSafe())
+
+		err = replica.setTSafe(defaultDeltaChannel, Timestamp(500))
+		assert.NoError(t, err)
+		assert.Equal(t, Timestamp(500), replica.getMinTSafe())
+	})
 }
@@ -20,7 +20,9 @@ import (
 	"bytes"
 	"fmt"
 	"path"
+	"sort"
 	"strconv"
+	"strings"
 	"sync"
 
 	"github.com/golang/protobuf/proto"
@@ -30,7 +32,10 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
@@ -44,6 +49,21 @@ const (
 	// ProxyMetaPrefix prefix for proxy meta
 	ProxyMetaPrefix = ComponentPrefix + "/proxy"
 
+	// DatabaseMetaPrefix prefix for database meta
+	DatabaseMetaPrefix = ComponentPrefix + "/database"
+
+	// CredentialMetaPrefix prefix for user credential meta
+	CredentialMetaPrefix = ComponentPrefix + "/" + util.CredentialPrefix
+
+	// RoleMetaPrefix prefix for role meta
+	RoleMetaPrefix = ComponentPrefix + "/" + util.RolePrefix
+
+	// RoleMappingMetaPrefix prefix for the binding between users and roles
+	RoleMappingMetaPrefix = ComponentPrefix + "/" + util.RoleMappingPrefix
+
+	// GrantMetaPrefix prefix for the privileges granted to roles
+	GrantMetaPrefix = ComponentPrefix + "/" + util.GrantPrefix
+
 	// CollectionMetaPrefix prefix for collection meta
 	CollectionMetaPrefix = ComponentPrefix + "/collection"
 
@@ -76,6 +96,9 @@ const (
 
 	// DropPartitionDDType name of DD type for drop partition
 	DropPartitionDDType = "DropPartition"
+
+	// DefaultDatabaseID id of the default database, which always exists and is never persisted
+	DefaultDatabaseID typeutil.UniqueID = 0
 )
 
 // MetaTable store all rootcoord meta info
@@ -84,9 +107,11 @@ type MetaTable struct {
 	snapshot        kv.SnapShotKV                                                   // client of a reliable snapshotkv service, i.e. etcd client
 	tenantID2Meta   map[typeutil.UniqueID]pb.TenantMeta                             // tenant id to tenant meta
 	proxyID2Meta    map[typeutil.UniqueID]pb.ProxyMeta                              // proxy id to proxy meta
+	dbID2Meta       map[typeutil.UniqueID]pb.DatabaseInfo                           // database id -> meta
+	dbName2ID       map[string]typeutil.UniqueID                                    // database name to database id
 	collID2Meta     map[typeutil.UniqueID]pb.CollectionInfo                         // collection_id -> meta
-	collName2ID     map[string]typeutil.UniqueID                                    // collection name to collection id
-	collAlias2ID    map[string]typeutil.UniqueID                                    // collection alias to collection id
+	collName2ID     map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection name -> collection id
+	collAlias2ID    map[typeutil.UniqueID]map[string]typeutil.UniqueID              // database id -> collection alias -> collection id
 	partID2SegID    map[typeutil.UniqueID]map[typeutil.UniqueID]bool                // partition_id -> segment_id -> bool
 	segID2IndexMeta map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo // collection_id/index_id/partition_id/segment_id -> meta
 	indexID2Meta    map[typeutil.UniqueID]pb.IndexInfo                              // collection_id/index_id -> meta
@@ -94,6 +119,7 @@ type MetaTable struct {
 	tenantLock sync.RWMutex
 	proxyLock  sync.RWMutex
 	ddLock     sync.RWMutex
+	credLock   sync.RWMutex
 }
 
 // NewMetaTable creates meta table for rootcoord, which stores all in-memory information
@@ -105,6 +131,7 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 		tenantLock: sync.RWMutex{},
 		proxyLock:  sync.RWMutex{},
 		ddLock:     sync.RWMutex{},
+		credLock:   sync.RWMutex{},
 	}
 	err := mt.reloadFromKV()
 	if err != nil {
@@ -116,9 +143,11 @@ func NewMetaTable(txn kv.TxnKV, snap kv.SnapShotKV) (*MetaTable, error) {
 func (mt *MetaTable) reloadFromKV() error {
 	mt.tenantID2Meta = make(map[typeutil.UniqueID]pb.TenantMeta)
 	mt.proxyID2Meta = make(map[typeutil.UniqueID]pb.ProxyMeta)
+	mt.dbID2Meta = make(map[typeutil.UniqueID]pb.DatabaseInfo)
+	mt.dbName2ID = make(map[string]typeutil.UniqueID)
 	mt.collID2Meta = make(map[typeutil.UniqueID]pb.CollectionInfo)
-	mt.collName2ID = make(map[string]typeutil.UniqueID)
-	mt.collAlias2ID = make(map[string]typeutil.UniqueID)
+	mt.collName2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
+	mt.collAlias2ID = make(map[typeutil.UniqueID]map[string]typeutil.UniqueID)
 	mt.partID2SegID = make(map[typeutil.UniqueID]map[typeutil.UniqueID]bool)
 	mt.segID2IndexMeta = make(map[typeutil.UniqueID]map[typeutil.UniqueID]pb.SegmentIndexInfo)
 	mt.indexID2Meta = make(map[typeutil.UniqueID]pb.IndexInfo)
@@ -155,6 +184,21 @@ func (mt *MetaTable) reloadFromKV() error {
 		mt.proxyID2Meta[proxyMeta.ID] = proxyMeta
 	}
 
+	mt.addDatabase(pb.DatabaseInfo{ID: DefaultDatabaseID, Name: Params.CommonCfg.DefaultDatabaseName})
+	_, values, err = mt.snapshot.LoadWithPrefix(DatabaseMetaPrefix, 0)
+	if err != nil {
+		return err
+	}
+
+	for _, value := range values {
+		dbInfo := pb.DatabaseInfo{}
+		err = proto.Unmarshal([]byte(value), &dbInfo)
+		if err != nil {
+			return fmt.Errorf("rootcoord Unmarshal pb.DatabaseInfo err:%w", err)
+		}
+		mt.addDatabase(dbInfo)
+	}
+
 	_, values, err = mt.snapshot.LoadWithPrefix(CollectionMetaPrefix, 0)
 	if err != nil {
 		return err
@@ -166,8 +210,11 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("rootcoord Unmarshal pb.CollectionInfo err:%w", err)
 		}
+		if _, ok := mt.dbID2Meta[collInfo.DbId]; !ok {
+			return fmt.Errorf("collection %s belongs to unknown database %d", collInfo.Schema.Name, collInfo.DbId)
+		}
 		mt.collID2Meta[collInfo.ID] = collInfo
-		mt.collName2ID[collInfo.Schema.Name] = collInfo.ID
+		mt.collName2ID[collInfo.DbId][collInfo.Schema.Name] = collInfo.ID
 	}
 
 	_, values, err = mt.txn.LoadWithPrefix(SegmentIndexMetaPrefix)
@@ -233,7 +280,10 @@ func (mt *MetaTable) reloadFromKV() error {
 		if err != nil {
 			return fmt.Errorf("rootcoord Unmarshal pb.AliasInfo err:%w", err)
 		}
-		mt.collAlias2ID[aliasInfo.Schema.Name] = aliasInfo.ID
+		if _, ok := mt.dbID2Meta[aliasInfo.DbId]; !ok {
+			return fmt.Errorf("alias %s belongs to unknown database %d", aliasInfo.Schema.Name, aliasInfo.DbId)
+		}
+		mt.collAlias2ID[aliasInfo.DbId][aliasInfo.Schema.Name] = aliasInfo.ID
 	}
 
 	log.Debug("reload meta table from KV successfully")
@@ -282,6 +332,137 @@ func (mt *MetaTable) AddProxy(po *pb.ProxyMeta) error {
 	return nil
 }
 
+func (mt *MetaTable) addDatabase(db pb.DatabaseInfo) {
+	mt.dbID2Meta[db.ID] = db
+	mt.dbName2ID[db.Name] = db.ID
+	mt.collName2ID[db.ID] = make(map[string]typeutil.UniqueID)
+	mt.collAlias2ID[db.ID] = make(map[string]typeutil.UniqueID)
+}
+
+// getDatabaseID return the database id of dbName, empty name stands for the default database
+func (mt *MetaTable) getDatabaseID(dbName string) (typeutil.UniqueID, error) {
+	if dbName == "" {
+		return DefaultDatabaseID, nil
+	}
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return 0, fmt.Errorf("database %s not found", dbName)
+	}
+	return dbID, nil
+}
+
+// getCollectionIDByName return the collection id of a collection name or alias in the database
+func (mt *MetaTable) getCollectionIDByName(dbName string, collName string) (typeutil.UniqueID, error) {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return 0, err
+	}
+	collID, ok := mt.collName2ID[dbID][collName]
+	if !ok {
+		collID, ok = mt.collAlias2ID[dbID][collName]
+		if !ok {
+			return 0, fmt.Errorf("collection %s not found", collName)
+		}
+	}
+	return collID, nil
+}
+
+func databaseKey(dbID typeutil.UniqueID) string {
+	return fmt.Sprintf("%s/%d", DatabaseMetaPrefix, dbID)
+}
+
+// aliasKey keeps the key of aliases in the default database unchanged for backward compatibility
+func aliasKey(dbID typeutil.UniqueID, alias string) string {
+	if dbID == DefaultDatabaseID {
+		return fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias)
+	}
+	return fmt.Sprintf("%s/%d/%s", CollectionAliasMetaPrefix, dbID, alias)
+}
+
+// CreateDatabase add database
+func (mt *MetaTable) CreateDatabase(db *pb.DatabaseInfo, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	if _, ok := mt.dbName2ID[db.Name]; ok {
+		return fmt.Errorf("database %s exist", db.Name)
+	}
+	if _, ok := mt.dbID2Meta[db.ID]; ok {
+		return fmt.Errorf("database id %d exist", db.ID)
+	}
+	db.CreateTime = ts
+
+	k := databaseKey(db.ID)
+	v, err := proto.Marshal(db)
+	if err != nil {
+		log.Error("MetaTable CreateDatabase Marshal fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable CreateDatabase Marshal fail key:%s, err:%w", k, err)
+	}
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+	mt.addDatabase(*db)
+	return nil
+}
+
+// DropDatabase drop database, only empty database except the default one can be dropped
+func (mt *MetaTable) DropDatabase(dbName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, ok := mt.dbName2ID[dbName]
+	if !ok {
+		return fmt.Errorf("database %s not found", dbName)
+	}
+	if dbID == DefaultDatabaseID {
+		return fmt.Errorf("default database %s can not be dropped", dbName)
+	}
+	if len(mt.collName2ID[dbID]) != 0 {
+		return fmt.Errorf("database %s still has %d collections", dbName, len(mt.collName2ID[dbID]))
+	}
+
+	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(map[string]string{}, []string{databaseKey(dbID)}, ts)
+	if err != nil {
+		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
+		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
+	}
+	delete(mt.dbID2Meta, dbID)
+	delete(mt.dbName2ID, dbName)
+	delete(mt.collName2ID, dbID)
+	delete(mt.collAlias2ID, dbID)
+	return nil
+}
+
+// GetDatabaseByName return database meta by database name, empty name stands for the default database
+func (mt *MetaTable) GetDatabaseByName(dbName string) (*pb.DatabaseInfo, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
+	db := mt.dbID2Meta[dbID]
+	return &db, nil
+}
+
+// ListDatabases list all databases, ordered by database id
+func (mt *MetaTable) ListDatabases() []*pb.DatabaseInfo {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+
+	dbs := make([]*pb.DatabaseInfo, 0, len(mt.dbID2Meta))
+	for _, db := range mt.dbID2Meta {
+		dbCopy := db
+		dbs = append(dbs, &dbCopy)
+	}
+	sort.Slice(dbs, func(i, j int) bool { return dbs[i].ID < dbs[j].ID })
+	return dbs
+}
+
 // AddCollection add collection
 func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestamp, idx []*pb.IndexInfo, ddOpStr string) error {
 	mt.ddLock.Lock()
@@ -292,7 +473,10 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		(len(coll.PartitionIDs) != 1 && len(coll.PartitionIDs) != 0) {
 		return fmt.Errorf("partition parameters' length mis-match when creating collection")
 	}
-	if _, ok := mt.collName2ID[coll.Schema.Name]; ok {
+	if _, ok := mt.dbID2Meta[coll.DbId]; !ok {
+		return fmt.Errorf("database id %d not found", coll.DbId)
+	}
+	if _, ok := mt.collName2ID[coll.DbId][coll.Schema.Name]; ok {
 		return fmt.Errorf("collection %s exist", coll.Schema.Name)
 	}
 	if len(coll.FieldIndexes) != len(idx) {
@@ -304,7 +488,7 @@ func (mt *MetaTable) AddCollection(coll *pb.CollectionInfo, ts typeutil.Timestam
 		coll.PartitionCreatedTimestamps[0] = ts
 	}
 	mt.collID2Meta[coll.ID] = *coll
-	mt.collName2ID[coll.Schema.Name] = coll.ID
+	mt.collName2ID[coll.DbId][coll.Schema.Name] = coll.ID
 	for _, i := range idx {
 		mt.indexID2Meta[i.IndexID] = *i
 	}
@@ -353,7 +537,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	delete(mt.collID2Meta, collID)
-	delete(mt.collName2ID, collMeta.Schema.Name)
+	delete(mt.collName2ID[collMeta.DbId], collMeta.Schema.Name)
 
 	// update segID2IndexMeta
 	for partID := range collMeta.PartitionIDs {
@@ -379,7 +563,7 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 	var aliases []string
 	// delete collection aliases
-	for alias, cid := range mt.collAlias2ID {
+	for alias, cid := range mt.collAlias2ID[collMeta.DbId] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -394,10 +578,8 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 	}
 
 	for _, alias := range aliases {
-		delete(mt.collAlias2ID, alias)
-		delMetakeysSnap = append(delMetakeysSnap,
-			fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, alias),
-		)
+		delete(mt.collAlias2ID[collMeta.DbId], alias)
+		delMetakeysSnap = append(delMetakeysSnap, aliasKey(collMeta.DbId, alias))
 	}
 
 	// save ddOpStr into etcd
@@ -416,10 +598,179 @@ func (mt *MetaTable) DeleteCollection(collID typeutil.UniqueID, ts typeutil.Time
 		log.Warn("TxnKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		//Txn kv fail will no panic here, treated as garbage
 	}
+	mt.removeCollectionGrants(collMeta.DbId, collID)
+
+	return nil
+}
+
+// RenameCollection renames the collection in the database, aliases of the collection keep pointing at it
+func (mt *MetaTable) RenameCollection(dbName string, oldName string, newName string, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	collID, ok := mt.collName2ID[dbID][oldName]
+	if !ok {
+		return fmt.Errorf("can't find collection: %s", oldName)
+	}
+	if _, ok := mt.collName2ID[dbID][newName]; ok {
+		return fmt.Errorf("duplicate collection name, name = %s", newName)
+	}
+	if _, ok := mt.collAlias2ID[dbID][newName]; ok {
+		return fmt.Errorf("collection name collides with existing collection alias, name = %s", newName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection %s with id %d", oldName, collID)
+	}
+
+	newColl := proto.Clone(&coll).(*pb.CollectionInfo)
+	newColl.Schema.Name = newName
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(newColl)
+	if err != nil {
+		log.Error("MetaTable RenameCollection Marshal CollectionInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable RenameCollection Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+
+	mt.collID2Meta[collID] = *newColl
+	delete(mt.collName2ID[dbID], oldName)
+	mt.collName2ID[dbID][newName] = collID
+	return nil
+}
+
+// AlterCollection merges the properties into the collection meta, a property with an empty value is removed
+func (mt *MetaTable) AlterCollection(dbName string, collName string, properties []*commonpb.KeyValuePair, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	collID, ok := mt.collName2ID[dbID][collName]
+	if !ok {
+		return fmt.Errorf("can't find collection: %s", collName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection %s with id %d", collName, collID)
+	}
+
+	newColl := proto.Clone(&coll).(*pb.CollectionInfo)
+	newColl.Properties = mergeProperties(newColl.Properties, properties)
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(newColl)
+	if err != nil {
+		log.Error("MetaTable AlterCollection Marshal CollectionInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable AlterCollection Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+
+	mt.collID2Meta[collID] = *newColl
 	return nil
 }
 
+// AddField appends the field to the collection schema with a new field id and bumps the schema version
+func (mt *MetaTable) AddField(dbName string, collName string, field *schemapb.FieldSchema, ts typeutil.Timestamp) error {
+	mt.ddLock.Lock()
+	defer mt.ddLock.Unlock()
+
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	collID, ok := mt.collName2ID[dbID][collName]
+	if !ok {
+		return fmt.Errorf("can't find collection: %s", collName)
+	}
+	coll, ok := mt.collID2Meta[collID]
+	if !ok {
+		return fmt.Errorf("can't find collection %s with id %d", collName, collID)
+	}
+
+	newColl := proto.Clone(&coll).(*pb.CollectionInfo)
+	maxFieldID := typeutil.UniqueID(0)
+	for _, f := range newColl.Schema.Fields {
+		if f.Name == field.Name {
+			return fmt.Errorf("duplicate field name, name = %s", field.Name)
+		}
+		if f.FieldID > maxFieldID {
+			maxFieldID = f.FieldID
+		}
+	}
+	newField := proto.Clone(field).(*schemapb.FieldSchema)
+	newField.FieldID = maxFieldID + 1
+	newColl.Schema.Fields = append(newColl.Schema.Fields, newField)
+	newColl.Schema.Version++
+
+	k := fmt.Sprintf("%s/%d", CollectionMetaPrefix, collID)
+	v, err := proto.Marshal(newColl)
+	if err != nil {
+		log.Error("MetaTable AddField Marshal CollectionInfo fail",
+			zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable AddField Marshal CollectionInfo fail key:%s, err:%w", k, err)
+	}
+
+	err = mt.snapshot.Save(k, string(v), ts)
+	if err != nil {
+		log.Error("SnapShotKV Save fail", zap.Error(err))
+		panic("SnapShotKV Save fail")
+	}
+
+	mt.collID2Meta[collID] = *newColl
+	return nil
+}
+
+// mergeProperties overwrites the properties having the same keys and appends the new ones, keeping the original order
+func mergeProperties(origin []*commonpb.KeyValuePair, updates []*commonpb.KeyValuePair) []*commonpb.KeyValuePair {
+	values := make(map[string]string, len(updates))
+	for _, kv := range updates {
+		values[kv.Key] = kv.Value
+	}
+	merged := make([]*commonpb.KeyValuePair, 0, len(origin)+len(updates))
+	for _, kv := range origin {
+		value, ok := values[kv.Key]
+		if !ok {
+			merged = append(merged, kv)
+			continue
+		}
+		delete(values, kv.Key)
+		if value != "" {
+			merged = append(merged, &commonpb.KeyValuePair{Key: kv.Key, Value: value})
+		}
+	}
+	for _, kv := range updates {
+		value, ok := values[kv.Key]
+		if !ok {
+			continue
+		}
+		delete(values, kv.Key)
+		if value != "" {
+			merged = append(merged, &commonpb.KeyValuePair{Key: kv.Key, Value: value})
+		}
+	}
+	return merged
+}
+
 // HasCollection return collection existence
 func (mt *MetaTable) HasCollection(collID typeutil.UniqueID, ts typeutil.Timestamp) bool {
 	mt.ddLock.RLock()
@@ -459,15 +810,19 @@ func (mt *MetaTable) GetCollectionByID(collectionID typeutil.UniqueID, ts typeut
 	return &colMeta, nil
 }
 
-// GetCollectionByName return collection meta by collection name
-func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
+// GetCollectionByName return collection meta by collection name in the database
+func (mt *MetaTable) GetCollectionByName(dbName string, collectionName string, ts typeutil.Timestamp) (*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		vid, ok := mt.collName2ID[collectionName]
+		vid, ok := mt.collName2ID[dbID][collectionName]
 		if !ok {
-			if vid, ok = mt.collAlias2ID[collectionName]; !ok {
+			if vid, ok = mt.collAlias2ID[dbID][collectionName]; !ok {
 				return nil, fmt.Errorf("can't find collection: " + collectionName)
 			}
 		}
@@ -489,21 +844,25 @@ func (mt *MetaTable) GetCollectionByName(collectionName string, ts typeutil.Time
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 			continue
 		}
-		if collMeta.Schema.Name == collectionName {
+		if collMeta.DbId == dbID && collMeta.Schema.Name == collectionName {
 			return &collMeta, nil
 		}
 	}
 	return nil, fmt.Errorf("can't find collection: %s, at timestamp = %d", collectionName, ts)
 }
 
-// ListCollections list all collection names
-func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
+// ListCollections list all collection names in the database
+func (mt *MetaTable) ListCollections(dbName string, ts typeutil.Timestamp) (map[string]*pb.CollectionInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	colls := make(map[string]*pb.CollectionInfo)
 
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return nil, err
+	}
 	if ts == 0 {
-		for collName, collID := range mt.collName2ID {
+		for collName, collID := range mt.collName2ID[dbID] {
 			coll := mt.collID2Meta[collID]
 			colCopy := proto.Clone(&coll)
 			colls[collName] = colCopy.(*pb.CollectionInfo)
@@ -521,6 +880,9 @@ func (mt *MetaTable) ListCollections(ts typeutil.Timestamp) (map[string]*pb.Coll
 		if err != nil {
 			log.Debug("unmarshal collection info failed", zap.Error(err))
 		}
+		if collMeta.DbId != dbID {
+			continue
+		}
 		colls[collMeta.Schema.Name] = &collMeta
 	}
 	return colls, nil
@@ -531,7 +893,11 @@ func (mt *MetaTable) ListAliases(collID typeutil.UniqueID) []string {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 	var aliases []string
-	for alias, cid := range mt.collAlias2ID {
+	collMeta, ok := mt.collID2Meta[collID]
+	if !ok {
+		return aliases
+	}
+	for alias, cid := range mt.collAlias2ID[collMeta.DbId] {
 		if cid == collID {
 			aliases = append(aliases, alias)
 		}
@@ -850,22 +1216,19 @@ func (mt *MetaTable) AddIndex(segIdxInfo *pb.SegmentIndexInfo) error {
 }
 
 // DropIndex drop index
-func (mt *MetaTable) DropIndex(collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
+func (mt *MetaTable) DropIndex(dbName, collName, fieldName, indexName string) (typeutil.UniqueID, bool, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return 0, false, fmt.Errorf("collection name = %s not exist", collName)
-		}
+	collID, err := mt.getCollectionIDByName(dbName, collName)
+	if err != nil {
+		return 0, false, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return 0, false, fmt.Errorf("collection name  = %s not has meta", collName)
 	}
-	fieldSch, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSch, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return 0, false, err
 	}
@@ -976,20 +1339,17 @@ func (mt *MetaTable) GetSegmentIndexInfoByID(segID typeutil.UniqueID, fieldID in
 }
 
 // GetFieldSchema return field schema
-func (mt *MetaTable) GetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	return mt.unlockGetFieldSchema(collName, fieldName)
+	return mt.unlockGetFieldSchema(dbName, collName, fieldName)
 }
 
-func (mt *MetaTable) unlockGetFieldSchema(collName string, fieldName string) (schemapb.FieldSchema, error) {
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+func (mt *MetaTable) unlockGetFieldSchema(dbName string, collName string, fieldName string) (schemapb.FieldSchema, error) {
+	collID, err := mt.getCollectionIDByName(dbName, collName)
+	if err != nil {
+		return schemapb.FieldSchema{}, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1034,25 +1394,22 @@ func (mt *MetaTable) unlockIsSegmentIndexed(segID typeutil.UniqueID, fieldSchema
 }
 
 // GetNotIndexedSegments return segment ids which have no index
-func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
+func (mt *MetaTable) GetNotIndexedSegments(dbName string, collName string, fieldName string, idxInfo *pb.IndexInfo, segIDs []typeutil.UniqueID) ([]typeutil.UniqueID, schemapb.FieldSchema, error) {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
 
 	if idxInfo.IndexParams == nil {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("index param is nil")
 	}
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
-		}
+	collID, err := mt.getCollectionIDByName(dbName, collName)
+	if err != nil {
+		return nil, schemapb.FieldSchema{}, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
 		return nil, schemapb.FieldSchema{}, fmt.Errorf("collection %s not found", collName)
 	}
-	fieldSchema, err := mt.unlockGetFieldSchema(collName, fieldName)
+	fieldSchema, err := mt.unlockGetFieldSchema(dbName, collName, fieldName)
 	if err != nil {
 		return nil, fieldSchema, err
 	}
@@ -1169,16 +1526,13 @@ func (mt *MetaTable) GetNotIndexedSegments(collName string, fieldName string, id
 }
 
 // GetIndexByName return index info by index name
-func (mt *MetaTable) GetIndexByName(collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
+func (mt *MetaTable) GetIndexByName(dbName, collName, indexName string) (pb.CollectionInfo, []pb.IndexInfo, error) {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
 
-	collID, ok := mt.collName2ID[collName]
-	if !ok {
-		collID, ok = mt.collAlias2ID[collName]
-		if !ok {
-			return pb.CollectionInfo{}, nil, fmt.Errorf("collection %s not found", collName)
-		}
+	collID, err := mt.getCollectionIDByName(dbName, collName)
+	if err != nil {
+		return pb.CollectionInfo{}, nil, err
 	}
 	collMeta, ok := mt.collID2Meta[collID]
 	if !ok {
@@ -1237,25 +1591,29 @@ func (mt *MetaTable) dupMeta() (
 }
 
 // AddAlias add collection alias
-func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) AddAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("duplicate collection alias, alias = %s", collectionAlias)
 	}
 
-	if _, ok := mt.collName2ID[collectionAlias]; ok {
+	if _, ok := mt.collName2ID[dbID][collectionAlias]; ok {
 		return fmt.Errorf("collection alias collides with existing collection name. collection = %s, alias = %s", collectionAlias, collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbID][collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AddAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1271,19 +1629,23 @@ func (mt *MetaTable) AddAlias(collectionAlias string, collectionName string, ts
 }
 
 // DropAlias drop collection alias
-func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) DropAlias(dbName string, collectionAlias string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
-	delete(mt.collAlias2ID, collectionAlias)
+	delete(mt.collAlias2ID[dbID], collectionAlias)
 
 	delMetakeys := []string{
-		fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias),
+		aliasKey(dbID, collectionAlias),
 	}
 	meta := make(map[string]string)
-	err := mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
+	err = mt.snapshot.MultiSaveAndRemoveWithPrefix(meta, delMetakeys, ts)
 	if err != nil {
 		log.Error("SnapShotKV MultiSaveAndRemoveWithPrefix fail", zap.Error(err))
 		panic("SnapShotKV MultiSaveAndRemoveWithPrefix fail")
@@ -1292,21 +1654,25 @@ func (mt *MetaTable) DropAlias(collectionAlias string, ts typeutil.Timestamp) er
 }
 
 // AlterAlias alter collection alias
-func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
+func (mt *MetaTable) AlterAlias(dbName string, collectionAlias string, collectionName string, ts typeutil.Timestamp) error {
 	mt.ddLock.Lock()
 	defer mt.ddLock.Unlock()
-	if _, ok := mt.collAlias2ID[collectionAlias]; !ok {
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return err
+	}
+	if _, ok := mt.collAlias2ID[dbID][collectionAlias]; !ok {
 		return fmt.Errorf("alias does not exist, alias = %s", collectionAlias)
 	}
 
-	id, ok := mt.collName2ID[collectionName]
+	id, ok := mt.collName2ID[dbID][collectionName]
 	if !ok {
 		return fmt.Errorf("aliased collection name does not exist, name = %s", collectionName)
 	}
-	mt.collAlias2ID[collectionAlias] = id
+	mt.collAlias2ID[dbID][collectionAlias] = id
 
-	k := fmt.Sprintf("%s/%s", CollectionAliasMetaPrefix, collectionAlias)
-	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}})
+	k := aliasKey(dbID, collectionAlias)
+	v, err := proto.Marshal(&pb.CollectionInfo{ID: id, Schema: &schemapb.CollectionSchema{Name: collectionAlias}, DbId: dbID})
 	if err != nil {
 		log.Error("MetaTable AlterAlias Marshal CollectionInfo fail",
 			zap.String("key", k), zap.Error(err))
@@ -1321,10 +1687,399 @@ func (mt *MetaTable) AlterAlias(collectionAlias string, collectionName string, t
 	return nil
 }
 
-// IsAlias returns true if specific `collectionAlias` is an alias of collection.
-func (mt *MetaTable) IsAlias(collectionAlias string) bool {
+// IsAlias returns true if specific `collectionAlias` is an alias of collection in the database.
+func (mt *MetaTable) IsAlias(dbName string, collectionAlias string) bool {
 	mt.ddLock.RLock()
 	defer mt.ddLock.RUnlock()
-	_, ok := mt.collAlias2ID[collectionAlias]
+	dbID, err := mt.getDatabaseID(dbName)
+	if err != nil {
+		return false
+	}
+	_, ok := mt.collAlias2ID[dbID][collectionAlias]
 	return ok
 }
+
+func credentialKey(username string) string {
+	return fmt.Sprintf("%s/%s", CredentialMetaPrefix, username)
+}
+
+func (mt *MetaTable) saveCredential(credInfo *internalpb.CredentialInfo) error {
+	k := credentialKey(credInfo.Username)
+	v, err := proto.Marshal(&internalpb.CredentialInfo{EncryptedPassword: credInfo.EncryptedPassword})
+	if err != nil {
+		log.Error("MetaTable marshal CredentialInfo fail", zap.String("key", k), zap.Error(err))
+		return fmt.Errorf("metaTable marshal CredentialInfo fail key:%s, err:%w", k, err)
+	}
+	return mt.txn.Save(k, string(v))
+}
+
+// AddCredential add a new user credential, the password should be encrypted already
+func (mt *MetaTable) AddCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if credInfo.Username == "" {
+		return fmt.Errorf("username is empty")
+	}
+	if _, err := mt.txn.Load(credentialKey(credInfo.Username)); err == nil {
+		return fmt.Errorf("user %s already exists", credInfo.Username)
+	}
+	return mt.saveCredential(credInfo)
+}
+
+// AlterCredential replace the encrypted password of an existing user
+func (mt *MetaTable) AlterCredential(credInfo *internalpb.CredentialInfo) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, err := mt.txn.Load(credentialKey(credInfo.Username)); err != nil {
+		return fmt.Errorf("user %s not found", credInfo.Username)
+	}
+	return mt.saveCredential(credInfo)
+}
+
+// GetCredential get the credential of the user, the password returned is encrypted
+func (mt *MetaTable) GetCredential(username string) (*internalpb.CredentialInfo, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	v, err := mt.txn.Load(credentialKey(username))
+	if err != nil {
+		return nil, fmt.Errorf("user %s not found", username)
+	}
+	credInfo := &internalpb.CredentialInfo{}
+	if err := proto.Unmarshal([]byte(v), credInfo); err != nil {
+		return nil, fmt.Errorf("unmarshal credential of user %s fail, err:%w", username, err)
+	}
+	credInfo.Username = username
+	return credInfo, nil
+}
+
+// DeleteCredential remove the credential of the user
+func (mt *MetaTable) DeleteCredential(username string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	k := credentialKey(username)
+	if _, err := mt.txn.Load(k); err != nil {
+		return fmt.Errorf("user %s not found", username)
+	}
+	// the roles bound to the user are released as well
+	if err := mt.txn.RemoveWithPrefix(fmt.Sprintf("%s/%s/", RoleMappingMetaPrefix, username)); err != nil {
+		return err
+	}
+	return mt.txn.Remove(k)
+}
+
+// ListCredentialUsernames list all usernames, sorted alphabetically
+func (mt *MetaTable) ListCredentialUsernames() ([]string, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	keys, _, err := mt.txn.LoadWithPrefix(CredentialMetaPrefix)
+	if err != nil {
+		return nil, err
+	}
+	usernames := make([]string, 0, len(keys))
+	for _, k := range keys {
+		usernames = append(usernames, path.Base(k))
+	}
+	sort.Strings(usernames)
+	return usernames, nil
+}
+
+func roleKey(roleName string) string {
+	return fmt.Sprintf("%s/%s", RoleMetaPrefix, roleName)
+}
+
+func roleMappingKey(username string, roleName string) string {
+	return fmt.Sprintf("%s/%s/%s", RoleMappingMetaPrefix, username, roleName)
+}
+
+// grantKey returns the meta key of the privilege granted on the object, which is keyed by the ids of the database
+// and the collection, so the grant follows the collection when it's renamed, and a new collection of the same name
+// doesn't inherit it. The ids are * for global objects, and the collection id is * for all collections of the database.
+func grantKey(roleName string, objectType string, dbID string, objectID string, privilege string) string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s", GrantMetaPrefix, roleName, objectType, dbID, objectID, privilege)
+}
+
+// grantObjectIDs resolves the database and the collection of the grant entity to the ids in grant keys,
+// caller should hold ddLock
+func (mt *MetaTable) grantObjectIDs(entity *milvuspb.GrantEntity) (string, string, error) {
+	if entity.ObjectType == util.ObjectTypeGlobal {
+		return util.AnyWord, util.AnyWord, nil
+	}
+	dbID, err := mt.getDatabaseID(entity.DbName)
+	if err != nil {
+		return "", "", err
+	}
+	if entity.ObjectName == util.AnyWord {
+		return strconv.FormatInt(dbID, 10), util.AnyWord, nil
+	}
+	collID, err := mt.getCollectionIDByName(entity.DbName, entity.ObjectName)
+	if err != nil {
+		return "", "", err
+	}
+	return strconv.FormatInt(dbID, 10), strconv.FormatInt(collID, 10), nil
+}
+
+// CreateRole add a new role
+func (mt *MetaTable) CreateRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if roleName == "" {
+		return fmt.Errorf("role name is empty")
+	}
+	if _, err := mt.txn.Load(roleKey(roleName)); err == nil {
+		return fmt.Errorf("role %s already exists", roleName)
+	}
+	return mt.txn.Save(roleKey(roleName), "")
+}
+
+// DropRole remove the role, the privileges granted to the role and the binding between users and the role are removed as well
+func (mt *MetaTable) DropRole(roleName string) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	k := roleKey(roleName)
+	if _, err := mt.txn.Load(k); err != nil {
+		return fmt.Errorf("role %s not found", roleName)
+	}
+
+	keys, _, err := mt.txn.LoadWithPrefix(RoleMappingMetaPrefix)
+	if err != nil {
+		return err
+	}
+	removals := []string{k}
+	for _, key := range keys {
+		if path.Base(key) == roleName {
+			username := path.Base(path.Dir(key))
+			removals = append(removals, roleMappingKey(username, roleName))
+		}
+	}
+	if err := mt.txn.RemoveWithPrefix(fmt.Sprintf("%s/%s/", GrantMetaPrefix, roleName)); err != nil {
+		return err
+	}
+	return mt.txn.MultiRemove(removals)
+}
+
+// OperateUserRole add the user to the role or remove the user from the role
+func (mt *MetaTable) OperateUserRole(username string, roleName string, operateType milvuspb.OperateUserRoleType) error {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if _, err := mt.txn.Load(credentialKey(username)); err != nil {
+		return fmt.Errorf("user %s not found", username)
+	}
+	if _, err := mt.txn.Load(roleKey(roleName)); err != nil {
+		return fmt.Errorf("role %s not found", roleName)
+	}
+
+	k := roleMappingKey(username, roleName)
+	switch operateType {
+	case milvuspb.OperateUserRoleType_AddUserToRole:
+		return mt.txn.Save(k, "")
+	case milvuspb.OperateUserRoleType_RemoveUserFromRole:
+		if _, err := mt.txn.Load(k); err != nil {
+			return fmt.Errorf("user %s is not bound to role %s", username, roleName)
+		}
+		return mt.txn.Remove(k)
+	default:
+		return fmt.Errorf("invalid operate user role type: %s", operateType.String())
+	}
+}
+
+func checkGrantEntity(entity *milvuspb.GrantEntity) error {
+	if entity == nil {
+		return fmt.Errorf("grant entity is nil")
+	}
+	if entity.ObjectType != util.ObjectTypeCollection && entity.ObjectType != util.ObjectTypeGlobal {
+		return fmt.Errorf("invalid object type: %s", entity.ObjectType)
+	}
+	if entity.ObjectName == "" {
+		return fmt.Errorf("object name is empty")
+	}
+	if entity.Privilege == "" {
+		return fmt.Errorf("privilege is empty")
+	}
+	return nil
+}
+
+// GrantPrivilege grant the privilege on the object to the role
+func (mt *MetaTable) GrantPrivilege(entity *milvuspb.GrantEntity) error {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if err := checkGrantEntity(entity); err != nil {
+		return err
+	}
+	if _, err := mt.txn.Load(roleKey(entity.RoleName)); err != nil {
+		return fmt.Errorf("role %s not found", entity.RoleName)
+	}
+	dbID, objectID, err := mt.grantObjectIDs(entity)
+	if err != nil {
+		return err
+	}
+	return mt.txn.Save(grantKey(entity.RoleName, entity.ObjectType, dbID, objectID, entity.Privilege), "")
+}
+
+// RevokePrivilege revoke the privilege on the object from the role
+func (mt *MetaTable) RevokePrivilege(entity *milvuspb.GrantEntity) error {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	if err := checkGrantEntity(entity); err != nil {
+		return err
+	}
+	dbID, objectID, err := mt.grantObjectIDs(entity)
+	if err != nil {
+		return err
+	}
+	k := grantKey(entity.RoleName, entity.ObjectType, dbID, objectID, entity.Privilege)
+	if _, err := mt.txn.Load(k); err != nil {
+		return fmt.Errorf("privilege %s on %s %s is not granted to role %s",
+			entity.Privilege, entity.ObjectType, entity.ObjectName, entity.RoleName)
+	}
+	return mt.txn.Remove(k)
+}
+
+func (mt *MetaTable) listGrants(roleName string) ([]*milvuspb.GrantEntity, error) {
+	if _, err := mt.txn.Load(roleKey(roleName)); err != nil {
+		return nil, fmt.Errorf("role %s not found", roleName)
+	}
+	keys, _, err := mt.txn.LoadWithPrefix(fmt.Sprintf("%s/%s/", GrantMetaPrefix, roleName))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(keys)
+	entities := make([]*milvuspb.GrantEntity, 0, len(keys))
+	for _, k := range keys {
+		// key format: prefix/role/object type/database id/collection id/privilege
+		parts := strings.Split(k, "/")
+		if len(parts) < 5 {
+			log.Warn("invalid grant key", zap.String("key", k))
+			continue
+		}
+		entity := &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			ObjectType: parts[len(parts)-4],
+			ObjectName: util.AnyWord,
+			Privilege:  parts[len(parts)-1],
+		}
+		if entity.ObjectType == util.ObjectTypeCollection {
+			// the grants on the dropped databases or collections are skipped
+			dbID, err := strconv.ParseInt(parts[len(parts)-3], 10, 64)
+			if err != nil {
+				log.Warn("invalid grant key", zap.String("key", k))
+				continue
+			}
+			db, ok := mt.dbID2Meta[dbID]
+			if !ok {
+				continue
+			}
+			entity.DbName = db.Name
+			if objectID := parts[len(parts)-2]; objectID != util.AnyWord {
+				collID, err := strconv.ParseInt(objectID, 10, 64)
+				if err != nil {
+					log.Warn("invalid grant key", zap.String("key", k))
+					continue
+				}
+				collMeta, ok := mt.collID2Meta[collID]
+				if !ok {
+					continue
+				}
+				entity.ObjectName = collMeta.Schema.Name
+			}
+		}
+		entities = append(entities, entity)
+	}
+	return entities, nil
+}
+
+// removeCollectionGrants removes the privileges granted on the collection from all roles, caller should hold ddLock
+func (mt *MetaTable) removeCollectionGrants(dbID typeutil.UniqueID, collID typeutil.UniqueID) {
+	mt.credLock.Lock()
+	defer mt.credLock.Unlock()
+
+	keys, _, err := mt.txn.LoadWithPrefix(GrantMetaPrefix + "/")
+	if err != nil {
+		log.Warn("failed to load grants", zap.Int64("collection id", collID), zap.Error(err))
+		return
+	}
+	var removals []string
+	for _, k := range keys {
+		parts := strings.Split(k, "/")
+		if len(parts) < 5 {
+			continue
+		}
+		if parts[len(parts)-4] == util.ObjectTypeCollection && parts[len(parts)-3] == strconv.FormatInt(dbID, 10) &&
+			parts[len(parts)-2] == strconv.FormatInt(collID, 10) {
+			removals = append(removals, k)
+		}
+	}
+	if len(removals) == 0 {
+		return
+	}
+	if err := mt.txn.MultiRemove(removals); err != nil {
+		// the grants left are skipped when listed
+		log.Warn("failed to remove grants of collection", zap.Int64("collection id", collID), zap.Error(err))
+	}
+}
+
+// ListGrants list the privileges granted to the role
+func (mt *MetaTable) ListGrants(roleName string) ([]*milvuspb.GrantEntity, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	return mt.listGrants(roleName)
+}
+
+// ListUserGrants list the privileges granted to all the roles bound to the user
+func (mt *MetaTable) ListUserGrants(username string) ([]*milvuspb.GrantEntity, error) {
+	mt.ddLock.RLock()
+	defer mt.ddLock.RUnlock()
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	roleNames, err := mt.listUserRoles(username)
+	if err != nil {
+		return nil, err
+	}
+	entities := make([]*milvuspb.GrantEntity, 0)
+	for _, roleName := range roleNames {
+		grants, err := mt.listGrants(roleName)
+		if err != nil {
+			return nil, err
+		}
+		entities = append(entities, grants...)
+	}
+	return entities, nil
+}
+
+func (mt *MetaTable) listUserRoles(username string) ([]string, error) {
+	keys, _, err := mt.txn.LoadWithPrefix(fmt.Sprintf("%s/%s/", RoleMappingMetaPrefix, username))
+	if err != nil {
+		return nil, err
+	}
+	roleNames := make([]string, 0, len(keys))
+	for _, k := range keys {
+		roleNames = append(roleNames, path.Base(k))
+	}
+	sort.Strings(roleNames)
+	return roleNames, nil
+}
+
+// ListUserRoles list the roles bound to the user, sorted alphabetically
+func (mt *MetaTable) ListUserRoles(username string) ([]string, error) {
+	mt.credLock.RLock()
+	defer mt.credLock.RUnlock()
+
+	return mt.listUserRoles(username)
+}
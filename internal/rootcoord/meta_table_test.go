@@ -26,12 +26,16 @@ import (
 	"time"
 
 	"github.com/golang/protobuf/proto"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/kv"
 	etcdkv "github.com/milvus-io/milvus/internal/kv/etcd"
 	memkv "github.com/milvus-io/milvus/internal/kv/mem"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	pb "github.com/milvus-io/milvus/internal/proto/etcdpb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/milvus-io/milvus/internal/util/etcd"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -133,6 +137,17 @@ func Test_MockKV(t *testing.T) {
 	_, err = NewMetaTable(kt, k1)
 	assert.NotNil(t, err)
 
+	// database
+	prefix[DatabaseMetaPrefix] = []string{"database-meta"}
+	_, err = NewMetaTable(kt, k1)
+	assert.NotNil(t, err)
+
+	value, err = proto.Marshal(&pb.DatabaseInfo{ID: 1, Name: "db1"})
+	assert.Nil(t, err)
+	prefix[DatabaseMetaPrefix] = []string{string(value)}
+	_, err = NewMetaTable(kt, k1)
+	assert.NotNil(t, err)
+
 	// collection
 	prefix[CollectionMetaPrefix] = []string{"collection-meta"}
 	_, err = NewMetaTable(kt, k1)
@@ -308,7 +323,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, uint64(1), ts)
 
-		collMeta, err := mt.GetCollectionByName(collName, 0)
+		collMeta, err := mt.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, collMeta.CreateTime, ts)
 		assert.Equal(t, collMeta.PartitionCreatedTimestamps[0], ts)
@@ -317,7 +332,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		assert.True(t, mt.HasCollection(collInfo.ID, 0))
 
-		field, err := mt.GetFieldSchema(collName, "field110")
+		field, err := mt.GetFieldSchema("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, collInfo.Schema.Fields[0].FieldID, field.FieldID)
 
@@ -331,22 +346,22 @@ func TestMetaTable(t *testing.T) {
 	t.Run("add alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		exists := mt.IsAlias(aliasName1)
+		exists := mt.IsAlias("", aliasName1)
 		assert.False(t, exists)
-		err = mt.AddAlias(aliasName1, collName, ts)
+		err = mt.AddAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
 		aliases := mt.ListAliases(collID)
 		assert.Equal(t, aliases, []string{aliasName1})
-		exists = mt.IsAlias(aliasName1)
+		exists = mt.IsAlias("", aliasName1)
 		assert.True(t, exists)
 	})
 	wg.Add(1)
 	t.Run("alter alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.AlterAlias(aliasName1, collName, ts)
+		err = mt.AlterAlias("", aliasName1, collName, ts)
 		assert.Nil(t, err)
-		err = mt.AlterAlias(aliasName1, collNameInvalid, ts)
+		err = mt.AlterAlias("", aliasName1, collNameInvalid, ts)
 		assert.NotNil(t, err)
 	})
 
@@ -354,7 +369,7 @@ func TestMetaTable(t *testing.T) {
 	t.Run("delete alias", func(t *testing.T) {
 		defer wg.Done()
 		ts := ftso()
-		err = mt.DropAlias(aliasName1, ts)
+		err = mt.DropAlias("", aliasName1, ts)
 		assert.Nil(t, err)
 	})
 
@@ -432,9 +447,9 @@ func TestMetaTable(t *testing.T) {
 			IndexParams: params,
 		}
 
-		_, _, err := mt.GetNotIndexedSegments("collTest", "field110", idxInfo, nil)
+		_, _, err := mt.GetNotIndexedSegments("", "collTest", "field110", idxInfo, nil)
 		assert.NotNil(t, err)
-		seg, field, err := mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err := mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(seg))
 		assert.Equal(t, segID2, seg[0])
@@ -450,7 +465,7 @@ func TestMetaTable(t *testing.T) {
 		idxInfo.IndexID = 2001
 		idxInfo.IndexName = "field110-1"
 
-		seg, field, err = mt.GetNotIndexedSegments(collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
+		seg, field, err = mt.GetNotIndexedSegments("", collName, "field110", idxInfo, []typeutil.UniqueID{segID, segID2})
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(seg))
 		assert.Equal(t, segID, seg[0])
@@ -462,7 +477,7 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("get index by name", func(t *testing.T) {
 		defer wg.Done()
-		_, idx, err := mt.GetIndexByName(collName, "field110")
+		_, idx, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 		assert.Equal(t, indexID, idx[0].IndexID)
@@ -478,7 +493,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		assert.True(t, EqualKeyPairArray(idx[0].IndexParams, params))
 
-		_, idx, err = mt.GetIndexByName(collName, "idx201")
+		_, idx, err = mt.GetIndexByName("", collName, "idx201")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idx))
 	})
@@ -504,20 +519,20 @@ func TestMetaTable(t *testing.T) {
 	wg.Add(1)
 	t.Run("drop index", func(t *testing.T) {
 		defer wg.Done()
-		idx, ok, err := mt.DropIndex(collName, "field110", "field110")
+		idx, ok, err := mt.DropIndex("", collName, "field110", "field110")
 		assert.Nil(t, err)
 		assert.True(t, ok)
 		assert.Equal(t, indexID, idx)
 
-		_, ok, err = mt.DropIndex(collName, "field110", "field110-error")
+		_, ok, err = mt.DropIndex("", collName, "field110", "field110-error")
 		assert.Nil(t, err)
 		assert.False(t, ok)
 
-		_, idxs, err := mt.GetIndexByName(collName, "field110")
+		_, idxs, err := mt.GetIndexByName("", collName, "field110")
 		assert.Nil(t, err)
 		assert.Zero(t, len(idxs))
 
-		_, idxs, err = mt.GetIndexByName(collName, "field110-1")
+		_, idxs, err = mt.GetIndexByName("", collName, "field110-1")
 		assert.Nil(t, err)
 		assert.Equal(t, len(idxs), 1)
 		assert.Equal(t, idxs[0].IndexID, int64(2001))
@@ -547,12 +562,12 @@ func TestMetaTable(t *testing.T) {
 		err = mt.DeleteCollection(collIDInvalid, ts, "")
 		assert.NotNil(t, err)
 		ts2 := ftso()
-		err = mt.AddAlias(aliasName2, collName, ts2)
+		err = mt.AddAlias("", aliasName2, collName, ts2)
 		assert.Nil(t, err)
 		err = mt.DeleteCollection(collID, ts, "")
 		assert.Nil(t, err)
 		ts3 := ftso()
-		err = mt.DropAlias(aliasName2, ts3)
+		err = mt.DropAlias("", aliasName2, ts3)
 		assert.NotNil(t, err)
 
 		// check DD operation flag
@@ -619,7 +634,7 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.GetCollectionByName(collInfo.Schema.Name, 0)
+		_, err = mt.GetCollectionByName("", collInfo.Schema.Name, 0)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("can't find collection %s with id %d", collInfo.Schema.Name, collInfo.ID))
 
@@ -831,16 +846,16 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
-		assert.EqualError(t, err, "collection name = abc not exist")
+		assert.EqualError(t, err, "collection abc not found")
 
-		mt.collName2ID["abc"] = 2
-		_, _, err = mt.DropIndex("abc", "abc", "abc")
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 2
+		_, _, err = mt.DropIndex("", "abc", "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection name  = abc not has meta")
 
-		_, _, err = mt.DropIndex(collInfo.Schema.Name, "abc", "abc")
+		_, _, err = mt.DropIndex("", collInfo.Schema.Name, "abc", "abc")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed abc", collInfo.Schema.Name))
 
@@ -857,7 +872,7 @@ func TestMetaTable(t *testing.T) {
 		}
 		mt.collID2Meta[coll.ID] = coll
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		idxID, isDroped, err := mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
+		idxID, isDroped, err := mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName)
 		assert.Zero(t, idxID)
 		assert.False(t, isDroped)
 		assert.Nil(t, err)
@@ -873,7 +888,7 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSaveAndRemoveWithPrefix = func(saves map[string]string, removals []string) error {
 			return fmt.Errorf("multi save and remove with prefix error")
 		}
-		assert.Panics(t, func() { mt.DropIndex(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
+		assert.Panics(t, func() { mt.DropIndex("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idxInfo[0].IndexName) })
 	})
 
 	wg.Add(1)
@@ -950,12 +965,12 @@ func TestMetaTable(t *testing.T) {
 		assert.Nil(t, err)
 
 		mt.collID2Meta = make(map[int64]pb.CollectionInfo)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 
-		mt.collName2ID = make(map[string]int64)
-		_, err = mt.unlockGetFieldSchema(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
+		mt.collName2ID[DefaultDatabaseID] = make(map[string]int64)
+		_, err = mt.unlockGetFieldSchema("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s not found", collInfo.Schema.Name))
 	})
@@ -1004,8 +1019,8 @@ func TestMetaTable(t *testing.T) {
 			},
 		}
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetNotIndexedSegments("abc", "no-field", idx, nil)
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetNotIndexedSegments("", "abc", "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1025,13 +1040,13 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, "no-field", idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, "no-field", idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("collection %s doesn't have filed no-field", collInfo.Schema.Name))
 
 		bakMeta := mt.indexID2Meta
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 		mt.indexID2Meta = bakMeta
@@ -1039,8 +1054,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 
@@ -1073,8 +1088,8 @@ func TestMetaTable(t *testing.T) {
 		mockTxnKV.multiSave = func(kvs map[string]string) error {
 			return fmt.Errorf("multi save error")
 		}
-		assert.Panics(t, func() { mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
-		//_, _, err = mt.GetNotIndexedSegments(collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
+		assert.Panics(t, func() { mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil) })
+		//_, _, err = mt.GetNotIndexedSegments("", collInfo.Schema.Name, collInfo.Schema.Fields[0].Name, idx, nil)
 		//assert.NotNil(t, err)
 		//assert.EqualError(t, err, "multi save error")
 	})
@@ -1088,8 +1103,8 @@ func TestMetaTable(t *testing.T) {
 		err := mt.reloadFromKV()
 		assert.Nil(t, err)
 
-		mt.collName2ID["abc"] = 123
-		_, _, err = mt.GetIndexByName("abc", "hij")
+		mt.collName2ID[DefaultDatabaseID]["abc"] = 123
+		_, _, err = mt.GetIndexByName("", "abc", "hij")
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, "collection abc not found")
 
@@ -1109,7 +1124,7 @@ func TestMetaTable(t *testing.T) {
 		err = mt.AddCollection(collInfo, ts, idxInfo, "")
 		assert.Nil(t, err)
 		mt.indexID2Meta = make(map[int64]pb.IndexInfo)
-		_, _, err = mt.GetIndexByName(collInfo.Schema.Name, idxInfo[0].IndexName)
+		_, _, err = mt.GetIndexByName("", collInfo.Schema.Name, idxInfo[0].IndexName)
 		assert.NotNil(t, err)
 		assert.EqualError(t, err, fmt.Sprintf("index id = %d not found", idxInfo[0].IndexID))
 
@@ -1214,29 +1229,29 @@ func TestMetaWithTimestamp(t *testing.T) {
 	c2, err = mt.GetCollectionByID(collID2, tsoStart)
 	assert.NotNil(t, err)
 
-	c1, err = mt.GetCollectionByName(collName1, 0)
+	c1, err = mt.GetCollectionByName("", collName1, 0)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, 0)
+	c2, err = mt.GetCollectionByName("", collName2, 0)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t2)
+	c1, err = mt.GetCollectionByName("", collName1, t2)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t2)
+	c2, err = mt.GetCollectionByName("", collName2, t2)
 	assert.Nil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 	assert.Equal(t, int64(2), c2.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, t1)
+	c1, err = mt.GetCollectionByName("", collName1, t1)
 	assert.Nil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, t1)
+	c2, err = mt.GetCollectionByName("", collName2, t1)
 	assert.NotNil(t, err)
 	assert.Equal(t, int64(1), c1.ID)
 
-	c1, err = mt.GetCollectionByName(collName1, tsoStart)
+	c1, err = mt.GetCollectionByName("", collName1, tsoStart)
 	assert.NotNil(t, err)
-	c2, err = mt.GetCollectionByName(collName2, tsoStart)
+	c2, err = mt.GetCollectionByName("", collName2, tsoStart)
 	assert.NotNil(t, err)
 
 	getKeys := func(m map[string]*pb.CollectionInfo) []string {
@@ -1247,22 +1262,22 @@ func TestMetaWithTimestamp(t *testing.T) {
 		return keys
 	}
 
-	s1, err := mt.ListCollections(0)
+	s1, err := mt.ListCollections("", 0)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t2)
+	s1, err = mt.ListCollections("", t2)
 	assert.Nil(t, err)
 	assert.Equal(t, 2, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1, collName2})
 
-	s1, err = mt.ListCollections(t1)
+	s1, err = mt.ListCollections("", t1)
 	assert.Nil(t, err)
 	assert.Equal(t, 1, len(s1))
 	assert.ElementsMatch(t, getKeys(s1), []string{collName1})
 
-	s1, err = mt.ListCollections(tsoStart)
+	s1, err = mt.ListCollections("", tsoStart)
 	assert.Nil(t, err)
 	assert.Equal(t, 0, len(s1))
 
@@ -1316,3 +1331,526 @@ func TestFixIssue10540(t *testing.T) {
 	_, err = NewMetaTable(txnKV, skv)
 	assert.Nil(t, err)
 }
+
+func TestMetaTable_Database(t *testing.T) {
+	const (
+		dbName   = "db1"
+		dbID     = typeutil.UniqueID(1000)
+		collName = "testColl"
+		alias    = "testAlias"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	dbs := mt.ListDatabases()
+	assert.Equal(t, 1, len(dbs))
+	assert.Equal(t, Params.CommonCfg.DefaultDatabaseName, dbs[0].Name)
+	assert.Equal(t, DefaultDatabaseID, dbs[0].ID)
+
+	err = mt.CreateDatabase(&pb.DatabaseInfo{ID: dbID, Name: dbName}, ftso())
+	assert.Nil(t, err)
+	err = mt.CreateDatabase(&pb.DatabaseInfo{ID: dbID + 1, Name: dbName}, ftso())
+	assert.EqualError(t, err, fmt.Sprintf("database %s exist", dbName))
+
+	db, err := mt.GetDatabaseByName(dbName)
+	assert.Nil(t, err)
+	assert.Equal(t, dbID, db.ID)
+	_, err = mt.GetDatabaseByName("not-exist")
+	assert.EqualError(t, err, "database not-exist not found")
+
+	// the same collection name lives in both databases
+	for i, id := range []typeutil.UniqueID{DefaultDatabaseID, dbID} {
+		coll := &pb.CollectionInfo{
+			ID:     typeutil.UniqueID(i + 1),
+			DbId:   id,
+			Schema: &schemapb.CollectionSchema{Name: collName},
+		}
+		err = mt.AddCollection(coll, ftso(), nil, "")
+		assert.Nil(t, err)
+	}
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 3, DbId: dbID + 1, Schema: &schemapb.CollectionSchema{Name: collName}}, ftso(), nil, "")
+	assert.NotNil(t, err)
+
+	coll, err := mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), coll.ID)
+	coll, err = mt.GetCollectionByName(dbName, collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), coll.ID)
+	coll, err = mt.GetCollectionByName(dbName, collName, vtso)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), coll.ID)
+	_, err = mt.GetCollectionByName("not-exist", collName, 0)
+	assert.NotNil(t, err)
+
+	colls, err := mt.ListCollections(dbName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(colls))
+	assert.Equal(t, typeutil.UniqueID(2), colls[collName].ID)
+
+	err = mt.AddAlias(dbName, alias, collName, ftso())
+	assert.Nil(t, err)
+	assert.True(t, mt.IsAlias(dbName, alias))
+	assert.False(t, mt.IsAlias("", alias))
+	assert.Equal(t, []string{alias}, mt.ListAliases(2))
+	assert.Equal(t, 0, len(mt.ListAliases(1)))
+
+	// reload keeps the database scope
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(mt.ListDatabases()))
+	coll, err = mt.GetCollectionByName(dbName, alias, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(2), coll.ID)
+
+	err = mt.DropDatabase(dbName, ftso())
+	assert.EqualError(t, err, fmt.Sprintf("database %s still has 1 collections", dbName))
+	err = mt.DropDatabase(Params.CommonCfg.DefaultDatabaseName, ftso())
+	assert.NotNil(t, err)
+
+	err = mt.DeleteCollection(2, ftso(), "")
+	assert.Nil(t, err)
+	assert.False(t, mt.IsAlias(dbName, alias))
+	err = mt.DropDatabase(dbName, ftso())
+	assert.Nil(t, err)
+	err = mt.DropDatabase(dbName, ftso())
+	assert.EqualError(t, err, fmt.Sprintf("database %s not found", dbName))
+
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(mt.ListDatabases()))
+}
+
+func TestMetaTable_RenameCollection(t *testing.T) {
+	const (
+		collName    = "testColl"
+		newCollName = "testCollRenamed"
+		otherColl   = "otherColl"
+		alias       = "testAlias"
+	)
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 1, Schema: &schemapb.CollectionSchema{Name: collName}}, ftso(), nil, "")
+	assert.Nil(t, err)
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 2, Schema: &schemapb.CollectionSchema{Name: otherColl}}, ftso(), nil, "")
+	assert.Nil(t, err)
+	err = mt.AddAlias("", alias, otherColl, ftso())
+	assert.Nil(t, err)
+	beforeRename := vtso
+
+	err = mt.RenameCollection("", "not-exist", newCollName, ftso())
+	assert.NotNil(t, err)
+	err = mt.RenameCollection("", collName, otherColl, ftso())
+	assert.EqualError(t, err, fmt.Sprintf("duplicate collection name, name = %s", otherColl))
+	err = mt.RenameCollection("", collName, alias, ftso())
+	assert.EqualError(t, err, fmt.Sprintf("collection name collides with existing collection alias, name = %s", alias))
+	err = mt.RenameCollection("not-exist", collName, newCollName, ftso())
+	assert.NotNil(t, err)
+
+	err = mt.RenameCollection("", collName, newCollName, ftso())
+	assert.Nil(t, err)
+
+	_, err = mt.GetCollectionByName("", collName, 0)
+	assert.NotNil(t, err)
+	coll, err := mt.GetCollectionByName("", newCollName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), coll.ID)
+	assert.Equal(t, newCollName, coll.Schema.Name)
+
+	// old name is still visible at a timestamp before the rename
+	coll, err = mt.GetCollectionByName("", collName, beforeRename)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), coll.ID)
+
+	// reload from kv keeps the new name
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	coll, err = mt.GetCollectionByName("", newCollName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, typeutil.UniqueID(1), coll.ID)
+	_, err = mt.GetCollectionByName("", collName, 0)
+	assert.NotNil(t, err)
+}
+
+func TestMetaTable_AlterCollection(t *testing.T) {
+	const collName = "testColl"
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	coll := &pb.CollectionInfo{
+		ID:     1,
+		Schema: &schemapb.CollectionSchema{Name: collName},
+		Properties: []*commonpb.KeyValuePair{
+			{Key: common.CollectionTTLConfigKey, Value: "3600"},
+			{Key: "k1", Value: "v1"},
+		},
+	}
+	err = mt.AddCollection(coll, ftso(), nil, "")
+	assert.Nil(t, err)
+	beforeAlter := vtso
+
+	err = mt.AlterCollection("", "not-exist", nil, ftso())
+	assert.NotNil(t, err)
+	err = mt.AlterCollection("not-exist", collName, nil, ftso())
+	assert.NotNil(t, err)
+
+	properties := []*commonpb.KeyValuePair{
+		{Key: common.CollectionTTLConfigKey, Value: "60"},
+		{Key: "k1", Value: ""},
+		{Key: "k2", Value: "v2"},
+	}
+	err = mt.AlterCollection("", collName, properties, ftso())
+	assert.Nil(t, err)
+
+	expected := []*commonpb.KeyValuePair{
+		{Key: common.CollectionTTLConfigKey, Value: "60"},
+		{Key: "k2", Value: "v2"},
+	}
+	collMeta, err := mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, collMeta.Properties)
+
+	// the properties before altering are still visible at an earlier timestamp
+	collMeta, err = mt.GetCollectionByName("", collName, beforeAlter)
+	assert.Nil(t, err)
+	assert.Equal(t, coll.Properties, collMeta.Properties)
+
+	// reload from kv keeps the altered properties
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, expected, collMeta.Properties)
+}
+
+func TestMetaTable_AddField(t *testing.T) {
+	const collName = "testColl"
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	coll := &pb.CollectionInfo{
+		ID: 1,
+		Schema: &schemapb.CollectionSchema{
+			Name: collName,
+			Fields: []*schemapb.FieldSchema{
+				{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+				{FieldID: RowIDField, Name: RowIDFieldName, DataType: schemapb.DataType_Int64},
+				{FieldID: TimeStampField, Name: TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			},
+		},
+	}
+	err = mt.AddCollection(coll, ftso(), nil, "")
+	assert.Nil(t, err)
+	beforeAdd := vtso
+
+	field := &schemapb.FieldSchema{
+		Name:         "age",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 18}},
+	}
+	err = mt.AddField("", "not-exist", field, ftso())
+	assert.NotNil(t, err)
+	err = mt.AddField("not-exist", collName, field, ftso())
+	assert.NotNil(t, err)
+	err = mt.AddField("", collName, &schemapb.FieldSchema{Name: "pk", DataType: schemapb.DataType_Int64}, ftso())
+	assert.EqualError(t, err, "duplicate field name, name = pk")
+
+	err = mt.AddField("", collName, field, ftso())
+	assert.Nil(t, err)
+
+	collMeta, err := mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), collMeta.Schema.Version)
+	assert.Equal(t, 4, len(collMeta.Schema.Fields))
+	added := collMeta.Schema.Fields[3]
+	assert.Equal(t, int64(101), added.FieldID)
+	assert.Equal(t, "age", added.Name)
+	assert.Equal(t, int32(18), added.DefaultValue.GetIntData())
+	// the request field is not modified
+	assert.Equal(t, int64(0), field.FieldID)
+
+	// the field is not visible at a timestamp before it is added
+	collMeta, err = mt.GetCollectionByName("", collName, beforeAdd)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), collMeta.Schema.Version)
+	assert.Equal(t, 3, len(collMeta.Schema.Fields))
+
+	// reload from kv keeps the added field
+	mt, err = NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+	collMeta, err = mt.GetCollectionByName("", collName, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, int32(1), collMeta.Schema.Version)
+	assert.Equal(t, 4, len(collMeta.Schema.Fields))
+}
+
+func TestMetaTable_Credential(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	usernames, err := mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(usernames))
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "", EncryptedPassword: "pwd"})
+	assert.NotNil(t, err)
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user2", EncryptedPassword: "pwd2"})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.Nil(t, err)
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.EqualError(t, err, "user user1 already exists")
+
+	credInfo, err := mt.GetCredential("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "user1", credInfo.Username)
+	assert.Equal(t, "pwd1", credInfo.EncryptedPassword)
+	_, err = mt.GetCredential("user3")
+	assert.EqualError(t, err, "user user3 not found")
+
+	usernames, err = mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user1", "user2"}, usernames)
+
+	err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "newPwd1"})
+	assert.Nil(t, err)
+	credInfo, err = mt.GetCredential("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, "newPwd1", credInfo.EncryptedPassword)
+	err = mt.AlterCredential(&internalpb.CredentialInfo{Username: "user3", EncryptedPassword: "pwd3"})
+	assert.EqualError(t, err, "user user3 not found")
+
+	err = mt.DeleteCredential("user1")
+	assert.Nil(t, err)
+	err = mt.DeleteCredential("user1")
+	assert.EqualError(t, err, "user user1 not found")
+	_, err = mt.GetCredential("user1")
+	assert.NotNil(t, err)
+
+	usernames, err = mt.ListCredentialUsernames()
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"user2"}, usernames)
+}
+
+func TestMetaTable_Role(t *testing.T) {
+	rand.Seed(time.Now().UnixNano())
+	randVal := rand.Int()
+	Params.Init()
+	rootPath := fmt.Sprintf("/test/meta/%d", randVal)
+
+	etcdCli, err := etcd.GetEtcdClient(&Params.BaseParams)
+	require.Nil(t, err)
+	defer etcdCli.Close()
+
+	skv, err := newMetaSnapshot(etcdCli, rootPath, TimestampPrefix, 7)
+	assert.Nil(t, err)
+	txnKV := etcdkv.NewEtcdKV(etcdCli, rootPath)
+	mt, err := NewMetaTable(txnKV, skv)
+	assert.Nil(t, err)
+
+	var vtso typeutil.Timestamp
+	ftso := func() typeutil.Timestamp {
+		vtso++
+		return vtso
+	}
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 1, Schema: &schemapb.CollectionSchema{Name: "collection1"}}, ftso(), nil, "")
+	assert.Nil(t, err)
+
+	err = mt.AddCredential(&internalpb.CredentialInfo{Username: "user1", EncryptedPassword: "pwd1"})
+	assert.Nil(t, err)
+
+	err = mt.CreateRole("")
+	assert.NotNil(t, err)
+	err = mt.CreateRole("role1")
+	assert.Nil(t, err)
+	err = mt.CreateRole("role1")
+	assert.EqualError(t, err, "role role1 already exists")
+	err = mt.CreateRole("role2")
+	assert.Nil(t, err)
+
+	err = mt.OperateUserRole("user2", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+	assert.EqualError(t, err, "user user2 not found")
+	err = mt.OperateUserRole("user1", "role3", milvuspb.OperateUserRoleType_AddUserToRole)
+	assert.EqualError(t, err, "role role3 not found")
+	err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_RemoveUserFromRole)
+	assert.EqualError(t, err, "user user1 is not bound to role role2")
+	err = mt.OperateUserRole("user1", "role1", milvuspb.OperateUserRoleType_AddUserToRole)
+	assert.Nil(t, err)
+	err = mt.OperateUserRole("user1", "role2", milvuspb.OperateUserRoleType_AddUserToRole)
+	assert.Nil(t, err)
+
+	roleNames, err := mt.ListUserRoles("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"role1", "role2"}, roleNames)
+
+	entity1 := &milvuspb.GrantEntity{
+		RoleName:   "role1",
+		ObjectType: util.ObjectTypeCollection,
+		ObjectName: "collection1",
+		DbName:     Params.CommonCfg.DefaultDatabaseName,
+		Privilege:  "Search",
+	}
+	entity2 := &milvuspb.GrantEntity{
+		RoleName:   "role2",
+		ObjectType: util.ObjectTypeGlobal,
+		ObjectName: util.AnyWord,
+		Privilege:  util.PrivilegeAll,
+	}
+	err = mt.GrantPrivilege(nil)
+	assert.NotNil(t, err)
+	err = mt.GrantPrivilege(&milvuspb.GrantEntity{RoleName: "role1", ObjectType: "Partition", ObjectName: "p", Privilege: "Search"})
+	assert.NotNil(t, err)
+	err = mt.GrantPrivilege(&milvuspb.GrantEntity{RoleName: "role3", ObjectType: util.ObjectTypeGlobal, ObjectName: util.AnyWord, Privilege: util.PrivilegeAll})
+	assert.EqualError(t, err, "role role3 not found")
+	err = mt.GrantPrivilege(&milvuspb.GrantEntity{RoleName: "role1", ObjectType: util.ObjectTypeCollection, ObjectName: "not-exist", Privilege: "Search"})
+	assert.NotNil(t, err)
+	err = mt.GrantPrivilege(&milvuspb.GrantEntity{RoleName: "role1", ObjectType: util.ObjectTypeCollection, ObjectName: "collection1", DbName: "not-exist", Privilege: "Search"})
+	assert.NotNil(t, err)
+	err = mt.GrantPrivilege(entity1)
+	assert.Nil(t, err)
+	err = mt.GrantPrivilege(entity2)
+	assert.Nil(t, err)
+
+	grants, err := mt.ListGrants("role1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(grants))
+	assert.True(t, proto.Equal(entity1, grants[0]))
+	_, err = mt.ListGrants("role3")
+	assert.NotNil(t, err)
+
+	grants, err = mt.ListUserGrants("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(grants))
+
+	// the grant follows the renamed collection
+	err = mt.RenameCollection("", "collection1", "collection2", ftso())
+	assert.Nil(t, err)
+	grants, err = mt.ListGrants("role1")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(grants))
+	assert.Equal(t, "collection2", grants[0].ObjectName)
+	err = mt.RenameCollection("", "collection2", "collection1", ftso())
+	assert.Nil(t, err)
+
+	err = mt.RevokePrivilege(entity1)
+	assert.Nil(t, err)
+	err = mt.RevokePrivilege(entity1)
+	assert.NotNil(t, err)
+	grants, err = mt.ListGrants("role1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(grants))
+
+	// the grant is removed with the dropped collection, and isn't inherited by a new collection of the same name
+	err = mt.GrantPrivilege(entity1)
+	assert.Nil(t, err)
+	err = mt.DeleteCollection(1, ftso(), "")
+	assert.Nil(t, err)
+	err = mt.AddCollection(&pb.CollectionInfo{ID: 2, Schema: &schemapb.CollectionSchema{Name: "collection1"}}, ftso(), nil, "")
+	assert.Nil(t, err)
+	grants, err = mt.ListGrants("role1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(grants))
+
+	err = mt.DropRole("role2")
+	assert.Nil(t, err)
+	err = mt.DropRole("role2")
+	assert.EqualError(t, err, "role role2 not found")
+	roleNames, err = mt.ListUserRoles("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"role1"}, roleNames)
+	grants, err = mt.ListUserGrants("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(grants))
+
+	err = mt.DeleteCredential("user1")
+	assert.Nil(t, err)
+	roleNames, err = mt.ListUserRoles("user1")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(roleNames))
+}
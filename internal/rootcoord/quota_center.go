@@ -0,0 +1,145 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/proxypb"
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+// waterLevelFactor decreases linearly from 1 to 0 as the value goes from the low water level to the high water level
+func waterLevelFactor(value, low, high float64) float64 {
+	if value <= low {
+		return 1
+	}
+	if value >= high {
+		return 0
+	}
+	return (high - value) / (high - low)
+}
+
+// dmlQuota is the backpressure factor of DML and the reason why the DML requests are slowed down or rejected
+type dmlQuota struct {
+	factor float64
+	reason string
+}
+
+// apply takes the lower factor of the quota and the one calculated by a metric
+func (q *dmlQuota) apply(factor float64, reason string) {
+	if factor < q.factor {
+		q.factor = factor
+		q.reason = reason
+	}
+}
+
+// applyTimeTickDelay slows down DML once the delay exceeds half of the max delay, and rejects DML at the max delay
+func (q *dmlQuota) applyTimeTickDelay(name string, delay time.Duration) {
+	maxDelay := Params.QuotaCfg.MaxTimeTickDelay
+	q.apply(waterLevelFactor(float64(delay), float64(maxDelay/2), float64(maxDelay)),
+		fmt.Sprintf("the time tick delay of %s is %s, the max delay is %s", name, delay, maxDelay))
+}
+
+// calculateDMLQuota calculates the backpressure factor of DML by the quota metrics of DataCoord and QueryNodes,
+// the lowest factor among the metrics is taken
+func calculateDMLQuota(dataTopology *metricsinfo.DataCoordTopology, queryTopology *metricsinfo.QueryCoordTopology) dmlQuota {
+	quota := dmlQuota{factor: 1}
+
+	dataMetrics := dataTopology.Cluster.Self.QuotaMetrics
+	quota.applyTimeTickDelay("DataNodes", dataMetrics.MaxTimeTickDelay)
+	quota.apply(waterLevelFactor(float64(dataMetrics.UnflushedBytes),
+		float64(Params.QuotaCfg.UnflushedBytesLowWaterLevel), float64(Params.QuotaCfg.UnflushedBytesHighWaterLevel)),
+		fmt.Sprintf("the size of unflushed data is %d MB, the high water level is %d MB",
+			dataMetrics.UnflushedBytes/1024/1024, Params.QuotaCfg.UnflushedBytesHighWaterLevel/1024/1024))
+
+	for _, node := range queryTopology.Cluster.ConnectedNodes {
+		if node.HasError {
+			continue
+		}
+		nodeMetrics := node.QuotaMetrics
+		quota.applyTimeTickDelay(node.Name, nodeMetrics.MaxTimeTickDelay)
+		if node.HardwareInfos.Memory == 0 {
+			continue
+		}
+		ratio := float64(nodeMetrics.GrowingSegmentsSize) / float64(node.HardwareInfos.Memory)
+		quota.apply(waterLevelFactor(ratio, Params.QuotaCfg.GrowingSegmentsMemLowWaterLevel, Params.QuotaCfg.GrowingSegmentsMemHighWaterLevel),
+			fmt.Sprintf("the growing segments take %.2f of the memory of %s, the high water level is %.2f",
+				ratio, node.Name, Params.QuotaCfg.GrowingSegmentsMemHighWaterLevel))
+	}
+	if quota.factor == 1 {
+		quota.reason = ""
+	}
+	return quota
+}
+
+// quotaCenterLoop collects the quota metrics periodically and applies the backpressure of DML on proxies
+func (c *Core) quotaCenterLoop() {
+	defer c.wg.Done()
+	ticker := time.NewTicker(Params.QuotaCfg.CollectInterval)
+	defer ticker.Stop()
+	lastQuota := dmlQuota{factor: 1}
+	for {
+		select {
+		case <-c.ctx.Done():
+			log.Debug("RootCoord context done, exit quota center loop")
+			return
+		case <-ticker.C:
+			quota, err := c.collectDMLQuota(c.ctx)
+			if err != nil {
+				log.Warn("failed to collect quota metrics", zap.Error(err))
+				continue
+			}
+			if quota.factor != lastQuota.factor {
+				log.Info("DML quota changed", zap.Float64("factor", quota.factor), zap.String("reason", quota.reason))
+			}
+			lastQuota = quota
+			// the quota is sent every time so that the new proxies catch up with it
+			ctx, cancel := context.WithTimeout(c.ctx, Params.QuotaCfg.CollectInterval)
+			c.proxyClientManager.SetDMLQuota(ctx, &proxypb.SetDMLQuotaRequest{
+				Base: &commonpb.MsgBase{
+					MsgType:  0, //TODO, msg type
+					SourceID: c.session.ServerID,
+				},
+				Factor: quota.factor,
+				Reason: quota.reason,
+			})
+			cancel()
+		}
+	}
+}
+
+// collectDMLQuota collects the quota metrics from DataCoord and QueryCoord, and calculates the DML quota
+func (c *Core) collectDMLQuota(ctx context.Context) (dmlQuota, error) {
+	ctx, cancel := context.WithTimeout(ctx, Params.QuotaCfg.CollectInterval)
+	defer cancel()
+	dataTopology, err := c.CallGetDataCoordMetricsService(ctx)
+	if err != nil {
+		return dmlQuota{}, err
+	}
+	queryTopology, err := c.CallGetQueryCoordMetricsService(ctx)
+	if err != nil {
+		return dmlQuota{}, err
+	}
+	return calculateDMLQuota(dataTopology, queryTopology), nil
+}
@@ -0,0 +1,123 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package rootcoord
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/milvus-io/milvus/internal/util/metricsinfo"
+)
+
+func TestWaterLevelFactor(t *testing.T) {
+	assert.Equal(t, float64(1), waterLevelFactor(0, 10, 20))
+	assert.Equal(t, float64(1), waterLevelFactor(10, 10, 20))
+	assert.Equal(t, 0.5, waterLevelFactor(15, 10, 20))
+	assert.Equal(t, float64(0), waterLevelFactor(20, 10, 20))
+	assert.Equal(t, float64(0), waterLevelFactor(30, 10, 20))
+}
+
+func newQuotaTestTopology(ttDelay time.Duration, unflushedBytes int64, growingSize int64, memory uint64) (*metricsinfo.DataCoordTopology, *metricsinfo.QueryCoordTopology) {
+	dataTopology := &metricsinfo.DataCoordTopology{}
+	dataTopology.Cluster.Self.QuotaMetrics = metricsinfo.DataCoordQuotaMetrics{
+		MaxTimeTickDelay: ttDelay,
+		UnflushedBytes:   unflushedBytes,
+	}
+	queryNode := metricsinfo.QueryNodeInfos{}
+	queryNode.Name = "querynode1"
+	queryNode.HardwareInfos.Memory = memory
+	queryNode.QuotaMetrics = metricsinfo.QueryNodeQuotaMetrics{
+		GrowingSegmentsSize: growingSize,
+	}
+	// the metrics of the node which has error are ignored
+	errNode := metricsinfo.QueryNodeInfos{}
+	errNode.HasError = true
+	errNode.QuotaMetrics.MaxTimeTickDelay = time.Hour * 24
+	queryTopology := &metricsinfo.QueryCoordTopology{}
+	queryTopology.Cluster.ConnectedNodes = []metricsinfo.QueryNodeInfos{queryNode, errNode}
+	return dataTopology, queryTopology
+}
+
+func TestCalculateDMLQuota(t *testing.T) {
+	Params.Init()
+	saved := Params.QuotaCfg
+	defer func() {
+		Params.QuotaCfg = saved
+	}()
+	Params.QuotaCfg.MaxTimeTickDelay = 100 * time.Second
+	Params.QuotaCfg.UnflushedBytesLowWaterLevel = 100
+	Params.QuotaCfg.UnflushedBytesHighWaterLevel = 200
+	Params.QuotaCfg.GrowingSegmentsMemLowWaterLevel = 0.2
+	Params.QuotaCfg.GrowingSegmentsMemHighWaterLevel = 0.4
+
+	quota := calculateDMLQuota(newQuotaTestTopology(time.Second, 10, 10, 100))
+	assert.Equal(t, float64(1), quota.factor)
+	assert.Equal(t, "", quota.reason)
+
+	// time tick delay
+	quota = calculateDMLQuota(newQuotaTestTopology(75*time.Second, 10, 10, 100))
+	assert.Equal(t, 0.5, quota.factor)
+	assert.Contains(t, quota.reason, "time tick delay")
+
+	// unflushed bytes
+	quota = calculateDMLQuota(newQuotaTestTopology(time.Second, 175, 10, 100))
+	assert.Equal(t, 0.25, quota.factor)
+	assert.Contains(t, quota.reason, "unflushed")
+
+	// growing segments memory
+	quota = calculateDMLQuota(newQuotaTestTopology(time.Second, 10, 40, 100))
+	assert.Equal(t, float64(0), quota.factor)
+	assert.Contains(t, quota.reason, "querynode1")
+
+	// the lowest factor is taken
+	quota = calculateDMLQuota(newQuotaTestTopology(75*time.Second, 175, 10, 100))
+	assert.Equal(t, 0.25, quota.factor)
+}
+
+func TestCore_CollectDMLQuota(t *testing.T) {
+	Params.Init()
+	ctx := context.Background()
+	core, err := NewCore(ctx, nil)
+	assert.Nil(t, err)
+
+	dataTopology, queryTopology := newQuotaTestTopology(time.Second, 0, 0, 100)
+	core.CallGetDataCoordMetricsService = func(ctx context.Context) (*metricsinfo.DataCoordTopology, error) {
+		return nil, errors.New("mock error")
+	}
+	core.CallGetQueryCoordMetricsService = func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error) {
+		return queryTopology, nil
+	}
+	_, err = core.collectDMLQuota(ctx)
+	assert.NotNil(t, err)
+
+	core.CallGetDataCoordMetricsService = func(ctx context.Context) (*metricsinfo.DataCoordTopology, error) {
+		return dataTopology, nil
+	}
+	quota, err := core.collectDMLQuota(ctx)
+	assert.Nil(t, err)
+	assert.Equal(t, float64(1), quota.factor)
+
+	core.CallGetQueryCoordMetricsService = func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error) {
+		return nil, errors.New("mock error")
+	}
+	_, err = core.collectDMLQuota(ctx)
+	assert.NotNil(t, err)
+}
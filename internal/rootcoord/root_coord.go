@@ -47,6 +47,8 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/tso"
 	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/paramtable"
 	"github.com/milvus-io/milvus/internal/util/retry"
@@ -136,6 +138,13 @@ type Core struct {
 
 	CallWatchChannels func(ctx context.Context, collectionID int64, channelNames []string) error
 
+	//notify data service of the properties of an altered collection
+	CallBroadcastAlteredCollection func(ctx context.Context, collectionID int64, properties []*commonpb.KeyValuePair) error
+
+	//get the system info metrics of data coord and query coord, which are used to apply the backpressure of DML
+	CallGetDataCoordMetricsService  func(ctx context.Context) (*metricsinfo.DataCoordTopology, error)
+	CallGetQueryCoordMetricsService func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error)
+
 	//Proxy manager
 	proxyManager *proxyManager
 
@@ -257,6 +266,9 @@ func (c *Core) checkInit() error {
 	if c.CallWatchChannels == nil {
 		return fmt.Errorf("callWatchChannels is nil")
 	}
+	if c.CallBroadcastAlteredCollection == nil {
+		return fmt.Errorf("callBroadcastAlteredCollection is nil")
+	}
 	if c.NewProxyClient == nil {
 		return fmt.Errorf("newProxyClient is nil")
 	}
@@ -266,6 +278,12 @@ func (c *Core) checkInit() error {
 	if c.CallReleasePartitionService == nil {
 		return fmt.Errorf("callReleasePartitionService is nil")
 	}
+	if c.CallGetDataCoordMetricsService == nil {
+		return fmt.Errorf("callGetDataCoordMetricsService is nil")
+	}
+	if c.CallGetQueryCoordMetricsService == nil {
+		return fmt.Errorf("callGetQueryCoordMetricsService is nil")
+	}
 
 	return nil
 }
@@ -722,6 +740,56 @@ func (c *Core) SetDataCoord(ctx context.Context, s types.DataCoord) error {
 		}
 		return nil
 	}
+
+	c.CallBroadcastAlteredCollection = func(ctx context.Context, collectionID int64, properties []*commonpb.KeyValuePair) (retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("broadcast altered collection panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req := &datapb.AlterCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType:  commonpb.MsgType_AlterCollection,
+				SourceID: c.session.ServerID,
+			},
+			CollectionID: collectionID,
+			Properties:   properties,
+		}
+		rsp, err := s.BroadcastAlteredCollection(ctx, req)
+		if err != nil {
+			return err
+		}
+		if rsp.ErrorCode != commonpb.ErrorCode_Success {
+			return fmt.Errorf("data coord broadcast altered collection failed, reason = %s", rsp.Reason)
+		}
+		return nil
+	}
+
+	c.CallGetDataCoordMetricsService = func(ctx context.Context) (retTopology *metricsinfo.DataCoordTopology, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get data coord metrics panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := s.GetMetrics(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("get metrics from data coord failed, reason = %s", rsp.Status.Reason)
+		}
+		topology := &metricsinfo.DataCoordTopology{}
+		if err := metricsinfo.UnmarshalTopology(rsp.Response, topology); err != nil {
+			return nil, err
+		}
+		return topology, nil
+	}
 	return nil
 }
 
@@ -856,6 +924,31 @@ func (c *Core) SetQueryCoord(s types.QueryCoord) error {
 		}
 		return nil
 	}
+
+	c.CallGetQueryCoordMetricsService = func(ctx context.Context) (retTopology *metricsinfo.QueryCoordTopology, retErr error) {
+		defer func() {
+			if err := recover(); err != nil {
+				retErr = fmt.Errorf("get query coord metrics panic, msg = %v", err)
+			}
+		}()
+		<-initCh
+		req, err := metricsinfo.ConstructRequestByMetricType(metricsinfo.SystemInfoMetrics)
+		if err != nil {
+			return nil, err
+		}
+		rsp, err := s.GetMetrics(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if rsp.Status.ErrorCode != commonpb.ErrorCode_Success {
+			return nil, fmt.Errorf("get metrics from query coord failed, reason = %s", rsp.Status.Reason)
+		}
+		topology := &metricsinfo.QueryCoordTopology{}
+		if err := metricsinfo.UnmarshalTopology(rsp.Response, topology); err != nil {
+			return nil, err
+		}
+		return topology, nil
+	}
 	return nil
 }
 
@@ -892,8 +985,8 @@ func (c *Core) BuildIndex(ctx context.Context, segID typeutil.UniqueID, field *s
 }
 
 // RemoveIndex will call drop index service
-func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName string) error {
-	_, indexInfos, err := c.MetaTable.GetIndexByName(collName, indexName)
+func (c *Core) RemoveIndex(ctx context.Context, dbName string, collName string, indexName string) error {
+	_, indexInfos, err := c.MetaTable.GetIndexByName(dbName, collName, indexName)
 	if err != nil {
 		log.Error("GetIndexByName failed,", zap.String("collection name", collName),
 			zap.String("index name", indexName), zap.Error(err))
@@ -909,7 +1002,7 @@ func (c *Core) RemoveIndex(ctx context.Context, collName string, indexName strin
 }
 
 // ExpireMetaCache will call invalidate collection meta cache
-func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeutil.Timestamp) {
+func (c *Core) ExpireMetaCache(ctx context.Context, dbName string, collNames []string, ts typeutil.Timestamp) {
 	for _, collName := range collNames {
 		req := proxypb.InvalidateCollMetaCacheRequest{
 			Base: &commonpb.MsgBase{
@@ -918,6 +1011,7 @@ func (c *Core) ExpireMetaCache(ctx context.Context, collNames []string, ts typeu
 				Timestamp: ts,
 				SourceID:  c.session.ServerID,
 			},
+			DbName:         dbName,
 			CollectionName: collName,
 		}
 		c.proxyClientManager.InvalidateCollectionMetaCache(ctx, &req)
@@ -1052,6 +1146,8 @@ func (c *Core) Init() error {
 		if initError != nil {
 			return
 		}
+
+		initError = c.initData()
 	})
 	if initError != nil {
 		log.Debug("RootCoord init error", zap.Error(initError))
@@ -1060,6 +1156,20 @@ func (c *Core) Init() error {
 	return initError
 }
 
+// initData creates the default root user if it doesn't exist
+func (c *Core) initData() error {
+	if _, err := c.MetaTable.GetCredential(util.UserRoot); err == nil {
+		return nil
+	}
+	encryptedRootPassword, err := crypto.PasswordEncrypt(util.DefaultRootPassword)
+	if err != nil {
+		log.Error("RootCoord failed to encrypt default root password", zap.Error(err))
+		return err
+	}
+	log.Debug("RootCoord create default root user", zap.String("username", util.UserRoot))
+	return c.MetaTable.AddCredential(&internalpb.CredentialInfo{Username: util.UserRoot, EncryptedPassword: encryptedRootPassword})
+}
+
 func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	if !force {
 		flag, err := c.MetaTable.txn.Load(DDMsgSendPrefix)
@@ -1081,6 +1191,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 
 	invalidateCache := false
 	var ts typeutil.Timestamp
+	var dbName string
 	var collName string
 
 	switch ddOp.Type {
@@ -1091,7 +1202,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 		if err = proto.Unmarshal(ddOp.Body, &ddReq); err != nil {
 			return err
 		}
-		if _, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err != nil {
+		if _, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err != nil {
 			if _, err = c.SendDdCreateCollectionReq(ctx, &ddReq, ddReq.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1105,8 +1216,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0); err == nil {
+		if collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0); err == nil {
 			if err = c.SendDdDropCollectionReq(ctx, &ddReq, collInfo.PhysicalChannelNames); err != nil {
 				return err
 			}
@@ -1121,8 +1233,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1141,8 +1254,9 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 			return err
 		}
 		ts = ddReq.Base.Timestamp
+		dbName = ddReq.DbName
 		collName = ddReq.CollectionName
-		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.CollectionName, 0)
+		collInfo, err := c.MetaTable.GetCollectionByName(ddReq.DbName, ddReq.CollectionName, 0)
 		if err != nil {
 			return err
 		}
@@ -1160,7 +1274,7 @@ func (c *Core) reSendDdMsg(ctx context.Context, force bool) error {
 	}
 
 	if invalidateCache {
-		c.ExpireMetaCache(ctx, []string{collName}, ts)
+		c.ExpireMetaCache(ctx, dbName, []string{collName}, ts)
 	}
 
 	// Update DDOperation in etcd
@@ -1192,6 +1306,10 @@ func (c *Core) Start() error {
 		go c.tsLoop()
 		go c.chanTimeTick.startWatch(&c.wg)
 		go c.checkFlushedSegmentsLoop()
+		if Params.QuotaCfg.Enabled {
+			c.wg.Add(1)
+			go c.quotaCenterLoop()
+		}
 		Params.RootCoordCfg.CreatedTime = time.Now()
 		Params.RootCoordCfg.UpdatedTime = time.Now()
 
@@ -1319,6 +1437,8 @@ func (c *Core) DropCollection(ctx context.Context, in *milvuspb.DropCollectionRe
 			zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
 		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropCollection failed: "+err.Error()), nil
 	}
+	// the grants cached by proxies refer to the collection by name
+	c.ExpirePolicyInfoCache(ctx)
 	log.Debug("DropCollection success", zap.String("role", typeutil.RootCoordRole),
 		zap.String("collection name", in.CollectionName), zap.Int64("msgID", in.Base.MsgID))
 
@@ -2053,3 +2173,427 @@ func (c *Core) AlterAlias(ctx context.Context, in *milvuspb.AlterAliasRequest) (
 
 	return succStatus(), nil
 }
+
+// RenameCollection rename collection
+func (c *Core) RenameCollection(ctx context.Context, in *milvuspb.RenameCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("RenameCollection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &RenameCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("RenameCollection failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RenameCollection failed: "+err.Error()), nil
+	}
+	// the grants cached by proxies refer to the collection by name
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("RenameCollection success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("old name", in.OldName), zap.String("new name", in.NewName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// AlterCollection alter collection properties
+func (c *Core) AlterCollection(ctx context.Context, in *milvuspb.AlterCollectionRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("AlterCollection", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.Any("properties", in.Properties),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &AlterCollectionReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("AlterCollection failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AlterCollection failed: "+err.Error()), nil
+	}
+	log.Debug("AlterCollection success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// AddField add a field to collection
+func (c *Core) AddField(ctx context.Context, in *milvuspb.AddFieldRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("AddField", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName), zap.Any("field", in.Field),
+		zap.Int64("msgID", in.Base.MsgID))
+	t := &AddFieldReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("AddField failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("collection name", in.CollectionName),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "AddField failed: "+err.Error()), nil
+	}
+	log.Debug("AddField success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("collection name", in.CollectionName),
+		zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// CreateDatabase create database
+func (c *Core) CreateDatabase(ctx context.Context, in *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &CreateDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("CreateDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateDatabase failed: "+err.Error()), nil
+	}
+	log.Debug("CreateDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// DropDatabase drop database
+func (c *Core) DropDatabase(ctx context.Context, in *milvuspb.DropDatabaseRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DropDatabase", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+	t := &DropDatabaseReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("DropDatabase failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropDatabase failed: "+err.Error()), nil
+	}
+	// the grants cached by proxies refer to the database by name
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("DropDatabase success", zap.String("role", typeutil.RootCoordRole),
+		zap.String("dbname", in.DbName), zap.Int64("msgID", in.Base.MsgID))
+
+	return succStatus(), nil
+}
+
+// ListDatabases list all databases
+func (c *Core) ListDatabases(ctx context.Context, in *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	log.Debug("ListDatabases", zap.String("role", typeutil.RootCoordRole), zap.Int64("msgID", in.Base.MsgID))
+	t := &ListDatabasesReqTask{
+		baseReqTask: baseReqTask{
+			ctx:  ctx,
+			core: c,
+		},
+		Req: in,
+		Rsp: &milvuspb.ListDatabasesResponse{},
+	}
+	err := executeTask(t)
+	if err != nil {
+		log.Error("ListDatabases failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Int64("msgID", in.Base.MsgID), zap.Error(err))
+		return &milvuspb.ListDatabasesResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListDatabases failed: "+err.Error()),
+		}, nil
+	}
+	log.Debug("ListDatabases success", zap.String("role", typeutil.RootCoordRole),
+		zap.Int("num of databases", len(t.Rsp.DbNames)), zap.Int64("msgID", in.Base.MsgID))
+
+	t.Rsp.Status = succStatus()
+	return t.Rsp, nil
+}
+
+// CreateCredential create a new user, the password has been encrypted by proxy
+func (c *Core) CreateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", credInfo.Username))
+	if err := c.MetaTable.AddCredential(credInfo); err != nil {
+		log.Error("CreateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", credInfo.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateCredential failed: "+err.Error()), nil
+	}
+	log.Debug("CreateCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", credInfo.Username))
+
+	return succStatus(), nil
+}
+
+// UpdateCredential update the password of an existing user, the password has been encrypted by proxy
+func (c *Core) UpdateCredential(ctx context.Context, credInfo *internalpb.CredentialInfo) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("UpdateCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", credInfo.Username))
+	if err := c.MetaTable.AlterCredential(credInfo); err != nil {
+		log.Error("UpdateCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", credInfo.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "UpdateCredential failed: "+err.Error()), nil
+	}
+	c.ExpireCredentialCache(ctx, credInfo.Username)
+	log.Debug("UpdateCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", credInfo.Username))
+
+	return succStatus(), nil
+}
+
+// DeleteCredential delete a user
+func (c *Core) DeleteCredential(ctx context.Context, in *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DeleteCredential", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+	if err := c.MetaTable.DeleteCredential(in.Username); err != nil {
+		log.Error("DeleteCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DeleteCredential failed: "+err.Error()), nil
+	}
+	c.ExpireCredentialCache(ctx, in.Username)
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("DeleteCredential success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username))
+
+	return succStatus(), nil
+}
+
+// ListUsers list all usernames
+func (c *Core) ListUsers(ctx context.Context, in *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListUsersResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	usernames, err := c.MetaTable.ListCredentialUsernames()
+	if err != nil {
+		log.Error("ListUsers failed", zap.String("role", typeutil.RootCoordRole), zap.Error(err))
+		return &milvuspb.ListUsersResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListUsers failed: "+err.Error()),
+		}, nil
+	}
+	log.Debug("ListUsers success", zap.String("role", typeutil.RootCoordRole), zap.Int("num of users", len(usernames)))
+
+	return &milvuspb.ListUsersResponse{
+		Status:    succStatus(),
+		Usernames: usernames,
+	}, nil
+}
+
+// GetCredential get the encrypted credential of a user, used by proxy to verify requests
+func (c *Core) GetCredential(ctx context.Context, in *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &rootcoordpb.GetCredentialResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	credInfo, err := c.MetaTable.GetCredential(in.Username)
+	if err != nil {
+		log.Debug("GetCredential failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.Error(err))
+		return &rootcoordpb.GetCredentialResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "GetCredential failed: "+err.Error()),
+		}, nil
+	}
+
+	return &rootcoordpb.GetCredentialResponse{
+		Status:   succStatus(),
+		Username: credInfo.Username,
+		Password: credInfo.EncryptedPassword,
+	}, nil
+}
+
+// ExpireCredentialCache will call invalidate credential cache
+func (c *Core) ExpireCredentialCache(ctx context.Context, username string) {
+	req := proxypb.InvalidateCredCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  0, //TODO, msg type
+			MsgID:    0, //TODO, msg id
+			SourceID: c.session.ServerID,
+		},
+		Username: username,
+	}
+	c.proxyClientManager.InvalidateCredentialCache(ctx, &req)
+}
+
+// CreateRole create a new role
+func (c *Core) CreateRole(ctx context.Context, in *milvuspb.CreateRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("CreateRole", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	if err := c.MetaTable.CreateRole(in.RoleName); err != nil {
+		log.Error("CreateRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "CreateRole failed: "+err.Error()), nil
+	}
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("CreateRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+
+	return succStatus(), nil
+}
+
+// DropRole drop a role, the privileges granted to the role are revoked as well
+func (c *Core) DropRole(ctx context.Context, in *milvuspb.DropRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("DropRole", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+	if err := c.MetaTable.DropRole(in.RoleName); err != nil {
+		log.Error("DropRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.RoleName), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "DropRole failed: "+err.Error()), nil
+	}
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("DropRole success", zap.String("role", typeutil.RootCoordRole), zap.String("role name", in.RoleName))
+
+	return succStatus(), nil
+}
+
+// OperateUserRole add the user to the role or remove the user from the role
+func (c *Core) OperateUserRole(ctx context.Context, in *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("OperateUserRole", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username), zap.String("role name", in.RoleName), zap.String("type", in.Type.String()))
+	if err := c.MetaTable.OperateUserRole(in.Username, in.RoleName, in.Type); err != nil {
+		log.Error("OperateUserRole failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("username", in.Username), zap.String("role name", in.RoleName), zap.String("type", in.Type.String()), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "OperateUserRole failed: "+err.Error()), nil
+	}
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("OperateUserRole success", zap.String("role", typeutil.RootCoordRole), zap.String("username", in.Username), zap.String("role name", in.RoleName), zap.String("type", in.Type.String()))
+
+	return succStatus(), nil
+}
+
+// GrantPrivilege grant the privilege on the object to the role
+func (c *Core) GrantPrivilege(ctx context.Context, in *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("GrantPrivilege", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity))
+	if err := c.MetaTable.GrantPrivilege(in.Entity); err != nil {
+		log.Error("GrantPrivilege failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Any("entity", in.Entity), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "GrantPrivilege failed: "+err.Error()), nil
+	}
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("GrantPrivilege success", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity))
+
+	return succStatus(), nil
+}
+
+// RevokePrivilege revoke the privilege on the object from the role
+func (c *Core) RevokePrivilege(ctx context.Context, in *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]), nil
+	}
+
+	log.Debug("RevokePrivilege", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity))
+	if err := c.MetaTable.RevokePrivilege(in.Entity); err != nil {
+		log.Error("RevokePrivilege failed", zap.String("role", typeutil.RootCoordRole),
+			zap.Any("entity", in.Entity), zap.Error(err))
+		return failStatus(commonpb.ErrorCode_UnexpectedError, "RevokePrivilege failed: "+err.Error()), nil
+	}
+	c.ExpirePolicyInfoCache(ctx)
+	log.Debug("RevokePrivilege success", zap.String("role", typeutil.RootCoordRole), zap.Any("entity", in.Entity))
+
+	return succStatus(), nil
+}
+
+// ListGrants list the privileges granted to the role, or to all the roles bound to the user if role name is empty
+func (c *Core) ListGrants(ctx context.Context, in *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error) {
+	if code, ok := c.checkHealthy(); !ok {
+		return &milvuspb.ListGrantsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "StateCode="+internalpb.StateCode_name[int32(code)]),
+		}, nil
+	}
+
+	var entities []*milvuspb.GrantEntity
+	var err error
+	if in.RoleName != "" {
+		entities, err = c.MetaTable.ListGrants(in.RoleName)
+	} else {
+		entities, err = c.MetaTable.ListUserGrants(in.Username)
+	}
+	if err != nil {
+		log.Error("ListGrants failed", zap.String("role", typeutil.RootCoordRole),
+			zap.String("role name", in.RoleName), zap.String("username", in.Username), zap.Error(err))
+		return &milvuspb.ListGrantsResponse{
+			Status: failStatus(commonpb.ErrorCode_UnexpectedError, "ListGrants failed: "+err.Error()),
+		}, nil
+	}
+
+	return &milvuspb.ListGrantsResponse{
+		Status:   succStatus(),
+		Entities: entities,
+	}, nil
+}
+
+// ExpirePolicyInfoCache will call refresh policy info cache, all the privileges cached by proxies are dropped
+func (c *Core) ExpirePolicyInfoCache(ctx context.Context) {
+	req := proxypb.RefreshPolicyInfoCacheRequest{
+		Base: &commonpb.MsgBase{
+			MsgType:  commonpb.MsgType_RefreshPolicyInfoCache,
+			SourceID: c.session.ServerID,
+		},
+	}
+	c.proxyClientManager.RefreshPolicyInfoCache(ctx, &req)
+}
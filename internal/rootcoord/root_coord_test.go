@@ -35,6 +35,8 @@ import (
 	"github.com/milvus-io/milvus/internal/msgstream"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/metricsinfo"
 	"github.com/milvus-io/milvus/internal/util/retry"
 	"github.com/stretchr/testify/require"
@@ -50,6 +52,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/types"
 	"github.com/milvus-io/milvus/internal/util/etcd"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/sessionutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"github.com/stretchr/testify/assert"
@@ -91,11 +94,33 @@ func (p *proxyMock) ReleaseDQLMessageStream(ctx context.Context, request *proxyp
 	}, nil
 }
 
+func (p *proxyMock) InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (p *proxyMock) RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
+func (p *proxyMock) SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error) {
+	return &commonpb.Status{
+		ErrorCode: commonpb.ErrorCode_Success,
+		Reason:    "",
+	}, nil
+}
+
 type dataMock struct {
 	types.DataCoord
 	randVal int
 	mu      sync.Mutex
 	segs    []typeutil.UniqueID
+	altered map[typeutil.UniqueID][]*commonpb.KeyValuePair
 }
 
 func (d *dataMock) Init() error {
@@ -165,6 +190,16 @@ func (d *dataMock) WatchChannels(ctx context.Context, req *datapb.WatchChannelsR
 		}}, nil
 }
 
+func (d *dataMock) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	if d.altered == nil {
+		d.altered = make(map[typeutil.UniqueID][]*commonpb.KeyValuePair)
+	}
+	d.altered[req.GetCollectionID()] = req.GetProperties()
+	return &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}, nil
+}
+
 type queryMock struct {
 	types.QueryCoord
 	collID []typeutil.UniqueID
@@ -325,6 +360,10 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 	}
 	schema.Fields = append(schema.Fields, rowIDField, timeStampField)
 
+	dbInfo, err := core.MetaTable.GetDatabaseByName(dbName)
+	if err != nil {
+		return err
+	}
 	collID, _, err := core.IDAllocator(1)
 	if err != nil {
 		return fmt.Errorf("alloc collection id error = %w", err)
@@ -351,6 +390,7 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 		PhysicalChannelNames:       chanNames,
 		ShardsNum:                  0, // intend to set zero
 		PartitionCreatedTimestamps: []uint64{0},
+		DbId:                       dbInfo.ID,
 	}
 
 	if modifyFunc != nil {
@@ -371,7 +411,7 @@ func createCollectionInMeta(dbName, collName string, core *Core, shardsNum int32
 		DbName:               t.DbName,
 		CollectionName:       t.CollectionName,
 		PartitionName:        Params.CommonCfg.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		DbID:                 dbInfo.ID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -542,7 +582,7 @@ func TestRootCoordInit(t *testing.T) {
 
 func TestRootCoord(t *testing.T) {
 	const (
-		dbName    = "testDb"
+		dbName    = "default"
 		collName  = "testColl"
 		collName2 = "testColl2"
 		aliasName = "alias1"
@@ -714,14 +754,20 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			Schema:         sbf,
 			ShardsNum:      shardsNum,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "invalid"}},
 		}
 		status, err := core.CreateCollection(ctx, req)
 		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		req.Properties = []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "3600"}}
+		status, err = core.CreateCollection(ctx, req)
+		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
 		assert.Equal(t, shardsNum, int32(core.chanTimeTick.getDmlChannelNum()))
 
-		createMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		createMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dmlStream.AsConsumer([]string{createMeta.PhysicalChannelNames[0]}, Params.RootCoordCfg.MsgChannelSubName)
 		dmlStream.Start()
@@ -867,7 +913,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -887,6 +933,9 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, shardsNum, int32(len(rsp.VirtualChannelNames)))
 		assert.Equal(t, shardsNum, int32(len(rsp.PhysicalChannelNames)))
 		assert.Equal(t, shardsNum, rsp.ShardsNum)
+		ttl, err := funcutil.GetCollectionTTL(rsp.Properties)
+		assert.Nil(t, err)
+		assert.Equal(t, time.Hour, ttl)
 	})
 
 	wg.Add(1)
@@ -927,7 +976,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		t.Log(status.Reason)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.PartitionIDs))
 		partNameIdx1, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[1], 0)
@@ -988,7 +1037,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("show partition", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.ShowPartitionsRequest{
 			Base: &commonpb.MsgBase{
@@ -1011,7 +1060,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("show segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 		dm.mu.Lock()
@@ -1055,7 +1104,7 @@ func TestRootCoord(t *testing.T) {
 				},
 			},
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(collMeta.FieldIndexes))
 
@@ -1066,7 +1115,7 @@ func TestRootCoord(t *testing.T) {
 		files := im.getFileArray()
 		assert.Equal(t, 3, len(files))
 		assert.ElementsMatch(t, files, []string{"file0-100", "file1-100", "file2-100"})
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		idxMeta, err := core.MetaTable.GetIndexByID(collMeta.FieldIndexes[0].IndexID)
@@ -1082,7 +1131,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &milvuspb.DescribeSegmentRequest{
@@ -1148,7 +1197,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("flush segment", func(t *testing.T) {
 		defer wg.Done()
-		coll, err := core.MetaTable.GetCollectionByName(collName, 0)
+		coll, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		partID := coll.PartitionIDs[1]
 
@@ -1206,7 +1255,7 @@ func TestRootCoord(t *testing.T) {
 			},
 		}
 
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.FieldIndexes))
 		oldIdx := collMeta.FieldIndexes[0].IndexID
@@ -1216,7 +1265,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 		time.Sleep(100 * time.Millisecond)
 
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 2, len(collMeta.FieldIndexes))
 		assert.Equal(t, oldIdx, collMeta.FieldIndexes[0].IndexID)
@@ -1246,7 +1295,7 @@ func TestRootCoord(t *testing.T) {
 			FieldName:      "vector",
 			IndexName:      Params.CommonCfg.DefaultIndexName,
 		}
-		_, idx, err := core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err := core.MetaTable.GetIndexByName("", collName, Params.CommonCfg.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(idx))
 
@@ -1259,7 +1308,7 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, idx[0].IndexID, im.idxDropID[0])
 		im.mutex.Unlock()
 
-		_, idx, err = core.MetaTable.GetIndexByName(collName, Params.CommonCfg.DefaultIndexName)
+		_, idx, err = core.MetaTable.GetIndexByName("", collName, Params.CommonCfg.DefaultIndexName)
 		assert.Nil(t, err)
 		assert.Equal(t, 0, len(idx))
 	})
@@ -1278,13 +1327,13 @@ func TestRootCoord(t *testing.T) {
 			CollectionName: collName,
 			PartitionName:  partName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dropPartID := collMeta.PartitionIDs[1]
 		status, err := core.DropPartition(ctx, req)
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
-		collMeta, err = core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err = core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		assert.Equal(t, 1, len(collMeta.PartitionIDs))
 		partName, err := core.MetaTable.GetPartitionNameByID(collMeta.ID, collMeta.PartitionIDs[0], 0)
@@ -1325,7 +1374,7 @@ func TestRootCoord(t *testing.T) {
 	wg.Add(1)
 	t.Run("remove DQL msgstream", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 
 		req := &proxypb.ReleaseDQLMessageStreamRequest{
@@ -1353,7 +1402,7 @@ func TestRootCoord(t *testing.T) {
 			DbName:         dbName,
 			CollectionName: collName,
 		}
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		status, err := core.DropCollection(ctx, req)
 		assert.Nil(t, err)
@@ -2006,6 +2055,388 @@ func TestRootCoord(t *testing.T) {
 		assert.Equal(t, commonpb.ErrorCode_Success, rsp.ErrorCode)
 	})
 
+	wg.Add(1)
+	t.Run("rename collection", func(t *testing.T) {
+		defer wg.Done()
+		const renamedColl = "testColl2Renamed"
+		// collide with an existing collection
+		status, err := core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+			OldName: collName2,
+			NewName: collName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+			OldName: collName2,
+			NewName: renamedColl,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		has, err := core.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_HasCollection},
+			CollectionName: collName2,
+		})
+		assert.Nil(t, err)
+		assert.False(t, has.Value)
+		has, err = core.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_HasCollection},
+			CollectionName: renamedColl,
+		})
+		assert.Nil(t, err)
+		assert.True(t, has.Value)
+
+		// rename back for the following cases
+		status, err = core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base:    &commonpb.MsgBase{MsgType: commonpb.MsgType_RenameCollection},
+			OldName: renamedColl,
+			NewName: collName2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("alter collection", func(t *testing.T) {
+		defer wg.Done()
+		status, err := core.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+			CollectionName: collName2,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "-1"}},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+			CollectionName: "not-exist",
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "120"}},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AlterCollection},
+			CollectionName: collName2,
+			Properties:     []*commonpb.KeyValuePair{{Key: common.CollectionTTLConfigKey, Value: "120"}},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+			CollectionName: collName2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		ttl, err := funcutil.GetCollectionTTL(rsp.Properties)
+		assert.Nil(t, err)
+		assert.Equal(t, 2*time.Minute, ttl)
+
+		dm.mu.Lock()
+		assert.Equal(t, rsp.Properties, dm.altered[rsp.CollectionID])
+		dm.mu.Unlock()
+	})
+
+	wg.Add(1)
+	t.Run("add field", func(t *testing.T) {
+		defer wg.Done()
+		field := &schemapb.FieldSchema{
+			Name:         "added_field",
+			DataType:     schemapb.DataType_Int64,
+			DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+		}
+		// vector field can't be added
+		status, err := core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: collName2,
+			Field:          &schemapb.FieldSchema{Name: "vector", DataType: schemapb.DataType_FloatVector},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		// default value is required
+		status, err = core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: collName2,
+			Field:          &schemapb.FieldSchema{Name: "no_default", DataType: schemapb.DataType_Int64},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		// default value mismatches the data type
+		status, err = core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: collName2,
+			Field: &schemapb.FieldSchema{
+				Name:         "mismatch",
+				DataType:     schemapb.DataType_Bool,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}},
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		before, err := core.MetaTable.GetCollectionByName("", collName2, 0)
+		assert.Nil(t, err)
+		status, err = core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: collName2,
+			Field:          field,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+			CollectionName: collName2,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, before.Schema.Version+1, rsp.Schema.Version)
+		assert.Equal(t, len(before.Schema.Fields)+1, len(rsp.Schema.Fields))
+		assert.Equal(t, field.Name, rsp.Schema.Fields[len(rsp.Schema.Fields)-1].Name)
+
+		// duplicate field name
+		status, err = core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_AddField},
+			CollectionName: collName2,
+			Field:          field,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("database", func(t *testing.T) {
+		defer wg.Done()
+		const newDbName = "testDb"
+		status, err := core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase},
+			DbName: newDbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateDatabase},
+			DbName: newDbName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ListDatabases},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{Params.CommonCfg.DefaultDatabaseName, newDbName}, rsp.DbNames)
+
+		// collections are invisible across databases
+		has, err := core.HasCollection(ctx, &milvuspb.HasCollectionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_HasCollection},
+			DbName:         newDbName,
+			CollectionName: collName,
+		})
+		assert.Nil(t, err)
+		assert.False(t, has.Value)
+
+		status, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase},
+			DbName: dbName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_DropDatabase},
+			DbName: newDbName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("credential", func(t *testing.T) {
+		defer wg.Done()
+		const username = "testUser"
+		// default root user is created in init
+		rootCred, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_GetCredential},
+			Username: util.UserRoot,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rootCred.Status.ErrorCode)
+		assert.True(t, crypto.PasswordVerify(util.DefaultRootPassword, rootCred.Password))
+
+		status, err := core.CreateCredential(ctx, &internalpb.CredentialInfo{
+			Username:          username,
+			EncryptedPassword: "encrypted",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateCredential(ctx, &internalpb.CredentialInfo{
+			Username:          username,
+			EncryptedPassword: "encrypted",
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.ListUsers(ctx, &milvuspb.ListUsersRequest{
+			Base: &commonpb.MsgBase{MsgType: commonpb.MsgType_ListUsers},
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.ElementsMatch(t, []string{util.UserRoot, username}, rsp.Usernames)
+
+		status, err = core.UpdateCredential(ctx, &internalpb.CredentialInfo{
+			Username:          username,
+			EncryptedPassword: "encrypted2",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		cred, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_GetCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, cred.Status.ErrorCode)
+		assert.Equal(t, "encrypted2", cred.Password)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.UpdateCredential(ctx, &internalpb.CredentialInfo{
+			Username:          username,
+			EncryptedPassword: "encrypted3",
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		cred, err = core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_GetCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, cred.Status.ErrorCode)
+	})
+
+	wg.Add(1)
+	t.Run("rbac", func(t *testing.T) {
+		defer wg.Done()
+		const username = "rbacUser"
+		const roleName = "rbacRole"
+		status, err := core.CreateCredential(ctx, &internalpb.CredentialInfo{
+			Username:          username,
+			EncryptedPassword: "encrypted",
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateRole},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateRole},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_OperateUserRole},
+			Username: username,
+			RoleName: roleName,
+			Type:     milvuspb.OperateUserRoleType_AddUserToRole,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		entity := &milvuspb.GrantEntity{
+			RoleName:   roleName,
+			ObjectType: util.ObjectTypeCollection,
+			ObjectName: util.AnyWord,
+			Privilege:  "Search",
+		}
+		status, err = core.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_GrantPrivilege},
+			Entity: entity,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err := core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_ListGrants},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(rsp.Entities))
+
+		rsp, err = core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_ListGrants},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+		assert.Equal(t, 1, len(rsp.Entities))
+
+		status, err = core.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_RevokePrivilege},
+			Entity: entity,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{
+			Base:   &commonpb.MsgBase{MsgType: commonpb.MsgType_RevokePrivilege},
+			Entity: entity,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		status, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DropRole},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+
+		rsp, err = core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_ListGrants},
+			RoleName: roleName,
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp.Status.ErrorCode)
+
+		status, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base:     &commonpb.MsgBase{MsgType: commonpb.MsgType_DeleteCredential},
+			Username: username,
+		})
+		assert.Nil(t, err)
+		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
+	})
+
 	status, err = core.DropCollection(ctx, &milvuspb.DropCollectionRequest{
 		Base: &commonpb.MsgBase{
 			MsgType:   commonpb.MsgType_DropCollection,
@@ -2244,6 +2675,134 @@ func TestRootCoord(t *testing.T) {
 		assert.Nil(t, err)
 		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp8.Status.ErrorCode)
 
+		st, err = core.CreateDatabase(ctx, &milvuspb.CreateDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateDatabase,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.DropDatabase(ctx, &milvuspb.DropDatabaseRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropDatabase,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		rsp9, err := core.ListDatabases(ctx, &milvuspb.ListDatabasesRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListDatabases,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp9.Status.ErrorCode)
+
+		st, err = core.RenameCollection(ctx, &milvuspb.RenameCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_RenameCollection,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.AlterCollection(ctx, &milvuspb.AlterCollectionRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_AlterCollection,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.AddField(ctx, &milvuspb.AddFieldRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_AddField,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.CreateCredential(ctx, &internalpb.CredentialInfo{})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.UpdateCredential(ctx, &internalpb.CredentialInfo{})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.DeleteCredential(ctx, &milvuspb.DeleteCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DeleteCredential,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		rsp10, err := core.ListUsers(ctx, &milvuspb.ListUsersRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListUsers,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp10.Status.ErrorCode)
+
+		rsp11, err := core.GetCredential(ctx, &rootcoordpb.GetCredentialRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GetCredential,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp11.Status.ErrorCode)
+
+		st, err = core.CreateRole(ctx, &milvuspb.CreateRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_CreateRole,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.DropRole(ctx, &milvuspb.DropRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_DropRole,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.OperateUserRole(ctx, &milvuspb.OperateUserRoleRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_OperateUserRole,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.GrantPrivilege(ctx, &milvuspb.GrantPrivilegeRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_GrantPrivilege,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		st, err = core.RevokePrivilege(ctx, &milvuspb.RevokePrivilegeRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_RevokePrivilege,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, st.ErrorCode)
+
+		rsp12, err := core.ListGrants(ctx, &milvuspb.ListGrantsRequest{
+			Base: &commonpb.MsgBase{
+				MsgType: commonpb.MsgType_ListGrants,
+			},
+		})
+		assert.Nil(t, err)
+		assert.NotEqual(t, commonpb.ErrorCode_Success, rsp12.Status.ErrorCode)
+
 	})
 
 	wg.Add(1)
@@ -2291,7 +2850,7 @@ func TestRootCoord(t *testing.T) {
 
 func TestRootCoord2(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = "default"
 		collName = "testColl"
 		partName = "testPartition"
 	)
@@ -2401,7 +2960,7 @@ func TestRootCoord2(t *testing.T) {
 		assert.Nil(t, err)
 		assert.Equal(t, commonpb.ErrorCode_Success, status.ErrorCode)
 
-		collInfo, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collInfo, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		dmlStream, _ := msFactory.NewMsgStream(ctx)
 		dmlStream.AsConsumer([]string{collInfo.PhysicalChannelNames[0]}, Params.RootCoordCfg.MsgChannelSubName)
@@ -2418,7 +2977,7 @@ func TestRootCoord2(t *testing.T) {
 	wg.Add(1)
 	t.Run("describe collection", func(t *testing.T) {
 		defer wg.Done()
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
@@ -2566,6 +3125,24 @@ func TestCheckInit(t *testing.T) {
 		return nil
 	}
 	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallBroadcastAlteredCollection = func(ctx context.Context, collectionID int64, properties []*commonpb.KeyValuePair) error {
+		return nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallGetDataCoordMetricsService = func(ctx context.Context) (*metricsinfo.DataCoordTopology, error) {
+		return &metricsinfo.DataCoordTopology{}, nil
+	}
+	err = c.checkInit()
+	assert.NotNil(t, err)
+
+	c.CallGetQueryCoordMetricsService = func(ctx context.Context) (*metricsinfo.QueryCoordTopology, error) {
+		return &metricsinfo.QueryCoordTopology{}, nil
+	}
+	err = c.checkInit()
 	assert.Nil(t, err)
 
 	err = c.Stop()
@@ -2574,7 +3151,7 @@ func TestCheckInit(t *testing.T) {
 
 func TestCheckFlushedSegments(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = "default"
 		collName = "testColl"
 		partName = "testPartition"
 	)
@@ -2740,7 +3317,7 @@ func TestCheckFlushedSegments(t *testing.T) {
 
 func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	const (
-		dbName   = "testDb"
+		dbName   = "default"
 		collName = "testColl"
 	)
 
@@ -2821,7 +3398,7 @@ func TestRootCoord_CheckZeroShardsNum(t *testing.T) {
 	createCollectionInMeta(dbName, collName, core, shardsNum, modifyFunc)
 
 	t.Run("describe collection", func(t *testing.T) {
-		collMeta, err := core.MetaTable.GetCollectionByName(collName, 0)
+		collMeta, err := core.MetaTable.GetCollectionByName("", collName, 0)
 		assert.Nil(t, err)
 		req := &milvuspb.DescribeCollectionRequest{
 			Base: &commonpb.MsgBase{
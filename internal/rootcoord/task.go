@@ -28,6 +28,7 @@ import (
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
 	"github.com/milvus-io/milvus/internal/proto/milvuspb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/tsoutil"
 	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
@@ -101,7 +102,14 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Req.ShardsNum <= 0 {
 		t.Req.ShardsNum = common.DefaultShardsNum
 	}
-	log.Debug("CreateCollectionReqTask Execute", zap.Any("CollectionName", t.Req.CollectionName),
+	if _, err := funcutil.GetCollectionTTL(t.Req.Properties); err != nil {
+		return err
+	}
+	dbInfo, err := t.core.MetaTable.GetDatabaseByName(t.Req.DbName)
+	if err != nil {
+		return err
+	}
+	log.Debug("CreateCollectionReqTask Execute", zap.String("DbName", dbInfo.Name), zap.Any("CollectionName", t.Req.CollectionName),
 		zap.Int32("ShardsNum", t.Req.ShardsNum),
 		zap.String("ConsistencyLevel", t.Req.ConsistencyLevel.String()))
 
@@ -163,6 +171,8 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		ShardsNum:                  t.Req.ShardsNum,
 		PartitionCreatedTimestamps: []uint64{0},
 		ConsistencyLevel:           t.Req.ConsistencyLevel,
+		DbId:                       dbInfo.ID,
+		Properties:                 t.Req.Properties,
 	}
 
 	idxInfo := make([]*etcdpb.IndexInfo, 0, 16)
@@ -179,7 +189,7 @@ func (t *CreateCollectionReqTask) Execute(ctx context.Context) error {
 		DbName:               t.Req.DbName,
 		CollectionName:       t.Req.CollectionName,
 		PartitionName:        Params.CommonCfg.DefaultPartitionName,
-		DbID:                 0, //TODO,not used
+		DbID:                 dbInfo.ID,
 		CollectionID:         collID,
 		PartitionID:          partID,
 		Schema:               schemaBytes,
@@ -273,11 +283,11 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropCollection {
 		return fmt.Errorf("drop collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if t.core.MetaTable.IsAlias(t.Req.CollectionName) {
+	if t.core.MetaTable.IsAlias(t.Req.DbName, t.Req.CollectionName) {
 		return fmt.Errorf("cannot drop the collection via alias = %s", t.Req.CollectionName)
 	}
 
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -286,7 +296,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 		Base:           t.Req.Base,
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
-		DbID:           0, //not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 	}
 
@@ -305,7 +315,7 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 
 	// drop all indices
-	if err = t.core.RemoveIndex(ctx, t.Req.CollectionName, ""); err != nil {
+	if err = t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, ""); err != nil {
 		return err
 	}
 
@@ -361,13 +371,13 @@ func (t *DropCollectionReqTask) Execute(ctx context.Context) error {
 	}
 
 	//notify query service to release collection
-	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, 0, collMeta.ID); err != nil {
+	if err = t.core.CallReleaseCollectionService(t.core.ctx, ts, collMeta.DbId, collMeta.ID); err != nil {
 		log.Error("Failed to CallReleaseCollectionService", zap.Error(err))
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
-	t.core.ExpireMetaCache(ctx, aliases, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, aliases, ts)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -390,7 +400,7 @@ func (t *HasCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasCollection {
 		return fmt.Errorf("has collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	_, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+	_, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 	if err == nil {
 		t.HasCollection = true
 	} else {
@@ -420,7 +430,7 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	var err error
 
 	if t.Req.CollectionName != "" {
-		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, t.Req.TimeStamp)
+		collInfo, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, t.Req.TimeStamp)
 		if err != nil {
 			return err
 		}
@@ -446,6 +456,8 @@ func (t *DescribeCollectionReqTask) Execute(ctx context.Context) error {
 	t.Rsp.CreatedUtcTimestamp = uint64(createdPhysicalTime)
 	t.Rsp.Aliases = t.core.MetaTable.ListAliases(collInfo.ID)
 	t.Rsp.StartPositions = collInfo.GetStartPositions()
+	t.Rsp.DbId = collInfo.DbId
+	t.Rsp.Properties = collInfo.Properties
 	return nil
 }
 
@@ -466,7 +478,7 @@ func (t *ShowCollectionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_ShowCollections {
 		return fmt.Errorf("show collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.ListCollections(t.Req.TimeStamp)
+	coll, err := t.core.MetaTable.ListCollections(t.Req.DbName, t.Req.TimeStamp)
 	if err != nil {
 		return err
 	}
@@ -496,7 +508,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_CreatePartition {
 		return fmt.Errorf("create partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -510,7 +522,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, // todo, not used
+		DbID:           collMeta.DbId,
 		CollectionID:   collMeta.ID,
 		PartitionID:    partID,
 	}
@@ -561,7 +573,7 @@ func (t *CreatePartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
 
 	// Update DDOperation in etcd
 	return t.core.setDdMsgSendFlag(true)
@@ -583,7 +595,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropPartition {
 		return fmt.Errorf("drop partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collInfo, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -597,7 +609,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		DbName:         t.Req.DbName,
 		CollectionName: t.Req.CollectionName,
 		PartitionName:  t.Req.PartitionName,
-		DbID:           0, //todo,not used
+		DbID:           collInfo.DbId,
 		CollectionID:   collInfo.ID,
 		PartitionID:    partID,
 	}
@@ -648,7 +660,7 @@ func (t *DropPartitionReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.CollectionName}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.CollectionName}, ts)
 
 	//notify query service to release partition
 	// TODO::xige-16, reOpen when queryCoord support release partitions after load collection
@@ -678,7 +690,7 @@ func (t *HasPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_HasPartition {
 		return fmt.Errorf("has partition, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	coll, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -708,7 +720,7 @@ func (t *ShowPartitionReqTask) Execute(ctx context.Context) error {
 	if t.Req.CollectionName == "" {
 		coll, err = t.core.MetaTable.GetCollectionByID(t.Req.CollectionID, 0)
 	} else {
-		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+		coll, err = t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	}
 	if err != nil {
 		return err
@@ -845,7 +857,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		IndexID:     indexID,
 		IndexParams: t.Req.ExtraParams,
 	}
-	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.CollectionName, 0)
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
 	if err != nil {
 		return err
 	}
@@ -859,7 +871,7 @@ func (t *CreateIndexReqTask) Execute(ctx context.Context) error {
 		return err
 	}
 
-	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
+	segIDs, field, err := t.core.MetaTable.GetNotIndexedSegments(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, idxInfo, flushedSegs)
 	if err != nil {
 		log.Debug("RootCoord CreateIndexReqTask metaTable.GetNotIndexedSegments", zap.Error(err))
 		return err
@@ -909,7 +921,7 @@ func (t *DescribeIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DescribeIndex {
 		return fmt.Errorf("describe index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.CollectionName, t.Req.IndexName)
+	coll, idx, err := t.core.MetaTable.GetIndexByName(t.Req.DbName, t.Req.CollectionName, t.Req.IndexName)
 	if err != nil {
 		return err
 	}
@@ -946,10 +958,10 @@ func (t *DropIndexReqTask) Execute(ctx context.Context) error {
 	if t.Type() != commonpb.MsgType_DropIndex {
 		return fmt.Errorf("drop index, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
 	}
-	if err := t.core.RemoveIndex(ctx, t.Req.CollectionName, t.Req.IndexName); err != nil {
+	if err := t.core.RemoveIndex(ctx, t.Req.DbName, t.Req.CollectionName, t.Req.IndexName); err != nil {
 		return err
 	}
-	_, _, err := t.core.MetaTable.DropIndex(t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
+	_, _, err := t.core.MetaTable.DropIndex(t.Req.DbName, t.Req.CollectionName, t.Req.FieldName, t.Req.IndexName)
 	return err
 }
 
@@ -974,7 +986,7 @@ func (t *CreateAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AddAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AddAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table add alias failed, error = %w", err)
 	}
@@ -1003,12 +1015,12 @@ func (t *DropAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.DropAlias(t.Req.Alias, ts)
+	err = t.core.MetaTable.DropAlias(t.Req.DbName, t.Req.Alias, ts)
 	if err != nil {
 		return fmt.Errorf("meta table drop alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.Alias}, ts)
 
 	return nil
 }
@@ -1034,12 +1046,247 @@ func (t *AlterAliasReqTask) Execute(ctx context.Context) error {
 	if err != nil {
 		return fmt.Errorf("TSO alloc fail, error = %w", err)
 	}
-	err = t.core.MetaTable.AlterAlias(t.Req.Alias, t.Req.CollectionName, ts)
+	err = t.core.MetaTable.AlterAlias(t.Req.DbName, t.Req.Alias, t.Req.CollectionName, ts)
 	if err != nil {
 		return fmt.Errorf("meta table alter alias failed, error = %w", err)
 	}
 
-	t.core.ExpireMetaCache(ctx, []string{t.Req.Alias}, ts)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, []string{t.Req.Alias}, ts)
+
+	return nil
+}
+
+// RenameCollectionReqTask rename collection request task
+type RenameCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.RenameCollectionRequest
+}
+
+// Type return msg type
+func (t *RenameCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *RenameCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_RenameCollection {
+		return fmt.Errorf("rename collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.NewName == "" {
+		return fmt.Errorf("new collection name should not be empty")
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.OldName, 0)
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.RenameCollection(t.Req.DbName, t.Req.OldName, t.Req.NewName, ts)
+	if err != nil {
+		return fmt.Errorf("meta table rename collection failed, error = %w", err)
+	}
+
+	// aliases are cached by proxy with the old schema name, expire them as well
+	collNames := append([]string{t.Req.OldName, t.Req.NewName}, t.core.MetaTable.ListAliases(collMeta.ID)...)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, collNames, ts)
+
+	return nil
+}
+
+// AlterCollectionReqTask alter collection request task
+type AlterCollectionReqTask struct {
+	baseReqTask
+	Req *milvuspb.AlterCollectionRequest
+}
+
+// Type return msg type
+func (t *AlterCollectionReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AlterCollectionReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AlterCollection {
+		return fmt.Errorf("alter collection, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if len(t.Req.Properties) == 0 {
+		return fmt.Errorf("no properties to alter")
+	}
+	if _, err := funcutil.GetCollectionTTL(t.Req.Properties); err != nil {
+		return err
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.AlterCollection(t.Req.DbName, t.Req.CollectionName, t.Req.Properties, ts)
+	if err != nil {
+		return fmt.Errorf("meta table alter collection failed, error = %w", err)
+	}
+
+	collNames := append([]string{t.Req.CollectionName}, t.core.MetaTable.ListAliases(collMeta.ID)...)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, collNames, ts)
+
+	// DataCoord caches the collection info as well, e.g. the ttl in the properties is used by compaction
+	altered, err := t.core.MetaTable.GetCollectionByID(collMeta.ID, 0)
+	if err != nil {
+		return err
+	}
+	if err = t.core.CallBroadcastAlteredCollection(ctx, collMeta.ID, altered.Properties); err != nil {
+		return fmt.Errorf("broadcast altered collection to data coord failed, error = %w", err)
+	}
+
+	return nil
+}
+
+// AddFieldReqTask add field request task
+type AddFieldReqTask struct {
+	baseReqTask
+	Req *milvuspb.AddFieldRequest
+}
+
+// Type return msg type
+func (t *AddFieldReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *AddFieldReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_AddField {
+		return fmt.Errorf("add field, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	field := t.Req.Field
+	if field == nil || field.Name == "" {
+		return fmt.Errorf("field name should not be empty")
+	}
+	if field.IsPrimaryKey || field.AutoID {
+		return fmt.Errorf("field %s added to an existing collection can't be primary key or auto id", field.Name)
+	}
+	// the rows are encoded with fixed size fields, so only these types can be added
+	switch field.DataType {
+	case schemapb.DataType_Bool, schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32,
+		schemapb.DataType_Int64, schemapb.DataType_Float, schemapb.DataType_Double:
+	default:
+		return fmt.Errorf("field %s of data type %s can't be added to an existing collection", field.Name, field.DataType.String())
+	}
+	if field.DefaultValue == nil {
+		return fmt.Errorf("default value of field %s is required", field.Name)
+	}
+	if err := typeutil.CheckDefaultValue(field); err != nil {
+		return err
+	}
+
+	collMeta, err := t.core.MetaTable.GetCollectionByName(t.Req.DbName, t.Req.CollectionName, 0)
+	if err != nil {
+		return err
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.AddField(t.Req.DbName, t.Req.CollectionName, field, ts)
+	if err != nil {
+		return fmt.Errorf("meta table add field failed, error = %w", err)
+	}
+
+	collNames := append([]string{t.Req.CollectionName}, t.core.MetaTable.ListAliases(collMeta.ID)...)
+	t.core.ExpireMetaCache(ctx, t.Req.DbName, collNames, ts)
 
 	return nil
 }
+
+// CreateDatabaseReqTask create database request task
+type CreateDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.CreateDatabaseRequest
+}
+
+// Type return msg type
+func (t *CreateDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *CreateDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_CreateDatabase {
+		return fmt.Errorf("create database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	if t.Req.DbName == "" {
+		return fmt.Errorf("database name should not be empty")
+	}
+
+	dbID, _, err := t.core.IDAllocator(1)
+	if err != nil {
+		return fmt.Errorf("alloc database id error = %w", err)
+	}
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.CreateDatabase(&etcdpb.DatabaseInfo{ID: dbID, Name: t.Req.DbName}, ts)
+	if err != nil {
+		return fmt.Errorf("meta table create database failed, error = %w", err)
+	}
+	return nil
+}
+
+// DropDatabaseReqTask drop database request task
+type DropDatabaseReqTask struct {
+	baseReqTask
+	Req *milvuspb.DropDatabaseRequest
+}
+
+// Type return msg type
+func (t *DropDatabaseReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *DropDatabaseReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_DropDatabase {
+		return fmt.Errorf("drop database, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+
+	ts, err := t.core.TSOAllocator(1)
+	if err != nil {
+		return fmt.Errorf("TSO alloc fail, error = %w", err)
+	}
+	err = t.core.MetaTable.DropDatabase(t.Req.DbName, ts)
+	if err != nil {
+		return fmt.Errorf("meta table drop database failed, error = %w", err)
+	}
+	return nil
+}
+
+// ListDatabasesReqTask list databases request task
+type ListDatabasesReqTask struct {
+	baseReqTask
+	Req *milvuspb.ListDatabasesRequest
+	Rsp *milvuspb.ListDatabasesResponse
+}
+
+// Type return msg type
+func (t *ListDatabasesReqTask) Type() commonpb.MsgType {
+	return t.Req.Base.MsgType
+}
+
+// Execute task execution
+func (t *ListDatabasesReqTask) Execute(ctx context.Context) error {
+	if t.Type() != commonpb.MsgType_ListDatabases {
+		return fmt.Errorf("list databases, msg type = %s", commonpb.MsgType_name[int32(t.Type())])
+	}
+	for _, db := range t.core.MetaTable.ListDatabases() {
+		t.Rsp.DbNames = append(t.Rsp.DbNames, db.Name)
+		t.Rsp.DbIds = append(t.Rsp.DbIds, db.ID)
+		t.Rsp.CreatedTimestamps = append(t.Rsp.CreatedTimestamps, db.CreateTime)
+	}
+	return nil
+}
@@ -43,7 +43,7 @@ type Iterator interface {
 // Value is the return value of Next
 type Value struct {
 	ID        int64
-	PK        int64
+	PK        PrimaryKey
 	Timestamp int64
 	IsDeleted bool
 	Value     interface{}
@@ -88,13 +88,22 @@ func (itr *InsertBinlogIterator) Next() (interface{}, error) {
 
 	m := make(map[FieldID]interface{})
 	for fieldID, fieldData := range itr.data.Data {
+		// nil is the value of a null row
+		if IsNull(fieldData, itr.pos) {
+			m[fieldID] = nil
+			continue
+		}
 		m[fieldID] = fieldData.GetRow(itr.pos)
 	}
 
+	pk, err := NewPrimaryKey(itr.data.Data[itr.PKfieldID].GetRow(itr.pos))
+	if err != nil {
+		return nil, err
+	}
 	v := &Value{
 		ID:        itr.data.Data[rootcoord.RowIDField].GetRow(itr.pos).(int64),
 		Timestamp: itr.data.Data[rootcoord.TimeStampField].GetRow(itr.pos).(int64),
-		PK:        itr.data.Data[itr.PKfieldID].GetRow(itr.pos).(int64),
+		PK:        pk,
 		IsDeleted: false,
 		Value:     m,
 	}
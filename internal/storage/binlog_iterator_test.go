@@ -118,7 +118,7 @@ func TestInsertlogIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -175,7 +175,7 @@ func TestMergeIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -210,7 +210,7 @@ func TestMergeIterator(t *testing.T) {
 
 			expected := &Value{
 				int64(i),
-				int64(i),
+				NewInt64PrimaryKey(int64(i)),
 				int64(i),
 				false,
 				map[FieldID]interface{}{
@@ -25,6 +25,8 @@ enum ColumnType : int {
   FLOAT = 10,
   DOUBLE = 11,
   STRING = 20,
+  VARCHAR = 21,
+  JSON = 23,
   VECTOR_BINARY = 100,
   VECTOR_FLOAT = 101
 };
@@ -82,6 +82,18 @@ CPayloadWriter NewPayloadWriter(int columnType) {
       p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
       break;
     }
+    case ColumnType::VARCHAR : {
+      p->columnType = ColumnType::VARCHAR;
+      p->builder = std::make_shared<arrow::StringBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
+      break;
+    }
+    case ColumnType::JSON : {
+      p->columnType = ColumnType::JSON;
+      p->builder = std::make_shared<arrow::StringBuilder>();
+      p->schema = arrow::schema({arrow::field("val", arrow::utf8())});
+      break;
+    }
     case ColumnType::VECTOR_BINARY : {
       p->columnType = ColumnType::VECTOR_BINARY;
       p->dimension = wrapper::EMPTY_DIMENSION;
@@ -291,6 +303,45 @@ CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int
   return st;
 }
 
+extern "C"
+CStatus AddValidDataToPayload(CPayloadWriter payloadWriter, bool *valid_data, int length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  if (length <= 0) return st;
+
+  auto p = reinterpret_cast<wrapper::PayloadWriter *>(payloadWriter);
+  if (p->output != nullptr) {
+    st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+    st.error_msg = ErrorMsg("payload has finished");
+    return st;
+  }
+  p->valid_data.insert(p->valid_data.end(), valid_data, valid_data + length);
+  return st;
+}
+
+// SetValidData replaces the validity bitmap of the array with the validity added to the payload,
+// the nulls are written into the definition levels of the parquet column
+static arrow::Status SetValidData(const std::vector<bool> &valid_data, std::shared_ptr<arrow::Array> *array) {
+  if (valid_data.empty()) return arrow::Status::OK();
+  if (static_cast<int64_t>(valid_data.size()) != (*array)->length()) {
+    return arrow::Status::Invalid("the valid data has ", valid_data.size(), " rows, but the payload has ",
+                                  (*array)->length(), " rows");
+  }
+  arrow::TypedBufferBuilder<bool> bitmap_builder;
+  ARROW_RETURN_NOT_OK(bitmap_builder.Reserve(valid_data.size()));
+  for (int64_t i = 0; i < (*array)->length(); i++) {
+    bitmap_builder.UnsafeAppend(valid_data[i] && (*array)->IsValid(i));
+  }
+  std::shared_ptr<arrow::Buffer> bitmap;
+  ARROW_RETURN_NOT_OK(bitmap_builder.Finish(&bitmap));
+  auto data = (*array)->data()->Copy();
+  data->buffers[0] = bitmap;
+  data->null_count = arrow::kUnknownNullCount;
+  *array = arrow::MakeArray(data);
+  return arrow::Status::OK();
+}
+
 extern "C"
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
   CStatus st;
@@ -310,6 +361,12 @@ CStatus FinishPayloadWriter(CPayloadWriter payloadWriter) {
       st.error_msg = ErrorMsg(ast.message());
       return st;
     }
+    ast = SetValidData(p->valid_data, &array);
+    if (!ast.ok()) {
+      st.error_code = static_cast<int>(ErrorCode::UNEXPECTED_ERROR);
+      st.error_msg = ErrorMsg(ast.message());
+      return st;
+    }
     auto table = arrow::Table::Make(p->schema, {array});
     p->output = std::make_shared<wrapper::PayloadOutputStream>();
     auto mem_pool = arrow::default_memory_pool();
@@ -354,6 +411,7 @@ extern "C"
 CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_size) {
   auto p = new wrapper::PayloadReader;
   p->bValues = nullptr;
+  p->validValues = nullptr;
   p->input = std::make_shared<wrapper::PayloadInputStream>(buffer, buf_size);
   auto mem_pool = arrow::default_memory_pool();
   auto st = parquet::arrow::OpenFile(p->input, mem_pool, &p->reader);
@@ -379,6 +437,8 @@ CPayloadReader NewPayloadReader(int columnType, uint8_t *buffer, int64_t buf_siz
     case ColumnType::FLOAT :
     case ColumnType::DOUBLE :
     case ColumnType::STRING :
+    case ColumnType::VARCHAR :
+    case ColumnType::JSON :
     case ColumnType::VECTOR_BINARY :
     case ColumnType::VECTOR_FLOAT : {
       break;
@@ -521,6 +581,30 @@ CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values,
   return st;
 }
 
+extern "C"
+CStatus GetValidDataFromPayload(CPayloadReader payloadReader, bool **valid_data, int *length) {
+  CStatus st;
+  st.error_code = static_cast<int>(ErrorCode::SUCCESS);
+  st.error_msg = nullptr;
+  auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
+  // no validity is returned if all rows are valid
+  if (p->array->null_count() == 0) {
+    *valid_data = nullptr;
+    *length = 0;
+    return st;
+  }
+  if (p->validValues == nullptr) {
+    int len = p->array->length();
+    p->validValues = new bool[len];
+    for (int i = 0; i < len; i++) {
+      p->validValues[i] = p->array->IsValid(i);
+    }
+  }
+  *valid_data = p->validValues;
+  *length = p->array->length();
+  return st;
+}
+
 extern "C"
 int GetPayloadLengthFromReader(CPayloadReader payloadReader) {
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
@@ -533,6 +617,7 @@ void ReleasePayloadReader(CPayloadReader payloadReader) {
   auto p = reinterpret_cast<wrapper::PayloadReader *>(payloadReader);
   if (p != nullptr) {
     delete[] p->bValues;
+    delete[] p->validValues;
     delete p;
   }
   arrow::default_memory_pool()->ReleaseUnused();
@@ -46,6 +46,7 @@ CStatus AddDoubleToPayload(CPayloadWriter payloadWriter, double *values, int len
 CStatus AddOneStringToPayload(CPayloadWriter payloadWriter, char *cstr, int str_size);
 CStatus AddBinaryVectorToPayload(CPayloadWriter payloadWriter, uint8_t *values, int dimension, int length);
 CStatus AddFloatVectorToPayload(CPayloadWriter payloadWriter, float *values, int dimension, int length);
+CStatus AddValidDataToPayload(CPayloadWriter payloadWriter, bool *valid_data, int length);
 
 CStatus FinishPayloadWriter(CPayloadWriter payloadWriter);
 CBuffer GetPayloadBufferFromWriter(CPayloadWriter payloadWriter);
@@ -65,6 +66,7 @@ CStatus GetDoubleFromPayload(CPayloadReader payloadReader, double **values, int
 CStatus GetOneStringFromPayload(CPayloadReader payloadReader, int idx, char **cstr, int *str_size);
 CStatus GetBinaryVectorFromPayload(CPayloadReader payloadReader, uint8_t **values, int *dimension, int *length);
 CStatus GetFloatVectorFromPayload(CPayloadReader payloadReader, float **values, int *dimension, int *length);
+CStatus GetValidDataFromPayload(CPayloadReader payloadReader, bool **valid_data, int *length);
 
 int GetPayloadLengthFromReader(CPayloadReader payloadReader);
 void ReleasePayloadReader(CPayloadReader payloadReader);
@@ -36,6 +36,7 @@ struct PayloadWriter {
   std::shared_ptr<arrow::Schema> schema;
   std::shared_ptr<PayloadOutputStream> output;
   int rows;
+  std::vector<bool> valid_data; // empty means all rows are valid
 };
 
 struct PayloadReader {
@@ -46,6 +47,7 @@ struct PayloadReader {
   std::shared_ptr<arrow::ChunkedArray> column;
   std::shared_ptr<arrow::Array> array;
   bool *bValues;
+  bool *validValues;
 };
 
 class PayloadOutputStream : public arrow::io::OutputStream {
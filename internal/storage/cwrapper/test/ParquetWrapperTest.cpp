@@ -319,3 +319,42 @@ TEST(wrapper, int8_2) {
   ASSERT_EQ(bool_array->Value(2), -100);
   ASSERT_EQ(bool_array->Value(3), 100);
 }
+
+TEST(wrapper, valid_data) {
+  auto payload = NewPayloadWriter(ColumnType::INT64);
+  int64_t data[] = {1, 2, 3, 4};
+  bool valid_data[] = {true, false, true, false};
+
+  auto st = AddInt64ToPayload(payload, data, 4);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddValidDataToPayload(payload, valid_data, 4);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = FinishPayloadWriter(payload);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  auto cb = GetPayloadBufferFromWriter(payload);
+  ASSERT_GT(cb.length, 0);
+
+  auto reader = NewPayloadReader(ColumnType::INT64, (uint8_t *) cb.data, cb.length);
+  ASSERT_NE(reader, nullptr);
+  bool *values;
+  int length;
+  st = GetValidDataFromPayload(reader, &values, &length);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  ASSERT_EQ(length, 4);
+  for (int i = 0; i < length; i++) {
+    ASSERT_EQ(valid_data[i], values[i]);
+  }
+  ReleasePayloadWriter(payload);
+  ReleasePayloadReader(reader);
+
+  // the valid data must cover all the rows
+  payload = NewPayloadWriter(ColumnType::INT64);
+  st = AddInt64ToPayload(payload, data, 4);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = AddValidDataToPayload(payload, valid_data, 2);
+  ASSERT_EQ(st.error_code, ErrorCode::SUCCESS);
+  st = FinishPayloadWriter(payload);
+  ASSERT_NE(st.error_code, ErrorCode::SUCCESS);
+  free((void *) st.error_msg);
+  ReleasePayloadWriter(payload);
+}
@@ -113,36 +113,49 @@ type FieldData interface {
 }
 
 type BoolFieldData struct {
-	NumRows []int64
-	Data    []bool
+	NumRows   []int64
+	Data      []bool
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type Int8FieldData struct {
-	NumRows []int64
-	Data    []int8
+	NumRows   []int64
+	Data      []int8
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type Int16FieldData struct {
-	NumRows []int64
-	Data    []int16
+	NumRows   []int64
+	Data      []int16
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type Int32FieldData struct {
-	NumRows []int64
-	Data    []int32
+	NumRows   []int64
+	Data      []int32
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type Int64FieldData struct {
-	NumRows []int64
-	Data    []int64
+	NumRows   []int64
+	Data      []int64
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type FloatFieldData struct {
-	NumRows []int64
-	Data    []float32
+	NumRows   []int64
+	Data      []float32
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type DoubleFieldData struct {
-	NumRows []int64
-	Data    []float64
+	NumRows   []int64
+	Data      []float64
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type StringFieldData struct {
-	NumRows []int64
-	Data    []string
+	NumRows   []int64
+	Data      []string
+	ValidData []bool // false means the row is null, empty means all rows are valid
+}
+type JSONFieldData struct {
+	NumRows   []int64
+	Data      [][]byte
+	ValidData []bool // false means the row is null, empty means all rows are valid
 }
 type BinaryVectorFieldData struct {
 	NumRows []int64
@@ -164,6 +177,7 @@ func (data *Int64FieldData) RowNum() int        { return len(data.Data) }
 func (data *FloatFieldData) RowNum() int        { return len(data.Data) }
 func (data *DoubleFieldData) RowNum() int       { return len(data.Data) }
 func (data *StringFieldData) RowNum() int       { return len(data.Data) }
+func (data *JSONFieldData) RowNum() int         { return len(data.Data) }
 func (data *BinaryVectorFieldData) RowNum() int { return len(data.Data) * 8 / data.Dim }
 func (data *FloatVectorFieldData) RowNum() int  { return len(data.Data) / data.Dim }
 
@@ -176,6 +190,7 @@ func (data *Int64FieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *FloatFieldData) GetRow(i int) interface{}  { return data.Data[i] }
 func (data *DoubleFieldData) GetRow(i int) interface{} { return data.Data[i] }
 func (data *StringFieldData) GetRow(i int) interface{} { return data.Data[i] }
+func (data *JSONFieldData) GetRow(i int) interface{}   { return data.Data[i] }
 func (data *BinaryVectorFieldData) GetRow(i int) interface{} {
 	return data.Data[i*data.Dim/8 : (i+1)*data.Dim/8]
 }
@@ -190,39 +205,47 @@ func (data *FloatVectorFieldData) GetRow(i int) interface{} {
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *BoolFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int8FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int16FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int32FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 // GetMemorySize implements FieldData.GetMemorySize
 func (data *Int64FieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *FloatFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *DoubleFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
 }
 
 func (data *StringFieldData) GetMemorySize() int {
-	return binary.Size(data.NumRows) + binary.Size(data.Data)
+	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.ValidData)
+}
+
+func (data *JSONFieldData) GetMemorySize() int {
+	size := binary.Size(data.NumRows) + binary.Size(data.ValidData)
+	for _, doc := range data.Data {
+		size += len(doc)
+	}
+	return size
 }
 
 func (data *BinaryVectorFieldData) GetMemorySize() int {
@@ -233,6 +256,91 @@ func (data *FloatVectorFieldData) GetMemorySize() int {
 	return binary.Size(data.NumRows) + binary.Size(data.Data) + binary.Size(data.Dim)
 }
 
+// GetValidData returns the validity of the rows of the field data, empty means all rows are valid
+func GetValidData(data FieldData) []bool {
+	switch d := data.(type) {
+	case *BoolFieldData:
+		return d.ValidData
+	case *Int8FieldData:
+		return d.ValidData
+	case *Int16FieldData:
+		return d.ValidData
+	case *Int32FieldData:
+		return d.ValidData
+	case *Int64FieldData:
+		return d.ValidData
+	case *FloatFieldData:
+		return d.ValidData
+	case *DoubleFieldData:
+		return d.ValidData
+	case *StringFieldData:
+		return d.ValidData
+	case *JSONFieldData:
+		return d.ValidData
+	default:
+		return nil
+	}
+}
+
+// IsNull returns whether the i-th row of the field data is null
+func IsNull(data FieldData, i int) bool {
+	validData := GetValidData(data)
+	return len(validData) > 0 && !validData[i]
+}
+
+// AppendValidData appends the validity of the last numRows rows of the field data,
+// empty validData means these rows are valid
+func AppendValidData(data FieldData, numRows int, validData []bool) error {
+	var dst *[]bool
+	switch d := data.(type) {
+	case *BoolFieldData:
+		dst = &d.ValidData
+	case *Int8FieldData:
+		dst = &d.ValidData
+	case *Int16FieldData:
+		dst = &d.ValidData
+	case *Int32FieldData:
+		dst = &d.ValidData
+	case *Int64FieldData:
+		dst = &d.ValidData
+	case *FloatFieldData:
+		dst = &d.ValidData
+	case *DoubleFieldData:
+		dst = &d.ValidData
+	case *StringFieldData:
+		dst = &d.ValidData
+	case *JSONFieldData:
+		dst = &d.ValidData
+	default:
+		if len(validData) > 0 {
+			return fmt.Errorf("null is not supported by %T", data)
+		}
+		return nil
+	}
+
+	if len(validData) == 0 {
+		if len(*dst) > 0 {
+			*dst = appendValid(*dst, numRows)
+		}
+		return nil
+	}
+	if len(validData) != numRows {
+		return fmt.Errorf("the number of valid data %d is not equal to the number of rows %d", len(validData), numRows)
+	}
+	if len(*dst) == 0 {
+		*dst = appendValid(*dst, data.RowNum()-numRows)
+	}
+	*dst = append(*dst, validData...)
+	return nil
+}
+
+func appendValid(validData []bool, n int) []bool {
+	for i := 0; i < n; i++ {
+		validData = append(validData, true)
+	}
+	return validData
+}
+
 // system filed id:
 // 0: unique row id
 // 1: timestamp
@@ -360,7 +468,7 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				return nil, nil, err
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*DoubleFieldData).GetMemorySize()))
-		case schemapb.DataType_String:
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			for _, singleString := range singleData.(*StringFieldData).Data {
 				err = eventWriter.AddOneStringToPayload(singleString)
 				if err != nil {
@@ -370,6 +478,16 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				}
 			}
 			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*StringFieldData).GetMemorySize()))
+		case schemapb.DataType_JSON:
+			for _, singleDoc := range singleData.(*JSONFieldData).Data {
+				err = eventWriter.AddOneJSONToPayload(singleDoc)
+				if err != nil {
+					eventWriter.Close()
+					writer.Close()
+					return nil, nil, err
+				}
+			}
+			writer.AddExtra(originalSizeKey, fmt.Sprintf("%v", singleData.(*JSONFieldData).GetMemorySize()))
 		case schemapb.DataType_BinaryVector:
 			err = eventWriter.AddBinaryVectorToPayload(singleData.(*BinaryVectorFieldData).Data, singleData.(*BinaryVectorFieldData).Dim)
 			if err != nil {
@@ -392,6 +510,13 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 		if err != nil {
 			return nil, nil, err
 		}
+		// the validity of a nullable field is kept in the payload as the nulls of the column
+		err = eventWriter.AddValidDataToPayload(GetValidData(singleData))
+		if err != nil {
+			eventWriter.Close()
+			writer.Close()
+			return nil, nil, err
+		}
 		writer.SetEventTimeStamp(typeutil.Timestamp(startTs), typeutil.Timestamp(endTs))
 
 		err = writer.Finish()
@@ -428,6 +553,17 @@ func (insertCodec *InsertCodec) Serialize(partitionID UniqueID, segmentID Unique
 				Key:   blobKey,
 				Value: statsBuffer,
 			})
+		case schemapb.DataType_VarChar:
+			statsWriter := &StatsWriter{}
+			err = statsWriter.StatsVarChar(field.FieldID, field.IsPrimaryKey, singleData.(*StringFieldData).Data)
+			if err != nil {
+				return nil, nil, err
+			}
+			statsBuffer := statsWriter.GetBuffer()
+			statsBlobs = append(statsBlobs, &Blob{
+				Key:   blobKey,
+				Value: statsBuffer,
+			})
 		}
 	}
 
@@ -465,6 +601,7 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 		dataType := binlogReader.PayloadDataType
 		fieldID := binlogReader.FieldID
 		totalLength := 0
+		var validData []bool
 		for {
 			eventReader, err := binlogReader.NextEventReader()
 			if err != nil {
@@ -621,7 +758,7 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				totalLength += length
 				doubleFieldData.NumRows = append(doubleFieldData.NumRows, int64(length))
 				resultData.Data[fieldID] = doubleFieldData
-			case schemapb.DataType_String:
+			case schemapb.DataType_String, schemapb.DataType_VarChar:
 				if resultData.Data[fieldID] == nil {
 					resultData.Data[fieldID] = &StringFieldData{}
 				}
@@ -644,6 +781,29 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 					stringFieldData.Data = append(stringFieldData.Data, singleString)
 				}
 				resultData.Data[fieldID] = stringFieldData
+			case schemapb.DataType_JSON:
+				if resultData.Data[fieldID] == nil {
+					resultData.Data[fieldID] = &JSONFieldData{}
+				}
+				jsonFieldData := resultData.Data[fieldID].(*JSONFieldData)
+				length, err := eventReader.GetPayloadLengthFromReader()
+				if err != nil {
+					eventReader.Close()
+					binlogReader.Close()
+					return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+				}
+				totalLength += length
+				jsonFieldData.NumRows = append(jsonFieldData.NumRows, int64(length))
+				for i := 0; i < length; i++ {
+					singleDoc, err := eventReader.GetOneJSONFromPayload(i)
+					if err != nil {
+						eventReader.Close()
+						binlogReader.Close()
+						return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+					}
+					jsonFieldData.Data = append(jsonFieldData.Data, singleDoc)
+				}
+				resultData.Data[fieldID] = jsonFieldData
 			case schemapb.DataType_BinaryVector:
 				if resultData.Data[fieldID] == nil {
 					resultData.Data[fieldID] = &BinaryVectorFieldData{}
@@ -693,8 +853,31 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("undefined data type %d", dataType)
 			}
+			eventValidData, err := eventReader.GetValidDataFromPayload()
+			if err != nil {
+				eventReader.Close()
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+			if len(eventValidData) > 0 {
+				// the rows of the previous events without nulls are valid
+				for len(validData) < totalLength-len(eventValidData) {
+					validData = append(validData, true)
+				}
+				validData = append(validData, eventValidData...)
+			}
 			eventReader.Close()
 		}
+		if fieldData, ok := resultData.Data[fieldID]; ok {
+			for len(validData) > 0 && len(validData) < totalLength {
+				validData = append(validData, true)
+			}
+			err = AppendValidData(fieldData, totalLength, validData)
+			if err != nil {
+				binlogReader.Close()
+				return InvalidUniqueID, InvalidUniqueID, InvalidUniqueID, nil, err
+			}
+		}
 		if fieldID == rootcoord.TimeStampField {
 			blobInfo := BlobInfo{
 				Length: totalLength,
@@ -704,9 +887,79 @@ func (insertCodec *InsertCodec) DeserializeAll(blobs []*Blob) (
 		binlogReader.Close()
 	}
 
+	if insertCodec.Schema != nil {
+		fillDefaultFieldData(insertCodec.Schema.GetSchema(), resultData)
+	}
+
 	return cID, pID, sID, resultData, nil
 }
 
+// fillDefaultFieldData fills the default values of the fields which have no binlog,
+// these fields are added to the schema after the binlogs are written
+func fillDefaultFieldData(schema *schemapb.CollectionSchema, data *InsertData) {
+	tsData, ok := data.Data[rootcoord.TimeStampField]
+	if !ok {
+		return
+	}
+	rowNum := tsData.RowNum()
+	numRows := []int64{int64(rowNum)}
+	for _, field := range schema.GetFields() {
+		if _, ok := data.Data[field.GetFieldID()]; ok {
+			continue
+		}
+		switch v := typeutil.GetDefaultValue(field).(type) {
+		case bool:
+			fieldData := &BoolFieldData{NumRows: numRows, Data: make([]bool, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case int8:
+			fieldData := &Int8FieldData{NumRows: numRows, Data: make([]int8, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case int16:
+			fieldData := &Int16FieldData{NumRows: numRows, Data: make([]int16, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case int32:
+			fieldData := &Int32FieldData{NumRows: numRows, Data: make([]int32, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case int64:
+			fieldData := &Int64FieldData{NumRows: numRows, Data: make([]int64, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case float32:
+			fieldData := &FloatFieldData{NumRows: numRows, Data: make([]float32, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case float64:
+			fieldData := &DoubleFieldData{NumRows: numRows, Data: make([]float64, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		case string:
+			fieldData := &StringFieldData{NumRows: numRows, Data: make([]string, rowNum)}
+			for i := range fieldData.Data {
+				fieldData.Data[i] = v
+			}
+			data.Data[field.GetFieldID()] = fieldData
+		}
+	}
+}
+
 // Deserialize transfer blob back to insert data.
 // From schema, it get all fields.
 // For each field, it will create a binlog reader, and read all event to the buffer.
@@ -719,13 +972,13 @@ func (insertCodec *InsertCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 // DeleteData saves each entity delete message represented as <primarykey,timestamp> map.
 // timestamp represents the time when this instance was deleted
 type DeleteData struct {
-	Pks      []int64     // primary keys
-	Tss      []Timestamp // timestamps
+	Pks      []PrimaryKey // primary keys
+	Tss      []Timestamp  // timestamps
 	RowCount int64
 }
 
 // Append append 1 pk&ts pair to DeleteData
-func (data *DeleteData) Append(pk UniqueID, ts Timestamp) {
+func (data *DeleteData) Append(pk PrimaryKey, ts Timestamp) {
 	data.Pks = append(data.Pks, pk)
 	data.Tss = append(data.Tss, ts)
 	data.RowCount++
@@ -741,7 +994,7 @@ func NewDeleteCodec() *DeleteCodec {
 }
 
 // Serialize transfer delete data to blob. .
-// For each delete message, it will save "pk,ts" string to binlog.
+// For each delete message, it will save "pk,ts" string to binlog, the varchar pk is quoted.
 func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID UniqueID, segmentID UniqueID, data *DeleteData) (*Blob, error) {
 	binlogWriter := NewDeleteBinlogWriter(schemapb.DataType_String, collectionID, partitionID, segmentID)
 	eventWriter, err := binlogWriter.NextDeleteEventWriter()
@@ -770,11 +1023,21 @@ func (deleteCodec *DeleteCodec) Serialize(collectionID UniqueID, partitionID Uni
 		if ts > endTs {
 			endTs = ts
 		}
-		err := eventWriter.AddOneStringToPayload(fmt.Sprintf("%d,%d", pk, ts))
+		var row string
+		switch v := pk.(type) {
+		case Int64PrimaryKey:
+			row = fmt.Sprintf("%d,%d", v.Value, ts)
+			sizeTotal += binary.Size(v.Value)
+		case VarCharPrimaryKey:
+			row = fmt.Sprintf("%s,%d", strconv.Quote(v.Value), ts)
+			sizeTotal += len(v.Value)
+		default:
+			return nil, fmt.Errorf("unsupported primary key %v", pk)
+		}
+		err := eventWriter.AddOneStringToPayload(row)
 		if err != nil {
 			return nil, err
 		}
-		sizeTotal += binary.Size(pk)
 		sizeTotal += binary.Size(ts)
 	}
 	eventWriter.SetEventTimestamp(startTs, endTs)
@@ -837,21 +1100,22 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			splits := strings.Split(singleString, ",")
-			if len(splits) != 2 {
+			// the varchar pk may contain commas, so split the row at the last comma
+			sep := strings.LastIndex(singleString, ",")
+			if sep < 0 {
 				eventReader.Close()
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, nil, fmt.Errorf("the format of delta log is incorrect")
 			}
 
-			pk, err := strconv.ParseInt(splits[0], 10, 64)
+			pk, err := parseDeltaLogPrimaryKey(singleString[:sep])
 			if err != nil {
 				eventReader.Close()
 				binlogReader.Close()
 				return InvalidUniqueID, InvalidUniqueID, nil, err
 			}
 
-			ts, err := strconv.ParseUint(splits[1], 10, 64)
+			ts, err := strconv.ParseUint(singleString[sep+1:], 10, 64)
 			if err != nil {
 				eventReader.Close()
 				binlogReader.Close()
@@ -870,6 +1134,22 @@ func (deleteCodec *DeleteCodec) Deserialize(blobs []*Blob) (partitionID UniqueID
 	return pid, sid, result, nil
 }
 
+// parseDeltaLogPrimaryKey parses the pk of a delta log row, the quoted pk is a varchar pk
+func parseDeltaLogPrimaryKey(s string) (PrimaryKey, error) {
+	if strings.HasPrefix(s, "\"") {
+		v, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, err
+		}
+		return NewVarCharPrimaryKey(v), nil
+	}
+	v, err := strconv.ParseInt(s, 10, 64)
+	if err != nil {
+		return nil, err
+	}
+	return NewInt64PrimaryKey(v), nil
+}
+
 // DataDefinitionCodec serializes and deserializes the data definition
 // Blob key example:
 // ${tenant}/data_definition_log/${collection_id}/ts/${log_idx}
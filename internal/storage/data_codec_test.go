@@ -20,10 +20,12 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/golang/protobuf/proto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/stretchr/testify/assert"
@@ -259,16 +261,16 @@ func TestInsertCodec(t *testing.T) {
 
 	insertDataEmpty := &InsertData{
 		Data: map[int64]FieldData{
-			RowIDField:        &Int64FieldData{[]int64{}, []int64{}},
-			TimestampField:    &Int64FieldData{[]int64{}, []int64{}},
-			BoolField:         &BoolFieldData{[]int64{}, []bool{}},
-			Int8Field:         &Int8FieldData{[]int64{}, []int8{}},
-			Int16Field:        &Int16FieldData{[]int64{}, []int16{}},
-			Int32Field:        &Int32FieldData{[]int64{}, []int32{}},
-			Int64Field:        &Int64FieldData{[]int64{}, []int64{}},
-			FloatField:        &FloatFieldData{[]int64{}, []float32{}},
-			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}},
-			StringField:       &StringFieldData{[]int64{}, []string{}},
+			RowIDField:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			TimestampField:    &Int64FieldData{[]int64{}, []int64{}, nil},
+			BoolField:         &BoolFieldData{[]int64{}, []bool{}, nil},
+			Int8Field:         &Int8FieldData{[]int64{}, []int8{}, nil},
+			Int16Field:        &Int16FieldData{[]int64{}, []int16{}, nil},
+			Int32Field:        &Int32FieldData{[]int64{}, []int32{}, nil},
+			Int64Field:        &Int64FieldData{[]int64{}, []int64{}, nil},
+			FloatField:        &FloatFieldData{[]int64{}, []float32{}, nil},
+			DoubleField:       &DoubleFieldData{[]int64{}, []float64{}, nil},
+			StringField:       &StringFieldData{[]int64{}, []string{}, nil},
 			BinaryVectorField: &BinaryVectorFieldData{[]int64{}, []byte{}, 8},
 			FloatVectorField:  &FloatVectorFieldData{[]int64{}, []float32{}, 4},
 		},
@@ -336,15 +338,147 @@ func TestInsertCodec(t *testing.T) {
 	assert.Nil(t, err)
 }
 
+func TestInsertCodecFillDefaultValue(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+		},
+	}
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: schema})
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+		},
+	}
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	// the field is added after the binlogs are written
+	newSchema := proto.Clone(schema).(*schemapb.CollectionSchema)
+	newSchema.Fields = append(newSchema.Fields, &schemapb.FieldSchema{
+		FieldID:      Int32Field,
+		Name:         "field_int32",
+		DataType:     schemapb.DataType_Int32,
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+	}, &schemapb.FieldSchema{
+		FieldID:  FloatField,
+		Name:     "field_float",
+		DataType: schemapb.DataType_Float,
+	})
+	newSchema.Version = 2
+	insertCodec = NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: newSchema})
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, &Int32FieldData{NumRows: []int64{2}, Data: []int32{7, 7}}, resultData.Data[Int32Field])
+	// no default value to fill
+	_, ok := resultData.Data[FloatField]
+	assert.False(t, ok)
+
+	// no schema to fill with
+	_, _, resultData, err = NewInsertCodec(nil).Deserialize(blobs)
+	assert.Nil(t, err)
+	_, ok = resultData.Data[Int32Field]
+	assert.False(t, ok)
+}
+
+func TestInsertCodecVarCharPrimaryKey(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{
+				FieldID:      StringField,
+				Name:         "field_varchar",
+				IsPrimaryKey: true,
+				DataType:     schemapb.DataType_VarChar,
+				TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "36"}},
+			},
+		},
+	}
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: schema})
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			TimestampField: &Int64FieldData{NumRows: []int64{2}, Data: []int64{1, 2}},
+			StringField:    &StringFieldData{NumRows: []int64{2}, Data: []string{"b", "a"}},
+		},
+	}
+	blobs, statsBlobs, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"b", "a"}, resultData.Data[StringField].(*StringFieldData).Data)
+
+	stats, err := DeserializeStats(statsBlobs)
+	assert.Nil(t, err)
+	for _, stat := range stats {
+		if stat.FieldID != StringField {
+			continue
+		}
+		assert.Equal(t, NewVarCharPrimaryKey("b"), stat.Max)
+		assert.Equal(t, NewVarCharPrimaryKey("a"), stat.Min)
+		assert.True(t, stat.BF.TestString("a"))
+	}
+}
+
+func TestInsertCodecValidData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: RowIDField, Name: "row_id", DataType: schemapb.DataType_Int64},
+			{FieldID: TimestampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{FieldID: Int64Field, Name: "field_int64", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: Int32Field, Name: "field_int32", DataType: schemapb.DataType_Int32, Nullable: true},
+		},
+	}
+	insertCodec := NewInsertCodec(&etcdpb.CollectionMeta{ID: CollectionID, Schema: schema})
+	insertData := &InsertData{
+		Data: map[int64]FieldData{
+			RowIDField:     &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			TimestampField: &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			Int64Field:     &Int64FieldData{NumRows: []int64{3}, Data: []int64{1, 2, 3}},
+			Int32Field:     &Int32FieldData{NumRows: []int64{3}, Data: []int32{1, 0, 3}, ValidData: []bool{true, false, true}},
+		},
+	}
+	blobs, _, err := insertCodec.Serialize(PartitionID, SegmentID, insertData)
+	assert.Nil(t, err)
+	_, _, resultData, err := insertCodec.Deserialize(blobs)
+	assert.Nil(t, err)
+	assert.Equal(t, []bool{true, false, true}, GetValidData(resultData.Data[Int32Field]))
+	assert.True(t, IsNull(resultData.Data[Int32Field], 1))
+	assert.Nil(t, GetValidData(resultData.Data[Int64Field]))
+}
+
+func TestAppendValidData(t *testing.T) {
+	data := &Int64FieldData{Data: []int64{1, 2}}
+	assert.Nil(t, AppendValidData(data, 2, nil))
+	assert.Nil(t, data.ValidData)
+
+	data.Data = append(data.Data, 3)
+	assert.Nil(t, AppendValidData(data, 1, []bool{false}))
+	assert.Equal(t, []bool{true, true, false}, data.ValidData)
+
+	data.Data = append(data.Data, 4)
+	assert.Nil(t, AppendValidData(data, 1, nil))
+	assert.Equal(t, []bool{true, true, false, true}, data.ValidData)
+
+	assert.Error(t, AppendValidData(data, 1, []bool{true, false}))
+	assert.Error(t, AppendValidData(&FloatVectorFieldData{}, 1, []bool{false}))
+}
+
 func TestDeleteCodec(t *testing.T) {
 	deleteCodec := NewDeleteCodec()
 	deleteData := &DeleteData{
-		Pks:      []int64{1},
+		Pks:      []PrimaryKey{NewInt64PrimaryKey(1)},
 		Tss:      []uint64{43757345},
 		RowCount: int64(1),
 	}
 
-	deleteData.Append(2, 23578294723)
+	deleteData.Append(NewInt64PrimaryKey(2), 23578294723)
 	blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
 	assert.Nil(t, err)
 
@@ -355,6 +489,20 @@ func TestDeleteCodec(t *testing.T) {
 	assert.Equal(t, data, deleteData)
 }
 
+func TestDeleteCodec_VarChar(t *testing.T) {
+	deleteCodec := NewDeleteCodec()
+	deleteData := &DeleteData{}
+	deleteData.Append(NewVarCharPrimaryKey("3f2b6c1e-8a4d-4e8f-9c0b-1d2e3f4a5b6c"), 43757345)
+	deleteData.Append(NewVarCharPrimaryKey("a,\"b\""), 23578294723)
+
+	blob, err := deleteCodec.Serialize(CollectionID, 1, 1, deleteData)
+	assert.Nil(t, err)
+
+	_, _, data, err := deleteCodec.Deserialize([]*Blob{blob})
+	assert.Nil(t, err)
+	assert.Equal(t, deleteData, data)
+}
+
 func TestDDCodec(t *testing.T) {
 	dataDefinitionCodec := NewDataDefinitionCodec(int64(1))
 	ts := []Timestamp{1, 2, 3, 4}
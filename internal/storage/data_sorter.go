@@ -77,9 +77,12 @@ func (ds *DataSorter) Swap(i, j int) {
 		case schemapb.DataType_Double:
 			data := singleData.(*DoubleFieldData).Data
 			data[i], data[j] = data[j], data[i]
-		case schemapb.DataType_String:
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			data := singleData.(*StringFieldData).Data
 			data[i], data[j] = data[j], data[i]
+		case schemapb.DataType_JSON:
+			data := singleData.(*JSONFieldData).Data
+			data[i], data[j] = data[j], data[i]
 		case schemapb.DataType_BinaryVector:
 			data := singleData.(*BinaryVectorFieldData).Data
 			dim := singleData.(*BinaryVectorFieldData).Dim
@@ -96,6 +99,9 @@ func (ds *DataSorter) Swap(i, j int) {
 			errMsg := "undefined data type " + string(field.DataType)
 			panic(errMsg)
 		}
+		if validData := GetValidData(singleData); len(validData) > 0 {
+			validData[i], validData[j] = validData[j], validData[i]
+		}
 	}
 }
 
@@ -18,6 +18,7 @@ package storage
 
 import (
 	"errors"
+	"io"
 	"io/ioutil"
 	"os"
 	"path"
@@ -108,3 +109,34 @@ func (lcm *LocalChunkManager) ReadAt(key string, p []byte, off int64) (n int, er
 
 	return at.ReadAt(p, off)
 }
+
+// Size returns the size of the local file.
+func (lcm *LocalChunkManager) Size(key string) (int64, error) {
+	info, err := os.Stat(path.Join(lcm.localPath, key))
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// Reader returns a reader of the local file.
+func (lcm *LocalChunkManager) Reader(key string) (io.ReadCloser, error) {
+	return os.Open(path.Clean(path.Join(lcm.localPath, key)))
+}
+
+// WriteFrom copies the data read from reader to local storage.
+func (lcm *LocalChunkManager) WriteFrom(key string, reader io.Reader, size int64) error {
+	filePath := path.Join(lcm.localPath, key)
+	if err := os.MkdirAll(path.Dir(filePath), os.ModePerm); err != nil {
+		return err
+	}
+	file, err := os.OpenFile(path.Clean(filePath), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.CopyN(file, reader, size); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
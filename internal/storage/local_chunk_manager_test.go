@@ -17,6 +17,8 @@
 package storage
 
 import (
+	"bytes"
+	"io/ioutil"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,3 +57,28 @@ func TestLocalChunkManager_Read(t *testing.T) {
 	assert.Nil(t, err)
 	assert.Equal(t, len(res), len(bin))
 }
+
+func TestLocalChunkManager_Stream(t *testing.T) {
+	lcm := NewLocalChunkManager(localPath)
+	_, err := lcm.Size("invalid")
+	assert.Error(t, err)
+	_, err = lcm.Reader("invalid")
+	assert.Error(t, err)
+
+	bin := []byte{1, 2, 3}
+	err = lcm.WriteFrom("stream/1", bytes.NewReader(bin), int64(len(bin)))
+	assert.Nil(t, err)
+	size, err := lcm.Size("stream/1")
+	assert.Nil(t, err)
+	assert.EqualValues(t, len(bin), size)
+	reader, err := lcm.Reader("stream/1")
+	assert.Nil(t, err)
+	defer reader.Close()
+	res, err := ioutil.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, bin, res)
+
+	// the reader has less data than the size
+	err = lcm.WriteFrom("stream/2", bytes.NewReader(bin), int64(len(bin)+1))
+	assert.Error(t, err)
+}
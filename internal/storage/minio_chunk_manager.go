@@ -76,3 +76,18 @@ func (mcm *MinioChunkManager) ReadAt(key string, p []byte, off int64) (int, erro
 
 	return n, nil
 }
+
+// Size returns the size of the minio storage data.
+func (mcm *MinioChunkManager) Size(key string) (int64, error) {
+	return mcm.minio.GetSize(key)
+}
+
+// Reader returns a reader streaming the minio storage data.
+func (mcm *MinioChunkManager) Reader(key string) (io.ReadCloser, error) {
+	return mcm.minio.LoadReader(key)
+}
+
+// WriteFrom streams the data read from reader to minio storage.
+func (mcm *MinioChunkManager) WriteFrom(key string, reader io.Reader, size int64) error {
+	return mcm.minio.SaveReader(key, reader, size)
+}
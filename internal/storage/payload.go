@@ -33,6 +33,7 @@ import (
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 )
 
 // PayloadWriterInterface abstracts PayloadWriter
@@ -47,8 +48,10 @@ type PayloadWriterInterface interface {
 	AddFloatToPayload(msgs []float32) error
 	AddDoubleToPayload(msgs []float64) error
 	AddOneStringToPayload(msgs string) error
+	AddOneJSONToPayload(msg []byte) error
 	AddBinaryVectorToPayload(binVec []byte, dim int) error
 	AddFloatVectorToPayload(binVec []float32, dim int) error
+	AddValidDataToPayload(validData []bool) error
 	FinishPayloadWriter() error
 	GetPayloadBufferFromWriter() ([]byte, error)
 	GetPayloadLengthFromWriter() (int, error)
@@ -68,8 +71,10 @@ type PayloadReaderInterface interface {
 	GetFloatFromPayload() ([]float32, error)
 	GetDoubleFromPayload() ([]float64, error)
 	GetOneStringFromPayload(idx int) (string, error)
+	GetOneJSONFromPayload(idx int) ([]byte, error)
 	GetBinaryVectorFromPayload() ([]byte, int, error)
 	GetFloatVectorFromPayload() ([]float32, int, error)
+	GetValidDataFromPayload() ([]bool, error)
 	GetPayloadLengthFromReader() (int, error)
 	ReleasePayloadReader()
 	Close()
@@ -143,12 +148,18 @@ func (w *PayloadWriter) AddDataToPayload(msgs interface{}, dim ...int) error {
 				return errors.New("incorrect data type")
 			}
 			return w.AddDoubleToPayload(val)
-		case schemapb.DataType_String:
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			val, ok := msgs.(string)
 			if !ok {
 				return errors.New("incorrect data type")
 			}
 			return w.AddOneStringToPayload(val)
+		case schemapb.DataType_JSON:
+			val, ok := msgs.([]byte)
+			if !ok {
+				return errors.New("incorrect data type")
+			}
+			return w.AddOneJSONToPayload(val)
 		default:
 			return errors.New("incorrect datatype")
 		}
@@ -292,6 +303,21 @@ func (w *PayloadWriter) AddOneStringToPayload(msg string) error {
 	return HandleCStatus(&status, "AddOneStringToPayload failed")
 }
 
+// AddOneJSONToPayload adds one serialized json document, which is stored as a string in the payload
+func (w *PayloadWriter) AddOneJSONToPayload(msg []byte) error {
+	length := len(msg)
+	if length == 0 {
+		return errors.New("can't add empty json into payload")
+	}
+
+	cmsg := C.CString(string(msg))
+	clength := C.int(length)
+	defer C.free(unsafe.Pointer(cmsg))
+
+	status := C.AddOneStringToPayload(w.payloadWriterPtr, cmsg, clength)
+	return HandleCStatus(&status, "AddOneJSONToPayload failed")
+}
+
 // AddBinaryVectorToPayload dimension > 0 && (%8 == 0)
 func (w *PayloadWriter) AddBinaryVectorToPayload(binVec []byte, dim int) error {
 	length := len(binVec)
@@ -328,6 +354,21 @@ func (w *PayloadWriter) AddFloatVectorToPayload(floatVec []float32, dim int) err
 	return HandleCStatus(&status, "AddFloatVectorToPayload failed")
 }
 
+// AddValidDataToPayload adds the validity of the rows of a nullable field, false means the row is null,
+// the validity is kept in the payload as the nulls of the column
+func (w *PayloadWriter) AddValidDataToPayload(validData []bool) error {
+	length := len(validData)
+	if length == 0 {
+		return nil
+	}
+
+	cValidData := (*C.bool)(unsafe.Pointer(&validData[0]))
+	cLength := C.int(length)
+
+	status := C.AddValidDataToPayload(w.payloadWriterPtr, cValidData, cLength)
+	return HandleCStatus(&status, "AddValidDataToPayload failed")
+}
+
 func (w *PayloadWriter) FinishPayloadWriter() error {
 	status := C.FinishPayloadWriter(w.payloadWriterPtr)
 	return HandleCStatus(&status, "FinishPayloadWriter failed")
@@ -385,9 +426,12 @@ func (r *PayloadReader) GetDataFromPayload(idx ...int) (interface{}, int, error)
 	switch len(idx) {
 	case 1:
 		switch r.colType {
-		case schemapb.DataType_String:
+		case schemapb.DataType_String, schemapb.DataType_VarChar:
 			val, err := r.GetOneStringFromPayload(idx[0])
 			return val, 0, err
+		case schemapb.DataType_JSON:
+			val, err := r.GetOneJSONFromPayload(idx[0])
+			return val, 0, err
 		default:
 			return nil, 0, errors.New("unknown type")
 		}
@@ -571,7 +615,7 @@ func (r *PayloadReader) GetDoubleFromPayload() ([]float64, error) {
 }
 
 func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
-	if r.colType != schemapb.DataType_String {
+	if !typeutil.IsStringType(r.colType) {
 		return "", errors.New("incorrect data type")
 	}
 
@@ -585,6 +629,22 @@ func (r *PayloadReader) GetOneStringFromPayload(idx int) (string, error) {
 	return C.GoStringN(cStr, cSize), nil
 }
 
+// GetOneJSONFromPayload returns the idx-th serialized json document
+func (r *PayloadReader) GetOneJSONFromPayload(idx int) ([]byte, error) {
+	if !typeutil.IsJSONType(r.colType) {
+		return nil, errors.New("incorrect data type")
+	}
+
+	var cStr *C.char
+	var cSize C.int
+
+	status := C.GetOneStringFromPayload(r.payloadReaderPtr, C.int(idx), &cStr, &cSize)
+	if err := HandleCStatus(&status, "GetOneJSONFromPayload failed"); err != nil {
+		return nil, err
+	}
+	return C.GoBytes(unsafe.Pointer(cStr), cSize), nil
+}
+
 // GetBinaryVectorFromPayload returns vector, dimension, error
 func (r *PayloadReader) GetBinaryVectorFromPayload() ([]byte, int, error) {
 	if r.colType != schemapb.DataType_BinaryVector {
@@ -625,6 +685,23 @@ func (r *PayloadReader) GetFloatVectorFromPayload() ([]float32, int, error) {
 	return slice, int(cDim), nil
 }
 
+// GetValidDataFromPayload returns the validity of the rows, empty means all rows are valid
+func (r *PayloadReader) GetValidDataFromPayload() ([]bool, error) {
+	var cValidData *C.bool
+	var cSize C.int
+
+	status := C.GetValidDataFromPayload(r.payloadReaderPtr, &cValidData, &cSize)
+	if err := HandleCStatus(&status, "GetValidDataFromPayload failed"); err != nil {
+		return nil, err
+	}
+	if cSize == 0 {
+		return nil, nil
+	}
+
+	slice := (*[1 << 28]bool)(unsafe.Pointer(cValidData))[:cSize:cSize]
+	return append([]bool{}, slice...), nil
+}
+
 func (r *PayloadReader) GetPayloadLengthFromReader() (int, error) {
 	length := C.GetPayloadLengthFromReader(r.payloadReaderPtr)
 	return int(length), nil
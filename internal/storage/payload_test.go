@@ -211,6 +211,49 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		defer r.ReleasePayloadReader()
 	})
 
+	t.Run("TestValidData", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_VarChar)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		for _, s := range []string{"a", "-", "c"} {
+			err = w.AddOneStringToPayload(s)
+			assert.Nil(t, err)
+		}
+		err = w.AddValidDataToPayload([]bool{true, false, true})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		defer w.ReleasePayloadWriter()
+
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_VarChar, buffer)
+		require.Nil(t, err)
+		defer r.ReleasePayloadReader()
+		validData, err := r.GetValidDataFromPayload()
+		assert.Nil(t, err)
+		assert.Equal(t, []bool{true, false, true}, validData)
+		str, err := r.GetOneStringFromPayload(2)
+		assert.Nil(t, err)
+		assert.Equal(t, "c", str)
+	})
+
+	t.Run("TestValidDataLengthMismatch", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_Int64)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+		defer w.Close()
+
+		err = w.AddInt64ToPayload([]int64{1, 2, 3})
+		assert.Nil(t, err)
+		err = w.AddValidDataToPayload([]bool{false})
+		assert.Nil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.NotNil(t, err)
+	})
+
 	t.Run("TestFloat32", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_Float)
 		require.Nil(t, err)
@@ -348,6 +391,45 @@ func TestPayload_ReaderandWriter(t *testing.T) {
 		w.ReleasePayloadWriter()
 	})
 
+	t.Run("TestAddOneJSON", func(t *testing.T) {
+		w, err := NewPayloadWriter(schemapb.DataType_JSON)
+		require.Nil(t, err)
+		require.NotNil(t, w)
+
+		err = w.AddOneJSONToPayload([]byte(`{"color":"red"}`))
+		assert.Nil(t, err)
+		err = w.AddDataToPayload([]byte(`{"price":10}`))
+		assert.Nil(t, err)
+		err = w.AddOneJSONToPayload([]byte{})
+		assert.NotNil(t, err)
+		err = w.FinishPayloadWriter()
+		assert.Nil(t, err)
+		length, err := w.GetPayloadLengthFromWriter()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		buffer, err := w.GetPayloadBufferFromWriter()
+		assert.Nil(t, err)
+
+		r, err := NewPayloadReader(schemapb.DataType_JSON, buffer)
+		assert.Nil(t, err)
+		length, err = r.GetPayloadLengthFromReader()
+		assert.Nil(t, err)
+		assert.Equal(t, length, 2)
+		doc0, err := r.GetOneJSONFromPayload(0)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"color":"red"}`), doc0)
+
+		idoc1, _, err := r.GetDataFromPayload(1)
+		assert.Nil(t, err)
+		assert.Equal(t, []byte(`{"price":10}`), idoc1.([]byte))
+
+		_, err = r.GetOneStringFromPayload(0)
+		assert.NotNil(t, err)
+
+		r.ReleasePayloadReader()
+		w.ReleasePayloadWriter()
+	})
+
 	t.Run("TestBinaryVector", func(t *testing.T) {
 		w, err := NewPayloadWriter(schemapb.DataType_BinaryVector)
 		require.Nil(t, err)
@@ -0,0 +1,184 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"fmt"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// PrimaryKey is the primary key of an entity, which is an int64 or a varchar,
+// the primary keys are comparable values, so they can be the keys of maps
+type PrimaryKey interface {
+	// GT returns whether the primary key is greater than key, the keys must be of the same type
+	GT(key PrimaryKey) bool
+	// LT returns whether the primary key is less than key, the keys must be of the same type
+	LT(key PrimaryKey) bool
+	// Bytes returns the bytes of the primary key added to bloom filters
+	Bytes() []byte
+	// GetValue returns the int64 or string value of the primary key
+	GetValue() interface{}
+	// Type returns the data type of the primary key
+	Type() schemapb.DataType
+}
+
+// Int64PrimaryKey is the primary key of int64
+type Int64PrimaryKey struct {
+	Value int64
+}
+
+// NewInt64PrimaryKey returns an int64 primary key
+func NewInt64PrimaryKey(v int64) Int64PrimaryKey {
+	return Int64PrimaryKey{Value: v}
+}
+
+// GT returns whether the primary key is greater than key
+func (pk Int64PrimaryKey) GT(key PrimaryKey) bool {
+	return pk.Value > key.(Int64PrimaryKey).Value
+}
+
+// LT returns whether the primary key is less than key
+func (pk Int64PrimaryKey) LT(key PrimaryKey) bool {
+	return pk.Value < key.(Int64PrimaryKey).Value
+}
+
+// Bytes returns the little endian bytes of the primary key
+func (pk Int64PrimaryKey) Bytes() []byte {
+	b := make([]byte, 8)
+	common.Endian.PutUint64(b, uint64(pk.Value))
+	return b
+}
+
+// GetValue returns the int64 value of the primary key
+func (pk Int64PrimaryKey) GetValue() interface{} {
+	return pk.Value
+}
+
+// Type returns DataType_Int64
+func (pk Int64PrimaryKey) Type() schemapb.DataType {
+	return schemapb.DataType_Int64
+}
+
+// VarCharPrimaryKey is the primary key of varchar
+type VarCharPrimaryKey struct {
+	Value string
+}
+
+// NewVarCharPrimaryKey returns a varchar primary key
+func NewVarCharPrimaryKey(v string) VarCharPrimaryKey {
+	return VarCharPrimaryKey{Value: v}
+}
+
+// GT returns whether the primary key is greater than key
+func (pk VarCharPrimaryKey) GT(key PrimaryKey) bool {
+	return pk.Value > key.(VarCharPrimaryKey).Value
+}
+
+// LT returns whether the primary key is less than key
+func (pk VarCharPrimaryKey) LT(key PrimaryKey) bool {
+	return pk.Value < key.(VarCharPrimaryKey).Value
+}
+
+// Bytes returns the bytes of the string of the primary key
+func (pk VarCharPrimaryKey) Bytes() []byte {
+	return []byte(pk.Value)
+}
+
+// GetValue returns the string value of the primary key
+func (pk VarCharPrimaryKey) GetValue() interface{} {
+	return pk.Value
+}
+
+// Type returns DataType_VarChar
+func (pk VarCharPrimaryKey) Type() schemapb.DataType {
+	return schemapb.DataType_VarChar
+}
+
+// NewPrimaryKey returns the primary key of an int64 or string value
+func NewPrimaryKey(v interface{}) (PrimaryKey, error) {
+	switch value := v.(type) {
+	case int64:
+		return NewInt64PrimaryKey(value), nil
+	case string:
+		return NewVarCharPrimaryKey(value), nil
+	default:
+		return nil, fmt.Errorf("unsupported primary key %v of type %T", v, v)
+	}
+}
+
+// ParseIDs2PrimaryKeys returns the primary keys in the ids
+func ParseIDs2PrimaryKeys(ids *schemapb.IDs) []PrimaryKey {
+	var pks []PrimaryKey
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		pks = make([]PrimaryKey, 0, len(ids.GetIntId().GetData()))
+		for _, v := range ids.GetIntId().GetData() {
+			pks = append(pks, NewInt64PrimaryKey(v))
+		}
+	case *schemapb.IDs_StrId:
+		pks = make([]PrimaryKey, 0, len(ids.GetStrId().GetData()))
+		for _, v := range ids.GetStrId().GetData() {
+			pks = append(pks, NewVarCharPrimaryKey(v))
+		}
+	}
+	return pks
+}
+
+// ParsePrimaryKeys2IDs returns the ids of the primary keys, which must be of the same type
+func ParsePrimaryKeys2IDs(pks []PrimaryKey) *schemapb.IDs {
+	ids := &schemapb.IDs{}
+	if len(pks) == 0 {
+		return ids
+	}
+	switch pks[0].Type() {
+	case schemapb.DataType_Int64:
+		data := make([]int64, 0, len(pks))
+		for _, pk := range pks {
+			data = append(data, pk.(Int64PrimaryKey).Value)
+		}
+		ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_VarChar:
+		data := make([]string, 0, len(pks))
+		for _, pk := range pks {
+			data = append(data, pk.(VarCharPrimaryKey).Value)
+		}
+		ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{Data: data}}
+	}
+	return ids
+}
+
+// GetPrimaryKeysFromFieldData returns the primary keys in the column of the primary key field
+func GetPrimaryKeysFromFieldData(data FieldData) ([]PrimaryKey, error) {
+	switch d := data.(type) {
+	case *Int64FieldData:
+		pks := make([]PrimaryKey, 0, len(d.Data))
+		for _, v := range d.Data {
+			pks = append(pks, NewInt64PrimaryKey(v))
+		}
+		return pks, nil
+	case *StringFieldData:
+		pks := make([]PrimaryKey, 0, len(d.Data))
+		for _, v := range d.Data {
+			pks = append(pks, NewVarCharPrimaryKey(v))
+		}
+		return pks, nil
+	default:
+		return nil, fmt.Errorf("unsupported primary key field data %T", data)
+	}
+}
@@ -0,0 +1,64 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package storage
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPrimaryKey(t *testing.T) {
+	assert.True(t, NewInt64PrimaryKey(2).GT(NewInt64PrimaryKey(1)))
+	assert.True(t, NewInt64PrimaryKey(1).LT(NewInt64PrimaryKey(2)))
+	assert.True(t, NewVarCharPrimaryKey("b").GT(NewVarCharPrimaryKey("a")))
+	assert.True(t, NewVarCharPrimaryKey("a").LT(NewVarCharPrimaryKey("b")))
+	assert.Equal(t, []byte("a"), NewVarCharPrimaryKey("a").Bytes())
+
+	// primary keys are comparable
+	m := map[PrimaryKey]int{NewInt64PrimaryKey(1): 1, NewVarCharPrimaryKey("1"): 2}
+	assert.Equal(t, 1, m[NewInt64PrimaryKey(1)])
+	assert.Equal(t, 2, m[NewVarCharPrimaryKey("1")])
+
+	pk, err := NewPrimaryKey("a")
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_VarChar, pk.Type())
+	pk, err = NewPrimaryKey(int64(1))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(1), pk.GetValue())
+	_, err = NewPrimaryKey(1.0)
+	assert.Error(t, err)
+}
+
+func TestParsePrimaryKeys(t *testing.T) {
+	pks := []PrimaryKey{NewVarCharPrimaryKey("a"), NewVarCharPrimaryKey("b")}
+	ids := ParsePrimaryKeys2IDs(pks)
+	assert.Equal(t, []string{"a", "b"}, ids.GetStrId().GetData())
+	assert.Equal(t, pks, ParseIDs2PrimaryKeys(ids))
+
+	pks = []PrimaryKey{NewInt64PrimaryKey(1), NewInt64PrimaryKey(2)}
+	ids = ParsePrimaryKeys2IDs(pks)
+	assert.Equal(t, []int64{1, 2}, ids.GetIntId().GetData())
+	assert.Equal(t, pks, ParseIDs2PrimaryKeys(ids))
+
+	pks, err := GetPrimaryKeysFromFieldData(&StringFieldData{Data: []string{"a"}})
+	assert.NoError(t, err)
+	assert.Equal(t, []PrimaryKey{NewVarCharPrimaryKey("a")}, pks)
+	_, err = GetPrimaryKeysFromFieldData(&FloatFieldData{Data: []float32{1}})
+	assert.Error(t, err)
+}
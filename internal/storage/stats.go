@@ -17,7 +17,10 @@
 package storage
 
 import (
+	"bytes"
 	"encoding/json"
+	"fmt"
+	"strconv"
 
 	"github.com/bits-and-blooms/bloom/v3"
 	"github.com/milvus-io/milvus/internal/common"
@@ -37,6 +40,23 @@ type Int64Stats struct {
 	BF      *bloom.BloomFilter `json:"bf"`
 }
 
+// VarCharStats contains statistics data for varchar column
+type VarCharStats struct {
+	FieldID int64              `json:"fieldID"`
+	Max     string             `json:"max"`
+	Min     string             `json:"min"`
+	BF      *bloom.BloomFilter `json:"bf"`
+}
+
+// PrimaryKeyStats contains statistics data for the primary key column, which is read from
+// the stats of an int64 column or a varchar column
+type PrimaryKeyStats struct {
+	FieldID int64
+	Max     PrimaryKey
+	Min     PrimaryKey
+	BF      *bloom.BloomFilter
+}
+
 // StatsWriter writes stats to buffer
 type StatsWriter struct {
 	buffer []byte
@@ -76,6 +96,41 @@ func (sw *StatsWriter) StatsInt64(fieldID int64, isPrimaryKey bool, msgs []int64
 	return nil
 }
 
+// StatsVarChar writes VarCharStats from @msgs with @fieldID to @buffer
+func (sw *StatsWriter) StatsVarChar(fieldID int64, isPrimaryKey bool, msgs []string) error {
+	if len(msgs) < 1 {
+		// return error: msgs must has one element at least
+		return nil
+	}
+
+	stats := &VarCharStats{
+		FieldID: fieldID,
+		Max:     msgs[0],
+		Min:     msgs[0],
+	}
+	for _, msg := range msgs {
+		if msg > stats.Max {
+			stats.Max = msg
+		}
+		if msg < stats.Min {
+			stats.Min = msg
+		}
+	}
+	if isPrimaryKey {
+		stats.BF = bloom.NewWithEstimates(bloomFilterSize, maxBloomFalsePositive)
+		for _, msg := range msgs {
+			stats.BF.AddString(msg)
+		}
+	}
+	b, err := json.Marshal(stats)
+	if err != nil {
+		return err
+	}
+	sw.buffer = b
+
+	return nil
+}
+
 // StatsReader reads stats
 type StatsReader struct {
 	buffer []byte
@@ -96,16 +151,55 @@ func (sr *StatsReader) GetInt64Stats() (*Int64Stats, error) {
 	return stats, nil
 }
 
-// DeserializeStats deserialize @blobs as []*Int64Stats
-func DeserializeStats(blobs []*Blob) ([]*Int64Stats, error) {
-	results := make([]*Int64Stats, 0, len(blobs))
+// GetPrimaryKeyStats returns buffer as PrimaryKeyStats, the buffer is written by StatsInt64 or StatsVarChar
+func (sr *StatsReader) GetPrimaryKeyStats() (*PrimaryKeyStats, error) {
+	raw := struct {
+		FieldID int64              `json:"fieldID"`
+		Max     json.RawMessage    `json:"max"`
+		Min     json.RawMessage    `json:"min"`
+		BF      *bloom.BloomFilter `json:"bf"`
+	}{}
+	err := json.Unmarshal(sr.buffer, &raw)
+	if err != nil {
+		return nil, err
+	}
+	stats := &PrimaryKeyStats{FieldID: raw.FieldID, BF: raw.BF}
+	if stats.Max, err = parseStatsPrimaryKey(raw.Max); err != nil {
+		return nil, err
+	}
+	if stats.Min, err = parseStatsPrimaryKey(raw.Min); err != nil {
+		return nil, err
+	}
+	return stats, nil
+}
+
+// parseStatsPrimaryKey parses the max or min of stats, a json string is a varchar primary key
+// and a json number is an int64 primary key
+func parseStatsPrimaryKey(raw json.RawMessage) (PrimaryKey, error) {
+	if bytes.HasPrefix(raw, []byte("\"")) {
+		var v string
+		if err := json.Unmarshal(raw, &v); err != nil {
+			return nil, err
+		}
+		return NewVarCharPrimaryKey(v), nil
+	}
+	v, err := strconv.ParseInt(string(raw), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid primary key %s in stats: %w", string(raw), err)
+	}
+	return NewInt64PrimaryKey(v), nil
+}
+
+// DeserializeStats deserialize @blobs as []*PrimaryKeyStats
+func DeserializeStats(blobs []*Blob) ([]*PrimaryKeyStats, error) {
+	results := make([]*PrimaryKeyStats, 0, len(blobs))
 	for _, blob := range blobs {
 		if blob.Value == nil {
 			continue
 		}
 		sr := &StatsReader{}
 		sr.SetBuffer(blob.Value)
-		stats, err := sr.GetInt64Stats()
+		stats, err := sr.GetPrimaryKeyStats()
 		if err != nil {
 			return nil, err
 		}
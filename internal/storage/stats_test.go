@@ -47,3 +47,42 @@ func TestStatsWriter_StatsInt64(t *testing.T) {
 	err = sw.StatsInt64(rootcoord.RowIDField, true, msgs)
 	assert.Nil(t, err)
 }
+
+func TestStatsWriter_StatsVarChar(t *testing.T) {
+	data := []string{"b", "c", "a"}
+	sw := &StatsWriter{}
+	err := sw.StatsVarChar(common.StartOfUserFieldID, true, data)
+	assert.NoError(t, err)
+	b := sw.GetBuffer()
+
+	sr := &StatsReader{}
+	sr.SetBuffer(b)
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.Nil(t, err)
+	assert.Equal(t, NewVarCharPrimaryKey("c"), stats.Max)
+	assert.Equal(t, NewVarCharPrimaryKey("a"), stats.Min)
+	for _, id := range data {
+		assert.True(t, stats.BF.Test(NewVarCharPrimaryKey(id).Bytes()))
+	}
+
+	err = sw.StatsVarChar(common.StartOfUserFieldID, true, []string{})
+	assert.Nil(t, err)
+}
+
+func TestStatsReader_GetPrimaryKeyStats(t *testing.T) {
+	sw := &StatsWriter{}
+	err := sw.StatsInt64(common.StartOfUserFieldID, true, []int64{1, 1 << 60})
+	assert.NoError(t, err)
+
+	sr := &StatsReader{}
+	sr.SetBuffer(sw.GetBuffer())
+	stats, err := sr.GetPrimaryKeyStats()
+	assert.Nil(t, err)
+	assert.Equal(t, NewInt64PrimaryKey(1<<60), stats.Max)
+	assert.Equal(t, NewInt64PrimaryKey(1), stats.Min)
+	assert.True(t, stats.BF.Test(NewInt64PrimaryKey(1).Bytes()))
+
+	sr.SetBuffer([]byte(`{"fieldID":100,"max":true,"min":1}`))
+	_, err = sr.GetPrimaryKeyStats()
+	assert.Error(t, err)
+}
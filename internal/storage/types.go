@@ -16,6 +16,8 @@
 
 package storage
 
+import "io"
+
 // ChunkManager is to manager chunks.
 // Include Read, Write, Remove chunks.
 type ChunkManager interface {
@@ -31,4 +33,10 @@ type ChunkManager interface {
 	// if all bytes are read, @err is io.EOF
 	// return other error if read failed
 	ReadAt(key string, p []byte, off int64) (n int, err error)
+	// Size returns the size of @key in bytes
+	Size(key string) (int64, error)
+	// Reader returns a reader streaming the content of @key, the caller should close it
+	Reader(key string) (io.ReadCloser, error)
+	// WriteFrom writes @size bytes read from @reader to @key without holding the whole content in memory
+	WriteFrom(key string, reader io.Reader, size int64) error
 }
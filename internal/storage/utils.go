@@ -27,6 +27,8 @@ import (
 	"github.com/milvus-io/milvus/internal/common"
 
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 
 	"github.com/milvus-io/milvus/internal/kv"
 )
@@ -169,7 +171,8 @@ func sortFieldDataList(ls fieldDataList) {
 //	- row id column must exist in insert data;
 //	- the row num of all column must be equal;
 //	- num_rows = len(RowData), a row will be assembled into the value of blob with field id order;
-func TransferColumnBasedInsertDataToRowBased(data *InsertData) (
+//	- the varchar or json value takes its length and the bytes, which are limited by max_length of the field in @schema;
+func TransferColumnBasedInsertDataToRowBased(data *InsertData, schema *schemapb.CollectionSchema) (
 	Timestamps []uint64,
 	RowIDs []int64,
 	RowData []*commonpb.Blob,
@@ -208,6 +211,18 @@ func TransferColumnBasedInsertDataToRowBased(data *InsertData) (
 
 	sortFieldDataList(ls)
 
+	maxLengths := make(map[FieldID]int)
+	for _, field := range schema.GetFields() {
+		if field.GetDataType() != schemapb.DataType_VarChar && field.GetDataType() != schemapb.DataType_JSON {
+			continue
+		}
+		maxLength, err := typeutil.GetMaxLength(field)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		maxLengths[field.GetFieldID()] = maxLength
+	}
+
 	numRows := tss.RowNum()
 	rows := make([]*commonpb.Blob, numRows)
 	for i := 0; i < numRows; i++ {
@@ -216,6 +231,19 @@ func TransferColumnBasedInsertDataToRowBased(data *InsertData) (
 
 		for j := 0; j < ls.Len(); j++ {
 			d := ls.datas[j].GetRow(i)
+			if _, ok := ls.datas[j].(*JSONFieldData); ok {
+				d = string(d.([]byte))
+			}
+			if s, ok := d.(string); ok {
+				maxLength, ok := maxLengths[ls.IDs[j]]
+				if !ok {
+					return nil, nil, nil,
+						fmt.Errorf("max length of string field %d is unknown", ls.IDs[j])
+				}
+				if d, err = typeutil.EncodeVarChar(s, maxLength); err != nil {
+					return nil, nil, nil, err
+				}
+			}
 			err := binary.Write(&buffer, common.Endian, d)
 			if err != nil {
 				return nil, nil, nil,
@@ -26,6 +26,8 @@ import (
 	"testing"
 
 	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/uniquegenerator"
 
@@ -464,7 +466,7 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 	}
 
 	// no ts
-	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data)
+	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data, nil)
 	assert.Error(t, err)
 
 	tss := &Int64FieldData{
@@ -473,7 +475,7 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 	data.Data[common.TimeStampField] = tss
 
 	// no row ids
-	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data)
+	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data, nil)
 	assert.Error(t, err)
 
 	rowIdsF := &Int64FieldData{
@@ -482,7 +484,7 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 	data.Data[common.RowIDField] = rowIdsF
 
 	// row num mismatch
-	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data)
+	_, _, _, err = TransferColumnBasedInsertDataToRowBased(data, nil)
 	assert.Error(t, err)
 
 	data.Data[common.RowIDField] = &Int64FieldData{
@@ -534,7 +536,7 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 	data.Data[109] = f9
 	data.Data[110] = f10
 
-	utss, rowIds, rows, err := TransferColumnBasedInsertDataToRowBased(data)
+	utss, rowIds, rows, err := TransferColumnBasedInsertDataToRowBased(data, nil)
 	assert.NoError(t, err)
 	assert.ElementsMatch(t, []uint64{1, 2, 3}, utss)
 	assert.ElementsMatch(t, []int64{1, 2, 3}, rowIds)
@@ -587,3 +589,39 @@ func TestTransferColumnBasedInsertDataToRowBased(t *testing.T) {
 			rows[2].Value)
 	}
 }
+
+func TestTransferColumnBasedInsertDataToRowBased_VarChar(t *testing.T) {
+	data := &InsertData{
+		Data: map[FieldID]FieldData{
+			common.TimeStampField: &Int64FieldData{Data: []int64{1, 2}},
+			common.RowIDField:     &Int64FieldData{Data: []int64{1, 2}},
+			101:                   &StringFieldData{Data: []string{"ab", "c"}},
+			102:                   &Int8FieldData{Data: []int8{1, 2}},
+			103:                   &JSONFieldData{Data: [][]byte{[]byte("{}"), []byte("[1]")}},
+		},
+	}
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{
+				FieldID:    101,
+				DataType:   schemapb.DataType_VarChar,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "3"}},
+			},
+			{FieldID: 102, DataType: schemapb.DataType_Int8},
+			{
+				FieldID:    103,
+				DataType:   schemapb.DataType_JSON,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "8"}},
+			},
+		},
+	}
+
+	// the max length is unknown
+	_, _, _, err := TransferColumnBasedInsertDataToRowBased(data, nil)
+	assert.Error(t, err)
+
+	_, _, rows, err := TransferColumnBasedInsertDataToRowBased(data, schema)
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2, 0, 0, 0, 'a', 'b', 1, 2, 0, 0, 0, '{', '}'}, rows[0].Value)
+	assert.Equal(t, []byte{1, 0, 0, 0, 'c', 2, 3, 0, 0, 0, '[', '1', ']'}, rows[1].Value)
+}
@@ -21,6 +21,7 @@ import (
 	"encoding/binary"
 	"errors"
 	"io"
+	"io/ioutil"
 
 	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/proto/etcdpb"
@@ -164,3 +165,35 @@ func (vcm *VectorChunkManager) ReadAt(key string, p []byte, off int64) (int, err
 
 	return n, nil
 }
+
+// Size returns the size of the pure vector data.
+func (vcm *VectorChunkManager) Size(key string) (int64, error) {
+	if vcm.localCacheEnable && vcm.localChunkManager.Exist(key) {
+		return vcm.localChunkManager.Size(key)
+	}
+	content, err := vcm.Read(key)
+	if err != nil {
+		return 0, err
+	}
+	return int64(len(content)), nil
+}
+
+// Reader returns a reader of the pure vector data. If cached, it reads from local.
+func (vcm *VectorChunkManager) Reader(key string) (io.ReadCloser, error) {
+	if vcm.localCacheEnable && vcm.localChunkManager.Exist(key) {
+		return vcm.localChunkManager.Reader(key)
+	}
+	content, err := vcm.Read(key)
+	if err != nil {
+		return nil, err
+	}
+	return ioutil.NopCloser(bytes.NewReader(content)), nil
+}
+
+// WriteFrom writes the vector data read from reader to local cache if cache enabled.
+func (vcm *VectorChunkManager) WriteFrom(key string, reader io.Reader, size int64) error {
+	if !vcm.localCacheEnable {
+		return errors.New("Cannot write local file for local cache is not allowed")
+	}
+	return vcm.localChunkManager.WriteFrom(key, reader, size)
+}
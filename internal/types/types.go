@@ -68,6 +68,9 @@ type DataNode interface {
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
 	// Compaction will add a compaction task according to the request plan
 	Compaction(ctx context.Context, req *datapb.CompactionPlan) (*commonpb.Status, error)
+	// Import parses the files of the import task in background and writes them into the segments allocated by DataCoord,
+	// the result of each segment is reported to DataCoord by `ReportImport`
+	Import(ctx context.Context, req *datapb.ImportTask) (*commonpb.Status, error)
 }
 
 // DataNodeComponent is used by grpc server of DataNode
@@ -256,6 +259,34 @@ type DataCoord interface {
 	// response status contains the status/error code and failing reason if any
 	// error is returned only when some communication issue occurs
 	DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest) (*datapb.DropVirtualChannelResponse, error)
+
+	// Import creates an import task which imports the files in the object storage into a collection,
+	// DataCoord allocates the segments and assigns the task to a DataNode
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the collection, partition and the paths of files
+	//
+	// response status contains the status/error code and failing reason if any, and the id of the task
+	// error is returned only when some communication issue occurs
+	Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+	// GetImportState gets the state of an import task and the state of each file
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
+	// ReportImport is called by DataNode when the files of a segment are imported or failed to import
+	ReportImport(ctx context.Context, req *datapb.ImportResult) (*commonpb.Status, error)
+
+	// AddFlushedSegments adds the segments whose binlogs are already in the object storage as flushed segments,
+	// it is used to restore the segments of a collection backup
+	AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error)
+
+	// PinSegments protects all the segments of a collection, including the dropped ones still in meta,
+	// from the garbage collection until they are unpinned or the lease expires, it is used by backup
+	PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error)
+	// UnpinSegments releases the segments pinned by PinSegments
+	UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error)
+
+	// BroadcastAlteredCollection notifies DataCoord of the properties of an altered collection,
+	// it is called by RootCoord after the collection is altered
+	BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest) (*commonpb.Status, error)
 }
 
 // DataCoordComponent defines the interface of DataCoord component.
@@ -500,6 +531,66 @@ type RootCoord interface {
 	// error is always nil
 	AlterAlias(ctx context.Context, req *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
 
+	// RenameCollection notifies RootCoord to rename a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old collection name and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, req *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// AlterCollection notifies RootCoord to alter the properties of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name and the properties to set
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, req *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+
+	// AddField notifies RootCoord to add a scalar field to a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name and the schema of the new field
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, req *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies RootCoord to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, req *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies RootCoord to drop a database, the database must be empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, req *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies RootCoord to list all databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// the database names are filled in `DbNames`
+	// error is always nil
+	ListDatabases(ctx context.Context, req *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
 	// AllocTimestamp notifies RootCoord to alloc timestamps
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -577,6 +668,127 @@ type RootCoord interface {
 
 	// GetMetrics notifies RootCoord to collect metrics for specified component
 	GetMetrics(ctx context.Context, req *milvuspb.GetMetricsRequest) (*milvuspb.GetMetricsResponse, error)
+
+	// CreateCredential notifies RootCoord to create a credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and the password encrypted by Proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+
+	// UpdateCredential notifies RootCoord to update the password of an existing credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and the new password encrypted by Proxy
+	//
+	// The `ErrorCode` of `Status` is `Success` if update credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all Proxies to invalidate the cached credential after updating.
+	UpdateCredential(ctx context.Context, req *internalpb.CredentialInfo) (*commonpb.Status, error)
+
+	// DeleteCredential notifies RootCoord to delete a credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `ErrorCode` of `Status` is `Success` if delete credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all Proxies to invalidate the cached credential after deleting.
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListUsers notifies RootCoord to list the usernames of all credentials
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListUsersResponse` indicates if this operation is processed successfully or fail cause;
+	// the usernames are filled in `Usernames`
+	// error is always nil
+	ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error)
+
+	// GetCredential notifies RootCoord to return the encrypted credential of a user
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `Status` in response struct `GetCredentialResponse` indicates if this operation is processed successfully or fail cause;
+	// the encrypted password is filled in `Password`
+	// error is always nil
+	//
+	// This interface is only used by Proxy to verify the credential of incoming requests.
+	GetCredential(ctx context.Context, req *rootcoordpb.GetCredentialRequest) (*rootcoordpb.GetCredentialResponse, error)
+
+	// CreateRole notifies RootCoord to create a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole notifies RootCoord to drop a role, the privileges granted to the role are revoked as well
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole notifies RootCoord to add the user to the role or remove the user from the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username, role name and operate type
+	//
+	// The `ErrorCode` of `Status` is `Success` if operate user role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// GrantPrivilege notifies RootCoord to grant a privilege on the object to the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name, object type, object name and privilege
+	//
+	// The `ErrorCode` of `Status` is `Success` if grant privilege successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all Proxies to refresh the cached privileges after granting.
+	GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error)
+
+	// RevokePrivilege notifies RootCoord to revoke a privilege on the object from the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name, object type, object name and privilege
+	//
+	// The `ErrorCode` of `Status` is `Success` if revoke privilege successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	//
+	// RootCoord notifies all Proxies to refresh the cached privileges after revoking.
+	RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error)
+
+	// ListGrants notifies RootCoord to list the privileges granted to the role,
+	// or to all the roles bound to the user if role name is empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name or username
+	//
+	// The `Status` in response struct `ListGrantsResponse` indicates if this operation is processed successfully or fail cause;
+	// the grants are filled in `Entities`
+	// error is always nil
+	ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
 }
 
 // RootCoordComponent is used by grpc server of RootCoord
@@ -648,6 +860,39 @@ type Proxy interface {
 	// error is returned only when some communication issue occurs.
 	ReleaseDQLMessageStream(ctx context.Context, in *proxypb.ReleaseDQLMessageStreamRequest) (*commonpb.Status, error)
 
+	// InvalidateCredentialCache notifies Proxy to clear the cached credential of specific user.
+	//
+	// InvalidateCredentialCache should be called when the credential of specific user was updated or deleted.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the request params, which is the username.
+	//
+	// InvalidateCredentialCache should always succeed even though the specific user doesn't exist in Proxy.
+	// So the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	InvalidateCredentialCache(ctx context.Context, request *proxypb.InvalidateCredCacheRequest) (*commonpb.Status, error)
+
+	// RefreshPolicyInfoCache notifies Proxy to drop all the cached privileges.
+	//
+	// RefreshPolicyInfoCache should be called when roles, grants or the binding between users and roles are changed.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	//
+	// RefreshPolicyInfoCache should always succeed, so the code of response `Status` should be always `Success`.
+	//
+	// error is returned only when some communication issue occurs.
+	RefreshPolicyInfoCache(ctx context.Context, request *proxypb.RefreshPolicyInfoCacheRequest) (*commonpb.Status, error)
+
+	// SetDMLQuota notifies Proxy to slow down or reject the DML requests according to the load of the cluster.
+	//
+	// ctx is the request to control request deadline and cancellation.
+	// request contains the factor applied to the insert rates, 0 means the DML requests should be rejected.
+	//
+	// The `Status` in response struct `commonpb.Status` indicates if this operation is processed successfully or fail cause;
+	// error is always nil
+	SetDMLQuota(ctx context.Context, request *proxypb.SetDMLQuotaRequest) (*commonpb.Status, error)
+
 	SendSearchResult(ctx context.Context, req *internalpb.SearchResults) (*commonpb.Status, error)
 	SendRetrieveResult(ctx context.Context, req *internalpb.RetrieveResults) (*commonpb.Status, error)
 }
@@ -910,6 +1155,18 @@ type ProxyComponent interface {
 	// error is always nil
 	Delete(ctx context.Context, request *milvuspb.DeleteRequest) (*milvuspb.MutationResult, error)
 
+	// Upsert notifies Proxy to insert rows, replacing the existing rows which have the same primary keys
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name(reserved), collection name, partition name(optional), fields data
+	//
+	// The `Status` in response struct `MutationResult` indicates if this operation is processed successfully or fail cause;
+	// the `IDs` in `MutationResult` return the id list of upserted rows.
+	// the `UpsertCnt` in `MutationResult` return the number of upserted rows.
+	// the `ErrIndex` in `MutationResult` return the failed number of upsert rows.
+	// error is always nil
+	Upsert(ctx context.Context, request *milvuspb.UpsertRequest) (*milvuspb.MutationResult, error)
+
 	// Search notifies Proxy to do search
 	//
 	// ctx is the context to control request deadline and cancellation
@@ -1025,11 +1282,193 @@ type ProxyComponent interface {
 	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
 	// error is always nil
 	AlterAlias(ctx context.Context, request *milvuspb.AlterAliasRequest) (*commonpb.Status, error)
+
+	// RenameCollection notifies Proxy to rename a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, old collection name and new collection name
+	//
+	// The `ErrorCode` of `Status` is `Success` if rename collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RenameCollection(ctx context.Context, request *milvuspb.RenameCollectionRequest) (*commonpb.Status, error)
+
+	// AlterCollection notifies Proxy to alter the properties of a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name and the properties to set
+	//
+	// The `ErrorCode` of `Status` is `Success` if alter collection successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AlterCollection(ctx context.Context, request *milvuspb.AlterCollectionRequest) (*commonpb.Status, error)
+
+	// AddField notifies Proxy to add a scalar field to a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name, collection name and the schema of the new field
+	//
+	// The `ErrorCode` of `Status` is `Success` if add field successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	AddField(ctx context.Context, request *milvuspb.AddFieldRequest) (*commonpb.Status, error)
+
+	// CreateDatabase notifies Proxy to create a database
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateDatabase(ctx context.Context, request *milvuspb.CreateDatabaseRequest) (*commonpb.Status, error)
+
+	// DropDatabase notifies Proxy to drop a database, the database must not contain any collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including database name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop database successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropDatabase(ctx context.Context, request *milvuspb.DropDatabaseRequest) (*commonpb.Status, error)
+
+	// ListDatabases notifies Proxy to list all databases
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListDatabasesResponse` indicates if this operation is processed successfully or fail cause;
+	// the database names are filled in `DbNames`
+	// error is always nil
+	ListDatabases(ctx context.Context, request *milvuspb.ListDatabasesRequest) (*milvuspb.ListDatabasesResponse, error)
+
+	// CreateCredential notifies Proxy to create a credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username and password
+	//
+	// The `ErrorCode` of `Status` is `Success` if create credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateCredential(ctx context.Context, req *milvuspb.CreateCredentialRequest) (*commonpb.Status, error)
+
+	// UpdateCredential notifies Proxy to update the password of an existing credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username, old password and new password
+	//
+	// The `ErrorCode` of `Status` is `Success` if update credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	UpdateCredential(ctx context.Context, req *milvuspb.UpdateCredentialRequest) (*commonpb.Status, error)
+
+	// DeleteCredential notifies Proxy to delete a credential
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username
+	//
+	// The `ErrorCode` of `Status` is `Success` if delete credential successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DeleteCredential(ctx context.Context, req *milvuspb.DeleteCredentialRequest) (*commonpb.Status, error)
+
+	// ListUsers notifies Proxy to list the usernames of all credentials
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params
+	//
+	// The `Status` in response struct `ListUsersResponse` indicates if this operation is processed successfully or fail cause;
+	// the usernames are filled in `Usernames`
+	// error is always nil
+	ListUsers(ctx context.Context, req *milvuspb.ListUsersRequest) (*milvuspb.ListUsersResponse, error)
+
+	// CreateRole notifies Proxy to create a role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if create role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	CreateRole(ctx context.Context, req *milvuspb.CreateRoleRequest) (*commonpb.Status, error)
+
+	// DropRole notifies Proxy to drop a role, the privileges granted to the role are revoked as well
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name
+	//
+	// The `ErrorCode` of `Status` is `Success` if drop role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	DropRole(ctx context.Context, req *milvuspb.DropRoleRequest) (*commonpb.Status, error)
+
+	// OperateUserRole notifies Proxy to add the user to the role or remove the user from the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including username, role name and operate type
+	//
+	// The `ErrorCode` of `Status` is `Success` if operate user role successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	OperateUserRole(ctx context.Context, req *milvuspb.OperateUserRoleRequest) (*commonpb.Status, error)
+
+	// GrantPrivilege notifies Proxy to grant a privilege on the object to the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name, object type, object name and privilege
+	//
+	// The `ErrorCode` of `Status` is `Success` if grant privilege successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	GrantPrivilege(ctx context.Context, req *milvuspb.GrantPrivilegeRequest) (*commonpb.Status, error)
+
+	// RevokePrivilege notifies Proxy to revoke a privilege on the object from the role
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name, object type, object name and privilege
+	//
+	// The `ErrorCode` of `Status` is `Success` if revoke privilege successfully;
+	// otherwise, the `ErrorCode` of `Status` will be `Error`, and the `Reason` of `Status` will record the fail cause.
+	// error is always nil
+	RevokePrivilege(ctx context.Context, req *milvuspb.RevokePrivilegeRequest) (*commonpb.Status, error)
+
+	// ListGrants notifies Proxy to list the privileges granted to the role,
+	// or to all the roles bound to the user if role name is empty
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including role name or username
+	//
+	// The `Status` in response struct `ListGrantsResponse` indicates if this operation is processed successfully or fail cause;
+	// the grants are filled in `Entities`
+	// error is always nil
+	ListGrants(ctx context.Context, req *milvuspb.ListGrantsRequest) (*milvuspb.ListGrantsResponse, error)
+
 	GetCompactionState(ctx context.Context, req *milvuspb.GetCompactionStateRequest) (*milvuspb.GetCompactionStateResponse, error)
 	ManualCompaction(ctx context.Context, req *milvuspb.ManualCompactionRequest) (*milvuspb.ManualCompactionResponse, error)
 	GetCompactionStateWithPlans(ctx context.Context, req *milvuspb.GetCompactionPlansRequest) (*milvuspb.GetCompactionPlansResponse, error)
 	// GetFlushState gets the flush state of multiple segments
 	GetFlushState(ctx context.Context, req *milvuspb.GetFlushStateRequest) (*milvuspb.GetFlushStateResponse, error)
+
+	// Import notifies Proxy to import the files in the object storage into a collection
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including collection name, partition name and the paths of files
+	//
+	// The `Status` in response struct `ImportResponse` indicates if this operation is processed successfully or fail cause;
+	// the id of the import task is filled in `TaskID`
+	// error is always nil
+	Import(ctx context.Context, req *milvuspb.ImportRequest) (*milvuspb.ImportResponse, error)
+
+	// GetImportState notifies Proxy to get the state of an import task
+	//
+	// ctx is the context to control request deadline and cancellation
+	// req contains the request params, including the id of the import task
+	//
+	// The `Status` in response struct `GetImportStateResponse` indicates if this operation is processed successfully or fail cause;
+	// the state of the task and each file are filled in `State` and `FileStates`
+	// error is always nil
+	GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest) (*milvuspb.GetImportStateResponse, error)
 }
 
 // QueryNode is the interface `querynode` package implements
@@ -0,0 +1,643 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"go.uber.org/zap"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
+)
+
+const (
+	// metaFileName is the name of the file holding the CollectionBackup of a backup
+	metaFileName = "meta"
+	// binlogDirName is the directory holding the copied binlogs of a backup
+	binlogDirName = "binlogs"
+	// segmentPinLease is how long the segments are protected from the garbage collection if a backup never unpins them
+	segmentPinLease = 12 * time.Hour
+)
+
+// Manager backs up the flushed segments of a collection to the object storage and restores them as a new collection.
+// A backup is stored under <rootPath>/<backupName>, the binlogs are copied to the same relative paths under
+// the binlogs directory, and the meta file is written at last so that a partial backup is never restored.
+type Manager struct {
+	rootCoord    types.RootCoord
+	dataCoord    types.DataCoord
+	chunkManager storage.ChunkManager
+	rootPath     string
+}
+
+// NewManager creates a backup manager storing the backups under rootPath by the chunk manager
+func NewManager(rootCoord types.RootCoord, dataCoord types.DataCoord, chunkManager storage.ChunkManager, rootPath string) *Manager {
+	return &Manager{
+		rootCoord:    rootCoord,
+		dataCoord:    dataCoord,
+		chunkManager: chunkManager,
+		rootPath:     rootPath,
+	}
+}
+
+func (m *Manager) metaPath(backupName string) string {
+	return path.Join(m.rootPath, backupName, metaFileName)
+}
+
+func (m *Manager) binlogPath(backupName string, logPath string) string {
+	return path.Join(m.rootPath, backupName, binlogDirName, logPath)
+}
+
+// Backup snapshots the meta of a collection and its segments flushed before the timestamp,
+// the current time is used if ts is zero. Rows still in growing segments are not included,
+// flush the collection before backup if they are needed. The segments are pinned against
+// the garbage collection of DataCoord while their binlogs are copied.
+func (m *Manager) Backup(ctx context.Context, backupName, dbName, collectionName string, ts typeutil.Timestamp) (*datapb.CollectionBackup, error) {
+	if backupName == "" {
+		return nil, fmt.Errorf("backup name is empty")
+	}
+	if m.chunkManager.Exist(m.metaPath(backupName)) {
+		return nil, fmt.Errorf("backup %s already exists", backupName)
+	}
+
+	if ts == 0 {
+		tsResp, err := m.rootCoord.AllocTimestamp(ctx, &rootcoordpb.AllocTimestampRequest{
+			Base:  &commonpb.MsgBase{MsgType: commonpb.MsgType_RequestTSO},
+			Count: 1,
+		})
+		if err = funcutil.VerifyResponse(tsResp, err); err != nil {
+			return nil, fmt.Errorf("failed to alloc timestamp, err: %w", err)
+		}
+		ts = tsResp.GetTimestamp()
+	}
+
+	collResp, err := m.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		TimeStamp:      ts,
+	})
+	if err = funcutil.VerifyResponse(collResp, err); err != nil {
+		return nil, fmt.Errorf("failed to describe collection %s, err: %w", collectionName, err)
+	}
+	partResp, err := m.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+		DbName:         dbName,
+		CollectionName: collectionName,
+		CollectionID:   collResp.GetCollectionID(),
+	})
+	if err = funcutil.VerifyResponse(partResp, err); err != nil {
+		return nil, fmt.Errorf("failed to show partitions of collection %s, err: %w", collectionName, err)
+	}
+
+	backup := &datapb.CollectionBackup{
+		BackupName:          backupName,
+		BackupTimestamp:     ts,
+		DbName:              dbName,
+		CollectionName:      collectionName,
+		CollectionID:        collResp.GetCollectionID(),
+		Schema:              collResp.GetSchema(),
+		ShardsNum:           collResp.GetShardsNum(),
+		ConsistencyLevel:    collResp.GetConsistencyLevel(),
+		VirtualChannelNames: collResp.GetVirtualChannelNames(),
+		Properties:          collResp.GetProperties(),
+	}
+	partitions := make(map[int64]struct{})
+	for i, partitionID := range partResp.GetPartitionIDs() {
+		if i >= len(partResp.GetPartitionNames()) {
+			break
+		}
+		partitions[partitionID] = struct{}{}
+		backup.Partitions = append(backup.Partitions, &datapb.PartitionBackup{
+			PartitionID:   partitionID,
+			PartitionName: partResp.GetPartitionNames()[i],
+		})
+	}
+
+	pinResp, err := m.dataCoord.PinSegments(ctx, &datapb.PinSegmentsRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_SegmentInfo},
+		CollectionID: collResp.GetCollectionID(),
+		LeaseSeconds: int64(segmentPinLease / time.Second),
+	})
+	if err = funcutil.VerifyResponse(pinResp, err); err != nil {
+		return nil, fmt.Errorf("failed to pin segments of collection %s, err: %w", collectionName, err)
+	}
+	defer m.unpinSegments(ctx, pinResp.GetPinID())
+
+	for _, segment := range segmentsAt(pinResp.GetSegments(), ts) {
+		if _, ok := partitions[segment.GetPartitionID()]; !ok {
+			continue
+		}
+		segment = proto.Clone(segment).(*datapb.SegmentInfo)
+		for _, logPath := range binlogPaths(&datapb.SegmentInfo{Binlogs: segment.GetBinlogs(), Statslogs: segment.GetStatslogs()}) {
+			if err := m.copyFile(logPath, m.binlogPath(backupName, logPath)); err != nil {
+				return nil, err
+			}
+		}
+		if segment.Deltalogs, err = m.backupDeltalogs(backupName, segment, ts); err != nil {
+			return nil, err
+		}
+		backup.Segments = append(backup.Segments, segment)
+	}
+
+	data, err := proto.Marshal(backup)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.chunkManager.Write(m.metaPath(backupName), data); err != nil {
+		return nil, fmt.Errorf("failed to write backup meta, err: %w", err)
+	}
+	log.Info("backup collection done", zap.String("backup", backupName), zap.String("collection", collectionName),
+		zap.Uint64("timestamp", ts), zap.Int("segments", len(backup.Segments)))
+	return backup, nil
+}
+
+func (m *Manager) unpinSegments(ctx context.Context, pinID typeutil.UniqueID) {
+	status, err := m.dataCoord.UnpinSegments(ctx, &datapb.UnpinSegmentsRequest{
+		Base:  &commonpb.MsgBase{MsgType: commonpb.MsgType_SegmentInfo},
+		PinID: pinID,
+	})
+	if err = funcutil.VerifyResponse(status, err); err != nil {
+		// the pin is released when the lease expires
+		log.Warn("failed to unpin segments", zap.Int64("pinID", pinID), zap.Error(err))
+	}
+}
+
+// segmentsAt picks the segments holding the flushed rows of a collection as of the timestamp from all its segments in meta.
+// A segment flushed after the timestamp is skipped, and a segment compacted after the timestamp is replaced by
+// its source segments, which stay in meta as dropped segments until they are garbage collected.
+// The compaction time of a segment is when its sources are dropped, a compacted segment whose sources are already
+// garbage collected is treated as compacted before the timestamp.
+func segmentsAt(segments []*datapb.SegmentInfo, ts typeutil.Timestamp) []*datapb.SegmentInfo {
+	physical, _ := tsoutil.ParseTS(ts)
+	at := uint64(physical.UnixNano())
+
+	droppedAt := make(map[int64]uint64)
+	for _, segment := range segments {
+		if segment.GetState() == commonpb.SegmentState_Dropped {
+			droppedAt[segment.GetID()] = segment.GetDroppedAt()
+		}
+	}
+	compactedAt := make(map[int64]uint64)
+	isSource := make(map[int64]bool)
+	for _, segment := range segments {
+		for _, from := range segment.GetCompactionFrom() {
+			isSource[from] = true
+			if droppedAt[from] > compactedAt[segment.GetID()] {
+				compactedAt[segment.GetID()] = droppedAt[from]
+			}
+		}
+	}
+
+	result := make([]*datapb.SegmentInfo, 0, len(segments))
+	for _, segment := range segments {
+		switch segment.GetState() {
+		case commonpb.SegmentState_Flushed:
+		case commonpb.SegmentState_Flushing:
+			// the binlogs of a compacted segment are written before it's added to meta
+			if !segment.GetCreatedByCompaction() {
+				continue
+			}
+		case commonpb.SegmentState_Dropped:
+			// only the sources of the segments compacted after the timestamp are restored
+			if !isSource[segment.GetID()] || segment.GetDroppedAt() <= at {
+				continue
+			}
+		default:
+			continue
+		}
+		if segment.GetDmlPosition().GetTimestamp() > ts {
+			continue
+		}
+		if segment.GetCreatedByCompaction() && compactedAt[segment.GetID()] > at {
+			continue
+		}
+		result = append(result, segment)
+	}
+	return result
+}
+
+// backupDeltalogs copies the deltalogs of a segment holding the deletes before the timestamp, a deltalog spanning
+// the timestamp is rewritten with only the deletes before the timestamp. It returns the binlogs of the backup.
+func (m *Manager) backupDeltalogs(backupName string, segment *datapb.SegmentInfo, ts typeutil.Timestamp) ([]*datapb.FieldBinlog, error) {
+	result := make([]*datapb.FieldBinlog, 0, len(segment.GetDeltalogs()))
+	for _, fieldBinlog := range segment.GetDeltalogs() {
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			dst := m.binlogPath(backupName, binlog.GetLogPath())
+			switch {
+			case binlog.GetTimestampFrom() > ts:
+				continue
+			case binlog.GetTimestampTo() != 0 && binlog.GetTimestampTo() <= ts:
+				if err := m.copyFile(binlog.GetLogPath(), dst); err != nil {
+					return nil, err
+				}
+			default:
+				filtered, err := m.filterDeltalog(segment, binlog, dst, ts)
+				if err != nil {
+					return nil, err
+				}
+				if filtered == nil {
+					continue
+				}
+				binlog = filtered
+			}
+			binlogs = append(binlogs, binlog)
+		}
+		if len(binlogs) > 0 {
+			result = append(result, &datapb.FieldBinlog{FieldID: fieldBinlog.GetFieldID(), Binlogs: binlogs})
+		}
+	}
+	return result, nil
+}
+
+// filterDeltalog writes the deletes before the timestamp in a deltalog to dst, returns nil if there is no such delete
+func (m *Manager) filterDeltalog(segment *datapb.SegmentInfo, binlog *datapb.Binlog, dst string, ts typeutil.Timestamp) (*datapb.Binlog, error) {
+	data, err := m.chunkManager.Read(binlog.GetLogPath())
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s, err: %w", binlog.GetLogPath(), err)
+	}
+	codec := storage.NewDeleteCodec()
+	_, _, deleteData, err := codec.Deserialize([]*storage.Blob{{Key: binlog.GetLogPath(), Value: data}})
+	if err != nil {
+		return nil, fmt.Errorf("failed to deserialize %s, err: %w", binlog.GetLogPath(), err)
+	}
+
+	filtered := &storage.DeleteData{}
+	var tsFrom, tsTo typeutil.Timestamp = math.MaxUint64, 0
+	for i, pk := range deleteData.Pks {
+		if deleteData.Tss[i] > ts {
+			continue
+		}
+		filtered.Append(pk, deleteData.Tss[i])
+		if deleteData.Tss[i] < tsFrom {
+			tsFrom = deleteData.Tss[i]
+		}
+		if deleteData.Tss[i] > tsTo {
+			tsTo = deleteData.Tss[i]
+		}
+	}
+	if filtered.RowCount == 0 {
+		return nil, nil
+	}
+	blob, err := codec.Serialize(segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID(), filtered)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.chunkManager.Write(dst, blob.GetValue()); err != nil {
+		return nil, fmt.Errorf("failed to write %s, err: %w", dst, err)
+	}
+	return &datapb.Binlog{
+		EntriesNum:    filtered.RowCount,
+		TimestampFrom: tsFrom,
+		TimestampTo:   tsTo,
+		LogPath:       binlog.GetLogPath(),
+		LogSize:       int64(len(blob.GetValue())),
+	}, nil
+}
+
+// Restore creates a collection from a backup and adds the segments of the backup to it as flushed segments,
+// the binlogs are copied to the paths of the new collection. It returns the ID of the new collection.
+// The new collection is dropped if the restore fails after it's created, the binlogs already copied are not
+// in meta so they are removed by the garbage collection of DataCoord.
+func (m *Manager) Restore(ctx context.Context, backupName, dbName, collectionName string) (typeutil.UniqueID, error) {
+	data, err := m.chunkManager.Read(m.metaPath(backupName))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read meta of backup %s, err: %w", backupName, err)
+	}
+	backup := &datapb.CollectionBackup{}
+	if err := proto.Unmarshal(data, backup); err != nil {
+		return 0, fmt.Errorf("invalid meta of backup %s, err: %w", backupName, err)
+	}
+
+	collResp, err := m.createCollection(ctx, backup, dbName, collectionName)
+	if err != nil {
+		return 0, err
+	}
+	segments, err := m.restoreSegments(ctx, backupName, backup, dbName, collectionName, collResp)
+	if err != nil {
+		m.dropCollection(ctx, dbName, collectionName)
+		return 0, err
+	}
+	log.Info("restore collection done", zap.String("backup", backupName), zap.String("collection", collectionName),
+		zap.Int64("collectionID", collResp.GetCollectionID()), zap.Int("segments", segments))
+	return collResp.GetCollectionID(), nil
+}
+
+// restoreSegments copies the binlogs of the backup to the new collection and adds the segments to DataCoord,
+// returns the number of the restored segments
+func (m *Manager) restoreSegments(ctx context.Context, backupName string, backup *datapb.CollectionBackup,
+	dbName, collectionName string, collResp *milvuspb.DescribeCollectionResponse) (int, error) {
+	collectionID := collResp.GetCollectionID()
+	channels := collResp.GetVirtualChannelNames()
+	if len(channels) == 0 {
+		return 0, fmt.Errorf("collection %s has no channels", collectionName)
+	}
+
+	// map the IDs in the backup to the IDs of the new collection
+	fieldIDs := make(map[int64]int64)
+	for _, field := range backup.GetSchema().GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			fieldIDs[field.GetFieldID()] = field.GetFieldID()
+			continue
+		}
+		for _, newField := range collResp.GetSchema().GetFields() {
+			if newField.GetName() == field.GetName() {
+				fieldIDs[field.GetFieldID()] = newField.GetFieldID()
+				break
+			}
+		}
+	}
+	partitionIDs, err := m.createPartitions(ctx, backup, dbName, collectionName, collectionID)
+	if err != nil {
+		return 0, err
+	}
+	channelIndex := make(map[string]int)
+	for i, channel := range backup.GetVirtualChannelNames() {
+		channelIndex[channel] = i
+	}
+
+	segments := make([]*datapb.SegmentInfo, 0, len(backup.GetSegments()))
+	for _, segment := range backup.GetSegments() {
+		partitionID, ok := partitionIDs[segment.GetPartitionID()]
+		if !ok {
+			return 0, fmt.Errorf("partition %d of segment %d is not found in backup", segment.GetPartitionID(), segment.GetID())
+		}
+		idx, ok := channelIndex[segment.GetInsertChannel()]
+		if !ok {
+			return 0, fmt.Errorf("channel %s of segment %d is not found in backup", segment.GetInsertChannel(), segment.GetID())
+		}
+		idResp, err := m.rootCoord.AllocID(ctx, &rootcoordpb.AllocIDRequest{
+			Base:  &commonpb.MsgBase{MsgType: commonpb.MsgType_RequestID},
+			Count: 1,
+		})
+		if err = funcutil.VerifyResponse(idResp, err); err != nil {
+			return 0, fmt.Errorf("failed to alloc segment ID, err: %w", err)
+		}
+
+		restored := &datapb.SegmentInfo{
+			ID:            idResp.GetID(),
+			CollectionID:  collectionID,
+			PartitionID:   partitionID,
+			InsertChannel: channels[idx%len(channels)],
+			NumOfRows:     segment.GetNumOfRows(),
+		}
+		rebase := func(logPath string, fieldID int64) (string, error) {
+			newPath, err := rebaseBinlogPath(logPath, segment, fieldID, restored)
+			if err != nil {
+				return "", err
+			}
+			return newPath, m.copyFile(m.binlogPath(backupName, logPath), newPath)
+		}
+		if restored.Binlogs, err = rebaseFieldBinlogs(segment.GetBinlogs(), fieldIDs, rebase); err != nil {
+			return 0, err
+		}
+		if restored.Statslogs, err = rebaseFieldBinlogs(segment.GetStatslogs(), fieldIDs, rebase); err != nil {
+			return 0, err
+		}
+		if restored.Deltalogs, err = rebaseFieldBinlogs(segment.GetDeltalogs(), nil, rebase); err != nil {
+			return 0, err
+		}
+		segments = append(segments, restored)
+	}
+
+	status, err := m.dataCoord.AddFlushedSegments(ctx, &datapb.AddFlushedSegmentsRequest{
+		Base:         &commonpb.MsgBase{MsgType: commonpb.MsgType_SegmentInfo},
+		CollectionID: collectionID,
+		Segments:     segments,
+	})
+	if err = funcutil.VerifyResponse(status, err); err != nil {
+		return 0, fmt.Errorf("failed to add segments to collection %s, err: %w", collectionName, err)
+	}
+	return len(segments), nil
+}
+
+// dropCollection drops the collection created by a failed restore
+func (m *Manager) dropCollection(ctx context.Context, dbName, collectionName string) {
+	status, err := m.rootCoord.DropCollection(ctx, &milvuspb.DropCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DropCollection},
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err = funcutil.VerifyResponse(status, err); err != nil {
+		log.Warn("failed to drop the collection of the failed restore", zap.String("collection", collectionName), zap.Error(err))
+	}
+}
+
+// createCollection creates the collection with the schema of the backup and describes it
+func (m *Manager) createCollection(ctx context.Context, backup *datapb.CollectionBackup, dbName, collectionName string) (*milvuspb.DescribeCollectionResponse, error) {
+	schema := proto.Clone(backup.GetSchema()).(*schemapb.CollectionSchema)
+	schema.Name = collectionName
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		// the system fields are added by RootCoord
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			fields = append(fields, field)
+		}
+	}
+	schema.Fields = fields
+	schemaBytes, err := proto.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := m.rootCoord.CreateCollection(ctx, &milvuspb.CreateCollectionRequest{
+		Base:             &commonpb.MsgBase{MsgType: commonpb.MsgType_CreateCollection},
+		DbName:           dbName,
+		CollectionName:   collectionName,
+		Schema:           schemaBytes,
+		ShardsNum:        backup.GetShardsNum(),
+		ConsistencyLevel: backup.GetConsistencyLevel(),
+		Properties:       backup.GetProperties(),
+	})
+	if err = funcutil.VerifyResponse(status, err); err != nil {
+		return nil, fmt.Errorf("failed to create collection %s, err: %w", collectionName, err)
+	}
+	collResp, err := m.rootCoord.DescribeCollection(ctx, &milvuspb.DescribeCollectionRequest{
+		Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_DescribeCollection},
+		DbName:         dbName,
+		CollectionName: collectionName,
+	})
+	if err = funcutil.VerifyResponse(collResp, err); err != nil {
+		return nil, fmt.Errorf("failed to describe collection %s, err: %w", collectionName, err)
+	}
+	return collResp, nil
+}
+
+// createPartitions creates the partitions of the backup which do not exist in the new collection,
+// returns the map from the partition IDs in the backup to the new partition IDs
+func (m *Manager) createPartitions(ctx context.Context, backup *datapb.CollectionBackup, dbName, collectionName string, collectionID int64) (map[int64]int64, error) {
+	showPartitions := func() (map[string]int64, error) {
+		resp, err := m.rootCoord.ShowPartitions(ctx, &milvuspb.ShowPartitionsRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_ShowPartitions},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			CollectionID:   collectionID,
+		})
+		if err = funcutil.VerifyResponse(resp, err); err != nil {
+			return nil, fmt.Errorf("failed to show partitions of collection %s, err: %w", collectionName, err)
+		}
+		partitions := make(map[string]int64)
+		for i, name := range resp.GetPartitionNames() {
+			if i < len(resp.GetPartitionIDs()) {
+				partitions[name] = resp.GetPartitionIDs()[i]
+			}
+		}
+		return partitions, nil
+	}
+
+	existed, err := showPartitions()
+	if err != nil {
+		return nil, err
+	}
+	for _, partition := range backup.GetPartitions() {
+		if _, ok := existed[partition.GetPartitionName()]; ok {
+			continue
+		}
+		status, err := m.rootCoord.CreatePartition(ctx, &milvuspb.CreatePartitionRequest{
+			Base:           &commonpb.MsgBase{MsgType: commonpb.MsgType_CreatePartition},
+			DbName:         dbName,
+			CollectionName: collectionName,
+			PartitionName:  partition.GetPartitionName(),
+		})
+		if err = funcutil.VerifyResponse(status, err); err != nil {
+			return nil, fmt.Errorf("failed to create partition %s, err: %w", partition.GetPartitionName(), err)
+		}
+	}
+	if existed, err = showPartitions(); err != nil {
+		return nil, err
+	}
+
+	partitionIDs := make(map[int64]int64)
+	for _, partition := range backup.GetPartitions() {
+		partitionID, ok := existed[partition.GetPartitionName()]
+		if !ok {
+			return nil, fmt.Errorf("partition %s is not created", partition.GetPartitionName())
+		}
+		partitionIDs[partition.GetPartitionID()] = partitionID
+	}
+	return partitionIDs, nil
+}
+
+// copyFile streams the content of src to dst without holding the whole file in memory
+func (m *Manager) copyFile(src, dst string) error {
+	size, err := m.chunkManager.Size(src)
+	if err != nil {
+		return fmt.Errorf("failed to get size of %s, err: %w", src, err)
+	}
+	reader, err := m.chunkManager.Reader(src)
+	if err != nil {
+		return fmt.Errorf("failed to read %s, err: %w", src, err)
+	}
+	defer reader.Close()
+	if err := m.chunkManager.WriteFrom(dst, reader, size); err != nil {
+		return fmt.Errorf("failed to write %s, err: %w", dst, err)
+	}
+	return nil
+}
+
+// binlogPaths returns the paths of all the insert, stats and delta binlogs of a segment
+func binlogPaths(segment *datapb.SegmentInfo) []string {
+	var paths []string
+	for _, fieldBinlogs := range [][]*datapb.FieldBinlog{segment.GetBinlogs(), segment.GetStatslogs(), segment.GetDeltalogs()} {
+		for _, fieldBinlog := range fieldBinlogs {
+			for _, binlog := range fieldBinlog.GetBinlogs() {
+				paths = append(paths, binlog.GetLogPath())
+			}
+		}
+	}
+	return paths
+}
+
+// rebaseFieldBinlogs maps the field IDs and the paths of the binlogs, the field IDs are kept if fieldIDs is nil
+func rebaseFieldBinlogs(fieldBinlogs []*datapb.FieldBinlog, fieldIDs map[int64]int64,
+	rebase func(logPath string, fieldID int64) (string, error)) ([]*datapb.FieldBinlog, error) {
+	result := make([]*datapb.FieldBinlog, 0, len(fieldBinlogs))
+	for _, fieldBinlog := range fieldBinlogs {
+		fieldID := fieldBinlog.GetFieldID()
+		if fieldIDs != nil {
+			newID, ok := fieldIDs[fieldID]
+			if !ok {
+				return nil, fmt.Errorf("field %d is not found in schema", fieldID)
+			}
+			fieldID = newID
+		}
+		binlogs := make([]*datapb.Binlog, 0, len(fieldBinlog.GetBinlogs()))
+		for _, binlog := range fieldBinlog.GetBinlogs() {
+			logPath, err := rebase(binlog.GetLogPath(), fieldID)
+			if err != nil {
+				return nil, err
+			}
+			binlog = proto.Clone(binlog).(*datapb.Binlog)
+			binlog.LogPath = logPath
+			binlogs = append(binlogs, binlog)
+		}
+		result = append(result, &datapb.FieldBinlog{FieldID: fieldID, Binlogs: binlogs})
+	}
+	return result, nil
+}
+
+// rebaseBinlogPath replaces the IDs in a binlog path with the IDs of the restored segment, the path of an insert or
+// stats binlog ends with collectionID/partitionID/segmentID/fieldID/logID, while a delta binlog has no fieldID
+func rebaseBinlogPath(logPath string, segment *datapb.SegmentInfo, fieldID int64, restored *datapb.SegmentInfo) (string, error) {
+	elems := strings.Split(logPath, "/")
+	ids := []int64{segment.GetCollectionID(), segment.GetPartitionID(), segment.GetID()}
+	newIDs := []int64{restored.GetCollectionID(), restored.GetPartitionID(), restored.GetID()}
+	// find the position of the collection ID, which is followed by the partition ID and the segment ID
+	for start := len(elems) - 5; start <= len(elems)-4; start++ {
+		if start < 0 {
+			continue
+		}
+		matched := true
+		for i, id := range ids {
+			if elems[start+i] != strconv.FormatInt(id, 10) {
+				matched = false
+				break
+			}
+		}
+		if !matched {
+			continue
+		}
+		result := make([]string, len(elems))
+		copy(result, elems)
+		for i, id := range newIDs {
+			result[start+i] = strconv.FormatInt(id, 10)
+		}
+		if start == len(elems)-5 {
+			result[start+3] = strconv.FormatInt(fieldID, 10)
+		}
+		return strings.Join(result, "/"), nil
+	}
+	return "", fmt.Errorf("unexpected binlog path %s of segment %d", logPath, segment.GetID())
+}
@@ -0,0 +1,411 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/datapb"
+	"github.com/milvus-io/milvus/internal/proto/internalpb"
+	"github.com/milvus-io/milvus/internal/proto/milvuspb"
+	"github.com/milvus-io/milvus/internal/proto/rootcoordpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/types"
+	"github.com/milvus-io/milvus/internal/util/tsoutil"
+)
+
+var successStatus = &commonpb.Status{ErrorCode: commonpb.ErrorCode_Success}
+
+type mockCollection struct {
+	id         int64
+	schema     *schemapb.CollectionSchema
+	channels   []string
+	partitions map[string]int64
+}
+
+// mockRootCoord keeps the collections in memory, the field IDs of a created collection start from 200
+type mockRootCoord struct {
+	types.RootCoord
+	collections map[string]*mockCollection
+	nextID      int64
+	ts          uint64
+}
+
+func (m *mockRootCoord) AllocTimestamp(ctx context.Context, req *rootcoordpb.AllocTimestampRequest) (*rootcoordpb.AllocTimestampResponse, error) {
+	return &rootcoordpb.AllocTimestampResponse{Status: successStatus, Timestamp: m.ts, Count: 1}, nil
+}
+
+func (m *mockRootCoord) AllocID(ctx context.Context, req *rootcoordpb.AllocIDRequest) (*rootcoordpb.AllocIDResponse, error) {
+	m.nextID++
+	return &rootcoordpb.AllocIDResponse{Status: successStatus, ID: m.nextID, Count: 1}, nil
+}
+
+func (m *mockRootCoord) DescribeCollection(ctx context.Context, req *milvuspb.DescribeCollectionRequest) (*milvuspb.DescribeCollectionResponse, error) {
+	coll, ok := m.collections[req.GetCollectionName()]
+	if !ok {
+		return &milvuspb.DescribeCollectionResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"},
+		}, nil
+	}
+	return &milvuspb.DescribeCollectionResponse{
+		Status:              successStatus,
+		CollectionID:        coll.id,
+		Schema:              coll.schema,
+		VirtualChannelNames: coll.channels,
+		ShardsNum:           int32(len(coll.channels)),
+	}, nil
+}
+
+func (m *mockRootCoord) ShowPartitions(ctx context.Context, req *milvuspb.ShowPartitionsRequest) (*milvuspb.ShowPartitionsResponse, error) {
+	coll, ok := m.collections[req.GetCollectionName()]
+	if !ok {
+		return &milvuspb.ShowPartitionsResponse{
+			Status: &commonpb.Status{ErrorCode: commonpb.ErrorCode_CollectionNotExists, Reason: "collection not found"},
+		}, nil
+	}
+	resp := &milvuspb.ShowPartitionsResponse{Status: successStatus}
+	for name, id := range coll.partitions {
+		resp.PartitionNames = append(resp.PartitionNames, name)
+		resp.PartitionIDs = append(resp.PartitionIDs, id)
+	}
+	return resp, nil
+}
+
+func (m *mockRootCoord) CreateCollection(ctx context.Context, req *milvuspb.CreateCollectionRequest) (*commonpb.Status, error) {
+	if _, ok := m.collections[req.GetCollectionName()]; ok {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "collection already exists"}, nil
+	}
+	schema := &schemapb.CollectionSchema{}
+	if err := proto.Unmarshal(req.GetSchema(), schema); err != nil {
+		return nil, err
+	}
+	if schema.GetName() != req.GetCollectionName() {
+		return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "schema name mismatch"}, nil
+	}
+	for i, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			return &commonpb.Status{ErrorCode: commonpb.ErrorCode_UnexpectedError, Reason: "system field"}, nil
+		}
+		field.FieldID = int64(200 + i)
+	}
+	m.nextID++
+	coll := &mockCollection{id: m.nextID, schema: schema, partitions: map[string]int64{"_default": m.nextID + 1}}
+	m.nextID++
+	for i := 0; i < int(req.GetShardsNum()); i++ {
+		coll.channels = append(coll.channels, fmt.Sprintf("%s_v%d", req.GetCollectionName(), i))
+	}
+	m.collections[req.GetCollectionName()] = coll
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) DropCollection(ctx context.Context, req *milvuspb.DropCollectionRequest) (*commonpb.Status, error) {
+	delete(m.collections, req.GetCollectionName())
+	return successStatus, nil
+}
+
+func (m *mockRootCoord) CreatePartition(ctx context.Context, req *milvuspb.CreatePartitionRequest) (*commonpb.Status, error) {
+	m.nextID++
+	m.collections[req.GetCollectionName()].partitions[req.GetPartitionName()] = m.nextID
+	return successStatus, nil
+}
+
+type mockDataCoord struct {
+	types.DataCoord
+	segments map[int64]*datapb.SegmentInfo
+	added    []*datapb.SegmentInfo
+	addErr   error
+	pins     map[int64]bool
+}
+
+func (m *mockDataCoord) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest) (*datapb.PinSegmentsResponse, error) {
+	pinID := int64(len(m.pins) + 1)
+	m.pins[pinID] = true
+	resp := &datapb.PinSegmentsResponse{Status: successStatus, PinID: pinID}
+	for _, segment := range m.segments {
+		if segment.GetCollectionID() == req.GetCollectionID() {
+			resp.Segments = append(resp.Segments, segment)
+		}
+	}
+	return resp, nil
+}
+
+func (m *mockDataCoord) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest) (*commonpb.Status, error) {
+	m.pins[req.GetPinID()] = false
+	return successStatus, nil
+}
+
+func (m *mockDataCoord) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest) (*commonpb.Status, error) {
+	if m.addErr != nil {
+		return nil, m.addErr
+	}
+	m.added = append(m.added, req.GetSegments()...)
+	return successStatus, nil
+}
+
+func newTestSegment(id, partitionID int64, channel string, dmlTs uint64) *datapb.SegmentInfo {
+	prefix := fmt.Sprintf("files/insert_log/10/%d/%d", partitionID, id)
+	return &datapb.SegmentInfo{
+		ID:            id,
+		CollectionID:  10,
+		PartitionID:   partitionID,
+		InsertChannel: channel,
+		NumOfRows:     100,
+		State:         commonpb.SegmentState_Flushed,
+		DmlPosition:   &internalpb.MsgPosition{ChannelName: channel, Timestamp: dmlTs},
+		Binlogs: []*datapb.FieldBinlog{
+			{FieldID: 0, Binlogs: []*datapb.Binlog{{LogPath: prefix + "/0/1", EntriesNum: 100}}},
+			{FieldID: 1, Binlogs: []*datapb.Binlog{{LogPath: prefix + "/1/2", EntriesNum: 100}}},
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: prefix + "/100/3", EntriesNum: 100}}},
+			{FieldID: 101, Binlogs: []*datapb.Binlog{{LogPath: prefix + "/101/4", EntriesNum: 100}}},
+		},
+		Statslogs: []*datapb.FieldBinlog{
+			{FieldID: 100, Binlogs: []*datapb.Binlog{{LogPath: fmt.Sprintf("files/stats_log/10/%d/%d/100/5", partitionID, id)}}},
+		},
+		Deltalogs: []*datapb.FieldBinlog{
+			{Binlogs: []*datapb.Binlog{{LogPath: fmt.Sprintf("files/delta_log/10/%d/%d/6", partitionID, id), TimestampFrom: 1, TimestampTo: dmlTs}}},
+		},
+	}
+}
+
+func TestManager_BackupAndRestore(t *testing.T) {
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "backup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+
+	rc := &mockRootCoord{
+		nextID: 1000,
+		collections: map[string]*mockCollection{
+			"source": {
+				id: 10,
+				schema: &schemapb.CollectionSchema{
+					Name: "source",
+					Fields: []*schemapb.FieldSchema{
+						{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+						{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+						{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+						{FieldID: 101, Name: "vec", DataType: schemapb.DataType_FloatVector},
+					},
+				},
+				channels:   []string{"source_v0", "source_v1"},
+				partitions: map[string]int64{"_default": 20, "p1": 21},
+			},
+		},
+	}
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+	rc.ts = ts
+	dc := &mockDataCoord{
+		segments: map[int64]*datapb.SegmentInfo{
+			30: newTestSegment(30, 20, "source_v0", ts-500),
+			31: newTestSegment(31, 21, "source_v1", ts-400),
+			// flushed after the backup timestamp
+			32: newTestSegment(32, 20, "source_v1", ts+1000),
+		},
+		pins: make(map[int64]bool),
+	}
+	for _, segment := range dc.segments {
+		for _, logPath := range binlogPaths(segment) {
+			require.NoError(t, cm.Write(logPath, []byte(logPath)))
+		}
+	}
+
+	m := NewManager(rc, dc, cm, "backup")
+	result, err := m.Backup(ctx, "b1", "", "source", 0)
+	require.NoError(t, err)
+	assert.Equal(t, ts, result.GetBackupTimestamp())
+	assert.EqualValues(t, 10, result.GetCollectionID())
+	assert.Equal(t, 2, len(result.GetPartitions()))
+	assert.Equal(t, 2, len(result.GetSegments()))
+	assert.True(t, cm.Exist("backup/b1/meta"))
+	assert.True(t, cm.Exist("backup/b1/binlogs/files/insert_log/10/20/30/101/4"))
+	assert.False(t, cm.Exist("backup/b1/binlogs/files/insert_log/10/20/32/101/4"))
+	assert.Equal(t, map[int64]bool{1: false}, dc.pins)
+
+	_, err = m.Backup(ctx, "b1", "", "source", 0)
+	assert.Error(t, err)
+	_, err = m.Backup(ctx, "b2", "", "not_exist", 0)
+	assert.Error(t, err)
+
+	collectionID, err := m.Restore(ctx, "b1", "", "target")
+	require.NoError(t, err)
+	target := rc.collections["target"]
+	require.NotNil(t, target)
+	assert.Equal(t, target.id, collectionID)
+	assert.Equal(t, 2, len(target.schema.GetFields()))
+	assert.Equal(t, 2, len(target.partitions))
+
+	require.Equal(t, 2, len(dc.added))
+	for _, segment := range dc.added {
+		assert.Equal(t, collectionID, segment.GetCollectionID())
+		assert.EqualValues(t, 100, segment.GetNumOfRows())
+		assert.Contains(t, target.channels, segment.GetInsertChannel())
+		var fieldIDs []int64
+		for _, fieldBinlog := range segment.GetBinlogs() {
+			fieldIDs = append(fieldIDs, fieldBinlog.GetFieldID())
+		}
+		assert.ElementsMatch(t, []int64{0, 1, 200, 201}, fieldIDs)
+		for _, logPath := range binlogPaths(segment) {
+			assert.True(t, cm.Exist(logPath), logPath)
+		}
+		assert.Equal(t, fmt.Sprintf("files/insert_log/%d/%d/%d/201/4", collectionID, segment.GetPartitionID(), segment.GetID()),
+			segment.GetBinlogs()[3].GetBinlogs()[0].GetLogPath())
+		assert.Equal(t, fmt.Sprintf("files/delta_log/%d/%d/%d/6", collectionID, segment.GetPartitionID(), segment.GetID()),
+			segment.GetDeltalogs()[0].GetBinlogs()[0].GetLogPath())
+	}
+
+	// the collection already exists
+	_, err = m.Restore(ctx, "b1", "", "target")
+	assert.Error(t, err)
+	_, err = m.Restore(ctx, "not_exist", "", "target2")
+	assert.Error(t, err)
+	// the collection is dropped if the restore fails
+	dc.addErr = errors.New("mock error")
+	_, err = m.Restore(ctx, "b1", "", "target3")
+	assert.Error(t, err)
+	assert.Nil(t, rc.collections["target3"])
+}
+
+func TestSegmentsAt(t *testing.T) {
+	now := time.Now()
+	ts := tsoutil.ComposeTSByTime(now, 0)
+	before := uint64(now.Add(-time.Minute).UnixNano())
+	after := uint64(now.Add(time.Minute).UnixNano())
+
+	segments := []*datapb.SegmentInfo{
+		newTestSegment(1, 20, "v0", ts-10),
+		// flushed after the timestamp
+		newTestSegment(2, 20, "v0", ts+10),
+		// compacted from 4 and 5 after the timestamp
+		newTestSegment(3, 20, "v0", ts-10),
+		newTestSegment(4, 20, "v0", ts-20),
+		newTestSegment(5, 20, "v0", ts-20),
+		// compacted from 7 before the timestamp, and the compaction result is still flushing
+		newTestSegment(6, 20, "v0", ts-10),
+		newTestSegment(7, 20, "v0", ts-20),
+		// compacted from 9, which is garbage collected
+		newTestSegment(8, 20, "v0", ts-10),
+		// dropped after the timestamp but not by compaction
+		newTestSegment(10, 20, "v0", ts-10),
+		// growing
+		newTestSegment(11, 20, "v0", 0),
+	}
+	segments[2].CreatedByCompaction = true
+	segments[2].CompactionFrom = []int64{4, 5}
+	for _, segment := range segments[3:5] {
+		segment.State = commonpb.SegmentState_Dropped
+		segment.DroppedAt = after
+	}
+	segments[5].State = commonpb.SegmentState_Flushing
+	segments[5].CreatedByCompaction = true
+	segments[5].CompactionFrom = []int64{7}
+	segments[6].State = commonpb.SegmentState_Dropped
+	segments[6].DroppedAt = before
+	segments[7].CreatedByCompaction = true
+	segments[7].CompactionFrom = []int64{9}
+	segments[8].State = commonpb.SegmentState_Dropped
+	segments[8].DroppedAt = after
+	segments[9].State = commonpb.SegmentState_Growing
+
+	var segmentIDs []int64
+	for _, segment := range segmentsAt(segments, ts) {
+		segmentIDs = append(segmentIDs, segment.GetID())
+	}
+	assert.ElementsMatch(t, []int64{1, 4, 5, 6, 8}, segmentIDs)
+}
+
+func TestManager_BackupDeltalogs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backup_test")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	cm := storage.NewLocalChunkManager(dir)
+	m := NewManager(&mockRootCoord{}, &mockDataCoord{}, cm, "backup")
+
+	segment := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2}
+	codec := storage.NewDeleteCodec()
+	deleteData := &storage.DeleteData{}
+	deleteData.Append(storage.NewInt64PrimaryKey(1), 100)
+	deleteData.Append(storage.NewInt64PrimaryKey(2), 200)
+	deleteData.Append(storage.NewInt64PrimaryKey(3), 300)
+	blob, err := codec.Serialize(1, 2, 3, deleteData)
+	require.NoError(t, err)
+	for _, logPath := range []string{"delta_log/1/2/3/1", "delta_log/1/2/3/2", "delta_log/1/2/3/3"} {
+		require.NoError(t, cm.Write(logPath, blob.GetValue()))
+	}
+	segment.Deltalogs = []*datapb.FieldBinlog{{Binlogs: []*datapb.Binlog{
+		{LogPath: "delta_log/1/2/3/1", EntriesNum: 3, TimestampFrom: 100, TimestampTo: 300},
+		// spans the timestamp
+		{LogPath: "delta_log/1/2/3/2", EntriesNum: 3, TimestampFrom: 100, TimestampTo: 300},
+		// after the timestamp
+		{LogPath: "delta_log/1/2/3/3", EntriesNum: 3, TimestampFrom: 400, TimestampTo: 500},
+	}}}
+	segment.Deltalogs[0].Binlogs[0].TimestampTo = 150
+
+	deltalogs, err := m.backupDeltalogs("b1", segment, 250)
+	require.NoError(t, err)
+	require.Equal(t, 1, len(deltalogs))
+	require.Equal(t, 2, len(deltalogs[0].GetBinlogs()))
+	assert.True(t, cm.Exist("backup/b1/binlogs/delta_log/1/2/3/1"))
+	assert.False(t, cm.Exist("backup/b1/binlogs/delta_log/1/2/3/3"))
+
+	filtered := deltalogs[0].GetBinlogs()[1]
+	assert.Equal(t, "delta_log/1/2/3/2", filtered.GetLogPath())
+	assert.EqualValues(t, 2, filtered.GetEntriesNum())
+	assert.EqualValues(t, 200, filtered.GetTimestampTo())
+	data, err := cm.Read("backup/b1/binlogs/delta_log/1/2/3/2")
+	require.NoError(t, err)
+	_, _, result, err := codec.Deserialize([]*storage.Blob{{Value: data}})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{100, 200}, result.Tss)
+
+	// nothing before the timestamp
+	deltalogs, err = m.backupDeltalogs("b2", segment, 50)
+	require.NoError(t, err)
+	assert.Equal(t, 0, len(deltalogs))
+}
+
+func TestRebaseBinlogPath(t *testing.T) {
+	segment := &datapb.SegmentInfo{ID: 3, CollectionID: 1, PartitionID: 2}
+	restored := &datapb.SegmentInfo{ID: 30, CollectionID: 10, PartitionID: 20}
+
+	p, err := rebaseBinlogPath("root/insert_log/1/2/3/100/7", segment, 200, restored)
+	assert.NoError(t, err)
+	assert.Equal(t, "root/insert_log/10/20/30/200/7", p)
+
+	p, err = rebaseBinlogPath("root/delta_log/1/2/3/7", segment, 0, restored)
+	assert.NoError(t, err)
+	assert.Equal(t, "root/delta_log/10/20/30/7", p)
+
+	_, err = rebaseBinlogPath("root/insert_log/1/2/4/100/7", segment, 200, restored)
+	assert.Error(t, err)
+	_, err = rebaseBinlogPath("7", segment, 200, restored)
+	assert.Error(t, err)
+}
@@ -21,3 +21,38 @@ const (
 	SegmentMetaPrefix    = "queryCoord-segmentMeta"
 	ChangeInfoMetaPrefix = "queryCoord-sealedSegmentChangeInfo"
 )
+
+// Credential consts
+const (
+	// CredentialPrefix is the meta prefix of user credentials
+	CredentialPrefix = "credential/users"
+	// HeaderAuthorize is the grpc metadata key carrying base64 encoded `username:password`
+	HeaderAuthorize = "authorization"
+	// HeaderNodeToken is the grpc metadata key carrying the node token of milvus components
+	HeaderNodeToken = "node-token"
+	// CredentialSeparator separates username and password in the authorization header
+	CredentialSeparator = ":"
+	// UserRoot is the default user created by root coord
+	UserRoot = "root"
+	// DefaultRootPassword is the password of the default root user
+	DefaultRootPassword = "Milvus"
+)
+
+// RBAC consts
+const (
+	// RolePrefix is the meta prefix of roles
+	RolePrefix = "credential/roles"
+	// RoleMappingPrefix is the meta prefix of the binding between users and roles
+	RoleMappingPrefix = "credential/user-role-mapping"
+	// GrantPrefix is the meta prefix of the privileges granted to roles
+	GrantPrefix = "credential/grants"
+
+	// ObjectTypeCollection is the object type of privileges on specific collection
+	ObjectTypeCollection = "Collection"
+	// ObjectTypeGlobal is the object type of privileges which are not bound to any collection
+	ObjectTypeGlobal = "Global"
+	// AnyWord matches all the object names
+	AnyWord = "*"
+	// PrivilegeAll matches all the privileges
+	PrivilegeAll = "All"
+)
@@ -0,0 +1,60 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// PasswordEncrypt returns the bcrypt hash of the raw password
+func PasswordEncrypt(pwd string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(pwd), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(bytes), nil
+}
+
+// PasswordVerify checks whether the raw password matches the encrypted one generated by PasswordEncrypt
+func PasswordVerify(rawPwd string, encryptedPwd string) bool {
+	return bcrypt.CompareHashAndPassword([]byte(encryptedPwd), []byte(rawPwd)) == nil
+}
+
+// SHA256 returns the hex encoded sha256 of the string with salt, it's cheap enough to verify every request
+// against a password which has been verified by PasswordVerify
+func SHA256(src string, salt string) string {
+	sum := sha256.Sum256([]byte(src + salt))
+	return hex.EncodeToString(sum[:])
+}
+
+// Base64Encode encodes the string with standard base64 encoding
+func Base64Encode(s string) string {
+	return base64.StdEncoding.EncodeToString([]byte(s))
+}
+
+// Base64Decode decodes the string encoded by Base64Encode
+func Base64Decode(s string) (string, error) {
+	b, err := base64.StdEncoding.DecodeString(s)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
@@ -0,0 +1,56 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordVerify(t *testing.T) {
+	encrypted, err := PasswordEncrypt("Milvus")
+	assert.Nil(t, err)
+	assert.NotEqual(t, "Milvus", encrypted)
+	assert.True(t, PasswordVerify("Milvus", encrypted))
+	assert.False(t, PasswordVerify("milvus", encrypted))
+	assert.False(t, PasswordVerify("Milvus", "not-encrypted"))
+	assert.False(t, PasswordVerify("Milvus", "zz$zz"))
+
+	// salted, the same password is encrypted differently
+	another, err := PasswordEncrypt("Milvus")
+	assert.Nil(t, err)
+	assert.NotEqual(t, encrypted, another)
+	assert.True(t, PasswordVerify("Milvus", another))
+}
+
+func TestSHA256(t *testing.T) {
+	assert.Equal(t, SHA256("Milvus", "root"), SHA256("Milvus", "root"))
+	assert.NotEqual(t, SHA256("Milvus", "root"), SHA256("Milvus", "user1"))
+	assert.NotEqual(t, SHA256("Milvus", "root"), SHA256("milvus", "root"))
+	assert.Equal(t, 64, len(SHA256("Milvus", "root")))
+}
+
+func TestBase64(t *testing.T) {
+	encoded := Base64Encode("root:Milvus")
+	decoded, err := Base64Decode(encoded)
+	assert.Nil(t, err)
+	assert.Equal(t, "root:Milvus", decoded)
+
+	_, err = Base64Decode("!invalid")
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,102 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+
+	"google.golang.org/grpc/credentials"
+)
+
+// loadCertPool reads the PEM encoded CA certificates from the file
+func loadCertPool(caPemPath string) (*x509.CertPool, error) {
+	caPem, err := ioutil.ReadFile(caPemPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ca cert %s, err: %w", caPemPath, err)
+	}
+	certPool := x509.NewCertPool()
+	if !certPool.AppendCertsFromPEM(caPem) {
+		return nil, fmt.Errorf("failed to append ca cert %s", caPemPath)
+	}
+	return certPool, nil
+}
+
+// ServerTLSConfig returns the tls config of a grpc server with the certificate and key,
+// the certificates of clients are required and verified by the CA if mutual is set
+func ServerTLSConfig(serverPemPath, serverKeyPath, caPemPath string, mutual bool) (*tls.Config, error) {
+	cert, err := tls.LoadX509KeyPair(serverPemPath, serverKeyPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load server cert %s, err: %w", serverPemPath, err)
+	}
+	config := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+	}
+	if mutual {
+		certPool, err := loadCertPool(caPemPath)
+		if err != nil {
+			return nil, err
+		}
+		config.ClientAuth = tls.RequireAndVerifyClientCert
+		config.ClientCAs = certPool
+	}
+	return config, nil
+}
+
+// ClientTLSConfig returns the tls config of a grpc client which verifies the server by the CA,
+// the client presents its own certificate for mutual tls if certPemPath and keyPath are not empty.
+// The server certificate is verified against serverName, or against the host of the dialed address if it's empty.
+func ClientTLSConfig(caPemPath, certPemPath, keyPath, serverName string) (*tls.Config, error) {
+	certPool, err := loadCertPool(caPemPath)
+	if err != nil {
+		return nil, err
+	}
+	config := &tls.Config{
+		RootCAs:    certPool,
+		ServerName: serverName,
+		MinVersion: tls.VersionTLS12,
+	}
+	if certPemPath != "" && keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPemPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load client cert %s, err: %w", certPemPath, err)
+		}
+		config.Certificates = []tls.Certificate{cert}
+	}
+	return config, nil
+}
+
+// ServerCredentials wraps ServerTLSConfig as grpc transport credentials
+func ServerCredentials(serverPemPath, serverKeyPath, caPemPath string, mutual bool) (credentials.TransportCredentials, error) {
+	config, err := ServerTLSConfig(serverPemPath, serverKeyPath, caPemPath, mutual)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(config), nil
+}
+
+// ClientCredentials wraps ClientTLSConfig as grpc transport credentials
+func ClientCredentials(caPemPath, certPemPath, keyPath, serverName string) (credentials.TransportCredentials, error) {
+	config, err := ClientTLSConfig(caPemPath, certPemPath, keyPath, serverName)
+	if err != nil {
+		return nil, err
+	}
+	return credentials.NewTLS(config), nil
+}
@@ -0,0 +1,244 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package crypto
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+type testCerts struct {
+	caPemPath     string
+	serverPemPath string
+	serverKeyPath string
+	clientPemPath string
+	clientKeyPath string
+	// otherServerPemPath is a server certificate for another host, its only SAN is other.host
+	otherServerPemPath string
+	otherServerKeyPath string
+	// otherCaPemPath is a CA which has not signed any certificate above
+	otherCaPemPath string
+}
+
+func writePem(t *testing.T, filePath string, blockType string, bytes []byte) {
+	f, err := os.Create(filePath)
+	require.Nil(t, err)
+	defer f.Close()
+	require.Nil(t, pem.Encode(f, &pem.Block{Type: blockType, Bytes: bytes}))
+}
+
+func genCA(t *testing.T, dir string, name string) (*x509.Certificate, *ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.Nil(t, err)
+	cert, err := x509.ParseCertificate(der)
+	require.Nil(t, err)
+	pemPath := path.Join(dir, name+".pem")
+	writePem(t, pemPath, "CERTIFICATE", der)
+	return cert, key, pemPath
+}
+
+// genCert generates a certificate signed by the CA, whose SANs are the dnsName and the ip if it's not empty
+func genCert(t *testing.T, dir string, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey, serial int64, dnsName string, ip string) (string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(serial),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{dnsName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if ip != "" {
+		template.IPAddresses = []net.IP{net.ParseIP(ip)}
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.Nil(t, err)
+	keyBytes, err := x509.MarshalECPrivateKey(key)
+	require.Nil(t, err)
+
+	pemPath := path.Join(dir, name+".pem")
+	keyPath := path.Join(dir, name+".key")
+	writePem(t, pemPath, "CERTIFICATE", der)
+	writePem(t, keyPath, "EC PRIVATE KEY", keyBytes)
+	return pemPath, keyPath
+}
+
+// genTestCerts generates self-signed CA and the certificates signed by it into dir
+func genTestCerts(t *testing.T, dir string) *testCerts {
+	ca, caKey, caPemPath := genCA(t, dir, "ca")
+	serverPemPath, serverKeyPath := genCert(t, dir, "server", ca, caKey, 2, "localhost", "127.0.0.1")
+	clientPemPath, clientKeyPath := genCert(t, dir, "client", ca, caKey, 3, "client", "")
+	otherServerPemPath, otherServerKeyPath := genCert(t, dir, "other_server", ca, caKey, 4, "other.host", "")
+	_, _, otherCaPemPath := genCA(t, dir, "other_ca")
+	return &testCerts{
+		caPemPath:          caPemPath,
+		serverPemPath:      serverPemPath,
+		serverKeyPath:      serverKeyPath,
+		clientPemPath:      clientPemPath,
+		clientKeyPath:      clientKeyPath,
+		otherServerPemPath: otherServerPemPath,
+		otherServerKeyPath: otherServerKeyPath,
+		otherCaPemPath:     otherCaPemPath,
+	}
+}
+
+// startHealthServer starts a grpc server which serves the health service with the certificate
+func startHealthServer(t *testing.T, certs *testCerts, serverPemPath, serverKeyPath string, mutual bool) (string, func()) {
+	creds, err := ServerCredentials(serverPemPath, serverKeyPath, certs.caPemPath, mutual)
+	require.Nil(t, err)
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.Nil(t, err)
+	server := grpc.NewServer(grpc.Creds(creds))
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	return lis.Addr().String(), server.Stop
+}
+
+func checkHealth(addr string, caPemPath, certPemPath, keyPath, serverName string) error {
+	creds, err := ClientCredentials(caPemPath, certPemPath, keyPath, serverName)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 3*time.Second)
+	defer cancel()
+	conn, err := grpc.DialContext(ctx, addr, grpc.WithTransportCredentials(creds), grpc.WithBlock(), grpc.FailOnNonTempDialError(true))
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	_, err = grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{})
+	return err
+}
+
+func TestTLSConfig(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_tls_test")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certs := genTestCerts(t, dir)
+
+	config, err := ServerTLSConfig(certs.serverPemPath, certs.serverKeyPath, certs.caPemPath, false)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(config.Certificates))
+	assert.Nil(t, config.ClientCAs)
+
+	config, err = ServerTLSConfig(certs.serverPemPath, certs.serverKeyPath, certs.caPemPath, true)
+	assert.Nil(t, err)
+	assert.NotNil(t, config.ClientCAs)
+
+	_, err = ServerTLSConfig(path.Join(dir, "not_exist.pem"), certs.serverKeyPath, certs.caPemPath, false)
+	assert.NotNil(t, err)
+	_, err = ServerTLSConfig(certs.serverPemPath, certs.serverKeyPath, path.Join(dir, "not_exist.pem"), true)
+	assert.NotNil(t, err)
+	// the key is not a valid ca certificate
+	_, err = ServerTLSConfig(certs.serverPemPath, certs.serverKeyPath, certs.serverKeyPath, true)
+	assert.NotNil(t, err)
+
+	config, err = ClientTLSConfig(certs.caPemPath, "", "", "localhost")
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(config.Certificates))
+	assert.Equal(t, "localhost", config.ServerName)
+
+	config, err = ClientTLSConfig(certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "localhost")
+	assert.Nil(t, err)
+	assert.Equal(t, 1, len(config.Certificates))
+
+	_, err = ClientTLSConfig(path.Join(dir, "not_exist.pem"), "", "", "localhost")
+	assert.NotNil(t, err)
+	_, err = ClientTLSConfig(certs.caPemPath, certs.clientPemPath, certs.serverKeyPath, "localhost")
+	assert.NotNil(t, err)
+}
+
+func TestTLSConnection(t *testing.T) {
+	dir, err := ioutil.TempDir("", "milvus_tls_test")
+	require.Nil(t, err)
+	defer os.RemoveAll(dir)
+	certs := genTestCerts(t, dir)
+
+	t.Run("one-way tls", func(t *testing.T) {
+		addr, stop := startHealthServer(t, certs, certs.serverPemPath, certs.serverKeyPath, false)
+		defer stop()
+
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, "", "", "localhost"))
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "localhost"))
+		// the server is not signed by the CA
+		assert.NotNil(t, checkHealth(addr, certs.otherCaPemPath, "", "", "localhost"))
+	})
+
+	t.Run("mutual tls", func(t *testing.T) {
+		addr, stop := startHealthServer(t, certs, certs.serverPemPath, certs.serverKeyPath, true)
+		defer stop()
+
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "localhost"))
+		// the server certificate can be used as client certificate as well
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, certs.serverPemPath, certs.serverKeyPath, "localhost"))
+		// the client does not present certificate
+		assert.NotNil(t, checkHealth(addr, certs.caPemPath, "", "", "localhost"))
+	})
+
+	t.Run("server name", func(t *testing.T) {
+		addr, stop := startHealthServer(t, certs, certs.serverPemPath, certs.serverKeyPath, true)
+		defer stop()
+
+		// the host of the address 127.0.0.1 is verified if the server name is not set
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, ""))
+		assert.NotNil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "other.host"))
+	})
+
+	t.Run("mismatched san", func(t *testing.T) {
+		addr, stop := startHealthServer(t, certs, certs.otherServerPemPath, certs.otherServerKeyPath, true)
+		defer stop()
+
+		// the certificate is issued for other.host, but the server is dialed at 127.0.0.1
+		assert.NotNil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, ""))
+		assert.NotNil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "localhost"))
+		// the shared name carried by the certificate
+		assert.Nil(t, checkHealth(addr, certs.caPemPath, certs.clientPemPath, certs.clientKeyPath, "other.host"))
+	})
+}
@@ -28,6 +28,7 @@ import (
 	"time"
 
 	"github.com/go-basic/ipv4"
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
 	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/internalpb"
@@ -170,6 +171,19 @@ func GetAttrByKeyFromRepeatedKV(key string, kvs []*commonpb.KeyValuePair) (strin
 	return "", errors.New("key " + key + " not found")
 }
 
+// GetCollectionTTL returns the ttl in the collection properties, zero means the entities never expire
+func GetCollectionTTL(properties []*commonpb.KeyValuePair) (time.Duration, error) {
+	value, err := GetAttrByKeyFromRepeatedKV(common.CollectionTTLConfigKey, properties)
+	if err != nil {
+		return 0, nil
+	}
+	seconds, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || seconds < 0 {
+		return 0, fmt.Errorf("invalid %s %s, it should be a non-negative integer", common.CollectionTTLConfigKey, value)
+	}
+	return time.Duration(seconds) * time.Second, nil
+}
+
 // CheckCtxValid check if the context is valid
 func CheckCtxValid(ctx context.Context) bool {
 	return ctx.Err() != context.DeadlineExceeded && ctx.Err() != context.Canceled
@@ -24,12 +24,15 @@ import (
 
 	grpcopentracing "github.com/grpc-ecosystem/go-grpc-middleware/tracing/opentracing"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util"
+	"github.com/milvus-io/milvus/internal/util/crypto"
 	"github.com/milvus-io/milvus/internal/util/funcutil"
 	"github.com/milvus-io/milvus/internal/util/trace"
 	"go.uber.org/zap"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/backoff"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/metadata"
 )
 
 const (
@@ -61,6 +64,17 @@ type ClientBase struct {
 	role              string
 	ClientMaxSendSize int
 	ClientMaxRecvSize int
+
+	// TLSMode is not zero if the server is served with tls, the client presents its own certificate
+	// as well if ClientPemPath and ClientKeyPath are set
+	TLSMode       int
+	CaPemPath     string
+	ClientPemPath string
+	ClientKeyPath string
+	ServerName    string
+
+	// NodeToken is attached to every request if not empty, which authenticates milvus components to the server
+	NodeToken string
 }
 
 // SetRole sets role of client
@@ -132,7 +146,21 @@ func (c *ClientBase) connect(ctx context.Context) error {
 		return err
 	}
 
+	transportOpt := grpc.WithInsecure()
+	if c.TLSMode != 0 {
+		creds, err := crypto.ClientCredentials(c.CaPemPath, c.ClientPemPath, c.ClientKeyPath, c.ServerName)
+		if err != nil {
+			log.Error("failed to load client tls credentials", zap.String("role", c.GetRole()), zap.Error(err))
+			return err
+		}
+		transportOpt = grpc.WithTransportCredentials(creds)
+	}
+
 	opts := trace.GetInterceptorOpts()
+	unaryInterceptors := []grpc.UnaryClientInterceptor{grpcopentracing.UnaryClientInterceptor(opts...)}
+	if c.NodeToken != "" {
+		unaryInterceptors = append(unaryInterceptors, nodeTokenInterceptor(c.NodeToken))
+	}
 	dialContext, cancel := context.WithTimeout(ctx, dialTimeout)
 
 	// refer to https://github.com/grpc/grpc-proto/blob/master/grpc/service_config/service_config.proto
@@ -152,13 +180,13 @@ func (c *ClientBase) connect(ctx context.Context) error {
 	conn, err := grpc.DialContext(
 		dialContext,
 		addr,
-		grpc.WithInsecure(),
+		transportOpt,
 		grpc.WithBlock(),
 		grpc.WithDefaultCallOptions(
 			grpc.MaxCallRecvMsgSize(c.ClientMaxRecvSize),
 			grpc.MaxCallSendMsgSize(c.ClientMaxSendSize),
 		),
-		grpc.WithUnaryInterceptor(grpcopentracing.UnaryClientInterceptor(opts...)),
+		grpc.WithChainUnaryInterceptor(unaryInterceptors...),
 		grpc.WithStreamInterceptor(grpcopentracing.StreamClientInterceptor(opts...)),
 		grpc.WithDefaultServiceConfig(retryPolicy),
 		grpc.WithKeepaliveParams(keepalive.ClientParameters{
@@ -266,3 +294,11 @@ func (c *ClientBase) Close() error {
 	}
 	return nil
 }
+
+// nodeTokenInterceptor returns a grpc unary interceptor which attaches the node token to the outgoing requests
+func nodeTokenInterceptor(nodeToken string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		ctx = metadata.AppendToOutgoingContext(ctx, util.HeaderNodeToken, nodeToken)
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
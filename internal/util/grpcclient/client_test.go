@@ -17,9 +17,13 @@
 package grpcclient
 
 import (
+	"context"
 	"testing"
 
+	"github.com/milvus-io/milvus/internal/util"
 	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
 )
 
 func TestClientBase_SetRole(t *testing.T) {
@@ -33,3 +37,27 @@ func TestClientBase_GetRole(t *testing.T) {
 	base := ClientBase{}
 	assert.Equal(t, "", base.GetRole())
 }
+
+func TestClientBase_ConnectWithInvalidTLS(t *testing.T) {
+	base := ClientBase{
+		TLSMode:   2,
+		CaPemPath: "/not/exist/ca.pem",
+	}
+	base.SetGetAddrFunc(func() (string, error) {
+		return "localhost:19530", nil
+	})
+	_, err := base.GetGrpcClient(context.Background())
+	assert.NotNil(t, err)
+}
+
+func TestNodeTokenInterceptor(t *testing.T) {
+	interceptor := nodeTokenInterceptor("token")
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		md, ok := metadata.FromOutgoingContext(ctx)
+		assert.True(t, ok)
+		assert.Equal(t, []string{"token"}, md.Get(util.HeaderNodeToken))
+		return nil
+	}
+	err := interceptor(context.Background(), "/milvus.proto.proxy.Proxy/GetComponentStates", nil, nil, nil, invoker)
+	assert.Nil(t, err)
+}
@@ -0,0 +1,180 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+	"github.com/milvus-io/milvus/internal/util/funcutil"
+)
+
+const (
+	// JSONFileExt is the extension of the files holding rows
+	JSONFileExt = ".json"
+	// NumpyFileExt is the extension of the files holding a column
+	NumpyFileExt = ".npy"
+)
+
+// ValidateFiles checks the extensions of the files, the row based files must be json files,
+// the column based files must be numpy files named by the fields and must not be duplicated
+func ValidateFiles(rowBased bool, files []string) error {
+	if len(files) == 0 {
+		return fmt.Errorf("no files to import")
+	}
+	names := make(map[string]struct{}, len(files))
+	for _, file := range files {
+		ext := path.Ext(file)
+		if rowBased {
+			if ext != JSONFileExt {
+				return fmt.Errorf("row based file %s is not a %s file", file, JSONFileExt)
+			}
+			continue
+		}
+		if ext != NumpyFileExt {
+			return fmt.Errorf("column based file %s is not a %s file", file, NumpyFileExt)
+		}
+		name := ColumnName(file)
+		if _, ok := names[name]; ok {
+			return fmt.Errorf("duplicated column %s", name)
+		}
+		names[name] = struct{}{}
+	}
+	return nil
+}
+
+// ColumnName returns the name of the field held by a column based file, which is the file name without the extension
+func ColumnName(file string) string {
+	return strings.TrimSuffix(path.Base(file), path.Ext(file))
+}
+
+// importFields returns the fields whose data should be provided by the files,
+// the system fields and the auto generated primary key are excluded
+func importFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	fields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() < common.StartOfUserFieldID {
+			continue
+		}
+		if field.GetIsPrimaryKey() && field.GetAutoID() {
+			continue
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// vectorDim returns the dimension of a vector field
+func vectorDim(field *schemapb.FieldSchema) (int, error) {
+	value, err := funcutil.GetAttrByKeyFromRepeatedKV("dim", field.GetTypeParams())
+	if err != nil {
+		return 0, fmt.Errorf("dimension of vector field %s is not set", field.GetName())
+	}
+	dim, err := strconv.Atoi(value)
+	if err != nil || dim <= 0 {
+		return 0, fmt.Errorf("invalid dimension %s of vector field %s", value, field.GetName())
+	}
+	if field.GetDataType() == schemapb.DataType_BinaryVector && dim%8 != 0 {
+		return 0, fmt.Errorf("dimension %d of binary vector field %s is not a multiple of 8", dim, field.GetName())
+	}
+	return dim, nil
+}
+
+// newFieldData creates an empty column of the field
+func newFieldData(field *schemapb.FieldSchema) (storage.FieldData, error) {
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return &storage.BoolFieldData{}, nil
+	case schemapb.DataType_Int8:
+		return &storage.Int8FieldData{}, nil
+	case schemapb.DataType_Int16:
+		return &storage.Int16FieldData{}, nil
+	case schemapb.DataType_Int32:
+		return &storage.Int32FieldData{}, nil
+	case schemapb.DataType_Int64:
+		return &storage.Int64FieldData{}, nil
+	case schemapb.DataType_Float:
+		return &storage.FloatFieldData{}, nil
+	case schemapb.DataType_Double:
+		return &storage.DoubleFieldData{}, nil
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return &storage.StringFieldData{}, nil
+	case schemapb.DataType_FloatVector:
+		dim, err := vectorDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &storage.FloatVectorFieldData{Dim: dim}, nil
+	case schemapb.DataType_BinaryVector:
+		dim, err := vectorDim(field)
+		if err != nil {
+			return nil, err
+		}
+		return &storage.BinaryVectorFieldData{Dim: dim}, nil
+	default:
+		return nil, fmt.Errorf("unsupported data type %s of field %s", field.GetDataType().String(), field.GetName())
+	}
+}
+
+// InsertDataSize returns the number of bytes taken by the values of the columns
+func InsertDataSize(data *storage.InsertData) int {
+	var size int
+	for _, fieldData := range data.Data {
+		if fd, ok := fieldData.(*storage.StringFieldData); ok {
+			for _, value := range fd.Data {
+				size += len(value)
+			}
+			continue
+		}
+		size += fieldData.GetMemorySize()
+	}
+	return size
+}
+
+// fillNumRows sets the number of rows of each column, which is required by the insert codec
+func fillNumRows(data *storage.InsertData) {
+	for _, fieldData := range data.Data {
+		numRows := []int64{int64(fieldData.RowNum())}
+		switch fd := fieldData.(type) {
+		case *storage.BoolFieldData:
+			fd.NumRows = numRows
+		case *storage.Int8FieldData:
+			fd.NumRows = numRows
+		case *storage.Int16FieldData:
+			fd.NumRows = numRows
+		case *storage.Int32FieldData:
+			fd.NumRows = numRows
+		case *storage.Int64FieldData:
+			fd.NumRows = numRows
+		case *storage.FloatFieldData:
+			fd.NumRows = numRows
+		case *storage.DoubleFieldData:
+			fd.NumRows = numRows
+		case *storage.StringFieldData:
+			fd.NumRows = numRows
+		case *storage.FloatVectorFieldData:
+			fd.NumRows = numRows
+		case *storage.BinaryVectorFieldData:
+			fd.NumRows = numRows
+		}
+	}
+}
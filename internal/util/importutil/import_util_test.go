@@ -0,0 +1,187 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+func newTestSchema(autoID bool) *schemapb.CollectionSchema {
+	return &schemapb.CollectionSchema{
+		Name: "test_import",
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.RowIDField, Name: common.RowIDFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: common.TimeStampFieldName, DataType: schemapb.DataType_Int64},
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, AutoID: autoID, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "flag", DataType: schemapb.DataType_Bool},
+			{FieldID: 102, Name: "age", DataType: schemapb.DataType_Int8},
+			{FieldID: 103, Name: "score", DataType: schemapb.DataType_Double},
+			{FieldID: 104, Name: "vec", DataType: schemapb.DataType_FloatVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "2"}}},
+			{FieldID: 105, Name: "bin", DataType: schemapb.DataType_BinaryVector,
+				TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "16"}}},
+		},
+	}
+}
+
+func TestValidateFiles(t *testing.T) {
+	assert.Error(t, ValidateFiles(true, nil))
+	assert.NoError(t, ValidateFiles(true, []string{"a/rows1.json", "a/rows2.json"}))
+	assert.Error(t, ValidateFiles(true, []string{"a/vec.npy"}))
+	assert.NoError(t, ValidateFiles(false, []string{"a/vec.npy", "a/pk.npy"}))
+	assert.Error(t, ValidateFiles(false, []string{"a/rows.json"}))
+	assert.Error(t, ValidateFiles(false, []string{"a/vec.npy", "b/vec.npy"}))
+}
+
+func TestColumnName(t *testing.T) {
+	assert.Equal(t, "vec", ColumnName("bucket/path/vec.npy"))
+	assert.Equal(t, "pk", ColumnName("pk.npy"))
+}
+
+func TestImportFields(t *testing.T) {
+	fields := importFields(newTestSchema(true))
+	assert.Equal(t, 5, len(fields))
+	assert.Equal(t, "flag", fields[0].GetName())
+
+	fields = importFields(newTestSchema(false))
+	assert.Equal(t, 6, len(fields))
+	assert.Equal(t, "pk", fields[0].GetName())
+}
+
+func TestNewFieldData(t *testing.T) {
+	for _, field := range newTestSchema(false).GetFields() {
+		_, err := newFieldData(field)
+		assert.NoError(t, err)
+	}
+
+	_, err := newFieldData(&schemapb.FieldSchema{Name: "vec", DataType: schemapb.DataType_FloatVector})
+	assert.Error(t, err)
+	_, err = newFieldData(&schemapb.FieldSchema{Name: "bin", DataType: schemapb.DataType_BinaryVector,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "dim", Value: "12"}}})
+	assert.Error(t, err)
+	_, err = newFieldData(&schemapb.FieldSchema{Name: "none", DataType: schemapb.DataType_None})
+	assert.Error(t, err)
+}
+
+// collectBatches returns a handler keeping the batches it receives
+func collectBatches(batches *[]*storage.InsertData) func(*storage.InsertData) error {
+	return func(data *storage.InsertData) error {
+		*batches = append(*batches, data)
+		return nil
+	}
+}
+
+func TestParseJSONRows(t *testing.T) {
+	schema := newTestSchema(true)
+
+	t.Run("normal", func(t *testing.T) {
+		content := `{"rows": [
+			{"flag": true, "age": 10, "score": 1.5, "vec": [1.0, 2.0], "bin": [1, 255]},
+			{"flag": false, "age": -5, "score": 0, "vec": [3, 4], "bin": [0, 128]}
+		]}`
+		var batches []*storage.InsertData
+		rowNum, err := ParseJSONRows(strings.NewReader(content), schema, 1024, collectBatches(&batches))
+		assert.NoError(t, err)
+		assert.Equal(t, 2, rowNum)
+		assert.Equal(t, 1, len(batches))
+		data := batches[0]
+		assert.Equal(t, 5, len(data.Data))
+		assert.Equal(t, []bool{true, false}, data.Data[101].(*storage.BoolFieldData).Data)
+		assert.Equal(t, []int8{10, -5}, data.Data[102].(*storage.Int8FieldData).Data)
+		assert.Equal(t, []float64{1.5, 0}, data.Data[103].(*storage.DoubleFieldData).Data)
+		assert.Equal(t, []float32{1, 2, 3, 4}, data.Data[104].(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, []byte{1, 255, 0, 128}, data.Data[105].(*storage.BinaryVectorFieldData).Data)
+		assert.Equal(t, []int64{2}, data.Data[104].(*storage.FloatVectorFieldData).NumRows)
+	})
+
+	t.Run("batches", func(t *testing.T) {
+		content := `{"rows": [
+			{"flag": true, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 2]},
+			{"flag": true, "age": 2, "score": 1, "vec": [1, 2], "bin": [1, 2]},
+			{"flag": true, "age": 3, "score": 1, "vec": [1, 2], "bin": [1, 2]}
+		]}`
+		var batches []*storage.InsertData
+		rowNum, err := ParseJSONRows(strings.NewReader(content), schema, 1, collectBatches(&batches))
+		assert.NoError(t, err)
+		assert.Equal(t, 3, rowNum)
+		require.Equal(t, 3, len(batches))
+		for i, data := range batches {
+			assert.Equal(t, []int8{int8(i + 1)}, data.Data[102].(*storage.Int8FieldData).Data)
+			assert.Equal(t, []int64{1}, data.Data[102].(*storage.Int8FieldData).NumRows)
+		}
+
+		// the error of handler stops the parsing
+		_, err = ParseJSONRows(strings.NewReader(content), schema, 1, func(*storage.InsertData) error {
+			return errors.New("mock error")
+		})
+		assert.Error(t, err)
+	})
+
+	t.Run("primary key", func(t *testing.T) {
+		content := `{"rows": [{"pk": 7, "flag": true, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 2]}]}`
+		var batches []*storage.InsertData
+		rowNum, err := ParseJSONRows(strings.NewReader(content), newTestSchema(false), 1024, collectBatches(&batches))
+		assert.NoError(t, err)
+		assert.Equal(t, 1, rowNum)
+		assert.Equal(t, []int64{7}, batches[0].Data[100].(*storage.Int64FieldData).Data)
+
+		// the auto generated primary key must not be provided
+		_, err = ParseJSONRows(strings.NewReader(content), schema, 1024, collectBatches(&batches))
+		assert.Error(t, err)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		contents := []string{
+			``,
+			`[]`,
+			`{"data": []}`,
+			`{"rows": {}}`,
+			`{"rows": []}`,
+			`{"rows": [{"flag": true}]}`,
+			`{"rows": [{"flag": 1, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 2]}]}`,
+			`{"rows": [{"flag": true, "age": 128, "score": 1, "vec": [1, 2], "bin": [1, 2]}]}`,
+			`{"rows": [{"flag": true, "age": 1.5, "score": 1, "vec": [1, 2], "bin": [1, 2]}]}`,
+			`{"rows": [{"flag": true, "age": 1, "score": 1, "vec": [1, 2, 3], "bin": [1, 2]}]}`,
+			`{"rows": [{"flag": true, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 256]}]}`,
+			`{"rows": [{"flag": true, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 2], "other": 1}]}`,
+			`{"rows": [{"flag": true, "age": 1, "score": 1, "vec": [1, 2], "bin": [1, 2]}`,
+		}
+		for _, content := range contents {
+			var batches []*storage.InsertData
+			_, err := ParseJSONRows(strings.NewReader(content), schema, 1024, collectBatches(&batches))
+			assert.Error(t, err, content)
+		}
+	})
+}
+
+func TestInsertDataSize(t *testing.T) {
+	data := &storage.InsertData{Data: map[int64]storage.FieldData{
+		100: &storage.Int64FieldData{Data: []int64{1, 2}},
+		101: &storage.StringFieldData{Data: []string{"abc", "de"}},
+	}}
+	assert.Equal(t, 16+5, InsertDataSize(data))
+}
@@ -0,0 +1,261 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// rowsKey is the key of the row array in a row based json file, such as {"rows": [{"id": 1, "vec": [0.1, 0.2]}]}
+const rowsKey = "rows"
+
+// jsonRowParser appends the rows decoded from a json file to the columns of the fields
+type jsonRowParser struct {
+	fields  []*schemapb.FieldSchema
+	columns map[int64]storage.FieldData
+	rowNum  int
+	// the rows in the columns and the bytes of their json values
+	batchRows int
+	batchSize int
+}
+
+// ParseJSONRows decodes the rows from a row based json file and validates them against the schema,
+// the values of all the fields except the auto generated primary key must be provided by each row.
+// The rows are handed to handler in batches, a batch is cut once the json values of its rows take batchSize bytes,
+// so only a batch of the file is held in memory. The number of rows is returned.
+func ParseJSONRows(reader io.Reader, schema *schemapb.CollectionSchema, batchSize int, handler func(*storage.InsertData) error) (int, error) {
+	p := &jsonRowParser{
+		fields: importFields(schema),
+	}
+	if err := p.reset(); err != nil {
+		return 0, err
+	}
+
+	decoder := json.NewDecoder(reader)
+	if err := expectDelim(decoder, '{'); err != nil {
+		return 0, err
+	}
+	for decoder.More() {
+		token, err := decoder.Token()
+		if err != nil {
+			return 0, fmt.Errorf("failed to decode json, err: %w", err)
+		}
+		if key, ok := token.(string); !ok || key != rowsKey {
+			return 0, fmt.Errorf("invalid key %v in json, only %s is allowed", token, rowsKey)
+		}
+		if err := expectDelim(decoder, '['); err != nil {
+			return 0, err
+		}
+		for decoder.More() {
+			row := make(map[string]json.RawMessage)
+			if err := decoder.Decode(&row); err != nil {
+				return 0, fmt.Errorf("failed to decode row %d, err: %w", p.rowNum, err)
+			}
+			if err := p.appendRow(row); err != nil {
+				return 0, fmt.Errorf("invalid row %d, err: %w", p.rowNum, err)
+			}
+			p.rowNum++
+			if p.batchSize >= batchSize {
+				if err := p.flush(handler); err != nil {
+					return 0, err
+				}
+			}
+		}
+		if err := expectDelim(decoder, ']'); err != nil {
+			return 0, err
+		}
+	}
+	if err := expectDelim(decoder, '}'); err != nil {
+		return 0, err
+	}
+
+	if p.rowNum == 0 {
+		return 0, fmt.Errorf("no rows to import")
+	}
+	if err := p.flush(handler); err != nil {
+		return 0, err
+	}
+	return p.rowNum, nil
+}
+
+// reset replaces the columns with empty ones for the next batch
+func (p *jsonRowParser) reset() error {
+	p.columns = make(map[int64]storage.FieldData, len(p.fields))
+	for _, field := range p.fields {
+		fieldData, err := newFieldData(field)
+		if err != nil {
+			return err
+		}
+		p.columns[field.GetFieldID()] = fieldData
+	}
+	p.batchRows = 0
+	p.batchSize = 0
+	return nil
+}
+
+// flush hands the rows of the batch to handler if there are any
+func (p *jsonRowParser) flush(handler func(*storage.InsertData) error) error {
+	if p.batchRows == 0 {
+		return nil
+	}
+	data := &storage.InsertData{Data: p.columns}
+	fillNumRows(data)
+	if err := handler(data); err != nil {
+		return err
+	}
+	return p.reset()
+}
+
+func expectDelim(decoder *json.Decoder, delim json.Delim) error {
+	token, err := decoder.Token()
+	if err != nil {
+		return fmt.Errorf("failed to decode json, err: %w", err)
+	}
+	if d, ok := token.(json.Delim); !ok || d != delim {
+		return fmt.Errorf("invalid json, expect %s but got %v", delim.String(), token)
+	}
+	return nil
+}
+
+func (p *jsonRowParser) appendRow(row map[string]json.RawMessage) error {
+	if len(row) > len(p.fields) {
+		for name := range row {
+			if !p.hasField(name) {
+				return fmt.Errorf("field %s does not exist or is auto generated", name)
+			}
+		}
+	}
+	for _, field := range p.fields {
+		raw, ok := row[field.GetName()]
+		if !ok {
+			return fmt.Errorf("the value of field %s is missing", field.GetName())
+		}
+		if err := appendValue(p.columns[field.GetFieldID()], raw); err != nil {
+			return fmt.Errorf("invalid value of field %s, err: %w", field.GetName(), err)
+		}
+		p.batchSize += len(raw)
+	}
+	p.batchRows++
+	return nil
+}
+
+func (p *jsonRowParser) hasField(name string) bool {
+	for _, field := range p.fields {
+		if field.GetName() == name {
+			return true
+		}
+	}
+	return false
+}
+
+func unmarshalInt(raw json.RawMessage, min, max int64) (int64, error) {
+	var value int64
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return 0, err
+	}
+	if value < min || value > max {
+		return 0, fmt.Errorf("%d is out of range [%d, %d]", value, min, max)
+	}
+	return value, nil
+}
+
+// appendValue decodes the json value according to the type of the column and appends it
+func appendValue(fieldData storage.FieldData, raw json.RawMessage) error {
+	switch fd := fieldData.(type) {
+	case *storage.BoolFieldData:
+		var value bool
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, value)
+	case *storage.Int8FieldData:
+		value, err := unmarshalInt(raw, math.MinInt8, math.MaxInt8)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int8(value))
+	case *storage.Int16FieldData:
+		value, err := unmarshalInt(raw, math.MinInt16, math.MaxInt16)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int16(value))
+	case *storage.Int32FieldData:
+		value, err := unmarshalInt(raw, math.MinInt32, math.MaxInt32)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, int32(value))
+	case *storage.Int64FieldData:
+		value, err := unmarshalInt(raw, math.MinInt64, math.MaxInt64)
+		if err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, value)
+	case *storage.FloatFieldData:
+		var value float32
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, value)
+	case *storage.DoubleFieldData:
+		var value float64
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, value)
+	case *storage.StringFieldData:
+		var value string
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		fd.Data = append(fd.Data, value)
+	case *storage.FloatVectorFieldData:
+		var value []float32
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		if len(value) != fd.Dim {
+			return fmt.Errorf("the dimension of vector is %d, but %d is expected", len(value), fd.Dim)
+		}
+		fd.Data = append(fd.Data, value...)
+	case *storage.BinaryVectorFieldData:
+		// the binary vector is an array of uint8, the json array of numbers can not be decoded as []byte directly
+		var value []uint16
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return err
+		}
+		if len(value)*8 != fd.Dim {
+			return fmt.Errorf("the dimension of binary vector is %d, but %d is expected", len(value)*8, fd.Dim)
+		}
+		for _, v := range value {
+			if v > math.MaxUint8 {
+				return fmt.Errorf("%d is out of range [0, %d]", v, math.MaxUint8)
+			}
+			fd.Data = append(fd.Data, byte(v))
+		}
+	default:
+		return fmt.Errorf("unsupported field data %T", fieldData)
+	}
+	return nil
+}
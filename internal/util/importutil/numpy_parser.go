@@ -0,0 +1,373 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/milvus-io/milvus/internal/common"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// numpyMagic is the magic string at the beginning of a npy file
+const numpyMagic = "\x93NUMPY"
+
+var (
+	numpyDescrPattern   = regexp.MustCompile(`'descr'\s*:\s*'([^']*)'`)
+	numpyFortranPattern = regexp.MustCompile(`'fortran_order'\s*:\s*(True|False)`)
+	numpyShapePattern   = regexp.MustCompile(`'shape'\s*:\s*\(([^)]*)\)`)
+	numpyStringPattern  = regexp.MustCompile(`^[<|=]?U[0-9]+$`)
+)
+
+// numpyHeader is the header of a npy file, see https://numpy.org/doc/stable/reference/generated/numpy.lib.format.html
+type numpyHeader struct {
+	descr string
+	shape []int
+}
+
+// readNumpyHeader reads the magic string, version and the header dict of a npy file
+func readNumpyHeader(reader io.Reader) (*numpyHeader, error) {
+	prefix := make([]byte, len(numpyMagic)+2)
+	if _, err := io.ReadFull(reader, prefix); err != nil {
+		return nil, fmt.Errorf("failed to read numpy header, err: %w", err)
+	}
+	if string(prefix[:len(numpyMagic)]) != numpyMagic {
+		return nil, fmt.Errorf("invalid numpy file, the magic string is not found")
+	}
+	var headerLen int
+	switch major := prefix[len(numpyMagic)]; major {
+	case 1:
+		var l uint16
+		if err := binary.Read(reader, binary.LittleEndian, &l); err != nil {
+			return nil, fmt.Errorf("failed to read numpy header, err: %w", err)
+		}
+		headerLen = int(l)
+	case 2, 3:
+		var l uint32
+		if err := binary.Read(reader, binary.LittleEndian, &l); err != nil {
+			return nil, fmt.Errorf("failed to read numpy header, err: %w", err)
+		}
+		headerLen = int(l)
+	default:
+		return nil, fmt.Errorf("unsupported numpy file version %d", major)
+	}
+	buf := make([]byte, headerLen)
+	if _, err := io.ReadFull(reader, buf); err != nil {
+		return nil, fmt.Errorf("failed to read numpy header, err: %w", err)
+	}
+	dict := string(buf)
+
+	header := &numpyHeader{}
+	match := numpyDescrPattern.FindStringSubmatch(dict)
+	if match == nil {
+		return nil, fmt.Errorf("invalid numpy header %s, descr is not found", dict)
+	}
+	header.descr = match[1]
+	if match = numpyFortranPattern.FindStringSubmatch(dict); match != nil && match[1] == "True" {
+		return nil, fmt.Errorf("fortran order numpy array is not supported")
+	}
+	match = numpyShapePattern.FindStringSubmatch(dict)
+	if match == nil {
+		return nil, fmt.Errorf("invalid numpy header %s, shape is not found", dict)
+	}
+	for _, s := range strings.Split(match[1], ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		n, err := strconv.Atoi(s)
+		if err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid numpy shape (%s)", match[1])
+		}
+		header.shape = append(header.shape, n)
+	}
+	return header, nil
+}
+
+// checkShape checks the array is 1-D for scalar fields, or 2-D whose second dimension is vectorLen for vector fields
+func (h *numpyHeader) checkShape(vectorLen int) (int, error) {
+	if vectorLen <= 0 {
+		if len(h.shape) != 1 {
+			return 0, fmt.Errorf("the shape of numpy array is %v, but an 1-D array is expected", h.shape)
+		}
+		return h.shape[0], nil
+	}
+	if len(h.shape) != 2 || h.shape[1] != vectorLen {
+		return 0, fmt.Errorf("the shape of numpy array is %v, but (n, %d) is expected", h.shape, vectorLen)
+	}
+	return h.shape[0], nil
+}
+
+// checkDescr checks the type of elements, the byte order must be little endian or not applicable
+func (h *numpyHeader) checkDescr(expected ...string) error {
+	descr := h.descr
+	if len(descr) > 0 && (descr[0] == '<' || descr[0] == '|' || descr[0] == '=') {
+		descr = descr[1:]
+	}
+	for _, e := range expected {
+		if descr == e {
+			return nil
+		}
+	}
+	return fmt.Errorf("the type of numpy array is %s, but %s is expected", h.descr, strings.Join(expected, " or "))
+}
+
+// numpyColumn reads the column of a field from a npy file batch by batch
+type numpyColumn struct {
+	reader io.Reader
+	field  *schemapb.FieldSchema
+	descr  string
+	rowNum int
+	// the number of bytes of a row in the file
+	rowSize int
+}
+
+// newNumpyColumn reads the header of a npy file, the element type of the array must match the data type of the field:
+// b1 for Bool, i1/i2/i4/i8 for integers, f4 for Float and FloatVector, f8 for Double, u1 for BinaryVector and U for String
+func newNumpyColumn(reader io.Reader, field *schemapb.FieldSchema) (*numpyColumn, error) {
+	header, err := readNumpyHeader(reader)
+	if err != nil {
+		return nil, err
+	}
+	fieldData, err := newFieldData(field)
+	if err != nil {
+		return nil, err
+	}
+
+	c := &numpyColumn{
+		reader: reader,
+		field:  field,
+		descr:  header.descr,
+	}
+	var descrErr error
+	switch fd := fieldData.(type) {
+	case *storage.BoolFieldData:
+		descrErr = header.checkDescr("b1")
+		c.rowSize = 1
+	case *storage.Int8FieldData:
+		descrErr = header.checkDescr("i1")
+		c.rowSize = 1
+	case *storage.Int16FieldData:
+		descrErr = header.checkDescr("i2")
+		c.rowSize = 2
+	case *storage.Int32FieldData:
+		descrErr = header.checkDescr("i4")
+		c.rowSize = 4
+	case *storage.Int64FieldData:
+		descrErr = header.checkDescr("i8")
+		c.rowSize = 8
+	case *storage.FloatFieldData:
+		descrErr = header.checkDescr("f4")
+		c.rowSize = 4
+	case *storage.DoubleFieldData:
+		descrErr = header.checkDescr("f8")
+		c.rowSize = 8
+	case *storage.StringFieldData:
+		if !numpyStringPattern.MatchString(header.descr) {
+			descrErr = fmt.Errorf("the type of numpy array is %s, but U is expected", header.descr)
+			break
+		}
+		maxLen, err := numpyStringLen(header.descr)
+		if err != nil {
+			return nil, err
+		}
+		c.rowSize = maxLen * 4
+	case *storage.FloatVectorFieldData:
+		descrErr = header.checkDescr("f4")
+		c.rowSize = fd.Dim * 4
+	case *storage.BinaryVectorFieldData:
+		descrErr = header.checkDescr("u1")
+		c.rowSize = fd.Dim / 8
+	}
+	if descrErr != nil {
+		return nil, fmt.Errorf("invalid numpy file of field %s, err: %w", field.GetName(), descrErr)
+	}
+
+	vectorLen := 0
+	switch fd := fieldData.(type) {
+	case *storage.FloatVectorFieldData:
+		vectorLen = fd.Dim
+	case *storage.BinaryVectorFieldData:
+		vectorLen = fd.Dim / 8
+	}
+	if c.rowNum, err = header.checkShape(vectorLen); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// read decodes the next n rows of the column
+func (c *numpyColumn) read(n int) (storage.FieldData, error) {
+	fieldData, err := newFieldData(c.field)
+	if err != nil {
+		return nil, err
+	}
+	switch fd := fieldData.(type) {
+	case *storage.BoolFieldData:
+		fd.Data = make([]bool, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.Int8FieldData:
+		fd.Data = make([]int8, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.Int16FieldData:
+		fd.Data = make([]int16, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.Int32FieldData:
+		fd.Data = make([]int32, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.Int64FieldData:
+		fd.Data = make([]int64, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.FloatFieldData:
+		fd.Data = make([]float32, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.DoubleFieldData:
+		fd.Data = make([]float64, n)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.StringFieldData:
+		fd.Data, err = readNumpyStrings(c.reader, c.descr, n)
+	case *storage.FloatVectorFieldData:
+		fd.Data = make([]float32, n*fd.Dim)
+		err = binary.Read(c.reader, common.Endian, fd.Data)
+	case *storage.BinaryVectorFieldData:
+		fd.Data = make([]byte, n*fd.Dim/8)
+		_, err = io.ReadFull(c.reader, fd.Data)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read numpy array of field %s, err: %w", c.field.GetName(), err)
+	}
+	return fieldData, nil
+}
+
+// ParseNumpy decodes a column of the field from a npy file as a whole, see newNumpyColumn for the element types
+func ParseNumpy(reader io.Reader, field *schemapb.FieldSchema) (storage.FieldData, error) {
+	c, err := newNumpyColumn(reader, field)
+	if err != nil {
+		return nil, err
+	}
+	return c.read(c.rowNum)
+}
+
+// numpyStringLen returns the max number of characters of the fixed length unicode strings
+func numpyStringLen(descr string) (int, error) {
+	maxLen, err := strconv.Atoi(strings.TrimLeft(descr, "<|=U"))
+	if err != nil || maxLen <= 0 {
+		return 0, fmt.Errorf("invalid string type %s of numpy array", descr)
+	}
+	return maxLen, nil
+}
+
+// readNumpyStrings reads the fixed length unicode strings, each character takes 4 bytes in UTF-32 little endian
+func readNumpyStrings(reader io.Reader, descr string, rowNum int) ([]string, error) {
+	maxLen, err := numpyStringLen(descr)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]uint32, maxLen)
+	data := make([]string, 0, rowNum)
+	for i := 0; i < rowNum; i++ {
+		if err := binary.Read(reader, binary.LittleEndian, buf); err != nil {
+			return nil, err
+		}
+		var sb bytes.Buffer
+		for _, r := range buf {
+			if r == 0 {
+				break
+			}
+			if !utf8.ValidRune(rune(r)) {
+				return nil, fmt.Errorf("invalid unicode character %d", r)
+			}
+			sb.WriteRune(rune(r))
+		}
+		data = append(data, sb.String())
+	}
+	return data, nil
+}
+
+// ParseNumpyColumns decodes the columns from the npy files keyed by the field names and validates them against the schema,
+// the columns of all the fields except the auto generated primary key must be provided.
+// The rows are handed to handler in batches of about batchSize bytes in the files, so only a batch of the files is held
+// in memory. The number of rows is returned.
+func ParseNumpyColumns(columns map[string]io.Reader, schema *schemapb.CollectionSchema, batchSize int, handler func(*storage.InsertData) error) (int, error) {
+	fields := importFields(schema)
+	for name := range columns {
+		found := false
+		for _, field := range fields {
+			if field.GetName() == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return 0, fmt.Errorf("field %s does not exist or is auto generated", name)
+		}
+	}
+
+	numpyColumns := make(map[int64]*numpyColumn, len(fields))
+	rowNum, rowSize := -1, 0
+	for _, field := range fields {
+		reader, ok := columns[field.GetName()]
+		if !ok {
+			return 0, fmt.Errorf("the numpy file of field %s is not provided", field.GetName())
+		}
+		c, err := newNumpyColumn(reader, field)
+		if err != nil {
+			return 0, err
+		}
+		if rowNum >= 0 && c.rowNum != rowNum {
+			return 0, fmt.Errorf("the number of rows of field %s is %d, but others are %d", field.GetName(), c.rowNum, rowNum)
+		}
+		rowNum = c.rowNum
+		rowSize += c.rowSize
+		numpyColumns[field.GetFieldID()] = c
+	}
+	if rowNum <= 0 {
+		return 0, fmt.Errorf("no rows to import")
+	}
+
+	batchRows := 1
+	if rowSize > 0 && batchSize/rowSize > 1 {
+		batchRows = batchSize / rowSize
+	}
+	for offset := 0; offset < rowNum; offset += batchRows {
+		n := batchRows
+		if offset+n > rowNum {
+			n = rowNum - offset
+		}
+		data := &storage.InsertData{Data: make(map[int64]storage.FieldData, len(numpyColumns))}
+		for fieldID, c := range numpyColumns {
+			fieldData, err := c.read(n)
+			if err != nil {
+				return 0, err
+			}
+			data.Data[fieldID] = fieldData
+		}
+		fillNumRows(data)
+		if err := handler(data); err != nil {
+			return 0, err
+		}
+	}
+	return rowNum, nil
+}
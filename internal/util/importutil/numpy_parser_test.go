@@ -0,0 +1,168 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package importutil
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/milvus-io/milvus/internal/storage"
+)
+
+// buildNumpy encodes the data as a version 1.0 npy file
+func buildNumpy(t *testing.T, descr string, shape string, data interface{}) []byte {
+	dict := fmt.Sprintf("{'descr': '%s', 'fortran_order': False, 'shape': (%s), }", descr, shape)
+	// the header is padded with spaces and ends with a newline so that the data is 64 bytes aligned
+	total := len(numpyMagic) + 4 + len(dict) + 1
+	if total%64 != 0 {
+		dict += strings.Repeat(" ", 64-total%64)
+	}
+	dict += "\n"
+
+	var buf bytes.Buffer
+	buf.WriteString(numpyMagic)
+	buf.Write([]byte{1, 0})
+	assert.NoError(t, binary.Write(&buf, binary.LittleEndian, uint16(len(dict))))
+	buf.WriteString(dict)
+	assert.NoError(t, binary.Write(&buf, binary.LittleEndian, data))
+	return buf.Bytes()
+}
+
+func TestParseNumpy(t *testing.T) {
+	schema := newTestSchema(false)
+	fieldOf := func(name string) *schemapb.FieldSchema {
+		for _, field := range schema.GetFields() {
+			if field.GetName() == name {
+				return field
+			}
+		}
+		return nil
+	}
+
+	t.Run("scalar", func(t *testing.T) {
+		fieldData, err := ParseNumpy(bytes.NewReader(buildNumpy(t, "<i8", "3,", []int64{1, 2, 3})), fieldOf("pk"))
+		assert.NoError(t, err)
+		assert.Equal(t, []int64{1, 2, 3}, fieldData.(*storage.Int64FieldData).Data)
+
+		fieldData, err = ParseNumpy(bytes.NewReader(buildNumpy(t, "|b1", "2,", []bool{true, false})), fieldOf("flag"))
+		assert.NoError(t, err)
+		assert.Equal(t, []bool{true, false}, fieldData.(*storage.BoolFieldData).Data)
+
+		fieldData, err = ParseNumpy(bytes.NewReader(buildNumpy(t, "<f8", "2,", []float64{0.5, 1.5})), fieldOf("score"))
+		assert.NoError(t, err)
+		assert.Equal(t, []float64{0.5, 1.5}, fieldData.(*storage.DoubleFieldData).Data)
+	})
+
+	t.Run("vector", func(t *testing.T) {
+		fieldData, err := ParseNumpy(bytes.NewReader(buildNumpy(t, "<f4", "2, 2", []float32{1, 2, 3, 4})), fieldOf("vec"))
+		assert.NoError(t, err)
+		assert.Equal(t, []float32{1, 2, 3, 4}, fieldData.(*storage.FloatVectorFieldData).Data)
+		assert.Equal(t, 2, fieldData.RowNum())
+
+		fieldData, err = ParseNumpy(bytes.NewReader(buildNumpy(t, "|u1", "1, 2", []uint8{1, 255})), fieldOf("bin"))
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1, 255}, fieldData.(*storage.BinaryVectorFieldData).Data)
+		assert.Equal(t, 1, fieldData.RowNum())
+	})
+
+	t.Run("string", func(t *testing.T) {
+		field := &schemapb.FieldSchema{FieldID: 106, Name: "str", DataType: schemapb.DataType_String}
+		data := []uint32{'a', 'b', 'c', 0x4e2d, 'x', 0, 0, 0}
+		fieldData, err := ParseNumpy(bytes.NewReader(buildNumpy(t, "<U4", "2,", data)), field)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"abc中", "x"}, fieldData.(*storage.StringFieldData).Data)
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		cases := []struct {
+			content []byte
+			field   string
+		}{
+			{[]byte("not a numpy file"), "pk"},
+			{buildNumpy(t, "<i4", "3,", []int32{1, 2, 3}), "pk"},
+			{buildNumpy(t, ">i8", "1,", []int64{1}), "pk"},
+			{buildNumpy(t, "<i8", "1, 1", []int64{1}), "pk"},
+			{buildNumpy(t, "<i8", "2,", []int64{1}), "pk"},
+			{buildNumpy(t, "<f4", "1, 3", []float32{1, 2, 3}), "vec"},
+			{buildNumpy(t, "<f4", "2,", []float32{1, 2}), "vec"},
+			{buildNumpy(t, "|u1", "1, 3", []uint8{1, 2, 3}), "bin"},
+		}
+		for i, c := range cases {
+			_, err := ParseNumpy(bytes.NewReader(c.content), fieldOf(c.field))
+			assert.Error(t, err, i)
+		}
+
+		content := buildNumpy(t, "<i8", "1,", []int64{1})
+		content = bytes.Replace(content, []byte("False"), []byte("True "), 1)
+		_, err := ParseNumpy(bytes.NewReader(content), fieldOf("pk"))
+		assert.Error(t, err)
+	})
+}
+
+func TestParseNumpyColumns(t *testing.T) {
+	schema := newTestSchema(true)
+	newColumns := func() map[string]io.Reader {
+		return map[string]io.Reader{
+			"flag":  bytes.NewReader(buildNumpy(t, "|b1", "2,", []bool{true, false})),
+			"age":   bytes.NewReader(buildNumpy(t, "|i1", "2,", []int8{1, 2})),
+			"score": bytes.NewReader(buildNumpy(t, "<f8", "2,", []float64{1, 2})),
+			"vec":   bytes.NewReader(buildNumpy(t, "<f4", "2, 2", []float32{1, 2, 3, 4})),
+			"bin":   bytes.NewReader(buildNumpy(t, "|u1", "2, 2", []uint8{1, 2, 3, 4})),
+		}
+	}
+
+	var batches []*storage.InsertData
+	rowNum, err := ParseNumpyColumns(newColumns(), schema, 1024, collectBatches(&batches))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rowNum)
+	require.Equal(t, 1, len(batches))
+	assert.Equal(t, 5, len(batches[0].Data))
+	assert.Equal(t, []int64{2}, batches[0].Data[102].(*storage.Int8FieldData).NumRows)
+
+	// a row takes 1+1+8+8+2 bytes, so every batch has a single row
+	batches = nil
+	rowNum, err = ParseNumpyColumns(newColumns(), schema, 20, collectBatches(&batches))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, rowNum)
+	require.Equal(t, 2, len(batches))
+	assert.Equal(t, []int8{1}, batches[0].Data[102].(*storage.Int8FieldData).Data)
+	assert.Equal(t, []int8{2}, batches[1].Data[102].(*storage.Int8FieldData).Data)
+	assert.Equal(t, []float32{3, 4}, batches[1].Data[104].(*storage.FloatVectorFieldData).Data)
+
+	columns := newColumns()
+	delete(columns, "vec")
+	_, err = ParseNumpyColumns(columns, schema, 1024, collectBatches(&batches))
+	assert.Error(t, err)
+
+	columns = newColumns()
+	columns["pk"] = bytes.NewReader(buildNumpy(t, "<i8", "2,", []int64{1, 2}))
+	_, err = ParseNumpyColumns(columns, schema, 1024, collectBatches(&batches))
+	assert.Error(t, err)
+
+	columns = newColumns()
+	columns["age"] = bytes.NewReader(buildNumpy(t, "|i1", "3,", []int8{1, 2, 3}))
+	_, err = ParseNumpyColumns(columns, schema, 1024, collectBatches(&batches))
+	assert.Error(t, err)
+}
@@ -13,6 +13,7 @@ package metricsinfo
 
 import (
 	"encoding/json"
+	"time"
 )
 
 // ComponentInfos defines the interface of all component infos
@@ -103,10 +104,19 @@ type QueryNodeConfiguration struct {
 	SimdType string `json:"simd_type"`
 }
 
+// QueryNodeQuotaMetrics records the metrics of QueryNode which are used to apply the backpressure of DML.
+type QueryNodeQuotaMetrics struct {
+	// MaxTimeTickDelay is the max delay of the tSafe of the flow graphs
+	MaxTimeTickDelay time.Duration `json:"max_time_tick_delay"`
+	// GrowingSegmentsSize is the memory size in bytes of the growing segments
+	GrowingSegmentsSize int64 `json:"growing_segments_size"`
+}
+
 // QueryNodeInfos implements ComponentInfos
 type QueryNodeInfos struct {
 	BaseComponentInfos
 	SystemConfigurations QueryNodeConfiguration `json:"system_configurations"`
+	QuotaMetrics         QueryNodeQuotaMetrics  `json:"quota_metrics"`
 }
 
 // QueryCoordConfiguration records the configuration of QueryCoord.
@@ -173,10 +183,19 @@ type DataCoordConfiguration struct {
 	SegmentMaxSize float64 `json:"segment_max_size"`
 }
 
+// DataCoordQuotaMetrics records the metrics of DataCoord which are used to apply the backpressure of DML.
+type DataCoordQuotaMetrics struct {
+	// MaxTimeTickDelay is the max delay of the time ticks reported by the flow graphs of DataNodes
+	MaxTimeTickDelay time.Duration `json:"max_time_tick_delay"`
+	// UnflushedBytes is the estimated size in bytes of the segments which have not been flushed
+	UnflushedBytes int64 `json:"unflushed_bytes"`
+}
+
 // DataCoordInfos implements ComponentInfos
 type DataCoordInfos struct {
 	BaseComponentInfos
 	SystemConfigurations DataCoordConfiguration `json:"system_configurations"`
+	QuotaMetrics         DataCoordQuotaMetrics  `json:"quota_metrics"`
 }
 
 // RootCoordConfiguration records the configuration of RootCoord.
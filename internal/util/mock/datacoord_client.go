@@ -118,3 +118,31 @@ func (m *DataCoordClient) GetFlushState(ctx context.Context, req *milvuspb.GetFl
 func (m *DataCoordClient) DropVirtualChannel(ctx context.Context, req *datapb.DropVirtualChannelRequest, opts ...grpc.CallOption) (*datapb.DropVirtualChannelResponse, error) {
 	return &datapb.DropVirtualChannelResponse{}, m.Err
 }
+
+func (m *DataCoordClient) Import(ctx context.Context, req *milvuspb.ImportRequest, opts ...grpc.CallOption) (*milvuspb.ImportResponse, error) {
+	return &milvuspb.ImportResponse{}, m.Err
+}
+
+func (m *DataCoordClient) GetImportState(ctx context.Context, req *milvuspb.GetImportStateRequest, opts ...grpc.CallOption) (*milvuspb.GetImportStateResponse, error) {
+	return &milvuspb.GetImportStateResponse{}, m.Err
+}
+
+func (m *DataCoordClient) ReportImport(ctx context.Context, req *datapb.ImportResult, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) AddFlushedSegments(ctx context.Context, req *datapb.AddFlushedSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) PinSegments(ctx context.Context, req *datapb.PinSegmentsRequest, opts ...grpc.CallOption) (*datapb.PinSegmentsResponse, error) {
+	return &datapb.PinSegmentsResponse{}, m.Err
+}
+
+func (m *DataCoordClient) UnpinSegments(ctx context.Context, req *datapb.UnpinSegmentsRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
+
+func (m *DataCoordClient) BroadcastAlteredCollection(ctx context.Context, req *datapb.AlterCollectionRequest, opts ...grpc.CallOption) (*commonpb.Status, error) {
+	return &commonpb.Status{}, m.Err
+}
@@ -12,6 +12,7 @@
 package paramtable
 
 import (
+	"fmt"
 	"math"
 	"os"
 	"path"
@@ -22,7 +23,11 @@ import (
 
 	"github.com/go-basic/ipv4"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/util/crypto"
+	"github.com/milvus-io/milvus/internal/util/typeutil"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 const (
@@ -38,6 +43,15 @@ const (
 	// DefaultClientMaxRecvSize defines the maximum size of data per grpc request can receive by client side.
 	DefaultClientMaxRecvSize = 100 * 1024 * 1024
 
+	// TLSModeDisabled means the grpc connections are not encrypted.
+	TLSModeDisabled = 0
+
+	// TLSModeOneWay means the server is verified by clients.
+	TLSModeOneWay = 1
+
+	// TLSModeMutual means both the server and clients are verified.
+	TLSModeMutual = 2
+
 	// SuggestPulsarMaxMessageSize defines the maximum size of Pulsar message.
 	SuggestPulsarMaxMessageSize = 5 * 1024 * 1024
 
@@ -57,6 +71,7 @@ type GlobalParamTable struct {
 
 	CommonCfg   commonConfig
 	KnowhereCfg knowhereConfig
+	QuotaCfg    quotaConfig
 	//MsgChannelCfg msgChannelConfig
 
 	RootCoordCfg  rootCoordConfig
@@ -86,6 +101,7 @@ func (p *GlobalParamTable) Init() {
 
 	p.CommonCfg.init(&p.BaseParams)
 	p.KnowhereCfg.init(&p.BaseParams)
+	p.QuotaCfg.init(&p.BaseParams)
 	//p.MsgChannelCfg.init(&p.BaseParams)
 
 	p.RootCoordCfg.init(&p.BaseParams)
@@ -243,7 +259,10 @@ type commonConfig struct {
 
 	DefaultPartitionName string
 	DefaultIndexName     string
+	DefaultDatabaseName  string
 	RetentionDuration    int64
+
+	AuthorizationEnabled bool
 }
 
 func (p *commonConfig) init(bp *BaseParamTable) {
@@ -251,7 +270,9 @@ func (p *commonConfig) init(bp *BaseParamTable) {
 
 	p.initDefaultPartitionName()
 	p.initDefaultIndexName()
+	p.initDefaultDatabaseName()
 	p.initRetentionDuration()
+	p.initAuthorizationEnabled()
 }
 
 func (p *commonConfig) initDefaultPartitionName() {
@@ -262,10 +283,18 @@ func (p *commonConfig) initDefaultIndexName() {
 	p.DefaultIndexName = p.BaseParams.LoadWithDefault("common.defaultIndexName", "_default_idx")
 }
 
+func (p *commonConfig) initDefaultDatabaseName() {
+	p.DefaultDatabaseName = p.BaseParams.LoadWithDefault("common.defaultDatabaseName", "default")
+}
+
 func (p *commonConfig) initRetentionDuration() {
 	p.RetentionDuration = p.BaseParams.ParseInt64WithDefault("common.retentionDuration", DefaultRetentionDuration)
 }
 
+func (p *commonConfig) initAuthorizationEnabled() {
+	p.AuthorizationEnabled = p.BaseParams.ParseBool("common.security.authorizationEnabled", false)
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- knowhere ---
 type knowhereConfig struct {
@@ -284,6 +313,74 @@ func (p *knowhereConfig) initSimdType() {
 	p.SimdType = p.BaseParams.LoadWithDefault("knowhere.simdType", "auto")
 }
 
+///////////////////////////////////////////////////////////////////////////////
+// --- quota ---
+type quotaConfig struct {
+	BaseParams *BaseParamTable
+
+	// Enabled decides whether RootCoord collects the metrics of the cluster and applies the backpressure of DML on proxies
+	Enabled         bool
+	CollectInterval time.Duration
+
+	// --- DML backpressure ---
+	// the DML requests are slowed down when a metric exceeds its low water level,
+	// and rejected when the metric reaches its high water level
+	MaxTimeTickDelay                 time.Duration
+	GrowingSegmentsMemLowWaterLevel  float64
+	GrowingSegmentsMemHighWaterLevel float64
+	UnflushedBytesLowWaterLevel      int64
+	UnflushedBytesHighWaterLevel     int64
+}
+
+func (p *quotaConfig) init(bp *BaseParamTable) {
+	p.BaseParams = bp
+
+	p.initEnabled()
+	p.initCollectInterval()
+	p.initMaxTimeTickDelay()
+	p.initGrowingSegmentsMemWaterLevel()
+	p.initUnflushedBytesWaterLevel()
+}
+
+func (p *quotaConfig) initEnabled() {
+	p.Enabled = p.BaseParams.ParseBool("quotaAndLimits.enabled", false)
+}
+
+func (p *quotaConfig) initCollectInterval() {
+	interval := p.BaseParams.ParseFloatWithDefault("quotaAndLimits.collectInterval", 3)
+	if interval <= 0 {
+		panic(fmt.Sprintf("invalid quotaAndLimits.collectInterval %f, it should be positive", interval))
+	}
+	p.CollectInterval = time.Duration(interval * float64(time.Second))
+}
+
+func (p *quotaConfig) initMaxTimeTickDelay() {
+	delay := p.BaseParams.ParseFloatWithDefault("quotaAndLimits.dmlBackPressure.maxTimeTickDelay", 300)
+	if delay <= 0 {
+		panic(fmt.Sprintf("invalid quotaAndLimits.dmlBackPressure.maxTimeTickDelay %f, it should be positive", delay))
+	}
+	p.MaxTimeTickDelay = time.Duration(delay * float64(time.Second))
+}
+
+func (p *quotaConfig) initGrowingSegmentsMemWaterLevel() {
+	p.GrowingSegmentsMemLowWaterLevel = p.BaseParams.ParseFloatWithDefault("quotaAndLimits.dmlBackPressure.growingSegmentsMemLowWaterLevel", 0.2)
+	p.GrowingSegmentsMemHighWaterLevel = p.BaseParams.ParseFloatWithDefault("quotaAndLimits.dmlBackPressure.growingSegmentsMemHighWaterLevel", 0.4)
+	if p.GrowingSegmentsMemLowWaterLevel <= 0 || p.GrowingSegmentsMemLowWaterLevel >= p.GrowingSegmentsMemHighWaterLevel || p.GrowingSegmentsMemHighWaterLevel > 1 {
+		panic(fmt.Sprintf("invalid water levels of growing segments memory, low: %f, high: %f",
+			p.GrowingSegmentsMemLowWaterLevel, p.GrowingSegmentsMemHighWaterLevel))
+	}
+}
+
+func (p *quotaConfig) initUnflushedBytesWaterLevel() {
+	low := p.BaseParams.ParseInt64WithDefault("quotaAndLimits.dmlBackPressure.unflushedBytesLowWaterLevel", 4096)
+	high := p.BaseParams.ParseInt64WithDefault("quotaAndLimits.dmlBackPressure.unflushedBytesHighWaterLevel", 8192)
+	if low <= 0 || low >= high {
+		panic(fmt.Sprintf("invalid water levels of unflushed bytes, low: %d MB, high: %d MB", low, high))
+	}
+	p.UnflushedBytesLowWaterLevel = low * 1024 * 1024
+	p.UnflushedBytesHighWaterLevel = high * 1024 * 1024
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- msgChannel ---
 //type msgChannelConfig struct {
@@ -559,6 +656,8 @@ type proxyConfig struct {
 	MaxFieldNum              int64
 	MaxShardNum              int32
 	MaxDimension             int64
+	MaxVarCharLength         int64
+	DynamicFieldMaxLength    int64
 	BufFlagExpireTime        time.Duration
 	BufFlagCleanupInterval   time.Duration
 
@@ -571,7 +670,26 @@ type proxyConfig struct {
 	SearchResultChannelNames   []string
 	RetrieveResultChannelNames []string
 
-	MaxTaskNum int64
+	MaxTaskNum     int64
+	MaxDeleteCount int64
+
+	// --- Rate limit ---
+	// the rates are in units per second, a non-positive rate means no limit
+	RateLimitEnabled         bool
+	DDLRate                  float64
+	InsertRowRate            float64
+	InsertByteRate           float64
+	SearchRate               float64
+	QueryRate                float64
+	CollectionInsertRowRate  float64
+	CollectionInsertByteRate float64
+	CollectionSearchRate     float64
+	CollectionQueryRate      float64
+
+	// --- HTTP ---
+	HTTPEnabled           bool
+	HTTPPort              int
+	HTTPReadHeaderTimeout time.Duration
 
 	CreatedTime time.Time
 	UpdatedTime time.Time
@@ -591,10 +709,16 @@ func (p *proxyConfig) init(bp *BaseParamTable) {
 	p.initMaxFieldNum()
 	p.initMaxShardNum()
 	p.initMaxDimension()
+	p.initMaxVarCharLength()
+	p.initDynamicFieldMaxLength()
 
 	p.initMaxTaskNum()
+	p.initMaxDeleteCount()
 	p.initBufFlagExpireTime()
 	p.initBufFlagCleanupInterval()
+
+	p.initRateLimit()
+	p.initHTTP()
 }
 
 // Refresh is called after session init
@@ -679,10 +803,22 @@ func (p *proxyConfig) initMaxDimension() {
 	p.MaxDimension = maxDimension
 }
 
+func (p *proxyConfig) initMaxVarCharLength() {
+	p.MaxVarCharLength = p.BaseParams.ParseInt64WithDefault("proxy.maxVarCharLength", 65535)
+}
+
+func (p *proxyConfig) initDynamicFieldMaxLength() {
+	p.DynamicFieldMaxLength = p.BaseParams.ParseInt64WithDefault("proxy.dynamicFieldMaxLength", 4096)
+}
+
 func (p *proxyConfig) initMaxTaskNum() {
 	p.MaxTaskNum = p.BaseParams.ParseInt64WithDefault("proxy.maxTaskNum", 1024)
 }
 
+func (p *proxyConfig) initMaxDeleteCount() {
+	p.MaxDeleteCount = p.BaseParams.ParseInt64WithDefault("proxy.maxDeleteCount", 100000)
+}
+
 func (p *proxyConfig) initBufFlagExpireTime() {
 	expireTime := p.BaseParams.ParseInt64WithDefault("proxy.bufFlagExpireTime", 3600)
 	p.BufFlagExpireTime = time.Duration(expireTime) * time.Second
@@ -693,6 +829,33 @@ func (p *proxyConfig) initBufFlagCleanupInterval() {
 	p.BufFlagCleanupInterval = time.Duration(interval) * time.Second
 }
 
+func (p *proxyConfig) initRateLimit() {
+	p.RateLimitEnabled = p.BaseParams.ParseBool("proxy.rateLimit.enabled", false)
+	p.DDLRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.ddlRate", -1)
+	p.InsertRowRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.insertRowRate", -1)
+	p.InsertByteRate = megaBytesRate(p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.insertByteRate", -1))
+	p.SearchRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.searchRate", -1)
+	p.QueryRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.queryRate", -1)
+	p.CollectionInsertRowRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.collection.insertRowRate", -1)
+	p.CollectionInsertByteRate = megaBytesRate(p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.collection.insertByteRate", -1))
+	p.CollectionSearchRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.collection.searchRate", -1)
+	p.CollectionQueryRate = p.BaseParams.ParseFloatWithDefault("proxy.rateLimit.collection.queryRate", -1)
+}
+
+func (p *proxyConfig) initHTTP() {
+	p.HTTPEnabled = p.BaseParams.ParseBool("proxy.http.enabled", false)
+	p.HTTPPort = p.BaseParams.ParseIntWithDefault("proxy.http.port", 8080)
+	p.HTTPReadHeaderTimeout = time.Duration(p.BaseParams.ParseIntWithDefault("proxy.http.readHeaderTimeout", 10)) * time.Second
+}
+
+// megaBytesRate converts the rate configured in MB/s to bytes/s
+func megaBytesRate(rate float64) float64 {
+	if rate <= 0 {
+		return rate
+	}
+	return rate * 1024 * 1024
+}
+
 ///////////////////////////////////////////////////////////////////////////////
 // --- querycoord ---
 type queryCoordConfig struct {
@@ -1100,6 +1263,10 @@ type dataCoordConfig struct {
 	GCInterval         time.Duration
 	GCMissingTolerance time.Duration
 	GCDropTolerance    time.Duration
+
+	// Import
+	ImportTaskTimeout   time.Duration
+	ImportTaskRetention time.Duration
 }
 
 func (p *dataCoordConfig) init(bp *BaseParamTable) {
@@ -1125,6 +1292,9 @@ func (p *dataCoordConfig) init(bp *BaseParamTable) {
 	p.initGCInterval()
 	p.initGCMissingTolerance()
 	p.initGCDropTolerance()
+
+	p.initImportTaskTimeout()
+	p.initImportTaskRetention()
 }
 
 func (p *dataCoordConfig) initSegmentMaxSize() {
@@ -1211,6 +1381,14 @@ func (p *dataCoordConfig) initGCDropTolerance() {
 	p.GCDropTolerance = time.Duration(p.BaseParams.ParseInt64WithDefault("dataCoord.gc.dropTolerance", 24*60*60)) * time.Second
 }
 
+func (p *dataCoordConfig) initImportTaskTimeout() {
+	p.ImportTaskTimeout = time.Duration(p.BaseParams.ParseInt64WithDefault("dataCoord.import.taskTimeout", 3*60*60)) * time.Second
+}
+
+func (p *dataCoordConfig) initImportTaskRetention() {
+	p.ImportTaskRetention = time.Duration(p.BaseParams.ParseInt64WithDefault("dataCoord.import.taskRetention", 24*60*60)) * time.Second
+}
+
 func (p *dataCoordConfig) initEnableAutoCompaction() {
 	p.EnableAutoCompaction = p.BaseParams.ParseBool("dataCoord.compaction.enableAutoCompaction", false)
 }
@@ -1447,6 +1625,18 @@ type grpcConfig struct {
 	Domain string
 	IP     string
 	Port   int
+
+	TLSMode       int
+	ServerPemPath string
+	ServerKeyPath string
+	ClientPemPath string
+	ClientKeyPath string
+	CaPemPath     string
+	// ServerName is the name which the server certificates are verified against, the host of the server
+	// address is verified if it's empty, otherwise every server certificate must carry the shared name
+	ServerName string
+
+	NodeToken string
 }
 
 func (p *grpcConfig) init(domain string) {
@@ -1456,6 +1646,8 @@ func (p *grpcConfig) init(domain string) {
 	p.LoadFromEnv()
 	p.LoadFromArgs()
 	p.initPort()
+	p.initTLS()
+	p.initNodeToken()
 }
 
 // LoadFromEnv is used to initialize configuration items from env.
@@ -1472,6 +1664,33 @@ func (p *grpcConfig) initPort() {
 	p.Port = p.ParseInt(p.Domain + ".port")
 }
 
+// initTLS loads the certificates used by grpc, proxy serves the clients with the tls mode set by
+// `common.security.tlsMode`, other components use mutual tls between each other if `common.security.internalTlsEnabled` is set
+func (p *grpcConfig) initTLS() {
+	if p.Domain == typeutil.ProxyRole {
+		p.TLSMode = p.ParseIntWithDefault("common.security.tlsMode", TLSModeDisabled)
+	} else if p.ParseBool("common.security.internalTlsEnabled", false) {
+		p.TLSMode = TLSModeMutual
+	} else {
+		p.TLSMode = TLSModeDisabled
+	}
+	if p.TLSMode != TLSModeDisabled && p.TLSMode != TLSModeOneWay && p.TLSMode != TLSModeMutual {
+		panic(fmt.Sprintf("invalid common.security.tlsMode: %d", p.TLSMode))
+	}
+
+	p.ServerPemPath = p.LoadWithDefault("tls.serverPemPath", path.Join(p.GetConfigDir(), "cert", "server.pem"))
+	p.ServerKeyPath = p.LoadWithDefault("tls.serverKeyPath", path.Join(p.GetConfigDir(), "cert", "server.key"))
+	p.ClientPemPath = p.LoadWithDefault("tls.clientPemPath", path.Join(p.GetConfigDir(), "cert", "client.pem"))
+	p.ClientKeyPath = p.LoadWithDefault("tls.clientKeyPath", path.Join(p.GetConfigDir(), "cert", "client.key"))
+	p.CaPemPath = p.LoadWithDefault("tls.caPemPath", path.Join(p.GetConfigDir(), "cert", "ca.pem"))
+	p.ServerName = p.LoadWithDefault("tls.serverName", "")
+}
+
+// initNodeToken loads the token which milvus components carry to call the internal rpcs of proxy
+func (p *grpcConfig) initNodeToken() {
+	p.NodeToken = p.LoadWithDefault("common.security.nodeToken", "")
+}
+
 // GetAddress return grpc address
 func (p *grpcConfig) GetAddress() string {
 	return p.IP + ":" + strconv.Itoa(p.Port)
@@ -1499,6 +1718,14 @@ func (p *GrpcServerConfig) init(domain string) {
 	p.initServerMaxRecvSize()
 }
 
+// ServerCredentials returns the transport credentials of grpc server according to the tls mode
+func (p *GrpcServerConfig) ServerCredentials() (credentials.TransportCredentials, error) {
+	if p.TLSMode == TLSModeDisabled {
+		return insecure.NewCredentials(), nil
+	}
+	return crypto.ServerCredentials(p.ServerPemPath, p.ServerKeyPath, p.CaPemPath, p.TLSMode == TLSModeMutual)
+}
+
 func (p *GrpcServerConfig) initServerMaxSendSize() {
 	var err error
 
@@ -76,7 +76,12 @@ func TestGlobalParamTable(t *testing.T) {
 		assert.NotEqual(t, Params.DefaultIndexName, "")
 		t.Logf("default index name = %s", Params.DefaultIndexName)
 
+		assert.NotEqual(t, Params.DefaultDatabaseName, "")
+		t.Logf("default database name = %s", Params.DefaultDatabaseName)
+
 		assert.Equal(t, Params.RetentionDuration, int64(DefaultRetentionDuration))
+
+		assert.False(t, Params.AuthorizationEnabled)
 	})
 
 	t.Run("test knowhereConfig", func(t *testing.T) {
@@ -86,6 +91,34 @@ func TestGlobalParamTable(t *testing.T) {
 		t.Logf("knowhere simd type = %s", Params.SimdType)
 	})
 
+	t.Run("test quotaConfig", func(t *testing.T) {
+		Params := GlobalParams.QuotaCfg
+
+		assert.False(t, Params.Enabled)
+		assert.Equal(t, 3*time.Second, Params.CollectInterval)
+		assert.Equal(t, 300*time.Second, Params.MaxTimeTickDelay)
+		assert.True(t, Params.GrowingSegmentsMemLowWaterLevel < Params.GrowingSegmentsMemHighWaterLevel)
+		assert.True(t, Params.UnflushedBytesLowWaterLevel < Params.UnflushedBytesHighWaterLevel)
+
+		shouldPanic(t, "quotaAndLimits.collectInterval", func() {
+			Params.BaseParams.Save("quotaAndLimits.collectInterval", "0")
+			Params.initCollectInterval()
+		})
+		Params.BaseParams.Remove("quotaAndLimits.collectInterval")
+
+		shouldPanic(t, "quotaAndLimits.dmlBackPressure.growingSegmentsMemHighWaterLevel", func() {
+			Params.BaseParams.Save("quotaAndLimits.dmlBackPressure.growingSegmentsMemHighWaterLevel", "0.1")
+			Params.initGrowingSegmentsMemWaterLevel()
+		})
+		Params.BaseParams.Remove("quotaAndLimits.dmlBackPressure.growingSegmentsMemHighWaterLevel")
+
+		shouldPanic(t, "quotaAndLimits.dmlBackPressure.unflushedBytesLowWaterLevel", func() {
+			Params.BaseParams.Save("quotaAndLimits.dmlBackPressure.unflushedBytesLowWaterLevel", "-1")
+			Params.initUnflushedBytesWaterLevel()
+		})
+		Params.BaseParams.Remove("quotaAndLimits.dmlBackPressure.unflushedBytesLowWaterLevel")
+	})
+
 	t.Run("test rootCoordConfig", func(t *testing.T) {
 		Params := GlobalParams.RootCoordCfg
 
@@ -137,7 +170,33 @@ func TestGlobalParamTable(t *testing.T) {
 
 		t.Logf("MaxDimension: %d", Params.MaxDimension)
 
+		t.Logf("MaxVarCharLength: %d", Params.MaxVarCharLength)
+
+		t.Logf("DynamicFieldMaxLength: %d", Params.DynamicFieldMaxLength)
+
 		t.Logf("MaxTaskNum: %d", Params.MaxTaskNum)
+
+		t.Logf("MaxDeleteCount: %d", Params.MaxDeleteCount)
+
+		assert.False(t, Params.RateLimitEnabled)
+		assert.True(t, Params.InsertRowRate <= 0)
+		assert.True(t, Params.CollectionSearchRate <= 0)
+
+		Params.BaseParams.Save("proxy.rateLimit.enabled", "true")
+		Params.BaseParams.Save("proxy.rateLimit.insertByteRate", "2")
+		Params.BaseParams.Save("proxy.rateLimit.collection.searchRate", "100")
+		Params.initRateLimit()
+		assert.True(t, Params.RateLimitEnabled)
+		assert.Equal(t, float64(2*1024*1024), Params.InsertByteRate)
+		assert.Equal(t, float64(100), Params.CollectionSearchRate)
+		Params.BaseParams.Remove("proxy.rateLimit.enabled")
+		Params.BaseParams.Remove("proxy.rateLimit.insertByteRate")
+		Params.BaseParams.Remove("proxy.rateLimit.collection.searchRate")
+		Params.initRateLimit()
+
+		assert.False(t, Params.HTTPEnabled)
+		assert.Equal(t, 8080, Params.HTTPPort)
+		assert.Equal(t, 10*time.Second, Params.HTTPReadHeaderTimeout)
 	})
 
 	t.Run("test proxyConfig panic", func(t *testing.T) {
@@ -177,6 +236,11 @@ func TestGlobalParamTable(t *testing.T) {
 			Params.BaseParams.Save("proxy.maxTaskNum", "-asdf")
 			Params.initMaxTaskNum()
 		})
+
+		shouldPanic(t, "proxy.maxDeleteCount", func() {
+			Params.BaseParams.Save("proxy.maxDeleteCount", "-asdf")
+			Params.initMaxDeleteCount()
+		})
 	})
 
 	t.Run("test queryCoordConfig", func(t *testing.T) {
@@ -253,6 +317,9 @@ func TestGlobalParamTable(t *testing.T) {
 
 		assert.Equal(t, Params.DataCoordSubscriptionName, "by-dev-dataCoord")
 		t.Logf("DataCoord subscription channel = %s", Params.DataCoordSubscriptionName)
+
+		assert.Equal(t, 3*time.Hour, Params.ImportTaskTimeout)
+		assert.Equal(t, 24*time.Hour, Params.ImportTaskRetention)
 	})
 
 	t.Run("test dataNodeConfig", func(t *testing.T) {
@@ -402,3 +469,44 @@ func TestGrpcClientParams(t *testing.T) {
 	Params.initClientMaxSendSize()
 	assert.Equal(t, Params.ClientMaxSendSize, DefaultClientMaxSendSize)
 }
+
+func TestGrpcTLSParams(t *testing.T) {
+	var Params GrpcServerConfig
+	Params.InitOnce(typeutil.DataNodeRole)
+
+	assert.Equal(t, TLSModeDisabled, Params.TLSMode)
+	assert.NotEqual(t, "", Params.ServerPemPath)
+	assert.NotEqual(t, "", Params.ServerKeyPath)
+	assert.NotEqual(t, "", Params.ClientPemPath)
+	assert.NotEqual(t, "", Params.ClientKeyPath)
+	assert.NotEqual(t, "", Params.CaPemPath)
+	// the host of the server address is verified by default
+	assert.Equal(t, "", Params.ServerName)
+
+	Params.Save("common.security.internalTlsEnabled", "true")
+	Params.initTLS()
+	assert.Equal(t, TLSModeMutual, Params.TLSMode)
+
+	var ProxyParams GrpcServerConfig
+	ProxyParams.InitOnce(typeutil.ProxyRole)
+	assert.Equal(t, TLSModeDisabled, ProxyParams.TLSMode)
+
+	ProxyParams.Save("common.security.tlsMode", "1")
+	ProxyParams.initTLS()
+	assert.Equal(t, TLSModeOneWay, ProxyParams.TLSMode)
+
+	ProxyParams.Save("common.security.tlsMode", "3")
+	assert.Panics(t, func() {
+		ProxyParams.initTLS()
+	})
+}
+
+func TestGrpcNodeTokenParams(t *testing.T) {
+	var Params GrpcClientConfig
+	Params.InitOnce(typeutil.ProxyRole)
+	assert.Equal(t, "", Params.NodeToken)
+
+	Params.Save("common.security.nodeToken", "token")
+	Params.initNodeToken()
+	assert.Equal(t, "token", Params.NodeToken)
+}
@@ -0,0 +1,94 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"math"
+	"sync"
+	"time"
+)
+
+// Limit is the maximum number of tokens which can be consumed per second
+type Limit float64
+
+// Inf is the infinite rate limit, it allows all events
+const Inf = Limit(math.MaxFloat64)
+
+// Limiter is a token bucket which is refilled at the rate of limit and holds at most burst tokens.
+// An event is allowed as long as the bucket is not in debt, so that an event which costs more
+// than burst tokens is still able to pass once and then the following events are throttled
+// until the tokens are paid back.
+type Limiter struct {
+	mu     sync.Mutex
+	limit  Limit
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewLimiter returns a Limiter which allows events up to rate r and permits bursts of at most b tokens
+func NewLimiter(r Limit, b float64) *Limiter {
+	return &Limiter{
+		limit:  r,
+		burst:  b,
+		tokens: b,
+		last:   time.Now(),
+	}
+}
+
+// Limit returns the current rate limit
+func (lim *Limiter) Limit() Limit {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	return lim.limit
+}
+
+// SetLimit sets a new rate limit, the tokens accumulated before are kept
+func (lim *Limiter) SetLimit(now time.Time, newLimit Limit) {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	lim.advance(now)
+	lim.limit = newLimit
+}
+
+// AllowN reports whether an event which costs n tokens may happen at time now,
+// the tokens are consumed if the event is allowed
+func (lim *Limiter) AllowN(now time.Time, n float64) bool {
+	lim.mu.Lock()
+	defer lim.mu.Unlock()
+	if lim.limit == Inf {
+		return true
+	}
+	lim.advance(now)
+	if lim.tokens < 0 {
+		return false
+	}
+	lim.tokens -= n
+	return true
+}
+
+// advance refills the bucket with the tokens generated since the last update
+func (lim *Limiter) advance(now time.Time) {
+	if now.Before(lim.last) {
+		return
+	}
+	if lim.limit != Inf {
+		elapsed := now.Sub(lim.last).Seconds()
+		lim.tokens = math.Min(lim.burst, lim.tokens+elapsed*float64(lim.limit))
+	}
+	lim.last = now
+}
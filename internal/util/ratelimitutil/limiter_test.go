@@ -0,0 +1,82 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ratelimitutil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLimiter_AllowN(t *testing.T) {
+	now := time.Now()
+	lim := NewLimiter(10, 10)
+	lim.last = now
+	assert.Equal(t, Limit(10), lim.Limit())
+
+	// consume all the tokens
+	assert.True(t, lim.AllowN(now, 10))
+	// the bucket is empty but not in debt
+	assert.True(t, lim.AllowN(now, 1))
+	assert.False(t, lim.AllowN(now, 1))
+
+	// 1 token is paid back after 100ms
+	now = now.Add(100 * time.Millisecond)
+	assert.True(t, lim.AllowN(now, 1))
+	assert.False(t, lim.AllowN(now, 1))
+
+	// the bucket holds at most burst tokens, an event larger than burst is allowed once
+	now = now.Add(10 * time.Second)
+	assert.True(t, lim.AllowN(now, 15))
+	assert.False(t, lim.AllowN(now, 1))
+	now = now.Add(time.Second)
+	assert.True(t, lim.AllowN(now, 30))
+	now = now.Add(time.Second)
+	assert.False(t, lim.AllowN(now, 1))
+	now = now.Add(2 * time.Second)
+	assert.True(t, lim.AllowN(now, 1))
+
+	// time going backwards does not generate tokens
+	assert.True(t, lim.AllowN(now, 5))
+	assert.False(t, lim.AllowN(now.Add(-time.Second), 1))
+	assert.True(t, lim.AllowN(now.Add(100*time.Millisecond), 1))
+}
+
+func TestLimiter_Inf(t *testing.T) {
+	now := time.Now()
+	lim := NewLimiter(Inf, 0)
+	for i := 0; i < 100; i++ {
+		assert.True(t, lim.AllowN(now, 1e9))
+	}
+}
+
+func TestLimiter_SetLimit(t *testing.T) {
+	now := time.Now()
+	lim := NewLimiter(1, 1)
+	lim.last = now
+	assert.True(t, lim.AllowN(now, 1))
+	assert.True(t, lim.AllowN(now, 1))
+	assert.False(t, lim.AllowN(now, 1))
+
+	lim.SetLimit(now, Inf)
+	assert.Equal(t, Inf, lim.Limit())
+	assert.True(t, lim.AllowN(now, 1))
+
+	lim.SetLimit(now, 0)
+	assert.False(t, lim.AllowN(now.Add(time.Hour), 1))
+}
@@ -0,0 +1,128 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+)
+
+// AggregateFieldData evaluates the min, max or sum of the values in fieldData of type dataType, the null values are skipped.
+// The result has a single value, int64 for integers and double for floating points.
+// Min and max are empty if there is no value, and sum is 0.
+func AggregateFieldData(op planpb.Aggregate_AggregateOp, dataType schemapb.DataType, fieldData *schemapb.FieldData) (*schemapb.FieldData, error) {
+	validData := fieldData.GetValidData()
+	isValid := func(i int) bool {
+		return len(validData) == 0 || validData[i]
+	}
+
+	switch {
+	case IsIntegerType(dataType):
+		var values []int64
+		for i, v := range fieldData.GetScalars().GetLongData().GetData() {
+			if isValid(i) {
+				values = append(values, v)
+			}
+		}
+		for i, v := range fieldData.GetScalars().GetIntData().GetData() {
+			if isValid(i) {
+				values = append(values, int64(v))
+			}
+		}
+		return &schemapb.FieldData{
+			Type: schemapb.DataType_Int64,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: AggregateInt64(op, values)}},
+				},
+			},
+		}, nil
+	case IsFloatingType(dataType):
+		var values []float64
+		for i, v := range fieldData.GetScalars().GetDoubleData().GetData() {
+			if isValid(i) {
+				values = append(values, v)
+			}
+		}
+		for i, v := range fieldData.GetScalars().GetFloatData().GetData() {
+			if isValid(i) {
+				values = append(values, float64(v))
+			}
+		}
+		return &schemapb.FieldData{
+			Type: schemapb.DataType_Double,
+			Field: &schemapb.FieldData_Scalars{
+				Scalars: &schemapb.ScalarField{
+					Data: &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: AggregateFloat64(op, values)}},
+				},
+			},
+		}, nil
+	default:
+		return nil, fmt.Errorf("aggregate %s is not supported on data type %s", op.String(), dataType.String())
+	}
+}
+
+// AggregateInt64 evaluates the min, max or sum of values
+func AggregateInt64(op planpb.Aggregate_AggregateOp, values []int64) []int64 {
+	if len(values) == 0 {
+		if op == planpb.Aggregate_Sum {
+			return []int64{0}
+		}
+		return []int64{}
+	}
+	ret := values[0]
+	for _, v := range values[1:] {
+		switch op {
+		case planpb.Aggregate_Min:
+			if v < ret {
+				ret = v
+			}
+		case planpb.Aggregate_Max:
+			if v > ret {
+				ret = v
+			}
+		case planpb.Aggregate_Sum:
+			ret += v
+		}
+	}
+	return []int64{ret}
+}
+
+// AggregateFloat64 evaluates the min, max or sum of values
+func AggregateFloat64(op planpb.Aggregate_AggregateOp, values []float64) []float64 {
+	if len(values) == 0 {
+		if op == planpb.Aggregate_Sum {
+			return []float64{0}
+		}
+		return []float64{}
+	}
+	ret := values[0]
+	for _, v := range values[1:] {
+		switch op {
+		case planpb.Aggregate_Min:
+			ret = math.Min(ret, v)
+		case planpb.Aggregate_Max:
+			ret = math.Max(ret, v)
+		case planpb.Aggregate_Sum:
+			ret += v
+		}
+	}
+	return []float64{ret}
+}
@@ -0,0 +1,73 @@
+// Licensed to the LF AI & Data foundation under one
+// or more contributor license agreements. See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership. The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License. You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typeutil
+
+import (
+	"testing"
+
+	"github.com/milvus-io/milvus/internal/proto/planpb"
+	"github.com/milvus-io/milvus/internal/proto/schemapb"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAggregateFieldData(t *testing.T) {
+	intData := &schemapb.FieldData{
+		Type: schemapb.DataType_Int32,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: []int32{30, 10, 20}}},
+			},
+		},
+	}
+	floatData := &schemapb.FieldData{
+		Type: schemapb.DataType_Float,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: []float32{0.5, 1.5, 1.0}}},
+			},
+		},
+	}
+
+	ret, err := AggregateFieldData(planpb.Aggregate_Min, schemapb.DataType_Int32, intData)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Int64, ret.Type)
+	assert.Equal(t, []int64{10}, ret.GetScalars().GetLongData().Data)
+	ret, err = AggregateFieldData(planpb.Aggregate_Sum, schemapb.DataType_Int32, intData)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{60}, ret.GetScalars().GetLongData().Data)
+	ret, err = AggregateFieldData(planpb.Aggregate_Max, schemapb.DataType_Float, floatData)
+	assert.NoError(t, err)
+	assert.Equal(t, schemapb.DataType_Double, ret.Type)
+	assert.Equal(t, []float64{1.5}, ret.GetScalars().GetDoubleData().Data)
+
+	// the null values are skipped
+	intData.ValidData = []bool{true, false, true}
+	ret, err = AggregateFieldData(planpb.Aggregate_Min, schemapb.DataType_Int32, intData)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{20}, ret.GetScalars().GetLongData().Data)
+
+	// nothing to aggregate
+	ret, err = AggregateFieldData(planpb.Aggregate_Max, schemapb.DataType_Int64, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, 0, len(ret.GetScalars().GetLongData().Data))
+	ret, err = AggregateFieldData(planpb.Aggregate_Sum, schemapb.DataType_Double, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, []float64{0}, ret.GetScalars().GetDoubleData().Data)
+
+	_, err = AggregateFieldData(planpb.Aggregate_Sum, schemapb.DataType_Bool, nil)
+	assert.Error(t, err)
+}
@@ -17,15 +17,25 @@
 package typeutil
 
 import (
+	"bytes"
+	"encoding/binary"
 	"errors"
 	"fmt"
+	"io"
+	"math"
 	"strconv"
 
+	"github.com/milvus-io/milvus/internal/common"
 	"github.com/milvus-io/milvus/internal/log"
+	"github.com/milvus-io/milvus/internal/proto/commonpb"
 	"github.com/milvus-io/milvus/internal/proto/schemapb"
 	"go.uber.org/zap"
 )
 
+// estimateVarCharLength is the length estimated for a varchar value or a json document, the values are usually
+// far shorter than max_length, which only limits them
+const estimateVarCharLength = 125
+
 // EstimateSizePerRecord returns the estimate size of a record in a collection
 func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 	res := 0
@@ -41,6 +51,16 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 			res += 8
 		case schemapb.DataType_String:
 			res += 125 // todo find a better way to estimate string type
+		case schemapb.DataType_VarChar, schemapb.DataType_JSON:
+			maxLength, err := GetMaxLength(fs)
+			if err != nil {
+				return -1, err
+			}
+			// a value takes its length and the bytes of its own
+			if maxLength > estimateVarCharLength {
+				maxLength = estimateVarCharLength
+			}
+			res += varCharLengthSize + maxLength
 		case schemapb.DataType_BinaryVector:
 			for _, kv := range fs.TypeParams {
 				if kv.Key == "dim" {
@@ -70,10 +90,11 @@ func EstimateSizePerRecord(schema *schemapb.CollectionSchema) (int, error) {
 
 // SchemaHelper provides methods to get the schema of fields
 type SchemaHelper struct {
-	schema           *schemapb.CollectionSchema
-	nameOffset       map[string]int
-	idOffset         map[int64]int
-	primaryKeyOffset int
+	schema             *schemapb.CollectionSchema
+	nameOffset         map[string]int
+	idOffset           map[int64]int
+	primaryKeyOffset   int
+	dynamicFieldOffset int
 }
 
 // CreateSchemaHelper returns a new SchemaHelper object
@@ -81,7 +102,7 @@ func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error
 	if schema == nil {
 		return nil, errors.New("schema is nil")
 	}
-	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1}
+	schemaHelper := SchemaHelper{schema: schema, nameOffset: make(map[string]int), idOffset: make(map[int64]int), primaryKeyOffset: -1, dynamicFieldOffset: -1}
 	for offset, field := range schema.Fields {
 		if _, ok := schemaHelper.nameOffset[field.Name]; ok {
 			return nil, errors.New("duplicated fieldName: " + field.Name)
@@ -97,6 +118,12 @@ func CreateSchemaHelper(schema *schemapb.CollectionSchema) (*SchemaHelper, error
 			}
 			schemaHelper.primaryKeyOffset = offset
 		}
+		if field.IsDynamic {
+			if schemaHelper.dynamicFieldOffset != -1 {
+				return nil, errors.New("dynamic field is not unique")
+			}
+			schemaHelper.dynamicFieldOffset = offset
+		}
 	}
 	return &schemaHelper, nil
 }
@@ -118,6 +145,27 @@ func (helper *SchemaHelper) GetFieldFromName(fieldName string) (*schemapb.FieldS
 	return helper.schema.Fields[offset], nil
 }
 
+// GetDynamicField returns the schema of the hidden json field which stores the keys not declared in the schema
+func (helper *SchemaHelper) GetDynamicField() (*schemapb.FieldSchema, error) {
+	if helper.dynamicFieldOffset == -1 {
+		return nil, fmt.Errorf("failed to get dynamic field: dynamic field is not enabled")
+	}
+	return helper.schema.Fields[helper.dynamicFieldOffset], nil
+}
+
+// GetFieldFromNameDefaultJSON is used to find the schema by field name, the names not declared in the schema
+// resolve to the dynamic field if it is enabled
+func (helper *SchemaHelper) GetFieldFromNameDefaultJSON(fieldName string) (*schemapb.FieldSchema, error) {
+	offset, ok := helper.nameOffset[fieldName]
+	if !ok {
+		if helper.dynamicFieldOffset == -1 {
+			return nil, fmt.Errorf("failed to get field schema by name: fieldName(%s) not found", fieldName)
+		}
+		offset = helper.dynamicFieldOffset
+	}
+	return helper.schema.Fields[offset], nil
+}
+
 // GetFieldFromID returns the schema of specified field
 func (helper *SchemaHelper) GetFieldFromID(fieldID int64) (*schemapb.FieldSchema, error) {
 	offset, ok := helper.idOffset[fieldID]
@@ -148,6 +196,23 @@ func (helper *SchemaHelper) GetVectorDimFromID(fieldID int64) (int, error) {
 	return 0, fmt.Errorf("fieldID(%d) not has dim", fieldID)
 }
 
+// GetMaxLength returns the max length of a varchar field, or the max length of the serialized document of a json field
+func GetMaxLength(field *schemapb.FieldSchema) (int, error) {
+	if field.GetDataType() != schemapb.DataType_VarChar && field.GetDataType() != schemapb.DataType_JSON {
+		return 0, fmt.Errorf("field %s of data type %s has no max length", field.GetName(), field.GetDataType().String())
+	}
+	for _, kv := range field.GetTypeParams() {
+		if kv.GetKey() == "max_length" {
+			maxLength, err := strconv.Atoi(kv.GetValue())
+			if err != nil {
+				return 0, err
+			}
+			return maxLength, nil
+		}
+	}
+	return 0, fmt.Errorf("max length of %s field %s is not set", field.GetDataType().String(), field.GetName())
+}
+
 // IsVectorType returns true if input is a vector type, otherwise false
 func IsVectorType(dataType schemapb.DataType) bool {
 	switch dataType {
@@ -179,6 +244,21 @@ func IsFloatingType(dataType schemapb.DataType) bool {
 	}
 }
 
+// IsStringType returns true if input is a string type, otherwise false
+func IsStringType(dataType schemapb.DataType) bool {
+	switch dataType {
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsJSONType returns true if input is a json type, otherwise false
+func IsJSONType(dataType schemapb.DataType) bool {
+	return dataType == schemapb.DataType_JSON
+}
+
 // IsBoolType returns true if input is a bool type, otherwise false
 func IsBoolType(dataType schemapb.DataType) bool {
 	switch dataType {
@@ -189,6 +269,338 @@ func IsBoolType(dataType schemapb.DataType) bool {
 	}
 }
 
+// GetDefaultValue returns the default value of the field as the go type of its data type,
+// nil is returned if the field has no default value
+func GetDefaultValue(field *schemapb.FieldSchema) interface{} {
+	value := field.GetDefaultValue()
+	if value == nil {
+		return nil
+	}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		return value.GetBoolData()
+	case schemapb.DataType_Int8:
+		return int8(value.GetIntData())
+	case schemapb.DataType_Int16:
+		return int16(value.GetIntData())
+	case schemapb.DataType_Int32:
+		return value.GetIntData()
+	case schemapb.DataType_Int64:
+		return value.GetLongData()
+	case schemapb.DataType_Float:
+		return value.GetFloatData()
+	case schemapb.DataType_Double:
+		return value.GetDoubleData()
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		return value.GetStringData()
+	default:
+		return nil
+	}
+}
+
+// CheckDefaultValue checks the default value of the field matches its data type
+func CheckDefaultValue(field *schemapb.FieldSchema) error {
+	value := field.GetDefaultValue()
+	if value == nil {
+		return nil
+	}
+	mismatch := fmt.Errorf("default value of field %s does not match the data type %s", field.GetName(), field.GetDataType().String())
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		if _, ok := value.GetData().(*schemapb.ValueField_BoolData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		if _, ok := value.GetData().(*schemapb.ValueField_IntData); !ok {
+			return mismatch
+		}
+		v := value.GetIntData()
+		if (field.GetDataType() == schemapb.DataType_Int8 && (v < math.MinInt8 || v > math.MaxInt8)) ||
+			(field.GetDataType() == schemapb.DataType_Int16 && (v < math.MinInt16 || v > math.MaxInt16)) {
+			return fmt.Errorf("default value %d of field %s overflows the data type %s", v, field.GetName(), field.GetDataType().String())
+		}
+	case schemapb.DataType_Int64:
+		if _, ok := value.GetData().(*schemapb.ValueField_LongData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Float:
+		if _, ok := value.GetData().(*schemapb.ValueField_FloatData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_Double:
+		if _, ok := value.GetData().(*schemapb.ValueField_DoubleData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_String:
+		if _, ok := value.GetData().(*schemapb.ValueField_StringData); !ok {
+			return mismatch
+		}
+	case schemapb.DataType_VarChar:
+		if _, ok := value.GetData().(*schemapb.ValueField_StringData); !ok {
+			return mismatch
+		}
+		maxLength, err := GetMaxLength(field)
+		if err != nil {
+			return err
+		}
+		if len(value.GetStringData()) > maxLength {
+			return fmt.Errorf("length of default value of field %s exceeds the max length %d", field.GetName(), maxLength)
+		}
+	default:
+		return fmt.Errorf("field %s of data type %s does not support default value", field.GetName(), field.GetDataType().String())
+	}
+	return nil
+}
+
+// GenDefaultFieldData generates the column of numRows rows of a field missing from the inserted data,
+// the rows take the default value of the field, or null if the field is nullable without a default value
+func GenDefaultFieldData(field *schemapb.FieldSchema, numRows int) (*schemapb.FieldData, error) {
+	value := field.GetDefaultValue()
+	if value == nil && !field.GetNullable() {
+		return nil, fmt.Errorf("field %s has no default value and is not nullable", field.GetName())
+	}
+
+	scalars := &schemapb.ScalarField{}
+	switch field.GetDataType() {
+	case schemapb.DataType_Bool:
+		data := make([]bool, numRows)
+		for i := range data {
+			data[i] = value.GetBoolData()
+		}
+		scalars.Data = &schemapb.ScalarField_BoolData{BoolData: &schemapb.BoolArray{Data: data}}
+	case schemapb.DataType_Int8, schemapb.DataType_Int16, schemapb.DataType_Int32:
+		data := make([]int32, numRows)
+		for i := range data {
+			data[i] = value.GetIntData()
+		}
+		scalars.Data = &schemapb.ScalarField_IntData{IntData: &schemapb.IntArray{Data: data}}
+	case schemapb.DataType_Int64:
+		data := make([]int64, numRows)
+		for i := range data {
+			data[i] = value.GetLongData()
+		}
+		scalars.Data = &schemapb.ScalarField_LongData{LongData: &schemapb.LongArray{Data: data}}
+	case schemapb.DataType_Float:
+		data := make([]float32, numRows)
+		for i := range data {
+			data[i] = value.GetFloatData()
+		}
+		scalars.Data = &schemapb.ScalarField_FloatData{FloatData: &schemapb.FloatArray{Data: data}}
+	case schemapb.DataType_Double:
+		data := make([]float64, numRows)
+		for i := range data {
+			data[i] = value.GetDoubleData()
+		}
+		scalars.Data = &schemapb.ScalarField_DoubleData{DoubleData: &schemapb.DoubleArray{Data: data}}
+	case schemapb.DataType_String, schemapb.DataType_VarChar:
+		data := make([]string, numRows)
+		for i := range data {
+			data[i] = value.GetStringData()
+		}
+		scalars.Data = &schemapb.ScalarField_StringData{StringData: &schemapb.StringArray{Data: data}}
+	case schemapb.DataType_JSON:
+		// a json field has no default value, the null rows are left empty
+		scalars.Data = &schemapb.ScalarField_JsonData{JsonData: &schemapb.JSONArray{Data: make([][]byte, numRows)}}
+	default:
+		return nil, fmt.Errorf("field %s of data type %s does not support default value", field.GetName(), field.GetDataType().String())
+	}
+
+	fieldData := &schemapb.FieldData{
+		Type:      field.GetDataType(),
+		FieldName: field.GetName(),
+		FieldId:   field.GetFieldID(),
+		Field:     &schemapb.FieldData_Scalars{Scalars: scalars},
+	}
+	if value == nil {
+		// all the rows are null
+		fieldData.ValidData = make([]bool, numRows)
+	}
+	return fieldData, nil
+}
+
+// varCharLengthSize is the size of the length prefixed to a varchar value in the row based data
+const varCharLengthSize = 4
+
+// EncodeVarChar encodes a varchar value or a serialized json document into the row based data,
+// the value is prefixed by its length in uint32 so it takes as many bytes as it needs
+func EncodeVarChar(value string, maxLength int) ([]byte, error) {
+	if len(value) > maxLength {
+		return nil, fmt.Errorf("the length %d of varchar value exceeds the max length %d", len(value), maxLength)
+	}
+	b := make([]byte, varCharLengthSize+len(value))
+	common.Endian.PutUint32(b, uint32(len(value)))
+	copy(b[varCharLengthSize:], value)
+	return b, nil
+}
+
+// DecodeVarChar decodes a varchar value encoded by EncodeVarChar from the head of b,
+// and returns the value and the size of the encoded value
+func DecodeVarChar(b []byte) (string, int, error) {
+	if len(b) < varCharLengthSize {
+		return "", 0, fmt.Errorf("size %d of the encoded varchar value is less than the size of its length", len(b))
+	}
+	size := varCharLengthSize + int(common.Endian.Uint32(b))
+	if len(b) < size {
+		return "", 0, fmt.Errorf("size %d of the encoded varchar value is less than %d", len(b), size)
+	}
+	return string(b[varCharLengthSize:size]), size, nil
+}
+
+// ReadVarChar reads a varchar value encoded by EncodeVarChar from r
+func ReadVarChar(r io.Reader) (string, error) {
+	var length uint32
+	if err := binary.Read(r, common.Endian, &length); err != nil {
+		return "", err
+	}
+	b := make([]byte, length)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// SplitRowData splits a row of the row based data encoded with the fields, in order, into the encoded values
+// of the fields. A varchar or json value takes its length and the bytes of the value, the others take fixed sizes.
+// The bytes following the fields are ignored
+func SplitRowData(fields []*schemapb.FieldSchema, row []byte) ([][]byte, error) {
+	values := make([][]byte, 0, len(fields))
+	offset := 0
+	for _, field := range fields {
+		var size int
+		if field.GetDataType() == schemapb.DataType_VarChar || field.GetDataType() == schemapb.DataType_JSON {
+			_, n, err := DecodeVarChar(row[offset:])
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode field %s, %w", field.GetName(), err)
+			}
+			size = n
+		} else {
+			n, err := EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+			if err != nil {
+				return nil, err
+			}
+			if offset+n > len(row) {
+				return nil, fmt.Errorf("size of row is %d, less than the fields", len(row))
+			}
+			size = n
+		}
+		values = append(values, row[offset:offset+size])
+		offset += size
+	}
+	return values, nil
+}
+
+// EncodeDefaultRow encodes the default values of the fields into the row based data, in order
+func EncodeDefaultRow(fields []*schemapb.FieldSchema) ([]byte, error) {
+	var row bytes.Buffer
+	for _, field := range fields {
+		value := GetDefaultValue(field)
+		if value == nil {
+			return nil, fmt.Errorf("field %s has no default value", field.GetName())
+		}
+		if field.GetDataType() == schemapb.DataType_VarChar {
+			maxLength, err := GetMaxLength(field)
+			if err != nil {
+				return nil, err
+			}
+			b, err := EncodeVarChar(value.(string), maxLength)
+			if err != nil {
+				return nil, err
+			}
+			row.Write(b)
+			continue
+		}
+		if err := binary.Write(&row, common.Endian, value); err != nil {
+			return nil, err
+		}
+	}
+	return row.Bytes(), nil
+}
+
+// GetUserFields returns the user fields of the schema, in order, which are the fields of the row based data
+func GetUserFields(schema *schemapb.CollectionSchema) []*schemapb.FieldSchema {
+	userFields := make([]*schemapb.FieldSchema, 0, len(schema.GetFields()))
+	for _, field := range schema.GetFields() {
+		if field.GetFieldID() >= common.StartOfUserFieldID {
+			userFields = append(userFields, field)
+		}
+	}
+	return userFields
+}
+
+// AlignRowData lays out the row based data encoded with the user fields of fieldIDs, in order, as the user fields of the schema.
+// AddField appends a field to the schema, so the fields of the schema missing in the rows are filled with their default values,
+// and the trailing fields unknown to the schema, which are added after the schema is cached, are dropped.
+// The rows are left as they are if fieldIDs is empty.
+func AlignRowData(schema *schemapb.CollectionSchema, fieldIDs []int64, rows []*commonpb.Blob) error {
+	if len(fieldIDs) == 0 || len(rows) == 0 {
+		return nil
+	}
+	userFields := GetUserFields(schema)
+	if len(userFields) == len(fieldIDs) {
+		aligned := true
+		for i, field := range userFields {
+			if field.GetFieldID() != fieldIDs[i] {
+				aligned = false
+				break
+			}
+		}
+		if aligned {
+			return nil
+		}
+	}
+
+	fields := make(map[int64]*schemapb.FieldSchema, len(userFields))
+	for _, field := range userFields {
+		fields[field.GetFieldID()] = field
+	}
+	// the fields of the rows known to the schema, and the positions of them in the rows
+	rowFields := make([]*schemapb.FieldSchema, 0, len(fieldIDs))
+	positions := make(map[int64]int, len(fieldIDs))
+	for i, fieldID := range fieldIDs {
+		field, ok := fields[fieldID]
+		if !ok {
+			for _, trailing := range fieldIDs[i+1:] {
+				if _, ok := fields[trailing]; ok {
+					return fmt.Errorf("field %d of the rows is unknown to the schema of version %d", fieldID, schema.GetVersion())
+				}
+			}
+			break
+		}
+		positions[fieldID] = len(rowFields)
+		rowFields = append(rowFields, field)
+	}
+
+	// the default values of the fields missing in the rows
+	defaults := make(map[int64][]byte)
+	for _, field := range userFields {
+		if _, ok := positions[field.GetFieldID()]; ok {
+			continue
+		}
+		value, err := EncodeDefaultRow([]*schemapb.FieldSchema{field})
+		if err != nil {
+			return err
+		}
+		defaults[field.GetFieldID()] = value
+	}
+
+	for i, row := range rows {
+		values, err := SplitRowData(rowFields, row.GetValue())
+		if err != nil {
+			return fmt.Errorf("failed to split row %d, %w", i, err)
+		}
+		value := make([]byte, 0, len(row.GetValue()))
+		for _, field := range userFields {
+			if pos, ok := positions[field.GetFieldID()]; ok {
+				value = append(value, values[pos]...)
+				continue
+			}
+			value = append(value, defaults[field.GetFieldID()]...)
+		}
+		rows[i] = &commonpb.Blob{Value: value}
+	}
+	return nil
+}
+
 // AppendFieldData appends fields data of specified index from src to dst
 func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx int64) {
 	for i, fieldData := range src {
@@ -255,9 +667,32 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 				} else {
 					dstScalar.GetDoubleData().Data = append(dstScalar.GetDoubleData().Data, srcScalar.DoubleData.Data[idx])
 				}
+			case *schemapb.ScalarField_StringData:
+				if dstScalar.GetStringData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_StringData{
+						StringData: &schemapb.StringArray{
+							Data: []string{srcScalar.StringData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetStringData().Data = append(dstScalar.GetStringData().Data, srcScalar.StringData.Data[idx])
+				}
+			case *schemapb.ScalarField_JsonData:
+				if dstScalar.GetJsonData() == nil {
+					dstScalar.Data = &schemapb.ScalarField_JsonData{
+						JsonData: &schemapb.JSONArray{
+							Data: [][]byte{srcScalar.JsonData.Data[idx]},
+						},
+					}
+				} else {
+					dstScalar.GetJsonData().Data = append(dstScalar.GetJsonData().Data, srcScalar.JsonData.Data[idx])
+				}
 			default:
 				log.Error("Not supported field type", zap.String("field type", fieldData.Type.String()))
 			}
+			if validData := fieldData.GetValidData(); len(validData) > 0 {
+				dst[i].ValidData = append(dst[i].ValidData, validData[idx])
+			}
 		case *schemapb.FieldData_Vectors:
 			dim := fieldType.Vectors.Dim
 			if dst[i] == nil || dst[i].GetVectors() == nil {
@@ -298,3 +733,58 @@ func AppendFieldData(dst []*schemapb.FieldData, src []*schemapb.FieldData, idx i
 		}
 	}
 }
+
+// GetSizeOfIDs returns the number of primary keys in the ids
+func GetSizeOfIDs(ids *schemapb.IDs) int {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return len(ids.GetIntId().GetData())
+	case *schemapb.IDs_StrId:
+		return len(ids.GetStrId().GetData())
+	default:
+		return 0
+	}
+}
+
+// GetPK returns the idx-th primary key in the ids, which is an int64 or a string
+func GetPK(ids *schemapb.IDs, idx int64) interface{} {
+	switch ids.GetIdField().(type) {
+	case *schemapb.IDs_IntId:
+		return ids.GetIntId().GetData()[idx]
+	case *schemapb.IDs_StrId:
+		return ids.GetStrId().GetData()[idx]
+	default:
+		return nil
+	}
+}
+
+// AppendPKs appends a primary key of int64 or string to the ids
+func AppendPKs(ids *schemapb.IDs, pk interface{}) {
+	switch v := pk.(type) {
+	case int64:
+		if ids.GetIdField() == nil {
+			ids.IdField = &schemapb.IDs_IntId{IntId: &schemapb.LongArray{}}
+		}
+		ids.GetIntId().Data = append(ids.GetIntId().Data, v)
+	case string:
+		if ids.GetIdField() == nil {
+			ids.IdField = &schemapb.IDs_StrId{StrId: &schemapb.StringArray{}}
+		}
+		ids.GetStrId().Data = append(ids.GetStrId().Data, v)
+	default:
+		log.Error("Not supported primary key type", zap.Any("pk", pk))
+	}
+}
+
+// IsInvalidPK returns whether the primary key marks an empty result,
+// which is -1 for int64 primary keys and the empty string for varchar primary keys
+func IsInvalidPK(pk interface{}) bool {
+	switch v := pk.(type) {
+	case int64:
+		return v == -1
+	case string:
+		return v == ""
+	default:
+		return true
+	}
+}
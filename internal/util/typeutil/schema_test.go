@@ -17,6 +17,7 @@
 package typeutil
 
 import (
+	"bytes"
 	"testing"
 
 	"go.uber.org/zap"
@@ -494,14 +495,323 @@ func TestAppendFieldData(t *testing.T) {
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(BinaryVectorFieldName, BinaryVectorFieldID, schemapb.DataType_BinaryVector, BinaryVector[Dim/8:2*Dim/8], Dim))
 	fieldDataArray2 = append(fieldDataArray2, genFieldData(FloatVectorFieldName, FloatVectorFieldID, schemapb.DataType_FloatVector, FloatVector[Dim:2*Dim], Dim))
 
+	fieldDataArray1[1].ValidData = []bool{true}
+	fieldDataArray2[1].ValidData = []bool{false}
+
 	AppendFieldData(result, fieldDataArray1, 0)
 	AppendFieldData(result, fieldDataArray2, 0)
 
 	assert.Equal(t, BoolArray, result[0].GetScalars().GetBoolData().Data)
 	assert.Equal(t, Int32Array, result[1].GetScalars().GetIntData().Data)
+	assert.Equal(t, []bool{true, false}, result[1].GetValidData())
+	assert.Nil(t, result[2].GetValidData())
 	assert.Equal(t, Int64Array, result[2].GetScalars().GetLongData().Data)
 	assert.Equal(t, FloatArray, result[3].GetScalars().GetFloatData().Data)
 	assert.Equal(t, DoubleArray, result[4].GetScalars().GetDoubleData().Data)
 	assert.Equal(t, BinaryVector, result[5].GetVectors().Data.(*schemapb.VectorField_BinaryVector).BinaryVector)
 	assert.Equal(t, FloatVector, result[6].GetVectors().GetFloatVector().Data)
 }
+
+func TestCheckDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{Name: "field", DataType: schemapb.DataType_Int8}
+	assert.NoError(t, CheckDefaultValue(field))
+	assert.Nil(t, GetDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}}
+	assert.NoError(t, CheckDefaultValue(field))
+	assert.Equal(t, int8(1), GetDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1000}}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 1}}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DataType = schemapb.DataType_Int64
+	assert.NoError(t, CheckDefaultValue(field))
+	assert.Equal(t, int64(1), GetDefaultValue(field))
+
+	field.DataType = schemapb.DataType_FloatVector
+	assert.Error(t, CheckDefaultValue(field))
+}
+
+func TestGenDefaultFieldData(t *testing.T) {
+	field := &schemapb.FieldSchema{FieldID: 100, Name: "field", DataType: schemapb.DataType_Int64}
+	_, err := GenDefaultFieldData(field, 2)
+	assert.Error(t, err)
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_LongData{LongData: 3}}
+	fieldData, err := GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(100), fieldData.GetFieldId())
+	assert.Equal(t, []int64{3, 3}, fieldData.GetScalars().GetLongData().GetData())
+	assert.Nil(t, fieldData.GetValidData())
+
+	field.DefaultValue = nil
+	field.Nullable = true
+	fieldData, err = GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []int64{0, 0}, fieldData.GetScalars().GetLongData().GetData())
+	assert.Equal(t, []bool{false, false}, fieldData.GetValidData())
+
+	field.DataType = schemapb.DataType_FloatVector
+	_, err = GenDefaultFieldData(field, 2)
+	assert.Error(t, err)
+}
+
+func TestAlignRowData(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "field_int64", DataType: schemapb.DataType_Int64},
+			{FieldID: common.RowIDField, Name: "RowID", DataType: schemapb.DataType_Int64},
+			{FieldID: common.TimeStampField, Name: "Timestamp", DataType: schemapb.DataType_Int64},
+			{
+				FieldID:      common.StartOfUserFieldID + 1,
+				Name:         "field_int32",
+				DataType:     schemapb.DataType_Int32,
+				DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 7}},
+			},
+		},
+		Version: 1,
+	}
+	oldRow := []byte{1, 0, 0, 0, 0, 0, 0, 0}
+	newRow := []byte{1, 0, 0, 0, 0, 0, 0, 0, 7, 0, 0, 0}
+	int64ID, int32ID := int64(common.StartOfUserFieldID), int64(common.StartOfUserFieldID+1)
+
+	rows := []*commonpb.Blob{{Value: oldRow}}
+	assert.NoError(t, AlignRowData(schema, []int64{int64ID}, rows))
+	assert.Equal(t, newRow, rows[0].Value)
+	// the original row is not modified
+	assert.Len(t, oldRow, 8)
+
+	rows = []*commonpb.Blob{{Value: newRow}}
+	assert.NoError(t, AlignRowData(schema, []int64{int64ID, int32ID}, rows))
+	assert.Equal(t, newRow, rows[0].Value)
+
+	// the rows are left as they are without the field ids
+	rows = []*commonpb.Blob{{Value: oldRow}}
+	assert.NoError(t, AlignRowData(schema, nil, rows))
+	assert.Equal(t, oldRow, rows[0].Value)
+
+	// the field added after the schema is cached is dropped
+	rows = []*commonpb.Blob{{Value: append(newRow, 1, 0, 0, 0)}}
+	assert.NoError(t, AlignRowData(schema, []int64{int64ID, int32ID, int32ID + 1}, rows))
+	assert.Equal(t, newRow, rows[0].Value)
+
+	// the fields are laid out by their ids rather than the count
+	rows = []*commonpb.Blob{{Value: []byte{9, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0}}}
+	assert.NoError(t, AlignRowData(schema, []int64{int32ID, int64ID}, rows))
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 9, 0, 0, 0}, rows[0].Value)
+
+	// an unknown field followed by a known one
+	assert.Error(t, AlignRowData(schema, []int64{int32ID + 1, int64ID}, []*commonpb.Blob{{Value: newRow}}))
+	// the row is shorter than the fields
+	assert.Error(t, AlignRowData(schema, []int64{int32ID, int64ID}, []*commonpb.Blob{{Value: oldRow}}))
+
+	schema.Fields[3].DefaultValue = nil
+	assert.Error(t, AlignRowData(schema, []int64{int64ID}, []*commonpb.Blob{{Value: oldRow}}))
+}
+
+func TestVarChar(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "field_varchar",
+		DataType:   schemapb.DataType_VarChar,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "4"}},
+	}
+	maxLength, err := GetMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, 4, maxLength)
+
+	size, err := EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+	assert.Nil(t, err)
+	assert.Equal(t, 8, size)
+
+	b, err := EncodeVarChar("ab", maxLength)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte{2, 0, 0, 0, 'a', 'b'}, b)
+	value, size, err := DecodeVarChar(append(b, 1))
+	assert.Nil(t, err)
+	assert.Equal(t, "ab", value)
+	assert.Equal(t, 6, size)
+	value, err = ReadVarChar(bytes.NewReader(b))
+	assert.Nil(t, err)
+	assert.Equal(t, "ab", value)
+	_, err = EncodeVarChar("abcde", maxLength)
+	assert.Error(t, err)
+
+	// the trailing zeros are kept
+	b, err = EncodeVarChar("a\x00", maxLength)
+	assert.Nil(t, err)
+	value, _, err = DecodeVarChar(b)
+	assert.Nil(t, err)
+	assert.Equal(t, "a\x00", value)
+
+	_, _, err = DecodeVarChar(b[:3])
+	assert.Error(t, err)
+	_, _, err = DecodeVarChar(b[:5])
+	assert.Error(t, err)
+	_, err = ReadVarChar(bytes.NewReader(b[:5]))
+	assert.Error(t, err)
+
+	field.TypeParams = nil
+	_, err = GetMaxLength(field)
+	assert.Error(t, err)
+	field.DataType = schemapb.DataType_Int64
+	_, err = GetMaxLength(field)
+	assert.Error(t, err)
+}
+
+func TestVarCharDefaultValue(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		FieldID:      common.StartOfUserFieldID + 1,
+		Name:         "field_varchar",
+		DataType:     schemapb.DataType_VarChar,
+		TypeParams:   []*commonpb.KeyValuePair{{Key: "max_length", Value: "4"}},
+		DefaultValue: &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "ab"}},
+	}
+	assert.NoError(t, CheckDefaultValue(field))
+	assert.Equal(t, "ab", GetDefaultValue(field))
+
+	fieldData, err := GenDefaultFieldData(field, 2)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"ab", "ab"}, fieldData.GetScalars().GetStringData().GetData())
+	row, err := EncodeDefaultRow([]*schemapb.FieldSchema{field})
+	assert.NoError(t, err)
+	assert.Equal(t, []byte{2, 0, 0, 0, 'a', 'b'}, row)
+
+	schema := &schemapb.CollectionSchema{
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: common.StartOfUserFieldID, Name: "field_int64", DataType: schemapb.DataType_Int64},
+			field,
+		},
+		Version: 1,
+	}
+	rows := []*commonpb.Blob{{Value: []byte{1, 0, 0, 0, 0, 0, 0, 0}}}
+	assert.NoError(t, AlignRowData(schema, []int64{common.StartOfUserFieldID}, rows))
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 2, 0, 0, 0, 'a', 'b'}, rows[0].Value)
+
+	// the varchar values of the rows vary in size
+	rows = []*commonpb.Blob{
+		{Value: []byte{1, 0, 0, 0, 'x', 1, 0, 0, 0, 0, 0, 0, 0}},
+		{Value: []byte{3, 0, 0, 0, 'x', 'y', 'z', 2, 0, 0, 0, 0, 0, 0, 0}},
+	}
+	assert.NoError(t, AlignRowData(schema, []int64{field.FieldID, common.StartOfUserFieldID}, rows))
+	assert.Equal(t, []byte{1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 'x'}, rows[0].Value)
+	assert.Equal(t, []byte{2, 0, 0, 0, 0, 0, 0, 0, 3, 0, 0, 0, 'x', 'y', 'z'}, rows[1].Value)
+	// the length of the varchar value exceeds the row
+	rows = []*commonpb.Blob{{Value: []byte{9, 0, 0, 0, 'x', 1, 0, 0, 0, 0, 0, 0, 0}}}
+	assert.Error(t, AlignRowData(schema, []int64{field.FieldID, common.StartOfUserFieldID}, rows))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "abcde"}}
+	assert.Error(t, CheckDefaultValue(field))
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_IntData{IntData: 1}}
+	assert.Error(t, CheckDefaultValue(field))
+}
+
+func TestJSON(t *testing.T) {
+	field := &schemapb.FieldSchema{
+		Name:       "field_json",
+		DataType:   schemapb.DataType_JSON,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "16"}},
+	}
+	assert.True(t, IsJSONType(field.DataType))
+	assert.False(t, IsStringType(field.DataType))
+	maxLength, err := GetMaxLength(field)
+	assert.Nil(t, err)
+	assert.Equal(t, 16, maxLength)
+
+	size, err := EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{field}})
+	assert.Nil(t, err)
+	assert.Equal(t, 20, size)
+	// the documents are estimated far shorter than a large max_length
+	largeField := &schemapb.FieldSchema{
+		DataType:   schemapb.DataType_JSON,
+		TypeParams: []*commonpb.KeyValuePair{{Key: "max_length", Value: "4096"}},
+	}
+	size, err = EstimateSizePerRecord(&schemapb.CollectionSchema{Fields: []*schemapb.FieldSchema{largeField}})
+	assert.Nil(t, err)
+	assert.Equal(t, 4+estimateVarCharLength, size)
+
+	src := []*schemapb.FieldData{{
+		Type:      schemapb.DataType_JSON,
+		FieldName: field.Name,
+		Field: &schemapb.FieldData_Scalars{
+			Scalars: &schemapb.ScalarField{
+				Data: &schemapb.ScalarField_JsonData{
+					JsonData: &schemapb.JSONArray{Data: [][]byte{[]byte(`{"a":1}`), []byte(`{"a":2}`)}},
+				},
+			},
+		},
+	}}
+	dst := make([]*schemapb.FieldData, 1)
+	AppendFieldData(dst, src, 1)
+	AppendFieldData(dst, src, 0)
+	assert.Equal(t, [][]byte{[]byte(`{"a":2}`), []byte(`{"a":1}`)}, dst[0].GetScalars().GetJsonData().GetData())
+
+	field.DefaultValue = &schemapb.ValueField{Data: &schemapb.ValueField_StringData{StringData: "{}"}}
+	assert.Error(t, CheckDefaultValue(field))
+	field.DefaultValue = nil
+	field.Nullable = true
+	fieldData, err := GenDefaultFieldData(field, 2)
+	assert.Nil(t, err)
+	assert.Len(t, fieldData.GetScalars().GetJsonData().GetData(), 2)
+	assert.Equal(t, []bool{false, false}, fieldData.GetValidData())
+}
+
+func TestDynamicField(t *testing.T) {
+	schema := &schemapb.CollectionSchema{
+		Name:               "testColl",
+		EnableDynamicField: true,
+		Fields: []*schemapb.FieldSchema{
+			{FieldID: 100, Name: "pk", IsPrimaryKey: true, DataType: schemapb.DataType_Int64},
+			{FieldID: 101, Name: "$meta", DataType: schemapb.DataType_JSON, IsDynamic: true},
+		},
+	}
+	helper, err := CreateSchemaHelper(schema)
+	assert.Nil(t, err)
+
+	field, err := helper.GetDynamicField()
+	assert.Nil(t, err)
+	assert.Equal(t, "$meta", field.Name)
+
+	field, err = helper.GetFieldFromNameDefaultJSON("pk")
+	assert.Nil(t, err)
+	assert.Equal(t, "pk", field.Name)
+	field, err = helper.GetFieldFromNameDefaultJSON("color")
+	assert.Nil(t, err)
+	assert.Equal(t, "$meta", field.Name)
+	_, err = helper.GetFieldFromName("color")
+	assert.NotNil(t, err)
+
+	schema.Fields = append(schema.Fields, &schemapb.FieldSchema{FieldID: 102, Name: "$meta2", DataType: schemapb.DataType_JSON, IsDynamic: true})
+	_, err = CreateSchemaHelper(schema)
+	assert.EqualError(t, err, "dynamic field is not unique")
+
+	schema.Fields = schema.Fields[:1]
+	helper, err = CreateSchemaHelper(schema)
+	assert.Nil(t, err)
+	_, err = helper.GetDynamicField()
+	assert.NotNil(t, err)
+	_, err = helper.GetFieldFromNameDefaultJSON("color")
+	assert.NotNil(t, err)
+}
+
+func TestIDs(t *testing.T) {
+	intIDs := &schemapb.IDs{}
+	AppendPKs(intIDs, int64(1))
+	AppendPKs(intIDs, int64(-1))
+	assert.Equal(t, 2, GetSizeOfIDs(intIDs))
+	assert.Equal(t, int64(1), GetPK(intIDs, 0))
+	assert.True(t, IsInvalidPK(GetPK(intIDs, 1)))
+
+	strIDs := &schemapb.IDs{}
+	AppendPKs(strIDs, "a")
+	AppendPKs(strIDs, "")
+	assert.Equal(t, 2, GetSizeOfIDs(strIDs))
+	assert.Equal(t, "a", GetPK(strIDs, 0))
+	assert.True(t, IsInvalidPK(GetPK(strIDs, 1)))
+
+	assert.Equal(t, 0, GetSizeOfIDs(&schemapb.IDs{}))
+	assert.Nil(t, GetPK(&schemapb.IDs{}, 0))
+}